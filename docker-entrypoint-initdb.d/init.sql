@@ -16,6 +16,31 @@ CREATE TABLE IF NOT EXISTS public.algorand_wallets(
     FOREIGN KEY (user_id) REFERENCES public.users (user_id)
 );
 
+CREATE TABLE IF NOT EXISTS public.user_roles(
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('customer', 'merchant', 'admin')),
+    PRIMARY KEY (user_id, role),
+    FOREIGN KEY (user_id) REFERENCES public.users (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS public.user_totp(
+    user_id UUID NOT NULL PRIMARY KEY,
+    secret TEXT NOT NULL,
+    confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user_id) REFERENCES public.users (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS public.user_recovery_codes(
+    code_id UUID NOT NULL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    code_hash TEXT NOT NULL,
+    used_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES public.users (user_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_recovery_codes_user_id_idx ON public.user_recovery_codes (user_id);
+
 INSERT INTO public.users
 (user_id, first_name, last_name, email, user_password)
 VALUES
@@ -28,4 +53,12 @@ INSERT INTO public.algorand_wallets
 VALUES
 ('3735b92d-5dcb-4dc0-a8b0-54415d0c52d3', '85e6a060-f914-48d1-b73a-23b7e6c81f46','S654A2A7TYORBPQ4GT4VBRZMVOCLT5EXA34RQISUF6TEHDCHC3GDJF7MTI', 'Y2hvb3NlIHByYWN0aWNlIGtpdGNoZW4gaGVuIGJvcmluZyBrbmVlIHZlbnVlIHJlbmV3IHNldHRsZSByZXNpc3Qgc2hhbGxvdyBraW5nZG9tIGhhd2sgZmF0IHBhdGNoIGNhbXAgdHVuYSBmYW5jeSBwaWNuaWMgaW1wYWN0IGluY2ggcHVscCBvbmlvbiBhYnNvcmIgYWNoaWV2ZQ=='),
 ('c09e7795-8b25-4639-b7e3-8c2592298eb8', '65a8ed73-b6f3-4543-82a6-7ab9ef6e9c7b', '6KVRYUNUMH4VCTQS55KWQQEXOV6QT7FOX6AZ4RFSIYQGPXN2J6X37OMYS4', 'Z2FwIGdyYXZpdHkgYWJvdmUgdW5hYmxlIGVhc3QgaG9tZSB0b3dhcmQgYmlydGggaHVtYW4gc2FkIHJpYiB2aWxsYWdlIHdoZWF0IGluZGV4IHR1cm4gdm9sY2FubyBzdWJqZWN0IGF1dGhvciBncmFjZSBhcm1vciBidW5kbGUgZXRlcm5hbCBtb2RpZnkgYWJzb3JiIGVnZw=='),
-('56526826-61d3-49c7-9496-a0efdab27639','5eb05a37-cee1-46dd-bafc-c9d32ef95d59','SCD7M75KMUCJTIDYHLIW374PTJYKEZYTBQH4TBJRHH5X4VHAVIDAV75Z2Q', 'Zm9ydW0gY291cnNlIHNwb25zb3IgYmlrZSB0dXJ0bGUgcm9hZCBtdXNocm9vbSBiZXlvbmQgc2hvY2sgcmVjYWxsIHJlY2FsbCBneW0gc3RlcmVvIHNlYXJjaCBwaWxvdCBndWlkZSBhcnJhbmdlIGVzdGF0ZSBkaXNoIHNlbnNlIHNlZWsgc2VydmljZSBkd2FyZiBhYnNlbnQgc3VtbWVy');
\ No newline at end of file
+('56526826-61d3-49c7-9496-a0efdab27639','5eb05a37-cee1-46dd-bafc-c9d32ef95d59','SCD7M75KMUCJTIDYHLIW374PTJYKEZYTBQH4TBJRHH5X4VHAVIDAV75Z2Q', 'Zm9ydW0gY291cnNlIHNwb25zb3IgYmlrZSB0dXJ0bGUgcm9hZCBtdXNocm9vbSBiZXlvbmQgc2hvY2sgcmVjYWxsIHJlY2FsbCBneW0gc3RlcmVvIHNlYXJjaCBwaWxvdCBndWlkZSBhcnJhbmdlIGVzdGF0ZSBkaXNoIHNlbnNlIHNlZWsgc2VydmljZSBkd2FyZiBhYnNlbnQgc3VtbWVy');
+
+INSERT INTO public.user_roles
+(user_id, role)
+VALUES
+('85e6a060-f914-48d1-b73a-23b7e6c81f46', 'merchant'),
+('85e6a060-f914-48d1-b73a-23b7e6c81f46', 'customer'),
+('65a8ed73-b6f3-4543-82a6-7ab9ef6e9c7b', 'customer'),
+('5eb05a37-cee1-46dd-bafc-c9d32ef95d59', 'admin');
@@ -9,10 +9,10 @@ require (
 	github.com/ThreeDotsLabs/watermill v1.3.5
 	github.com/ThreeDotsLabs/watermill-kafka/v3 v3.0.0
 	github.com/algorand/go-algorand-sdk/v2 v2.4.0
+	github.com/alicebob/miniredis/v2 v2.31.1
 	github.com/alitto/pond v1.8.3
 	github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2 v2.0.0-rc8
 	github.com/avito-tech/go-transaction-manager/trm/v2 v2.0.0-rc8
-	github.com/chilts/sid v0.0.0-20190607042430-660e94789ec9
 	github.com/go-faster/errors v0.7.1
 	github.com/go-faster/jx v1.1.0
 	github.com/go-faster/sdk v0.15.0
@@ -28,14 +28,18 @@ require (
 	github.com/jackc/pgx/v5 v5.5.5
 	github.com/jessevdk/go-flags v1.5.0
 	github.com/justinas/alice v1.2.0
+	github.com/leanovate/gopter v0.2.11
 	github.com/lib/pq v1.10.2
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/ogen-go/ogen v1.1.0
+	github.com/pquerna/otp v1.4.0
 	github.com/pressly/goose v2.7.0+incompatible
 	github.com/pwnedgod/idemgotent v1.0.0
 	github.com/pwnedgod/wracha v1.0.0
 	github.com/redis/go-redis/v9 v9.5.1
+	github.com/shopspring/decimal v1.3.1
 	github.com/sirupsen/logrus v1.9.3
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/stretchr/testify v1.9.0
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.51.0
 	go.opentelemetry.io/otel v1.26.0
@@ -47,6 +51,13 @@ require (
 	golang.org/x/net v0.24.0
 	golang.org/x/sync v0.7.0
 	gopkg.in/tomb.v2 v2.0.0-20161208151619-d5d1b5820637
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/alicebob/gopher-json v0.0.0-20200520072559-a9ecdc9d1d3a // indirect
+	github.com/boombuler/barcode v1.0.1-0.20190219062509-6c824513bacc // indirect
+	github.com/yuin/gopher-lua v1.1.0 // indirect
 )
 
 require (
@@ -147,6 +158,5 @@ require (
 	google.golang.org/grpc v1.63.2 // indirect
 	google.golang.org/protobuf v1.33.0 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 	olympos.io/encoding/edn v0.0.0-20201019073823-d3554ca0b0a3 // indirect
 )
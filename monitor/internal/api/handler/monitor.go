@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/ShmelJUJ/software-engineering/monitor/internal/generated/models"
@@ -20,6 +21,10 @@ const (
 	getUserMethod   = "getClientByID"
 	getWalletMethod = "getWalletByID"
 	loginMethod     = "login"
+	loginTOTPMethod = "loginTOTP"
+	refreshMethod   = "refreshToken"
+	getJWKSMethod   = "getJWKS"
+	unlockMethod    = "unlockLogin"
 )
 
 // MonitorHandler handles incoming requests for monitoring.
@@ -65,7 +70,7 @@ func verify(from, to, method string) bool {
 		return true
 	}
 
-	if from == transactionService && to == userService && method == loginMethod {
+	if from == transactionService && to == userService && (method == loginMethod || method == loginTOTPMethod || method == refreshMethod || method == getJWKSMethod || method == getWalletMethod || method == unlockMethod) {
 		return true
 	}
 
@@ -134,6 +139,12 @@ func (mh *MonitorHandler) processRequest(params apiMonitor.ProcessParams) middle
 
 			switch w := wallet.(type) {
 			case *gen.Wallet:
+				// The transaction service only verifies payer signatures and never gets the private key.
+				if *params.Body.From == transactionService {
+					return apiMonitor.NewProcessOK().
+						WithPayload(&gen.Wallet{PublicKey: w.PublicKey})
+				}
+
 				return apiMonitor.NewProcessOK().
 					WithPayload(w)
 			default:
@@ -147,7 +158,7 @@ func (mh *MonitorHandler) processRequest(params apiMonitor.ProcessParams) middle
 		case loginMethod:
 			dto := &gen.AuthRequest{}
 
-			if err := mapstructure.Decode(params.Body.Payload, dto); err != nil {
+			if err := decodeJSONPayload(params.Body.Payload, dto); err != nil {
 				return apiMonitor.NewProcessBadRequest().
 					WithPayload(&models.ErrorResponse{
 						Code:    int32(apiMonitor.ProcessBadRequestCode),
@@ -171,11 +182,33 @@ func (mh *MonitorHandler) processRequest(params apiMonitor.ProcessParams) middle
 			case *gen.AuthResponse:
 				return apiMonitor.NewProcessOK().
 					WithPayload(t)
-			case *gen.GetAuthTokenBadRequest, *gen.GetAuthTokenForbidden, *gen.GetAuthTokenInternalServerError:
+			case *gen.ChallengeResponse:
+				return apiMonitor.NewProcessOK().
+					WithPayload(t)
+			case *gen.GetAuthTokenUnauthorized:
+				return apiMonitor.NewProcessUnauthorized().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+						Message: t.Message,
+					})
+			case *gen.LockedError:
+				return apiMonitor.NewProcessLocked().
+					WithRetryAfter(t.RetryAfter).
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessLockedCode),
+						Message: t.Message,
+					})
+			case *gen.GetAuthTokenBadRequest:
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to get auth token: %s", t.Message),
+					})
+			case *gen.GetAuthTokenInternalServerError:
 				return apiMonitor.NewProcessInternalServerError().
 					WithPayload(&models.ErrorResponse{
 						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
-						Message: fmt.Sprintf("failed to get auth token: %s", t),
+						Message: fmt.Sprintf("failed to get auth token: %s", t.Message),
 					})
 			default:
 				return apiMonitor.NewProcessInternalServerError().
@@ -185,6 +218,194 @@ func (mh *MonitorHandler) processRequest(params apiMonitor.ProcessParams) middle
 					})
 			}
 
+		case loginTOTPMethod:
+			dto := &gen.TOTPChallengeRequest{}
+
+			if err := decodeJSONPayload(params.Body.Payload, dto); err != nil {
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to decode payload to gen.TOTPChallengeRequest: %s", err.Error()),
+					})
+			}
+
+			verifyRes, err := mh.userClient.VerifyTOTPChallenge(ctx, gen.OptTOTPChallengeRequest{
+				Value: *dto,
+				Set:   true,
+			})
+			if err != nil {
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to verify totp challenge: %s", err.Error()),
+					})
+			}
+
+			switch t := verifyRes.(type) {
+			case *gen.AuthResponse:
+				return apiMonitor.NewProcessOK().
+					WithPayload(t)
+			case *gen.VerifyTOTPChallengeUnauthorized:
+				return apiMonitor.NewProcessUnauthorized().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+						Message: t.Message,
+					})
+			case *gen.LockedError:
+				return apiMonitor.NewProcessLocked().
+					WithRetryAfter(t.RetryAfter).
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessLockedCode),
+						Message: t.Message,
+					})
+			case *gen.VerifyTOTPChallengeBadRequest:
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to verify totp challenge: %s", t.Message),
+					})
+			case *gen.VerifyTOTPChallengeInternalServerError:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to verify totp challenge: %s", t.Message),
+					})
+			default:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to cast verify totp challenge response %T to *gen.AuthResponse", verifyRes),
+					})
+			}
+
+		case refreshMethod:
+			dto := &gen.RefreshRequest{}
+
+			if err := decodeJSONPayload(params.Body.Payload, dto); err != nil {
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to decode payload to gen.RefreshRequest: %s", err.Error()),
+					})
+			}
+
+			refreshTokenRes, err := mh.userClient.RefreshAuthToken(ctx, gen.OptRefreshRequest{
+				Value: *dto,
+				Set:   true,
+			})
+			if err != nil {
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to refresh auth token: %s", err.Error()),
+					})
+			}
+
+			switch t := refreshTokenRes.(type) {
+			case *gen.AuthResponse:
+				return apiMonitor.NewProcessOK().
+					WithPayload(t)
+			case *gen.RefreshAuthTokenUnauthorized:
+				return apiMonitor.NewProcessUnauthorized().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+						Message: t.Message,
+					})
+			case *gen.RefreshAuthTokenNotFound:
+				// The token belongs to a deleted user, so it is as invalid as a forged one.
+				return apiMonitor.NewProcessUnauthorized().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+						Message: t.Message,
+					})
+			case *gen.RefreshAuthTokenBadRequest:
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to refresh auth token: %s", t.Message),
+					})
+			case *gen.RefreshAuthTokenInternalServerError:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to refresh auth token: %s", t.Message),
+					})
+			default:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to cast refresh auth token response %T to *gen.AuthResponse", refreshTokenRes),
+					})
+			}
+
+		case unlockMethod:
+			dto := &gen.UnlockRequest{}
+
+			if err := decodeJSONPayload(params.Body.Payload, dto); err != nil {
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to decode payload to gen.UnlockRequest: %s", err.Error()),
+					})
+			}
+
+			unlockRes, err := mh.userClient.UnlockLogin(ctx, gen.OptUnlockRequest{
+				Value: *dto,
+				Set:   true,
+			})
+			if err != nil {
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to unlock login: %s", err.Error()),
+					})
+			}
+
+			switch t := unlockRes.(type) {
+			case *gen.UnlockLoginNoContent:
+				return apiMonitor.NewProcessOK()
+			case *gen.UnlockLoginBadRequest:
+				return apiMonitor.NewProcessBadRequest().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessBadRequestCode),
+						Message: fmt.Sprintf("failed to unlock login: %s", t.Message),
+					})
+			case *gen.UnlockLoginInternalServerError:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to unlock login: %s", t.Message),
+					})
+			default:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: "failed to cast method info type to *get.UnlockLoginNoContent",
+					})
+			}
+
+		case getJWKSMethod:
+			jwksRes, err := mh.userClient.GetJWKS(ctx)
+			if err != nil {
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: fmt.Sprintf("failed to get jwks: %s", err.Error()),
+					})
+			}
+
+			switch j := jwksRes.(type) {
+			case *gen.JWKS:
+				return apiMonitor.NewProcessOK().
+					WithPayload(j)
+			default:
+				return apiMonitor.NewProcessInternalServerError().
+					WithPayload(&models.ErrorResponse{
+						Code:    int32(apiMonitor.ProcessInternalServerErrorCode),
+						Message: "failed to cast method info type to *get.JWKS",
+					})
+			}
+
 		default:
 			return apiMonitor.NewProcessBadRequest().WithPayload(
 				&models.ErrorResponse{
@@ -202,3 +423,14 @@ func (mh *MonitorHandler) processRequest(params apiMonitor.ProcessParams) middle
 		)
 	}
 }
+
+// decodeJSONPayload decodes payload into a generated user service type,
+// which relies on its json field names and optional value wrappers.
+func decodeJSONPayload(payload interface{}, dto json.Unmarshaler) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return dto.UnmarshalJSON(raw)
+}
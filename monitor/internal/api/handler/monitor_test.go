@@ -204,6 +204,60 @@ func TestVerify(t *testing.T) {
 			},
 			expectedVal: true,
 		},
+		{
+			name: "Successful verify from transaction to user service with refreshToken method",
+			args: args{
+				from:   transactionService,
+				to:     userService,
+				method: refreshMethod,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to user service with getWallet method",
+			args: args{
+				from:   transactionService,
+				to:     userService,
+				method: getWalletMethod,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to user service with unlockLogin method",
+			args: args{
+				from:   transactionService,
+				to:     userService,
+				method: unlockMethod,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to user service with getJWKS method",
+			args: args{
+				from:   transactionService,
+				to:     userService,
+				method: getJWKSMethod,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to user service with loginTOTP method",
+			args: args{
+				from:   transactionService,
+				to:     userService,
+				method: loginTOTPMethod,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "failed to verify from payment gateway to user service with getJWKS method",
+			args: args{
+				from:   paymentGatewayService,
+				to:     userService,
+				method: getJWKSMethod,
+			},
+			expectedVal: false,
+		},
 		{
 			name: "failed to verify from unknownService to user service with getClient method",
 			args: args{
@@ -263,6 +317,28 @@ func TestProcessRequest(t *testing.T) {
 		testGetWalletMethod = getWalletMethod
 		testWalletPayload   = gen.GetWalletByIdParams{}
 		testWalletRes       = &gen.Wallet{}
+
+		testTransactionService = transactionService
+
+		testRefreshMethod  = refreshMethod
+		testRefreshPayload = map[string]interface{}{"refresh_token": "test-token"}
+		testRefreshRequest = gen.RefreshRequest{RefreshToken: "test-token"}
+		testRefreshRes     = &gen.AuthResponse{AuthToken: "test-auth-token", RefreshToken: "test-refresh-token"}
+
+		testLoginMethod  = loginMethod
+		testLoginPayload = map[string]interface{}{"email": "test@example.com", "password": "test-password", "client_ip": "10.0.0.1"}
+		testLoginRequest = gen.AuthRequest{Email: "test@example.com", Password: "test-password", ClientIP: gen.NewOptString("10.0.0.1")}
+
+		testChallengeRes = &gen.ChallengeResponse{ChallengeToken: "test-challenge-token", ChallengeExpiresIn: 300}
+
+		testLoginTOTPMethod  = loginTOTPMethod
+		testLoginTOTPPayload = map[string]interface{}{"challenge_token": "test-challenge-token", "code": "123456"}
+		testLoginTOTPRequest = gen.TOTPChallengeRequest{ChallengeToken: "test-challenge-token", Code: "123456"}
+
+		testUnlockMethod = unlockMethod
+
+		testGetJWKSMethod = getJWKSMethod
+		testJWKSRes       = &gen.JWKS{Keys: []gen.JWK{{Kid: "test-kid"}}}
 	)
 
 	testcases := []struct {
@@ -307,6 +383,245 @@ func TestProcessRequest(t *testing.T) {
 			expectedResponse: apiMonitor.NewProcessOK().
 				WithPayload(testWalletRes),
 		},
+		{
+			name: "Successfully process request getWalletByID from transaction service without private key",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testGetWalletMethod,
+						Payload: testWalletPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().GetWalletById(ctx, testWalletPayload).Return(&gen.Wallet{
+					PublicKey:  "test-public-key",
+					PrivateKey: "test-private-key",
+				}, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK().
+				WithPayload(&gen.Wallet{PublicKey: "test-public-key"}),
+		},
+		{
+			name: "Successfully process request unlockLogin",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testUnlockMethod,
+						Payload: map[string]interface{}{"email": "test@example.com", "client_ip": "10.0.0.1"},
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().UnlockLogin(ctx, gen.OptUnlockRequest{
+					Value: gen.UnlockRequest{Email: "test@example.com", ClientIP: gen.NewOptString("10.0.0.1")},
+					Set:   true,
+				}).Return(&gen.UnlockLoginNoContent{}, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK(),
+		},
+		{
+			name: "Successfully process request refreshToken",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testRefreshMethod,
+						Payload: testRefreshPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().RefreshAuthToken(ctx, gen.OptRefreshRequest{
+					Value: testRefreshRequest,
+					Set:   true,
+				}).Return(testRefreshRes, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK().
+				WithPayload(testRefreshRes),
+		},
+		{
+			name: "Failed to process request refreshToken with invalid token",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testRefreshMethod,
+						Payload: testRefreshPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().RefreshAuthToken(ctx, gen.OptRefreshRequest{
+					Value: testRefreshRequest,
+					Set:   true,
+				}).Return(&gen.RefreshAuthTokenUnauthorized{Message: "test-message"}, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessUnauthorized().
+				WithPayload(&models.ErrorResponse{
+					Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+					Message: "test-message",
+				}),
+		},
+		{
+			name: "Successfully process request login",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testLoginMethod,
+						Payload: testLoginPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().GetAuthToken(ctx, gen.OptAuthRequest{
+					Value: testLoginRequest,
+					Set:   true,
+				}).Return(testRefreshRes, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK().
+				WithPayload(testRefreshRes),
+		},
+		{
+			name: "Successfully process request login with second factor required",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testLoginMethod,
+						Payload: testLoginPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().GetAuthToken(ctx, gen.OptAuthRequest{
+					Value: testLoginRequest,
+					Set:   true,
+				}).Return(testChallengeRes, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK().
+				WithPayload(testChallengeRes),
+		},
+		{
+			name: "Successfully process request loginTOTP",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testLoginTOTPMethod,
+						Payload: testLoginTOTPPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().VerifyTOTPChallenge(ctx, gen.OptTOTPChallengeRequest{
+					Value: testLoginTOTPRequest,
+					Set:   true,
+				}).Return(testRefreshRes, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK().
+				WithPayload(testRefreshRes),
+		},
+		{
+			name: "Failed to process request loginTOTP with wrong code",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testLoginTOTPMethod,
+						Payload: testLoginTOTPPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().VerifyTOTPChallenge(ctx, gen.OptTOTPChallengeRequest{
+					Value: testLoginTOTPRequest,
+					Set:   true,
+				}).Return(&gen.VerifyTOTPChallengeUnauthorized{Message: "test-message"}, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessUnauthorized().
+				WithPayload(&models.ErrorResponse{
+					Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+					Message: "test-message",
+				}),
+		},
+		{
+			name: "Failed to process request login with wrong password",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testLoginMethod,
+						Payload: testLoginPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().GetAuthToken(ctx, gen.OptAuthRequest{
+					Value: testLoginRequest,
+					Set:   true,
+				}).Return(&gen.GetAuthTokenUnauthorized{Message: "test-message"}, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessUnauthorized().
+				WithPayload(&models.ErrorResponse{
+					Code:    int32(apiMonitor.ProcessUnauthorizedCode),
+					Message: "test-message",
+				}),
+		},
+		{
+			name: "Failed to process request login with locked account",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:    &testTransactionService,
+						To:      &testUserService,
+						Method:  &testLoginMethod,
+						Payload: testLoginPayload,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().GetAuthToken(ctx, gen.OptAuthRequest{
+					Value: testLoginRequest,
+					Set:   true,
+				}).Return(&gen.LockedError{Message: "test-message", RetryAfter: 60}, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessLocked().
+				WithRetryAfter(60).
+				WithPayload(&models.ErrorResponse{
+					Code:    int32(apiMonitor.ProcessLockedCode),
+					Message: "test-message",
+				}),
+		},
+		{
+			name: "Successfully process request getJWKS",
+			args: args{
+				params: apiMonitor.ProcessParams{
+					Body: &models.ProcessRequest{
+						From:   &testTransactionService,
+						To:     &testUserService,
+						Method: &testGetJWKSMethod,
+					},
+				},
+			},
+			mock: func(mh *mock_user_client.MockHandler) {
+				mh.EXPECT().GetJWKS(ctx).Return(testJWKSRes, nil).Times(1)
+			},
+			expectedResponse: apiMonitor.NewProcessOK().
+				WithPayload(testJWKSRes),
+		},
 		{
 			name: "Failed to process request with unknown destination service",
 			args: args{
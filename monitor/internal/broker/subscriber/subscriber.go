@@ -16,9 +16,16 @@ const (
 	fromTransaction    = "transaction"
 	fromPaymentGateway = "payment_gateway"
 
-	toProcessedTransactionTopic = "transaction.processed"
-	toSucceededTransactionTopic = "transaction.succeeded"
-	toFailedTransactionTopic    = "transaction.failed"
+	toProcessedTransactionTopic       = "transaction.processed"
+	toCancelledTransactionTopic       = "transaction.cancelled"
+	toStatusRequestedTransactionTopic = "transaction.status_requested"
+	toSucceededTransactionTopic       = "transaction.succeeded"
+	toFailedTransactionTopic          = "transaction.failed"
+	toStatusReportedTransactionTopic  = "transaction.status_reported"
+	toCaptureRequestedTopic           = "transaction.capture_requested"
+	toVoidRequestedTopic              = "transaction.void_requested"
+	toAuthorizedTransactionTopic      = "transaction.authorized"
+	toVoidedTransactionTopic          = "transaction.voided"
 )
 
 // MonitorSubscriber represents a subscriber for monitoring.
@@ -105,10 +112,19 @@ func (s *MonitorSubscriber) handleProcess(msg *message.Message) error {
 }
 
 func verify(from, toTopic string) bool {
-	if from == fromTransaction && toTopic == toProcessedTransactionTopic {
-		return true
-	} else if from == fromPaymentGateway && (toTopic == toFailedTransactionTopic || toTopic == toSucceededTransactionTopic) {
-		return true
+	switch from {
+	case fromTransaction:
+		switch toTopic {
+		case toProcessedTransactionTopic, toCancelledTransactionTopic, toStatusRequestedTransactionTopic,
+			toCaptureRequestedTopic, toVoidRequestedTopic:
+			return true
+		}
+	case fromPaymentGateway:
+		switch toTopic {
+		case toFailedTransactionTopic, toSucceededTransactionTopic, toStatusReportedTransactionTopic,
+			toAuthorizedTransactionTopic, toVoidedTransactionTopic:
+			return true
+		}
 	}
 
 	return false
@@ -222,6 +222,78 @@ func TestVerify(t *testing.T) {
 			},
 			expectedVal: true,
 		},
+		{
+			name: "Successful verify from transaction to transaction.cancelled topic",
+			args: args{
+				from:    fromTransaction,
+				toTopic: toCancelledTransactionTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to transaction.status_requested topic",
+			args: args{
+				from:    fromTransaction,
+				toTopic: toStatusRequestedTransactionTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from payment_gateway to transaction.status_reported topic",
+			args: args{
+				from:    fromPaymentGateway,
+				toTopic: toStatusReportedTransactionTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to transaction.capture_requested topic",
+			args: args{
+				from:    fromTransaction,
+				toTopic: toCaptureRequestedTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from transaction to transaction.void_requested topic",
+			args: args{
+				from:    fromTransaction,
+				toTopic: toVoidRequestedTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from payment_gateway to transaction.authorized topic",
+			args: args{
+				from:    fromPaymentGateway,
+				toTopic: toAuthorizedTransactionTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "Successful verify from payment_gateway to transaction.voided topic",
+			args: args{
+				from:    fromPaymentGateway,
+				toTopic: toVoidedTransactionTopic,
+			},
+			expectedVal: true,
+		},
+		{
+			name: "failed to verify from payment_gateway to transaction.capture_requested topic",
+			args: args{
+				from:    fromPaymentGateway,
+				toTopic: toCaptureRequestedTopic,
+			},
+			expectedVal: false,
+		},
+		{
+			name: "failed to verify from transaction to transaction.status_reported topic",
+			args: args{
+				from:    fromTransaction,
+				toTopic: toStatusReportedTransactionTopic,
+			},
+			expectedVal: false,
+		},
 		{
 			name: "failed to verify from someService to transaction.failed topic",
 			args: args{
@@ -48,7 +48,7 @@ func init() {
         "operationId": "process",
         "parameters": [
           {
-            "description": "Information required to accpet a transaction.",
+            "description": "Information required to process a request.",
             "name": "body",
             "in": "body",
             "required": true,
@@ -70,12 +70,31 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "401": {
+            "description": "Unauthorized error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "403": {
             "description": "Forbidden error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "Locked error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "format": "int64",
+                "description": "Seconds until the lock ends."
+              }
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -163,7 +182,7 @@ func init() {
         "operationId": "process",
         "parameters": [
           {
-            "description": "Information required to accpet a transaction.",
+            "description": "Information required to process a request.",
             "name": "body",
             "in": "body",
             "required": true,
@@ -185,12 +204,31 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "401": {
+            "description": "Unauthorized error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "403": {
             "description": "Forbidden error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "423": {
+            "description": "Locked error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "format": "int64",
+                "description": "Seconds until the lock ends."
+              }
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -34,7 +34,7 @@ type ProcessParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*Information required to accpet a transaction.
+	/*Information required to process a request.
 	  Required: true
 	  In: body
 	*/
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/ShmelJUJ/software-engineering/monitor/internal/generated/models"
 )
@@ -101,6 +102,51 @@ func (o *ProcessBadRequest) WriteResponse(rw http.ResponseWriter, producer runti
 	}
 }
 
+// ProcessUnauthorizedCode is the HTTP code returned for type ProcessUnauthorized
+const ProcessUnauthorizedCode int = 401
+
+/*
+ProcessUnauthorized Unauthorized error.
+
+swagger:response processUnauthorized
+*/
+type ProcessUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewProcessUnauthorized creates ProcessUnauthorized with default headers values
+func NewProcessUnauthorized() *ProcessUnauthorized {
+
+	return &ProcessUnauthorized{}
+}
+
+// WithPayload adds the payload to the process unauthorized response
+func (o *ProcessUnauthorized) WithPayload(payload *models.ErrorResponse) *ProcessUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the process unauthorized response
+func (o *ProcessUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ProcessUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ProcessForbiddenCode is the HTTP code returned for type ProcessForbidden
 const ProcessForbiddenCode int = 403
 
@@ -146,6 +192,73 @@ func (o *ProcessForbidden) WriteResponse(rw http.ResponseWriter, producer runtim
 	}
 }
 
+// ProcessLockedCode is the HTTP code returned for type ProcessLocked
+const ProcessLockedCode int = 423
+
+/*
+ProcessLocked Locked error.
+
+swagger:response processLocked
+*/
+type ProcessLocked struct {
+	/*Seconds until the lock ends.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewProcessLocked creates ProcessLocked with default headers values
+func NewProcessLocked() *ProcessLocked {
+
+	return &ProcessLocked{}
+}
+
+// WithRetryAfter adds the retryAfter to the process locked response
+func (o *ProcessLocked) WithRetryAfter(retryAfter int64) *ProcessLocked {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the process locked response
+func (o *ProcessLocked) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the process locked response
+func (o *ProcessLocked) WithPayload(payload *models.ErrorResponse) *ProcessLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the process locked response
+func (o *ProcessLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ProcessLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ProcessInternalServerErrorCode is the HTTP code returned for type ProcessInternalServerError
 const ProcessInternalServerErrorCode int = 500
 
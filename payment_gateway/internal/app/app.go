@@ -112,6 +112,9 @@ func Run(cfg *config.Config) {
 
 	sub.RegisterCancelledTransactionHandler()
 	sub.RegisterProcessedTransactionHandler()
+	sub.RegisterStatusRequestedTransactionHandler()
+	sub.RegisterCaptureRequestedTransactionHandler()
+	sub.RegisterVoidRequestedTransactionHandler()
 
 	go func() {
 		if err := sub.Run(ctx); err != nil {
@@ -11,26 +11,35 @@ import (
 var ErrNilConfig = errors.New("cannot override nil config")
 
 const (
-	defaultPaymentProccessingTime    = 30 * time.Second
-	defaultSucceededTransactionTopic = "transaction.succeeded"
-	defaultFailedTransactionTopic    = "transaction.failed"
-	defaultMonitorProcessTopic       = "monitor.process"
+	defaultPaymentProccessingTime     = 30 * time.Second
+	defaultSucceededTransactionTopic  = "transaction.succeeded"
+	defaultFailedTransactionTopic     = "transaction.failed"
+	defaultMonitorProcessTopic        = "monitor.process"
+	defaultStatusReportedTopic        = "transaction.status_reported"
+	defaultAuthorizedTransactionTopic = "transaction.authorized"
+	defaultVoidedTransactionTopic     = "transaction.voided"
 )
 
 // Config represents publisher configuration parameters.
 type Config struct {
-	MonitorProcessTopic       string        `yaml:"monitor_process_topic"`
-	PaymentProccessingTime    time.Duration `yaml:"payment_processing_time"`
-	SucceededTransactionTopic string        `yaml:"succeeded_transaction_topic"`
-	FailedTransactionTopic    string        `yaml:"failed_transaction_topic"`
+	MonitorProcessTopic        string        `yaml:"monitor_process_topic"`
+	PaymentProccessingTime     time.Duration `yaml:"payment_processing_time"`
+	SucceededTransactionTopic  string        `yaml:"succeeded_transaction_topic"`
+	FailedTransactionTopic     string        `yaml:"failed_transaction_topic"`
+	StatusReportedTopic        string        `yaml:"status_reported_topic"`
+	AuthorizedTransactionTopic string        `yaml:"authorized_transaction_topic"`
+	VoidedTransactionTopic     string        `yaml:"voided_transaction_topic"`
 }
 
 func getDefaultConfig() *Config {
 	return &Config{
-		MonitorProcessTopic:       defaultMonitorProcessTopic,
-		PaymentProccessingTime:    defaultPaymentProccessingTime,
-		SucceededTransactionTopic: defaultSucceededTransactionTopic,
-		FailedTransactionTopic:    defaultFailedTransactionTopic,
+		MonitorProcessTopic:        defaultMonitorProcessTopic,
+		PaymentProccessingTime:     defaultPaymentProccessingTime,
+		SucceededTransactionTopic:  defaultSucceededTransactionTopic,
+		FailedTransactionTopic:     defaultFailedTransactionTopic,
+		StatusReportedTopic:        defaultStatusReportedTopic,
+		AuthorizedTransactionTopic: defaultAuthorizedTransactionTopic,
+		VoidedTransactionTopic:     defaultVoidedTransactionTopic,
 	}
 }
 
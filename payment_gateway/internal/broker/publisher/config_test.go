@@ -23,20 +23,26 @@ func TestMergeWithDefault(t *testing.T) {
 				FailedTransactionTopic: "transaction.failed2",
 			},
 			expectedCfg: &Config{
-				MonitorProcessTopic:       defaultMonitorProcessTopic,
-				PaymentProccessingTime:    time.Minute,
-				SucceededTransactionTopic: defaultSucceededTransactionTopic,
-				FailedTransactionTopic:    "transaction.failed2",
+				MonitorProcessTopic:        defaultMonitorProcessTopic,
+				PaymentProccessingTime:     time.Minute,
+				SucceededTransactionTopic:  defaultSucceededTransactionTopic,
+				FailedTransactionTopic:     "transaction.failed2",
+				StatusReportedTopic:        defaultStatusReportedTopic,
+				AuthorizedTransactionTopic: defaultAuthorizedTransactionTopic,
+				VoidedTransactionTopic:     defaultVoidedTransactionTopic,
 			},
 		},
 		{
 			name: "With empty config",
 			cfg:  &Config{},
 			expectedCfg: &Config{
-				MonitorProcessTopic:       defaultMonitorProcessTopic,
-				PaymentProccessingTime:    defaultPaymentProccessingTime,
-				SucceededTransactionTopic: defaultSucceededTransactionTopic,
-				FailedTransactionTopic:    defaultFailedTransactionTopic,
+				MonitorProcessTopic:        defaultMonitorProcessTopic,
+				PaymentProccessingTime:     defaultPaymentProccessingTime,
+				SucceededTransactionTopic:  defaultSucceededTransactionTopic,
+				FailedTransactionTopic:     defaultFailedTransactionTopic,
+				StatusReportedTopic:        defaultStatusReportedTopic,
+				AuthorizedTransactionTopic: defaultAuthorizedTransactionTopic,
+				VoidedTransactionTopic:     defaultVoidedTransactionTopic,
 			},
 			expectedErr: nil,
 		},
@@ -3,8 +3,10 @@ package dto
 import "encoding/json"
 
 // SucceededTransaction represents a successful transaction with a transaction ID.
+// Reason notes how a watched payment deviated from the transaction value, like an overpayment.
 type SucceededTransaction struct {
 	TransactionID string `json:"transaction_id"`
+	Reason        string `json:"reason,omitempty"`
 }
 
 // Encode converts the SucceededTransaction struct to JSON bytes.
@@ -32,3 +34,65 @@ func (t *FailedTransaction) Encode() ([]byte, error) {
 
 	return data, nil
 }
+
+// AuthorizedTransaction represents a transaction whose value is held until it is captured or voided.
+type AuthorizedTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// Encode converts the AuthorizedTransaction struct to JSON bytes.
+func (t *AuthorizedTransaction) Encode() ([]byte, error) {
+	data, err := json.Marshal(&t)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// VoidedTransaction represents a transaction whose hold was released back to the sender.
+type VoidedTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// Encode converts the VoidedTransaction struct to JSON bytes.
+func (t *VoidedTransaction) Encode() ([]byte, error) {
+	data, err := json.Marshal(&t)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+const (
+	// StatusProcessing is reported while a payment worker is still running for the transaction.
+	StatusProcessing = "processing"
+	// StatusSucceeded is reported when the payment of the transaction succeeded.
+	StatusSucceeded = "succeeded"
+	// StatusAuthorized is reported when the value of the transaction is held until it is captured or voided.
+	StatusAuthorized = "authorized"
+	// StatusVoided is reported when the hold of the transaction was released.
+	StatusVoided = "voided"
+	// StatusFailed is reported when the payment of the transaction failed, the reason says why.
+	StatusFailed = "failed"
+	// StatusUnknown is reported when the payment gateway has no record of the transaction.
+	StatusUnknown = "unknown"
+)
+
+// TransactionStatus represents the live status of a transaction payment as seen by the payment gateway.
+type TransactionStatus struct {
+	TransactionID string `json:"transaction_id"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// Encode converts the TransactionStatus struct to JSON bytes.
+func (t *TransactionStatus) Encode() ([]byte, error) {
+	data, err := json.Marshal(&t)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -85,3 +85,81 @@ func TestFailedTransactionEncode(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorizedTransactionEncode(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		transaction  *dto.AuthorizedTransaction
+		expectedData []byte
+		expectedErr  error
+	}{
+		{
+			name:         "With empty authorized transaction",
+			transaction:  &dto.AuthorizedTransaction{},
+			expectedData: []byte(`{"transaction_id":""}`),
+			expectedErr:  nil,
+		},
+		{
+			name: "With full filled authorized transaction",
+			transaction: &dto.AuthorizedTransaction{
+				TransactionID: "123",
+			},
+			expectedData: []byte(`{"transaction_id":"123"}`),
+			expectedErr:  nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualData, err := testcase.transaction.Encode()
+
+			assert.Equal(t, testcase.expectedData, actualData)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestVoidedTransactionEncode(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		transaction  *dto.VoidedTransaction
+		expectedData []byte
+		expectedErr  error
+	}{
+		{
+			name:         "With empty voided transaction",
+			transaction:  &dto.VoidedTransaction{},
+			expectedData: []byte(`{"transaction_id":""}`),
+			expectedErr:  nil,
+		},
+		{
+			name: "With full filled voided transaction",
+			transaction: &dto.VoidedTransaction{
+				TransactionID: "123",
+			},
+			expectedData: []byte(`{"transaction_id":"123"}`),
+			expectedErr:  nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualData, err := testcase.transaction.Encode()
+
+			assert.Equal(t, testcase.expectedData, actualData)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -55,3 +55,39 @@ func NewProccessPaymentError(msg string, err error) *ProccessPaymentError {
 func (e ProccessPaymentError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
+
+// StatusReporterError represents an error encountered during creation status reporter.
+type StatusReporterError struct {
+	msg string
+	err error
+}
+
+// NewStatusReporterError creates a new instance of StatusReporterError with the given message and error.
+func NewStatusReporterError(msg string, err error) *StatusReporterError {
+	return &StatusReporterError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e StatusReporterError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+// ReportStatusError represents an error encountered during reporting a transaction status.
+type ReportStatusError struct {
+	msg string
+	err error
+}
+
+// NewReportStatusError creates a new instance of ReportStatusError with the given message and error.
+func NewReportStatusError(msg string, err error) *ReportStatusError {
+	return &ReportStatusError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ReportStatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher (interfaces: StatusReporter)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/status_reporter_mocks.go github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher StatusReporter
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	dto "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStatusReporter is a mock of StatusReporter interface.
+type MockStatusReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusReporterMockRecorder
+}
+
+// MockStatusReporterMockRecorder is the mock recorder for MockStatusReporter.
+type MockStatusReporterMockRecorder struct {
+	mock *MockStatusReporter
+}
+
+// NewMockStatusReporter creates a new mock instance.
+func NewMockStatusReporter(ctrl *gomock.Controller) *MockStatusReporter {
+	mock := &MockStatusReporter{ctrl: ctrl}
+	mock.recorder = &MockStatusReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusReporter) EXPECT() *MockStatusReporterMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockStatusReporter) Report(arg0 *dto.TransactionStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockStatusReporterMockRecorder) Report(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockStatusReporter)(nil).Report), arg0)
+}
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	publisher "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher"
+	dto "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -40,6 +41,20 @@ func (m *MockPaymentWorker) EXPECT() *MockPaymentWorkerMockRecorder {
 	return m.recorder
 }
 
+// Outcome mocks base method.
+func (m *MockPaymentWorker) Outcome() *dto.TransactionStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outcome")
+	ret0, _ := ret[0].(*dto.TransactionStatus)
+	return ret0
+}
+
+// Outcome indicates an expected call of Outcome.
+func (mr *MockPaymentWorkerMockRecorder) Outcome() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outcome", reflect.TypeOf((*MockPaymentWorker)(nil).Outcome))
+}
+
 // Start mocks base method.
 func (m *MockPaymentWorker) Start(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -30,6 +30,37 @@ const (
 	Shutdown
 )
 
+// Operation represents the gateway call a PaymentWorker makes for the transaction.
+type Operation int
+
+const (
+	// Pay takes the transaction value right away.
+	Pay Operation = iota
+	// Authorize holds the transaction value until it is captured or voided.
+	Authorize
+	// Capture takes the transaction value out of the hold.
+	Capture
+	// Void releases the hold.
+	Void
+	// Watch waits for the payment the payer makes from their own wallet.
+	Watch
+)
+
+func (op Operation) String() string {
+	switch op {
+	case Authorize:
+		return "authorize"
+	case Capture:
+		return "capture"
+	case Void:
+		return "void"
+	case Watch:
+		return "watch"
+	default:
+		return "create"
+	}
+}
+
 var (
 	errCancelledTransation = errors.New("transaction cancelled")
 	errShutdownWorker      = errors.New("worker shutdown")
@@ -39,15 +70,21 @@ var (
 type PaymentWorker interface {
 	Start(context.Context) error
 	Stop(StopReason) error
+	// Outcome returns the final status of the payment, or nil while it is still being processed.
+	Outcome() *dto.TransactionStatus
 }
 
 type paymentWorker struct {
 	cfg          *Config
 	gateway      gateway.PaymentGateway
+	authorizer   gateway.Authorizer
+	watcher      gateway.Watcher
+	operation    Operation
 	pub          message.Publisher
 	log          logger.Logger
 	tomb         tomb.Tomb
 	cancelled    atomic.Bool
+	outcome      atomic.Pointer[dto.TransactionStatus]
 	cancel, stop chan struct{}
 }
 
@@ -58,20 +95,74 @@ func NewWorker(
 	g gateway.PaymentGateway,
 	pub message.Publisher,
 ) (PaymentWorker, error) {
+	worker, err := newPaymentWorker(cfg, log, g, nil, Pay, pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+// NewHoldWorker creates a new paymentWorker instance making the operation on the hold of the transaction.
+// The Pay operation makes it an ordinary payment worker.
+func NewHoldWorker(
+	cfg *Config,
+	log logger.Logger,
+	authorizer gateway.Authorizer,
+	operation Operation,
+	pub message.Publisher,
+) (PaymentWorker, error) {
+	worker, err := newPaymentWorker(cfg, log, authorizer, authorizer, operation, pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return worker, nil
+}
+
+// NewWatchWorker creates a new paymentWorker instance waiting for the payment the payer makes from their own wallet.
+// It processes the payment until the watch gives up instead of the configured processing time,
+// a cancelled payment fails with the outcome the watcher explains it with.
+func NewWatchWorker(
+	cfg *Config,
+	log logger.Logger,
+	watcher gateway.Watcher,
+	pub message.Publisher,
+) (PaymentWorker, error) {
+	worker, err := newPaymentWorker(cfg, log, watcher, nil, Watch, pub)
+	if err != nil {
+		return nil, err
+	}
+
+	worker.watcher = watcher
+
+	return worker, nil
+}
+
+func newPaymentWorker(
+	cfg *Config,
+	log logger.Logger,
+	g gateway.PaymentGateway,
+	authorizer gateway.Authorizer,
+	operation Operation,
+	pub message.Publisher,
+) (*paymentWorker, error) {
 	cfg, err := mergeWithDefault(cfg)
 	if err != nil {
 		return nil, NewWorkerError("failed to merge with default config", err)
 	}
 
 	return &paymentWorker{
-		cfg:       cfg,
-		log:       log,
-		gateway:   g,
-		pub:       pub,
-		tomb:      tomb.Tomb{},
-		cancelled: atomic.Bool{},
-		cancel:    make(chan struct{}, 1),
-		stop:      make(chan struct{}, 1),
+		cfg:        cfg,
+		log:        log,
+		gateway:    g,
+		authorizer: authorizer,
+		operation:  operation,
+		pub:        pub,
+		tomb:       tomb.Tomb{},
+		cancelled:  atomic.Bool{},
+		cancel:     make(chan struct{}, 1),
+		stop:       make(chan struct{}, 1),
 	}, nil
 }
 
@@ -81,9 +172,26 @@ func (worker *paymentWorker) Start(ctx context.Context) error {
 		"transaction_id": worker.gateway.TransactionID(),
 	})
 
-	paymentID, err := worker.gateway.CreatePayment(ctx)
+	var (
+		paymentID string
+		err       error
+	)
+
+	switch worker.operation {
+	case Authorize:
+		paymentID, err = worker.authorizer.Authorize(ctx)
+	case Capture:
+		paymentID, err = worker.authorizer.Capture(ctx)
+	case Void:
+		paymentID, err = worker.authorizer.Void(ctx)
+	case Watch:
+		paymentID, err = worker.watcher.Watch(ctx)
+	default:
+		paymentID, err = worker.gateway.CreatePayment(ctx)
+	}
+
 	if err != nil {
-		return NewStartError("failed to create payment", err)
+		return NewStartError(fmt.Sprintf("failed to %s payment", worker.operation), err)
 	}
 
 	return worker.proccessPayment(ctx, paymentID)
@@ -98,7 +206,7 @@ func (worker *paymentWorker) proccessPayment(ctx context.Context, paymentID stri
 	ticker := time.NewTicker(worker.gateway.Timeout())
 	defer ticker.Stop()
 
-	paymentProccessingTimeout := time.After(worker.cfg.PaymentProccessingTime)
+	paymentProccessingTimeout := time.After(worker.proccessingTime())
 
 	var retries atomic.Int32
 
@@ -143,7 +251,7 @@ func (worker *paymentWorker) proccessPayment(ctx context.Context, paymentID stri
 					return worker.handleSucceededTransaction()
 
 				case gateway.Cancelled:
-					return worker.handleFailedTransaction("Payment gateway cancelled the transaction")
+					return worker.handleFailedTransaction(worker.cancelledReason())
 				}
 
 				worker.log.Debug("Payment worker waiting for a change in the transaction status", map[string]interface{}{
@@ -160,6 +268,24 @@ func (worker *paymentWorker) proccessPayment(ctx context.Context, paymentID stri
 	}
 }
 
+// proccessingTime returns how long the payment is processed for, a watch lasts until its deadline.
+func (worker *paymentWorker) proccessingTime() time.Duration {
+	if worker.watcher != nil {
+		return time.Until(worker.watcher.Deadline()) + worker.gateway.Timeout()
+	}
+
+	return worker.cfg.PaymentProccessingTime
+}
+
+// cancelledReason returns why the payment gateway cancelled the payment, a watcher tells what went wrong.
+func (worker *paymentWorker) cancelledReason() string {
+	if worker.watcher != nil && worker.watcher.Outcome() != "" {
+		return worker.watcher.Outcome()
+	}
+
+	return "Payment gateway cancelled the transaction"
+}
+
 func (worker *paymentWorker) handleFailedTransaction(reason string) error {
 	worker.log.Debug("Payment worker handle failed transaction", map[string]interface{}{
 		"transaction_id": worker.gateway.TransactionID(),
@@ -171,6 +297,21 @@ func (worker *paymentWorker) handleFailedTransaction(reason string) error {
 		Reason:        reason,
 	}
 
+	worker.outcome.Store(&dto.TransactionStatus{
+		TransactionID: failedTransaction.TransactionID,
+		Status:        dto.StatusFailed,
+		Reason:        reason,
+	})
+
+	// The hold is still in place after a failed void, it is not a failed payment of the transaction.
+	// The transaction service requests the void again.
+	if worker.operation == Void {
+		worker.tomb.Kill(nil)
+		worker.cancelled.Store(true)
+
+		return worker.tomb.Wait()
+	}
+
 	monitorDTO := &dto.Process{
 		From:    paymentGatewayService,
 		ToTopic: worker.cfg.FailedTransactionTopic,
@@ -203,14 +344,41 @@ func (worker *paymentWorker) handleSucceededTransaction() error {
 		"transaction_id": worker.gateway.TransactionID(),
 	})
 
-	succeededTransaction := &dto.SucceededTransaction{
-		TransactionID: worker.gateway.TransactionID(),
+	transactionID := worker.gateway.TransactionID()
+
+	var (
+		toTopic = worker.cfg.SucceededTransactionTopic
+		status  = dto.StatusSucceeded
+		reason  string
+		result  any
+	)
+
+	// A watched payment can succeed with a deviation the transaction service passes on, like an overpayment.
+	if worker.watcher != nil {
+		reason = worker.watcher.Outcome()
 	}
 
+	switch worker.operation {
+	case Authorize:
+		toTopic, status = worker.cfg.AuthorizedTransactionTopic, dto.StatusAuthorized
+		result = &dto.AuthorizedTransaction{TransactionID: transactionID}
+	case Void:
+		toTopic, status = worker.cfg.VoidedTransactionTopic, dto.StatusVoided
+		result = &dto.VoidedTransaction{TransactionID: transactionID}
+	default:
+		result = &dto.SucceededTransaction{TransactionID: transactionID, Reason: reason}
+	}
+
+	worker.outcome.Store(&dto.TransactionStatus{
+		TransactionID: transactionID,
+		Status:        status,
+		Reason:        reason,
+	})
+
 	monitorDTO := &dto.Process{
 		From:    paymentGatewayService,
-		ToTopic: worker.cfg.SucceededTransactionTopic,
-		Payload: succeededTransaction,
+		ToTopic: toTopic,
+		Payload: result,
 	}
 
 	payload, err := monitorDTO.Encode()
@@ -234,6 +402,12 @@ func (worker *paymentWorker) handleSucceededTransaction() error {
 	return worker.tomb.Wait()
 }
 
+// Outcome returns the final status of the payment, or nil while it is still being processed.
+// It is recorded before the result is published so that it survives a lost message.
+func (worker *paymentWorker) Outcome() *dto.TransactionStatus {
+	return worker.outcome.Load()
+}
+
 // Stop stops the payment processing based on the specified reason.
 func (worker *paymentWorker) Stop(reason StopReason) error {
 	worker.log.Debug("Stop payment worker", map[string]interface{}{
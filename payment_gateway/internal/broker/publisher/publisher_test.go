@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
 	gateway_mocks "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway/mocks"
 	kafka_mocks "github.com/ShmelJUJ/software-engineering/pkg/kafka/mocks"
@@ -183,3 +184,238 @@ func TestStartWorker(t *testing.T) {
 		})
 	}
 }
+
+func TestStartHoldWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testcases := []struct {
+		name        string
+		operation   Operation
+		mock        func(*logger_mocks.MockLogger, *gateway_mocks.MockAuthorizer, *kafka_mocks.MockPublisher)
+		expectedErr error
+	}{
+		{
+			name:      "Authorize payment error",
+			operation: Authorize,
+			mock: func(ml *logger_mocks.MockLogger, ma *gateway_mocks.MockAuthorizer, _ *kafka_mocks.MockPublisher) {
+				ma.EXPECT().TransactionID().Return(transactionID).Times(1)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				ma.EXPECT().Authorize(ctx).Return("", &gateway.AuthorizeError{})
+			},
+			expectedErr: &StartError{
+				msg: "failed to authorize payment",
+				err: &gateway.AuthorizeError{},
+			},
+		},
+		{
+			name:      "Authorized transaction",
+			operation: Authorize,
+			mock: func(ml *logger_mocks.MockLogger, ma *gateway_mocks.MockAuthorizer, mp *kafka_mocks.MockPublisher) {
+				ma.EXPECT().TransactionID().Return(transactionID).Times(4)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				ma.EXPECT().Authorize(ctx).Return(paymentID, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker start payment processing", map[string]interface{}{
+					"transaction_id": transactionID,
+					"payment_id":     paymentID,
+				}).Times(1)
+				ma.EXPECT().Timeout().Return(timeout).Times(1)
+				ma.EXPECT().Retries().Return(retries).Times(1)
+				ma.EXPECT().CheckStatus(ctx, paymentID).Return(gateway.Succeeded, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker handle succeeded transaction", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				mp.EXPECT().Publish(monitorTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "Captured transaction",
+			operation: Capture,
+			mock: func(ml *logger_mocks.MockLogger, ma *gateway_mocks.MockAuthorizer, mp *kafka_mocks.MockPublisher) {
+				ma.EXPECT().TransactionID().Return(transactionID).Times(4)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				ma.EXPECT().Capture(ctx).Return(paymentID, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker start payment processing", map[string]interface{}{
+					"transaction_id": transactionID,
+					"payment_id":     paymentID,
+				}).Times(1)
+				ma.EXPECT().Timeout().Return(timeout).Times(1)
+				ma.EXPECT().Retries().Return(retries).Times(1)
+				ma.EXPECT().CheckStatus(ctx, paymentID).Return(gateway.Succeeded, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker handle succeeded transaction", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				mp.EXPECT().Publish(monitorTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:      "Void cancelled by payment gateway is not published",
+			operation: Void,
+			mock: func(ml *logger_mocks.MockLogger, ma *gateway_mocks.MockAuthorizer, _ *kafka_mocks.MockPublisher) {
+				ma.EXPECT().TransactionID().Return(transactionID).Times(4)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				ma.EXPECT().Void(ctx).Return(paymentID, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker start payment processing", map[string]interface{}{
+					"transaction_id": transactionID,
+					"payment_id":     paymentID,
+				}).Times(1)
+				ma.EXPECT().Timeout().Return(timeout).Times(1)
+				ma.EXPECT().Retries().Return(retries).Times(1)
+				ma.EXPECT().CheckStatus(ctx, paymentID).Return(gateway.Cancelled, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker handle failed transaction", map[string]interface{}{
+					"transaction_id": transactionID,
+					"reason":         "Payment gateway cancelled the transaction",
+				}).Times(1)
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockLog := logger_mocks.NewMockLogger(mockCtrl)
+			mockAuthorizer := gateway_mocks.NewMockAuthorizer(mockCtrl)
+			mockPublisher := kafka_mocks.NewMockPublisher(mockCtrl)
+			testcase.mock(mockLog, mockAuthorizer, mockPublisher)
+
+			worker, err := NewHoldWorker(&Config{PaymentProccessingTime: time.Minute}, mockLog, mockAuthorizer, testcase.operation, mockPublisher)
+			assert.NoError(t, err)
+
+			err = worker.Start(ctx)
+			assert.Equal(t, testcase.expectedErr, err)
+
+			if testcase.expectedErr == nil {
+				assert.NotNil(t, worker.Outcome())
+			}
+		})
+	}
+}
+
+func TestStartWatchWorker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	overpaid := "overpaid by 0.500000 ALGO, the receiver has to refund the excess"
+	late := "paid late: 1.000000 ALGO of 1.000000 ALGO arrived after the deadline, the receiver has to refund it"
+
+	testcases := []struct {
+		name            string
+		mock            func(*logger_mocks.MockLogger, *gateway_mocks.MockWatcher, *kafka_mocks.MockPublisher)
+		expectedOutcome *dto.TransactionStatus
+		expectedErr     error
+	}{
+		{
+			name: "Watch payment error",
+			mock: func(ml *logger_mocks.MockLogger, mw *gateway_mocks.MockWatcher, _ *kafka_mocks.MockPublisher) {
+				mw.EXPECT().TransactionID().Return(transactionID).Times(1)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mw.EXPECT().Watch(ctx).Return("", &gateway.WatchError{})
+			},
+			expectedErr: &StartError{
+				msg: "failed to watch payment",
+				err: &gateway.WatchError{},
+			},
+		},
+		{
+			name: "Overpaid transaction succeeds with the outcome",
+			mock: func(ml *logger_mocks.MockLogger, mw *gateway_mocks.MockWatcher, mp *kafka_mocks.MockPublisher) {
+				mw.EXPECT().TransactionID().Return(transactionID).Times(4)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				mw.EXPECT().Watch(ctx).Return(paymentID, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker start payment processing", map[string]interface{}{
+					"transaction_id": transactionID,
+					"payment_id":     paymentID,
+				}).Times(1)
+				mw.EXPECT().Timeout().Return(timeout).Times(2)
+				mw.EXPECT().Deadline().Return(time.Now().Add(time.Minute)).Times(1)
+				mw.EXPECT().Retries().Return(retries).Times(1)
+				mw.EXPECT().CheckStatus(ctx, paymentID).Return(gateway.Succeeded, nil).Times(1)
+				mw.EXPECT().Outcome().Return(overpaid).AnyTimes()
+				ml.EXPECT().Debug("Payment worker handle succeeded transaction", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				mp.EXPECT().Publish(monitorTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedOutcome: &dto.TransactionStatus{
+				TransactionID: transactionID,
+				Status:        dto.StatusSucceeded,
+				Reason:        overpaid,
+			},
+		},
+		{
+			name: "Late payment fails with the outcome",
+			mock: func(ml *logger_mocks.MockLogger, mw *gateway_mocks.MockWatcher, mp *kafka_mocks.MockPublisher) {
+				mw.EXPECT().TransactionID().Return(transactionID).Times(4)
+				ml.EXPECT().Debug("Start payment worker", map[string]interface{}{
+					"transaction_id": transactionID,
+				}).Times(1)
+				mw.EXPECT().Watch(ctx).Return(paymentID, nil).Times(1)
+				ml.EXPECT().Debug("Payment worker start payment processing", map[string]interface{}{
+					"transaction_id": transactionID,
+					"payment_id":     paymentID,
+				}).Times(1)
+				mw.EXPECT().Timeout().Return(timeout).Times(2)
+				mw.EXPECT().Deadline().Return(time.Now().Add(time.Minute)).Times(1)
+				mw.EXPECT().Retries().Return(retries).Times(1)
+				mw.EXPECT().CheckStatus(ctx, paymentID).Return(gateway.Cancelled, nil).Times(1)
+				mw.EXPECT().Outcome().Return(late).AnyTimes()
+				ml.EXPECT().Debug("Payment worker handle failed transaction", map[string]interface{}{
+					"transaction_id": transactionID,
+					"reason":         late,
+				}).Times(1)
+				mp.EXPECT().Publish(monitorTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedOutcome: &dto.TransactionStatus{
+				TransactionID: transactionID,
+				Status:        dto.StatusFailed,
+				Reason:        late,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockLog := logger_mocks.NewMockLogger(mockCtrl)
+			mockWatcher := gateway_mocks.NewMockWatcher(mockCtrl)
+			mockPublisher := kafka_mocks.NewMockPublisher(mockCtrl)
+			testcase.mock(mockLog, mockWatcher, mockPublisher)
+
+			worker, err := NewWatchWorker(&Config{}, mockLog, mockWatcher, mockPublisher)
+			assert.NoError(t, err)
+
+			err = worker.Start(ctx)
+			assert.Equal(t, testcase.expectedErr, err)
+			assert.Equal(t, testcase.expectedOutcome, worker.Outcome())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package publisher
+
+import (
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+)
+
+//go:generate mockgen -package mocks -destination mocks/status_reporter_mocks.go github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher StatusReporter
+
+// StatusReporter answers status requests of the transaction service.
+type StatusReporter interface {
+	Report(status *dto.TransactionStatus) error
+}
+
+type statusReporter struct {
+	cfg *Config
+	log logger.Logger
+	pub message.Publisher
+}
+
+// NewStatusReporter creates a new StatusReporter instance.
+func NewStatusReporter(
+	cfg *Config,
+	log logger.Logger,
+	pub message.Publisher,
+) (StatusReporter, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, NewStatusReporterError("failed to merge with default config", err)
+	}
+
+	return &statusReporter{
+		cfg: cfg,
+		log: log,
+		pub: pub,
+	}, nil
+}
+
+// Report publishes the live status of a transaction payment through the monitor.
+func (r *statusReporter) Report(status *dto.TransactionStatus) error {
+	r.log.Debug("Report transaction status", map[string]interface{}{
+		"transaction_id": status.TransactionID,
+		"status":         status.Status,
+	})
+
+	monitorDTO := &dto.Process{
+		From:    paymentGatewayService,
+		ToTopic: r.cfg.StatusReportedTopic,
+		Payload: status,
+	}
+
+	payload, err := monitorDTO.Encode()
+	if err != nil {
+		return NewReportStatusError("failed to encode monitor dto", err)
+	}
+
+	if err := r.pub.Publish(
+		r.cfg.MonitorProcessTopic,
+		message.NewMessage(
+			watermill.NewUUID(),
+			payload,
+		),
+	); err != nil {
+		return NewReportStatusError("failed to publish message", err)
+	}
+
+	return nil
+}
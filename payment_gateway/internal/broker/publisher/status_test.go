@@ -0,0 +1,77 @@
+package publisher
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
+	kafka_mocks "github.com/ShmelJUJ/software-engineering/pkg/kafka/mocks"
+	logger_mocks "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportStatus(t *testing.T) {
+	t.Parallel()
+
+	status := &dto.TransactionStatus{
+		TransactionID: transactionID,
+		Status:        dto.StatusFailed,
+		Reason:        "test-reason",
+	}
+
+	someErr := errors.New("test-err")
+
+	testcases := []struct {
+		name        string
+		publishErr  error
+		expectedErr error
+	}{
+		{
+			name: "Successfully report status",
+		},
+		{
+			name:       "Failed to publish status",
+			publishErr: someErr,
+			expectedErr: &ReportStatusError{
+				msg: "failed to publish message",
+				err: someErr,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			log := logger_mocks.NewMockLogger(mockCtrl)
+			log.EXPECT().Debug("Report transaction status", map[string]interface{}{
+				"transaction_id": transactionID,
+				"status":         dto.StatusFailed,
+			})
+
+			p := kafka_mocks.NewMockPublisher(mockCtrl)
+			p.EXPECT().Publish(monitorTopic, gomock.Any()).
+				DoAndReturn(func(_ string, messages ...*message.Message) error {
+					assert.JSONEq(t,
+						`{"from":"payment_gateway","to_topic":"transaction.status_reported","payload":{"transaction_id":"test-transaction-id","status":"failed","reason":"test-reason"}}`,
+						string(messages[0].Payload),
+					)
+
+					return testcase.publishErr
+				})
+
+			reporter, err := NewStatusReporter(&Config{
+				MonitorProcessTopic: monitorTopic,
+			}, log, p)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testcase.expectedErr, reporter.Report(status))
+		})
+	}
+}
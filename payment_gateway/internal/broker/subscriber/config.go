@@ -16,8 +16,12 @@ const (
 	defaultNumWorkers    = 100
 	defaultTasksCapacity = 1000
 
-	defaultProcessedTransactionTopic = "transaction.processed"
-	defaultCancelledTransactionTopic = "transaction.cancelled"
+	defaultProcessedTransactionTopic        = "transaction.processed"
+	defaultCancelledTransactionTopic        = "transaction.cancelled"
+	defaultStatusRequestedTransactionTopic  = "transaction.status_requested"
+	defaultCaptureRequestedTransactionTopic = "transaction.capture_requested"
+	defaultVoidRequestedTransactionTopic    = "transaction.void_requested"
+	defaultOutcomeRetention                 = time.Hour
 )
 
 // PoolConfig holds configuration settings for worker pool.
@@ -30,9 +34,14 @@ type PoolConfig struct {
 
 // Config represents the transaction subscriber configuration.
 type Config struct {
-	PoolCfg                   *PoolConfig `yaml:"pool"`
-	ProcessedTransactionTopic string      `yaml:"processed_transaction_topic"`
-	CancelledTransactionTopic string      `yaml:"cancelled_transaction_topic"`
+	PoolCfg                          *PoolConfig `yaml:"pool"`
+	ProcessedTransactionTopic        string      `yaml:"processed_transaction_topic"`
+	CancelledTransactionTopic        string      `yaml:"cancelled_transaction_topic"`
+	StatusRequestedTransactionTopic  string      `yaml:"status_requested_transaction_topic"`
+	CaptureRequestedTransactionTopic string      `yaml:"capture_requested_transaction_topic"`
+	VoidRequestedTransactionTopic    string      `yaml:"void_requested_transaction_topic"`
+	// OutcomeRetention is how long the results of finished payments are kept to answer status requests.
+	OutcomeRetention time.Duration `yaml:"outcome_retention"`
 }
 
 func getDefaultConfig() *Config {
@@ -43,8 +52,12 @@ func getDefaultConfig() *Config {
 			NumWorkers:    defaultNumWorkers,
 			TasksCapacity: defaultTasksCapacity,
 		},
-		ProcessedTransactionTopic: defaultProcessedTransactionTopic,
-		CancelledTransactionTopic: defaultCancelledTransactionTopic,
+		ProcessedTransactionTopic:        defaultProcessedTransactionTopic,
+		CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+		StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+		CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+		VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+		OutcomeRetention:                 defaultOutcomeRetention,
 	}
 }
 
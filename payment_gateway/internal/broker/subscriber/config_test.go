@@ -31,8 +31,12 @@ func TestMergeWithDefault(t *testing.T) {
 					NumWorkers:    defaultNumWorkers,
 					TasksCapacity: 10000,
 				},
-				ProcessedTransactionTopic: "transaction.processed2",
-				CancelledTransactionTopic: defaultCancelledTransactionTopic,
+				ProcessedTransactionTopic:        "transaction.processed2",
+				CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+				StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+				CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+				VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+				OutcomeRetention:                 defaultOutcomeRetention,
 			},
 		},
 		{
@@ -45,8 +49,12 @@ func TestMergeWithDefault(t *testing.T) {
 					NumWorkers:    defaultNumWorkers,
 					TasksCapacity: defaultTasksCapacity,
 				},
-				ProcessedTransactionTopic: defaultProcessedTransactionTopic,
-				CancelledTransactionTopic: defaultCancelledTransactionTopic,
+				ProcessedTransactionTopic:        defaultProcessedTransactionTopic,
+				CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+				StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+				CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+				VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+				OutcomeRetention:                 defaultOutcomeRetention,
 			},
 			expectedErr: nil,
 		},
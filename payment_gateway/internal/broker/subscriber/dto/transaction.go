@@ -2,11 +2,13 @@ package dto
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
 )
 
 // Transaction represents a basic transaction with specific fields.
+// Value is a decimal amount in the major unit of the ISO 4217 (or ALGO) Currency, like "12.34".
 type Transaction struct {
 	TransactionID string `json:"transaction_id"`
 	Value         string `json:"value"`
@@ -28,11 +30,26 @@ type TransactionUser struct {
 	WalletID string `json:"wallet_id"`
 }
 
+// TransactionLeg represents the part of a split transaction paid to one receiver.
+// Value is a decimal amount in the major unit of the transaction currency.
+type TransactionLeg struct {
+	Receiver *TransactionUser `json:"receiver"`
+	Value    string           `json:"value"`
+}
+
 // ProcessedTransaction represents a transaction that has been fully processed.
+// Legs are set for split transactions only, the first leg is paid to the receiver.
+// AuthorizeOnly holds the value instead of paying it, the payment is finished by a capture or a void.
+// Captures and voids are requested with the same structure, the value of a capture is the captured one.
+// Watch waits until ExpiresAt for the payment the payer makes from their own wallet, there is no Sender then.
 type ProcessedTransaction struct {
-	Transaction *Transaction     `json:"transaction"`
-	Sender      *TransactionUser `json:"sender"`
-	Receiver    *TransactionUser `json:"receiver"`
+	Transaction   *Transaction      `json:"transaction"`
+	Sender        *TransactionUser  `json:"sender"`
+	Receiver      *TransactionUser  `json:"receiver"`
+	Legs          []*TransactionLeg `json:"legs,omitempty"`
+	AuthorizeOnly bool              `json:"authorize_only,omitempty"`
+	Watch         bool              `json:"watch,omitempty"`
+	ExpiresAt     *time.Time        `json:"expires_at,omitempty"`
 }
 
 // Decode populates a ProcessedTransaction object from JSON data.
@@ -49,3 +66,13 @@ type CancelledTransaction struct {
 func (t *CancelledTransaction) Decode(data []byte) error {
 	return json.Unmarshal(data, &t)
 }
+
+// StatusRequestedTransaction represents a request for the live status of a transaction payment.
+type StatusRequestedTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// Decode populates a StatusRequestedTransaction object from JSON data.
+func (t *StatusRequestedTransaction) Decode(data []byte) error {
+	return json.Unmarshal(data, &t)
+}
@@ -2,6 +2,7 @@ package dto_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/subscriber/dto"
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
@@ -55,6 +56,8 @@ func TestToTransactionInfo(t *testing.T) {
 func TestProcessedTransactionDecode(t *testing.T) {
 	t.Parallel()
 
+	watchExpiresAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
 	type args struct {
 		data []byte
 	}
@@ -88,6 +91,87 @@ func TestProcessedTransactionDecode(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "Successfully decode split transaction",
+			args: args{
+				data: []byte(`{"transaction":{"transaction_id":"123","value":"1.5"},"sender":{"user_id":"456", "wallet_id":"789"},"receiver":{"user_id":"789", "wallet_id":"456"},"legs":[{"receiver":{"user_id":"789", "wallet_id":"456"},"value":"1.2"},{"receiver":{"user_id":"012", "wallet_id":"345"},"value":"0.3"}]}`),
+			},
+			transaction: &dto.ProcessedTransaction{},
+			expectedTransaction: &dto.ProcessedTransaction{
+				Transaction: &dto.Transaction{
+					TransactionID: "123",
+					Value:         "1.5",
+				},
+				Sender: &dto.TransactionUser{
+					UserID:   "456",
+					WalletID: "789",
+				},
+				Receiver: &dto.TransactionUser{
+					UserID:   "789",
+					WalletID: "456",
+				},
+				Legs: []*dto.TransactionLeg{
+					{
+						Receiver: &dto.TransactionUser{
+							UserID:   "789",
+							WalletID: "456",
+						},
+						Value: "1.2",
+					},
+					{
+						Receiver: &dto.TransactionUser{
+							UserID:   "012",
+							WalletID: "345",
+						},
+						Value: "0.3",
+					},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Successfully decode authorize only transaction",
+			args: args{
+				data: []byte(`{"transaction":{"transaction_id":"123","value":"v"},"sender":{"user_id":"456", "wallet_id":"789"},"receiver":{"user_id":"789", "wallet_id":"456"},"authorize_only":true}`),
+			},
+			transaction: &dto.ProcessedTransaction{},
+			expectedTransaction: &dto.ProcessedTransaction{
+				Transaction: &dto.Transaction{
+					TransactionID: "123",
+					Value:         "v",
+				},
+				Sender: &dto.TransactionUser{
+					UserID:   "456",
+					WalletID: "789",
+				},
+				Receiver: &dto.TransactionUser{
+					UserID:   "789",
+					WalletID: "456",
+				},
+				AuthorizeOnly: true,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Successfully decode watch transaction",
+			args: args{
+				data: []byte(`{"transaction":{"transaction_id":"123","value":"v"},"sender":null,"receiver":{"user_id":"789", "wallet_id":"456"},"watch":true,"expires_at":"2024-05-01T12:00:00Z"}`),
+			},
+			transaction: &dto.ProcessedTransaction{},
+			expectedTransaction: &dto.ProcessedTransaction{
+				Transaction: &dto.Transaction{
+					TransactionID: "123",
+					Value:         "v",
+				},
+				Receiver: &dto.TransactionUser{
+					UserID:   "789",
+					WalletID: "456",
+				},
+				Watch:     true,
+				ExpiresAt: &watchExpiresAt,
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, testcase := range testcases {
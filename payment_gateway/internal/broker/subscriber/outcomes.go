@@ -0,0 +1,79 @@
+package subscriber
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
+)
+
+type outcome struct {
+	status   *dto.TransactionStatus
+	storedAt time.Time
+}
+
+// outcomeCache keeps the final statuses of finished payments for a while,
+// so that status requests are answered even if the result message was lost.
+type outcomeCache struct {
+	mu        sync.Mutex
+	retention time.Duration
+	outcomes  map[string]*outcome
+	order     []string
+	now       func() time.Time
+}
+
+func newOutcomeCache(retention time.Duration) *outcomeCache {
+	return &outcomeCache{
+		retention: retention,
+		outcomes:  make(map[string]*outcome),
+		now:       time.Now,
+	}
+}
+
+// Store records the final status of a payment and drops outcomes older than the retention.
+func (c *outcomeCache) Store(status *dto.TransactionStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	c.prune(now)
+
+	if _, ok := c.outcomes[status.TransactionID]; !ok {
+		c.order = append(c.order, status.TransactionID)
+	}
+
+	c.outcomes[status.TransactionID] = &outcome{
+		status:   status,
+		storedAt: now,
+	}
+}
+
+// Load returns the recorded final status of a payment.
+func (c *outcomeCache) Load(transactionID string) (*dto.TransactionStatus, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stored, ok := c.outcomes[transactionID]
+	if !ok || c.now().Sub(stored.storedAt) > c.retention {
+		return nil, false
+	}
+
+	return stored.status, true
+}
+
+func (c *outcomeCache) prune(now time.Time) {
+	expired := 0
+
+	for _, transactionID := range c.order {
+		stored, ok := c.outcomes[transactionID]
+		if ok && now.Sub(stored.storedAt) <= c.retention {
+			break
+		}
+
+		delete(c.outcomes, transactionID)
+		expired++
+	}
+
+	c.order = c.order[expired:]
+}
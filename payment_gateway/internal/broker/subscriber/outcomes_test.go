@@ -0,0 +1,46 @@
+package subscriber
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcomeCache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+	cache := newOutcomeCache(time.Minute)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	first := &dto.TransactionStatus{TransactionID: "first", Status: dto.StatusSucceeded}
+	second := &dto.TransactionStatus{TransactionID: "second", Status: dto.StatusFailed, Reason: "test-reason"}
+
+	cache.Store(first)
+
+	now = now.Add(30 * time.Second)
+	cache.Store(second)
+
+	actual, ok := cache.Load("first")
+	assert.True(t, ok)
+	assert.Equal(t, first, actual)
+
+	now = now.Add(45 * time.Second)
+
+	_, ok = cache.Load("first")
+	assert.False(t, ok, "outcome must not be returned after the retention")
+
+	actual, ok = cache.Load("second")
+	assert.True(t, ok)
+	assert.Equal(t, second, actual)
+
+	cache.Store(&dto.TransactionStatus{TransactionID: "third", Status: dto.StatusSucceeded})
+
+	assert.Len(t, cache.outcomes, 2, "expired outcomes must be pruned on store")
+	assert.Equal(t, []string{"second", "third"}, cache.order)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher"
+	publisher_dto "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/subscriber/dto"
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway/algorand"
@@ -28,10 +30,12 @@ const (
 // TransactionSubscriber represents a subscriber handling transaction-related messages.
 type TransactionSubscriber struct {
 	paymentWorkers sync.Map
+	outcomes       *outcomeCache
 	router         *message.Router
 	sub            message.Subscriber
 	pub            message.Publisher
 	publisherCfg   *publisher.Config
+	statusReporter publisher.StatusReporter
 	pool           *pond.WorkerPool
 	cfg            *Config
 	algorandCfg    *algorand.Config
@@ -55,12 +59,19 @@ func NewTransactionSubscriber(
 		return nil, NewTransactionSubscriberError("failed to set default config", err)
 	}
 
+	statusReporter, err := publisher.NewStatusReporter(publisherCfg, log, pub)
+	if err != nil {
+		return nil, NewTransactionSubscriberError("failed to create status reporter", err)
+	}
+
 	return &TransactionSubscriber{
 		paymentWorkers: sync.Map{},
+		outcomes:       newOutcomeCache(cfg.OutcomeRetention),
 		router:         router,
 		sub:            sub,
 		pub:            pub,
 		publisherCfg:   publisherCfg,
+		statusReporter: statusReporter,
 		cfg:            cfg,
 		algorandCfg:    algorandCfg,
 		log:            log,
@@ -88,8 +99,6 @@ func (s *TransactionSubscriber) RegisterProcessedTransactionHandler() {
 }
 
 func (s *TransactionSubscriber) handleProcessedTransaction(msg *message.Message) error {
-	ctx := context.Background()
-
 	processedTransaction := &dto.ProcessedTransaction{}
 	if err := processedTransaction.Decode(msg.Payload); err != nil {
 		s.log.Error("failed to decode processed transaction", map[string]interface{}{
@@ -99,12 +108,93 @@ func (s *TransactionSubscriber) handleProcessedTransaction(msg *message.Message)
 		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
 	}
 
-	transactionID := processedTransaction.Transaction.TransactionID
-
 	s.log.Debug("Start handle processed transaction", map[string]interface{}{
-		"transaction_id": transactionID,
+		"transaction_id": processedTransaction.Transaction.TransactionID,
 	})
 
+	operation := publisher.Pay
+
+	switch {
+	case processedTransaction.Watch:
+		operation = publisher.Watch
+	case processedTransaction.AuthorizeOnly:
+		operation = publisher.Authorize
+	}
+
+	s.startWorker(processedTransaction, operation)
+
+	return nil
+}
+
+// RegisterCaptureRequestedTransactionHandler registers a handler for the captures of authorized transactions.
+func (s *TransactionSubscriber) RegisterCaptureRequestedTransactionHandler() {
+	s.log.Debug("Register capture requested transaction handler", map[string]interface{}{})
+
+	s.router.AddNoPublisherHandler(
+		"capture_requested_transaction",
+		s.cfg.CaptureRequestedTransactionTopic,
+		s.sub,
+		s.handleCaptureRequestedTransaction,
+	)
+}
+
+func (s *TransactionSubscriber) handleCaptureRequestedTransaction(msg *message.Message) error {
+	capturedTransaction := &dto.ProcessedTransaction{}
+	if err := capturedTransaction.Decode(msg.Payload); err != nil {
+		s.log.Error("failed to decode capture requested transaction", map[string]interface{}{
+			"error": err,
+		})
+
+		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+	}
+
+	s.log.Debug("Start handle capture requested transaction", map[string]interface{}{
+		"transaction_id": capturedTransaction.Transaction.TransactionID,
+	})
+
+	s.startWorker(capturedTransaction, publisher.Capture)
+
+	return nil
+}
+
+// RegisterVoidRequestedTransactionHandler registers a handler for the voids of authorized transactions.
+func (s *TransactionSubscriber) RegisterVoidRequestedTransactionHandler() {
+	s.log.Debug("Register void requested transaction handler", map[string]interface{}{})
+
+	s.router.AddNoPublisherHandler(
+		"void_requested_transaction",
+		s.cfg.VoidRequestedTransactionTopic,
+		s.sub,
+		s.handleVoidRequestedTransaction,
+	)
+}
+
+func (s *TransactionSubscriber) handleVoidRequestedTransaction(msg *message.Message) error {
+	voidedTransaction := &dto.ProcessedTransaction{}
+	if err := voidedTransaction.Decode(msg.Payload); err != nil {
+		s.log.Error("failed to decode void requested transaction", map[string]interface{}{
+			"error": err,
+		})
+
+		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+	}
+
+	s.log.Debug("Start handle void requested transaction", map[string]interface{}{
+		"transaction_id": voidedTransaction.Transaction.TransactionID,
+	})
+
+	s.startWorker(voidedTransaction, publisher.Void)
+
+	return nil
+}
+
+// startWorker submits a payment worker making the operation for the transaction to the pool.
+// Watch needs a payment gateway that implements gateway.Watcher,
+// the other operations but Pay one that implements gateway.Authorizer.
+func (s *TransactionSubscriber) startWorker(processedTransaction *dto.ProcessedTransaction, operation publisher.Operation) {
+	ctx := context.Background()
+	transactionID := processedTransaction.Transaction.TransactionID
+
 	paymentGateway, err := s.getPaymentGateway(processedTransaction)
 	if err != nil {
 		s.log.Error("failed to get payment gateway", map[string]interface{}{
@@ -112,11 +202,44 @@ func (s *TransactionSubscriber) handleProcessedTransaction(msg *message.Message)
 			"error":          err,
 		})
 
-		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+		return
+	}
+
+	watcher, ok := paymentGateway.(gateway.Watcher)
+	if operation == publisher.Watch && !ok {
+		s.log.Error("payment gateway cannot watch payments", map[string]interface{}{
+			"transaction_id": transactionID,
+			"payment_method": processedTransaction.Transaction.PaymentMethod,
+		})
+
+		return
+	}
+
+	authorizer, ok := paymentGateway.(gateway.Authorizer)
+	if operation != publisher.Pay && operation != publisher.Watch && !ok {
+		s.log.Error("payment gateway cannot hold payments", map[string]interface{}{
+			"transaction_id": transactionID,
+			"payment_method": processedTransaction.Transaction.PaymentMethod,
+		})
+
+		return
 	}
 
 	s.pool.Submit(func() {
-		worker, err := publisher.NewWorker(s.publisherCfg, s.log, paymentGateway, s.pub)
+		var (
+			worker publisher.PaymentWorker
+			err    error
+		)
+
+		switch operation {
+		case publisher.Pay:
+			worker, err = publisher.NewWorker(s.publisherCfg, s.log, paymentGateway, s.pub)
+		case publisher.Watch:
+			worker, err = publisher.NewWatchWorker(s.publisherCfg, s.log, watcher, s.pub)
+		default:
+			worker, err = publisher.NewHoldWorker(s.publisherCfg, s.log, authorizer, operation, s.pub)
+		}
+
 		if err != nil {
 			s.log.Error("failed to make new worker", map[string]interface{}{
 				"transaction_id": transactionID,
@@ -135,75 +258,59 @@ func (s *TransactionSubscriber) handleProcessedTransaction(msg *message.Message)
 			})
 		}
 
+		// The outcome is stored before the worker is forgotten so that status requests always find one of them.
+		if outcome := worker.Outcome(); outcome != nil {
+			s.outcomes.Store(outcome)
+		}
+
 		s.paymentWorkers.Delete(transactionID)
 	})
-
-	return nil
 }
 
 func (s *TransactionSubscriber) getPaymentGateway(processedTransaction *dto.ProcessedTransaction) (gateway.PaymentGateway, error) {
 	paymentMethod := processedTransaction.Transaction.PaymentMethod
 
+	if paymentMethod == "algorand" && processedTransaction.Watch {
+		return s.getAlgorandWatch(processedTransaction)
+	}
+
 	if paymentMethod == "algorand" {
 		if s.algorandCfg.IsTest {
 			return gateway_stub.New(processedTransaction.Transaction.ToTransactionInfo()), nil
 		}
 
-		from := paymentGatewayService
-		to := userService
-		method := getWalletMethod
-
-		senderResp, err := s.monitorClient.Process(&monitor_client.ProcessParams{
-			Body: &models.ProcessRequest{
-				From:   &from,
-				To:     &to,
-				Method: &method,
-				Payload: gen.GetWalletByIdParams{
-					ClientID: processedTransaction.Sender.UserID,
-					WalletID: processedTransaction.Sender.WalletID,
-				},
-			},
-		})
+		sender, err := s.getWalletUserData(processedTransaction.Sender)
 		if err != nil {
-			return nil, fmt.Errorf("failed to process sender getWalletById request to monitor: %w", err)
+			return nil, fmt.Errorf("failed to get sender wallet: %w", err)
 		}
 
-		payload := senderResp.Payload.(map[string]interface{}) //nolint:errcheck // blya budu tut chto nado
-
-		senderPublicKey := payload["public_key"].(string)   //nolint:errcheck // blya budu tut chto nado
-		senderPrivateKey := payload["private_key"].(string) //nolint:errcheck // blya budu tut chto nado
-
-		receiverResp, err := s.monitorClient.Process(&monitor_client.ProcessParams{
-			Body: &models.ProcessRequest{
-				From:   &from,
-				To:     &to,
-				Method: &method,
-				Payload: gen.GetWalletByIdParams{
-					ClientID: processedTransaction.Receiver.UserID,
-					WalletID: processedTransaction.Receiver.WalletID,
-				},
-			},
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to process receiver getWalletById request to monitor: %w", err)
+		legs := processedTransaction.Legs
+		if len(legs) == 0 {
+			legs = []*dto.TransactionLeg{{
+				Receiver: processedTransaction.Receiver,
+				Value:    processedTransaction.Transaction.Value,
+			}}
 		}
 
-		payload = receiverResp.Payload.(map[string]interface{}) //nolint:errcheck // blya budu tut chto nado
+		algorandLegs := make([]*algorand.Leg, 0, len(legs))
+
+		for _, leg := range legs {
+			receiver, err := s.getWalletUserData(leg.Receiver)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get receiver wallet: %w", err)
+			}
 
-		receiverPublicKey := payload["public_key"].(string)   //nolint:errcheck // blya budu tut chto nado
-		receiverPrivateKey := payload["private_key"].(string) //nolint:errcheck // blya budu tut chto nado
+			algorandLegs = append(algorandLegs, &algorand.Leg{
+				Receiver: receiver,
+				Value:    leg.Value,
+			})
+		}
 
 		algorandGateway, err := algorand.New(
 			s.algorandCfg,
 			processedTransaction.Transaction.ToTransactionInfo(),
-			&algorand.UserData{
-				WalletAddress: senderPublicKey,
-				Mnemonic:      senderPrivateKey,
-			},
-			&algorand.UserData{
-				WalletAddress: receiverPublicKey,
-				Mnemonic:      receiverPrivateKey,
-			},
+			sender,
+			algorandLegs,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create algorand gateway: %w", err)
@@ -215,6 +322,65 @@ func (s *TransactionSubscriber) getPaymentGateway(processedTransaction *dto.Proc
 	return nil, fmt.Errorf("cannot handle %s payment gateway", paymentMethod)
 }
 
+// getAlgorandWatch creates the gateway watching the receiver wallet for the payment of a watch transaction,
+// only the address of the wallet is used.
+func (s *TransactionSubscriber) getAlgorandWatch(processedTransaction *dto.ProcessedTransaction) (gateway.Watcher, error) {
+	var expiresAt time.Time
+	if processedTransaction.ExpiresAt != nil {
+		expiresAt = *processedTransaction.ExpiresAt
+	}
+
+	if s.algorandCfg.IsTest {
+		return gateway_stub.NewWatch(processedTransaction.Transaction.ToTransactionInfo(), expiresAt), nil
+	}
+
+	receiver, err := s.getWalletUserData(processedTransaction.Receiver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receiver wallet: %w", err)
+	}
+
+	watch, err := algorand.NewWatch(
+		s.algorandCfg,
+		processedTransaction.Transaction.ToTransactionInfo(),
+		receiver,
+		expiresAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create algorand watch: %w", err)
+	}
+
+	return watch, nil
+}
+
+// getWalletUserData requests the keys of the user wallet from the user service through the monitor.
+func (s *TransactionSubscriber) getWalletUserData(user *dto.TransactionUser) (*algorand.UserData, error) {
+	from := paymentGatewayService
+	to := userService
+	method := getWalletMethod
+
+	resp, err := s.monitorClient.Process(&monitor_client.ProcessParams{
+		Body: &models.ProcessRequest{
+			From:   &from,
+			To:     &to,
+			Method: &method,
+			Payload: gen.GetWalletByIdParams{
+				ClientID: user.UserID,
+				WalletID: user.WalletID,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to process getWalletById request to monitor: %w", err)
+	}
+
+	payload := resp.Payload.(map[string]interface{}) //nolint:errcheck // blya budu tut chto nado
+
+	return &algorand.UserData{
+		WalletAddress: payload["public_key"].(string),  //nolint:errcheck // blya budu tut chto nado
+		Mnemonic:      payload["private_key"].(string), //nolint:errcheck // blya budu tut chto nado
+	}, nil
+}
+
 // RegisterCancelledTransactionHandler registers a handler for cancelled transaction messages.
 func (s *TransactionSubscriber) RegisterCancelledTransactionHandler() {
 	s.log.Debug("Register cancelled transaction handler", map[string]interface{}{})
@@ -261,6 +427,60 @@ func (s *TransactionSubscriber) handleCancelledTransaction(msg *message.Message)
 	return nil
 }
 
+// RegisterStatusRequestedTransactionHandler registers a handler for transaction status requests.
+func (s *TransactionSubscriber) RegisterStatusRequestedTransactionHandler() {
+	s.log.Debug("Register status requested transaction handler", map[string]interface{}{})
+
+	s.router.AddNoPublisherHandler(
+		"status_requested_transaction",
+		s.cfg.StatusRequestedTransactionTopic,
+		s.sub,
+		s.handleStatusRequestedTransaction,
+	)
+}
+
+func (s *TransactionSubscriber) handleStatusRequestedTransaction(msg *message.Message) error {
+	statusRequestedTransaction := &dto.StatusRequestedTransaction{}
+	if err := statusRequestedTransaction.Decode(msg.Payload); err != nil {
+		s.log.Error("failed to decode status requested transaction", map[string]interface{}{
+			"error": err,
+		})
+
+		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+	}
+
+	s.log.Debug("Start handle status requested transaction", map[string]interface{}{
+		"transaction_id": statusRequestedTransaction.TransactionID,
+	})
+
+	if err := s.statusReporter.Report(s.transactionStatus(statusRequestedTransaction.TransactionID)); err != nil {
+		s.log.Error("failed to report transaction status", map[string]interface{}{
+			"transaction_id": statusRequestedTransaction.TransactionID,
+			"error":          err,
+		})
+	}
+
+	return nil
+}
+
+func (s *TransactionSubscriber) transactionStatus(transactionID string) *publisher_dto.TransactionStatus {
+	if _, ok := s.paymentWorkers.Load(transactionID); ok {
+		return &publisher_dto.TransactionStatus{
+			TransactionID: transactionID,
+			Status:        publisher_dto.StatusProcessing,
+		}
+	}
+
+	if status, ok := s.outcomes.Load(transactionID); ok {
+		return status
+	}
+
+	return &publisher_dto.TransactionStatus{
+		TransactionID: transactionID,
+		Status:        publisher_dto.StatusUnknown,
+	}
+}
+
 // Run starts the transaction subscriber's router.
 func (s *TransactionSubscriber) Run(ctx context.Context) error {
 	s.log.Debug("Run transaction subscriber", map[string]interface{}{})
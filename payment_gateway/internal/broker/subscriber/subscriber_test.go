@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher"
+	publisher_dto "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/dto"
 	worker_mocks "github.com/ShmelJUJ/software-engineering/payment_gateway/internal/broker/publisher/mocks"
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway/algorand"
 	kafka_mocks "github.com/ShmelJUJ/software-engineering/pkg/kafka/mocks"
@@ -144,8 +145,12 @@ func TestNewTransactionSubscriber(t *testing.T) {
 						NumWorkers:    10000,
 						TasksCapacity: defaultTasksCapacity,
 					},
-					ProcessedTransactionTopic: processedTopic,
-					CancelledTransactionTopic: defaultCancelledTransactionTopic,
+					ProcessedTransactionTopic:        processedTopic,
+					CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+					StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+					CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+					VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+					OutcomeRetention:                 defaultOutcomeRetention,
 				},
 				algorandCfg:   &algorand.Config{},
 				log:           log,
@@ -242,6 +247,80 @@ func TestHandleProcessedTransaction(t *testing.T) {
 	}
 }
 
+func TestHandleHoldRequestedTransaction(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		payload message.Payload
+		handle  func(*TransactionSubscriber, *message.Message) error
+		mock    func(*logger_mocks.MockLogger)
+	}{
+		{
+			name:    "Decode capture requested transaction error",
+			payload: []byte(`{`),
+			handle:  (*TransactionSubscriber).handleCaptureRequestedTransaction,
+			mock: func(ml *logger_mocks.MockLogger) {
+				ml.EXPECT().Error("failed to decode capture requested transaction", gomock.Any()).Times(1)
+			},
+		},
+		{
+			name:    "Capture with unknown payment gateway",
+			payload: []byte(`{"transaction":{"transaction_id":"123","payment_method":"card"}}`),
+			handle:  (*TransactionSubscriber).handleCaptureRequestedTransaction,
+			mock: func(ml *logger_mocks.MockLogger) {
+				ml.EXPECT().Debug("Start handle capture requested transaction", map[string]interface{}{
+					"transaction_id": "123",
+				}).Times(1)
+				ml.EXPECT().Error("failed to get payment gateway", map[string]interface{}{
+					"transaction_id": "123",
+					"error":          fmt.Errorf("cannot handle %s payment gateway", "card"),
+				}).Times(1)
+			},
+		},
+		{
+			name:    "Void with unknown payment gateway",
+			payload: []byte(`{"transaction":{"transaction_id":"123","payment_method":"card"}}`),
+			handle:  (*TransactionSubscriber).handleVoidRequestedTransaction,
+			mock: func(ml *logger_mocks.MockLogger) {
+				ml.EXPECT().Debug("Start handle void requested transaction", map[string]interface{}{
+					"transaction_id": "123",
+				}).Times(1)
+				ml.EXPECT().Error("failed to get payment gateway", map[string]interface{}{
+					"transaction_id": "123",
+					"error":          fmt.Errorf("cannot handle %s payment gateway", "card"),
+				}).Times(1)
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockLog, mockSubscriber, mockPublisher, _, monitorClient := subscriberHelper(t)
+			testcase.mock(mockLog)
+
+			transactionSubscriber, err := NewTransactionSubscriber(
+				&Config{},
+				mockLog,
+				&message.Router{},
+				mockSubscriber,
+				mockPublisher,
+				&publisher.Config{},
+				&algorand.Config{},
+				monitorClient,
+			)
+			assert.NoError(t, err)
+
+			err = testcase.handle(transactionSubscriber, message.NewMessage(watermill.NewUUID(), testcase.payload))
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func lenSyncMap(m *sync.Map) int {
 	var i int
 
@@ -317,3 +396,81 @@ func TestHandleCancelledTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleStatusRequestedTransaction(t *testing.T) {
+	t.Parallel()
+
+	failedOutcome := &publisher_dto.TransactionStatus{
+		TransactionID: transactionID,
+		Status:        publisher_dto.StatusFailed,
+		Reason:        "test-reason",
+	}
+
+	testcases := []struct {
+		name           string
+		preAction      func(*TransactionSubscriber, publisher.PaymentWorker)
+		expectedStatus *publisher_dto.TransactionStatus
+	}{
+		{
+			name: "Payment is still processing",
+			preAction: func(transactionSubscriber *TransactionSubscriber, worker publisher.PaymentWorker) {
+				transactionSubscriber.paymentWorkers.Store(transactionID, worker)
+			},
+			expectedStatus: &publisher_dto.TransactionStatus{
+				TransactionID: transactionID,
+				Status:        publisher_dto.StatusProcessing,
+			},
+		},
+		{
+			name: "Payment already finished",
+			preAction: func(transactionSubscriber *TransactionSubscriber, _ publisher.PaymentWorker) {
+				transactionSubscriber.outcomes.Store(failedOutcome)
+			},
+			expectedStatus: failedOutcome,
+		},
+		{
+			name:      "Payment is unknown",
+			preAction: func(*TransactionSubscriber, publisher.PaymentWorker) {},
+			expectedStatus: &publisher_dto.TransactionStatus{
+				TransactionID: transactionID,
+				Status:        publisher_dto.StatusUnknown,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockLog, mockSubscriber, mockPublisher, mockWorker, monitorClient := subscriberHelper(t)
+			mockLog.EXPECT().Debug("Start handle status requested transaction", map[string]interface{}{
+				"transaction_id": transactionID,
+			}).Times(1)
+
+			transactionSubscriber, err := NewTransactionSubscriber(
+				&Config{},
+				mockLog,
+				&message.Router{},
+				mockSubscriber,
+				mockPublisher,
+				&publisher.Config{},
+				&algorand.Config{},
+				monitorClient,
+			)
+			assert.NoError(t, err)
+
+			statusReporter := worker_mocks.NewMockStatusReporter(gomock.NewController(t))
+			statusReporter.EXPECT().Report(testcase.expectedStatus).Return(nil).Times(1)
+			transactionSubscriber.statusReporter = statusReporter
+
+			testcase.preAction(transactionSubscriber, mockWorker)
+
+			err = transactionSubscriber.handleStatusRequestedTransaction(
+				message.NewMessage(watermill.NewUUID(), []byte(`{"transaction_id":"test-transaction-id"}`)),
+			)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -2,15 +2,41 @@ package algorand
 
 import (
 	"context"
-	"strconv"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
 	"github.com/algorand/go-algorand-sdk/v2/client/v2/algod"
 	"github.com/algorand/go-algorand-sdk/v2/crypto"
 	"github.com/algorand/go-algorand-sdk/v2/mnemonic"
 	"github.com/algorand/go-algorand-sdk/v2/transaction"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const (
+	// microAlgoExponent is the exponent of the base unit of Algorand payments, one Algo is 10^6 microAlgos.
+	microAlgoExponent = 6
+	// escrowFee is the flat fee of every payment made by an escrow account, the minimum fee of the protocol.
+	escrowFee = 1_000
+	// escrowReserve is funded on top of the authorized value, it is the minimum balance of an account
+	// and the fees of a full group of payments out of the escrow account.
+	escrowReserve = 100_000 + types.MaxTxGroupSize*escrowFee
+)
+
+var (
+	// ErrUnsupportedCurrency is returned when the transaction currency cannot be paid in Algos.
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	// ErrInvalidLegs is returned when the legs are empty, too many for one group or do not add up to the transaction value.
+	ErrInvalidLegs = errors.New("invalid transaction legs")
+	// ErrEscrowUnavailable is returned by the hold operations when no escrow seed is configured.
+	ErrEscrowUnavailable = errors.New("escrow is not configured")
 )
 
 // UserData represents user-specific data like wallet address and mnemonic.
@@ -19,19 +45,30 @@ type UserData struct {
 	Mnemonic      string
 }
 
+// Leg represents the part of the transaction value paid to one receiver.
+// Value is a decimal amount in the major unit of the transaction currency.
+type Leg struct {
+	Receiver *UserData
+	Value    string
+}
+
 // AlgorandGateway provides methods for interacting with the Algorand blockchain.
 type Gateway struct {
-	client           *algod.Client
-	cfg              *Config
-	sender, receiver *UserData
-	transactionInfo  *gateway.TransactionInfo
+	client          *algod.Client
+	cfg             *Config
+	sender          *UserData
+	legs            []*Leg
+	transactionInfo *gateway.TransactionInfo
 }
 
 // New creates a new instance of AlgorandGateway.
+// A transaction paid to a single receiver has one leg with the whole value,
+// a split transaction is paid as an atomic group of payments, one for each leg.
 func New(
 	cfg *Config,
 	transactionInfo *gateway.TransactionInfo,
-	sender, receiver *UserData,
+	sender *UserData,
+	legs []*Leg,
 ) (*Gateway, error) {
 	cfg, err := mergeWithDefault(cfg)
 	if err != nil {
@@ -47,51 +84,311 @@ func New(
 		client:          algodClient,
 		cfg:             cfg,
 		sender:          sender,
-		receiver:        receiver,
+		legs:            legs,
 		transactionInfo: transactionInfo,
 	}, nil
 }
 
 // CreatePayment initiates a payment transaction on the Algorand blockchain.
+// The legs of a split transaction are sent as one group, so either all of them are confirmed or none,
+// the ID of the first payment of the group is returned. Every payment notes the transaction ID,
+// the reconciliation finds the payments of a transaction by it.
 func (g *Gateway) CreatePayment(ctx context.Context) (string, error) {
 	sp, err := g.client.SuggestedParams().Do(ctx)
 	if err != nil {
 		return "", gateway.NewCreatePaymentError("failed to get suggested params", err)
 	}
 
-	amount, err := strconv.ParseInt(g.transactionInfo.Value, 10, 64)
+	amounts, err := legMicroAlgos(g.transactionInfo, g.legs)
+	if err != nil {
+		return "", gateway.NewCreatePaymentError("failed to convert transaction value", err)
+	}
+
+	ptxns := make([]types.Transaction, len(g.legs))
+
+	for i, leg := range g.legs {
+		ptxns[i], err = transaction.MakePaymentTxn(
+			g.sender.WalletAddress,
+			leg.Receiver.WalletAddress,
+			amounts[i],
+			paynote.New(paynote.Payment, g.transactionInfo.TransactionID),
+			"",
+			sp,
+		)
+		if err != nil {
+			return "", gateway.NewCreatePaymentError("failed to make payment txn", err)
+		}
+	}
+
+	privateKey, err := mnemonic.ToPrivateKey(g.sender.Mnemonic)
+	if err != nil {
+		return "", gateway.NewCreatePaymentError("failed convert mnemonic to private key", err)
+	}
+
+	txID, err := g.sendGroup(ctx, privateKey, ptxns)
+	if err != nil {
+		return "", gateway.NewCreatePaymentError("failed to send payment", err)
+	}
+
+	return txID, nil
+}
+
+// Authorize holds the transaction value in the escrow account of the transaction.
+// The sender funds it with the value and escrowReserve, which keeps the account open
+// and pays the fees of the capture or the void, the unused reserve is returned by them.
+func (g *Gateway) Authorize(ctx context.Context) (string, error) {
+	escrow, err := escrowAccount(g.cfg.EscrowSeed, g.transactionInfo.TransactionID)
+	if err != nil {
+		return "", gateway.NewAuthorizeError("failed to derive escrow account", err)
+	}
+
+	amount, err := microAlgos(g.transactionInfo)
+	if err != nil {
+		return "", gateway.NewAuthorizeError("failed to convert transaction value", err)
+	}
+
+	sp, err := g.client.SuggestedParams().Do(ctx)
 	if err != nil {
-		return "", gateway.NewCreationGatewayError("failed to parse transaction value", err)
+		return "", gateway.NewAuthorizeError("failed to get suggested params", err)
 	}
 
 	ptxn, err := transaction.MakePaymentTxn(
 		g.sender.WalletAddress,
-		g.receiver.WalletAddress,
-		uint64(amount),
-		nil,
+		escrow.Address.String(),
+		amount+escrowReserve,
+		paynote.New(paynote.Hold, g.transactionInfo.TransactionID),
 		"",
 		sp,
 	)
 	if err != nil {
-		return "", gateway.NewCreatePaymentError("failed to make payment txn", err)
+		return "", gateway.NewAuthorizeError("failed to make payment txn", err)
 	}
 
 	privateKey, err := mnemonic.ToPrivateKey(g.sender.Mnemonic)
 	if err != nil {
-		return "", gateway.NewCreatePaymentError("failed convert mnemonic to private key", err)
+		return "", gateway.NewAuthorizeError("failed convert mnemonic to private key", err)
+	}
+
+	txID, err := g.sendGroup(ctx, privateKey, []types.Transaction{ptxn})
+	if err != nil {
+		return "", gateway.NewAuthorizeError("failed to send payment", err)
+	}
+
+	return txID, nil
+}
+
+// Capture pays the legs out of the escrow account and closes it to the sender in one group,
+// so the part of the hold above the transaction value is returned together with the reserve.
+func (g *Gateway) Capture(ctx context.Context) (string, error) {
+	escrow, err := escrowAccount(g.cfg.EscrowSeed, g.transactionInfo.TransactionID)
+	if err != nil {
+		return "", gateway.NewCaptureError("failed to derive escrow account", err)
+	}
+
+	// One place of the group is taken by the payment closing the escrow account.
+	if len(g.legs) >= types.MaxTxGroupSize {
+		return "", gateway.NewCaptureError("failed to capture payment", fmt.Errorf("%w: %d legs", ErrInvalidLegs, len(g.legs)))
+	}
+
+	amounts, err := legMicroAlgos(g.transactionInfo, g.legs)
+	if err != nil {
+		return "", gateway.NewCaptureError("failed to convert transaction value", err)
+	}
+
+	sp, err := g.escrowParams(ctx)
+	if err != nil {
+		return "", gateway.NewCaptureError("failed to get suggested params", err)
+	}
+
+	ptxns := make([]types.Transaction, 0, len(g.legs)+1)
+
+	for i, leg := range g.legs {
+		ptxn, err := transaction.MakePaymentTxn(
+			escrow.Address.String(),
+			leg.Receiver.WalletAddress,
+			amounts[i],
+			paynote.New(paynote.Payment, g.transactionInfo.TransactionID),
+			"",
+			sp,
+		)
+		if err != nil {
+			return "", gateway.NewCaptureError("failed to make payment txn", err)
+		}
+
+		ptxns = append(ptxns, ptxn)
+	}
+
+	closeTxn, err := g.makeCloseEscrowTxn(escrow, sp)
+	if err != nil {
+		return "", gateway.NewCaptureError("failed to make close txn", err)
+	}
+
+	txID, err := g.sendGroup(ctx, escrow.PrivateKey, append(ptxns, closeTxn))
+	if err != nil {
+		return "", gateway.NewCaptureError("failed to send payment", err)
+	}
+
+	return txID, nil
+}
+
+// Void closes the escrow account to the sender, returning the whole hold.
+func (g *Gateway) Void(ctx context.Context) (string, error) {
+	escrow, err := escrowAccount(g.cfg.EscrowSeed, g.transactionInfo.TransactionID)
+	if err != nil {
+		return "", gateway.NewVoidError("failed to derive escrow account", err)
+	}
+
+	sp, err := g.escrowParams(ctx)
+	if err != nil {
+		return "", gateway.NewVoidError("failed to get suggested params", err)
+	}
+
+	closeTxn, err := g.makeCloseEscrowTxn(escrow, sp)
+	if err != nil {
+		return "", gateway.NewVoidError("failed to make close txn", err)
+	}
+
+	txID, err := g.sendGroup(ctx, escrow.PrivateKey, []types.Transaction{closeTxn})
+	if err != nil {
+		return "", gateway.NewVoidError("failed to send payment", err)
+	}
+
+	return txID, nil
+}
+
+// escrowParams returns the suggested params with the flat escrowFee, the fees escrowReserve is counted for.
+func (g *Gateway) escrowParams(ctx context.Context) (types.SuggestedParams, error) {
+	sp, err := g.client.SuggestedParams().Do(ctx)
+	if err != nil {
+		return types.SuggestedParams{}, err
+	}
+
+	sp.FlatFee = true
+	sp.Fee = escrowFee
+
+	return sp, nil
+}
+
+// makeCloseEscrowTxn makes the payment closing the escrow account, everything left on it goes to the sender.
+func (g *Gateway) makeCloseEscrowTxn(escrow crypto.Account, sp types.SuggestedParams) (types.Transaction, error) {
+	return transaction.MakePaymentTxn(
+		escrow.Address.String(),
+		g.sender.WalletAddress,
+		0,
+		paynote.New(paynote.Release, g.transactionInfo.TransactionID),
+		g.sender.WalletAddress,
+		sp,
+	)
+}
+
+// sendGroup signs the payments with privateKey and sends them as one atomic group,
+// the ID of the first payment is returned.
+func (g *Gateway) sendGroup(ctx context.Context, privateKey ed25519.PrivateKey, ptxns []types.Transaction) (string, error) {
+	if len(ptxns) > 1 {
+		groupID, err := crypto.ComputeGroupID(ptxns)
+		if err != nil {
+			return "", fmt.Errorf("failed to compute group id: %w", err)
+		}
+
+		for i := range ptxns {
+			ptxns[i].Group = groupID
+		}
+	}
+
+	var (
+		firstTxID string
+		sptxns    []byte
+	)
+
+	for i, ptxn := range ptxns {
+		txID, sptxn, err := crypto.SignTransaction(privateKey, ptxn)
+		if err != nil {
+			return "", fmt.Errorf("failed to sign transaction: %w", err)
+		}
+
+		if i == 0 {
+			firstTxID = txID
+		}
+
+		sptxns = append(sptxns, sptxn...)
+	}
+
+	if _, err := g.client.SendRawTransaction(sptxns).Do(ctx); err != nil {
+		return "", fmt.Errorf("failed to send raw transaction: %w", err)
+	}
+
+	return firstTxID, nil
+}
+
+// escrowAccount derives the escrow account holding the authorized value of the transaction from seed,
+// so its key is never stored and the account is the same for every replica of the payment gateway.
+func escrowAccount(seed, transactionID string) (crypto.Account, error) {
+	if seed == "" {
+		return crypto.Account{}, ErrEscrowUnavailable
+	}
+
+	mac := hmac.New(sha256.New, []byte(seed))
+	mac.Write([]byte(transactionID))
+
+	return crypto.AccountFromPrivateKey(ed25519.NewKeyFromSeed(mac.Sum(nil)))
+}
+
+// legMicroAlgos converts the leg values into microAlgos, they must add up to the transaction value.
+func legMicroAlgos(transactionInfo *gateway.TransactionInfo, legs []*Leg) ([]uint64, error) {
+	if len(legs) == 0 || len(legs) > types.MaxTxGroupSize {
+		return nil, fmt.Errorf("%w: %d legs", ErrInvalidLegs, len(legs))
+	}
+
+	total, err := microAlgos(transactionInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make([]uint64, len(legs))
+
+	var sum uint64
+
+	for i, leg := range legs {
+		amounts[i], err = microAlgos(&gateway.TransactionInfo{
+			TransactionID: transactionInfo.TransactionID,
+			Value:         leg.Value,
+			Currency:      transactionInfo.Currency,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sum += amounts[i]
 	}
 
-	_, sptxn, err := crypto.SignTransaction(privateKey, ptxn)
+	if sum != total {
+		return nil, fmt.Errorf("%w: legs add up to %d of %d microAlgos", ErrInvalidLegs, sum, total)
+	}
+
+	return amounts, nil
+}
+
+// microAlgos converts the decimal transaction value into microAlgos, the base unit of Algorand payments.
+func microAlgos(transactionInfo *gateway.TransactionInfo) (uint64, error) {
+	amount, err := money.Parse(transactionInfo.Value, transactionInfo.Currency)
 	if err != nil {
-		return "", gateway.NewCreatePaymentError("failed to sign transaction", err)
+		return 0, err
 	}
 
-	pendingTxID, err := g.client.SendRawTransaction(sptxn).Do(ctx)
+	if amount.Currency() != money.ALGO {
+		return 0, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, amount.Currency())
+	}
+
+	units, err := amount.Units(microAlgoExponent)
 	if err != nil {
-		return "", gateway.NewCreatePaymentError("failed to send raw transaction", err)
+		return 0, err
+	}
+
+	if units <= 0 {
+		return 0, fmt.Errorf("%w: %s", money.ErrInvalidAmount, amount)
 	}
 
-	return pendingTxID, nil
+	return uint64(units), nil
 }
 
 // CheckStatus checks the status of a payment transaction on the Algorand blockchain.
@@ -0,0 +1,189 @@
+package algorand
+
+import (
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMicroAlgos(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		value          string
+		currency       string
+		expectedAmount uint64
+		expectedErr    error
+	}{
+		{
+			name:           "Whole Algos",
+			value:          "5",
+			currency:       "ALGO",
+			expectedAmount: 5000000,
+		},
+		{
+			name:           "Fractional Algos",
+			value:          "0.000005",
+			currency:       "ALGO",
+			expectedAmount: 5,
+		},
+		{
+			name:        "More decimals than microAlgos",
+			value:       "0.0000005",
+			currency:    "ALGO",
+			expectedErr: money.ErrPrecisionLoss,
+		},
+		{
+			name:        "Fiat currency",
+			value:       "5.00",
+			currency:    "USD",
+			expectedErr: ErrUnsupportedCurrency,
+		},
+		{
+			name:        "Unknown currency",
+			value:       "5",
+			currency:    "string",
+			expectedErr: money.ErrUnknownCurrency,
+		},
+		{
+			name:        "Not positive",
+			value:       "0",
+			currency:    "ALGO",
+			expectedErr: money.ErrInvalidAmount,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			amount, err := microAlgos(&gateway.TransactionInfo{
+				Value:    testcase.value,
+				Currency: testcase.currency,
+			})
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedAmount, amount)
+		})
+	}
+}
+
+func TestLegMicroAlgos(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		values          []string
+		expectedAmounts []uint64
+		expectedErr     error
+	}{
+		{
+			name:            "Single receiver",
+			values:          []string{"1.5"},
+			expectedAmounts: []uint64{1500000},
+		},
+		{
+			name:            "Seller and platform fee",
+			values:          []string{"1.45", "0.05"},
+			expectedAmounts: []uint64{1450000, 50000},
+		},
+		{
+			name:        "Legs do not add up",
+			values:      []string{"1.45", "0.04"},
+			expectedErr: ErrInvalidLegs,
+		},
+		{
+			name:        "No legs",
+			expectedErr: ErrInvalidLegs,
+		},
+		{
+			name:        "More legs than one group fits",
+			values:      []string{"0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.1", "0.05", "0.05"},
+			expectedErr: ErrInvalidLegs,
+		},
+		{
+			name:        "Leg is not positive",
+			values:      []string{"1.5", "0"},
+			expectedErr: money.ErrInvalidAmount,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			legs := make([]*Leg, 0, len(testcase.values))
+			for _, value := range testcase.values {
+				legs = append(legs, &Leg{Value: value})
+			}
+
+			amounts, err := legMicroAlgos(&gateway.TransactionInfo{
+				Value:    "1.5",
+				Currency: "ALGO",
+			}, legs)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedAmounts, amounts)
+		})
+	}
+}
+
+func TestEscrowAccount(t *testing.T) {
+	t.Parallel()
+
+	escrow, err := escrowAccount("seed", "transaction-1")
+	assert.NoError(t, err)
+
+	testcases := []struct {
+		name          string
+		seed          string
+		transactionID string
+		expectedSame  bool
+		expectedErr   error
+	}{
+		{
+			name:          "Same seed and transaction",
+			seed:          "seed",
+			transactionID: "transaction-1",
+			expectedSame:  true,
+		},
+		{
+			name:          "Other transaction",
+			seed:          "seed",
+			transactionID: "transaction-2",
+		},
+		{
+			name:          "Other seed",
+			seed:          "other-seed",
+			transactionID: "transaction-1",
+		},
+		{
+			name:          "No seed",
+			transactionID: "transaction-1",
+			expectedErr:   ErrEscrowUnavailable,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			account, err := escrowAccount(testcase.seed, testcase.transactionID)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.expectedSame, account.Address == escrow.Address)
+			}
+		})
+	}
+}
@@ -20,6 +20,10 @@ const (
 	defaultTimeout                = 3 * time.Second
 	defaultRetries                = 10
 	defaultIsTest                 = false
+	defaultIndexerAddress         = "http://localhost:8980"
+	defaultWatchInterval          = 5 * time.Second
+	defaultWatchGrace             = time.Minute
+	defaultWatchTimeout           = 15 * time.Minute
 )
 
 // Config holds configuration settings for Algorand client.
@@ -30,6 +34,17 @@ type Config struct {
 	Timeout                time.Duration `yaml:"timeout"`
 	Retries                int           `yaml:"retries"`
 	IsTest                 bool          `yaml:"is_test"`
+	// EscrowSeed derives the escrow accounts holding authorized payments, they cannot be captured
+	// or voided once it changes. Holds are unavailable without it.
+	EscrowSeed string `yaml:"escrow_seed"`
+	// The indexer is searched for the payments of watch transactions every WatchInterval.
+	// A payment confirmed after the transaction expires is late, the watch goes on for WatchGrace
+	// after it to let the indexer catch up. WatchTimeout is the deadline of transactions without expiration.
+	IndexerAddress string        `yaml:"indexer_address"`
+	IndexerToken   string        `yaml:"indexer_token"`
+	WatchInterval  time.Duration `yaml:"watch_interval"`
+	WatchGrace     time.Duration `yaml:"watch_grace"`
+	WatchTimeout   time.Duration `yaml:"watch_timeout"`
 }
 
 func getDefaultConfig() *Config {
@@ -40,6 +55,10 @@ func getDefaultConfig() *Config {
 		Timeout:                defaultTimeout,
 		Retries:                defaultRetries,
 		IsTest:                 defaultIsTest,
+		IndexerAddress:         defaultIndexerAddress,
+		WatchInterval:          defaultWatchInterval,
+		WatchGrace:             defaultWatchGrace,
+		WatchTimeout:           defaultWatchTimeout,
 	}
 }
 
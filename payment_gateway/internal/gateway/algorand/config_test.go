@@ -2,6 +2,7 @@ package algorand
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -18,8 +19,9 @@ func TestMergeWithDefault(t *testing.T) {
 		{
 			name: "With some config",
 			cfg: &Config{
-				AlgodAddress: "test-algod-address",
-				Retries:      81,
+				AlgodAddress:  "test-algod-address",
+				Retries:       81,
+				WatchInterval: time.Second,
 			},
 			expectedCfg: &Config{
 				AlgodAddress:           "test-algod-address",
@@ -28,6 +30,10 @@ func TestMergeWithDefault(t *testing.T) {
 				Timeout:                defaultTimeout,
 				Retries:                81,
 				IsTest:                 defaultIsTest,
+				IndexerAddress:         defaultIndexerAddress,
+				WatchInterval:          time.Second,
+				WatchGrace:             defaultWatchGrace,
+				WatchTimeout:           defaultWatchTimeout,
 			},
 		},
 		{
@@ -40,6 +46,10 @@ func TestMergeWithDefault(t *testing.T) {
 				Timeout:                defaultTimeout,
 				Retries:                defaultRetries,
 				IsTest:                 defaultIsTest,
+				IndexerAddress:         defaultIndexerAddress,
+				WatchInterval:          defaultWatchInterval,
+				WatchGrace:             defaultWatchGrace,
+				WatchTimeout:           defaultWatchTimeout,
 			},
 			expectedErr: nil,
 		},
@@ -0,0 +1,221 @@
+package algorand
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/indexer"
+)
+
+// watchPageSize is the number of payments requested from the indexer at once.
+const watchPageSize = 1000
+
+// ErrWatchOnly is returned by CreatePayment of a watch, the payer makes the payment from their own wallet.
+var ErrWatchOnly = errors.New("payment is made by the payer, it can only be watched")
+
+// Watch waits for the payment the payer makes from their own wallet with the payment URI of the transaction,
+// nothing is signed on their behalf. The payments are found in the indexer by the note noting the transaction
+// and the receiver address, the ones confirmed until the transaction expires are summed up.
+// A partial payment keeps the watch going, the payer can top it up until the transaction expires.
+type Watch struct {
+	client          *indexer.Client
+	cfg             *Config
+	receiver        *UserData
+	transactionInfo *gateway.TransactionInfo
+	expiresAt       time.Time
+	retries         int
+
+	mu      sync.Mutex
+	outcome string
+}
+
+// NewWatch creates a new instance of Watch for the payment of the transaction to the receiver.
+// A transaction without expiration is given WatchTimeout from now.
+func NewWatch(
+	cfg *Config,
+	transactionInfo *gateway.TransactionInfo,
+	receiver *UserData,
+	expiresAt time.Time,
+) (*Watch, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, gateway.NewCreationGatewayError("failed to merge with default config", err)
+	}
+
+	indexerClient, err := indexer.MakeClient(cfg.IndexerAddress, cfg.IndexerToken)
+	if err != nil {
+		return nil, gateway.NewCreationGatewayError("failed to make indexer client", err)
+	}
+
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(cfg.WatchTimeout)
+	}
+
+	watch := &Watch{
+		client:          indexerClient,
+		cfg:             cfg,
+		receiver:        receiver,
+		transactionInfo: transactionInfo,
+		expiresAt:       expiresAt,
+	}
+
+	// One check is left for the end of the watch and one for a tick lost to the timer.
+	watch.retries = int(time.Until(watch.Deadline())/cfg.WatchInterval) + 2
+
+	return watch, nil
+}
+
+// CreatePayment always fails, a watch cannot pay on behalf of the payer.
+func (w *Watch) CreatePayment(context.Context) (string, error) {
+	return "", gateway.NewCreatePaymentError("failed to create payment", ErrWatchOnly)
+}
+
+// Watch returns the note the payments of the transaction carry, it is the payment ID checked with CheckStatus.
+func (w *Watch) Watch(context.Context) (string, error) {
+	if _, err := microAlgos(w.transactionInfo); err != nil {
+		return "", gateway.NewWatchError("failed to convert transaction value", err)
+	}
+
+	return string(paynote.New(paynote.Payment, w.transactionInfo.TransactionID)), nil
+}
+
+// CheckStatus sums up the payments to the receiver noting the transaction.
+// The payment succeeded once the payments confirmed until the transaction expired cover its value,
+// an overpayment succeeds with an outcome telling the excess. Once the watch is over without it,
+// the payment is cancelled with an outcome telling whether it came late, short or not at all.
+func (w *Watch) CheckStatus(ctx context.Context, paymentID string) (gateway.PaymentStatus, error) {
+	expected, err := microAlgos(w.transactionInfo)
+	if err != nil {
+		return gateway.Undefined, gateway.NewCheckStatusError("failed to convert transaction value", err)
+	}
+
+	payments, err := w.searchPayments(ctx, []byte(paymentID))
+	if err != nil {
+		return gateway.Undefined, gateway.NewCheckStatusError("failed to search payments", err)
+	}
+
+	var paid, late uint64
+
+	for _, payment := range payments {
+		if time.Unix(int64(payment.RoundTime), 0).After(w.expiresAt) {
+			late += payment.PaymentTransaction.Amount
+		} else {
+			paid += payment.PaymentTransaction.Amount
+		}
+	}
+
+	switch {
+	case paid > expected:
+		w.setOutcome(fmt.Sprintf("overpaid by %s, the receiver has to refund the excess", algos(paid-expected)))
+
+		return gateway.Succeeded, nil
+	case paid == expected:
+		w.setOutcome("")
+
+		return gateway.Succeeded, nil
+	case time.Now().Before(w.Deadline()):
+		return gateway.Pending, nil
+	case late != 0:
+		w.setOutcome(fmt.Sprintf("paid late: %s of %s arrived after the deadline, the receiver has to refund it", algos(late), algos(expected)))
+	case paid != 0:
+		w.setOutcome(fmt.Sprintf("underpaid: %s of %s arrived before the deadline, the receiver has to refund it", algos(paid), algos(expected)))
+	default:
+		w.setOutcome("no payment arrived before the deadline")
+	}
+
+	return gateway.Cancelled, nil
+}
+
+// searchPayments returns the payments to the receiver carrying the note, going through every page of the search.
+func (w *Watch) searchPayments(ctx context.Context, note []byte) ([]models.Transaction, error) {
+	var (
+		payments  []models.Transaction
+		nextToken string
+	)
+
+	for {
+		search := w.client.SearchForTransactions().
+			TxType("pay").
+			AddressString(w.receiver.WalletAddress).
+			AddressRole("receiver").
+			NotePrefix(note).
+			Limit(watchPageSize)
+
+		if nextToken != "" {
+			search = search.NextToken(nextToken)
+		}
+
+		resp, err := search.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, txn := range resp.Transactions {
+			// The role matches the receivers of closed accounts too and the note is only matched by its prefix.
+			if txn.PaymentTransaction.Receiver != w.receiver.WalletAddress || !bytes.Equal(txn.Note, note) {
+				continue
+			}
+
+			payments = append(payments, txn)
+		}
+
+		if resp.NextToken == "" || len(resp.Transactions) == 0 {
+			return payments, nil
+		}
+
+		nextToken = resp.NextToken
+	}
+}
+
+func (w *Watch) setOutcome(outcome string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.outcome = outcome
+}
+
+// Outcome explains the last status returned by CheckStatus, it is empty for a payment of the exact value.
+func (w *Watch) Outcome() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.outcome
+}
+
+// Deadline returns the time the watch gives up at, WatchGrace after the transaction expires.
+func (w *Watch) Deadline() time.Time {
+	return w.expiresAt.Add(w.cfg.WatchGrace)
+}
+
+// TransactionID returns the ID of the watched transaction.
+func (w *Watch) TransactionID() string {
+	return w.transactionInfo.TransactionID
+}
+
+// Timeout returns the interval between two searches of the payments.
+func (w *Watch) Timeout() time.Duration {
+	return w.cfg.WatchInterval
+}
+
+// Retries returns the number of searches that fit in the watch.
+func (w *Watch) Retries() int {
+	return w.retries
+}
+
+// algos formats an amount of microAlgos, like "1.500000 ALGO".
+func algos(amount uint64) string {
+	value, err := money.New(int64(amount), money.ALGO.Code)
+	if err != nil {
+		return fmt.Sprintf("%d microAlgos", amount)
+	}
+
+	return value.String()
+}
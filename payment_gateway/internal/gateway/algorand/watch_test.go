@@ -0,0 +1,232 @@
+package algorand
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const watchTransactionID = "d1000000-0000-4000-8000-000000000001"
+
+// chainPayment is a payment the fake indexer has confirmed, confirmed is the time from the expiration of the transaction.
+type chainPayment struct {
+	receiver  string
+	note      []byte
+	amount    uint64
+	confirmed time.Duration
+}
+
+// fakeIndexer serves the transaction search of the indexer API, one payment per page to go through the paging.
+// A nil chain makes every search fail.
+func fakeIndexer(t *testing.T, receiver string, expiresAt time.Time, chain []chainPayment) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/transactions" || chain == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		query := r.URL.Query()
+
+		assert.Equal(t, "pay", query.Get("tx-type"))
+		assert.Equal(t, receiver, query.Get("address"))
+		assert.Equal(t, "receiver", query.Get("address-role"))
+
+		notePrefix, err := base64.StdEncoding.DecodeString(query.Get("note-prefix"))
+		require.NoError(t, err)
+
+		page := 0
+		if token := query.Get("next"); token != "" {
+			page, err = strconv.Atoi(token)
+			require.NoError(t, err)
+		}
+
+		resp := models.TransactionsResponse{CurrentRound: 100, Transactions: []models.Transaction{}}
+
+		if page < len(chain) {
+			payment := chain[page]
+
+			resp.NextToken = strconv.Itoa(page + 1)
+
+			if bytes.HasPrefix(payment.note, notePrefix) {
+				resp.Transactions = append(resp.Transactions, models.Transaction{
+					Id:             "TX" + strconv.Itoa(page),
+					Type:           "pay",
+					Note:           payment.note,
+					ConfirmedRound: 90,
+					RoundTime:      uint64(expiresAt.Add(payment.confirmed).Unix()),
+					PaymentTransaction: models.TransactionPayment{
+						Receiver: payment.receiver,
+						Amount:   payment.amount,
+					},
+				})
+			}
+		}
+
+		_, _ = w.Write(json.Encode(resp))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func TestWatchCheckStatus(t *testing.T) {
+	t.Parallel()
+
+	receiver := crypto.GenerateAccount().Address.String()
+	other := crypto.GenerateAccount().Address.String()
+	note := paynote.New(paynote.Payment, watchTransactionID)
+
+	open := time.Now().Add(time.Hour)
+	closed := time.Now().Add(-time.Hour)
+
+	testcases := []struct {
+		name            string
+		expiresAt       time.Time
+		chain           []chainPayment
+		expectedStatus  gateway.PaymentStatus
+		expectedOutcome string
+		expectedErr     bool
+	}{
+		{
+			name:           "Paid the exact value",
+			expiresAt:      open,
+			chain:          []chainPayment{{receiver: receiver, note: note, amount: 1_000_000, confirmed: -time.Minute}},
+			expectedStatus: gateway.Succeeded,
+		},
+		{
+			name:      "Paid in two payments",
+			expiresAt: open,
+			chain: []chainPayment{
+				{receiver: receiver, note: note, amount: 400_000, confirmed: -2 * time.Minute},
+				{receiver: receiver, note: note, amount: 600_000, confirmed: -time.Minute},
+			},
+			expectedStatus: gateway.Succeeded,
+		},
+		{
+			name:            "Overpaid",
+			expiresAt:       open,
+			chain:           []chainPayment{{receiver: receiver, note: note, amount: 1_500_000, confirmed: -time.Minute}},
+			expectedStatus:  gateway.Succeeded,
+			expectedOutcome: "overpaid by 0.500000 ALGO, the receiver has to refund the excess",
+		},
+		{
+			name:           "Underpaid while the transaction is open",
+			expiresAt:      open,
+			chain:          []chainPayment{{receiver: receiver, note: note, amount: 400_000, confirmed: -time.Minute}},
+			expectedStatus: gateway.Pending,
+		},
+		{
+			name:            "Underpaid",
+			expiresAt:       closed,
+			chain:           []chainPayment{{receiver: receiver, note: note, amount: 400_000, confirmed: -time.Minute}},
+			expectedStatus:  gateway.Cancelled,
+			expectedOutcome: "underpaid: 0.400000 ALGO of 1.000000 ALGO arrived before the deadline, the receiver has to refund it",
+		},
+		{
+			name:            "Paid late",
+			expiresAt:       closed,
+			chain:           []chainPayment{{receiver: receiver, note: note, amount: 1_000_000, confirmed: 30 * time.Second}},
+			expectedStatus:  gateway.Cancelled,
+			expectedOutcome: "paid late: 1.000000 ALGO of 1.000000 ALGO arrived after the deadline, the receiver has to refund it",
+		},
+		{
+			name:            "Paid to another address",
+			expiresAt:       closed,
+			chain:           []chainPayment{{receiver: other, note: note, amount: 1_000_000, confirmed: -time.Minute}},
+			expectedStatus:  gateway.Cancelled,
+			expectedOutcome: "no payment arrived before the deadline",
+		},
+		{
+			name:      "Payment of another transaction",
+			expiresAt: closed,
+			chain: []chainPayment{
+				{receiver: receiver, note: paynote.New(paynote.Payment, "other"), amount: 1_000_000, confirmed: -time.Minute},
+			},
+			expectedStatus:  gateway.Cancelled,
+			expectedOutcome: "no payment arrived before the deadline",
+		},
+		{
+			name:        "Indexer fails",
+			expiresAt:   open,
+			chain:       nil,
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			watch, err := NewWatch(
+				&Config{
+					IndexerAddress: fakeIndexer(t, receiver, testcase.expiresAt, testcase.chain),
+					WatchGrace:     time.Minute,
+				},
+				&gateway.TransactionInfo{
+					TransactionID: watchTransactionID,
+					Value:         "1",
+					Currency:      "ALGO",
+				},
+				&UserData{WalletAddress: receiver},
+				testcase.expiresAt,
+			)
+			require.NoError(t, err)
+
+			paymentID, err := watch.Watch(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, string(note), paymentID)
+
+			status, err := watch.CheckStatus(context.Background(), paymentID)
+			if testcase.expectedErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectedStatus, status)
+			assert.Equal(t, testcase.expectedOutcome, watch.Outcome())
+		})
+	}
+}
+
+func TestWatchRetries(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(time.Minute)
+
+	watch, err := NewWatch(
+		&Config{WatchInterval: 10 * time.Second, WatchGrace: time.Minute},
+		&gateway.TransactionInfo{TransactionID: watchTransactionID, Value: "1", Currency: "ALGO"},
+		&UserData{},
+		expiresAt,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, expiresAt.Add(time.Minute), watch.Deadline())
+	assert.Equal(t, 10*time.Second, watch.Timeout())
+	// Just under two minutes of watch fit 11 intervals, one check is left for the end and one for a lost tick.
+	assert.Equal(t, 13, watch.Retries())
+
+	_, err = watch.CreatePayment(context.Background())
+	assert.ErrorIs(t, err, ErrWatchOnly)
+}
@@ -38,6 +38,10 @@ func (e *CreatePaymentError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
 
+func (e *CreatePaymentError) Unwrap() error {
+	return e.err
+}
+
 // CheckStatusError represents an error type specific to checking status.
 type CheckStatusError struct {
 	msg string
@@ -55,3 +59,91 @@ func NewCheckStatusError(msg string, err error) *CheckStatusError {
 func (e *CheckStatusError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
+
+// AuthorizeError represents an error type specific to authorizing payments.
+type AuthorizeError struct {
+	msg string
+	err error
+}
+
+// NewAuthorizeError creates a new AuthorizeError instance with the given message and underlying error.
+func NewAuthorizeError(msg string, err error) *AuthorizeError {
+	return &AuthorizeError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e *AuthorizeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e *AuthorizeError) Unwrap() error {
+	return e.err
+}
+
+// CaptureError represents an error type specific to capturing authorized payments.
+type CaptureError struct {
+	msg string
+	err error
+}
+
+// NewCaptureError creates a new CaptureError instance with the given message and underlying error.
+func NewCaptureError(msg string, err error) *CaptureError {
+	return &CaptureError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e *CaptureError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e *CaptureError) Unwrap() error {
+	return e.err
+}
+
+// VoidError represents an error type specific to voiding authorized payments.
+type VoidError struct {
+	msg string
+	err error
+}
+
+// NewVoidError creates a new VoidError instance with the given message and underlying error.
+func NewVoidError(msg string, err error) *VoidError {
+	return &VoidError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e *VoidError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e *VoidError) Unwrap() error {
+	return e.err
+}
+
+// WatchError represents an error type specific to watching for payments.
+type WatchError struct {
+	msg string
+	err error
+}
+
+// NewWatchError creates a new WatchError instance with the given message and underlying error.
+func NewWatchError(msg string, err error) *WatchError {
+	return &WatchError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e *WatchError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e *WatchError) Unwrap() error {
+	return e.err
+}
@@ -5,7 +5,7 @@ import (
 	"time"
 )
 
-//go:generate mockgen -package mocks -destination mocks/gateway_mocks.go github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway PaymentGateway
+//go:generate mockgen -package mocks -destination mocks/gateway_mocks.go github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway PaymentGateway,Authorizer,Watcher
 
 // PaymentStatus represents the status of a payment.
 type PaymentStatus int
@@ -32,3 +32,30 @@ type PaymentGateway interface {
 	Timeout() time.Duration
 	Retries() int
 }
+
+// Authorizer is implemented by payment gateways that can hold funds before taking them,
+// like the CapturePayment flow of Yookassa.
+// Every call returns the payment ID whose status is checked with CheckStatus.
+type Authorizer interface {
+	PaymentGateway
+	// Authorize holds the transaction value on the sender side.
+	Authorize(context.Context) (string, error)
+	// Capture takes the transaction value out of the hold and releases the rest of it,
+	// the value may be lower than the authorized one.
+	Capture(context.Context) (string, error)
+	// Void releases the whole hold back to the sender.
+	Void(context.Context) (string, error)
+}
+
+// Watcher is implemented by payment gateways that wait for a payment the payer makes from their own wallet,
+// instead of making it on their behalf. CheckStatus reports the payment succeeded once enough arrived
+// and cancelled once the deadline passed without it.
+type Watcher interface {
+	PaymentGateway
+	// Watch starts watching for the payment and returns the payment ID whose status is checked with CheckStatus.
+	Watch(context.Context) (string, error)
+	// Deadline returns the time the watch gives up at.
+	Deadline() time.Time
+	// Outcome explains the last status returned by CheckStatus, like an overpaid or a late payment.
+	Outcome() string
+}
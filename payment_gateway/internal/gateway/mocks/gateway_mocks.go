@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway (interfaces: PaymentGateway)
+// Source: github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway (interfaces: PaymentGateway,Authorizer,Watcher)
 //
 // Generated by this command:
 //
-//	mockgen -package mocks -destination mocks/gateway_mocks.go github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway PaymentGateway
+//	mockgen -package mocks -destination mocks/gateway_mocks.go github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway PaymentGateway,Authorizer,Watcher
 //
 
 // Package mocks is a generated GoMock package.
@@ -112,3 +112,281 @@ func (mr *MockPaymentGatewayMockRecorder) TransactionID() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionID", reflect.TypeOf((*MockPaymentGateway)(nil).TransactionID))
 }
+
+// MockAuthorizer is a mock of Authorizer interface.
+type MockAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizerMockRecorder
+}
+
+// MockAuthorizerMockRecorder is the mock recorder for MockAuthorizer.
+type MockAuthorizerMockRecorder struct {
+	mock *MockAuthorizer
+}
+
+// NewMockAuthorizer creates a new mock instance.
+func NewMockAuthorizer(ctrl *gomock.Controller) *MockAuthorizer {
+	mock := &MockAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizer) EXPECT() *MockAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockAuthorizer) Authorize(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAuthorizerMockRecorder) Authorize(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizer)(nil).Authorize), arg0)
+}
+
+// Capture mocks base method.
+func (m *MockAuthorizer) Capture(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockAuthorizerMockRecorder) Capture(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockAuthorizer)(nil).Capture), arg0)
+}
+
+// CheckStatus mocks base method.
+func (m *MockAuthorizer) CheckStatus(arg0 context.Context, arg1 string) (gateway.PaymentStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStatus", arg0, arg1)
+	ret0, _ := ret[0].(gateway.PaymentStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckStatus indicates an expected call of CheckStatus.
+func (mr *MockAuthorizerMockRecorder) CheckStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStatus", reflect.TypeOf((*MockAuthorizer)(nil).CheckStatus), arg0, arg1)
+}
+
+// CreatePayment mocks base method.
+func (m *MockAuthorizer) CreatePayment(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockAuthorizerMockRecorder) CreatePayment(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockAuthorizer)(nil).CreatePayment), arg0)
+}
+
+// Retries mocks base method.
+func (m *MockAuthorizer) Retries() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retries")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Retries indicates an expected call of Retries.
+func (mr *MockAuthorizerMockRecorder) Retries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retries", reflect.TypeOf((*MockAuthorizer)(nil).Retries))
+}
+
+// Timeout mocks base method.
+func (m *MockAuthorizer) Timeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// Timeout indicates an expected call of Timeout.
+func (mr *MockAuthorizerMockRecorder) Timeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeout", reflect.TypeOf((*MockAuthorizer)(nil).Timeout))
+}
+
+// TransactionID mocks base method.
+func (m *MockAuthorizer) TransactionID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TransactionID indicates an expected call of TransactionID.
+func (mr *MockAuthorizerMockRecorder) TransactionID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionID", reflect.TypeOf((*MockAuthorizer)(nil).TransactionID))
+}
+
+// Void mocks base method.
+func (m *MockAuthorizer) Void(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockAuthorizerMockRecorder) Void(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockAuthorizer)(nil).Void), arg0)
+}
+
+// MockWatcher is a mock of Watcher interface.
+type MockWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherMockRecorder
+}
+
+// MockWatcherMockRecorder is the mock recorder for MockWatcher.
+type MockWatcherMockRecorder struct {
+	mock *MockWatcher
+}
+
+// NewMockWatcher creates a new mock instance.
+func NewMockWatcher(ctrl *gomock.Controller) *MockWatcher {
+	mock := &MockWatcher{ctrl: ctrl}
+	mock.recorder = &MockWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcher) EXPECT() *MockWatcherMockRecorder {
+	return m.recorder
+}
+
+// CheckStatus mocks base method.
+func (m *MockWatcher) CheckStatus(arg0 context.Context, arg1 string) (gateway.PaymentStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckStatus", arg0, arg1)
+	ret0, _ := ret[0].(gateway.PaymentStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckStatus indicates an expected call of CheckStatus.
+func (mr *MockWatcherMockRecorder) CheckStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckStatus", reflect.TypeOf((*MockWatcher)(nil).CheckStatus), arg0, arg1)
+}
+
+// CreatePayment mocks base method.
+func (m *MockWatcher) CreatePayment(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockWatcherMockRecorder) CreatePayment(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockWatcher)(nil).CreatePayment), arg0)
+}
+
+// Deadline mocks base method.
+func (m *MockWatcher) Deadline() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deadline")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// Deadline indicates an expected call of Deadline.
+func (mr *MockWatcherMockRecorder) Deadline() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deadline", reflect.TypeOf((*MockWatcher)(nil).Deadline))
+}
+
+// Outcome mocks base method.
+func (m *MockWatcher) Outcome() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outcome")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Outcome indicates an expected call of Outcome.
+func (mr *MockWatcherMockRecorder) Outcome() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outcome", reflect.TypeOf((*MockWatcher)(nil).Outcome))
+}
+
+// Retries mocks base method.
+func (m *MockWatcher) Retries() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retries")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// Retries indicates an expected call of Retries.
+func (mr *MockWatcherMockRecorder) Retries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retries", reflect.TypeOf((*MockWatcher)(nil).Retries))
+}
+
+// Timeout mocks base method.
+func (m *MockWatcher) Timeout() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeout")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// Timeout indicates an expected call of Timeout.
+func (mr *MockWatcherMockRecorder) Timeout() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeout", reflect.TypeOf((*MockWatcher)(nil).Timeout))
+}
+
+// TransactionID mocks base method.
+func (m *MockWatcher) TransactionID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// TransactionID indicates an expected call of TransactionID.
+func (mr *MockWatcherMockRecorder) TransactionID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionID", reflect.TypeOf((*MockWatcher)(nil).TransactionID))
+}
+
+// Watch mocks base method.
+func (m *MockWatcher) Watch(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockWatcherMockRecorder) Watch(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockWatcher)(nil).Watch), arg0)
+}
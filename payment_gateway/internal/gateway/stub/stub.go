@@ -2,9 +2,13 @@ package stub
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
 )
 
 const (
@@ -12,20 +16,101 @@ const (
 	defaultRetries = 10
 )
 
+var (
+	// ErrHoldNotFound is returned when a capture or a void finds no hold of the transaction.
+	ErrHoldNotFound = errors.New("hold not found")
+	// ErrCaptureExceedsHold is returned when the captured value is greater than the authorized one.
+	ErrCaptureExceedsHold = errors.New("captured value exceeds the hold")
+)
+
+// holds emulates the funds held by the payment provider, keyed by transaction ID.
+// They are shared by the stubs of all messages and are lost on restart.
+var holds sync.Map
+
 type gatewayStub struct {
 	transactionInfo *gateway.TransactionInfo
+	deadline        time.Time
 }
 
-func New(transactionInfo *gateway.TransactionInfo) gateway.PaymentGateway {
+func New(transactionInfo *gateway.TransactionInfo) gateway.Authorizer {
 	return &gatewayStub{
 		transactionInfo: transactionInfo,
 	}
 }
 
+// NewWatch returns a stub watching for a payment until deadline, the payment is found on the first check.
+// A zero deadline leaves the stub all of its retries.
+func NewWatch(transactionInfo *gateway.TransactionInfo, deadline time.Time) gateway.Watcher {
+	if deadline.IsZero() {
+		deadline = time.Now().Add(defaultTimeout * defaultRetries)
+	}
+
+	return &gatewayStub{
+		transactionInfo: transactionInfo,
+		deadline:        deadline,
+	}
+}
+
 func (g *gatewayStub) CreatePayment(_ context.Context) (string, error) {
 	return "test", nil
 }
 
+// Authorize records a hold of the transaction value.
+func (g *gatewayStub) Authorize(_ context.Context) (string, error) {
+	amount, err := money.Parse(g.transactionInfo.Value, g.transactionInfo.Currency)
+	if err != nil {
+		return "", gateway.NewAuthorizeError("failed to parse transaction value", err)
+	}
+
+	holds.Store(g.transactionInfo.TransactionID, amount)
+
+	return "test-authorize", nil
+}
+
+// Capture removes the hold, it fails when there is none or the transaction value exceeds it.
+func (g *gatewayStub) Capture(_ context.Context) (string, error) {
+	amount, err := money.Parse(g.transactionInfo.Value, g.transactionInfo.Currency)
+	if err != nil {
+		return "", gateway.NewCaptureError("failed to parse transaction value", err)
+	}
+
+	value, ok := holds.Load(g.transactionInfo.TransactionID)
+	if !ok {
+		return "", gateway.NewCaptureError("failed to find hold", ErrHoldNotFound)
+	}
+
+	held := value.(money.Money) //nolint:errcheck // only money is stored in holds
+	if held.Currency() != amount.Currency() || held.Amount() < amount.Amount() {
+		return "", gateway.NewCaptureError("failed to capture hold", fmt.Errorf("%w: %s of %s", ErrCaptureExceedsHold, amount, held))
+	}
+
+	holds.Delete(g.transactionInfo.TransactionID)
+
+	return "test-capture", nil
+}
+
+// Void removes the hold, it fails when there is none.
+func (g *gatewayStub) Void(_ context.Context) (string, error) {
+	if _, ok := holds.LoadAndDelete(g.transactionInfo.TransactionID); !ok {
+		return "", gateway.NewVoidError("failed to find hold", ErrHoldNotFound)
+	}
+
+	return "test-void", nil
+}
+
+// Watch returns the payment ID of the watched payment.
+func (g *gatewayStub) Watch(_ context.Context) (string, error) {
+	return "test-watch", nil
+}
+
+func (g *gatewayStub) Deadline() time.Time {
+	return g.deadline
+}
+
+func (g *gatewayStub) Outcome() string {
+	return ""
+}
+
 func (g *gatewayStub) CheckStatus(context.Context, string) (gateway.PaymentStatus, error) {
 	return gateway.Succeeded, nil
 }
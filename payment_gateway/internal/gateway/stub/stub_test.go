@@ -0,0 +1,94 @@
+package stub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/payment_gateway/internal/gateway"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHolds(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testcases := []struct {
+		name          string
+		transactionID string
+		authorized    string
+		captured      string
+		void          bool
+		expectedErr   error
+	}{
+		{
+			name:          "Full capture",
+			transactionID: "full-capture",
+			authorized:    "10",
+			captured:      "10",
+		},
+		{
+			name:          "Partial capture",
+			transactionID: "partial-capture",
+			authorized:    "10",
+			captured:      "2.5",
+		},
+		{
+			name:          "Capture above the hold",
+			transactionID: "excessive-capture",
+			authorized:    "10",
+			captured:      "10.000001",
+			expectedErr:   ErrCaptureExceedsHold,
+		},
+		{
+			name:          "Capture without hold",
+			transactionID: "missing-hold",
+			captured:      "10",
+			expectedErr:   ErrHoldNotFound,
+		},
+		{
+			name:          "Void",
+			transactionID: "void",
+			authorized:    "10",
+			void:          true,
+		},
+		{
+			name:          "Void without hold",
+			transactionID: "missing-void",
+			void:          true,
+			expectedErr:   ErrHoldNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if testcase.authorized != "" {
+				_, err := New(&gateway.TransactionInfo{
+					TransactionID: testcase.transactionID,
+					Value:         testcase.authorized,
+					Currency:      "ALGO",
+				}).Authorize(ctx)
+				assert.NoError(t, err)
+			}
+
+			g := New(&gateway.TransactionInfo{
+				TransactionID: testcase.transactionID,
+				Value:         testcase.captured,
+				Currency:      "ALGO",
+			})
+
+			var err error
+			if testcase.void {
+				_, err = g.Void(ctx)
+			} else {
+				_, err = g.Capture(ctx)
+			}
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package jwt
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultRefreshInterval    = 10 * time.Minute
+	defaultMinRefreshInterval = 10 * time.Second
+	defaultLeeway             = 30 * time.Second
+)
+
+// Config represents the verifier configuration structure.
+type Config struct {
+	Issuer             string
+	RefreshInterval    time.Duration
+	MinRefreshInterval time.Duration
+	Leeway             time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		RefreshInterval:    defaultRefreshInterval,
+		MinRefreshInterval: defaultMinRefreshInterval,
+		Leeway:             defaultLeeway,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
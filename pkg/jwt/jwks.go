@@ -0,0 +1,181 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+)
+
+const (
+	keyType  = "OKP"
+	curve    = "Ed25519"
+	keyUsage = "sig"
+)
+
+// JWK is an Ed25519 public key in the JSON Web Key format (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty" mapstructure:"kty"`
+	Crv string `json:"crv" mapstructure:"crv"`
+	X   string `json:"x" mapstructure:"x"`
+	Kid string `json:"kid" mapstructure:"kid"`
+	Use string `json:"use" mapstructure:"use"`
+	Alg string `json:"alg" mapstructure:"alg"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys" mapstructure:"keys"`
+}
+
+func newJWK(kid string, key ed25519.PublicKey) JWK {
+	return JWK{
+		Kty: keyType,
+		Crv: curve,
+		X:   encoding.EncodeToString(key),
+		Kid: kid,
+		Use: keyUsage,
+		Alg: algorithm,
+	}
+}
+
+func (k *JWK) publicKey() (ed25519.PublicKey, error) {
+	if k.Kty != keyType || k.Crv != curve {
+		return nil, fmt.Errorf("%w: %s/%s", ErrUnsupportedAlg, k.Kty, k.Crv)
+	}
+
+	x, err := encoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key %s: %w", k.Kid, err)
+	}
+
+	if len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("key %s has invalid size %d", k.Kid, len(x))
+	}
+
+	return ed25519.PublicKey(x), nil
+}
+
+// KeyFetcher fetches the issuer's current JWKS.
+type KeyFetcher interface {
+	FetchJWKS(ctx context.Context) (*JWKS, error)
+}
+
+// KeyFetcherFunc is an adapter to allow the use of ordinary functions as KeyFetcher.
+type KeyFetcherFunc func(ctx context.Context) (*JWKS, error)
+
+// FetchJWKS calls f(ctx).
+func (f KeyFetcherFunc) FetchJWKS(ctx context.Context) (*JWKS, error) {
+	return f(ctx)
+}
+
+// Verifier validates tokens locally against a cached copy of the issuer's JWKS.
+// The cache is refreshed when it gets older than RefreshInterval or when a token
+// refers to an unknown key, but not more often than MinRefreshInterval.
+// A failed refresh counts as an attempt, so an unavailable issuer is not fetched on every token.
+type Verifier struct {
+	cfg     *Config
+	fetcher KeyFetcher
+	clock   clock.Clock
+
+	mu          sync.RWMutex
+	keys        map[string]ed25519.PublicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewVerifier creates a new Verifier.
+func NewVerifier(cfg *Config, fetcher KeyFetcher, clk clock.Clock) (*Verifier, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge with default config: %w", err)
+	}
+
+	return &Verifier{
+		cfg:     cfg,
+		fetcher: fetcher,
+		clock:   clk,
+		keys:    make(map[string]ed25519.PublicKey),
+	}, nil
+}
+
+// Verify validates an access token and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	return Verify(ctx, token, v, &VerifyOptions{
+		Type:   AccessToken,
+		Issuer: v.cfg.Issuer,
+		Now:    v.clock.NowUTC(),
+		Leeway: v.cfg.Leeway,
+	})
+}
+
+// ResolveKey returns the cached key with the given id, refreshing the cache if needed.
+func (v *Verifier) ResolveKey(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+	now := v.clock.NowUTC()
+
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	age := now.Sub(v.fetchedAt)
+	sinceAttempt := now.Sub(v.attemptedAt)
+	v.mu.RUnlock()
+
+	// Every refresh records its attempt, the last attempt is never older than the last successful fetch.
+	if (ok && age < v.cfg.RefreshInterval) || sinceAttempt < v.cfg.MinRefreshInterval {
+		if !ok {
+			return nil, ErrUnknownKey
+		}
+
+		return key, nil
+	}
+
+	if err := v.refresh(ctx, now); err != nil {
+		if ok {
+			// The issuer is unavailable, but the key is still known: keep serving from cache.
+			return key, nil
+		}
+
+		return nil, err
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	key, ok = v.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	return key, nil
+}
+
+func (v *Verifier) refresh(ctx context.Context, now time.Time) error {
+	v.mu.Lock()
+	v.attemptedAt = now
+	v.mu.Unlock()
+
+	jwks, err := v.fetcher.FetchJWKS(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(jwks.Keys))
+
+	for i := range jwks.Keys {
+		key, err := jwks.Keys[i].publicKey()
+		if err != nil {
+			return err
+		}
+
+		keys[jwks.Keys[i].Kid] = key
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.fetchedAt = now
+	v.mu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				Issuer: testIssuer,
+				Leeway: time.Minute,
+			},
+			expectedCfg: &Config{
+				Issuer:             testIssuer,
+				RefreshInterval:    defaultRefreshInterval,
+				MinRefreshInterval: defaultMinRefreshInterval,
+				Leeway:             time.Minute,
+			},
+		},
+		{
+			name: "With empty config",
+			cfg:  &Config{},
+			expectedCfg: &Config{
+				RefreshInterval:    defaultRefreshInterval,
+				MinRefreshInterval: defaultMinRefreshInterval,
+				Leeway:             defaultLeeway,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+type countingFetcher struct {
+	ks    *KeySet
+	err   error
+	calls int
+}
+
+func (f *countingFetcher) FetchJWKS(context.Context) (*JWKS, error) {
+	f.calls++
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.ks.JWKS(), nil
+}
+
+func TestVerifierCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ks := keySetHelper(t)
+
+	mockCtrl := gomock.NewController(t)
+	clk := mock_clock.NewMockClock(mockCtrl)
+
+	now := testNow
+	clk.EXPECT().NowUTC().DoAndReturn(func() time.Time { return now }).AnyTimes()
+
+	fetcher := &countingFetcher{ks: ks}
+
+	verifier, err := NewVerifier(&Config{
+		Issuer:             testIssuer,
+		RefreshInterval:    time.Hour,
+		MinRefreshInterval: time.Minute,
+	}, fetcher, clk)
+	require.NoError(t, err)
+
+	token, err := ks.Sign(testClaims(AccessToken, 3*time.Hour))
+	require.NoError(t, err)
+
+	// The first verification populates the cache, the second one is served from it.
+	claims, err := verifier.Verify(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, testSubject, claims.Subject)
+
+	_, err = verifier.Verify(ctx, token)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetcher.calls)
+
+	// A token signed by a key unknown to the cache triggers a refresh once the minimum interval has passed.
+	rotatedKs, err := NewKeySet(testSecret(t), clk, time.Hour, time.Hour)
+	require.NoError(t, err)
+
+	fetcher.ks = rotatedKs
+
+	rotatedToken, err := rotatedKs.Sign(testClaims(AccessToken, 3*time.Hour))
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, rotatedToken)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 1, fetcher.calls)
+
+	now = now.Add(2 * time.Minute)
+
+	_, err = verifier.Verify(ctx, rotatedToken)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetcher.calls)
+
+	// A stale cache keeps serving known keys while the issuer is unavailable.
+	now = now.Add(2 * time.Hour)
+	fetcher.err = errors.New("test err")
+
+	_, err = verifier.Verify(ctx, rotatedToken)
+	require.NoError(t, err)
+	assert.Equal(t, 3, fetcher.calls)
+
+	// The failed refresh backs off, the unavailable issuer is not fetched again until the minimum interval has passed.
+	_, err = verifier.Verify(ctx, rotatedToken)
+	require.NoError(t, err)
+
+	_, err = verifier.Verify(ctx, token)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 3, fetcher.calls)
+
+	now = now.Add(2 * time.Minute)
+
+	_, err = verifier.Verify(ctx, rotatedToken)
+	require.NoError(t, err)
+	assert.Equal(t, 4, fetcher.calls)
+}
@@ -0,0 +1,183 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	algorithm = "EdDSA"
+	typ       = "JWT"
+)
+
+// Token types carried in the "typ" claim.
+const (
+	AccessToken  = "access"
+	RefreshToken = "refresh"
+	// ChallengeToken proves that the password was checked and the second factor is pending.
+	ChallengeToken = "challenge"
+)
+
+// Roles carried in the "roles" claim.
+const (
+	RoleCustomer = "customer"
+	RoleMerchant = "merchant"
+	RoleAdmin    = "admin"
+)
+
+var (
+	ErrMalformedToken   = errors.New("malformed token")
+	ErrUnsupportedAlg   = errors.New("unsupported signing algorithm")
+	ErrInvalidSignature = errors.New("invalid token signature")
+	ErrUnknownKey       = errors.New("unknown signing key")
+	ErrTokenExpired     = errors.New("token expired")
+	ErrTokenNotYetValid = errors.New("token not yet valid")
+	ErrWrongTokenType   = errors.New("wrong token type")
+	ErrWrongIssuer      = errors.New("wrong token issuer")
+)
+
+var encoding = base64.RawURLEncoding
+
+// Claims represents the payload of the tokens issued by the user service.
+type Claims struct {
+	ID        string   `json:"jti"`
+	Issuer    string   `json:"iss,omitempty"`
+	Subject   string   `json:"sub"`
+	Type      string   `json:"typ"`
+	Roles     []string `json:"roles,omitempty"`
+	IssuedAt  int64    `json:"iat"`
+	NotBefore int64    `json:"nbf"`
+	ExpiresAt int64    `json:"exp"`
+}
+
+// HasRole reports whether the claims grant the given role.
+func (c *Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// KeyResolver resolves the public key a token was signed with by its key id.
+type KeyResolver interface {
+	ResolveKey(ctx context.Context, kid string) (ed25519.PublicKey, error)
+}
+
+// VerifyOptions describes the expectations a token must satisfy.
+type VerifyOptions struct {
+	Type   string
+	Issuer string
+	Now    time.Time
+	Leeway time.Duration
+}
+
+func sign(kid string, key ed25519.PrivateKey, claims *Claims) (string, error) {
+	headerData, err := json.Marshal(&header{
+		Alg: algorithm,
+		Typ: typ,
+		Kid: kid,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal header: %w", err)
+	}
+
+	claimsData, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal claims: %w", err)
+	}
+
+	signingInput := encoding.EncodeToString(headerData) + "." + encoding.EncodeToString(claimsData)
+	signature := ed25519.Sign(key, []byte(signingInput))
+
+	return signingInput + "." + encoding.EncodeToString(signature), nil
+}
+
+// Verify checks the token signature with a key obtained from resolver and validates its claims against opts.
+func Verify(ctx context.Context, token string, resolver KeyResolver, opts *VerifyOptions) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	headerData, err := encoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	h := &header{}
+	if err := json.Unmarshal(headerData, h); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if h.Alg != algorithm {
+		return nil, ErrUnsupportedAlg
+	}
+
+	signature, err := encoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	publicKey, err := resolver.ResolveKey(ctx, h.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ed25519.Verify(publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	claimsData, err := encoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	claims := &Claims{}
+	if err := json.Unmarshal(claimsData, claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if err := validate(claims, opts); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func validate(claims *Claims, opts *VerifyOptions) error {
+	if opts.Type != "" && claims.Type != opts.Type {
+		return ErrWrongTokenType
+	}
+
+	if opts.Issuer != "" && claims.Issuer != opts.Issuer {
+		return ErrWrongIssuer
+	}
+
+	now := opts.Now.Unix()
+	leeway := int64(opts.Leeway.Seconds())
+
+	if now > claims.ExpiresAt+leeway {
+		return ErrTokenExpired
+	}
+
+	if now+leeway < claims.NotBefore {
+		return ErrTokenNotYetValid
+	}
+
+	return nil
+}
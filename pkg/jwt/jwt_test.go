@@ -0,0 +1,275 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testIssuer  = "user"
+	testSubject = "85e6a060-f914-48d1-b73a-23b7e6c81f46"
+)
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func keySetHelper(t *testing.T) *KeySet {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	ks, err := NewKeySet(testSecret(t), clk, time.Hour, time.Hour)
+	require.NoError(t, err)
+
+	return ks
+}
+
+func testSecret(t *testing.T) []byte {
+	t.Helper()
+
+	secret := make([]byte, MinSecretSize)
+
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	return secret
+}
+
+func testClaims(typ string, ttl time.Duration) *Claims {
+	return &Claims{
+		ID:        "test-id",
+		Issuer:    testIssuer,
+		Subject:   testSubject,
+		Type:      typ,
+		Roles:     []string{"customer"},
+		IssuedAt:  testNow.Unix(),
+		NotBefore: testNow.Unix(),
+		ExpiresAt: testNow.Add(ttl).Unix(),
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ks := keySetHelper(t)
+	otherKs := keySetHelper(t)
+
+	accessToken, err := ks.Sign(testClaims(AccessToken, time.Minute))
+	require.NoError(t, err)
+
+	refreshToken, err := ks.Sign(testClaims(RefreshToken, time.Hour))
+	require.NoError(t, err)
+
+	foreignToken, err := otherKs.Sign(testClaims(AccessToken, time.Minute))
+	require.NoError(t, err)
+
+	parts := strings.Split(accessToken, ".")
+	tamperedToken := parts[0] + "." + encoding.EncodeToString([]byte(`{"sub":"someone-else","typ":"access","exp":9999999999}`)) + "." + parts[2]
+
+	testcases := []struct {
+		name           string
+		token          string
+		opts           *VerifyOptions
+		expectedClaims *Claims
+		expectedErr    error
+	}{
+		{
+			name:  "Successfully verify access token",
+			token: accessToken,
+			opts: &VerifyOptions{
+				Type:   AccessToken,
+				Issuer: testIssuer,
+				Now:    testNow.Add(30 * time.Second),
+			},
+			expectedClaims: testClaims(AccessToken, time.Minute),
+		},
+		{
+			name:  "Expired token",
+			token: accessToken,
+			opts: &VerifyOptions{
+				Type: AccessToken,
+				Now:  testNow.Add(2 * time.Minute),
+			},
+			expectedErr: ErrTokenExpired,
+		},
+		{
+			name:  "Expired token within leeway",
+			token: accessToken,
+			opts: &VerifyOptions{
+				Type:   AccessToken,
+				Now:    testNow.Add(2 * time.Minute),
+				Leeway: 2 * time.Minute,
+			},
+			expectedClaims: testClaims(AccessToken, time.Minute),
+		},
+		{
+			name:  "Token not yet valid",
+			token: accessToken,
+			opts: &VerifyOptions{
+				Type: AccessToken,
+				Now:  testNow.Add(-time.Minute),
+			},
+			expectedErr: ErrTokenNotYetValid,
+		},
+		{
+			name:  "Refresh token used as access token",
+			token: refreshToken,
+			opts: &VerifyOptions{
+				Type: AccessToken,
+				Now:  testNow,
+			},
+			expectedErr: ErrWrongTokenType,
+		},
+		{
+			name:  "Wrong issuer",
+			token: accessToken,
+			opts: &VerifyOptions{
+				Type:   AccessToken,
+				Issuer: "test-issuer",
+				Now:    testNow,
+			},
+			expectedErr: ErrWrongIssuer,
+		},
+		{
+			name:  "Token signed by unknown key",
+			token: foreignToken,
+			opts: &VerifyOptions{
+				Type: AccessToken,
+				Now:  testNow,
+			},
+			expectedErr: ErrUnknownKey,
+		},
+		{
+			name:  "Tampered payload",
+			token: tamperedToken,
+			opts: &VerifyOptions{
+				Type: AccessToken,
+				Now:  testNow,
+			},
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:  "Malformed token",
+			token: "test-token",
+			opts: &VerifyOptions{
+				Type: AccessToken,
+				Now:  testNow,
+			},
+			expectedErr: ErrMalformedToken,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualClaims, err := Verify(ctx, testcase.token, ks, testcase.opts)
+
+			assert.Equal(t, testcase.expectedClaims, actualClaims)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestKeySetRotate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	clk := mock_clock.NewMockClock(mockCtrl)
+
+	now := testNow
+	clk.EXPECT().NowUTC().DoAndReturn(func() time.Time { return now }).AnyTimes()
+
+	secret := testSecret(t)
+
+	ks, err := NewKeySet(secret, clk, time.Hour, 90*time.Minute)
+	require.NoError(t, err)
+
+	firstToken, err := ks.Sign(testClaims(AccessToken, time.Minute))
+	require.NoError(t, err)
+
+	firstKid := ks.JWKS().Keys[0].Kid
+
+	// The current key, the next one and the two keys within the retention are published.
+	assert.Len(t, ks.JWKS().Keys, 4)
+
+	// Another replica holding the same secret, like a restarted one, derives the same keys.
+	replica, err := NewKeySet(secret, clk, time.Hour, 90*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, ks.JWKS(), replica.JWKS())
+
+	_, err = Verify(ctx, firstToken, replica, &VerifyOptions{Now: testNow})
+	assert.NoError(t, err)
+
+	// The keys are derived once per period.
+	assert.Same(t, ks.keys()[0], ks.keys()[0])
+
+	// The next period the first key is retired but still verifies its tokens.
+	now = now.Add(time.Hour)
+
+	assert.NotEqual(t, firstKid, ks.JWKS().Keys[0].Kid)
+
+	_, err = Verify(ctx, firstToken, ks, &VerifyOptions{Now: testNow})
+	assert.NoError(t, err)
+
+	// The first key has outlived its retention and is dropped.
+	now = now.Add(2 * time.Hour)
+
+	_, err = Verify(ctx, firstToken, ks, &VerifyOptions{Now: testNow})
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestNewKeySet(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		secret      []byte
+		interval    time.Duration
+		expectedErr error
+	}{
+		{
+			name:     "Successfully create key set",
+			secret:   make([]byte, MinSecretSize),
+			interval: time.Hour,
+		},
+		{
+			name:        "Short secret",
+			secret:      make([]byte, MinSecretSize-1),
+			interval:    time.Hour,
+			expectedErr: ErrWeakSecret,
+		},
+		{
+			name:        "Zero interval",
+			secret:      make([]byte, MinSecretSize),
+			expectedErr: ErrInvalidInterval,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewKeySet(testcase.secret, nil, testcase.interval, time.Hour)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
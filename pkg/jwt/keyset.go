@@ -0,0 +1,154 @@
+package jwt
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+)
+
+// MinSecretSize is the minimal size of the secret the signing keys are derived from.
+const MinSecretSize = 32
+
+var (
+	ErrWeakSecret      = errors.New("signing key secret must be at least 32 bytes")
+	ErrInvalidInterval = errors.New("key rotation interval must be positive")
+)
+
+// keyLabel separates the derived signing keys from other uses of the secret.
+var keyLabel = []byte("jwt signing key")
+
+type signingKey struct {
+	id      string
+	private ed25519.PrivateKey
+	public  ed25519.PublicKey
+}
+
+// KeySet derives the Ed25519 keys used to sign tokens from a shared secret, one key per rotation period.
+// Every replica holding the secret derives the same keys, so issued tokens survive restarts
+// and are verified against the JWKS of any replica. The key of the current period signs,
+// the keys of the periods within the retention are kept so that tokens they signed stay verifiable,
+// and the key of the next period is published ahead so that replicas with a clock running ahead are trusted.
+type KeySet struct {
+	secret    []byte
+	clock     clock.Clock
+	interval  time.Duration
+	retention time.Duration
+
+	// The published keys only change with the period, they are derived once per period.
+	mu           sync.Mutex
+	cachedPeriod int64
+	cachedKeys   []*signingKey
+}
+
+// NewKeySet creates a new KeySet deriving its keys from the secret and rotating them every interval.
+func NewKeySet(secret []byte, clk clock.Clock, interval, retention time.Duration) (*KeySet, error) {
+	if len(secret) < MinSecretSize {
+		return nil, ErrWeakSecret
+	}
+
+	if interval <= 0 {
+		return nil, ErrInvalidInterval
+	}
+
+	return &KeySet{
+		secret:    append([]byte(nil), secret...),
+		clock:     clk,
+		interval:  interval,
+		retention: retention,
+	}, nil
+}
+
+// deriveKey derives the key of the rotation period, the key id is its RFC 7638 thumbprint.
+func (ks *KeySet) deriveKey(period int64) *signingKey {
+	mac := hmac.New(sha256.New, ks.secret)
+	mac.Write(keyLabel)
+	_ = binary.Write(mac, binary.BigEndian, period)
+
+	private := ed25519.NewKeyFromSeed(mac.Sum(nil))
+	public := private.Public().(ed25519.PublicKey)
+
+	return &signingKey{
+		id:      thumbprint(public),
+		private: private,
+		public:  public,
+	}
+}
+
+func thumbprint(key ed25519.PublicKey) string {
+	// The members are in the lexicographic order required by RFC 7638.
+	sum := sha256.Sum256([]byte(fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, curve, keyType, encoding.EncodeToString(key))))
+
+	return encoding.EncodeToString(sum[:])
+}
+
+// period returns the rotation period the moment falls in.
+func (ks *KeySet) period(t time.Time) int64 {
+	return t.UnixNano() / int64(ks.interval)
+}
+
+// keys returns the published keys, the current one first, then the next one and the retired ones from the newest.
+// The returned slice is shared and must not be modified.
+func (ks *KeySet) keys() []*signingKey {
+	current := ks.period(ks.clock.NowUTC())
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if ks.cachedKeys != nil && ks.cachedPeriod == current {
+		return ks.cachedKeys
+	}
+
+	// A token signed at the end of a period stays valid for the retention after it.
+	retired := int64((ks.retention + ks.interval - 1) / ks.interval)
+
+	keys := []*signingKey{ks.deriveKey(current), ks.deriveKey(current + 1)}
+	for period := current - 1; period >= current-retired; period-- {
+		keys = append(keys, ks.deriveKey(period))
+	}
+
+	ks.cachedPeriod = current
+	ks.cachedKeys = keys
+
+	return keys
+}
+
+// Sign signs the claims with the key of the current period.
+func (ks *KeySet) Sign(claims *Claims) (string, error) {
+	key := ks.keys()[0]
+
+	return sign(key.id, key.private, claims)
+}
+
+// ResolveKey returns the public key with the given id if it is still published.
+func (ks *KeySet) ResolveKey(_ context.Context, kid string) (ed25519.PublicKey, error) {
+	for _, k := range ks.keys() {
+		if k.id == kid {
+			return k.public, nil
+		}
+	}
+
+	return nil, ErrUnknownKey
+}
+
+// JWKS returns the public part of every published key.
+func (ks *KeySet) JWKS() *JWKS {
+	keys := ks.keys()
+
+	jwks := &JWKS{
+		Keys: make([]JWK, 0, len(keys)),
+	}
+
+	for _, k := range keys {
+		jwks.Keys = append(jwks.Keys, newJWK(k.id, k.public))
+	}
+
+	return jwks
+}
@@ -0,0 +1,104 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Currency describes a currency and the minor unit its amounts are counted in.
+type Currency struct {
+	// Code is the ISO 4217 alphabetic code, or the ticker of a crypto currency.
+	Code string
+	// Numeric is the ISO 4217 numeric code, it is empty for currencies outside of ISO 4217.
+	Numeric string
+	// Exponent is the number of decimals between the major and the minor unit.
+	Exponent int32
+}
+
+// ALGO is the native Algorand currency, its minor unit is the microAlgo.
+var ALGO = Currency{Code: "ALGO", Exponent: 6}
+
+// currencies is the registry of supported currencies keyed by code.
+var currencies = registry(
+	ALGO,
+	Currency{Code: "AED", Numeric: "784", Exponent: 2},
+	Currency{Code: "AMD", Numeric: "051", Exponent: 2},
+	Currency{Code: "AUD", Numeric: "036", Exponent: 2},
+	Currency{Code: "AZN", Numeric: "944", Exponent: 2},
+	Currency{Code: "BHD", Numeric: "048", Exponent: 3},
+	Currency{Code: "BRL", Numeric: "986", Exponent: 2},
+	Currency{Code: "BYN", Numeric: "933", Exponent: 2},
+	Currency{Code: "CAD", Numeric: "124", Exponent: 2},
+	Currency{Code: "CHF", Numeric: "756", Exponent: 2},
+	Currency{Code: "CLF", Numeric: "990", Exponent: 4},
+	Currency{Code: "CLP", Numeric: "152", Exponent: 0},
+	Currency{Code: "CNY", Numeric: "156", Exponent: 2},
+	Currency{Code: "CZK", Numeric: "203", Exponent: 2},
+	Currency{Code: "DKK", Numeric: "208", Exponent: 2},
+	Currency{Code: "EUR", Numeric: "978", Exponent: 2},
+	Currency{Code: "GBP", Numeric: "826", Exponent: 2},
+	Currency{Code: "GEL", Numeric: "981", Exponent: 2},
+	Currency{Code: "HKD", Numeric: "344", Exponent: 2},
+	Currency{Code: "HUF", Numeric: "348", Exponent: 2},
+	Currency{Code: "ILS", Numeric: "376", Exponent: 2},
+	Currency{Code: "INR", Numeric: "356", Exponent: 2},
+	Currency{Code: "IQD", Numeric: "368", Exponent: 3},
+	Currency{Code: "ISK", Numeric: "352", Exponent: 0},
+	Currency{Code: "JOD", Numeric: "400", Exponent: 3},
+	Currency{Code: "JPY", Numeric: "392", Exponent: 0},
+	Currency{Code: "KGS", Numeric: "417", Exponent: 2},
+	Currency{Code: "KRW", Numeric: "410", Exponent: 0},
+	Currency{Code: "KWD", Numeric: "414", Exponent: 3},
+	Currency{Code: "KZT", Numeric: "398", Exponent: 2},
+	Currency{Code: "LYD", Numeric: "434", Exponent: 3},
+	Currency{Code: "MXN", Numeric: "484", Exponent: 2},
+	Currency{Code: "NOK", Numeric: "578", Exponent: 2},
+	Currency{Code: "OMR", Numeric: "512", Exponent: 3},
+	Currency{Code: "PLN", Numeric: "985", Exponent: 2},
+	Currency{Code: "RUB", Numeric: "643", Exponent: 2},
+	Currency{Code: "SAR", Numeric: "682", Exponent: 2},
+	Currency{Code: "SEK", Numeric: "752", Exponent: 2},
+	Currency{Code: "SGD", Numeric: "702", Exponent: 2},
+	Currency{Code: "TND", Numeric: "788", Exponent: 3},
+	Currency{Code: "TRY", Numeric: "949", Exponent: 2},
+	Currency{Code: "UAH", Numeric: "980", Exponent: 2},
+	Currency{Code: "USD", Numeric: "840", Exponent: 2},
+	Currency{Code: "UYW", Numeric: "927", Exponent: 4},
+	Currency{Code: "UZS", Numeric: "860", Exponent: 2},
+	Currency{Code: "VND", Numeric: "704", Exponent: 0},
+	Currency{Code: "ZAR", Numeric: "710", Exponent: 2},
+)
+
+func registry(list ...Currency) map[string]Currency {
+	byCode := make(map[string]Currency, len(list))
+	for _, currency := range list {
+		byCode[currency.Code] = currency
+	}
+
+	return byCode
+}
+
+// LookupCurrency returns the registered currency with the code, the code is case-insensitive.
+func LookupCurrency(code string) (Currency, error) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+
+	return currency, nil
+}
+
+// LookupNumeric returns the registered currency with the ISO 4217 numeric code.
+func LookupNumeric(numeric string) (Currency, error) {
+	for _, currency := range currencies {
+		if currency.Numeric != "" && currency.Numeric == numeric {
+			return currency, nil
+		}
+	}
+
+	return Currency{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, numeric)
+}
+
+func (c Currency) String() string {
+	return c.Code
+}
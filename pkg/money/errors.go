@@ -0,0 +1,14 @@
+package money
+
+import "errors"
+
+var (
+	// ErrUnknownCurrency is returned when a currency code is not registered.
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrInvalidAmount is returned when an amount is not a decimal number.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrPrecisionLoss is returned when an amount has more decimals than its unit can hold.
+	ErrPrecisionLoss = errors.New("amount does not fit the unit precision")
+	// ErrOverflow is returned when an amount does not fit into 64 bits of its unit.
+	ErrOverflow = errors.New("amount overflows")
+)
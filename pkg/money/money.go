@@ -0,0 +1,110 @@
+// Package money represents amounts as integer counts of the currency minor unit,
+// for example cents for USD or microAlgos for ALGO.
+package money
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Money is an amount in the minor unit of its currency.
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+// New creates Money from an amount in the minor unit of the currency with the code.
+func New(amount int64, code string) (Money, error) {
+	currency, err := LookupCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// Parse creates Money from a decimal amount in the major unit of the currency with the code,
+// "12.34" USD is 1234 cents. The amount may not have more decimals than the currency exponent.
+func Parse(amount, code string) (Money, error) {
+	currency, err := LookupCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	minor, err := toInt64(value.Shift(currency.Exponent))
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %s %s", err, amount, currency.Code)
+	}
+
+	return Money{amount: minor, currency: currency}, nil
+}
+
+// Amount returns the amount in the minor unit of the currency.
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency returns the currency of the amount.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Decimal formats the amount in the major unit with exactly the currency exponent decimals,
+// 1234 cents is "12.34".
+func (m Money) Decimal() string {
+	return m.decimal().StringFixed(m.currency.Exponent)
+}
+
+// String formats the amount with its currency code, like "12.34 USD".
+func (m Money) String() string {
+	return m.Decimal() + " " + m.currency.Code
+}
+
+// Units converts the amount into a unit with the given exponent, a payment gateway base unit for example.
+// It fails rather than rounds when the unit cannot represent the amount exactly.
+func (m Money) Units(exponent int32) (int64, error) {
+	units, err := toInt64(m.decimal().Shift(exponent))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s to exponent %d", err, m, exponent)
+	}
+
+	return units, nil
+}
+
+// Convert converts the amount into the currency at the rate, the price of one major unit in the currency.
+// The result is rounded up to the minor unit of the currency so that a conversion never falls short.
+func (m Money) Convert(currency Currency, rate decimal.Decimal) (Money, error) {
+	if !rate.IsPositive() {
+		return Money{}, fmt.Errorf("%w: rate %s", ErrInvalidAmount, rate)
+	}
+
+	converted, err := toInt64(m.decimal().Mul(rate).RoundUp(currency.Exponent).Shift(currency.Exponent))
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %s at rate %s to %s", err, m, rate, currency)
+	}
+
+	return Money{amount: converted, currency: currency}, nil
+}
+
+func (m Money) decimal() decimal.Decimal {
+	return decimal.New(m.amount, -m.currency.Exponent)
+}
+
+func toInt64(value decimal.Decimal) (int64, error) {
+	if !value.IsInteger() {
+		return 0, ErrPrecisionLoss
+	}
+
+	integer := value.BigInt()
+	if !integer.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	return integer.Int64(), nil
+}
@@ -0,0 +1,355 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		code             string
+		expectedCurrency Currency
+		expectedErr      error
+	}{
+		{
+			name:             "ISO 4217 currency",
+			code:             "USD",
+			expectedCurrency: Currency{Code: "USD", Numeric: "840", Exponent: 2},
+		},
+		{
+			name:             "Lower case code",
+			code:             "jpy",
+			expectedCurrency: Currency{Code: "JPY", Numeric: "392", Exponent: 0},
+		},
+		{
+			name:             "Crypto currency",
+			code:             "ALGO",
+			expectedCurrency: ALGO,
+		},
+		{
+			name:        "Unknown currency",
+			code:        "string",
+			expectedErr: ErrUnknownCurrency,
+		},
+		{
+			name:        "Empty code",
+			code:        "",
+			expectedErr: ErrUnknownCurrency,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			currency, err := LookupCurrency(testcase.code)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedCurrency, currency)
+		})
+	}
+}
+
+func TestLookupNumeric(t *testing.T) {
+	t.Parallel()
+
+	currency, err := LookupNumeric("398")
+	require.NoError(t, err)
+	assert.Equal(t, "KZT", currency.Code)
+
+	_, err = LookupNumeric("")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		amount         string
+		code           string
+		expectedAmount int64
+		expectedErr    error
+	}{
+		{
+			name:           "Two decimals",
+			amount:         "12.34",
+			code:           "USD",
+			expectedAmount: 1234,
+		},
+		{
+			name:           "Fewer decimals than exponent",
+			amount:         "12.3",
+			code:           "KWD",
+			expectedAmount: 12300,
+		},
+		{
+			name:           "Whole units",
+			amount:         "500",
+			code:           "JPY",
+			expectedAmount: 500,
+		},
+		{
+			name:           "Microalgos",
+			amount:         "0.000001",
+			code:           "ALGO",
+			expectedAmount: 1,
+		},
+		{
+			name:           "Trailing zeros beyond exponent",
+			amount:         "1.500",
+			code:           "EUR",
+			expectedAmount: 150,
+		},
+		{
+			name:        "Too many decimals",
+			amount:      "0.005",
+			code:        "USD",
+			expectedErr: ErrPrecisionLoss,
+		},
+		{
+			name:        "Decimals for zero exponent",
+			amount:      "1.5",
+			code:        "JPY",
+			expectedErr: ErrPrecisionLoss,
+		},
+		{
+			name:        "Overflow",
+			amount:      "92233720368547758.08",
+			code:        "USD",
+			expectedErr: ErrOverflow,
+		},
+		{
+			name:        "Not a number",
+			amount:      "ten",
+			code:        "USD",
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Unknown currency",
+			amount:      "1",
+			code:        "XYZ",
+			expectedErr: ErrUnknownCurrency,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := Parse(testcase.amount, testcase.code)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedAmount, m.Amount())
+		})
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		amount          int64
+		code            string
+		expectedDecimal string
+	}{
+		{
+			name:            "Cents",
+			amount:          1234,
+			code:            "USD",
+			expectedDecimal: "12.34",
+		},
+		{
+			name:            "Below one",
+			amount:          5,
+			code:            "EUR",
+			expectedDecimal: "0.05",
+		},
+		{
+			name:            "Zero exponent",
+			amount:          500,
+			code:            "JPY",
+			expectedDecimal: "500",
+		},
+		{
+			name:            "Three decimals",
+			amount:          1000,
+			code:            "BHD",
+			expectedDecimal: "1.000",
+		},
+		{
+			name:            "Negative",
+			amount:          -150,
+			code:            "GBP",
+			expectedDecimal: "-1.50",
+		},
+		{
+			name:            "Microalgos",
+			amount:          2500000,
+			code:            "ALGO",
+			expectedDecimal: "2.500000",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(testcase.amount, testcase.code)
+			require.NoError(t, err)
+
+			assert.Equal(t, testcase.expectedDecimal, m.Decimal())
+			assert.Equal(t, testcase.expectedDecimal+" "+m.Currency().Code, m.String())
+
+			parsed, err := Parse(m.Decimal(), testcase.code)
+			require.NoError(t, err)
+			assert.Equal(t, m, parsed)
+		})
+	}
+}
+
+func TestUnits(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		amount        int64
+		code          string
+		exponent      int32
+		expectedUnits int64
+		expectedErr   error
+	}{
+		{
+			name:          "Same exponent",
+			amount:        1500000,
+			code:          "ALGO",
+			exponent:      6,
+			expectedUnits: 1500000,
+		},
+		{
+			name:          "Finer unit",
+			amount:        1234,
+			code:          "USD",
+			exponent:      6,
+			expectedUnits: 12340000,
+		},
+		{
+			name:          "Coarser unit",
+			amount:        3000000,
+			code:          "ALGO",
+			exponent:      0,
+			expectedUnits: 3,
+		},
+		{
+			name:        "Coarser unit loses precision",
+			amount:      1500000,
+			code:        "ALGO",
+			exponent:    0,
+			expectedErr: ErrPrecisionLoss,
+		},
+		{
+			name:        "Finer unit overflows",
+			amount:      math.MaxInt64,
+			code:        "JPY",
+			exponent:    2,
+			expectedErr: ErrOverflow,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(testcase.amount, testcase.code)
+			require.NoError(t, err)
+
+			units, err := m.Units(testcase.exponent)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedUnits, units)
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		amount         int64
+		code           string
+		currency       Currency
+		rate           string
+		expectedAmount int64
+		expectedErr    error
+	}{
+		{
+			name:           "Fiat to crypto",
+			amount:         150000,
+			code:           "RUB",
+			currency:       ALGO,
+			rate:           "0.0625",
+			expectedAmount: 93750000,
+		},
+		{
+			name:           "Rounded up to the minor unit",
+			amount:         1,
+			code:           "USD",
+			currency:       Currency{Code: "JPY", Numeric: "392", Exponent: 0},
+			rate:           "151.37",
+			expectedAmount: 2,
+		},
+		{
+			name:        "Not positive rate",
+			amount:      100,
+			code:        "USD",
+			currency:    ALGO,
+			rate:        "0",
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Overflow",
+			amount:      math.MaxInt64,
+			code:        "JPY",
+			currency:    ALGO,
+			rate:        "2",
+			expectedErr: ErrOverflow,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			m, err := New(testcase.amount, testcase.code)
+			require.NoError(t, err)
+
+			converted, err := m.Convert(testcase.currency, decimal.RequireFromString(testcase.rate))
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedAmount, converted.Amount())
+
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.currency, converted.Currency())
+			}
+		})
+	}
+}
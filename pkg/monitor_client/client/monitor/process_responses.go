@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/ShmelJUJ/software-engineering/pkg/monitor_client/models"
 )
@@ -35,12 +37,24 @@ func (o *ProcessReader) ReadResponse(response runtime.ClientResponse, consumer r
 			return nil, err
 		}
 		return nil, result
+	case 401:
+		result := NewProcessUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 403:
 		result := NewProcessForbidden()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 423:
+		result := NewProcessLocked()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewProcessInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -186,6 +200,74 @@ func (o *ProcessBadRequest) readResponse(response runtime.ClientResponse, consum
 	return nil
 }
 
+// NewProcessUnauthorized creates a ProcessUnauthorized with default headers values
+func NewProcessUnauthorized() *ProcessUnauthorized {
+	return &ProcessUnauthorized{}
+}
+
+/*
+ProcessUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized error.
+*/
+type ProcessUnauthorized struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this process unauthorized response has a 2xx status code
+func (o *ProcessUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this process unauthorized response has a 3xx status code
+func (o *ProcessUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this process unauthorized response has a 4xx status code
+func (o *ProcessUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this process unauthorized response has a 5xx status code
+func (o *ProcessUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this process unauthorized response a status code equal to that given
+func (o *ProcessUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the process unauthorized response
+func (o *ProcessUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ProcessUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /monitor/process][%d] processUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ProcessUnauthorized) String() string {
+	return fmt.Sprintf("[POST /monitor/process][%d] processUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *ProcessUnauthorized) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ProcessUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewProcessForbidden creates a ProcessForbidden with default headers values
 func NewProcessForbidden() *ProcessForbidden {
 	return &ProcessForbidden{}
@@ -254,6 +336,92 @@ func (o *ProcessForbidden) readResponse(response runtime.ClientResponse, consume
 	return nil
 }
 
+// NewProcessLocked creates a ProcessLocked with default headers values
+func NewProcessLocked() *ProcessLocked {
+	return &ProcessLocked{}
+}
+
+/*
+ProcessLocked describes a response with status code 423, with default header values.
+
+Locked error.
+*/
+type ProcessLocked struct {
+
+	/* Seconds until the lock ends.
+
+	   Format: int64
+	*/
+	RetryAfter int64
+
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this process locked response has a 2xx status code
+func (o *ProcessLocked) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this process locked response has a 3xx status code
+func (o *ProcessLocked) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this process locked response has a 4xx status code
+func (o *ProcessLocked) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this process locked response has a 5xx status code
+func (o *ProcessLocked) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this process locked response a status code equal to that given
+func (o *ProcessLocked) IsCode(code int) bool {
+	return code == 423
+}
+
+// Code gets the status code for the process locked response
+func (o *ProcessLocked) Code() int {
+	return 423
+}
+
+func (o *ProcessLocked) Error() string {
+	return fmt.Sprintf("[POST /monitor/process][%d] processLocked  %+v", 423, o.Payload)
+}
+
+func (o *ProcessLocked) String() string {
+	return fmt.Sprintf("[POST /monitor/process][%d] processLocked  %+v", 423, o.Payload)
+}
+
+func (o *ProcessLocked) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ProcessLocked) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Retry-After
+	hdrRetryAfter := response.GetHeader("Retry-After")
+
+	if hdrRetryAfter != "" {
+		valretryAfter, err := swag.ConvertInt64(hdrRetryAfter)
+		if err != nil {
+			return errors.InvalidType("Retry-After", "header", "int64", hdrRetryAfter)
+		}
+		o.RetryAfter = valretryAfter
+	}
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewProcessInternalServerError creates a ProcessInternalServerError with default headers values
 func NewProcessInternalServerError() *ProcessInternalServerError {
 	return &ProcessInternalServerError{}
@@ -0,0 +1,50 @@
+// Package paynote formats the notes the payment gateway writes into on-chain payments,
+// so that a payment can be traced back to the transaction it paid.
+package paynote
+
+import (
+	"bytes"
+	"strings"
+)
+
+// prefix starts every note written by the platform.
+const prefix = "qrpay:"
+
+// Kind tells what a payment did for the transaction.
+type Kind string
+
+const (
+	// Payment pays the transaction value, or a leg of it, to a receiver.
+	Payment Kind = "pay"
+	// Hold moves the authorized value from the payer into the escrow account of the transaction.
+	Hold Kind = "hold"
+	// Release returns what is left in the escrow account to the payer.
+	Release Kind = "release"
+)
+
+// New returns the note of a payment made for the transaction, it is also the prefix
+// the payments of the transaction are searched by.
+func New(kind Kind, transactionID string) []byte {
+	return []byte(prefix + string(kind) + ":" + transactionID)
+}
+
+// Parse returns the kind and the transaction of a note written by New.
+// It returns false for notes written by anyone else.
+func Parse(note []byte) (Kind, string, bool) {
+	rest, ok := bytes.CutPrefix(note, []byte(prefix))
+	if !ok {
+		return "", "", false
+	}
+
+	kind, transactionID, ok := strings.Cut(string(rest), ":")
+	if !ok || transactionID == "" {
+		return "", "", false
+	}
+
+	switch Kind(kind) {
+	case Payment, Hold, Release:
+		return Kind(kind), transactionID, true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,64 @@
+package paynote_test
+
+import (
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                  string
+		note                  []byte
+		expectedKind          paynote.Kind
+		expectedTransactionID string
+		expectedOK            bool
+	}{
+		{
+			name:                  "Payment note",
+			note:                  paynote.New(paynote.Payment, "85e6a060-f914-48d1-b73a-23b7e6c81f46"),
+			expectedKind:          paynote.Payment,
+			expectedTransactionID: "85e6a060-f914-48d1-b73a-23b7e6c81f46",
+			expectedOK:            true,
+		},
+		{
+			name:                  "Release note",
+			note:                  []byte("qrpay:release:test-transaction-id"),
+			expectedKind:          paynote.Release,
+			expectedTransactionID: "test-transaction-id",
+			expectedOK:            true,
+		},
+		{
+			name: "Foreign note",
+			note: []byte("invoice 42"),
+		},
+		{
+			name: "Unknown kind",
+			note: []byte("qrpay:tip:test-transaction-id"),
+		},
+		{
+			name: "Without transaction",
+			note: []byte("qrpay:pay:"),
+		},
+		{
+			name: "Empty note",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, transactionID, ok := paynote.Parse(testcase.note)
+
+			assert.Equal(t, testcase.expectedOK, ok)
+			assert.Equal(t, testcase.expectedKind, kind)
+			assert.Equal(t, testcase.expectedTransactionID, transactionID)
+		})
+	}
+}
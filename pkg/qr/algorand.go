@@ -0,0 +1,100 @@
+package qr
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const (
+	// AlgorandScheme is the URI scheme of the Algorand payment payload.
+	AlgorandScheme = "algorand"
+
+	xnoteKey = "xnote"
+)
+
+// ErrInvalidReceiver is returned when the receiver of an Algorand payment payload is not an Algorand address.
+var ErrInvalidReceiver = errors.New("invalid payload receiver")
+
+// AlgorandPayload represents a payment request wallets pay from the payer account, see ARC-26.
+// Amount is counted in microAlgos. The note is sent as xnote, which wallets do not let the payer edit.
+type AlgorandPayload struct {
+	Receiver string
+	Amount   uint64
+	Note     []byte
+}
+
+// EncodeAlgorand validates the payload and formats it as an algorand URI, e.g.
+// algorand://<receiver address>?amount=150000&xnote=qrpay%3Apay%3A<transaction id>.
+func EncodeAlgorand(payload *AlgorandPayload) (string, error) {
+	if err := payload.validate(); err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set(amountKey, strconv.FormatUint(payload.Amount, 10))
+	values.Set(xnoteKey, string(payload.Note))
+
+	uri := url.URL{
+		Scheme:   AlgorandScheme,
+		Host:     payload.Receiver,
+		RawQuery: values.Encode(),
+	}
+
+	return uri.String(), nil
+}
+
+// ParseAlgorand decodes an algorand URI produced by EncodeAlgorand.
+func ParseAlgorand(content string) (*AlgorandPayload, error) {
+	uri, err := url.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payload: %w", err)
+	}
+
+	if uri.Scheme != AlgorandScheme {
+		return nil, ErrInvalidScheme
+	}
+
+	values := uri.Query()
+
+	rawAmount := values.Get(amountKey)
+	if rawAmount == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingField, amountKey)
+	}
+
+	amount, err := strconv.ParseUint(rawAmount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAmount, rawAmount)
+	}
+
+	payload := &AlgorandPayload{
+		Receiver: uri.Host,
+		Amount:   amount,
+		Note:     []byte(values.Get(xnoteKey)),
+	}
+
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func (p *AlgorandPayload) validate() error {
+	if _, err := types.DecodeAddress(p.Receiver); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidReceiver, p.Receiver)
+	}
+
+	if p.Amount == 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, p.Amount)
+	}
+
+	if len(p.Note) == 0 {
+		return fmt.Errorf("%w: %s", ErrMissingField, xnoteKey)
+	}
+
+	return nil
+}
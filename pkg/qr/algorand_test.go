@@ -0,0 +1,119 @@
+package qr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testReceiver = "5JFGYY7CTRJAVPXVKB5RGLWF7GKUO5VOX27HXESCD3VGSFCG2IWAKDM5YU"
+
+func testAlgorandPayload() *AlgorandPayload {
+	return &AlgorandPayload{
+		Receiver: testReceiver,
+		Amount:   150000,
+		Note:     []byte("qrpay:pay:85e6a060-f914-48d1-b73a-23b7e6c81f46"),
+	}
+}
+
+func TestEncodeParseAlgorand(t *testing.T) {
+	t.Parallel()
+
+	payload := testAlgorandPayload()
+
+	content, err := EncodeAlgorand(payload)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"algorand://"+testReceiver+"?amount=150000&xnote=qrpay%3Apay%3A85e6a060-f914-48d1-b73a-23b7e6c81f46",
+		content,
+	)
+
+	parsed, err := ParseAlgorand(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, payload, parsed)
+}
+
+func TestEncodeInvalidAlgorandPayload(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		modify      func(*AlgorandPayload)
+		expectedErr error
+	}{
+		{
+			name:        "Malformed receiver",
+			modify:      func(p *AlgorandPayload) { p.Receiver = "receiver" },
+			expectedErr: ErrInvalidReceiver,
+		},
+		{
+			name:        "Zero amount",
+			modify:      func(p *AlgorandPayload) { p.Amount = 0 },
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Missing note",
+			modify:      func(p *AlgorandPayload) { p.Note = nil },
+			expectedErr: ErrMissingField,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := testAlgorandPayload()
+			testcase.modify(payload)
+
+			_, err := EncodeAlgorand(payload)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestParseInvalidAlgorandContent(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		content     string
+		expectedErr error
+	}{
+		{
+			name:        "Foreign scheme",
+			content:     "qrpay://" + testReceiver + "?amount=1&xnote=note",
+			expectedErr: ErrInvalidScheme,
+		},
+		{
+			name:        "Missing amount",
+			content:     "algorand://" + testReceiver + "?xnote=note",
+			expectedErr: ErrMissingField,
+		},
+		{
+			name:        "Negative amount",
+			content:     "algorand://" + testReceiver + "?amount=-1&xnote=note",
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Missing note",
+			content:     "algorand://" + testReceiver + "?amount=1",
+			expectedErr: ErrMissingField,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseAlgorand(testcase.content)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package emvco
+
+import "fmt"
+
+const (
+	crcPolynomial = 0x1021
+	crcInitial    = 0xFFFF
+)
+
+// crc16 computes the CRC-16/CCITT-FALSE checksum required by EMVCo for tag 63.
+func crc16(data []byte) uint16 {
+	crc := uint16(crcInitial)
+
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ crcPolynomial
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+func formatCRC(crc uint16) string {
+	return fmt.Sprintf("%04X", crc)
+}
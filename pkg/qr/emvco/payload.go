@@ -0,0 +1,432 @@
+package emvco
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PointOfInitiation tells whether a code is reused for many payments or issued for one.
+type PointOfInitiation string
+
+const (
+	// Static codes are printed once and the payer enters the amount.
+	Static PointOfInitiation = "11"
+	// Dynamic codes are issued per transaction and carry its amount.
+	Dynamic PointOfInitiation = "12"
+)
+
+const (
+	tagPayloadFormat       = "00"
+	tagPointOfInitiation   = "01"
+	tagMerchantAccountMin  = 26
+	tagMerchantAccountMax  = 51
+	tagMerchantCategory    = "52"
+	tagTransactionCurrency = "53"
+	tagTransactionAmount   = "54"
+	tagCountryCode         = "58"
+	tagMerchantName        = "59"
+	tagMerchantCity        = "60"
+	tagPostalCode          = "61"
+	tagAdditionalData      = "62"
+	tagCRC                 = "63"
+
+	subTagGloballyUniqueID = "00"
+	subTagBillNumber       = "01"
+	subTagReferenceLabel   = "05"
+	subTagTerminalLabel    = "07"
+	subTagPurpose          = "08"
+
+	payloadFormatVersion = "01"
+	crcFieldSize         = tagSize + lengthSize + 4
+	maxPayloadSize       = 512
+
+	maxMerchantNameSize   = 25
+	maxMerchantCitySize   = 15
+	maxPostalCodeSize     = 10
+	maxAdditionalDataSize = 25
+	maxAmountSize         = 13
+)
+
+var (
+	ErrMalformed     = errors.New("malformed emvco payload")
+	ErrInvalidCRC    = errors.New("invalid emvco checksum")
+	ErrMissingTag    = errors.New("missing mandatory emvco tag")
+	ErrInvalidValue  = errors.New("invalid emvco value")
+	ErrPayloadTooBig = errors.New("emvco payload is longer than 512 characters")
+)
+
+var (
+	amountPattern  = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+	countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+// MerchantAccount is a merchant account information template (tags 26-51).
+type MerchantAccount struct {
+	// GloballyUniqueID identifies the payment network the remaining fields belong to, e.g. a reverse domain name.
+	GloballyUniqueID string
+	// Fields holds the network specific sub-tags 01-99.
+	Fields map[string]string
+}
+
+// AdditionalData is the additional data field template (tag 62).
+type AdditionalData struct {
+	BillNumber     string
+	ReferenceLabel string
+	TerminalLabel  string
+	Purpose        string
+}
+
+// Payload represents an EMVCo merchant-presented mode QR payload.
+type Payload struct {
+	PointOfInitiation PointOfInitiation
+	// MerchantAccounts maps a template tag between "26" and "51" to its content.
+	MerchantAccounts     map[string]MerchantAccount
+	MerchantCategoryCode string
+	// TransactionCurrency is the ISO 4217 numeric currency code.
+	TransactionCurrency string
+	// TransactionAmount is a decimal amount in major units, mandatory for dynamic codes only.
+	TransactionAmount string
+	CountryCode       string
+	MerchantName      string
+	MerchantCity      string
+	PostalCode        string
+	AdditionalData    *AdditionalData
+}
+
+// Encode validates the payload and formats it as TLV data objects terminated by the CRC (tag 63).
+func Encode(payload *Payload) (string, error) {
+	if err := payload.validate(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+
+	fields := []field{
+		{tag: tagPayloadFormat, value: payloadFormatVersion},
+		{tag: tagPointOfInitiation, value: string(payload.PointOfInitiation)},
+	}
+
+	for _, f := range fields {
+		if err := writeField(&b, f.tag, f.value); err != nil {
+			return "", err
+		}
+	}
+
+	accountTags := make([]string, 0, len(payload.MerchantAccounts))
+	for tag := range payload.MerchantAccounts {
+		accountTags = append(accountTags, tag)
+	}
+
+	sort.Strings(accountTags)
+
+	for _, tag := range accountTags {
+		account := payload.MerchantAccounts[tag]
+
+		template := map[string]string{subTagGloballyUniqueID: account.GloballyUniqueID}
+		for subTag, value := range account.Fields {
+			template[subTag] = value
+		}
+
+		if err := writeTemplate(&b, tag, template); err != nil {
+			return "", err
+		}
+	}
+
+	fields = []field{
+		{tag: tagMerchantCategory, value: payload.MerchantCategoryCode},
+		{tag: tagTransactionCurrency, value: payload.TransactionCurrency},
+		{tag: tagTransactionAmount, value: payload.TransactionAmount},
+		{tag: tagCountryCode, value: payload.CountryCode},
+		{tag: tagMerchantName, value: payload.MerchantName},
+		{tag: tagMerchantCity, value: payload.MerchantCity},
+		{tag: tagPostalCode, value: payload.PostalCode},
+	}
+
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+
+		if err := writeField(&b, f.tag, f.value); err != nil {
+			return "", err
+		}
+	}
+
+	if additionalData := payload.AdditionalData.fields(); len(additionalData) > 0 {
+		if err := writeTemplate(&b, tagAdditionalData, additionalData); err != nil {
+			return "", err
+		}
+	}
+
+	b.WriteString(tagCRC + "04")
+	b.WriteString(formatCRC(crc16([]byte(b.String()))))
+
+	if b.Len() > maxPayloadSize {
+		return "", ErrPayloadTooBig
+	}
+
+	return b.String(), nil
+}
+
+// Decode verifies the checksum and mandatory tags and parses the payload.
+// Tags this package does not model, e.g. tip indicators or network specific accounts 02-25, are skipped.
+func Decode(data string) (*Payload, error) {
+	if len(data) > maxPayloadSize {
+		return nil, ErrPayloadTooBig
+	}
+
+	if len(data) < crcFieldSize || data[len(data)-crcFieldSize:len(data)-4] != tagCRC+"04" {
+		return nil, fmt.Errorf("%w: payload must end with the checksum", ErrMissingTag)
+	}
+
+	checksum := data[len(data)-4:]
+	if !strings.EqualFold(checksum, formatCRC(crc16([]byte(data[:len(data)-4])))) {
+		return nil, ErrInvalidCRC
+	}
+
+	fields, err := parseFields(data[:len(data)-crcFieldSize])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 || fields[0].tag != tagPayloadFormat || fields[0].value != payloadFormatVersion {
+		return nil, fmt.Errorf("%w: payload must start with the payload format indicator", ErrMissingTag)
+	}
+
+	payload := &Payload{
+		PointOfInitiation: Static,
+	}
+	seen := make(map[string]bool, len(fields))
+
+	for _, f := range fields[1:] {
+		if seen[f.tag] {
+			return nil, fmt.Errorf("%w: repeated tag %s", ErrMalformed, f.tag)
+		}
+
+		seen[f.tag] = true
+
+		if err := payload.setField(f); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func (p *Payload) setField(f field) error {
+	switch f.tag {
+	case tagPayloadFormat, tagCRC:
+		return fmt.Errorf("%w: unexpected tag %s", ErrMalformed, f.tag)
+	case tagPointOfInitiation:
+		p.PointOfInitiation = PointOfInitiation(f.value)
+	case tagMerchantCategory:
+		p.MerchantCategoryCode = f.value
+	case tagTransactionCurrency:
+		p.TransactionCurrency = f.value
+	case tagTransactionAmount:
+		p.TransactionAmount = f.value
+	case tagCountryCode:
+		p.CountryCode = f.value
+	case tagMerchantName:
+		p.MerchantName = f.value
+	case tagMerchantCity:
+		p.MerchantCity = f.value
+	case tagPostalCode:
+		p.PostalCode = f.value
+	case tagAdditionalData:
+		template, err := parseTemplate(f.tag, f.value)
+		if err != nil {
+			return err
+		}
+
+		p.AdditionalData = additionalDataFromFields(template)
+	default:
+		if !isMerchantAccountTag(f.tag) {
+			return nil
+		}
+
+		template, err := parseTemplate(f.tag, f.value)
+		if err != nil {
+			return err
+		}
+
+		account := MerchantAccount{GloballyUniqueID: template[subTagGloballyUniqueID]}
+		delete(template, subTagGloballyUniqueID)
+
+		if len(template) > 0 {
+			account.Fields = template
+		}
+
+		if p.MerchantAccounts == nil {
+			p.MerchantAccounts = make(map[string]MerchantAccount)
+		}
+
+		p.MerchantAccounts[f.tag] = account
+	}
+
+	return nil
+}
+
+func (p *Payload) validate() error {
+	switch p.PointOfInitiation {
+	case Static:
+	case Dynamic:
+		if p.TransactionAmount == "" {
+			return fmt.Errorf("%w: dynamic code without transaction amount", ErrMissingTag)
+		}
+	default:
+		return fmt.Errorf("%w: point of initiation %q", ErrInvalidValue, p.PointOfInitiation)
+	}
+
+	if len(p.MerchantAccounts) == 0 {
+		return fmt.Errorf("%w: merchant account information", ErrMissingTag)
+	}
+
+	for tag, account := range p.MerchantAccounts {
+		if !isMerchantAccountTag(tag) {
+			return fmt.Errorf("%w: merchant account tag %q is not between 26 and 51", ErrInvalidValue, tag)
+		}
+
+		if account.GloballyUniqueID == "" {
+			return fmt.Errorf("%w: globally unique identifier of merchant account %s", ErrMissingTag, tag)
+		}
+
+		if err := validateText(tag+"."+subTagGloballyUniqueID, account.GloballyUniqueID, maxLength); err != nil {
+			return err
+		}
+
+		for subTag, value := range account.Fields {
+			if len(subTag) != tagSize || !isDigits(subTag) || subTag == subTagGloballyUniqueID {
+				return fmt.Errorf("%w: merchant account %s sub-tag %q", ErrInvalidValue, tag, subTag)
+			}
+
+			if err := validateText(tag+"."+subTag, value, maxLength); err != nil {
+				return err
+			}
+		}
+	}
+
+	mandatory := []struct {
+		tag   string
+		value string
+	}{
+		{tag: tagMerchantCategory, value: p.MerchantCategoryCode},
+		{tag: tagTransactionCurrency, value: p.TransactionCurrency},
+		{tag: tagCountryCode, value: p.CountryCode},
+		{tag: tagMerchantName, value: p.MerchantName},
+		{tag: tagMerchantCity, value: p.MerchantCity},
+	}
+
+	for _, m := range mandatory {
+		if m.value == "" {
+			return fmt.Errorf("%w: %s", ErrMissingTag, m.tag)
+		}
+	}
+
+	if len(p.MerchantCategoryCode) != 4 || !isDigits(p.MerchantCategoryCode) {
+		return fmt.Errorf("%w: merchant category code %q", ErrInvalidValue, p.MerchantCategoryCode)
+	}
+
+	if len(p.TransactionCurrency) != 3 || !isDigits(p.TransactionCurrency) {
+		return fmt.Errorf("%w: transaction currency %q", ErrInvalidValue, p.TransactionCurrency)
+	}
+
+	if p.TransactionAmount != "" && (len(p.TransactionAmount) > maxAmountSize || !amountPattern.MatchString(p.TransactionAmount)) {
+		return fmt.Errorf("%w: transaction amount %q", ErrInvalidValue, p.TransactionAmount)
+	}
+
+	if !countryPattern.MatchString(p.CountryCode) {
+		return fmt.Errorf("%w: country code %q", ErrInvalidValue, p.CountryCode)
+	}
+
+	texts := []struct {
+		tag     string
+		value   string
+		maxSize int
+	}{
+		{tag: tagMerchantName, value: p.MerchantName, maxSize: maxMerchantNameSize},
+		{tag: tagMerchantCity, value: p.MerchantCity, maxSize: maxMerchantCitySize},
+		{tag: tagPostalCode, value: p.PostalCode, maxSize: maxPostalCodeSize},
+	}
+
+	for tag, value := range p.AdditionalData.fields() {
+		texts = append(texts, struct {
+			tag     string
+			value   string
+			maxSize int
+		}{tag: tagAdditionalData + "." + tag, value: value, maxSize: maxAdditionalDataSize})
+	}
+
+	for _, text := range texts {
+		if err := validateText(text.tag, text.value, text.maxSize); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *AdditionalData) fields() map[string]string {
+	fields := make(map[string]string)
+	if d == nil {
+		return fields
+	}
+
+	for tag, value := range map[string]string{
+		subTagBillNumber:     d.BillNumber,
+		subTagReferenceLabel: d.ReferenceLabel,
+		subTagTerminalLabel:  d.TerminalLabel,
+		subTagPurpose:        d.Purpose,
+	} {
+		if value != "" {
+			fields[tag] = value
+		}
+	}
+
+	return fields
+}
+
+func additionalDataFromFields(fields map[string]string) *AdditionalData {
+	data := &AdditionalData{
+		BillNumber:     fields[subTagBillNumber],
+		ReferenceLabel: fields[subTagReferenceLabel],
+		TerminalLabel:  fields[subTagTerminalLabel],
+		Purpose:        fields[subTagPurpose],
+	}
+
+	if *data == (AdditionalData{}) {
+		return nil
+	}
+
+	return data
+}
+
+func isMerchantAccountTag(tag string) bool {
+	n, err := strconv.Atoi(tag)
+
+	return err == nil && len(tag) == tagSize && n >= tagMerchantAccountMin && n <= tagMerchantAccountMax
+}
+
+// validateText checks that the value fits its tag and only uses printable ASCII,
+// lengths are counted in bytes and would be ambiguous otherwise.
+func validateText(tag, value string, maxSize int) error {
+	if len(value) > maxSize {
+		return fmt.Errorf("%w: tag %s is longer than %d characters", ErrInvalidValue, tag, maxSize)
+	}
+
+	for i := 0; i < len(value); i++ {
+		if value[i] < 0x20 || value[i] > 0x7E {
+			return fmt.Errorf("%w: tag %s contains non printable characters", ErrInvalidValue, tag)
+		}
+	}
+
+	return nil
+}
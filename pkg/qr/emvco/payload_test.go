@@ -0,0 +1,238 @@
+package emvco
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPayload() *Payload {
+	return &Payload{
+		PointOfInitiation: Dynamic,
+		MerchantAccounts: map[string]MerchantAccount{
+			"26": {
+				GloballyUniqueID: "com.qr-payment",
+				Fields: map[string]string{
+					"01": "test-user",
+					"02": "test-wallet",
+				},
+			},
+		},
+		MerchantCategoryCode: "5411",
+		TransactionCurrency:  "643",
+		TransactionAmount:    "1500.00",
+		CountryCode:          "RU",
+		MerchantName:         "Test Shop",
+		MerchantCity:         "Moscow",
+		AdditionalData: &AdditionalData{
+			ReferenceLabel: "test-transaction",
+		},
+	}
+}
+
+// withCRC appends a valid checksum so that decoding reaches the tag validation.
+func withCRC(data string) string {
+	data += tagCRC + "04"
+
+	return data + formatCRC(crc16([]byte(data)))
+}
+
+func TestCRC16(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint16(0x29B1), crc16([]byte("123456789")))
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+
+	content, err := Encode(testPayload())
+	require.NoError(t, err)
+
+	assert.Equal(t, withCRC(
+		"000201"+
+			"010212"+
+			"2646"+"0014com.qr-payment"+"0109test-user"+"0211test-wallet"+
+			"52045411"+
+			"5303643"+
+			"54071500.00"+
+			"5802RU"+
+			"5909Test Shop"+
+			"6006Moscow"+
+			"6220"+"0516test-transaction",
+	), content)
+
+	decoded, err := Decode(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, testPayload(), decoded)
+}
+
+func TestEncodeInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		modify      func(*Payload)
+		expectedErr error
+	}{
+		{
+			name:        "Dynamic code without amount",
+			modify:      func(p *Payload) { p.TransactionAmount = "" },
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name:        "Unknown point of initiation",
+			modify:      func(p *Payload) { p.PointOfInitiation = "13" },
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "Without merchant account",
+			modify:      func(p *Payload) { p.MerchantAccounts = nil },
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name: "Merchant account outside of template range",
+			modify: func(p *Payload) {
+				p.MerchantAccounts["52"] = MerchantAccount{GloballyUniqueID: "com.qr-payment"}
+			},
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "Alphabetic currency",
+			modify:      func(p *Payload) { p.TransactionCurrency = "RUB" },
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "Negative amount",
+			modify:      func(p *Payload) { p.TransactionAmount = "-1" },
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "Too long merchant name",
+			modify:      func(p *Payload) { p.MerchantName = "The Longest Merchant Name Ever" },
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "Non ascii merchant city",
+			modify:      func(p *Payload) { p.MerchantCity = "Москва" },
+			expectedErr: ErrInvalidValue,
+		},
+		{
+			name:        "Missing merchant city",
+			modify:      func(p *Payload) { p.MerchantCity = "" },
+			expectedErr: ErrMissingTag,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := testPayload()
+			testcase.modify(payload)
+
+			_, err := Encode(payload)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestDecodeInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	valid, err := Encode(testPayload())
+	require.NoError(t, err)
+
+	const (
+		header   = "000201010211"
+		account  = "26180014com.qr-payment"
+		merchant = "5204541153036435802RU5909Test Shop6006Moscow"
+	)
+
+	testcases := []struct {
+		name        string
+		content     string
+		expectedErr error
+	}{
+		{
+			name:        "Wrong checksum",
+			content:     valid[:len(valid)-1] + "0",
+			expectedErr: ErrInvalidCRC,
+		},
+		{
+			name:        "Tampered amount",
+			content:     "000201010212" + valid[12:40] + "9" + valid[41:],
+			expectedErr: ErrInvalidCRC,
+		},
+		{
+			name:        "Without checksum",
+			content:     header + account + merchant,
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name:        "Without payload format indicator",
+			content:     withCRC("010211" + account + merchant),
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name:        "Without merchant name",
+			content:     withCRC(header + account + "5204541153036435802RU6006Moscow"),
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name:        "Without merchant account",
+			content:     withCRC(header + merchant),
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name:        "Dynamic code without amount",
+			content:     withCRC("000201010212" + account + merchant),
+			expectedErr: ErrMissingTag,
+		},
+		{
+			name:        "Truncated value",
+			content:     withCRC(header + account + merchant + "6120"),
+			expectedErr: ErrMalformed,
+		},
+		{
+			name:        "Repeated tag",
+			content:     withCRC(header + account + merchant + "6006Moscow"),
+			expectedErr: ErrMalformed,
+		},
+		{
+			name:        "Checksum tag inside the payload",
+			content:     withCRC(header + account + "63041234" + merchant),
+			expectedErr: ErrMalformed,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Decode(testcase.content)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestDecodeSkipsUnmodelledTags(t *testing.T) {
+	t.Parallel()
+
+	// Tag 02 is reserved for a card scheme account and 55 is the tip indicator.
+	content := withCRC("000201010211" + "0204test" + "26180014com.qr-payment" + "52045411530364355020158" +
+		"02RU5909Test Shop6006Moscow")
+
+	payload, err := Decode(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, Static, payload.PointOfInitiation)
+	assert.Equal(t, map[string]MerchantAccount{"26": {GloballyUniqueID: "com.qr-payment"}}, payload.MerchantAccounts)
+	assert.Empty(t, payload.TransactionAmount)
+}
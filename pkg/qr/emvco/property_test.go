@@ -0,0 +1,225 @@
+package emvco
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func genString(minSize, maxSize int, first, last rune) gopter.Gen {
+	return gen.IntRange(minSize, maxSize).FlatMap(func(size interface{}) gopter.Gen {
+		return gen.SliceOfN(size.(int), gen.RuneRange(first, last)).Map(func(r []rune) string {
+			return string(r)
+		})
+	}, reflect.TypeOf(""))
+}
+
+func genText(minSize, maxSize int) gopter.Gen {
+	return genString(minSize, maxSize, ' ', '~')
+}
+
+func genDigits(minSize, maxSize int) gopter.Gen {
+	return genString(minSize, maxSize, '0', '9')
+}
+
+func genTag(minTag, maxTag int) gopter.Gen {
+	return gen.IntRange(minTag, maxTag).Map(func(tag int) string {
+		return strconv.Itoa(100 + tag)[1:]
+	})
+}
+
+func genAmount() gopter.Gen {
+	return gopter.CombineGens(
+		genDigits(1, 10),
+		gen.OneGenOf(gen.Const(""), genDigits(1, 2)),
+	).Map(func(values []interface{}) string {
+		if fraction := values[1].(string); fraction != "" {
+			return values[0].(string) + "." + fraction
+		}
+
+		return values[0].(string)
+	})
+}
+
+type accountEntry struct {
+	tag     string
+	account MerchantAccount
+}
+
+func genMerchantAccount() gopter.Gen {
+	type subField struct {
+		tag   string
+		value string
+	}
+
+	genSubField := gopter.CombineGens(genTag(1, 99), genText(0, 20)).Map(func(values []interface{}) subField {
+		return subField{tag: values[0].(string), value: values[1].(string)}
+	})
+
+	return gopter.CombineGens(
+		genTag(tagMerchantAccountMin, tagMerchantAccountMax),
+		genText(1, 32),
+		gen.IntRange(0, 2).FlatMap(func(size interface{}) gopter.Gen {
+			return gen.SliceOfN(size.(int), genSubField)
+		}, reflect.TypeOf([]subField{})),
+	).Map(func(values []interface{}) accountEntry {
+		account := MerchantAccount{GloballyUniqueID: values[1].(string)}
+
+		for _, f := range values[2].([]subField) {
+			if account.Fields == nil {
+				account.Fields = make(map[string]string)
+			}
+
+			account.Fields[f.tag] = f.value
+		}
+
+		return accountEntry{tag: values[0].(string), account: account}
+	})
+}
+
+func genAdditionalData() gopter.Gen {
+	// Four sub-fields of the maximum size would not fit into the template.
+	const maxSize = 20
+
+	return gopter.CombineGens(
+		genText(0, maxAdditionalDataSize),
+		genText(0, maxSize),
+		genText(0, maxSize),
+		genText(0, maxSize),
+	).Map(func(values []interface{}) *AdditionalData {
+		data := &AdditionalData{
+			BillNumber:     values[0].(string),
+			ReferenceLabel: values[1].(string),
+			TerminalLabel:  values[2].(string),
+			Purpose:        values[3].(string),
+		}
+
+		// Decode drops an empty template, so does the generator.
+		if *data == (AdditionalData{}) {
+			return nil
+		}
+
+		return data
+	})
+}
+
+func genPayload() gopter.Gen {
+	return gopter.CombineGens(
+		gen.OneConstOf(Static, Dynamic),
+		gen.IntRange(1, 2).FlatMap(func(size interface{}) gopter.Gen {
+			return gen.SliceOfN(size.(int), genMerchantAccount())
+		}, reflect.TypeOf([]accountEntry{})),
+		genDigits(4, 4),
+		genDigits(3, 3),
+		genAmount(),
+		gen.Bool(),
+		genString(2, 2, 'A', 'Z'),
+		genText(1, maxMerchantNameSize),
+		genText(1, maxMerchantCitySize),
+		genText(0, maxPostalCodeSize),
+		genAdditionalData(),
+	).Map(func(values []interface{}) *Payload {
+		payload := &Payload{
+			PointOfInitiation:    values[0].(PointOfInitiation),
+			MerchantAccounts:     make(map[string]MerchantAccount),
+			MerchantCategoryCode: values[2].(string),
+			TransactionCurrency:  values[3].(string),
+			CountryCode:          values[6].(string),
+			MerchantName:         values[7].(string),
+			MerchantCity:         values[8].(string),
+			PostalCode:           values[9].(string),
+			AdditionalData:       values[10].(*AdditionalData),
+		}
+
+		for _, entry := range values[1].([]accountEntry) {
+			payload.MerchantAccounts[entry.tag] = entry.account
+		}
+
+		// Static codes may still carry an amount, dynamic ones always do.
+		if payload.PointOfInitiation == Dynamic || values[5].(bool) {
+			payload.TransactionAmount = values[4].(string)
+		}
+
+		return payload
+	})
+}
+
+func TestEncodeDecodeProperties(t *testing.T) {
+	t.Parallel()
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 500
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("decode inverts encode", prop.ForAll(
+		func(payload *Payload) bool {
+			content, err := Encode(payload)
+			if err != nil {
+				return false
+			}
+
+			decoded, err := Decode(content)
+			if err != nil {
+				return false
+			}
+
+			return reflect.DeepEqual(payload, decoded)
+		},
+		genPayload(),
+	))
+
+	properties.Property("encoding is stable", prop.ForAll(
+		func(payload *Payload) bool {
+			content, err := Encode(payload)
+			if err != nil {
+				return false
+			}
+
+			decoded, err := Decode(content)
+			if err != nil {
+				return false
+			}
+
+			reencoded, err := Encode(decoded)
+
+			return err == nil && reencoded == content
+		},
+		genPayload(),
+	))
+
+	properties.Property("any single character change is rejected", prop.ForAll(
+		func(payload *Payload, position int, replacement rune) bool {
+			content, err := Encode(payload)
+			if err != nil {
+				return false
+			}
+
+			position %= len(content)
+			if rune(content[position]) == replacement {
+				return true
+			}
+
+			// The checksum is compared case-insensitively.
+			if position >= len(content)-4 && strings.EqualFold(content[position:position+1], string(replacement)) {
+				return true
+			}
+
+			tampered := content[:position] + string(replacement) + content[position+1:]
+
+			_, err = Decode(tampered)
+
+			return err != nil
+		},
+		genPayload(),
+		gen.IntRange(0, maxPayloadSize),
+		gen.RuneRange(' ', '~'),
+	))
+
+	properties.TestingRun(t)
+}
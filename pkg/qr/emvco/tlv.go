@@ -0,0 +1,109 @@
+package emvco
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	tagSize    = 2
+	lengthSize = 2
+	maxLength  = 99
+)
+
+// field is a single EMVCo tag-length-value data object.
+type field struct {
+	tag   string
+	value string
+}
+
+func writeField(b *strings.Builder, tag, value string) error {
+	if len(value) > maxLength {
+		return fmt.Errorf("%w: tag %s is longer than %d characters", ErrInvalidValue, tag, maxLength)
+	}
+
+	fmt.Fprintf(b, "%s%02d%s", tag, len(value), value)
+
+	return nil
+}
+
+// writeTemplate writes the sub-fields in ascending tag order as the value of tag.
+func writeTemplate(b *strings.Builder, tag string, fields map[string]string) error {
+	tags := make([]string, 0, len(fields))
+	for subTag := range fields {
+		tags = append(tags, subTag)
+	}
+
+	sort.Strings(tags)
+
+	var template strings.Builder
+
+	for _, subTag := range tags {
+		if err := writeField(&template, subTag, fields[subTag]); err != nil {
+			return err
+		}
+	}
+
+	return writeField(b, tag, template.String())
+}
+
+func parseFields(data string) ([]field, error) {
+	var fields []field
+
+	for len(data) > 0 {
+		if len(data) < tagSize+lengthSize {
+			return nil, fmt.Errorf("%w: truncated data object %q", ErrMalformed, data)
+		}
+
+		tag := data[:tagSize]
+		if !isDigits(tag) {
+			return nil, fmt.Errorf("%w: invalid tag %q", ErrMalformed, tag)
+		}
+
+		length, err := strconv.Atoi(data[tagSize : tagSize+lengthSize])
+		if err != nil || length < 0 {
+			return nil, fmt.Errorf("%w: invalid length of tag %s", ErrMalformed, tag)
+		}
+
+		data = data[tagSize+lengthSize:]
+		if len(data) < length {
+			return nil, fmt.Errorf("%w: value of tag %s is truncated", ErrMalformed, tag)
+		}
+
+		fields = append(fields, field{tag: tag, value: data[:length]})
+		data = data[length:]
+	}
+
+	return fields, nil
+}
+
+func parseTemplate(tag, data string) (map[string]string, error) {
+	fields, err := parseFields(data)
+	if err != nil {
+		return nil, fmt.Errorf("template %s: %w", tag, err)
+	}
+
+	template := make(map[string]string, len(fields))
+
+	for _, f := range fields {
+		if _, ok := template[f.tag]; ok {
+			return nil, fmt.Errorf("%w: template %s repeats tag %s", ErrMalformed, tag, f.tag)
+		}
+
+		template[f.tag] = f.value
+	}
+
+	return template, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return s != ""
+}
@@ -0,0 +1,176 @@
+package qr
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// Scheme is the URI scheme of the payment payload.
+	Scheme = "qrpay"
+	// Version is the payload format version written by Encode.
+	Version = "1"
+
+	payHost = "pay"
+
+	versionKey    = "v"
+	idKey         = "id"
+	amountKey     = "amount"
+	currencyKey   = "currency"
+	methodKey     = "method"
+	serviceURLKey = "url"
+	expiresAtKey  = "exp"
+	nonceKey      = "nonce"
+	signatureKey  = "sig"
+)
+
+var (
+	ErrInvalidScheme      = errors.New("invalid payload scheme")
+	ErrUnsupportedVersion = errors.New("unsupported payload version")
+	ErrMissingField       = errors.New("missing payload field")
+	ErrInvalidAmount      = errors.New("invalid payload amount")
+	ErrInvalidServiceURL  = errors.New("invalid payload service url")
+	ErrInvalidExpiry      = errors.New("invalid payload expiry")
+	ErrInvalidSignature   = errors.New("invalid payload signature")
+)
+
+var signatureEncoding = base64.RawURLEncoding
+
+// Payload represents the payment data carried by a transaction QR code.
+// ExpiresAt, Nonce and Signature are only present in payloads produced by Sign.
+type Payload struct {
+	TransactionID string
+	Amount        int64
+	Currency      string
+	Method        string
+	ServiceURL    string
+	ExpiresAt     time.Time
+	Nonce         string
+	Signature     []byte
+}
+
+// Encode validates the payload and formats it as a qrpay URI, e.g.
+// qrpay://pay?v=1&id=<transaction id>&amount=100&currency=ALGO&method=algorand&url=<service url>.
+// Signed payloads additionally carry the exp (unix seconds), nonce and sig (base64url) parameters.
+func Encode(payload *Payload) (string, error) {
+	if err := payload.validate(); err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set(versionKey, Version)
+	values.Set(idKey, payload.TransactionID)
+	values.Set(amountKey, strconv.FormatInt(payload.Amount, 10))
+	values.Set(currencyKey, payload.Currency)
+	values.Set(methodKey, payload.Method)
+	values.Set(serviceURLKey, payload.ServiceURL)
+
+	if !payload.ExpiresAt.IsZero() {
+		values.Set(expiresAtKey, strconv.FormatInt(payload.ExpiresAt.Unix(), 10))
+	}
+
+	if payload.Nonce != "" {
+		values.Set(nonceKey, payload.Nonce)
+	}
+
+	if len(payload.Signature) > 0 {
+		values.Set(signatureKey, signatureEncoding.EncodeToString(payload.Signature))
+	}
+
+	uri := url.URL{
+		Scheme:   Scheme,
+		Host:     payHost,
+		RawQuery: values.Encode(),
+	}
+
+	return uri.String(), nil
+}
+
+// Parse decodes a qrpay URI produced by Encode.
+func Parse(content string) (*Payload, error) {
+	uri, err := url.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payload: %w", err)
+	}
+
+	if uri.Scheme != Scheme || uri.Host != payHost {
+		return nil, ErrInvalidScheme
+	}
+
+	values := uri.Query()
+
+	if version := values.Get(versionKey); version != Version {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedVersion, version)
+	}
+
+	rawAmount := values.Get(amountKey)
+	if rawAmount == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingField, amountKey)
+	}
+
+	amount, err := strconv.ParseInt(rawAmount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAmount, rawAmount)
+	}
+
+	payload := &Payload{
+		TransactionID: values.Get(idKey),
+		Amount:        amount,
+		Currency:      values.Get(currencyKey),
+		Method:        values.Get(methodKey),
+		ServiceURL:    values.Get(serviceURLKey),
+		Nonce:         values.Get(nonceKey),
+	}
+
+	if rawExpiresAt := values.Get(expiresAtKey); rawExpiresAt != "" {
+		expiresAt, err := strconv.ParseInt(rawExpiresAt, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidExpiry, rawExpiresAt)
+		}
+
+		payload.ExpiresAt = time.Unix(expiresAt, 0).UTC()
+	}
+
+	if rawSignature := values.Get(signatureKey); rawSignature != "" {
+		signature, err := signatureEncoding.DecodeString(rawSignature)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+		}
+
+		payload.Signature = signature
+	}
+
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func (p *Payload) validate() error {
+	for key, value := range map[string]string{
+		idKey:         p.TransactionID,
+		currencyKey:   p.Currency,
+		methodKey:     p.Method,
+		serviceURLKey: p.ServiceURL,
+	} {
+		if value == "" {
+			return fmt.Errorf("%w: %s", ErrMissingField, key)
+		}
+	}
+
+	if p.Amount <= 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidAmount, p.Amount)
+	}
+
+	serviceURL, err := url.Parse(p.ServiceURL)
+	if err != nil || serviceURL.Scheme == "" || serviceURL.Host == "" {
+		return fmt.Errorf("%w: %s", ErrInvalidServiceURL, p.ServiceURL)
+	}
+
+	return nil
+}
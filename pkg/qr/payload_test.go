@@ -0,0 +1,129 @@
+package qr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPayload() *Payload {
+	return &Payload{
+		TransactionID: "85e6a060-f914-48d1-b73a-23b7e6c81f46",
+		Amount:        150000,
+		Currency:      "ALGO",
+		Method:        "algorand",
+		ServiceURL:    "http://localhost:8083/api/v1",
+	}
+}
+
+func TestEncodeParse(t *testing.T) {
+	t.Parallel()
+
+	payload := testPayload()
+
+	content, err := Encode(payload)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"qrpay://pay?amount=150000&currency=ALGO&id=85e6a060-f914-48d1-b73a-23b7e6c81f46&method=algorand&url=http%3A%2F%2Flocalhost%3A8083%2Fapi%2Fv1&v=1",
+		content,
+	)
+
+	parsed, err := Parse(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, payload, parsed)
+}
+
+func TestEncodeInvalidPayload(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		modify      func(*Payload)
+		expectedErr error
+	}{
+		{
+			name:        "Missing transaction id",
+			modify:      func(p *Payload) { p.TransactionID = "" },
+			expectedErr: ErrMissingField,
+		},
+		{
+			name:        "Missing currency",
+			modify:      func(p *Payload) { p.Currency = "" },
+			expectedErr: ErrMissingField,
+		},
+		{
+			name:        "Non-positive amount",
+			modify:      func(p *Payload) { p.Amount = 0 },
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Relative service url",
+			modify:      func(p *Payload) { p.ServiceURL = "/api/v1" },
+			expectedErr: ErrInvalidServiceURL,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := testPayload()
+			testcase.modify(payload)
+
+			_, err := Encode(payload)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestParseInvalidContent(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		content     string
+		expectedErr error
+	}{
+		{
+			name:        "Foreign scheme",
+			content:     "https://pay?v=1&id=test-id&amount=1&currency=ALGO&method=algorand&url=http://localhost",
+			expectedErr: ErrInvalidScheme,
+		},
+		{
+			name:        "Unsupported version",
+			content:     "qrpay://pay?v=2&id=test-id&amount=1&currency=ALGO&method=algorand&url=http://localhost",
+			expectedErr: ErrUnsupportedVersion,
+		},
+		{
+			name:        "Missing amount",
+			content:     "qrpay://pay?v=1&id=test-id&currency=ALGO&method=algorand&url=http://localhost",
+			expectedErr: ErrMissingField,
+		},
+		{
+			name:        "Malformed amount",
+			content:     "qrpay://pay?v=1&id=test-id&amount=ten&currency=ALGO&method=algorand&url=http://localhost",
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Missing method",
+			content:     "qrpay://pay?v=1&id=test-id&amount=1&currency=ALGO&url=http://localhost",
+			expectedErr: ErrMissingField,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testcase.content)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package qr
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	pointHost = "point"
+
+	minAmountKey = "min"
+	maxAmountKey = "max"
+)
+
+// PointPayload represents a static payment point QR code.
+// It carries no amount, the payer enters it within the optional MinAmount and MaxAmount bounds.
+type PointPayload struct {
+	PointID    string
+	Currency   string
+	Method     string
+	ServiceURL string
+	MinAmount  *int64
+	MaxAmount  *int64
+}
+
+// EncodePoint validates the payload and formats it as a qrpay URI, e.g.
+// qrpay://point?v=1&id=<payment point id>&currency=ALGO&method=algorand&url=<service url>&min=100.
+// Static codes are printed once and reused, so they are not signed and do not expire.
+func EncodePoint(payload *PointPayload) (string, error) {
+	if err := payload.validate(); err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set(versionKey, Version)
+	values.Set(idKey, payload.PointID)
+	values.Set(currencyKey, payload.Currency)
+	values.Set(methodKey, payload.Method)
+	values.Set(serviceURLKey, payload.ServiceURL)
+
+	if payload.MinAmount != nil {
+		values.Set(minAmountKey, strconv.FormatInt(*payload.MinAmount, 10))
+	}
+
+	if payload.MaxAmount != nil {
+		values.Set(maxAmountKey, strconv.FormatInt(*payload.MaxAmount, 10))
+	}
+
+	uri := url.URL{
+		Scheme:   Scheme,
+		Host:     pointHost,
+		RawQuery: values.Encode(),
+	}
+
+	return uri.String(), nil
+}
+
+// ParsePoint decodes a qrpay URI produced by EncodePoint.
+func ParsePoint(content string) (*PointPayload, error) {
+	uri, err := url.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payload: %w", err)
+	}
+
+	if uri.Scheme != Scheme || uri.Host != pointHost {
+		return nil, ErrInvalidScheme
+	}
+
+	values := uri.Query()
+
+	if version := values.Get(versionKey); version != Version {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedVersion, version)
+	}
+
+	payload := &PointPayload{
+		PointID:    values.Get(idKey),
+		Currency:   values.Get(currencyKey),
+		Method:     values.Get(methodKey),
+		ServiceURL: values.Get(serviceURLKey),
+	}
+
+	if payload.MinAmount, err = parseOptionalAmount(values.Get(minAmountKey)); err != nil {
+		return nil, err
+	}
+
+	if payload.MaxAmount, err = parseOptionalAmount(values.Get(maxAmountKey)); err != nil {
+		return nil, err
+	}
+
+	if err := payload.validate(); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func parseOptionalAmount(rawAmount string) (*int64, error) {
+	if rawAmount == "" {
+		return nil, nil
+	}
+
+	amount, err := strconv.ParseInt(rawAmount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAmount, rawAmount)
+	}
+
+	return &amount, nil
+}
+
+func (p *PointPayload) validate() error {
+	for key, value := range map[string]string{
+		idKey:         p.PointID,
+		currencyKey:   p.Currency,
+		methodKey:     p.Method,
+		serviceURLKey: p.ServiceURL,
+	} {
+		if value == "" {
+			return fmt.Errorf("%w: %s", ErrMissingField, key)
+		}
+	}
+
+	if p.MinAmount != nil && *p.MinAmount <= 0 {
+		return fmt.Errorf("%w: minimum %d", ErrInvalidAmount, *p.MinAmount)
+	}
+
+	if p.MaxAmount != nil && *p.MaxAmount <= 0 {
+		return fmt.Errorf("%w: maximum %d", ErrInvalidAmount, *p.MaxAmount)
+	}
+
+	if p.MinAmount != nil && p.MaxAmount != nil && *p.MinAmount > *p.MaxAmount {
+		return fmt.Errorf("%w: minimum %d is above maximum %d", ErrInvalidAmount, *p.MinAmount, *p.MaxAmount)
+	}
+
+	serviceURL, err := url.Parse(p.ServiceURL)
+	if err != nil || serviceURL.Scheme == "" || serviceURL.Host == "" {
+		return fmt.Errorf("%w: %s", ErrInvalidServiceURL, p.ServiceURL)
+	}
+
+	return nil
+}
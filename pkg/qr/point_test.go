@@ -0,0 +1,107 @@
+package qr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPointPayload() *PointPayload {
+	minAmount, maxAmount := int64(100), int64(50000)
+
+	return &PointPayload{
+		PointID:    "0d4b8f7c-5b0f-4a41-9d55-8a5d8a0b9e57",
+		Currency:   "ALGO",
+		Method:     "algorand",
+		ServiceURL: "http://localhost:8083/api/v1",
+		MinAmount:  &minAmount,
+		MaxAmount:  &maxAmount,
+	}
+}
+
+func TestEncodeParsePoint(t *testing.T) {
+	t.Parallel()
+
+	payload := testPointPayload()
+
+	content, err := EncodePoint(payload)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"qrpay://point?currency=ALGO&id=0d4b8f7c-5b0f-4a41-9d55-8a5d8a0b9e57&max=50000&method=algorand&min=100&url=http%3A%2F%2Flocalhost%3A8083%2Fapi%2Fv1&v=1",
+		content,
+	)
+
+	parsed, err := ParsePoint(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, payload, parsed)
+
+	_, err = Parse(content)
+	assert.ErrorIs(t, err, ErrInvalidScheme)
+}
+
+func TestEncodeInvalidPointPayload(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		modify      func(*PointPayload)
+		expectedErr error
+	}{
+		{
+			name:        "Missing point id",
+			modify:      func(p *PointPayload) { p.PointID = "" },
+			expectedErr: ErrMissingField,
+		},
+		{
+			name: "Non-positive minimum",
+			modify: func(p *PointPayload) {
+				minAmount := int64(0)
+				p.MinAmount = &minAmount
+			},
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name: "Minimum above maximum",
+			modify: func(p *PointPayload) {
+				minAmount := *p.MaxAmount + 1
+				p.MinAmount = &minAmount
+			},
+			expectedErr: ErrInvalidAmount,
+		},
+		{
+			name:        "Relative service url",
+			modify:      func(p *PointPayload) { p.ServiceURL = "/api/v1" },
+			expectedErr: ErrInvalidServiceURL,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := testPointPayload()
+			testcase.modify(payload)
+
+			_, err := EncodePoint(payload)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestParsePointWithoutBounds(t *testing.T) {
+	t.Parallel()
+
+	payload, err := ParsePoint("qrpay://point?v=1&id=test-id&currency=ALGO&method=algorand&url=http://localhost")
+	require.NoError(t, err)
+
+	assert.Nil(t, payload.MinAmount)
+	assert.Nil(t, payload.MaxAmount)
+
+	_, err = ParsePoint("qrpay://point?v=1&id=test-id&currency=ALGO&method=algorand&url=http://localhost&min=ten")
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
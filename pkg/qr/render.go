@@ -0,0 +1,110 @@
+package qr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+// Level is the QR error-correction level.
+type Level string
+
+const (
+	LevelLow      Level = "L"
+	LevelMedium   Level = "M"
+	LevelQuartile Level = "Q"
+	LevelHigh     Level = "H"
+)
+
+var (
+	ErrUnknownLevel = errors.New("unknown error-correction level")
+	ErrInvalidSize  = errors.New("invalid image size")
+)
+
+func (l Level) recoveryLevel() (qrcode.RecoveryLevel, error) {
+	switch l {
+	case LevelLow:
+		return qrcode.Low, nil
+	case LevelMedium:
+		return qrcode.Medium, nil
+	case LevelQuartile:
+		return qrcode.High, nil
+	case LevelHigh:
+		return qrcode.Highest, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnknownLevel, string(l))
+	}
+}
+
+func newCode(content string, size int, level Level) (*qrcode.QRCode, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSize, size)
+	}
+
+	recoveryLevel, err := level.recoveryLevel()
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := qrcode.New(content, recoveryLevel)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode qr code: %w", err)
+	}
+
+	return code, nil
+}
+
+// PNG renders content as a size x size PNG image.
+func PNG(content string, size int, level Level) ([]byte, error) {
+	code, err := newCode(content, size, level)
+	if err != nil {
+		return nil, err
+	}
+
+	png, err := code.PNG(size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render png: %w", err)
+	}
+
+	return png, nil
+}
+
+// SVG renders content as a size x size SVG image, one unit of the view box per module.
+func SVG(content string, size int, level Level) ([]byte, error) {
+	code, err := newCode(content, size, level)
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := code.Bitmap()
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		size, size, len(bitmap), len(bitmap),
+	)
+	buf.WriteString(`<rect width="100%" height="100%" fill="#ffffff"/><path fill="#000000" d="`)
+
+	// Adjacent dark modules of a row are merged into a single rectangle to keep the path short.
+	for y, row := range bitmap {
+		for x := 0; x < len(row); x++ {
+			if !row[x] {
+				continue
+			}
+
+			start := x
+			for x < len(row) && row[x] {
+				x++
+			}
+
+			fmt.Fprintf(&buf, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+
+	buf.WriteString(`"/></svg>`)
+
+	return buf.Bytes(), nil
+}
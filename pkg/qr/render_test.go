@@ -0,0 +1,60 @@
+package qr
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContent = "qrpay://pay?amount=1&currency=ALGO&id=test-id&method=algorand&url=http%3A%2F%2Flocalhost&v=1"
+
+func TestPNG(t *testing.T) {
+	t.Parallel()
+
+	for _, level := range []Level{LevelLow, LevelMedium, LevelQuartile, LevelHigh} {
+		content, err := PNG(testContent, 256, level)
+		require.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(content))
+		require.NoError(t, err)
+
+		assert.Equal(t, 256, img.Bounds().Dx())
+		assert.Equal(t, 256, img.Bounds().Dy())
+	}
+}
+
+func TestSVG(t *testing.T) {
+	t.Parallel()
+
+	content, err := SVG(testContent, 300, LevelMedium)
+	require.NoError(t, err)
+
+	var svg struct {
+		XMLName xml.Name `xml:"svg"`
+		Width   int      `xml:"width,attr"`
+		Height  int      `xml:"height,attr"`
+		Path    struct {
+			D string `xml:"d,attr"`
+		} `xml:"path"`
+	}
+
+	require.NoError(t, xml.Unmarshal(content, &svg))
+
+	assert.Equal(t, 300, svg.Width)
+	assert.Equal(t, 300, svg.Height)
+	assert.NotEmpty(t, svg.Path.D)
+}
+
+func TestRenderInvalidOptions(t *testing.T) {
+	t.Parallel()
+
+	_, err := PNG(testContent, 256, "X")
+	assert.ErrorIs(t, err, ErrUnknownLevel)
+
+	_, err = SVG(testContent, 0, LevelMedium)
+	assert.ErrorIs(t, err, ErrInvalidSize)
+}
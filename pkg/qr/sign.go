@@ -0,0 +1,69 @@
+package qr
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// signatureDomain separates QR payload signatures from anything else signed by the same key.
+const signatureDomain = "qr-payment/qr/v1"
+
+var ErrMissingSignature = errors.New("payload is not signed")
+
+// Sign signs every field of the payload with the server key and stores the result in Signature.
+// The payload must carry an expiry and a nonce so that a signed code cannot be reused forever.
+func Sign(payload *Payload, key ed25519.PrivateKey) error {
+	if payload.ExpiresAt.IsZero() {
+		return fmt.Errorf("%w: %s", ErrMissingField, expiresAtKey)
+	}
+
+	if payload.Nonce == "" {
+		return fmt.Errorf("%w: %s", ErrMissingField, nonceKey)
+	}
+
+	if err := payload.validate(); err != nil {
+		return err
+	}
+
+	payload.Signature = ed25519.Sign(key, payload.signingBytes())
+
+	return nil
+}
+
+// Verify checks the payload signature against the server public key.
+// The expiry is not checked here, see Expired.
+func Verify(payload *Payload, key ed25519.PublicKey) error {
+	if len(payload.Signature) == 0 {
+		return ErrMissingSignature
+	}
+
+	if len(key) != ed25519.PublicKeySize || !ed25519.Verify(key, payload.signingBytes(), payload.Signature) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Expired reports whether a signed payload can no longer be used at now.
+func (p *Payload) Expired(now time.Time) bool {
+	return !now.Before(p.ExpiresAt)
+}
+
+func (p *Payload) signingBytes() []byte {
+	fields := []string{
+		signatureDomain,
+		p.TransactionID,
+		strconv.FormatInt(p.Amount, 10),
+		p.Currency,
+		p.Method,
+		p.ServiceURL,
+		strconv.FormatInt(p.ExpiresAt.Unix(), 10),
+		p.Nonce,
+	}
+
+	return []byte(strings.Join(fields, "\n"))
+}
@@ -0,0 +1,119 @@
+package qr
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testExpiresAt = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func signedTestPayload(t *testing.T, key ed25519.PrivateKey) *Payload {
+	t.Helper()
+
+	payload := testPayload()
+	payload.ExpiresAt = testExpiresAt
+	payload.Nonce = "test-nonce"
+
+	require.NoError(t, Sign(payload, key))
+
+	return payload
+}
+
+func TestSignVerify(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := signedTestPayload(t, privateKey)
+
+	content, err := Encode(payload)
+	require.NoError(t, err)
+
+	parsed, err := Parse(content)
+	require.NoError(t, err)
+
+	assert.Equal(t, payload, parsed)
+	assert.NoError(t, Verify(parsed, publicKey))
+	assert.ErrorIs(t, Verify(parsed, otherPublicKey), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(testPayload(), publicKey), ErrMissingSignature)
+}
+
+func TestVerifyTamperedPayload(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name   string
+		tamper func(*Payload)
+	}{
+		{
+			name:   "Changed transaction id",
+			tamper: func(p *Payload) { p.TransactionID = "test-transaction" },
+		},
+		{
+			name:   "Changed amount",
+			tamper: func(p *Payload) { p.Amount = 1 },
+		},
+		{
+			name:   "Changed service url",
+			tamper: func(p *Payload) { p.ServiceURL = "http://attacker.example.com" },
+		},
+		{
+			name:   "Extended expiry",
+			tamper: func(p *Payload) { p.ExpiresAt = p.ExpiresAt.Add(time.Hour) },
+		},
+		{
+			name:   "Changed nonce",
+			tamper: func(p *Payload) { p.Nonce = "other-nonce" },
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload := signedTestPayload(t, privateKey)
+			testcase.tamper(payload)
+
+			assert.ErrorIs(t, Verify(payload, publicKey), ErrInvalidSignature)
+		})
+	}
+}
+
+func TestSignRequiresExpiryAndNonce(t *testing.T) {
+	t.Parallel()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := testPayload()
+	payload.Nonce = "test-nonce"
+	assert.ErrorIs(t, Sign(payload, privateKey), ErrMissingField)
+
+	payload = testPayload()
+	payload.ExpiresAt = testExpiresAt
+	assert.ErrorIs(t, Sign(payload, privateKey), ErrMissingField)
+}
+
+func TestExpired(t *testing.T) {
+	t.Parallel()
+
+	payload := testPayload()
+	payload.ExpiresAt = testExpiresAt
+
+	assert.False(t, payload.Expired(testExpiresAt.Add(-time.Second)))
+	assert.True(t, payload.Expired(testExpiresAt))
+}
@@ -57,9 +57,11 @@ def test_accept_transaction():
     assert retries > 0
     assert status_result["transaction_status"] == "created"
 
-    sender_token = r.get_auth_token_send(r.CREATOR_DATA)
+    qr_payload = r.qr_payload_send(id, creator_token)
 
-    r.accept_transaction_send(id, r.DATA_TO_ACCEPT_TRANSACTION, sender_token)
+    sender_token = r.get_auth_token_send(r.BUYER_DATA)
+
+    r.accept_transaction_send(id, dict(r.DATA_TO_ACCEPT_TRANSACTION, qr_payload=qr_payload), sender_token)
 
     sleep(5)
 
@@ -5,6 +5,7 @@ TRANSACTION_URL = "http://localhost:8083"
 API_PATH = "/api/v1"
 CREATE_TRANSACTION_PATH = "/transaction/create"
 LOGIN_PATH = "/transaction/login"
+CREATE_PAYMENT_POINT_PATH = "/payment-point/create"
 
 
 CREATOR_DATA = {
@@ -20,7 +21,7 @@ BUYER_DATA = {
 DATA_TO_CREATE_TRANSACTION = {
     "money_info": {
         "amount": 1,
-        "currency": "string",
+        "currency": "ALGO",
         "method": "algorand"
     },
     "receiver": {
@@ -39,14 +40,14 @@ DATA_TO_ACCEPT_TRANSACTION = {
 DATA_TO_RETRIEVE_TRANSACTION = {
     "money_info": {
         "amount": 2,
-        "currency": "string",
+        "currency": "ALGO",
         "method": "algorand"
     }
 }
 
 EDITED_TRANSACTION_TRANSACTION = {
     "amount": 2,
-    "currency": "string",
+    "currency": "ALGO",
     "method": "algorand",
     "receiver": {
         "user_id": "85e6a060-f914-48d1-b73a-23b7e6c81f46",
@@ -55,6 +56,25 @@ EDITED_TRANSACTION_TRANSACTION = {
     "status": "created"
 }
 
+DATA_TO_CREATE_PAYMENT_POINT = {
+    "currency": "ALGO",
+    "method": "algorand",
+    "min_amount": 1,
+    "max_amount": 100,
+    "receiver": {
+        "user_id": "85e6a060-f914-48d1-b73a-23b7e6c81f46",
+        "wallet_id": "3735b92d-5dcb-4dc0-a8b0-54415d0c52d3"
+    }
+}
+
+DATA_TO_PAY_PAYMENT_POINT = {
+    "amount": 5,
+    "sender": {
+        "user_id": "65a8ed73-b6f3-4543-82a6-7ab9ef6e9c7b",
+        "wallet_id": "c09e7795-8b25-4639-b7e3-8c2592298eb8"
+    }
+}
+
 ACCEPT_DATA = {
     "sender": {
         "user_id": "65a8ed73-b6f3-4543-82a6-7ab9ef6e9c7b",
@@ -64,7 +84,7 @@ ACCEPT_DATA = {
 
 def headers_auth(token):
     return {'content-type': 'application/json',
-            'Authorization': token
+            'Authorization': 'Bearer ' + token
             }
 
 def headers():
@@ -99,6 +119,12 @@ def cancel_transaction_send(id: str, token: str):
     response = urlopen(req)
     assert response.getcode() == 200
 
+def qr_payload_send(id: str, token: str) -> str:
+    req = Request(TRANSACTION_URL + API_PATH + "/transaction/" + id + "/qr?format=text", headers=headers_auth(token), method='GET')
+    response = urlopen(req)
+    assert response.getcode() == 200
+    return response.read().decode()
+
 def accept_transaction_send(id: str, data: dict, token: str):
     req = Request(TRANSACTION_URL + API_PATH + "/transaction/" + id + "/accept", headers=headers_auth(token),
                   data=json.dumps(data).encode(), method='POST')
@@ -115,4 +141,24 @@ def edit_transaction(data: dict, id: str, token: str):
     req = Request(TRANSACTION_URL + API_PATH + "/transaction/" + id + "/edit", data=json.dumps(
         data).encode(), headers=headers_auth(token), method='POST')
     response = urlopen(req)
-    assert response.getcode() == 200
\ No newline at end of file
+    assert response.getcode() == 200
+
+def create_payment_point_send(data: dict, token: str) -> dict:
+    req = Request(TRANSACTION_URL + API_PATH + CREATE_PAYMENT_POINT_PATH, data=json.dumps(
+        data).encode(), headers=headers_auth(token), method='POST')
+    response = urlopen(req)
+    assert response.getcode() == 200
+    return json.loads(response.read().decode())
+
+def payment_point_payload_send(id: str, token: str) -> str:
+    req = Request(TRANSACTION_URL + API_PATH + "/payment-point/" + id + "/qr?format=text", headers=headers_auth(token), method='GET')
+    response = urlopen(req)
+    assert response.getcode() == 200
+    return response.read().decode()
+
+def pay_payment_point_send(id: str, data: dict, token: str) -> dict:
+    req = Request(TRANSACTION_URL + API_PATH + "/payment-point/" + id + "/pay", headers=headers_auth(token),
+                  data=json.dumps(data).encode(), method='POST')
+    response = urlopen(req)
+    assert response.getcode() == 200
+    return json.loads(response.read().decode())
@@ -48,4 +48,21 @@ def test_edit_retrieve_transaction():
 
     r.edit_transaction(r.DATA_TO_RETRIEVE_TRANSACTION, id, token)
 
-    assert r.retrieve_transaction_send(id, token) == r.EDITED_TRANSACTION_TRANSACTION
\ No newline at end of file
+    transaction = r.retrieve_transaction_send(id, token)
+    for field, value in r.EDITED_TRANSACTION_TRANSACTION.items():
+        assert transaction[field] == value
+
+def test_pay_payment_point():
+    merchant_token = r.get_auth_token_send(r.CREATOR_DATA)
+    buyer_token = r.get_auth_token_send(r.BUYER_DATA)
+
+    point_id = r.create_payment_point_send(r.DATA_TO_CREATE_PAYMENT_POINT, merchant_token)["payment_point_id"]
+
+    assert r.payment_point_payload_send(point_id, merchant_token).startswith("qrpay://point?")
+
+    result = r.pay_payment_point_send(point_id, r.DATA_TO_PAY_PAYMENT_POINT, buyer_token)
+
+    sleep(1)
+
+    transaction = r.retrieve_transaction_send(result["transaction_id"], buyer_token)
+    assert transaction["amount"] == r.DATA_TO_PAY_PAYMENT_POINT["amount"]
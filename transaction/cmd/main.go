@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/ShmelJUJ/software-engineering/transaction/config"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/app"
@@ -13,5 +14,13 @@ func main() {
 		log.Fatal("failed to create new config: ", err)
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		if err := app.Report(cfg, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal("failed to write settlement report: ", err)
+		}
+
+		return
+	}
+
 	app.Run(cfg)
 }
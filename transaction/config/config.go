@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -29,14 +30,132 @@ type redisConfig struct {
 	URL string `yaml:"url"`
 }
 
+type authConfig struct {
+	Issuer                 string        `yaml:"issuer"`
+	JWKSRefreshInterval    time.Duration `yaml:"jwks_refresh_interval"`
+	JWKSMinRefreshInterval time.Duration `yaml:"jwks_min_refresh_interval"`
+	Leeway                 time.Duration `yaml:"leeway"`
+}
+
+type confirmationConfig struct {
+	Thresholds   map[string]string `yaml:"thresholds"`
+	CodeTTL      time.Duration     `yaml:"code_ttl"`
+	MaxAttempts  int               `yaml:"max_attempts"`
+	Notifier     string            `yaml:"notifier"`
+	NotifierFile string            `yaml:"notifier_file"`
+}
+
+type qrConfig struct {
+	ServiceURL string        `yaml:"service_url"`
+	TTL        time.Duration `yaml:"ttl"`
+	// SigningKey is the base64 encoded Ed25519 seed used to sign payloads.
+	SigningKey string `yaml:"signing_key" env:"QR_SIGNING_KEY"`
+}
+
+type expiryConfig struct {
+	// TTL is the default lifetime of created transactions, zero disables the expiration.
+	TTL           time.Duration `yaml:"ttl"`
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+	BatchSize     uint64        `yaml:"batch_size"`
+}
+
+type scheduledConfig struct {
+	PollInterval time.Duration `yaml:"poll_interval"`
+	BatchSize    uint64        `yaml:"batch_size"`
+}
+
+type holdConfig struct {
+	// Timeout is how long an authorized transaction can be captured before its hold is voided.
+	Timeout       time.Duration `yaml:"timeout"`
+	PollInterval  time.Duration `yaml:"poll_interval"`
+	BatchSize     uint64        `yaml:"batch_size"`
+	RetryInterval time.Duration `yaml:"retry_interval"`
+}
+
+type groupConfig struct {
+	// Deadline is the default time the payers of a group transaction have to pay their shares.
+	Deadline      time.Duration `yaml:"deadline"`
+	SweepInterval time.Duration `yaml:"sweep_interval"`
+	BatchSize     uint64        `yaml:"batch_size"`
+}
+
+type sagaConfig struct {
+	ProcessingTimeout time.Duration `yaml:"processing_timeout"`
+	StatusTimeout     time.Duration `yaml:"status_timeout"`
+	CheckInterval     time.Duration `yaml:"check_interval"`
+	BatchSize         uint64        `yaml:"batch_size"`
+	LeaderKey         string        `yaml:"leader_key"`
+	LeaderTTL         time.Duration `yaml:"leader_ttl"`
+}
+
+type mandateConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval"`
+	BatchSize     uint64        `yaml:"batch_size"`
+	// MaxAttempts is how many times a period is charged before the mandate is unpaid.
+	MaxAttempts    int32         `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	LeaderKey      string        `yaml:"leader_key"`
+	LeaderTTL      time.Duration `yaml:"leader_ttl"`
+}
+
+type reconcileConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval"`
+	// Lookback is how far back a replica that becomes the leader reconciles first.
+	Lookback       time.Duration `yaml:"lookback"`
+	Delay          time.Duration `yaml:"delay"`
+	LeaderKey      string        `yaml:"leader_key"`
+	LeaderTTL      time.Duration `yaml:"leader_ttl"`
+	IndexerAddress string        `yaml:"indexer_address"`
+	IndexerToken   string        `yaml:"indexer_token" env:"ALGORAND_INDEXER_TOKEN"`
+}
+
+type eventsConfig struct {
+	KeyPrefix         string        `yaml:"key_prefix"`
+	HistoryLength     int64         `yaml:"history_length"`
+	HistoryTTL        time.Duration `yaml:"history_ttl"`
+	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
+}
+
+type webhookConfig struct {
+	PollInterval   time.Duration `yaml:"poll_interval"`
+	BatchSize      uint64        `yaml:"batch_size"`
+	RequestTimeout time.Duration `yaml:"request_timeout"`
+	MaxAttempts    int32         `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+type fxConfig struct {
+	Provider       string        `yaml:"provider"`
+	RatesFile      string        `yaml:"rates_file"`
+	URL            string        `yaml:"url"`
+	RequestTimeout time.Duration `yaml:"request_timeout"`
+	LockDuration   time.Duration `yaml:"lock_duration"`
+	MarginBps      int64         `yaml:"margin_bps"`
+	SpreadBps      int64         `yaml:"spread_bps"`
+}
+
+type feeConfig struct {
+	WalletUserID string `yaml:"wallet_user_id"`
+	WalletID     string `yaml:"wallet_id"`
+}
+
 type httpConfig struct {
 	Port int `yaml:"port"`
+	// ForwardedHeader carries the client address set by the TrustedProxies, it is ignored on other requests.
+	ForwardedHeader string   `yaml:"forwarded_header"`
+	TrustedProxies  []string `yaml:"trusted_proxies"`
 }
 
 type publisherConfig struct {
-	Brokers                   []string `yaml:"brokers"`
-	ProcessedTransactionTopic string   `yaml:"processed_transaction_topic"`
-	ProcessMonitorTopic       string   `yaml:"process_monitor_topic"`
+	Brokers                          []string `yaml:"brokers"`
+	ProcessedTransactionTopic        string   `yaml:"processed_transaction_topic"`
+	CancelledTransactionTopic        string   `yaml:"cancelled_transaction_topic"`
+	StatusRequestedTransactionTopic  string   `yaml:"status_requested_transaction_topic"`
+	CaptureRequestedTransactionTopic string   `yaml:"capture_requested_transaction_topic"`
+	VoidRequestedTransactionTopic    string   `yaml:"void_requested_transaction_topic"`
+	ProcessMonitorTopic              string   `yaml:"process_monitor_topic"`
 }
 
 type topicDetails struct {
@@ -45,21 +164,38 @@ type topicDetails struct {
 }
 
 type subscriberConfig struct {
-	Brokers                   []string      `yaml:"brokers"`
-	TopicDetails              *topicDetails `yaml:"topic_details"`
-	SucceededTransactionTopic string        `yaml:"succeeded_transaction_topic"`
-	FailedTransactionTopic    string        `yaml:"failed_transaction_topic"`
+	Brokers                        []string      `yaml:"brokers"`
+	TopicDetails                   *topicDetails `yaml:"topic_details"`
+	SucceededTransactionTopic      string        `yaml:"succeeded_transaction_topic"`
+	FailedTransactionTopic         string        `yaml:"failed_transaction_topic"`
+	StatusReportedTransactionTopic string        `yaml:"status_reported_transaction_topic"`
+	AuthorizedTransactionTopic     string        `yaml:"authorized_transaction_topic"`
+	VoidedTransactionTopic         string        `yaml:"voided_transaction_topic"`
 }
 
 // Config represents the overall configuration structure.
 type Config struct {
-	LoggerCfg     *loggerConfig     `yaml:"logger"`
-	HTTPCfg       *httpConfig       `yaml:"http"`
-	PostgresCfg   *postgresConfig   `yaml:"postgres"`
-	RedisCfg      *redisConfig      `yaml:"redis"`
-	MiddlewareCfg *middlewareConfig `yaml:"middleware"`
-	PublisherCfg  *publisherConfig  `yaml:"publisher"`
-	SubscriberCfg *subscriberConfig `yaml:"subscriber"`
+	LoggerCfg       *loggerConfig       `yaml:"logger"`
+	HTTPCfg         *httpConfig         `yaml:"http"`
+	PostgresCfg     *postgresConfig     `yaml:"postgres"`
+	RedisCfg        *redisConfig        `yaml:"redis"`
+	AuthCfg         *authConfig         `yaml:"auth"`
+	ConfirmationCfg *confirmationConfig `yaml:"confirmation"`
+	QRCfg           *qrConfig           `yaml:"qr"`
+	ExpiryCfg       *expiryConfig       `yaml:"expiry"`
+	ScheduledCfg    *scheduledConfig    `yaml:"scheduled"`
+	HoldCfg         *holdConfig         `yaml:"hold"`
+	GroupCfg        *groupConfig        `yaml:"group"`
+	SagaCfg         *sagaConfig         `yaml:"saga"`
+	MandateCfg      *mandateConfig      `yaml:"mandate"`
+	ReconcileCfg    *reconcileConfig    `yaml:"reconcile"`
+	WebhookCfg      *webhookConfig      `yaml:"webhook"`
+	EventsCfg       *eventsConfig       `yaml:"events"`
+	FXCfg           *fxConfig           `yaml:"fx"`
+	FeeCfg          *feeConfig          `yaml:"fee"`
+	MiddlewareCfg   *middlewareConfig   `yaml:"middleware"`
+	PublisherCfg    *publisherConfig    `yaml:"publisher"`
+	SubscriberCfg   *subscriberConfig   `yaml:"subscriber"`
 }
 
 // NewConfig initializes a new Config instance by reading from a YAML file.
@@ -0,0 +1,425 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations"
+	apiAdmin "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/admin"
+	apiFee "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/fee"
+	apiLedger "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/ledger"
+	apiPaymentPoint "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/payment_point"
+	apiReport "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/report"
+	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	apiWebhook "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/webhook"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testIssuer = "test-issuer"
+
+// okResponder stands in for the operation handlers, only the access decision is under test.
+var okResponder = middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.WriteHeader(http.StatusOK)
+})
+
+func newAccessTestServer(t *testing.T) (http.Handler, *jwt.KeySet) {
+	t.Helper()
+
+	clk := clock.New()
+
+	keySet, err := jwt.NewKeySet(make([]byte, jwt.MinSecretSize), clk, time.Hour, time.Hour)
+	require.NoError(t, err)
+
+	verifier, err := jwt.NewVerifier(&jwt.Config{Issuer: testIssuer}, jwt.KeyFetcherFunc(func(context.Context) (*jwt.JWKS, error) {
+		return keySet.JWKS(), nil
+	}), clk)
+	require.NoError(t, err)
+
+	mockCtrl := gomock.NewController(t)
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	transactionHandler := handler.NewTransactionHandler(nil, l, nil, verifier, nil)
+
+	swaggerSpec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+	require.NoError(t, err)
+
+	api := operations.NewTransactionAPI(swaggerSpec)
+	api.Logger = t.Logf
+	api.BearerAuth = transactionHandler.VerifyAuthToken
+
+	api.TransactionAcceptTransactionHandler = apiTransaction.AcceptTransactionHandlerFunc(
+		func(apiTransaction.AcceptTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionConfirmTransactionHandler = apiTransaction.ConfirmTransactionHandlerFunc(
+		func(apiTransaction.ConfirmTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionQuoteTransactionHandler = apiTransaction.QuoteTransactionHandlerFunc(
+		func(apiTransaction.QuoteTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionGetTransactionFeeHandler = apiTransaction.GetTransactionFeeHandlerFunc(
+		func(apiTransaction.GetTransactionFeeParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionEditTransactionHandler = apiTransaction.EditTransactionHandlerFunc(
+		func(apiTransaction.EditTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionCancelTransactionHandler = apiTransaction.CancelTransactionHandlerFunc(
+		func(apiTransaction.CancelTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionCaptureTransactionHandler = apiTransaction.CaptureTransactionHandlerFunc(
+		func(apiTransaction.CaptureTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionVoidTransactionHandler = apiTransaction.VoidTransactionHandlerFunc(
+		func(apiTransaction.VoidTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionRetrieveTransactionStatusHandler = apiTransaction.RetrieveTransactionStatusHandlerFunc(
+		func(apiTransaction.RetrieveTransactionStatusParams, interface{}) middleware.Responder {
+			return okResponder
+		})
+	api.TransactionStreamTransactionEventsHandler = apiTransaction.StreamTransactionEventsHandlerFunc(
+		func(apiTransaction.StreamTransactionEventsParams, interface{}) middleware.Responder {
+			return okResponder
+		})
+	api.TransactionRetrieveTransactionHandler = apiTransaction.RetrieveTransactionHandlerFunc(
+		func(apiTransaction.RetrieveTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionGetTransactionQRHandler = apiTransaction.GetTransactionQRHandlerFunc(
+		func(apiTransaction.GetTransactionQRParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionCreateTransactionHandler = apiTransaction.CreateTransactionHandlerFunc(
+		func(apiTransaction.CreateTransactionParams, interface{}) middleware.Responder { return okResponder })
+	api.PaymentPointCreatePaymentPointHandler = apiPaymentPoint.CreatePaymentPointHandlerFunc(
+		func(apiPaymentPoint.CreatePaymentPointParams, interface{}) middleware.Responder { return okResponder })
+	api.PaymentPointRetrievePaymentPointHandler = apiPaymentPoint.RetrievePaymentPointHandlerFunc(
+		func(apiPaymentPoint.RetrievePaymentPointParams, interface{}) middleware.Responder { return okResponder })
+	api.PaymentPointDisablePaymentPointHandler = apiPaymentPoint.DisablePaymentPointHandlerFunc(
+		func(apiPaymentPoint.DisablePaymentPointParams, interface{}) middleware.Responder { return okResponder })
+	api.PaymentPointGetPaymentPointQRHandler = apiPaymentPoint.GetPaymentPointQRHandlerFunc(
+		func(apiPaymentPoint.GetPaymentPointQRParams, interface{}) middleware.Responder { return okResponder })
+	api.PaymentPointPayPaymentPointHandler = apiPaymentPoint.PayPaymentPointHandlerFunc(
+		func(apiPaymentPoint.PayPaymentPointParams, interface{}) middleware.Responder { return okResponder })
+	api.WebhookCreateWebhookHandler = apiWebhook.CreateWebhookHandlerFunc(
+		func(apiWebhook.CreateWebhookParams, interface{}) middleware.Responder { return okResponder })
+	api.WebhookListWebhookDeliveriesHandler = apiWebhook.ListWebhookDeliveriesHandlerFunc(
+		func(apiWebhook.ListWebhookDeliveriesParams, interface{}) middleware.Responder { return okResponder })
+	api.WebhookResendWebhookDeliveryHandler = apiWebhook.ResendWebhookDeliveryHandlerFunc(
+		func(apiWebhook.ResendWebhookDeliveryParams, interface{}) middleware.Responder { return okResponder })
+	api.LedgerGetLedgerBalanceHandler = apiLedger.GetLedgerBalanceHandlerFunc(
+		func(apiLedger.GetLedgerBalanceParams, interface{}) middleware.Responder { return okResponder })
+	api.LedgerGetLedgerStatementHandler = apiLedger.GetLedgerStatementHandlerFunc(
+		func(apiLedger.GetLedgerStatementParams, interface{}) middleware.Responder { return okResponder })
+	api.FeeSetFeePlanHandler = apiFee.SetFeePlanHandlerFunc(
+		func(apiFee.SetFeePlanParams, interface{}) middleware.Responder { return okResponder })
+	api.FeeGetFeePlansHandler = apiFee.GetFeePlansHandlerFunc(
+		func(apiFee.GetFeePlansParams, interface{}) middleware.Responder { return okResponder })
+	api.ReportGetSettlementReportHandler = apiReport.GetSettlementReportHandlerFunc(
+		func(apiReport.GetSettlementReportParams, interface{}) middleware.Responder { return okResponder })
+	api.AdminUnlockLoginHandler = apiAdmin.UnlockLoginHandlerFunc(
+		func(apiAdmin.UnlockLoginParams, interface{}) middleware.Responder { return okResponder })
+	api.TransactionLoginHandler = apiTransaction.LoginHandlerFunc(
+		func(apiTransaction.LoginParams) middleware.Responder { return okResponder })
+	api.TransactionLoginTwoFactorHandler = apiTransaction.LoginTwoFactorHandlerFunc(
+		func(apiTransaction.LoginTwoFactorParams) middleware.Responder { return okResponder })
+	api.TransactionRefreshLoginHandler = apiTransaction.RefreshLoginHandlerFunc(
+		func(apiTransaction.RefreshLoginParams) middleware.Responder { return okResponder })
+
+	return api.Serve(nil), keySet
+}
+
+func signToken(t *testing.T, keySet *jwt.KeySet, roles []string) string {
+	t.Helper()
+
+	now := time.Now()
+
+	token, err := keySet.Sign(&jwt.Claims{
+		ID:        uuid.NewString(),
+		Issuer:    testIssuer,
+		Subject:   uuid.NewString(),
+		Type:      jwt.AccessToken,
+		Roles:     roles,
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestOperationAccess(t *testing.T) {
+	t.Parallel()
+
+	server, keySet := newAccessTestServer(t)
+
+	transactionPath := "/api/v1/transaction/" + uuid.NewString()
+	paymentPointPath := "/api/v1/payment-point/" + uuid.NewString()
+	userBody := `{"user_id":"` + uuid.NewString() + `","wallet_id":"` + uuid.NewString() + `"}`
+
+	operations := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		allowed []string
+	}{
+		{
+			name:    "acceptTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/accept",
+			body:    `{"sender":` + userBody + `,"qr_payload":"test-qr-payload"}`,
+			allowed: []string{jwt.RoleCustomer},
+		},
+		{
+			name:    "confirmTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/confirm",
+			body:    `{"code":"123456"}`,
+			allowed: []string{jwt.RoleCustomer},
+		},
+		{
+			name:    "quoteTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/quote",
+			body:    `{"currency":"ALGO"}`,
+			allowed: []string{jwt.RoleCustomer},
+		},
+		{
+			name:    "getTransactionFee",
+			method:  http.MethodGet,
+			path:    transactionPath + "/fee",
+			allowed: []string{jwt.RoleCustomer},
+		},
+		{
+			name:    "editTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/edit",
+			body:    `{}`,
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "cancelTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/cancel",
+			body:    `{"reason":"test-reason"}`,
+			allowed: []string{jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "captureTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/capture",
+			body:    `{"amount":100}`,
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "voidTransaction",
+			method:  http.MethodPost,
+			path:    transactionPath + "/void",
+			allowed: []string{jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "retrieveTransactionStatus",
+			method:  http.MethodGet,
+			path:    transactionPath + "/retrieve/status",
+			allowed: []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "streamTransactionEvents",
+			method:  http.MethodGet,
+			path:    transactionPath + "/events",
+			allowed: []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "retrieveTransaction",
+			method:  http.MethodGet,
+			path:    transactionPath + "/retrieve",
+			allowed: []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "getTransactionQR",
+			method:  http.MethodGet,
+			path:    transactionPath + "/qr",
+			allowed: []string{jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "createTransaction",
+			method:  http.MethodPost,
+			path:    "/api/v1/transaction/create",
+			body:    `{"money_info":{"method":"algorand","currency":"ALGO","amount":1},"receiver":` + userBody + `}`,
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "createPaymentPoint",
+			method:  http.MethodPost,
+			path:    "/api/v1/payment-point/create",
+			body:    `{"method":"algorand","currency":"ALGO","receiver":` + userBody + `}`,
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "retrievePaymentPoint",
+			method:  http.MethodGet,
+			path:    paymentPointPath + "/retrieve",
+			allowed: []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "disablePaymentPoint",
+			method:  http.MethodPost,
+			path:    paymentPointPath + "/disable",
+			allowed: []string{jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "getPaymentPointQR",
+			method:  http.MethodGet,
+			path:    paymentPointPath + "/qr",
+			allowed: []string{jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "payPaymentPoint",
+			method:  http.MethodPost,
+			path:    paymentPointPath + "/pay",
+			body:    `{"sender":` + userBody + `,"amount":100}`,
+			allowed: []string{jwt.RoleCustomer},
+		},
+		{
+			name:    "createWebhook",
+			method:  http.MethodPost,
+			path:    "/api/v1/webhook/create",
+			body:    `{"url":"https://merchant.example.com/webhook","secret":"test-webhook-secret"}`,
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "listWebhookDeliveries",
+			method:  http.MethodGet,
+			path:    "/api/v1/webhook/" + uuid.NewString() + "/deliveries",
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "resendWebhookDelivery",
+			method:  http.MethodPost,
+			path:    "/api/v1/webhook/delivery/" + uuid.NewString() + "/resend",
+			allowed: []string{jwt.RoleMerchant},
+		},
+		{
+			name:    "getLedgerBalance",
+			method:  http.MethodGet,
+			path:    "/api/v1/ledger/account/wallet:" + uuid.NewString() + "/balance",
+			allowed: []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "getLedgerStatement",
+			method:  http.MethodGet,
+			path:    "/api/v1/ledger/account/platform:fees/statement",
+			allowed: []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin},
+		},
+		{
+			name:    "setFeePlan",
+			method:  http.MethodPost,
+			path:    "/api/v1/fee-plan/set",
+			body:    `{"merchant_id":"` + uuid.NewString() + `","method":"algorand","currency":"USD"}`,
+			allowed: []string{jwt.RoleAdmin},
+		},
+		{
+			name:    "getFeePlans",
+			method:  http.MethodGet,
+			path:    "/api/v1/fee-plan/merchant/" + uuid.NewString() + "/retrieve",
+			allowed: []string{jwt.RoleAdmin},
+		},
+		{
+			name:    "getSettlementReport",
+			method:  http.MethodGet,
+			path:    "/api/v1/report/settlement?date=2024-05-01",
+			allowed: []string{jwt.RoleAdmin},
+		},
+		{
+			name:    "unlockLogin",
+			method:  http.MethodPost,
+			path:    "/api/v1/admin/login/unlock",
+			body:    `{"email":"test@example.com"}`,
+			allowed: []string{jwt.RoleAdmin},
+		},
+	}
+
+	roles := []string{jwt.RoleCustomer, jwt.RoleMerchant, jwt.RoleAdmin}
+
+	for _, operation := range operations {
+		operation := operation
+
+		for _, role := range roles {
+			role := role
+
+			expectedStatus := http.StatusForbidden
+
+			for _, allowed := range operation.allowed {
+				if allowed == role {
+					expectedStatus = http.StatusOK
+				}
+			}
+
+			t.Run(operation.name+" as "+role, func(t *testing.T) {
+				t.Parallel()
+
+				rec := serve(server, operation.method, operation.path, operation.body, signToken(t, keySet, []string{role}))
+
+				assert.Equal(t, expectedStatus, rec.Code, rec.Body.String())
+			})
+		}
+
+		t.Run(operation.name+" without role", func(t *testing.T) {
+			t.Parallel()
+
+			rec := serve(server, operation.method, operation.path, operation.body, signToken(t, keySet, nil))
+
+			assert.Equal(t, http.StatusForbidden, rec.Code, rec.Body.String())
+		})
+
+		t.Run(operation.name+" without token", func(t *testing.T) {
+			t.Parallel()
+
+			rec := serve(server, operation.method, operation.path, operation.body, "")
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+		})
+
+		t.Run(operation.name+" with invalid token", func(t *testing.T) {
+			t.Parallel()
+
+			rec := serve(server, operation.method, operation.path, operation.body, "test-token")
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestPublicOperationAccess(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newAccessTestServer(t)
+
+	for path, body := range map[string]string{
+		"/api/v1/transaction/login":         `{"email":"test@example.com","password":"test-password"}`,
+		"/api/v1/transaction/login/2fa":     `{"challenge_token":"test-token","code":"123456"}`,
+		"/api/v1/transaction/login/refresh": `{"refresh_token":"test-token"}`,
+	} {
+		rec := serve(server, http.MethodPost, path, body, "")
+
+		assert.Equal(t, http.StatusOK, rec.Code, path)
+	}
+}
+
+func serve(server http.Handler, method, path, body, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+
+	return rec
+}
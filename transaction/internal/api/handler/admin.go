@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client/monitor"
+	monitor_models "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiAdmin "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/admin"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+const unlockLoginMethod = "unlockLogin"
+
+// UnlockLoginHandler handles the admin request to lift a login lockout.
+func (th *TransactionHandler) UnlockLoginHandler(params apiAdmin.UnlockLoginParams, principal interface{}) middleware.Responder {
+	from := transactionService
+	to := userService
+	method := unlockLoginMethod
+
+	var adminID string
+	if claims, ok := principal.(*jwt.Claims); ok {
+		adminID = claims.Subject
+	}
+
+	th.log.Info("Unlock login handler", map[string]interface{}{
+		"admin_id":  adminID,
+		"email":     *params.Body.Email,
+		"client_ip": params.Body.ClientIP,
+	})
+
+	_, err := th.monitorClient.Process(&monitor_client.ProcessParams{
+		Body: &monitor_models.ProcessRequest{
+			From:   &from,
+			To:     &to,
+			Method: &method,
+			Payload: map[string]interface{}{
+				"email":     *params.Body.Email,
+				"client_ip": params.Body.ClientIP,
+			},
+		},
+		Context: params.HTTPRequest.Context(),
+	})
+
+	var badRequestErr *monitor_client.ProcessBadRequest
+
+	switch {
+	case errors.As(err, &badRequestErr):
+		return apiAdmin.NewUnlockLoginBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiAdmin.UnlockLoginBadRequestCode),
+				Message: badRequestErr.GetPayload().Message,
+			})
+	case err != nil:
+		return apiAdmin.NewUnlockLoginInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiAdmin.UnlockLoginInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiAdmin.NewUnlockLoginNoContent()
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ClientIPResolver resolves the address of the caller used to count failed login attempts per ip.
+// The forwarded header is only trusted on requests coming from one of the trusted proxies,
+// otherwise the caller could set it to dodge the limit.
+type ClientIPResolver struct {
+	header  string
+	proxies []*net.IPNet
+}
+
+// NewClientIPResolver creates a new ClientIPResolver trusting the header, e.g. X-Forwarded-For,
+// set by the proxies within the given CIDRs. An empty header resolves the remote address only.
+func NewClientIPResolver(header string, trustedProxies []string) (*ClientIPResolver, error) {
+	proxies := make([]*net.IPNet, 0, len(trustedProxies))
+
+	for _, cidr := range trustedProxies {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trusted proxy %s: %w", cidr, err)
+		}
+
+		proxies = append(proxies, network)
+	}
+
+	return &ClientIPResolver{
+		header:  http.CanonicalHeaderKey(header),
+		proxies: proxies,
+	}, nil
+}
+
+// ClientIP returns the address of the caller. Behind trusted proxies it is the rightmost address
+// of the forwarded header that is not a trusted proxy itself, the addresses left of it are set by the caller.
+func (r *ClientIPResolver) ClientIP(req *http.Request) string {
+	remote := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+
+	if r == nil || r.header == "" || !r.trusted(net.ParseIP(remote)) {
+		return remote
+	}
+
+	var forwarded []string
+	for _, value := range req.Header.Values(r.header) {
+		forwarded = append(forwarded, strings.Split(value, ",")...)
+	}
+
+	client := remote
+
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(forwarded[i]))
+		if ip == nil {
+			break
+		}
+
+		client = ip.String()
+
+		if !r.trusted(ip) {
+			break
+		}
+	}
+
+	return client
+}
+
+func (r *ClientIPResolver) trusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range r.proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
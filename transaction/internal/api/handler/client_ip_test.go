@@ -0,0 +1,108 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientIP(t *testing.T) {
+	t.Parallel()
+
+	resolver, err := handler.NewClientIPResolver("x-forwarded-for", []string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	untrustedResolver, err := handler.NewClientIPResolver("", []string{"10.0.0.0/8"})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name       string
+		resolver   *handler.ClientIPResolver
+		remoteAddr string
+		forwarded  []string
+		expectedIP string
+	}{
+		{
+			name:       "Direct request",
+			resolver:   resolver,
+			remoteAddr: "203.0.113.7:5000",
+			expectedIP: "203.0.113.7",
+		},
+		{
+			name:       "Forwarded header from an untrusted caller",
+			resolver:   resolver,
+			remoteAddr: "203.0.113.7:5000",
+			forwarded:  []string{"198.51.100.1"},
+			expectedIP: "203.0.113.7",
+		},
+		{
+			name:       "Forwarded header from a trusted proxy",
+			resolver:   resolver,
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"198.51.100.1"},
+			expectedIP: "198.51.100.1",
+		},
+		{
+			name:       "Address spoofed by the caller behind a trusted proxy",
+			resolver:   resolver,
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"192.0.2.9, 198.51.100.1"},
+			expectedIP: "198.51.100.1",
+		},
+		{
+			name:       "Chain of trusted proxies",
+			resolver:   resolver,
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"198.51.100.1", "10.0.0.3"},
+			expectedIP: "198.51.100.1",
+		},
+		{
+			name:       "Malformed forwarded header",
+			resolver:   resolver,
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"unknown"},
+			expectedIP: "10.0.0.2",
+		},
+		{
+			name:       "Forwarded header not configured",
+			resolver:   untrustedResolver,
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"198.51.100.1"},
+			expectedIP: "10.0.0.2",
+		},
+		{
+			name:       "No resolver",
+			remoteAddr: "10.0.0.2:5000",
+			forwarded:  []string{"198.51.100.1"},
+			expectedIP: "10.0.0.2",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/login", nil)
+			req.RemoteAddr = testcase.remoteAddr
+
+			for _, value := range testcase.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			assert.Equal(t, testcase.expectedIP, testcase.resolver.ClientIP(req))
+		})
+	}
+}
+
+func TestNewClientIPResolver(t *testing.T) {
+	t.Parallel()
+
+	_, err := handler.NewClientIPResolver("X-Forwarded-For", []string{"10.0.0.1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/events"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+const (
+	eventStreamContentType = "text/event-stream"
+	statusEventName        = "status"
+
+	defaultHeartbeatInterval = 15 * time.Second
+)
+
+type EventsHandler struct {
+	transactionUsecase usecase.TransactionUsecase
+	broker             events.Broker
+	heartbeatInterval  time.Duration
+	log                logger.Logger
+}
+
+// NewEventsHandler creates a new instance of EventsHandler.
+// A heartbeat comment is written every heartbeatInterval to keep idle streams open behind proxies.
+func NewEventsHandler(
+	transactionUsecase usecase.TransactionUsecase,
+	broker events.Broker,
+	heartbeatInterval time.Duration,
+	log logger.Logger,
+) *EventsHandler {
+	if heartbeatInterval <= 0 {
+		heartbeatInterval = defaultHeartbeatInterval
+	}
+
+	return &EventsHandler{
+		transactionUsecase: transactionUsecase,
+		broker:             broker,
+		heartbeatInterval:  heartbeatInterval,
+		log:                log,
+	}
+}
+
+// StreamTransactionEventsHandler handles the request to stream status changes of a transaction as server-sent events.
+func (eh *EventsHandler) StreamTransactionEventsHandler(params apiTransaction.StreamTransactionEventsParams, _ interface{}) middleware.Responder {
+	lastEventID := swag.StringValue(params.LastEventID)
+
+	eh.log.Debug("Stream transaction events handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+		"last_event_id":  lastEventID,
+	})
+
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+
+	stream, err := eh.broker.Subscribe(ctx, params.ID.String(), lastEventID)
+
+	switch {
+	case errors.Is(err, events.ErrInvalidEventID):
+		cancel()
+
+		return jsonResponder(apiTransaction.NewStreamTransactionEventsBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.StreamTransactionEventsBadRequestCode),
+				Message: err.Error(),
+			}))
+	case err != nil:
+		cancel()
+
+		return jsonResponder(apiTransaction.NewStreamTransactionEventsInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.StreamTransactionEventsInternalServerErrorCode),
+				Message: err.Error(),
+			}))
+	}
+
+	// The status is read after subscribing, so a change in between is streamed rather than lost.
+	transactionStatus, err := eh.transactionUsecase.GetTransactionStatus(ctx, params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		cancel()
+
+		return jsonResponder(apiTransaction.NewStreamTransactionEventsNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.StreamTransactionEventsNotFoundCode),
+				Message: err.Error(),
+			}))
+	case err != nil:
+		cancel()
+
+		return jsonResponder(apiTransaction.NewStreamTransactionEventsInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.StreamTransactionEventsInternalServerErrorCode),
+				Message: err.Error(),
+			}))
+	}
+
+	var snapshot *model.TransactionStatusEvent
+
+	// A resumed stream replays what was missed instead of the current status.
+	if lastEventID == "" {
+		snapshot = &model.TransactionStatusEvent{
+			TransactionID: params.ID.String(),
+			Status:        transactionStatus,
+			OccurredAt:    time.Now().UTC(),
+		}
+	}
+
+	return eh.streamResponder(ctx, cancel, snapshot, stream)
+}
+
+// streamResponder writes the events until the client goes away or the stream ends.
+func (eh *EventsHandler) streamResponder(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	snapshot *model.TransactionStatusEvent,
+	stream <-chan *model.TransactionStatusEvent,
+) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		defer cancel()
+
+		controller := http.NewResponseController(rw)
+
+		// The stream outlives the write timeout of the server.
+		if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			eh.log.Warn("Failed to clear event stream write deadline", map[string]interface{}{
+				"error": err,
+			})
+		}
+
+		rw.Header().Set(runtime.HeaderContentType, eventStreamContentType)
+		rw.Header().Set("Cache-Control", "no-cache")
+		rw.Header().Set("X-Accel-Buffering", "no")
+		rw.WriteHeader(http.StatusOK)
+
+		if snapshot != nil {
+			if err := writeStatusEvent(rw, snapshot); err != nil {
+				return
+			}
+		}
+
+		if err := controller.Flush(); err != nil {
+			return
+		}
+
+		heartbeat := time.NewTicker(eh.heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			var err error
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-heartbeat.C:
+				_, err = io.WriteString(rw, ": heartbeat\n\n")
+			case event, ok := <-stream:
+				if !ok {
+					return
+				}
+
+				err = writeStatusEvent(rw, event)
+			}
+
+			if err == nil {
+				err = controller.Flush()
+			}
+
+			if err != nil {
+				eh.log.Debug("Stop transaction event stream", map[string]interface{}{
+					"error": err,
+				})
+
+				return
+			}
+		}
+	})
+}
+
+// writeStatusEvent writes the event in the server-sent events format, the id lets the client resume after it.
+func writeStatusEvent(w io.Writer, event *model.TransactionStatusEvent) error {
+	data, err := json.Marshal(event.ToTransactionStatusEventDTO())
+	if err != nil {
+		return err
+	}
+
+	if event.ID != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", event.ID); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", statusEventName, data)
+
+	return err
+}
+
+// jsonResponder writes the error response as JSON,
+// the producer negotiated for an event stream request cannot write it.
+func jsonResponder(response middleware.Responder) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+
+		response.WriteResponse(rw, runtime.JSONProducer())
+	})
+}
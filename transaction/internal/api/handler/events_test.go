@@ -0,0 +1,194 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/events"
+	mock_events "github.com/ShmelJUJ/software-engineering/transaction/internal/events/mocks"
+	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testLastEventID = "1714564800000-0"
+
+func TestStreamTransactionEventsHandler(t *testing.T) {
+	t.Parallel()
+
+	succeeded := &model.TransactionStatusEvent{
+		ID:            "1714564801000-0",
+		TransactionID: testTransactionID,
+		Status:        model.Succeeded,
+		OccurredAt:    time.Date(2024, time.May, 1, 12, 0, 1, 0, time.UTC),
+	}
+
+	testcases := []struct {
+		name              string
+		lastEventID       string
+		streamed          []*model.TransactionStatusEvent
+		subscribeErr      error
+		status            model.TransactionStatus
+		statusErr         error
+		expectedStatus    int
+		expectedFragments []string
+		unexpected        string
+	}{
+		{
+			name:           "Stream current status and changes",
+			streamed:       []*model.TransactionStatusEvent{succeeded},
+			status:         model.Processed,
+			expectedStatus: http.StatusOK,
+			expectedFragments: []string{
+				"event: status\ndata: {\"occurred_at\":",
+				`"status":"processed","transaction_id":"` + testTransactionID + `"}` + "\n\n",
+				"id: 1714564801000-0\nevent: status\ndata: ",
+				`"status":"succeeded"`,
+			},
+		},
+		{
+			name:              "Resume without current status",
+			lastEventID:       testLastEventID,
+			streamed:          []*model.TransactionStatusEvent{succeeded},
+			status:            model.Succeeded,
+			expectedStatus:    http.StatusOK,
+			expectedFragments: []string{"id: 1714564801000-0\n"},
+			unexpected:        `"status":"processed"`,
+		},
+		{
+			name:           "Malformed last event id",
+			lastEventID:    "not-an-id",
+			subscribeErr:   events.ErrInvalidEventID,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failed to subscribe",
+			subscribeErr:   errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "Transaction not found",
+			statusErr:      usecase.ErrTransactionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			stream := make(chan *model.TransactionStatusEvent, len(testcase.streamed))
+			for _, event := range testcase.streamed {
+				stream <- event
+			}
+
+			close(stream)
+
+			broker := mock_events.NewMockBroker(mockCtrl)
+			broker.EXPECT().
+				Subscribe(gomock.Any(), testTransactionID, testcase.lastEventID).
+				Return(stream, testcase.subscribeErr)
+
+			transactionUsecase := mock_usecase.NewMockTransactionUsecase(mockCtrl)
+			if testcase.subscribeErr == nil {
+				transactionUsecase.EXPECT().
+					GetTransactionStatus(gomock.Any(), testTransactionID).
+					Return(testcase.status, testcase.statusErr)
+			}
+
+			eventsHandler := handler.NewEventsHandler(transactionUsecase, broker, time.Minute, l)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/transaction/"+testTransactionID+"/events", nil)
+
+			params := apiTransaction.StreamTransactionEventsParams{
+				HTTPRequest: req,
+				ID:          strfmt.UUID(testTransactionID),
+			}
+			if testcase.lastEventID != "" {
+				params.LastEventID = &testcase.lastEventID
+			}
+
+			responder := eventsHandler.StreamTransactionEventsHandler(params, nil)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.expectedStatus != http.StatusOK {
+				assert.Equal(t, runtime.JSONMime, rec.Header().Get(runtime.HeaderContentType))
+
+				return
+			}
+
+			assert.Equal(t, "text/event-stream", rec.Header().Get(runtime.HeaderContentType))
+
+			for _, fragment := range testcase.expectedFragments {
+				assert.Contains(t, rec.Body.String(), fragment)
+			}
+
+			if testcase.unexpected != "" {
+				assert.NotContains(t, rec.Body.String(), testcase.unexpected)
+			}
+		})
+	}
+}
+
+func TestStreamTransactionEventsHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	stream := make(chan *model.TransactionStatusEvent)
+
+	broker := mock_events.NewMockBroker(mockCtrl)
+	broker.EXPECT().
+		Subscribe(gomock.Any(), testTransactionID, "").
+		Return(stream, nil)
+
+	transactionUsecase := mock_usecase.NewMockTransactionUsecase(mockCtrl)
+	transactionUsecase.EXPECT().
+		GetTransactionStatus(gomock.Any(), testTransactionID).
+		Return(model.Processed, nil)
+
+	eventsHandler := handler.NewEventsHandler(transactionUsecase, broker, 10*time.Millisecond, l)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/transaction/"+testTransactionID+"/events", nil).
+		WithContext(ctx)
+
+	responder := eventsHandler.StreamTransactionEventsHandler(apiTransaction.StreamTransactionEventsParams{
+		HTTPRequest: req,
+		ID:          strfmt.UUID(testTransactionID),
+	}, nil)
+
+	rec := httptest.NewRecorder()
+	responder.WriteResponse(rec, runtime.JSONProducer())
+
+	assert.GreaterOrEqual(t, strings.Count(rec.Body.String(), ": heartbeat\n\n"), 2)
+}
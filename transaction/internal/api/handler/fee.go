@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiFee "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+type FeeHandler struct {
+	feeUsecase usecase.FeeUsecase
+	log        logger.Logger
+}
+
+// NewFeeHandler creates a new instance of FeeHandler.
+func NewFeeHandler(
+	feeUsecase usecase.FeeUsecase,
+	log logger.Logger,
+) *FeeHandler {
+	return &FeeHandler{
+		feeUsecase: feeUsecase,
+		log:        log,
+	}
+}
+
+// SetFeePlanHandler handles the admin request to set the fee plan of a merchant.
+func (fh *FeeHandler) SetFeePlanHandler(params apiFee.SetFeePlanParams, _ interface{}) middleware.Responder {
+	fh.log.Debug("Set fee plan handler", map[string]interface{}{
+		"body": params.Body,
+	})
+
+	plan, err := model.FromSetFeePlanDTO(params.Body)
+	if err != nil {
+		return apiFee.NewSetFeePlanBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiFee.SetFeePlanBadRequestCode),
+				Message: err.Error(),
+			})
+	}
+
+	if err := fh.feeUsecase.SetFeePlan(params.HTTPRequest.Context(), plan); err != nil {
+		return apiFee.NewSetFeePlanInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiFee.SetFeePlanInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiFee.NewSetFeePlanOK().
+		WithPayload(plan.ToFeePlanDTO())
+}
+
+// GetFeePlansHandler handles the admin request to retrieve the fee plans of a merchant.
+func (fh *FeeHandler) GetFeePlansHandler(params apiFee.GetFeePlansParams, _ interface{}) middleware.Responder {
+	fh.log.Debug("Get fee plans handler", map[string]interface{}{
+		"merchant_id": params.ID.String(),
+	})
+
+	plans, err := fh.feeUsecase.GetFeePlans(params.HTTPRequest.Context(), params.ID.String())
+	if err != nil {
+		return apiFee.NewGetFeePlansInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiFee.GetFeePlansInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	response := &models.GetFeePlansResponse{
+		Plans: make([]*models.FeePlanResponse, 0, len(plans)),
+	}
+
+	for _, plan := range plans {
+		response.Plans = append(response.Plans, plan.ToFeePlanDTO())
+	}
+
+	return apiFee.NewGetFeePlansOK().
+		WithPayload(response)
+}
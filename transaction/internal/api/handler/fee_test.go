@@ -0,0 +1,114 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiFee "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func feeHandlerHelper(t *testing.T) (*handler.FeeHandler, *mock_usecase.MockFeeUsecase) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	feeUsecase := mock_usecase.NewMockFeeUsecase(mockCtrl)
+
+	return handler.NewFeeHandler(feeUsecase, l), feeUsecase
+}
+
+func TestSetFeePlanHandler(t *testing.T) {
+	t.Parallel()
+
+	merchantID := strfmt.UUID(testMerchantID)
+
+	testcases := []struct {
+		name           string
+		percentage     string
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "Successfully set fee plan",
+			percentage:     "2.9",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid percentage",
+			percentage:     "101",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failed to set fee plan",
+			percentage:     "2.9",
+			err:            errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			feeHandler, feeUsecase := feeHandlerHelper(t)
+
+			if testcase.expectedStatus != http.StatusBadRequest {
+				feeUsecase.EXPECT().
+					SetFeePlan(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, plan *model.FeePlan) error {
+						assert.Equal(t, testMerchantID, plan.MerchantID)
+						assert.Equal(t, int64(290), plan.PercentageBps)
+
+						return testcase.err
+					})
+			}
+
+			responder := feeHandler.SetFeePlanHandler(apiFee.SetFeePlanParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/fee-plan/set", nil),
+				Body: &models.SetFeePlanRequest{
+					MerchantID:  &merchantID,
+					Method:      swag.String("algorand"),
+					Currency:    swag.String("USD"),
+					FixedAmount: swag.Int64(30),
+					Percentage:  testcase.percentage,
+				},
+			}, nil)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response models.FeePlanResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+			assert.Equal(t, merchantID, *response.MerchantID)
+			assert.Equal(t, "2.9", *response.Percentage)
+			assert.Equal(t, int64(30), *response.FixedAmount)
+			assert.Empty(t, response.Tiers)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiLedger "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+type LedgerHandler struct {
+	ledgerUsecase usecase.LedgerUsecase
+	log           logger.Logger
+}
+
+// NewLedgerHandler creates a new instance of LedgerHandler.
+func NewLedgerHandler(
+	ledgerUsecase usecase.LedgerUsecase,
+	log logger.Logger,
+) *LedgerHandler {
+	return &LedgerHandler{
+		ledgerUsecase: ledgerUsecase,
+		log:           log,
+	}
+}
+
+// GetLedgerBalanceHandler handles the request to retrieve the balances of a ledger account.
+func (lh *LedgerHandler) GetLedgerBalanceHandler(params apiLedger.GetLedgerBalanceParams, principal interface{}) middleware.Responder {
+	lh.log.Debug("Get ledger balance handler", map[string]interface{}{
+		"account_id": params.ID,
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiLedger.NewGetLedgerBalanceForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiLedger.GetLedgerBalanceForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	account, balances, err := lh.ledgerUsecase.GetBalances(params.HTTPRequest.Context(), ownerID(claims), params.ID)
+
+	switch {
+	case errors.Is(err, usecase.ErrLedgerAccountNotFound):
+		return apiLedger.NewGetLedgerBalanceNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiLedger.GetLedgerBalanceNotFoundCode),
+				Message: usecase.ErrLedgerAccountNotFound.Error(),
+			})
+	case err != nil:
+		return apiLedger.NewGetLedgerBalanceInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiLedger.GetLedgerBalanceInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	kind := string(account.Kind)
+
+	response := &models.GetLedgerBalanceResponse{
+		AccountID: &account.ID,
+		Kind:      &kind,
+		Balances:  make([]*models.LedgerBalanceResponse, 0, len(balances)),
+	}
+
+	for _, balance := range balances {
+		response.Balances = append(response.Balances, balance.ToLedgerBalanceDTO())
+	}
+
+	return apiLedger.NewGetLedgerBalanceOK().
+		WithPayload(response)
+}
+
+// GetLedgerStatementHandler handles the request to retrieve the postings of a ledger account.
+func (lh *LedgerHandler) GetLedgerStatementHandler(params apiLedger.GetLedgerStatementParams, principal interface{}) middleware.Responder {
+	lh.log.Debug("Get ledger statement handler", map[string]interface{}{
+		"account_id": params.ID,
+		"limit":      *params.Limit,
+		"offset":     *params.Offset,
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiLedger.NewGetLedgerStatementForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiLedger.GetLedgerStatementForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	lines, err := lh.ledgerUsecase.GetStatement(
+		params.HTTPRequest.Context(),
+		ownerID(claims),
+		params.ID,
+		uint64(*params.Limit),
+		uint64(*params.Offset),
+	)
+
+	switch {
+	case errors.Is(err, usecase.ErrLedgerAccountNotFound):
+		return apiLedger.NewGetLedgerStatementNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiLedger.GetLedgerStatementNotFoundCode),
+				Message: usecase.ErrLedgerAccountNotFound.Error(),
+			})
+	case err != nil:
+		return apiLedger.NewGetLedgerStatementInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiLedger.GetLedgerStatementInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	response := &models.GetLedgerStatementResponse{
+		AccountID: &params.ID,
+		Lines:     make([]*models.LedgerStatementLineResponse, 0, len(lines)),
+	}
+
+	for _, line := range lines {
+		response.Lines = append(response.Lines, line.ToLedgerStatementLineDTO())
+	}
+
+	return apiLedger.NewGetLedgerStatementOK().
+		WithPayload(response)
+}
+
+// ownerID returns whose accounts and mandates the principal may see, administrators see every one.
+func ownerID(claims *jwt.Claims) string {
+	if claims.HasRole(jwt.RoleAdmin) {
+		return ""
+	}
+
+	return claims.Subject
+}
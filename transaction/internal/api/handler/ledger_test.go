@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiLedger "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testLedgerWalletID = "8f4a6b5c-7d8e-4f9a-0b1c-2d3e4f5a6b7c"
+
+func ledgerHandlerHelper(t *testing.T) (*handler.LedgerHandler, *mock_usecase.MockLedgerUsecase) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ledgerUsecase := mock_usecase.NewMockLedgerUsecase(mockCtrl)
+
+	return handler.NewLedgerHandler(ledgerUsecase, l), ledgerUsecase
+}
+
+func TestGetLedgerBalanceHandler(t *testing.T) {
+	t.Parallel()
+
+	account := ledger.NewWalletAccount(testMerchantID, testLedgerWalletID)
+	adminClaims := &jwt.Claims{
+		Subject: "admin-id",
+		Roles:   []string{jwt.RoleAdmin},
+	}
+
+	testcases := []struct {
+		name            string
+		principal       interface{}
+		expectedOwnerID string
+		err             error
+		expectedStatus  int
+	}{
+		{
+			name:            "Successfully get own balance",
+			principal:       testMerchantClaims,
+			expectedOwnerID: testMerchantID,
+			expectedStatus:  http.StatusOK,
+		},
+		{
+			name:            "Administrator gets any balance",
+			principal:       adminClaims,
+			expectedOwnerID: "",
+			expectedStatus:  http.StatusOK,
+		},
+		{
+			name:           "Unknown principal",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:            "Account not found",
+			principal:       testMerchantClaims,
+			expectedOwnerID: testMerchantID,
+			err:             usecase.ErrLedgerAccountNotFound,
+			expectedStatus:  http.StatusNotFound,
+		},
+		{
+			name:            "Failed to get balance",
+			principal:       testMerchantClaims,
+			expectedOwnerID: testMerchantID,
+			err:             errors.New("test err"),
+			expectedStatus:  http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ledgerHandler, ledgerUsecase := ledgerHandlerHelper(t)
+
+			if testcase.principal != nil {
+				var balances []*ledger.Balance
+				if testcase.err == nil {
+					balances = []*ledger.Balance{{Currency: "ALGO", Debits: 1_500_000, Credits: 4_000_000}}
+				}
+
+				ledgerUsecase.EXPECT().
+					GetBalances(gomock.Any(), testcase.expectedOwnerID, account.ID).
+					Return(account, balances, testcase.err)
+			}
+
+			responder := ledgerHandler.GetLedgerBalanceHandler(apiLedger.GetLedgerBalanceParams{
+				HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/ledger/account/"+account.ID+"/balance", nil),
+				ID:          account.ID,
+			}, testcase.principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var response models.GetLedgerBalanceResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.Len(t, response.Balances, 1)
+
+			assert.Equal(t, account.ID, *response.AccountID)
+			assert.Equal(t, "wallet", *response.Kind)
+			assert.Equal(t, int64(2_500_000), *response.Balances[0].Balance)
+			assert.Equal(t, "2.500000", response.Balances[0].BalanceDecimal)
+		})
+	}
+}
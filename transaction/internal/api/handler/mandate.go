@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiMandate "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/mandate"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+type MandateHandler struct {
+	mandateUsecase usecase.MandateUsecase
+	log            logger.Logger
+}
+
+// NewMandateHandler creates a new instance of MandateHandler.
+func NewMandateHandler(
+	mandateUsecase usecase.MandateUsecase,
+	log logger.Logger,
+) *MandateHandler {
+	return &MandateHandler{
+		mandateUsecase: mandateUsecase,
+		log:            log,
+	}
+}
+
+// CreateMandateHandler handles the request of the payer to create a recurring payment mandate charged from their wallet.
+func (mh *MandateHandler) CreateMandateHandler(params apiMandate.CreateMandateParams, principal interface{}) middleware.Responder {
+	mh.log.Debug("Create mandate handler", map[string]interface{}{
+		"body": params.Body,
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiMandate.NewCreateMandateForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CreateMandateForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	mandate, err := model.FromCreateMandateDTO(claims.Subject, params.Body)
+	if err != nil {
+		return apiMandate.NewCreateMandateBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CreateMandateBadRequestCode),
+				Message: err.Error(),
+			})
+	}
+
+	err = mh.mandateUsecase.CreateMandate(params.HTTPRequest.Context(), mandate)
+
+	switch {
+	case errors.Is(err, usecase.ErrMandateNeedsConfirmation):
+		return apiMandate.NewCreateMandateBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CreateMandateBadRequestCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiMandate.NewCreateMandateInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CreateMandateInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiMandate.NewCreateMandateOK().
+		WithPayload(&models.CreateMandateResponse{
+			MandateID: (*strfmt.UUID)(&mandate.ID),
+		})
+}
+
+// RetrieveMandateHandler handles the request of the payer or the receiver to retrieve a mandate.
+func (mh *MandateHandler) RetrieveMandateHandler(params apiMandate.RetrieveMandateParams, principal interface{}) middleware.Responder {
+	mh.log.Debug("Retrieve mandate handler", map[string]interface{}{
+		"mandate_id": params.ID.String(),
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiMandate.NewRetrieveMandateForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.RetrieveMandateForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	mandate, err := mh.mandateUsecase.GetMandate(params.HTTPRequest.Context(), ownerID(claims), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrMandateNotFound):
+		return apiMandate.NewRetrieveMandateNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.RetrieveMandateNotFoundCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiMandate.NewRetrieveMandateInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.RetrieveMandateInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiMandate.NewRetrieveMandateOK().
+		WithPayload(mandate.ToGetMandateDTO())
+}
+
+// PauseMandateHandler handles the request of the payer to pause a mandate.
+func (mh *MandateHandler) PauseMandateHandler(params apiMandate.PauseMandateParams, principal interface{}) middleware.Responder {
+	mh.log.Debug("Pause mandate handler", map[string]interface{}{
+		"mandate_id": params.ID.String(),
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiMandate.NewPauseMandateForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.PauseMandateForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	err := mh.mandateUsecase.PauseMandate(params.HTTPRequest.Context(), ownerID(claims), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrMandateNotFound):
+		return apiMandate.NewPauseMandateNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.PauseMandateNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrMandateTransition):
+		return apiMandate.NewPauseMandateConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.PauseMandateConflictCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiMandate.NewPauseMandateInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.PauseMandateInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiMandate.NewPauseMandateOK()
+}
+
+// ResumeMandateHandler handles the request of the payer to resume a paused or unpaid mandate.
+func (mh *MandateHandler) ResumeMandateHandler(params apiMandate.ResumeMandateParams, principal interface{}) middleware.Responder {
+	mh.log.Debug("Resume mandate handler", map[string]interface{}{
+		"mandate_id": params.ID.String(),
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiMandate.NewResumeMandateForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.ResumeMandateForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	err := mh.mandateUsecase.ResumeMandate(params.HTTPRequest.Context(), ownerID(claims), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrMandateNotFound):
+		return apiMandate.NewResumeMandateNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.ResumeMandateNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrMandateTransition):
+		return apiMandate.NewResumeMandateConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.ResumeMandateConflictCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiMandate.NewResumeMandateInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.ResumeMandateInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiMandate.NewResumeMandateOK()
+}
+
+// CancelMandateHandler handles the request of the payer or the receiver to cancel a mandate.
+func (mh *MandateHandler) CancelMandateHandler(params apiMandate.CancelMandateParams, principal interface{}) middleware.Responder {
+	mh.log.Debug("Cancel mandate handler", map[string]interface{}{
+		"mandate_id": params.ID.String(),
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiMandate.NewCancelMandateForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CancelMandateForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	err := mh.mandateUsecase.CancelMandate(params.HTTPRequest.Context(), ownerID(claims), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrMandateNotFound):
+		return apiMandate.NewCancelMandateNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CancelMandateNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrMandateTransition):
+		return apiMandate.NewCancelMandateConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CancelMandateConflictCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiMandate.NewCancelMandateInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiMandate.CancelMandateInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiMandate.NewCancelMandateOK()
+}
@@ -0,0 +1,175 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiMandate "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/mandate"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const testMandateID = "2d4e6f8a-0b1c-4d3e-9f5a-7b8c9d0e1f2a"
+
+func mandateHandlerHelper(t *testing.T) (*handler.MandateHandler, *mock_usecase.MockMandateUsecase) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mandateUsecase := mock_usecase.NewMockMandateUsecase(mockCtrl)
+
+	return handler.NewMandateHandler(mandateUsecase, l), mandateUsecase
+}
+
+func TestCreateMandateHandler(t *testing.T) {
+	t.Parallel()
+
+	receiverID := strfmt.UUID(testMerchantID)
+	walletID := strfmt.UUID(testPayerWalletID)
+	endsAt := strfmt.DateTime(time.Now().UTC().AddDate(1, 0, 0))
+	payerClaims := &jwt.Claims{
+		Subject: testPayerID,
+		Roles:   []string{jwt.RoleCustomer},
+	}
+
+	testcases := []struct {
+		name           string
+		principal      interface{}
+		mock           func(*mock_usecase.MockMandateUsecase)
+		expectedStatus int
+	}{
+		{
+			name:      "Mandate is charged from the authenticated payer",
+			principal: payerClaims,
+			mock: func(mmu *mock_usecase.MockMandateUsecase) {
+				mmu.EXPECT().
+					CreateMandate(gomock.Any(), gomock.Cond(func(x any) bool {
+						mandate, ok := x.(*model.Mandate)
+
+						return ok && mandate.Payer.UserID == testPayerID && mandate.Payer.WalletID == testPayerWalletID
+					})).
+					Return(nil).
+					Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown principal",
+			mock:           func(*mock_usecase.MockMandateUsecase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mandateHandler, mandateUsecase := mandateHandlerHelper(t)
+			testcase.mock(mandateUsecase)
+
+			responder := mandateHandler.CreateMandateHandler(apiMandate.CreateMandateParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/mandate/create", nil),
+				Body: &models.CreateMandateRequest{
+					Receiver:      &models.CreateTransactionUserRequest{UserID: &receiverID, WalletID: &walletID},
+					PayerWalletID: &walletID,
+					MoneyInfo: &models.MoneyInfo{
+						Amount:   swag.Int64(999),
+						Currency: swag.String("USD"),
+						Method:   swag.String("algorand"),
+					},
+					Interval: swag.String("month"),
+					EndsAt:   &endsAt,
+				},
+			}, testcase.principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			assert.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestCancelMandateHandler(t *testing.T) {
+	t.Parallel()
+
+	adminClaims := &jwt.Claims{
+		Subject: "admin-id",
+		Roles:   []string{jwt.RoleAdmin},
+	}
+
+	testcases := []struct {
+		name           string
+		principal      interface{}
+		mock           func(*mock_usecase.MockMandateUsecase)
+		expectedStatus int
+	}{
+		{
+			name:      "Successfully cancel own mandate",
+			principal: testMerchantClaims,
+			mock: func(mmu *mock_usecase.MockMandateUsecase) {
+				mmu.EXPECT().CancelMandate(gomock.Any(), testMerchantID, testMandateID).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Administrator cancels any mandate",
+			principal: adminClaims,
+			mock: func(mmu *mock_usecase.MockMandateUsecase) {
+				mmu.EXPECT().CancelMandate(gomock.Any(), "", testMandateID).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Mandate of another user",
+			principal: testMerchantClaims,
+			mock: func(mmu *mock_usecase.MockMandateUsecase) {
+				mmu.EXPECT().CancelMandate(gomock.Any(), testMerchantID, testMandateID).Return(usecase.ErrMandateNotFound).Times(1)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Unknown principal",
+			mock:           func(*mock_usecase.MockMandateUsecase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mandateHandler, mandateUsecase := mandateHandlerHelper(t)
+			testcase.mock(mandateUsecase)
+
+			responder := mandateHandler.CancelMandateHandler(apiMandate.CancelMandateParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/mandate/"+testMandateID+"/cancel", nil),
+				ID:          strfmt.UUID(testMandateID),
+			}, testcase.principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			assert.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
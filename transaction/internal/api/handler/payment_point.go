@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/qr"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiPaymentPoint "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/payment_point"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+type PaymentPointHandler struct {
+	paymentPointUsecase usecase.PaymentPointUsecase
+	serviceURL          string
+	log                 logger.Logger
+}
+
+// NewPaymentPointHandler creates a new instance of PaymentPointHandler.
+// The serviceURL is written into the static QR codes so that payer apps know where to pay.
+func NewPaymentPointHandler(
+	paymentPointUsecase usecase.PaymentPointUsecase,
+	serviceURL string,
+	log logger.Logger,
+) *PaymentPointHandler {
+	return &PaymentPointHandler{
+		paymentPointUsecase: paymentPointUsecase,
+		serviceURL:          serviceURL,
+		log:                 log,
+	}
+}
+
+// CreatePaymentPointHandler handles the request to create a static payment point.
+func (ph *PaymentPointHandler) CreatePaymentPointHandler(params apiPaymentPoint.CreatePaymentPointParams, _ interface{}) middleware.Responder {
+	ph.log.Debug("Create payment point handler", map[string]interface{}{
+		"body": params.Body,
+	})
+
+	paymentPoint, err := model.FromCreatePaymentPointDTO(params.Body)
+	if err != nil {
+		return apiPaymentPoint.NewCreatePaymentPointBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.CreatePaymentPointBadRequestCode),
+				Message: err.Error(),
+			})
+	}
+
+	if paymentPoint.MinAmount != nil && paymentPoint.MaxAmount != nil && *paymentPoint.MinAmount > *paymentPoint.MaxAmount {
+		return apiPaymentPoint.NewCreatePaymentPointBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.CreatePaymentPointBadRequestCode),
+				Message: "min_amount must not be above max_amount",
+			})
+	}
+
+	if err := ph.paymentPointUsecase.CreatePaymentPoint(
+		params.HTTPRequest.Context(),
+		paymentPoint,
+	); err != nil {
+		return apiPaymentPoint.NewCreatePaymentPointInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.CreatePaymentPointInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiPaymentPoint.NewCreatePaymentPointOK().
+		WithPayload(&models.CreatePaymentPointResponse{
+			PaymentPointID: (*strfmt.UUID)(&paymentPoint.ID),
+		})
+}
+
+// RetrievePaymentPointHandler handles the request to retrieve a payment point.
+func (ph *PaymentPointHandler) RetrievePaymentPointHandler(params apiPaymentPoint.RetrievePaymentPointParams, _ interface{}) middleware.Responder {
+	ph.log.Debug("Retrieve payment point handler", map[string]interface{}{
+		"payment_point_id": params.ID.String(),
+	})
+
+	paymentPoint, err := ph.paymentPointUsecase.GetPaymentPoint(params.HTTPRequest.Context(), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrPaymentPointNotFound):
+		return apiPaymentPoint.NewRetrievePaymentPointNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.RetrievePaymentPointNotFoundCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiPaymentPoint.NewRetrievePaymentPointInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.RetrievePaymentPointInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiPaymentPoint.NewRetrievePaymentPointOK().
+		WithPayload(paymentPoint.ToGetPaymentPointDTO())
+}
+
+// DisablePaymentPointHandler handles the request to disable a payment point.
+func (ph *PaymentPointHandler) DisablePaymentPointHandler(params apiPaymentPoint.DisablePaymentPointParams, _ interface{}) middleware.Responder {
+	ph.log.Debug("Disable payment point handler", map[string]interface{}{
+		"payment_point_id": params.ID.String(),
+	})
+
+	err := ph.paymentPointUsecase.DisablePaymentPoint(params.HTTPRequest.Context(), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrPaymentPointNotFound):
+		return apiPaymentPoint.NewDisablePaymentPointNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.DisablePaymentPointNotFoundCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiPaymentPoint.NewDisablePaymentPointInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.DisablePaymentPointInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiPaymentPoint.NewDisablePaymentPointOK()
+}
+
+// GetPaymentPointQRHandler handles the request to render the static QR code of a payment point.
+func (ph *PaymentPointHandler) GetPaymentPointQRHandler(params apiPaymentPoint.GetPaymentPointQRParams, _ interface{}) middleware.Responder {
+	ph.log.Debug("Get payment point qr handler", map[string]interface{}{
+		"payment_point_id": params.ID.String(),
+		"format":           *params.Format,
+		"size":             *params.Size,
+		"level":            *params.Level,
+	})
+
+	paymentPoint, err := ph.paymentPointUsecase.GetPaymentPoint(params.HTTPRequest.Context(), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrPaymentPointNotFound):
+		return apiPaymentPoint.NewGetPaymentPointQRNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.GetPaymentPointQRNotFoundCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiPaymentPoint.NewGetPaymentPointQRInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.GetPaymentPointQRInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	if !paymentPoint.Active {
+		return apiPaymentPoint.NewGetPaymentPointQRGone().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.GetPaymentPointQRGoneCode),
+				Message: usecase.ErrPaymentPointDisabled.Error(),
+			})
+	}
+
+	content, err := qr.EncodePoint(&qr.PointPayload{
+		PointID:    paymentPoint.ID,
+		Currency:   paymentPoint.Currency,
+		Method:     paymentPoint.Method,
+		ServiceURL: ph.serviceURL,
+		MinAmount:  paymentPoint.MinAmount,
+		MaxAmount:  paymentPoint.MaxAmount,
+	})
+	if err != nil {
+		return apiPaymentPoint.NewGetPaymentPointQRInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.GetPaymentPointQRInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	contentType, image, err := renderQR(content, *params.Format, *params.Size, *params.Level)
+	if err != nil {
+		return apiPaymentPoint.NewGetPaymentPointQRInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.GetPaymentPointQRInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return fileResponder(contentType, apiPaymentPoint.NewGetPaymentPointQROK().
+		WithPayload(io.NopCloser(bytes.NewReader(image))))
+}
+
+// PayPaymentPointHandler handles the request to pay the payer-entered amount to a payment point.
+// The sender must be the principal, nobody pays on behalf of another payer.
+func (ph *PaymentPointHandler) PayPaymentPointHandler(params apiPaymentPoint.PayPaymentPointParams, principal interface{}) middleware.Responder {
+	ph.log.Debug("Pay payment point handler", map[string]interface{}{
+		"payment_point_id": params.ID.String(),
+		"body":             params.Body,
+	})
+
+	sender := model.FromAcceptTransactionUserDTO(params.Body.Sender)
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok || sender == nil || sender.UserID != claims.Subject {
+		return apiPaymentPoint.NewPayPaymentPointForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.PayPaymentPointForbiddenCode),
+				Message: usecase.ErrNotSender.Error(),
+			})
+	}
+
+	confirmationMethod := model.CodeConfirmation
+	if params.Body.ConfirmationMethod != nil {
+		confirmationMethod = model.ConfirmationMethod(*params.Body.ConfirmationMethod)
+	}
+
+	transaction, pendingConfirmation, err := ph.paymentPointUsecase.PayPaymentPoint(
+		params.HTTPRequest.Context(),
+		params.ID.String(),
+		sender,
+		*params.Body.Amount,
+		confirmationMethod,
+	)
+
+	switch {
+	case errors.Is(err, usecase.ErrPaymentPointNotFound):
+		return apiPaymentPoint.NewPayPaymentPointNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.PayPaymentPointNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrPaymentPointDisabled):
+		return apiPaymentPoint.NewPayPaymentPointGone().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.PayPaymentPointGoneCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrAmountOutOfRange):
+		return apiPaymentPoint.NewPayPaymentPointUnprocessableEntity().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.PayPaymentPointUnprocessableEntityCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiPaymentPoint.NewPayPaymentPointInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiPaymentPoint.PayPaymentPointInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	response := &models.PayPaymentPointResponse{
+		TransactionID: (*strfmt.UUID)(&transaction.ID),
+	}
+
+	if pendingConfirmation != nil {
+		method := string(pendingConfirmation.Method)
+		expiresAt := strfmt.DateTime(pendingConfirmation.ExpiresAt)
+
+		response.Confirmation = &models.ConfirmationRequiredResponse{
+			ConfirmationMethod: &method,
+			TransactionHash:    &pendingConfirmation.TransactionHash,
+			ExpiresAt:          &expiresAt,
+		}
+
+		return apiPaymentPoint.NewPayPaymentPointAccepted().
+			WithPayload(response)
+	}
+
+	return apiPaymentPoint.NewPayPaymentPointOK().
+		WithPayload(response)
+}
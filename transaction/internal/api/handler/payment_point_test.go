@@ -0,0 +1,234 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiPaymentPoint "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/payment_point"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPaymentPointID = "0d4b8f7c-5b0f-4a41-9d55-8a5d8a0b9e57"
+	testServiceURL     = "http://localhost:8083/api/v1"
+)
+
+func TestPayPaymentPointHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := strfmt.UUID("3f0c8a5e-8f2d-4a51-a1f4-2d3f1d6c7b10")
+	walletID := strfmt.UUID("7a9e1f0b-6d0c-4f5a-9b8e-1c2d3e4f5a6b")
+
+	transaction := &model.Transaction{
+		ID: testTransactionID,
+	}
+	pendingConfirmation := &model.Confirmation{
+		Method:          model.SignatureConfirmation,
+		TransactionHash: "test-hash",
+		ExpiresAt:       time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	payerClaims := &jwt.Claims{
+		Subject: userID.String(),
+		Roles:   []string{jwt.RoleCustomer},
+	}
+
+	testcases := []struct {
+		name                 string
+		principal            *jwt.Claims
+		transaction          *model.Transaction
+		confirmation         *model.Confirmation
+		err                  error
+		expectedStatus       int
+		expectedConfirmation bool
+	}{
+		{
+			name:           "Successfully pay",
+			transaction:    transaction,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "Confirmation required",
+			transaction:          transaction,
+			confirmation:         pendingConfirmation,
+			expectedStatus:       http.StatusAccepted,
+			expectedConfirmation: true,
+		},
+		{
+			name:           "Payment point not found",
+			err:            usecase.ErrPaymentPointNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Payment point disabled",
+			err:            usecase.ErrPaymentPointDisabled,
+			expectedStatus: http.StatusGone,
+		},
+		{
+			name:           "Amount out of range",
+			err:            usecase.ErrAmountOutOfRange,
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Failed to pay",
+			err:            errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "Pay on behalf of another payer",
+			principal:      &jwt.Claims{Subject: "other-payer-id", Roles: []string{jwt.RoleCustomer}},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			principal := testcase.principal
+			if principal == nil {
+				principal = payerClaims
+			}
+
+			paymentPointUsecase := mock_usecase.NewMockPaymentPointUsecase(mockCtrl)
+			if principal == payerClaims {
+				paymentPointUsecase.EXPECT().
+					PayPaymentPoint(gomock.Any(), testPaymentPointID, gomock.Any(), int64(500), model.CodeConfirmation).
+					Return(testcase.transaction, testcase.confirmation, testcase.err)
+			}
+
+			paymentPointHandler := handler.NewPaymentPointHandler(paymentPointUsecase, testServiceURL, l)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/payment-point/"+testPaymentPointID+"/pay", nil)
+
+			responder := paymentPointHandler.PayPaymentPointHandler(apiPaymentPoint.PayPaymentPointParams{
+				HTTPRequest: req,
+				ID:          strfmt.UUID(testPaymentPointID),
+				Body: &models.PayPaymentPointRequest{
+					Sender: &models.AcceptTransactionUserRequest{
+						UserID:   &userID,
+						WalletID: &walletID,
+					},
+					Amount: swag.Int64(500),
+				},
+			}, principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.transaction == nil {
+				return
+			}
+
+			var response models.PayPaymentPointResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+			assert.Equal(t, testTransactionID, response.TransactionID.String())
+			assert.Equal(t, testcase.expectedConfirmation, response.Confirmation != nil)
+		})
+	}
+}
+
+func TestGetPaymentPointQRHandler(t *testing.T) {
+	t.Parallel()
+
+	minAmount := int64(100)
+
+	testcases := []struct {
+		name           string
+		paymentPoint   *model.PaymentPoint
+		err            error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Return static payload as text",
+			paymentPoint: &model.PaymentPoint{
+				ID:        testPaymentPointID,
+				Currency:  "ALGO",
+				Method:    "algorand",
+				MinAmount: &minAmount,
+				Active:    true,
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "qrpay://point?currency=ALGO&id=" + testPaymentPointID + "&method=algorand&min=100&url=http%3A%2F%2Flocalhost%3A8083%2Fapi%2Fv1&v=1",
+		},
+		{
+			name: "Payment point disabled",
+			paymentPoint: &model.PaymentPoint{
+				ID:     testPaymentPointID,
+				Active: false,
+			},
+			expectedStatus: http.StatusGone,
+		},
+		{
+			name:           "Payment point not found",
+			err:            usecase.ErrPaymentPointNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			paymentPointUsecase := mock_usecase.NewMockPaymentPointUsecase(mockCtrl)
+			paymentPointUsecase.EXPECT().
+				GetPaymentPoint(gomock.Any(), testPaymentPointID).
+				Return(testcase.paymentPoint, testcase.err)
+
+			paymentPointHandler := handler.NewPaymentPointHandler(paymentPointUsecase, testServiceURL, l)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/payment-point/"+testPaymentPointID+"/qr", nil)
+
+			responder := paymentPointHandler.GetPaymentPointQRHandler(apiPaymentPoint.GetPaymentPointQRParams{
+				HTTPRequest: req,
+				ID:          strfmt.UUID(testPaymentPointID),
+				Format:      swag.String("text"),
+				Size:        swag.Int32(128),
+				Level:       swag.String("M"),
+			}, nil)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.expectedBody != "" {
+				assert.Equal(t, testcase.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	"github.com/ShmelJUJ/software-engineering/pkg/qr"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+const (
+	svgFormat  = "svg"
+	textFormat = "text"
+
+	pngContentType  = "image/png"
+	svgContentType  = "image/svg+xml"
+	textContentType = "text/plain; charset=utf-8"
+)
+
+type QRHandler struct {
+	transactionUsecase usecase.TransactionUsecase
+	scanTokens         scantoken.Issuer
+	wallets            confirmation.WalletKeyFetcher
+	log                logger.Logger
+}
+
+// NewQRHandler creates a new instance of QRHandler.
+// The wallets resolve the receiver address put into the payment URI of a watch transaction.
+func NewQRHandler(
+	transactionUsecase usecase.TransactionUsecase,
+	scanTokens scantoken.Issuer,
+	wallets confirmation.WalletKeyFetcher,
+	log logger.Logger,
+) *QRHandler {
+	return &QRHandler{
+		transactionUsecase: transactionUsecase,
+		scanTokens:         scanTokens,
+		wallets:            wallets,
+		log:                log,
+	}
+}
+
+// GetTransactionQRHandler handles the request to render the payment QR code of a transaction.
+func (qh *QRHandler) GetTransactionQRHandler(params apiTransaction.GetTransactionQRParams, _ interface{}) middleware.Responder {
+	qh.log.Debug("Get transaction qr handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+		"format":         *params.Format,
+		"size":           *params.Size,
+		"level":          *params.Level,
+	})
+
+	transaction, err := qh.transactionUsecase.GetTransaction(params.HTTPRequest.Context(), params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewGetTransactionQRNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionQRNotFoundCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiTransaction.NewGetTransactionQRInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionQRInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	payableStatus := model.Created
+	if transaction.Watch {
+		payableStatus = model.Processed
+	}
+
+	if transaction.Status != payableStatus {
+		return apiTransaction.NewGetTransactionQRBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code: int32(apiTransaction.GetTransactionQRBadRequestCode),
+				Message: "transaction is " + transaction.Status.String() +
+					", only " + payableStatus.String() + " transactions can be paid",
+			})
+	}
+
+	content, err := qh.content(params.HTTPRequest.Context(), transaction)
+	if err != nil {
+		return apiTransaction.NewGetTransactionQRInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionQRInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	contentType, image, err := renderQR(content, *params.Format, *params.Size, *params.Level)
+	if err != nil {
+		return apiTransaction.NewGetTransactionQRInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionQRInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return fileResponder(contentType, apiTransaction.NewGetTransactionQROK().
+		WithPayload(io.NopCloser(bytes.NewReader(image))))
+}
+
+// content returns what the QR code of the transaction carries: a signed scan token,
+// or the algorand:// payment URI a payer pays a watch transaction with from their own wallet.
+func (qh *QRHandler) content(ctx context.Context, transaction *model.Transaction) (string, error) {
+	if !transaction.Watch {
+		return qh.scanTokens.Issue(ctx, transaction)
+	}
+
+	publicKey, err := qh.wallets.FetchPublicKey(ctx, transaction.Receiver)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch receiver address: %w", err)
+	}
+
+	var receiver types.Address
+
+	copy(receiver[:], publicKey)
+
+	return qr.EncodeAlgorand(&qr.AlgorandPayload{
+		Receiver: receiver.String(),
+		Amount:   uint64(transaction.Amount),
+		Note:     paynote.New(paynote.Payment, transaction.ID),
+	})
+}
+
+// renderQR renders content in the requested format, text returns the content itself.
+func renderQR(content, format string, size int32, level string) (string, []byte, error) {
+	if format == textFormat {
+		return textContentType, []byte(content), nil
+	}
+
+	render, contentType := qr.PNG, pngContentType
+	if format == svgFormat {
+		render, contentType = qr.SVG, svgContentType
+	}
+
+	image, err := render(content, int(size), qr.Level(level))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return contentType, image, nil
+}
+
+// fileResponse is a generated response carrying a rendered file.
+type fileResponse interface {
+	WriteResponse(rw http.ResponseWriter, producer runtime.Producer)
+}
+
+// fileResponder writes the rendered file with its own content type,
+// the negotiated one follows the Accept header and may name another format.
+func fileResponder(contentType string, response fileResponse) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, contentType)
+
+		response.WriteResponse(rw, runtime.ByteStreamProducer())
+	})
+}
@@ -0,0 +1,223 @@
+package handler_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	"github.com/ShmelJUJ/software-engineering/pkg/qr"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	mock_confirmation "github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation/mocks"
+	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	mock_scantoken "github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testTransactionID = "85e6a060-f914-48d1-b73a-23b7e6c81f46"
+	testQRPayload     = "qrpay://pay?amount=100&currency=ALGO&exp=1714564800&id=85e6a060-f914-48d1-b73a-23b7e6c81f46&method=algorand&nonce=test-nonce&sig=test-sig&url=http%3A%2F%2Flocalhost%3A8083%2Fapi%2Fv1&v=1"
+)
+
+func TestGetTransactionQRHandler(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	createdTransaction := &model.Transaction{
+		ID:       testTransactionID,
+		Currency: "ALGO",
+		Amount:   100,
+		Status:   model.Created,
+		Method:   "algorand",
+	}
+
+	receiverKey := ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize)).Public().(ed25519.PublicKey) //nolint:errcheck // always a public key
+
+	var receiverAddress types.Address
+
+	copy(receiverAddress[:], receiverKey)
+
+	watchTransaction := &model.Transaction{
+		ID:       testTransactionID,
+		Currency: "ALGO",
+		Amount:   100,
+		Status:   model.Processed,
+		Method:   "algorand",
+		Watch:    true,
+		Receiver: &model.TransactionUser{UserID: "receiver-user", WalletID: "receiver-wallet"},
+	}
+
+	watchPayload, err := qr.EncodeAlgorand(&qr.AlgorandPayload{
+		Receiver: receiverAddress.String(),
+		Amount:   100,
+		Note:     paynote.New(paynote.Payment, testTransactionID),
+	})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name                string
+		format              string
+		transaction         *model.Transaction
+		err                 error
+		issueErr            error
+		fetchErr            error
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "Render png",
+			format:              "png",
+			transaction:         createdTransaction,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "image/png",
+		},
+		{
+			name:                "Render svg",
+			format:              "svg",
+			transaction:         createdTransaction,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "image/svg+xml",
+		},
+		{
+			name:                "Return signed payload as text",
+			format:              "text",
+			transaction:         createdTransaction,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        testQRPayload,
+		},
+		{
+			name:           "Failed to issue payload",
+			format:         "png",
+			transaction:    createdTransaction,
+			issueErr:       testErr,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "Transaction is already processed",
+			format: "png",
+			transaction: &model.Transaction{
+				ID:     testTransactionID,
+				Status: model.Processed,
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:                "Return payment URI of a watch transaction",
+			format:              "text",
+			transaction:         watchTransaction,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        watchPayload,
+		},
+		{
+			name:           "Failed to fetch receiver address of a watch transaction",
+			format:         "png",
+			transaction:    watchTransaction,
+			fetchErr:       testErr,
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:   "Watch transaction is already settled",
+			format: "png",
+			transaction: &model.Transaction{
+				ID:     testTransactionID,
+				Status: model.Succeeded,
+				Watch:  true,
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Transaction not found",
+			format:         "png",
+			err:            usecase.ErrTransactionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Failed to get transaction",
+			format:         "png",
+			err:            testErr,
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			transactionUsecase := mock_usecase.NewMockTransactionUsecase(mockCtrl)
+			transactionUsecase.EXPECT().
+				GetTransaction(gomock.Any(), testTransactionID).
+				Return(testcase.transaction, testcase.err)
+
+			scanTokens := mock_scantoken.NewMockIssuer(mockCtrl)
+			if testcase.transaction != nil && testcase.transaction.Status == model.Created {
+				scanTokens.EXPECT().
+					Issue(gomock.Any(), testcase.transaction).
+					Return(testQRPayload, testcase.issueErr)
+			}
+
+			wallets := mock_confirmation.NewMockWalletKeyFetcher(mockCtrl)
+			if testcase.transaction != nil && testcase.transaction.Watch && testcase.transaction.Status == model.Processed {
+				wallets.EXPECT().
+					FetchPublicKey(gomock.Any(), testcase.transaction.Receiver).
+					Return(receiverKey, testcase.fetchErr)
+			}
+
+			qrHandler := handler.NewQRHandler(transactionUsecase, scanTokens, wallets, l)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/transaction/"+testTransactionID+"/qr", nil)
+
+			responder := qrHandler.GetTransactionQRHandler(apiTransaction.GetTransactionQRParams{
+				HTTPRequest: req,
+				ID:          strfmt.UUID(testTransactionID),
+				Format:      swag.String(testcase.format),
+				Size:        swag.Int32(128),
+				Level:       swag.String("M"),
+			}, nil)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.expectedContentType != "" {
+				assert.Equal(t, testcase.expectedContentType, rec.Header().Get(runtime.HeaderContentType))
+			}
+
+			if testcase.expectedBody != "" {
+				assert.Equal(t, testcase.expectedBody, rec.Body.String())
+			}
+
+			if testcase.expectedContentType == "image/png" {
+				img, err := png.Decode(bytes.NewReader(rec.Body.Bytes()))
+				require.NoError(t, err)
+
+				assert.Equal(t, 128, img.Bounds().Dx())
+			}
+		})
+	}
+}
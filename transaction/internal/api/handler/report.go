@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiReport "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/report"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime/middleware"
+)
+
+type ReportHandler struct {
+	reportUsecase usecase.ReportUsecase
+	log           logger.Logger
+}
+
+// NewReportHandler creates a new instance of ReportHandler.
+func NewReportHandler(
+	reportUsecase usecase.ReportUsecase,
+	log logger.Logger,
+) *ReportHandler {
+	return &ReportHandler{
+		reportUsecase: reportUsecase,
+		log:           log,
+	}
+}
+
+// GetSettlementReportHandler handles the request to render the settlement report of a day.
+func (rh *ReportHandler) GetSettlementReportHandler(params apiReport.GetSettlementReportParams, _ interface{}) middleware.Responder {
+	rh.log.Debug("Get settlement report handler", map[string]interface{}{
+		"date":        params.Date.String(),
+		"merchant_id": params.MerchantID,
+		"format":      *params.Format,
+	})
+
+	format, err := report.ParseFormat(*params.Format)
+	if err != nil {
+		return apiReport.NewGetSettlementReportBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiReport.GetSettlementReportBadRequestCode),
+				Message: err.Error(),
+			})
+	}
+
+	var merchantID string
+	if params.MerchantID != nil {
+		merchantID = params.MerchantID.String()
+	}
+
+	settlementReport, err := rh.reportUsecase.GetSettlementReport(
+		params.HTTPRequest.Context(),
+		time.Time(params.Date),
+		merchantID,
+	)
+	if err != nil {
+		return apiReport.NewGetSettlementReportInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiReport.GetSettlementReportInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	var rendered bytes.Buffer
+
+	if err := report.Write(&rendered, settlementReport, format); err != nil {
+		return apiReport.NewGetSettlementReportInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiReport.GetSettlementReportInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return fileResponder(format.ContentType(), apiReport.NewGetSettlementReportOK().
+		WithPayload(io.NopCloser(&rendered)))
+}
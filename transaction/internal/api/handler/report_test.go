@@ -0,0 +1,122 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	apiReport "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/report"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetSettlementReportHandler(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	merchantID := strfmt.UUID(testMerchantID)
+
+	settlementReport := &report.Report{
+		Date:        date,
+		GeneratedAt: date.Add(27 * time.Hour),
+		Statements: []*report.Statement{
+			{MerchantID: testMerchantID, Currency: "USD", Count: 1, Gross: 1000, Fees: 30, Net: 970},
+		},
+	}
+
+	testcases := []struct {
+		name                string
+		format              string
+		report              *report.Report
+		err                 error
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "Render csv",
+			format:              "csv",
+			report:              settlementReport,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "2024-05-01," + testMerchantID + ",USD,1,10.00,0.30,0,0.00,9.70",
+		},
+		{
+			name:                "Render json",
+			format:              "json",
+			report:              settlementReport,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			expectedBody:        `"net": "9.70"`,
+		},
+		{
+			name:                "Render camt053",
+			format:              "camt053",
+			report:              settlementReport,
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/xml; charset=utf-8",
+			expectedBody:        `<Amt Ccy="USD">9.70</Amt>`,
+		},
+		{
+			name:           "Unknown format",
+			format:         "xlsx",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Failed to get settlement report",
+			format:         "csv",
+			err:            errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			reportUsecase := mock_usecase.NewMockReportUsecase(mockCtrl)
+
+			if testcase.expectedStatus != http.StatusBadRequest {
+				reportUsecase.EXPECT().
+					GetSettlementReport(gomock.Any(), date, testMerchantID).
+					Return(testcase.report, testcase.err)
+			}
+
+			responder := handler.NewReportHandler(reportUsecase, l).GetSettlementReportHandler(apiReport.GetSettlementReportParams{
+				HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/report/settlement", nil),
+				Date:        strfmt.Date(date),
+				MerchantID:  &merchantID,
+				Format:      swag.String(testcase.format),
+			}, nil)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, testcase.expectedContentType, rec.Header().Get(runtime.HeaderContentType))
+			assert.Contains(t, rec.Body.String(), testcase.expectedBody)
+		})
+	}
+}
@@ -2,35 +2,43 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
 	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client/monitor"
 	monitor_models "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/models"
-	"github.com/ShmelJUJ/software-engineering/pkg/redis"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fx"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
 	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	openapi_errors "github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/mitchellh/mapstructure"
 )
 
 const (
 	transactionService = "transaction"
 	userService        = "user"
 
-	loginMethod = "login"
-
-	transactionAuthKey    = "transaction-auth"
-	defaultAuthExpiration = time.Hour
+	loginMethod     = "login"
+	loginTOTPMethod = "loginTOTP"
+	refreshMethod   = "refreshToken"
 )
 
 type TransactionHandler struct {
 	transactionUsecase usecase.TransactionUsecase
 	monitorClient      monitor_client.ClientService
-	r                  *redis.Redis
+	verifier           *jwt.Verifier
+	clientIPs          *ClientIPResolver
 	log                logger.Logger
 }
 
@@ -39,18 +47,21 @@ func NewTransactionHandler(
 	transactionUsecase usecase.TransactionUsecase,
 	log logger.Logger,
 	monitorClient monitor_client.ClientService,
-	r *redis.Redis,
+	verifier *jwt.Verifier,
+	clientIPs *ClientIPResolver,
 ) *TransactionHandler {
 	return &TransactionHandler{
 		transactionUsecase: transactionUsecase,
 		log:                log,
 		monitorClient:      monitorClient,
-		r:                  r,
+		verifier:           verifier,
+		clientIPs:          clientIPs,
 	}
 }
 
 // AcceptTransactionHandler handles the request to accept a transaction.
-func (th *TransactionHandler) AcceptTransactionHandler(params apiTransaction.AcceptTransactionParams, _ interface{}) middleware.Responder {
+// The sender must be the principal, nobody accepts on behalf of another payer.
+func (th *TransactionHandler) AcceptTransactionHandler(params apiTransaction.AcceptTransactionParams, principal interface{}) middleware.Responder {
 	th.log.Debug("Accept transaction handler", map[string]interface{}{
 		"transaction_id": params.ID.String(),
 		"body":           params.Body,
@@ -58,11 +69,75 @@ func (th *TransactionHandler) AcceptTransactionHandler(params apiTransaction.Acc
 
 	sender := model.FromAcceptTransactionUserDTO(params.Body.Sender)
 
-	if err := th.transactionUsecase.AcceptTransaction(
+	claims, ok := principal.(*jwt.Claims)
+	if !ok || sender == nil || sender.UserID != claims.Subject {
+		return apiTransaction.NewAcceptTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionForbiddenCode),
+				Message: usecase.ErrNotSender.Error(),
+			})
+	}
+
+	confirmationMethod := model.CodeConfirmation
+	if params.Body.ConfirmationMethod != nil {
+		confirmationMethod = model.ConfirmationMethod(*params.Body.ConfirmationMethod)
+	}
+
+	var executeAt *time.Time
+	if !time.Time(params.Body.ExecuteAt).IsZero() {
+		bodyExecuteAt := time.Time(params.Body.ExecuteAt)
+		executeAt = &bodyExecuteAt
+	}
+
+	pendingConfirmation, err := th.transactionUsecase.AcceptTransaction(
 		params.HTTPRequest.Context(),
 		params.ID.String(),
 		sender,
-	); err != nil {
+		confirmationMethod,
+		*params.Body.QrPayload,
+		executeAt,
+	)
+
+	switch {
+	case errors.Is(err, usecase.ErrQuoteLocked):
+		return apiTransaction.NewAcceptTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, scantoken.ErrInvalidToken), errors.Is(err, usecase.ErrScheduleUnsupported):
+		return apiTransaction.NewAcceptTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewAcceptTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, scantoken.ErrTokenReplayed), errors.Is(err, usecase.ErrNoShareLeft),
+		errors.Is(err, usecase.ErrPayerHasShare):
+		return apiTransaction.NewAcceptTransactionConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionConflictCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, scantoken.ErrTokenExpired), errors.Is(err, usecase.ErrTransactionExpired),
+		errors.Is(err, usecase.ErrQuoteExpired):
+		return apiTransaction.NewAcceptTransactionGone().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionGoneCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, scantoken.ErrAmountMismatch):
+		return apiTransaction.NewAcceptTransactionUnprocessableEntity().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.AcceptTransactionUnprocessableEntityCode),
+				Message: err.Error(),
+			})
+	case err != nil:
 		return apiTransaction.NewAcceptTransactionInternalServerError().
 			WithPayload(&models.ErrorResponse{
 				Code:    int32(apiTransaction.AcceptTransactionInternalServerErrorCode),
@@ -70,21 +145,129 @@ func (th *TransactionHandler) AcceptTransactionHandler(params apiTransaction.Acc
 			})
 	}
 
+	if pendingConfirmation != nil {
+		method := string(pendingConfirmation.Method)
+		expiresAt := strfmt.DateTime(pendingConfirmation.ExpiresAt)
+
+		return apiTransaction.NewAcceptTransactionAccepted().
+			WithPayload(&models.ConfirmationRequiredResponse{
+				ConfirmationMethod: &method,
+				TransactionHash:    &pendingConfirmation.TransactionHash,
+				ExpiresAt:          &expiresAt,
+			})
+	}
+
 	return apiTransaction.NewAcceptTransactionOK()
 }
 
-// CancelTransactionHandler handles the request to cancel a transaction.
-func (th *TransactionHandler) CancelTransactionHandler(params apiTransaction.CancelTransactionParams, _ interface{}) middleware.Responder {
-	th.log.Debug("Cancel transaction handler", map[string]interface{}{
+// ConfirmTransactionHandler handles the request to confirm a high-value transaction by its payer.
+func (th *TransactionHandler) ConfirmTransactionHandler(params apiTransaction.ConfirmTransactionParams, principal interface{}) middleware.Responder {
+	th.log.Debug("Confirm transaction handler", map[string]interface{}{
 		"transaction_id": params.ID.String(),
-		"body":           params.Body,
 	})
 
-	if err := th.transactionUsecase.CancelTransaction(
+	if params.Body.Code == "" && params.Body.Signature == "" {
+		return apiTransaction.NewConfirmTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionBadRequestCode),
+				Message: "code or signature is required",
+			})
+	}
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiTransaction.NewConfirmTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	err := th.transactionUsecase.ConfirmTransaction(
 		params.HTTPRequest.Context(),
 		params.ID.String(),
-		params.Body.Reason,
-	); err != nil {
+		claims.Subject,
+		params.Body.Code,
+		params.Body.Signature,
+	)
+
+	var (
+		invalidErr *confirmation.InvalidConfirmationError
+		expiredErr *confirmation.ConfirmationExpiredError
+	)
+
+	switch {
+	case err == nil:
+		return apiTransaction.NewConfirmTransactionOK()
+	case errors.Is(err, usecase.ErrNotPayer):
+		return apiTransaction.NewConfirmTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrConfirmationNotFound):
+		return apiTransaction.NewConfirmTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.As(err, &expiredErr):
+		return apiTransaction.NewConfirmTransactionGone().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionGoneCode),
+				Message: expiredErr.Error(),
+			})
+	case errors.As(err, &invalidErr):
+		return apiTransaction.NewConfirmTransactionUnprocessableEntity().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionUnprocessableEntityCode),
+				Message: invalidErr.Error(),
+			})
+	default:
+		return apiTransaction.NewConfirmTransactionInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.ConfirmTransactionInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+}
+
+// CancelTransactionHandler handles the request to cancel a transaction of the merchant.
+func (th *TransactionHandler) CancelTransactionHandler(params apiTransaction.CancelTransactionParams, principal interface{}) middleware.Responder {
+	th.log.Debug("Cancel transaction handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+		"body":           params.Body,
+	})
+
+	err := th.authorizeReceiver(params.HTTPRequest.Context(), params.ID.String(), principal)
+	if err == nil {
+		err = th.transactionUsecase.CancelTransaction(
+			params.HTTPRequest.Context(),
+			params.ID.String(),
+			params.Body.Reason,
+		)
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrNotReceiver):
+		return apiTransaction.NewCancelTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CancelTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewCancelTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CancelTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotCancellable), errors.Is(err, usecase.ErrTransactionNotAuthorized):
+		return apiTransaction.NewCancelTransactionConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CancelTransactionConflictCode),
+				Message: err.Error(),
+			})
+	case err != nil:
 		return apiTransaction.NewCancelTransactionInternalServerError().
 			WithPayload(&models.ErrorResponse{
 				Code:    int32(apiTransaction.CancelTransactionInternalServerErrorCode),
@@ -95,18 +278,135 @@ func (th *TransactionHandler) CancelTransactionHandler(params apiTransaction.Can
 	return apiTransaction.NewCancelTransactionOK()
 }
 
+// CaptureTransactionHandler handles the request to take the whole or a part of the hold of an authorized transaction.
+func (th *TransactionHandler) CaptureTransactionHandler(params apiTransaction.CaptureTransactionParams, principal interface{}) middleware.Responder {
+	th.log.Debug("Capture transaction handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+		"body":           params.Body,
+	})
+
+	var amount int64
+	if params.Body != nil {
+		amount = params.Body.Amount
+	}
+
+	err := th.authorizeReceiver(params.HTTPRequest.Context(), params.ID.String(), principal)
+	if err == nil {
+		err = th.transactionUsecase.CaptureTransaction(
+			params.HTTPRequest.Context(),
+			params.ID.String(),
+			amount,
+		)
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrNotReceiver):
+		return apiTransaction.NewCaptureTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CaptureTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, model.ErrInvalidCapture):
+		return apiTransaction.NewCaptureTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CaptureTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewCaptureTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CaptureTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotAuthorized):
+		return apiTransaction.NewCaptureTransactionConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CaptureTransactionConflictCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiTransaction.NewCaptureTransactionInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CaptureTransactionInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiTransaction.NewCaptureTransactionOK()
+}
+
+// VoidTransactionHandler handles the request to release the hold of an authorized transaction.
+func (th *TransactionHandler) VoidTransactionHandler(params apiTransaction.VoidTransactionParams, principal interface{}) middleware.Responder {
+	th.log.Debug("Void transaction handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+	})
+
+	err := th.authorizeReceiver(params.HTTPRequest.Context(), params.ID.String(), principal)
+	if err == nil {
+		err = th.transactionUsecase.VoidTransaction(
+			params.HTTPRequest.Context(),
+			params.ID.String(),
+		)
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrNotReceiver):
+		return apiTransaction.NewVoidTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.VoidTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewVoidTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.VoidTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotAuthorized):
+		return apiTransaction.NewVoidTransactionConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.VoidTransactionConflictCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiTransaction.NewVoidTransactionInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.VoidTransactionInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiTransaction.NewVoidTransactionOK()
+}
+
 // CreateTransactionHandler handles the request to create a transaction.
 func (th *TransactionHandler) CreateTransactionHandler(params apiTransaction.CreateTransactionParams, _ interface{}) middleware.Responder {
 	th.log.Debug("Create transaction handler", map[string]interface{}{
 		"body": params.Body,
 	})
 
-	transaction := model.FromCreateTransactionDTO(params.Body)
+	transaction, err := model.FromCreateTransactionDTO(params.Body)
+	if err != nil {
+		return apiTransaction.NewCreateTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CreateTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	}
 
-	if err := th.transactionUsecase.CreateTransaction(
+	err = th.transactionUsecase.CreateTransaction(
 		params.HTTPRequest.Context(),
 		transaction,
-	); err != nil {
+	)
+
+	switch {
+	case errors.Is(err, usecase.ErrShareNeedsConfirmation), errors.Is(err, model.ErrInvalidWatch):
+		return apiTransaction.NewCreateTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.CreateTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	case err != nil:
 		return apiTransaction.NewCreateTransactionInternalServerError().
 			WithPayload(&models.ErrorResponse{
 				Code:    int32(apiTransaction.CreateTransactionInternalServerErrorCode),
@@ -120,19 +420,182 @@ func (th *TransactionHandler) CreateTransactionHandler(params apiTransaction.Cre
 		})
 }
 
+// QuoteTransactionHandler handles the request to lock an exchange rate for paying a transaction in another currency.
+// The rate is locked for the principal, only they can accept the transaction with it.
+func (th *TransactionHandler) QuoteTransactionHandler(params apiTransaction.QuoteTransactionParams, principal interface{}) middleware.Responder {
+	th.log.Debug("Quote transaction handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+		"body":           params.Body,
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiTransaction.NewQuoteTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	quote, err := th.transactionUsecase.QuoteTransaction(
+		params.HTTPRequest.Context(),
+		params.ID.String(),
+		claims.Subject,
+		*params.Body.Currency,
+	)
+
+	var rateErr *fx.RateError
+
+	switch {
+	case errors.Is(err, usecase.ErrQuoteLocked):
+		return apiTransaction.NewQuoteTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, money.ErrUnknownCurrency), errors.Is(err, fx.ErrSameCurrency), errors.Is(err, model.ErrInvalidSplit):
+		return apiTransaction.NewQuoteTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewQuoteTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotCreated), errors.Is(err, usecase.ErrGroupTransaction):
+		return apiTransaction.NewQuoteTransactionConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionConflictCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionExpired):
+		return apiTransaction.NewQuoteTransactionGone().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionGoneCode),
+				Message: err.Error(),
+			})
+	case errors.As(err, &rateErr):
+		return apiTransaction.NewQuoteTransactionBadGateway().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionBadGatewayCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiTransaction.NewQuoteTransactionInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.QuoteTransactionInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiTransaction.NewQuoteTransactionOK().
+		WithPayload(quote.ToTransactionQuoteDTO())
+}
+
+// GetTransactionFeeHandler handles the request to preview the fee of a transaction before accepting it.
+func (th *TransactionHandler) GetTransactionFeeHandler(params apiTransaction.GetTransactionFeeParams, _ interface{}) middleware.Responder {
+	th.log.Debug("Get transaction fee handler", map[string]interface{}{
+		"transaction_id": params.ID.String(),
+	})
+
+	transaction, err := th.transactionUsecase.GetTransactionFee(
+		params.HTTPRequest.Context(),
+		params.ID.String(),
+	)
+
+	switch {
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewGetTransactionFeeNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionFeeNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotCreated):
+		return apiTransaction.NewGetTransactionFeeConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionFeeConflictCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionExpired):
+		return apiTransaction.NewGetTransactionFeeGone().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionFeeGoneCode),
+				Message: err.Error(),
+			})
+	case err != nil:
+		return apiTransaction.NewGetTransactionFeeInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionFeeInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	feeResponse, err := transaction.ToTransactionFeeDTO()
+	if err != nil {
+		return apiTransaction.NewGetTransactionFeeInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.GetTransactionFeeInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiTransaction.NewGetTransactionFeeOK().
+		WithPayload(feeResponse)
+}
+
 // EditTransactionHandler handles the request to edit a transaction.
-func (th *TransactionHandler) EditTransactionHandler(params apiTransaction.EditTransactionParams, _ interface{}) middleware.Responder {
+func (th *TransactionHandler) EditTransactionHandler(params apiTransaction.EditTransactionParams, principal interface{}) middleware.Responder {
 	th.log.Debug("Edit transaction handler", map[string]interface{}{
 		"transaction_id": params.ID.String(),
 		"body":           params.Body,
 	})
 
-	transaction := model.FromEditTransactionDTO(params.ID.String(), params.Body)
+	transaction, err := model.FromEditTransactionDTO(params.ID.String(), params.Body)
+	if err != nil {
+		return apiTransaction.NewEditTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.EditTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	}
 
-	if err := th.transactionUsecase.UpdateTransaction(
-		params.HTTPRequest.Context(),
-		transaction,
-	); err != nil {
+	err = th.authorizeReceiver(params.HTTPRequest.Context(), params.ID.String(), principal)
+	if err == nil {
+		err = th.transactionUsecase.UpdateTransaction(
+			params.HTTPRequest.Context(),
+			transaction,
+		)
+	}
+
+	switch {
+	case errors.Is(err, usecase.ErrNotReceiver):
+		return apiTransaction.NewEditTransactionForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.EditTransactionForbiddenCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotFound):
+		return apiTransaction.NewEditTransactionNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.EditTransactionNotFoundCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, usecase.ErrTransactionNotCreated):
+		return apiTransaction.NewEditTransactionConflict().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.EditTransactionConflictCode),
+				Message: err.Error(),
+			})
+	case errors.Is(err, model.ErrInvalidSplit), errors.Is(err, model.ErrInvalidShares):
+		return apiTransaction.NewEditTransactionBadRequest().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.EditTransactionBadRequestCode),
+				Message: err.Error(),
+			})
+	case err != nil:
 		return apiTransaction.NewEditTransactionInternalServerError().
 			WithPayload(&models.ErrorResponse{
 				Code:    int32(apiTransaction.EditTransactionInternalServerErrorCode),
@@ -143,6 +606,29 @@ func (th *TransactionHandler) EditTransactionHandler(params apiTransaction.EditT
 	return apiTransaction.NewEditTransactionOK()
 }
 
+// authorizeReceiver checks that the principal is the receiver of the transaction, administrators manage every transaction.
+func (th *TransactionHandler) authorizeReceiver(ctx context.Context, transactionID string, principal interface{}) error {
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return usecase.ErrNotReceiver
+	}
+
+	if claims.HasRole(jwt.RoleAdmin) {
+		return nil
+	}
+
+	transaction, err := th.transactionUsecase.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if transaction.Receiver == nil || transaction.Receiver.UserID != claims.Subject {
+		return usecase.ErrNotReceiver
+	}
+
+	return nil
+}
+
 // RetrieveTransactionHandler handles the request to retrieve a transaction.
 func (th *TransactionHandler) RetrieveTransactionHandler(params apiTransaction.RetrieveTransactionParams, _ interface{}) middleware.Responder {
 	th.log.Debug("Retrieve transaction handler", map[string]interface{}{
@@ -191,6 +677,7 @@ func (th *TransactionHandler) RetrieveTransactionStatusHandler(params apiTransac
 		})
 }
 
+// LoginHandler handles the request to login user.
 func (th *TransactionHandler) LoginHandler(params apiTransaction.LoginParams) middleware.Responder {
 	from := transactionService
 	to := userService
@@ -200,18 +687,42 @@ func (th *TransactionHandler) LoginHandler(params apiTransaction.LoginParams) mi
 		"from":   from,
 		"to":     to,
 		"method": method,
-		"body":   params.Body,
 	})
 
 	resp, err := th.monitorClient.Process(&monitor_client.ProcessParams{
 		Body: &monitor_models.ProcessRequest{
-			From:    &from,
-			To:      &to,
-			Method:  &method,
-			Payload: params.Body,
+			From:   &from,
+			To:     &to,
+			Method: &method,
+			Payload: map[string]interface{}{
+				"email":     params.Body.Email,
+				"password":  params.Body.Password,
+				"client_ip": th.clientIPs.ClientIP(params.HTTPRequest),
+			},
 		},
+		Context: params.HTTPRequest.Context(),
 	})
-	if err != nil {
+
+	var (
+		unauthorizedErr *monitor_client.ProcessUnauthorized
+		lockedErr       *monitor_client.ProcessLocked
+	)
+
+	switch {
+	case errors.As(err, &unauthorizedErr):
+		return apiTransaction.NewLoginUnauthorized().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.LoginUnauthorizedCode),
+				Message: unauthorizedErr.GetPayload().Message,
+			})
+	case errors.As(err, &lockedErr):
+		return apiTransaction.NewLoginLocked().
+			WithRetryAfter(lockedErr.RetryAfter).
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.LoginLockedCode),
+				Message: lockedErr.GetPayload().Message,
+			})
+	case err != nil:
 		return apiTransaction.NewLoginInternalServerError().
 			WithPayload(&models.ErrorResponse{
 				Code:    int32(apiTransaction.LoginInternalServerErrorCode),
@@ -219,11 +730,8 @@ func (th *TransactionHandler) LoginHandler(params apiTransaction.LoginParams) mi
 			})
 	}
 
-	payload := resp.Payload.(map[string]interface{}) //nolint:errcheck // blya budu tut chto nado
-
-	token := payload["auth_token"].(string) //nolint:errcheck // blya budu tut chto nado
-
-	if err := th.r.Client.SAdd(params.HTTPRequest.Context(), transactionAuthKey, token).Err(); err != nil {
+	loginResponse, err := decodeLoginResponse(resp.Payload)
+	if err != nil {
 		return apiTransaction.NewLoginInternalServerError().
 			WithPayload(&models.ErrorResponse{
 				Code:    int32(apiTransaction.LoginInternalServerErrorCode),
@@ -232,26 +740,181 @@ func (th *TransactionHandler) LoginHandler(params apiTransaction.LoginParams) mi
 	}
 
 	return apiTransaction.NewLoginOK().
-		WithPayload(&models.LoginResponse{
-			AuthToken: &token,
-		})
+		WithPayload(loginResponse)
 }
 
-func (th *TransactionHandler) VerifyAuthToken(token string) (interface{}, error) {
-	ctx := context.Background()
+// LoginTwoFactorHandler handles the request to complete login with a two-factor code.
+func (th *TransactionHandler) LoginTwoFactorHandler(params apiTransaction.LoginTwoFactorParams) middleware.Responder {
+	from := transactionService
+	to := userService
+	method := loginTOTPMethod
 
-	th.log.Debug("Verify auth token", map[string]interface{}{
-		"token": token,
+	th.log.Debug("Login two factor handler", map[string]interface{}{
+		"from":   from,
+		"to":     to,
+		"method": method,
 	})
 
-	res, err := th.r.Client.SIsMember(ctx, transactionAuthKey, token).Result()
+	resp, err := th.monitorClient.Process(&monitor_client.ProcessParams{
+		Body: &monitor_models.ProcessRequest{
+			From:   &from,
+			To:     &to,
+			Method: &method,
+			Payload: map[string]interface{}{
+				"challenge_token": params.Body.ChallengeToken,
+				"code":            params.Body.Code,
+				"client_ip":       th.clientIPs.ClientIP(params.HTTPRequest),
+			},
+		},
+		Context: params.HTTPRequest.Context(),
+	})
+
+	var (
+		unauthorizedErr *monitor_client.ProcessUnauthorized
+		lockedErr       *monitor_client.ProcessLocked
+	)
+
+	switch {
+	case errors.As(err, &unauthorizedErr):
+		return apiTransaction.NewLoginTwoFactorUnauthorized().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.LoginTwoFactorUnauthorizedCode),
+				Message: unauthorizedErr.GetPayload().Message,
+			})
+	case errors.As(err, &lockedErr):
+		return apiTransaction.NewLoginTwoFactorLocked().
+			WithRetryAfter(lockedErr.RetryAfter).
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.LoginTwoFactorLockedCode),
+				Message: lockedErr.GetPayload().Message,
+			})
+	case err != nil:
+		return apiTransaction.NewLoginTwoFactorInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.LoginTwoFactorInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	loginResponse, err := decodeLoginResponse(resp.Payload)
 	if err != nil {
-		return false, fmt.Errorf("failed to check that token contains in transactionAuthKey: %w", err)
+		return apiTransaction.NewLoginTwoFactorInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.LoginTwoFactorInternalServerErrorCode),
+				Message: err.Error(),
+			})
 	}
 
-	if !res {
+	return apiTransaction.NewLoginTwoFactorOK().
+		WithPayload(loginResponse)
+}
+
+// RefreshLoginHandler handles the request to renew auth token with refresh token.
+func (th *TransactionHandler) RefreshLoginHandler(params apiTransaction.RefreshLoginParams) middleware.Responder {
+	from := transactionService
+	to := userService
+	method := refreshMethod
+
+	th.log.Debug("Refresh login handler", map[string]interface{}{
+		"from":   from,
+		"to":     to,
+		"method": method,
+	})
+
+	resp, err := th.monitorClient.Process(&monitor_client.ProcessParams{
+		Body: &monitor_models.ProcessRequest{
+			From:    &from,
+			To:      &to,
+			Method:  &method,
+			Payload: params.Body,
+		},
+		Context: params.HTTPRequest.Context(),
+	})
+
+	var unauthorizedErr *monitor_client.ProcessUnauthorized
+
+	switch {
+	case errors.As(err, &unauthorizedErr):
+		return apiTransaction.NewRefreshLoginUnauthorized().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.RefreshLoginUnauthorizedCode),
+				Message: unauthorizedErr.GetPayload().Message,
+			})
+	case err != nil:
+		return apiTransaction.NewRefreshLoginInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.RefreshLoginInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	loginResponse, err := decodeLoginResponse(resp.Payload)
+	if err != nil {
+		return apiTransaction.NewRefreshLoginInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiTransaction.RefreshLoginInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiTransaction.NewRefreshLoginOK().
+		WithPayload(loginResponse)
+}
+
+func decodeLoginResponse(payload interface{}) (*models.LoginResponse, error) {
+	loginResponse := &models.LoginResponse{}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName: "json",
+		Result:  loginResponse,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create login response decoder: %w", err)
+	}
+
+	if err := decoder.Decode(payload); err != nil {
+		return nil, fmt.Errorf("failed to decode login response: %w", err)
+	}
+
+	// The user service answers with a challenge instead of tokens when a second factor is enabled.
+	twoFactorRequired := loginResponse.ChallengeToken != ""
+	loginResponse.TwoFactorRequired = &twoFactorRequired
+
+	if err := loginResponse.Validate(strfmt.Default); err != nil {
+		return nil, fmt.Errorf("invalid login response: %w", err)
+	}
+
+	if !twoFactorRequired && loginResponse.AuthToken == "" {
+		return nil, errors.New("invalid login response: auth_token is empty")
+	}
+
+	return loginResponse, nil
+}
+
+// VerifyAuthToken validates the auth token locally and checks that it grants the scopes of the operation.
+// Scopes are user roles. Every scope of a security requirement must be held,
+// alternatives are listed in the spec as separate requirements.
+func (th *TransactionHandler) VerifyAuthToken(token string, scopes []string) (interface{}, error) {
+	claims, err := th.verifier.Verify(context.Background(), token)
+	if err != nil {
+		th.log.Debug("Invalid auth token", map[string]interface{}{
+			"error": err,
+		})
+
 		return nil, nil //nolint:nilnil // to get 401 error
 	}
 
-	return true, nil
+	for _, scope := range scopes {
+		if !claims.HasRole(scope) {
+			th.log.Debug("Insufficient role", map[string]interface{}{
+				"subject": claims.Subject,
+				"roles":   claims.Roles,
+				"scopes":  scopes,
+			})
+
+			return nil, openapi_errors.New(http.StatusForbidden, "insufficient role for the operation")
+		}
+	}
+
+	return claims, nil
 }
@@ -0,0 +1,283 @@
+package handler_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testPayerID       = "0b2c3d4e-5f6a-4b7c-9d8e-9f0a1b2c3d4e"
+	testPayerWalletID = "1c3d4e5f-6a7b-4c8d-0e9f-0a1b2c3d4e5f"
+)
+
+func transactionHandlerHelper(t *testing.T) (*handler.TransactionHandler, *mock_usecase.MockTransactionUsecase) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	transactionUsecase := mock_usecase.NewMockTransactionUsecase(mockCtrl)
+
+	return handler.NewTransactionHandler(transactionUsecase, l, nil, nil, nil), transactionUsecase
+}
+
+func TestCancelTransactionHandler(t *testing.T) {
+	t.Parallel()
+
+	merchantTransaction := &model.Transaction{
+		ID:       testTransactionID,
+		Receiver: &model.TransactionUser{UserID: testMerchantID},
+	}
+	otherTransaction := &model.Transaction{
+		ID:       testTransactionID,
+		Receiver: &model.TransactionUser{UserID: "other-merchant-id"},
+	}
+	adminClaims := &jwt.Claims{
+		Subject: "admin-id",
+		Roles:   []string{jwt.RoleAdmin},
+	}
+
+	testcases := []struct {
+		name           string
+		principal      interface{}
+		mock           func(*mock_usecase.MockTransactionUsecase)
+		expectedStatus int
+	}{
+		{
+			name:      "Successfully cancel own transaction",
+			principal: testMerchantClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(merchantTransaction, nil).Times(1)
+				mtu.EXPECT().CancelTransaction(gomock.Any(), testTransactionID, "test reason").Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Administrator cancels any transaction",
+			principal: adminClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().CancelTransaction(gomock.Any(), testTransactionID, "test reason").Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:      "Transaction of another merchant",
+			principal: testMerchantClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(otherTransaction, nil).Times(1)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Unknown principal",
+			mock:           func(*mock_usecase.MockTransactionUsecase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:      "Transaction not found",
+			principal: testMerchantClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(nil, usecase.ErrTransactionNotFound).Times(1)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:      "Transaction handed over to the payment gateway",
+			principal: testMerchantClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(merchantTransaction, nil).Times(1)
+				mtu.EXPECT().CancelTransaction(gomock.Any(), testTransactionID, "test reason").Return(usecase.ErrTransactionNotCancellable).Times(1)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:      "Failed to cancel transaction",
+			principal: testMerchantClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(merchantTransaction, nil).Times(1)
+				mtu.EXPECT().CancelTransaction(gomock.Any(), testTransactionID, "test reason").Return(errors.New("test err")).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transactionHandler, transactionUsecase := transactionHandlerHelper(t)
+			testcase.mock(transactionUsecase)
+
+			responder := transactionHandler.CancelTransactionHandler(apiTransaction.CancelTransactionParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/transaction/"+testTransactionID+"/cancel", nil),
+				ID:          testTransactionID,
+				Body:        &models.CancelTransactionRequest{Reason: "test reason"},
+			}, testcase.principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			assert.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestEditTransactionHandler(t *testing.T) {
+	t.Parallel()
+
+	merchantTransaction := &model.Transaction{
+		ID:       testTransactionID,
+		Receiver: &model.TransactionUser{UserID: testMerchantID},
+	}
+
+	testcases := []struct {
+		name           string
+		mock           func(*mock_usecase.MockTransactionUsecase)
+		expectedStatus int
+	}{
+		{
+			name: "Successfully edit transaction",
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(merchantTransaction, nil).Times(1)
+				mtu.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Transaction is no longer created",
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(merchantTransaction, nil).Times(1)
+				mtu.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).Return(usecase.ErrTransactionNotCreated).Times(1)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "Failed to edit transaction",
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().GetTransaction(gomock.Any(), testTransactionID).Return(merchantTransaction, nil).Times(1)
+				mtu.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).Return(errors.New("test err")).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transactionHandler, transactionUsecase := transactionHandlerHelper(t)
+			testcase.mock(transactionUsecase)
+
+			responder := transactionHandler.EditTransactionHandler(apiTransaction.EditTransactionParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/transaction/"+testTransactionID+"/edit", nil),
+				ID:          testTransactionID,
+				Body:        &models.EditTransactionRequest{MoneyInfo: &models.EditMoneyInfo{Amount: 500}},
+			}, testMerchantClaims)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			assert.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestAcceptTransactionHandler(t *testing.T) {
+	t.Parallel()
+
+	payerID := strfmt.UUID(testPayerID)
+	walletID := strfmt.UUID(testPayerWalletID)
+	payerClaims := &jwt.Claims{
+		Subject: testPayerID,
+		Roles:   []string{jwt.RoleCustomer},
+	}
+
+	testcases := []struct {
+		name           string
+		principal      interface{}
+		mock           func(*mock_usecase.MockTransactionUsecase)
+		expectedStatus int
+	}{
+		{
+			name:      "Successfully accept transaction",
+			principal: payerClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().
+					AcceptTransaction(gomock.Any(), testTransactionID, gomock.Any(), model.CodeConfirmation, "test-qr-payload", nil).
+					Return(nil, nil).
+					Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Accept on behalf of another payer",
+			principal: &jwt.Claims{
+				Subject: "other-payer-id",
+				Roles:   []string{jwt.RoleCustomer},
+			},
+			mock:           func(*mock_usecase.MockTransactionUsecase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:      "Quote locked for another payer",
+			principal: payerClaims,
+			mock: func(mtu *mock_usecase.MockTransactionUsecase) {
+				mtu.EXPECT().
+					AcceptTransaction(gomock.Any(), testTransactionID, gomock.Any(), model.CodeConfirmation, "test-qr-payload", nil).
+					Return(nil, usecase.ErrQuoteLocked).
+					Times(1)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transactionHandler, transactionUsecase := transactionHandlerHelper(t)
+			testcase.mock(transactionUsecase)
+
+			responder := transactionHandler.AcceptTransactionHandler(apiTransaction.AcceptTransactionParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/transaction/"+testTransactionID+"/accept", nil),
+				ID:          testTransactionID,
+				Body: &models.AcceptTransactionRequest{
+					Sender: &models.AcceptTransactionUserRequest{
+						UserID:   &payerID,
+						WalletID: &walletID,
+					},
+					QrPayload: swag.String("test-qr-payload"),
+				},
+			}, testcase.principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			assert.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
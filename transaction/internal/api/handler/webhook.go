@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiWebhook "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/webhook"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+type WebhookHandler struct {
+	webhookUsecase usecase.WebhookUsecase
+	log            logger.Logger
+}
+
+// NewWebhookHandler creates a new instance of WebhookHandler.
+func NewWebhookHandler(
+	webhookUsecase usecase.WebhookUsecase,
+	log logger.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUsecase: webhookUsecase,
+		log:            log,
+	}
+}
+
+// CreateWebhookHandler handles the request to register a webhook of the merchant.
+func (wh *WebhookHandler) CreateWebhookHandler(params apiWebhook.CreateWebhookParams, principal interface{}) middleware.Responder {
+	wh.log.Debug("Create webhook handler", map[string]interface{}{
+		"url": params.Body.URL,
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiWebhook.NewCreateWebhookForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.CreateWebhookForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	webhook := model.FromCreateWebhookDTO(claims.Subject, params.Body)
+
+	if err := wh.webhookUsecase.CreateWebhook(params.HTTPRequest.Context(), webhook); err != nil {
+		return apiWebhook.NewCreateWebhookInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.CreateWebhookInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiWebhook.NewCreateWebhookOK().
+		WithPayload(&models.CreateWebhookResponse{
+			WebhookID: (*strfmt.UUID)(&webhook.ID),
+		})
+}
+
+// ListWebhookDeliveriesHandler handles the request to retrieve the delivery log of a merchant webhook.
+func (wh *WebhookHandler) ListWebhookDeliveriesHandler(params apiWebhook.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+	wh.log.Debug("List webhook deliveries handler", map[string]interface{}{
+		"webhook_id": params.ID.String(),
+		"limit":      *params.Limit,
+		"offset":     *params.Offset,
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiWebhook.NewListWebhookDeliveriesForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.ListWebhookDeliveriesForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	deliveries, err := wh.webhookUsecase.ListWebhookDeliveries(
+		params.HTTPRequest.Context(),
+		claims.Subject,
+		params.ID.String(),
+		uint64(*params.Limit),
+		uint64(*params.Offset),
+	)
+
+	switch {
+	case errors.Is(err, usecase.ErrWebhookNotFound):
+		return apiWebhook.NewListWebhookDeliveriesNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.ListWebhookDeliveriesNotFoundCode),
+				Message: usecase.ErrWebhookNotFound.Error(),
+			})
+	case err != nil:
+		return apiWebhook.NewListWebhookDeliveriesInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.ListWebhookDeliveriesInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	response := &models.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*models.WebhookDeliveryResponse, 0, len(deliveries)),
+	}
+
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, delivery.ToWebhookDeliveryDTO())
+	}
+
+	return apiWebhook.NewListWebhookDeliveriesOK().
+		WithPayload(response)
+}
+
+// ResendWebhookDeliveryHandler handles the request to send a webhook delivery again.
+func (wh *WebhookHandler) ResendWebhookDeliveryHandler(params apiWebhook.ResendWebhookDeliveryParams, principal interface{}) middleware.Responder {
+	wh.log.Debug("Resend webhook delivery handler", map[string]interface{}{
+		"delivery_id": params.ID.String(),
+	})
+
+	claims, ok := principal.(*jwt.Claims)
+	if !ok {
+		return apiWebhook.NewResendWebhookDeliveryForbidden().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.ResendWebhookDeliveryForbiddenCode),
+				Message: "unknown principal",
+			})
+	}
+
+	resent, err := wh.webhookUsecase.ResendWebhookDelivery(params.HTTPRequest.Context(), claims.Subject, params.ID.String())
+
+	switch {
+	case errors.Is(err, usecase.ErrWebhookDeliveryNotFound):
+		return apiWebhook.NewResendWebhookDeliveryNotFound().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.ResendWebhookDeliveryNotFoundCode),
+				Message: usecase.ErrWebhookDeliveryNotFound.Error(),
+			})
+	case err != nil:
+		return apiWebhook.NewResendWebhookDeliveryInternalServerError().
+			WithPayload(&models.ErrorResponse{
+				Code:    int32(apiWebhook.ResendWebhookDeliveryInternalServerErrorCode),
+				Message: err.Error(),
+			})
+	}
+
+	return apiWebhook.NewResendWebhookDeliveryOK().
+		WithPayload(&models.ResendWebhookDeliveryResponse{
+			DeliveryID: (*strfmt.UUID)(&resent.ID),
+		})
+}
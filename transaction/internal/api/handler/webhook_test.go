@@ -0,0 +1,266 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	apiWebhook "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/webhook"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	mock_usecase "github.com/ShmelJUJ/software-engineering/transaction/internal/usecase/mocks"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testMerchantID = "5c1d3e2f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	testWebhookID  = "6d2e4f3a-5b6c-4d7e-8f9a-0b1c2d3e4f5a"
+	testDeliveryID = "7e3f5a4b-6c7d-4e8f-9a0b-1c2d3e4f5a6b"
+)
+
+var testMerchantClaims = &jwt.Claims{
+	Subject: testMerchantID,
+	Roles:   []string{jwt.RoleMerchant},
+}
+
+func webhookHandlerHelper(t *testing.T) (*handler.WebhookHandler, *mock_usecase.MockWebhookUsecase) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	webhookUsecase := mock_usecase.NewMockWebhookUsecase(mockCtrl)
+
+	return handler.NewWebhookHandler(webhookUsecase, l), webhookUsecase
+}
+
+func TestCreateWebhookHandler(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		principal      interface{}
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "Successfully create webhook",
+			principal:      testMerchantClaims,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown principal",
+			principal:      nil,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Failed to create webhook",
+			principal:      testMerchantClaims,
+			err:            errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookHandler, webhookUsecase := webhookHandlerHelper(t)
+
+			if testcase.principal != nil {
+				webhookUsecase.EXPECT().
+					CreateWebhook(gomock.Any(), gomock.Cond(func(x any) bool {
+						webhook := x.(*model.Webhook)
+
+						return webhook.MerchantID == testMerchantID &&
+							webhook.URL == "https://merchant.example.com/webhook" &&
+							webhook.Secret == "test-webhook-secret" &&
+							webhook.Active
+					})).
+					Return(testcase.err)
+			}
+
+			url := strfmt.URI("https://merchant.example.com/webhook")
+
+			responder := webhookHandler.CreateWebhookHandler(apiWebhook.CreateWebhookParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/webhook/create", nil),
+				Body: &models.CreateWebhookRequest{
+					URL:    &url,
+					Secret: swag.String("test-webhook-secret"),
+				},
+			}, testcase.principal)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestListWebhookDeliveriesHandler(t *testing.T) {
+	t.Parallel()
+
+	responseCode := int32(http.StatusInternalServerError)
+	lastError := "webhook responded with status code 500"
+	nextAttemptAt := time.Date(2024, time.May, 1, 12, 1, 0, 0, time.UTC)
+
+	deliveries := []*model.WebhookDelivery{
+		{
+			ID:               testDeliveryID,
+			WebhookID:        testWebhookID,
+			TransactionID:    testTransactionID,
+			FromStatus:       model.Processed,
+			ToStatus:         model.Succeeded,
+			Status:           model.DeliveryPending,
+			Attempts:         1,
+			NextAttemptAt:    &nextAttemptAt,
+			LastResponseCode: &responseCode,
+			LastError:        &lastError,
+			CreatedAt:        time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	testcases := []struct {
+		name           string
+		deliveries     []*model.WebhookDelivery
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "Successfully list deliveries",
+			deliveries:     deliveries,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Webhook not found",
+			err:            usecase.ErrWebhookNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Failed to list deliveries",
+			err:            errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookHandler, webhookUsecase := webhookHandlerHelper(t)
+
+			webhookUsecase.EXPECT().
+				ListWebhookDeliveries(gomock.Any(), testMerchantID, testWebhookID, uint64(20), uint64(0)).
+				Return(testcase.deliveries, testcase.err)
+
+			responder := webhookHandler.ListWebhookDeliveriesHandler(apiWebhook.ListWebhookDeliveriesParams{
+				HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/webhook/"+testWebhookID+"/deliveries", nil),
+				ID:          strfmt.UUID(testWebhookID),
+				Limit:       swag.Int32(20),
+				Offset:      swag.Int32(0),
+			}, testMerchantClaims)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.deliveries == nil {
+				return
+			}
+
+			var response models.ListWebhookDeliveriesResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.Len(t, response.Deliveries, 1)
+
+			delivery := response.Deliveries[0]
+			assert.Equal(t, testDeliveryID, delivery.DeliveryID.String())
+			assert.Equal(t, "succeeded", *delivery.Status)
+			assert.Equal(t, "processed", *delivery.PreviousStatus)
+			assert.Equal(t, "pending", *delivery.State)
+			assert.Equal(t, int32(1), *delivery.Attempts)
+			assert.Equal(t, responseCode, *delivery.LastResponseCode)
+			assert.Equal(t, lastError, delivery.LastError)
+			assert.NotNil(t, delivery.NextAttemptAt)
+			assert.Nil(t, delivery.DeliveredAt)
+		})
+	}
+}
+
+func TestResendWebhookDeliveryHandler(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		resent         *model.WebhookDelivery
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "Successfully resend delivery",
+			resent:         &model.WebhookDelivery{ID: "8f4a6b5c-7d8e-4f9a-0b1c-2d3e4f5a6b7c"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Delivery not found",
+			err:            usecase.ErrWebhookDeliveryNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Failed to resend delivery",
+			err:            errors.New("test err"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookHandler, webhookUsecase := webhookHandlerHelper(t)
+
+			webhookUsecase.EXPECT().
+				ResendWebhookDelivery(gomock.Any(), testMerchantID, testDeliveryID).
+				Return(testcase.resent, testcase.err)
+
+			responder := webhookHandler.ResendWebhookDeliveryHandler(apiWebhook.ResendWebhookDeliveryParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/api/v1/webhook/delivery/"+testDeliveryID+"/resend", nil),
+				ID:          strfmt.UUID(testDeliveryID),
+			}, testMerchantClaims)
+
+			rec := httptest.NewRecorder()
+			responder.WriteResponse(rec, runtime.JSONProducer())
+
+			require.Equal(t, testcase.expectedStatus, rec.Code, rec.Body.String())
+
+			if testcase.resent == nil {
+				return
+			}
+
+			var response models.ResendWebhookDeliveryResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, testcase.resent.ID, response.DeliveryID.String())
+		})
+	}
+}
@@ -2,7 +2,11 @@ package app
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -11,18 +15,40 @@ import (
 	"github.com/IBM/sarama"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/subscriber"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/events"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/expiry"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fx"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/group"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/hold"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/mandate"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/reconcile"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/saga"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scheduled"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
-
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/webhook"
+
+	apiAdmin "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/admin"
+	apiFee "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/fee"
+	apiLedger "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/ledger"
+	apiMandate "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/mandate"
+	apiPaymentPoint "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/payment_point"
+	apiReport "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/report"
 	apiTransaction "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	apiWebhook "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/webhook"
 
 	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client"
 	"github.com/go-openapi/loads"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
 	"github.com/ShmelJUJ/software-engineering/pkg/kafka"
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
 	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
@@ -30,6 +56,7 @@ import (
 	"github.com/ShmelJUJ/software-engineering/transaction/config"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/handler"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/api/middleware"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/auth"
 )
 
 const (
@@ -54,6 +81,31 @@ func getSubscriberSaramaConfig() (*sarama.Config, error) {
 	return saramaConfig, nil
 }
 
+// qrSigningKey decodes the configured payload signing key or generates an ephemeral one.
+func qrSigningKey(seed string, l logger.Logger) ed25519.PrivateKey {
+	if seed == "" {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			l.Fatal("failed to generate qr signing key", map[string]interface{}{
+				"error": err,
+			})
+		}
+
+		l.Warn("qr signing key is not configured, using an ephemeral key", nil)
+
+		return key
+	}
+
+	rawSeed, err := base64.StdEncoding.DecodeString(seed)
+	if err != nil || len(rawSeed) != ed25519.SeedSize {
+		l.Fatal("invalid qr signing key, expected base64 encoded ed25519 seed", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	return ed25519.NewKeyFromSeed(rawSeed)
+}
+
 func Run(cfg *config.Config) {
 	ctx := context.Background()
 
@@ -105,7 +157,11 @@ func Run(cfg *config.Config) {
 
 	transactionPublisher, err := publisher.NewTransactionPublisher(
 		&publisher.Config{
-			ProcessedTransactionTopic: cfg.PublisherCfg.ProcessedTransactionTopic,
+			ProcessedTransactionTopic:        cfg.PublisherCfg.ProcessedTransactionTopic,
+			CancelledTransactionTopic:        cfg.PublisherCfg.CancelledTransactionTopic,
+			StatusRequestedTransactionTopic:  cfg.PublisherCfg.StatusRequestedTransactionTopic,
+			CaptureRequestedTransactionTopic: cfg.PublisherCfg.CaptureRequestedTransactionTopic,
+			VoidRequestedTransactionTopic:    cfg.PublisherCfg.VoidRequestedTransactionTopic,
 		},
 		l,
 		kafkaPublisher,
@@ -116,14 +172,176 @@ func Run(cfg *config.Config) {
 		})
 	}
 
+	verifier, err := jwt.NewVerifier(&jwt.Config{
+		Issuer:             cfg.AuthCfg.Issuer,
+		RefreshInterval:    cfg.AuthCfg.JWKSRefreshInterval,
+		MinRefreshInterval: cfg.AuthCfg.JWKSMinRefreshInterval,
+		Leeway:             cfg.AuthCfg.Leeway,
+	}, auth.NewMonitorKeyFetcher(monitorClient.Monitor, l), clock.New())
+	if err != nil {
+		l.Fatal("failed to create auth token verifier", map[string]interface{}{
+			"error": err,
+		})
+	}
+
 	transactionRepo := repository.NewTransactionRepo(pg, l)
-	transactionUsecase := usecase.NewTransactionUsecase(transactionRepo, transactionPublisher, l)
+	var confirmationNotifier confirmation.Notifier
+
+	switch cfg.ConfirmationCfg.Notifier {
+	case "file":
+		confirmationNotifier = confirmation.NewFileNotifier(cfg.ConfirmationCfg.NotifierFile)
+	default:
+		confirmationNotifier = confirmation.NewLogNotifier(l)
+	}
+
+	walletKeys := confirmation.NewMonitorWalletKeyFetcher(monitorClient.Monitor, l)
+
+	confirmer, err := confirmation.NewConfirmer(
+		&confirmation.Config{
+			Thresholds:  cfg.ConfirmationCfg.Thresholds,
+			CodeTTL:     cfg.ConfirmationCfg.CodeTTL,
+			MaxAttempts: cfg.ConfirmationCfg.MaxAttempts,
+		},
+		confirmationNotifier,
+		walletKeys,
+		clock.New(),
+	)
+	if err != nil {
+		l.Fatal("failed to create transaction confirmer", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	scanTokens, err := scantoken.NewIssuer(
+		&scantoken.Config{
+			ServiceURL: cfg.QRCfg.ServiceURL,
+			TTL:        cfg.QRCfg.TTL,
+		},
+		qrSigningKey(cfg.QRCfg.SigningKey, l),
+		scantoken.NewRedisNonceStore(r.Client),
+		clock.New(),
+	)
+	if err != nil {
+		l.Fatal("failed to create qr scan token issuer", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	var rateProvider fx.RateProvider
+
+	switch cfg.FXCfg.Provider {
+	case "http":
+		rateProvider, err = fx.NewHTTPProvider(cfg.FXCfg.URL, &http.Client{Timeout: cfg.FXCfg.RequestTimeout})
+	default:
+		rateProvider, err = fx.LoadStaticProvider(cfg.FXCfg.RatesFile)
+	}
+
+	if err != nil {
+		l.Fatal("failed to create exchange rate provider", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	quoter, err := fx.NewQuoter(
+		&fx.Config{
+			LockDuration: cfg.FXCfg.LockDuration,
+			MarginBps:    cfg.FXCfg.MarginBps,
+			SpreadBps:    cfg.FXCfg.SpreadBps,
+		},
+		rateProvider,
+		clock.New(),
+	)
+	if err != nil {
+		l.Fatal("failed to create exchange rate quoter", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	feeRepo := repository.NewFeeRepo(pg, l)
+
+	feeCalculator, err := fee.NewCalculator(
+		&fee.Config{
+			WalletUserID: cfg.FeeCfg.WalletUserID,
+			WalletID:     cfg.FeeCfg.WalletID,
+		},
+		feeRepo,
+		clock.New(),
+	)
+	if err != nil {
+		l.Fatal("failed to create fee calculator", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	transactionUsecase := usecase.NewTransactionUsecase(
+		transactionRepo,
+		transactionPublisher,
+		confirmer,
+		scanTokens,
+		quoter,
+		feeCalculator,
+		clock.New(),
+		cfg.ExpiryCfg.TTL,
+		cfg.GroupCfg.Deadline,
+		l,
+	)
+	clientIPs, err := handler.NewClientIPResolver(cfg.HTTPCfg.ForwardedHeader, cfg.HTTPCfg.TrustedProxies)
+	if err != nil {
+		l.Fatal("failed to create client ip resolver", map[string]interface{}{
+			"error": err,
+		})
+	}
+
 	transactionHandler := handler.NewTransactionHandler(
 		transactionUsecase,
 		l,
 		monitorClient.Monitor,
-		r,
+		verifier,
+		clientIPs,
 	)
+	qrHandler := handler.NewQRHandler(transactionUsecase, scanTokens, walletKeys, l)
+
+	eventBroker, err := events.NewRedisBroker(
+		&events.Config{
+			KeyPrefix:     cfg.EventsCfg.KeyPrefix,
+			HistoryLength: cfg.EventsCfg.HistoryLength,
+			HistoryTTL:    cfg.EventsCfg.HistoryTTL,
+		},
+		r.Client,
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create transaction event broker", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	eventsHandler := handler.NewEventsHandler(transactionUsecase, eventBroker, cfg.EventsCfg.HeartbeatInterval, l)
+
+	paymentPointUsecase := usecase.NewPaymentPointUsecase(
+		repository.NewPaymentPointRepo(pg, l),
+		transactionRepo,
+		transactionPublisher,
+		confirmer,
+		feeCalculator,
+		clock.New(),
+		cfg.ExpiryCfg.TTL,
+		l,
+	)
+	paymentPointHandler := handler.NewPaymentPointHandler(paymentPointUsecase, cfg.QRCfg.ServiceURL, l)
+
+	mandateRepo := repository.NewMandateRepo(pg, l)
+	mandateHandler := handler.NewMandateHandler(usecase.NewMandateUsecase(mandateRepo, confirmer, clock.New(), l), l)
+
+	webhookRepo := repository.NewWebhookRepo(pg, l)
+	webhookHandler := handler.NewWebhookHandler(usecase.NewWebhookUsecase(webhookRepo, clock.New(), l), l)
+
+	ledgerHandler := handler.NewLedgerHandler(usecase.NewLedgerUsecase(repository.NewLedgerRepo(pg, l), l), l)
+
+	feeHandler := handler.NewFeeHandler(usecase.NewFeeUsecase(feeRepo, l), l)
+
+	reportHandler := handler.NewReportHandler(usecase.NewReportUsecase(repository.NewReportRepo(pg, l), clock.New(), l), l)
 
 	middlewareManager, err := middleware.NewMiddlewareManager(&middleware.Config{
 		IdempotencyCfg: &middleware.IdempotencyConfig{
@@ -141,12 +359,40 @@ func Run(cfg *config.Config) {
 
 	api.BearerAuth = transactionHandler.VerifyAuthToken
 	api.TransactionAcceptTransactionHandler = apiTransaction.AcceptTransactionHandlerFunc(transactionHandler.AcceptTransactionHandler)
+	api.TransactionConfirmTransactionHandler = apiTransaction.ConfirmTransactionHandlerFunc(transactionHandler.ConfirmTransactionHandler)
 	api.TransactionCancelTransactionHandler = apiTransaction.CancelTransactionHandlerFunc(transactionHandler.CancelTransactionHandler)
+	api.TransactionCaptureTransactionHandler = apiTransaction.CaptureTransactionHandlerFunc(transactionHandler.CaptureTransactionHandler)
+	api.TransactionVoidTransactionHandler = apiTransaction.VoidTransactionHandlerFunc(transactionHandler.VoidTransactionHandler)
 	api.TransactionCreateTransactionHandler = apiTransaction.CreateTransactionHandlerFunc(transactionHandler.CreateTransactionHandler)
 	api.TransactionEditTransactionHandler = apiTransaction.EditTransactionHandlerFunc(transactionHandler.EditTransactionHandler)
+	api.TransactionQuoteTransactionHandler = apiTransaction.QuoteTransactionHandlerFunc(transactionHandler.QuoteTransactionHandler)
+	api.TransactionGetTransactionFeeHandler = apiTransaction.GetTransactionFeeHandlerFunc(transactionHandler.GetTransactionFeeHandler)
 	api.TransactionRetrieveTransactionHandler = apiTransaction.RetrieveTransactionHandlerFunc(transactionHandler.RetrieveTransactionHandler)
 	api.TransactionRetrieveTransactionStatusHandler = apiTransaction.RetrieveTransactionStatusHandlerFunc(transactionHandler.RetrieveTransactionStatusHandler)
+	api.TransactionStreamTransactionEventsHandler = apiTransaction.StreamTransactionEventsHandlerFunc(eventsHandler.StreamTransactionEventsHandler)
+	api.TransactionGetTransactionQRHandler = apiTransaction.GetTransactionQRHandlerFunc(qrHandler.GetTransactionQRHandler)
+	api.PaymentPointCreatePaymentPointHandler = apiPaymentPoint.CreatePaymentPointHandlerFunc(paymentPointHandler.CreatePaymentPointHandler)
+	api.PaymentPointRetrievePaymentPointHandler = apiPaymentPoint.RetrievePaymentPointHandlerFunc(paymentPointHandler.RetrievePaymentPointHandler)
+	api.PaymentPointDisablePaymentPointHandler = apiPaymentPoint.DisablePaymentPointHandlerFunc(paymentPointHandler.DisablePaymentPointHandler)
+	api.PaymentPointGetPaymentPointQRHandler = apiPaymentPoint.GetPaymentPointQRHandlerFunc(paymentPointHandler.GetPaymentPointQRHandler)
+	api.PaymentPointPayPaymentPointHandler = apiPaymentPoint.PayPaymentPointHandlerFunc(paymentPointHandler.PayPaymentPointHandler)
+	api.MandateCreateMandateHandler = apiMandate.CreateMandateHandlerFunc(mandateHandler.CreateMandateHandler)
+	api.MandateRetrieveMandateHandler = apiMandate.RetrieveMandateHandlerFunc(mandateHandler.RetrieveMandateHandler)
+	api.MandatePauseMandateHandler = apiMandate.PauseMandateHandlerFunc(mandateHandler.PauseMandateHandler)
+	api.MandateResumeMandateHandler = apiMandate.ResumeMandateHandlerFunc(mandateHandler.ResumeMandateHandler)
+	api.MandateCancelMandateHandler = apiMandate.CancelMandateHandlerFunc(mandateHandler.CancelMandateHandler)
+	api.WebhookCreateWebhookHandler = apiWebhook.CreateWebhookHandlerFunc(webhookHandler.CreateWebhookHandler)
+	api.WebhookListWebhookDeliveriesHandler = apiWebhook.ListWebhookDeliveriesHandlerFunc(webhookHandler.ListWebhookDeliveriesHandler)
+	api.WebhookResendWebhookDeliveryHandler = apiWebhook.ResendWebhookDeliveryHandlerFunc(webhookHandler.ResendWebhookDeliveryHandler)
+	api.LedgerGetLedgerBalanceHandler = apiLedger.GetLedgerBalanceHandlerFunc(ledgerHandler.GetLedgerBalanceHandler)
+	api.LedgerGetLedgerStatementHandler = apiLedger.GetLedgerStatementHandlerFunc(ledgerHandler.GetLedgerStatementHandler)
+	api.FeeSetFeePlanHandler = apiFee.SetFeePlanHandlerFunc(feeHandler.SetFeePlanHandler)
+	api.FeeGetFeePlansHandler = apiFee.GetFeePlansHandlerFunc(feeHandler.GetFeePlansHandler)
+	api.ReportGetSettlementReportHandler = apiReport.GetSettlementReportHandlerFunc(reportHandler.GetSettlementReportHandler)
 	api.TransactionLoginHandler = apiTransaction.LoginHandlerFunc(transactionHandler.LoginHandler)
+	api.TransactionLoginTwoFactorHandler = apiTransaction.LoginTwoFactorHandlerFunc(transactionHandler.LoginTwoFactorHandler)
+	api.AdminUnlockLoginHandler = apiAdmin.UnlockLoginHandlerFunc(transactionHandler.UnlockLoginHandler)
+	api.TransactionRefreshLoginHandler = apiTransaction.RefreshLoginHandlerFunc(transactionHandler.RefreshLoginHandler)
 
 	middlewareManager.AddIdempotenceMiddleware()
 	middlewareManager.SetupGlobalMiddleware(swaggerSpec, api)
@@ -213,13 +459,19 @@ func Run(cfg *config.Config) {
 
 	transactionSub, err := subscriber.NewTransactionSubscriber(
 		&subscriber.Config{
-			FailedTransactionTopic:    cfg.SubscriberCfg.FailedTransactionTopic,
-			SucceededTransactionTopic: cfg.SubscriberCfg.SucceededTransactionTopic,
+			FailedTransactionTopic:         cfg.SubscriberCfg.FailedTransactionTopic,
+			SucceededTransactionTopic:      cfg.SubscriberCfg.SucceededTransactionTopic,
+			StatusReportedTransactionTopic: cfg.SubscriberCfg.StatusReportedTransactionTopic,
+			AuthorizedTransactionTopic:     cfg.SubscriberCfg.AuthorizedTransactionTopic,
+			VoidedTransactionTopic:         cfg.SubscriberCfg.VoidedTransactionTopic,
+			HoldTimeout:                    cfg.HoldCfg.Timeout,
 		},
 		l,
 		kafkaSubscriber,
 		kafkaRouter,
 		transactionRepo,
+		transactionPublisher,
+		eventBroker,
 	)
 	if err != nil {
 		l.Fatal("failed to create new transaction subscriber", map[string]interface{}{
@@ -229,6 +481,9 @@ func Run(cfg *config.Config) {
 
 	transactionSub.RegisterFailedTransactionHandler()
 	transactionSub.RegisterSucceededTransactionHandler()
+	transactionSub.RegisterStatusReportedTransactionHandler()
+	transactionSub.RegisterAuthorizedTransactionHandler()
+	transactionSub.RegisterVoidedTransactionHandler()
 
 	go func() {
 		if err := transactionSub.Run(ctx); err != nil {
@@ -238,6 +493,179 @@ func Run(cfg *config.Config) {
 		}
 	}()
 
+	// Run expiration sweeper
+	expirySweeper, err := expiry.NewSweeper(
+		&expiry.Config{
+			SweepInterval: cfg.ExpiryCfg.SweepInterval,
+			BatchSize:     cfg.ExpiryCfg.BatchSize,
+		},
+		transactionRepo,
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create transaction expiry sweeper", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go expirySweeper.Run(ctx)
+
+	// Run scheduled transaction executor, due transactions are claimed so every replica can execute them
+	scheduledExecutor, err := scheduled.NewExecutor(
+		&scheduled.Config{
+			PollInterval: cfg.ScheduledCfg.PollInterval,
+			BatchSize:    cfg.ScheduledCfg.BatchSize,
+		},
+		transactionRepo,
+		transactionPublisher,
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create scheduled transaction executor", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go scheduledExecutor.Run(ctx)
+
+	// Run expired hold voider, expired holds are claimed so every replica can void them
+	holdVoider, err := hold.NewVoider(
+		&hold.Config{
+			PollInterval:  cfg.HoldCfg.PollInterval,
+			BatchSize:     cfg.HoldCfg.BatchSize,
+			RetryInterval: cfg.HoldCfg.RetryInterval,
+		},
+		transactionRepo,
+		transactionPublisher,
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create expired hold voider", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go holdVoider.Run(ctx)
+
+	// Run group transaction settler
+	groupSettler, err := group.NewSettler(
+		&group.Config{
+			SweepInterval: cfg.GroupCfg.SweepInterval,
+			BatchSize:     cfg.GroupCfg.BatchSize,
+		},
+		transactionRepo,
+		transactionPublisher,
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create group transaction settler", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go groupSettler.Run(ctx)
+
+	// Run saga supervisor, only the replica holding the redis lease supervises
+	sagaSupervisor, err := saga.NewSupervisor(
+		&saga.Config{
+			ProcessingTimeout: cfg.SagaCfg.ProcessingTimeout,
+			StatusTimeout:     cfg.SagaCfg.StatusTimeout,
+			CheckInterval:     cfg.SagaCfg.CheckInterval,
+			BatchSize:         cfg.SagaCfg.BatchSize,
+		},
+		transactionRepo,
+		transactionPublisher,
+		saga.NewRedisElector(r.Client, cfg.SagaCfg.LeaderKey, cfg.SagaCfg.LeaderTTL, l),
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create transaction saga supervisor", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go sagaSupervisor.Run(ctx)
+
+	// Run mandate scheduler, only the replica holding its redis lease charges mandates
+	mandateScheduler, err := mandate.NewScheduler(
+		&mandate.Config{
+			CheckInterval:  cfg.MandateCfg.CheckInterval,
+			BatchSize:      cfg.MandateCfg.BatchSize,
+			MaxAttempts:    cfg.MandateCfg.MaxAttempts,
+			InitialBackoff: cfg.MandateCfg.InitialBackoff,
+			MaxBackoff:     cfg.MandateCfg.MaxBackoff,
+		},
+		mandateRepo,
+		transactionPublisher,
+		feeCalculator,
+		saga.NewRedisElector(r.Client, cfg.MandateCfg.LeaderKey, cfg.MandateCfg.LeaderTTL, l),
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create mandate scheduler", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go mandateScheduler.Run(ctx)
+
+	// Run reconciler, only the replica holding its redis lease compares succeeded transactions against the chain
+	algorandIndexer, err := reconcile.NewAlgorandIndexer(cfg.ReconcileCfg.IndexerAddress, cfg.ReconcileCfg.IndexerToken)
+	if err != nil {
+		l.Fatal("failed to create algorand indexer client", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	reconciler, err := reconcile.NewReconciler(
+		&reconcile.Config{
+			CheckInterval: cfg.ReconcileCfg.CheckInterval,
+			Lookback:      cfg.ReconcileCfg.Lookback,
+			Delay:         cfg.ReconcileCfg.Delay,
+		},
+		transactionRepo,
+		walletKeys,
+		algorandIndexer,
+		saga.NewRedisElector(r.Client, cfg.ReconcileCfg.LeaderKey, cfg.ReconcileCfg.LeaderTTL, l),
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create reconciler", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go reconciler.Run(ctx)
+
+	// Run webhook dispatcher, deliveries are leased so every replica can dispatch
+	webhookDispatcher, err := webhook.NewDispatcher(
+		&webhook.Config{
+			PollInterval:   cfg.WebhookCfg.PollInterval,
+			BatchSize:      cfg.WebhookCfg.BatchSize,
+			RequestTimeout: cfg.WebhookCfg.RequestTimeout,
+			MaxAttempts:    cfg.WebhookCfg.MaxAttempts,
+			InitialBackoff: cfg.WebhookCfg.InitialBackoff,
+			MaxBackoff:     cfg.WebhookCfg.MaxBackoff,
+		},
+		webhookRepo,
+		&http.Client{},
+		clock.New(),
+		l,
+	)
+	if err != nil {
+		l.Fatal("failed to create webhook dispatcher", map[string]interface{}{
+			"error": err,
+		})
+	}
+
+	go webhookDispatcher.Run(ctx)
+
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGTERM)
@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/config"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+)
+
+const reportDateLayout = "2006-01-02"
+
+// Report runs the report subcommand: it writes the settlement report of a day to out,
+// or to the file given by -output. The day defaults to yesterday in UTC.
+func Report(cfg *config.Config, args []string, out io.Writer) error {
+	ctx := context.Background()
+
+	flags := flag.NewFlagSet("report", flag.ContinueOnError)
+
+	var (
+		date       = flags.String("date", time.Now().UTC().AddDate(0, 0, -1).Format(reportDateLayout), "day to report, YYYY-MM-DD in UTC")
+		merchantID = flags.String("merchant", "", "user id of the merchant to report, every merchant when empty")
+		formatName = flags.String("format", string(report.CSVFormat), "report format: csv, json or camt053")
+		output     = flags.String("output", "", "file to write the report to, standard output when empty")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	day, err := time.Parse(reportDateLayout, *date)
+	if err != nil {
+		return fmt.Errorf("invalid date %q: %w", *date, err)
+	}
+
+	format, err := report.ParseFormat(*formatName)
+	if err != nil {
+		return err
+	}
+
+	l, err := logger.NewLogrusLogger(cfg.LoggerCfg.Level)
+	if err != nil {
+		return fmt.Errorf("failed to create new logger: %w", err)
+	}
+
+	pg, err := postgres.New(ctx, cfg.PostgresCfg.URL, postgres.WithLogger(l))
+	if err != nil {
+		return fmt.Errorf("failed to create a new postgre: %w", err)
+	}
+	defer pg.Close()
+
+	reportUsecase := usecase.NewReportUsecase(repository.NewReportRepo(pg, l), clock.New(), l)
+
+	settlementReport, err := reportUsecase.GetSettlementReport(ctx, day, *merchantID)
+	if err != nil {
+		return err
+	}
+
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("failed to create report file: %w", err)
+		}
+		defer file.Close()
+
+		out = file
+	}
+
+	return report.Write(out, settlementReport, format)
+}
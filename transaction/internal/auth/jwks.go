@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client/monitor"
+	monitor_models "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/models"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	transactionService = "transaction"
+	userService        = "user"
+
+	getJWKSMethod = "getJWKS"
+)
+
+var errEmptyJWKS = errors.New("user service returned empty jwks")
+
+// MonitorKeyFetcher fetches the user service JWKS through the monitor.
+type MonitorKeyFetcher struct {
+	monitorClient monitor_client.ClientService
+	log           logger.Logger
+}
+
+// NewMonitorKeyFetcher creates a new instance of MonitorKeyFetcher.
+func NewMonitorKeyFetcher(monitorClient monitor_client.ClientService, log logger.Logger) jwt.KeyFetcher {
+	return &MonitorKeyFetcher{
+		monitorClient: monitorClient,
+		log:           log,
+	}
+}
+
+// FetchJWKS requests the current JWKS from the user service.
+func (f *MonitorKeyFetcher) FetchJWKS(ctx context.Context) (*jwt.JWKS, error) {
+	from := transactionService
+	to := userService
+	method := getJWKSMethod
+
+	f.log.Debug("Fetch JWKS", map[string]interface{}{
+		"from":   from,
+		"to":     to,
+		"method": method,
+	})
+
+	resp, err := f.monitorClient.Process(&monitor_client.ProcessParams{
+		Body: &monitor_models.ProcessRequest{
+			From:   &from,
+			To:     &to,
+			Method: &method,
+		},
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to process getJWKS request: %w", err)
+	}
+
+	jwks := &jwt.JWKS{}
+
+	if err := mapstructure.Decode(resp.Payload, jwks); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	if len(jwks.Keys) == 0 {
+		return nil, errEmptyJWKS
+	}
+
+	return jwks, nil
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client/monitor"
+	mock_monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchJWKS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testErr := errors.New("test err")
+
+	testPayload := map[string]interface{}{
+		"keys": []interface{}{
+			map[string]interface{}{
+				"kty": "OKP",
+				"crv": "Ed25519",
+				"x":   "test-x",
+				"kid": "test-kid",
+				"use": "sig",
+				"alg": "EdDSA",
+			},
+		},
+	}
+
+	testcases := []struct {
+		name         string
+		mock         func(*mock_monitor_client.MockClientService)
+		expectedJWKS *jwt.JWKS
+		expectedErr  bool
+	}{
+		{
+			name: "Successfully fetch jwks",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(&monitor_client.ProcessOK{Payload: testPayload}, nil)
+			},
+			expectedJWKS: &jwt.JWKS{
+				Keys: []jwt.JWK{
+					{
+						Kty: "OKP",
+						Crv: "Ed25519",
+						X:   "test-x",
+						Kid: "test-kid",
+						Use: "sig",
+						Alg: "EdDSA",
+					},
+				},
+			},
+		},
+		{
+			name: "Failed to process request",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(nil, testErr)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Empty jwks",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(&monitor_client.ProcessOK{Payload: map[string]interface{}{}}, nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			log := mock_logger.NewMockLogger(mockCtrl)
+			log.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			monitorClient := mock_monitor_client.NewMockClientService(mockCtrl)
+			testcase.mock(monitorClient)
+
+			fetcher := NewMonitorKeyFetcher(monitorClient, log)
+
+			actualJWKS, err := fetcher.FetchJWKS(ctx)
+
+			assert.Equal(t, testcase.expectedJWKS, actualJWKS)
+			assert.Equal(t, testcase.expectedErr, err != nil)
+		})
+	}
+}
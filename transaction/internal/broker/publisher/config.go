@@ -10,20 +10,32 @@ import (
 var ErrNilConfig = errors.New("cannot override nil config")
 
 const (
-	defaultProcessedTransactionTopic = "transaction.processed"
-	defaultProcessMonitorTopic       = "monitor.process"
+	defaultProcessedTransactionTopic        = "transaction.processed"
+	defaultCancelledTransactionTopic        = "transaction.cancelled"
+	defaultStatusRequestedTransactionTopic  = "transaction.status_requested"
+	defaultCaptureRequestedTransactionTopic = "transaction.capture_requested"
+	defaultVoidRequestedTransactionTopic    = "transaction.void_requested"
+	defaultProcessMonitorTopic              = "monitor.process"
 )
 
 // Config represents the publisher configuration structure.
 type Config struct {
-	ProcessedTransactionTopic string
-	ProcessMonitorTopic       string
+	ProcessedTransactionTopic        string
+	CancelledTransactionTopic        string
+	StatusRequestedTransactionTopic  string
+	CaptureRequestedTransactionTopic string
+	VoidRequestedTransactionTopic    string
+	ProcessMonitorTopic              string
 }
 
 func getDefaultConfig() *Config {
 	return &Config{
-		ProcessedTransactionTopic: defaultProcessedTransactionTopic,
-		ProcessMonitorTopic:       defaultProcessMonitorTopic,
+		ProcessedTransactionTopic:        defaultProcessedTransactionTopic,
+		CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+		StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+		CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+		VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+		ProcessMonitorTopic:              defaultProcessMonitorTopic,
 	}
 }
 
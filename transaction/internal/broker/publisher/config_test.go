@@ -25,16 +25,24 @@ func TestMergeWithDefault(t *testing.T) {
 				ProcessedTransactionTopic: testTransactionProcessedTopic,
 			},
 			expectedCfg: &Config{
-				ProcessedTransactionTopic: testTransactionProcessedTopic,
-				ProcessMonitorTopic:       defaultProcessMonitorTopic,
+				ProcessedTransactionTopic:        testTransactionProcessedTopic,
+				CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+				StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+				CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+				VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+				ProcessMonitorTopic:              defaultProcessMonitorTopic,
 			},
 		},
 		{
 			name: "With empty config",
 			cfg:  &Config{},
 			expectedCfg: &Config{
-				ProcessedTransactionTopic: defaultProcessedTransactionTopic,
-				ProcessMonitorTopic:       defaultProcessMonitorTopic,
+				ProcessedTransactionTopic:        defaultProcessedTransactionTopic,
+				CancelledTransactionTopic:        defaultCancelledTransactionTopic,
+				StatusRequestedTransactionTopic:  defaultStatusRequestedTransactionTopic,
+				CaptureRequestedTransactionTopic: defaultCaptureRequestedTransactionTopic,
+				VoidRequestedTransactionTopic:    defaultVoidRequestedTransactionTopic,
+				ProcessMonitorTopic:              defaultProcessMonitorTopic,
 			},
 			expectedErr: nil,
 		},
@@ -2,12 +2,15 @@ package dto
 
 import (
 	"encoding/json"
-	"strconv"
+	"time"
 
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 )
 
 // Transaction represents a transaction with details such as ID, value, currency, and payment method.
+// Value is the decimal amount in the major unit of the currency, like "12.34" for USD,
+// every payment gateway converts it into its own base unit.
 type Transaction struct {
 	TransactionID string `json:"transaction_id"`
 	Value         string `json:"value"`
@@ -20,11 +23,27 @@ type TransactionUser struct {
 	WalletID string `json:"wallet_id"`
 }
 
+// TransactionLeg represents the part of a split transaction paid to one receiver.
+// Value is the decimal amount in the major unit of the transaction currency.
+type TransactionLeg struct {
+	Receiver *TransactionUser `json:"receiver"`
+	Value    string           `json:"value"`
+}
+
 // ProcessedTransaction represents a processed transaction, including the original transaction details, sender ID, and receiver ID.
+// Legs are set for split transactions and transactions charged a fee, the first leg is paid to the receiver
+// and the values add up to the transaction value. The fee is deducted from the first leg and paid by the last one.
+// AuthorizeOnly asks the payment gateway to hold the value on the payer side until it is captured or voided.
+// Watch asks the payment gateway to watch the receiver for a payment from a wallet outside of the platform
+// until ExpiresAt, a watch transaction has no sender.
 type ProcessedTransaction struct {
-	Transaction *Transaction     `json:"transaction"`
-	Sender      *TransactionUser `json:"sender"`
-	Receiver    *TransactionUser `json:"receiver"`
+	Transaction   *Transaction      `json:"transaction"`
+	Sender        *TransactionUser  `json:"sender"`
+	Receiver      *TransactionUser  `json:"receiver"`
+	Legs          []*TransactionLeg `json:"legs,omitempty"`
+	AuthorizeOnly bool              `json:"authorize_only,omitempty"`
+	Watch         bool              `json:"watch,omitempty"`
+	ExpiresAt     *time.Time        `json:"expires_at,omitempty"`
 }
 
 // Encode serializes a ProcessedTransaction into a JSON-encoded byte slice.
@@ -38,23 +57,162 @@ func (t *ProcessedTransaction) Encode() ([]byte, error) {
 }
 
 // FromTransactionModel creates a ProcessedTransaction from a model.Transaction object.
-func FromTransactionModel(transaction *model.Transaction) *ProcessedTransaction {
-	amount := strconv.Itoa(int(transaction.Amount))
+// The sender is left out for a watch transaction, which is paid from a wallet outside of the platform.
+// A transaction with a locked quote is paid in the quote currency, the converted amount is sent.
+// It fails when the currency is not registered in money.
+func FromTransactionModel(transaction *model.Transaction) (*ProcessedTransaction, error) {
+	amount, err := transaction.PaymentMoney()
+	if err != nil {
+		return nil, err
+	}
 
-	return &ProcessedTransaction{
+	processedTransaction := &ProcessedTransaction{
 		Transaction: &Transaction{
 			TransactionID: transaction.ID,
-			Value:         amount,
-			Currency:      transaction.Currency,
+			Value:         amount.Decimal(),
+			Currency:      amount.Currency().Code,
 			PaymentMethod: transaction.Method,
 		},
-		Sender: &TransactionUser{
+		Receiver: &TransactionUser{
+			UserID:   transaction.Receiver.UserID,
+			WalletID: transaction.Receiver.WalletID,
+		},
+		AuthorizeOnly: transaction.ManualCapture,
+	}
+
+	if transaction.Sender != nil {
+		processedTransaction.Sender = &TransactionUser{
 			UserID:   transaction.Sender.UserID,
 			WalletID: transaction.Sender.WalletID,
+		}
+	}
+
+	if transaction.Watch {
+		processedTransaction.Watch = true
+		processedTransaction.ExpiresAt = transaction.ExpiresAt
+	}
+
+	if len(transaction.Legs) == 0 && transaction.Fee == 0 {
+		return processedTransaction, nil
+	}
+
+	payouts, fee, err := transaction.Payouts()
+	if err != nil {
+		return nil, err
+	}
+
+	receivers := make([]*model.TransactionUser, 0, len(transaction.Legs))
+	for _, leg := range transaction.Legs {
+		receivers = append(receivers, leg.Receiver)
+	}
+
+	if len(receivers) == 0 {
+		receivers = append(receivers, transaction.Receiver)
+	}
+
+	for i, receiver := range receivers {
+		processedTransaction.Legs = append(processedTransaction.Legs, &TransactionLeg{
+			Receiver: &TransactionUser{
+				UserID:   receiver.UserID,
+				WalletID: receiver.WalletID,
+			},
+			Value: payouts[i].Decimal(),
+		})
+	}
+
+	if fee.Amount() != 0 {
+		processedTransaction.Legs = append(processedTransaction.Legs, &TransactionLeg{
+			Receiver: &TransactionUser{
+				UserID:   transaction.FeeReceiver.UserID,
+				WalletID: transaction.FeeReceiver.WalletID,
+			},
+			Value: fee.Decimal(),
+		})
+	}
+
+	return processedTransaction, nil
+}
+
+// FromCaptureRequest creates the ProcessedTransaction taking the captured amount of an authorized transaction out of its hold.
+// A partial capture is only allowed for transactions without legs and quote, so its value is in the transaction currency.
+func FromCaptureRequest(transaction *model.Transaction) (*ProcessedTransaction, error) {
+	capture, err := FromTransactionModel(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	capture.AuthorizeOnly = false
+
+	if transaction.CapturedAmount != nil && *transaction.CapturedAmount != transaction.Amount {
+		amount, err := money.New(*transaction.CapturedAmount, transaction.Currency)
+		if err != nil {
+			return nil, err
+		}
+
+		capture.Transaction.Value = amount.Decimal()
+	}
+
+	return capture, nil
+}
+
+// FromVoidRequest creates the ProcessedTransaction releasing the hold of an authorized transaction back to the payer.
+func FromVoidRequest(transaction *model.Transaction) (*ProcessedTransaction, error) {
+	void, err := FromTransactionModel(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	void.AuthorizeOnly = false
+
+	return void, nil
+}
+
+// FromTransactionShare creates the ProcessedTransaction paying a share of a group transaction,
+// it is published under the share id from the share payer to the transaction receiver.
+func FromTransactionShare(transaction *model.Transaction, share *model.TransactionShare) (*ProcessedTransaction, error) {
+	amount, err := money.New(share.Amount, transaction.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProcessedTransaction{
+		Transaction: &Transaction{
+			TransactionID: share.ID,
+			Value:         amount.Decimal(),
+			Currency:      amount.Currency().Code,
+			PaymentMethod: transaction.Method,
+		},
+		Sender: &TransactionUser{
+			UserID:   share.Payer.UserID,
+			WalletID: share.Payer.WalletID,
 		},
 		Receiver: &TransactionUser{
 			UserID:   transaction.Receiver.UserID,
 			WalletID: transaction.Receiver.WalletID,
 		},
+	}, nil
+}
+
+// FromShareRefund creates the ProcessedTransaction paying a share of a group transaction back,
+// it is published under the share refund id from the transaction receiver to the share payer.
+func FromShareRefund(transaction *model.Transaction, share *model.TransactionShare) (*ProcessedTransaction, error) {
+	refund, err := FromTransactionShare(transaction, share)
+	if err != nil {
+		return nil, err
 	}
+
+	refund.Transaction.TransactionID = share.RefundID
+	refund.Sender, refund.Receiver = refund.Receiver, refund.Sender
+
+	return refund, nil
+}
+
+// CancelledTransaction represents a command to stop processing the payment of a transaction.
+type CancelledTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// StatusRequestedTransaction represents a request for the live status of a transaction payment.
+type StatusRequestedTransaction struct {
+	TransactionID string `json:"transaction_id"`
 }
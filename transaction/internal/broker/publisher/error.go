@@ -37,3 +37,75 @@ func NewPublishProcessedTransactionError(msg string, err error) *PublishProcesse
 func (e PublishProcessedTransactionError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
+
+// PublishCancelledTransactionError represents an error when attempting to publish a cancelled transaction.
+type PublishCancelledTransactionError struct {
+	msg string
+	err error
+}
+
+// NewPublishCancelledTransactionError creates and returns a new instance of PublishCancelledTransactionError.
+func NewPublishCancelledTransactionError(msg string, err error) *PublishCancelledTransactionError {
+	return &PublishCancelledTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e PublishCancelledTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+// PublishStatusRequestedTransactionError represents an error when attempting to request a transaction payment status.
+type PublishStatusRequestedTransactionError struct {
+	msg string
+	err error
+}
+
+// NewPublishStatusRequestedTransactionError creates and returns a new instance of PublishStatusRequestedTransactionError.
+func NewPublishStatusRequestedTransactionError(msg string, err error) *PublishStatusRequestedTransactionError {
+	return &PublishStatusRequestedTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e PublishStatusRequestedTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+// PublishCaptureRequestedTransactionError represents an error when requesting the capture of a transaction hold.
+type PublishCaptureRequestedTransactionError struct {
+	msg string
+	err error
+}
+
+// NewPublishCaptureRequestedTransactionError creates and returns a new instance of PublishCaptureRequestedTransactionError.
+func NewPublishCaptureRequestedTransactionError(msg string, err error) *PublishCaptureRequestedTransactionError {
+	return &PublishCaptureRequestedTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e PublishCaptureRequestedTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+// PublishVoidRequestedTransactionError represents an error when requesting the void of a transaction hold.
+type PublishVoidRequestedTransactionError struct {
+	msg string
+	err error
+}
+
+// NewPublishVoidRequestedTransactionError creates and returns a new instance of PublishVoidRequestedTransactionError.
+func NewPublishVoidRequestedTransactionError(msg string, err error) *PublishVoidRequestedTransactionError {
+	return &PublishVoidRequestedTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e PublishVoidRequestedTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
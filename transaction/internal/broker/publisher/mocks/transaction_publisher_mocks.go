@@ -39,6 +39,34 @@ func (m *MockTransactionPublisher) EXPECT() *MockTransactionPublisherMockRecorde
 	return m.recorder
 }
 
+// PublishCancelledTransaction mocks base method.
+func (m *MockTransactionPublisher) PublishCancelledTransaction(arg0 *dto.CancelledTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCancelledTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCancelledTransaction indicates an expected call of PublishCancelledTransaction.
+func (mr *MockTransactionPublisherMockRecorder) PublishCancelledTransaction(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCancelledTransaction", reflect.TypeOf((*MockTransactionPublisher)(nil).PublishCancelledTransaction), arg0)
+}
+
+// PublishCaptureRequestedTransaction mocks base method.
+func (m *MockTransactionPublisher) PublishCaptureRequestedTransaction(arg0 *dto.ProcessedTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishCaptureRequestedTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishCaptureRequestedTransaction indicates an expected call of PublishCaptureRequestedTransaction.
+func (mr *MockTransactionPublisherMockRecorder) PublishCaptureRequestedTransaction(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishCaptureRequestedTransaction", reflect.TypeOf((*MockTransactionPublisher)(nil).PublishCaptureRequestedTransaction), arg0)
+}
+
 // PublishProcessedTransaction mocks base method.
 func (m *MockTransactionPublisher) PublishProcessedTransaction(arg0 *dto.ProcessedTransaction) error {
 	m.ctrl.T.Helper()
@@ -52,3 +80,31 @@ func (mr *MockTransactionPublisherMockRecorder) PublishProcessedTransaction(arg0
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishProcessedTransaction", reflect.TypeOf((*MockTransactionPublisher)(nil).PublishProcessedTransaction), arg0)
 }
+
+// PublishStatusRequestedTransaction mocks base method.
+func (m *MockTransactionPublisher) PublishStatusRequestedTransaction(arg0 *dto.StatusRequestedTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStatusRequestedTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStatusRequestedTransaction indicates an expected call of PublishStatusRequestedTransaction.
+func (mr *MockTransactionPublisherMockRecorder) PublishStatusRequestedTransaction(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStatusRequestedTransaction", reflect.TypeOf((*MockTransactionPublisher)(nil).PublishStatusRequestedTransaction), arg0)
+}
+
+// PublishVoidRequestedTransaction mocks base method.
+func (m *MockTransactionPublisher) PublishVoidRequestedTransaction(arg0 *dto.ProcessedTransaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishVoidRequestedTransaction", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishVoidRequestedTransaction indicates an expected call of PublishVoidRequestedTransaction.
+func (mr *MockTransactionPublisherMockRecorder) PublishVoidRequestedTransaction(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishVoidRequestedTransaction", reflect.TypeOf((*MockTransactionPublisher)(nil).PublishVoidRequestedTransaction), arg0)
+}
@@ -1,6 +1,8 @@
 package publisher
 
 import (
+	"fmt"
+
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
 	"github.com/ThreeDotsLabs/watermill"
@@ -14,9 +16,14 @@ const (
 	paymentGatewayService = "payment_gateway"
 )
 
-// TransactionPublisher is an interface for publishing processed transactions.
+// TransactionPublisher is an interface for publishing processed transactions
+// and the commands that supervise their payment.
 type TransactionPublisher interface {
 	PublishProcessedTransaction(transaction *dto.ProcessedTransaction) error
+	PublishCancelledTransaction(transaction *dto.CancelledTransaction) error
+	PublishStatusRequestedTransaction(transaction *dto.StatusRequestedTransaction) error
+	PublishCaptureRequestedTransaction(transaction *dto.ProcessedTransaction) error
+	PublishVoidRequestedTransaction(transaction *dto.ProcessedTransaction) error
 }
 
 type transactionPublisher struct {
@@ -49,26 +56,83 @@ func (p *transactionPublisher) PublishProcessedTransaction(transaction *dto.Proc
 		"transaction": transaction,
 	})
 
+	if err := p.publishProcess(p.cfg.ProcessedTransactionTopic, transaction); err != nil {
+		return NewPublishProcessedTransactionError("failed to publish processed transaction", err)
+	}
+
+	return nil
+}
+
+// PublishCancelledTransaction publishes a command to stop processing the payment of a transaction.
+func (p *transactionPublisher) PublishCancelledTransaction(transaction *dto.CancelledTransaction) error {
+	p.log.Debug("Start publish cancelled transaction", map[string]interface{}{
+		"transaction": transaction,
+	})
+
+	if err := p.publishProcess(p.cfg.CancelledTransactionTopic, transaction); err != nil {
+		return NewPublishCancelledTransactionError("failed to publish cancelled transaction", err)
+	}
+
+	return nil
+}
+
+// PublishStatusRequestedTransaction publishes a request for the live status of a transaction payment.
+func (p *transactionPublisher) PublishStatusRequestedTransaction(transaction *dto.StatusRequestedTransaction) error {
+	p.log.Debug("Start publish status requested transaction", map[string]interface{}{
+		"transaction": transaction,
+	})
+
+	if err := p.publishProcess(p.cfg.StatusRequestedTransactionTopic, transaction); err != nil {
+		return NewPublishStatusRequestedTransactionError("failed to publish status requested transaction", err)
+	}
+
+	return nil
+}
+
+// PublishCaptureRequestedTransaction publishes a request to take the captured amount of a transaction out of its hold.
+func (p *transactionPublisher) PublishCaptureRequestedTransaction(transaction *dto.ProcessedTransaction) error {
+	p.log.Debug("Start publish capture requested transaction", map[string]interface{}{
+		"transaction": transaction,
+	})
+
+	if err := p.publishProcess(p.cfg.CaptureRequestedTransactionTopic, transaction); err != nil {
+		return NewPublishCaptureRequestedTransactionError("failed to publish capture requested transaction", err)
+	}
+
+	return nil
+}
+
+// PublishVoidRequestedTransaction publishes a request to release the hold of a transaction.
+func (p *transactionPublisher) PublishVoidRequestedTransaction(transaction *dto.ProcessedTransaction) error {
+	p.log.Debug("Start publish void requested transaction", map[string]interface{}{
+		"transaction": transaction,
+	})
+
+	if err := p.publishProcess(p.cfg.VoidRequestedTransactionTopic, transaction); err != nil {
+		return NewPublishVoidRequestedTransactionError("failed to publish void requested transaction", err)
+	}
+
+	return nil
+}
+
+// publishProcess sends the payload to the topic through the monitor.
+func (p *transactionPublisher) publishProcess(toTopic string, payload any) error {
 	monitorDTO := &dto.Process{
 		From:    transactionService,
-		ToTopic: p.cfg.ProcessedTransactionTopic,
-		Payload: transaction,
+		ToTopic: toTopic,
+		Payload: payload,
 	}
 
-	payload, err := monitorDTO.Encode()
+	data, err := monitorDTO.Encode()
 	if err != nil {
-		return NewPublishProcessedTransactionError("failed to encode monitor process dto", err)
+		return fmt.Errorf("failed to encode monitor process dto: %w", err)
 	}
 
-	if err = p.pub.Publish(
+	return p.pub.Publish(
 		p.cfg.ProcessMonitorTopic,
 		message.NewMessage(
 			watermill.NewUUID(),
-			payload,
+			data,
 		),
-	); err != nil {
-		return NewPublishProcessedTransactionError("failed to publish processed transaction", err)
-	}
-
-	return nil
+	)
 }
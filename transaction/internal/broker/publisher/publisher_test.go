@@ -147,3 +147,233 @@ func TestPublishSucceededTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestPublishCancelledTransaction(t *testing.T) {
+	t.Parallel()
+
+	cancelledTransaction := &dto.CancelledTransaction{
+		TransactionID: "test-transaction-id",
+	}
+
+	someErr := NewPublishCancelledTransactionError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_publisher.MockPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Successfully publish cancelled transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish cancelled transaction", map[string]interface{}{
+					"transaction": cancelledTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed to publish cancelled transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish cancelled transaction", map[string]interface{}{
+					"transaction": cancelledTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(someErr).Times(1)
+			},
+			expectedErr: NewPublishCancelledTransactionError("failed to publish cancelled transaction", someErr),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, pub := transactionPublisherHelper(t)
+
+			testcase.mock(log, pub)
+
+			transactionPublisher, err := NewTransactionPublisher(&Config{}, log, pub)
+			assert.NoError(t, err)
+
+			err = transactionPublisher.PublishCancelledTransaction(cancelledTransaction)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestPublishStatusRequestedTransaction(t *testing.T) {
+	t.Parallel()
+
+	statusRequestedTransaction := &dto.StatusRequestedTransaction{
+		TransactionID: "test-transaction-id",
+	}
+
+	someErr := NewPublishStatusRequestedTransactionError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_publisher.MockPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Successfully publish status requested transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish status requested transaction", map[string]interface{}{
+					"transaction": statusRequestedTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed to publish status requested transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish status requested transaction", map[string]interface{}{
+					"transaction": statusRequestedTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(someErr).Times(1)
+			},
+			expectedErr: NewPublishStatusRequestedTransactionError("failed to publish status requested transaction", someErr),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, pub := transactionPublisherHelper(t)
+
+			testcase.mock(log, pub)
+
+			transactionPublisher, err := NewTransactionPublisher(&Config{}, log, pub)
+			assert.NoError(t, err)
+
+			err = transactionPublisher.PublishStatusRequestedTransaction(statusRequestedTransaction)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestPublishCaptureRequestedTransaction(t *testing.T) {
+	t.Parallel()
+
+	captureRequestedTransaction := &dto.ProcessedTransaction{
+		Transaction: &dto.Transaction{
+			TransactionID: "test-transaction-id",
+			Value:         "1.5",
+			Currency:      "ALGO",
+			PaymentMethod: "algorand",
+		},
+	}
+
+	someErr := NewPublishCaptureRequestedTransactionError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_publisher.MockPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Successfully publish capture requested transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish capture requested transaction", map[string]interface{}{
+					"transaction": captureRequestedTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed to publish capture requested transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish capture requested transaction", map[string]interface{}{
+					"transaction": captureRequestedTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(someErr).Times(1)
+			},
+			expectedErr: NewPublishCaptureRequestedTransactionError("failed to publish capture requested transaction", someErr),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, pub := transactionPublisherHelper(t)
+
+			testcase.mock(log, pub)
+
+			transactionPublisher, err := NewTransactionPublisher(&Config{}, log, pub)
+			assert.NoError(t, err)
+
+			err = transactionPublisher.PublishCaptureRequestedTransaction(captureRequestedTransaction)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestPublishVoidRequestedTransaction(t *testing.T) {
+	t.Parallel()
+
+	voidRequestedTransaction := &dto.ProcessedTransaction{
+		Transaction: &dto.Transaction{
+			TransactionID: "test-transaction-id",
+			Value:         "1.5",
+			Currency:      "ALGO",
+			PaymentMethod: "algorand",
+		},
+	}
+
+	someErr := NewPublishVoidRequestedTransactionError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_publisher.MockPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Successfully publish void requested transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish void requested transaction", map[string]interface{}{
+					"transaction": voidRequestedTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed to publish void requested transaction",
+			mock: func(ml *mock_logger.MockLogger, mp *mock_publisher.MockPublisher) {
+				ml.EXPECT().Debug("Start publish void requested transaction", map[string]interface{}{
+					"transaction": voidRequestedTransaction,
+				})
+				mp.EXPECT().Publish(testMonitorProcessTopic, gomock.Any()).Return(someErr).Times(1)
+			},
+			expectedErr: NewPublishVoidRequestedTransactionError("failed to publish void requested transaction", someErr),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, pub := transactionPublisherHelper(t)
+
+			testcase.mock(log, pub)
+
+			transactionPublisher, err := NewTransactionPublisher(&Config{}, log, pub)
+			assert.NoError(t, err)
+
+			err = transactionPublisher.PublishVoidRequestedTransaction(voidRequestedTransaction)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package subscriber
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"dario.cat/mergo"
 )
@@ -10,20 +11,33 @@ import (
 var ErrNilConfig = errors.New("cannot override nil config")
 
 const (
-	defaultFailedTransactionTopic    = "transaction.failed"
-	defaultSucceededTransactionTopic = "transaction.succeeded"
+	defaultFailedTransactionTopic         = "transaction.failed"
+	defaultSucceededTransactionTopic      = "transaction.succeeded"
+	defaultStatusReportedTransactionTopic = "transaction.status_reported"
+	defaultAuthorizedTransactionTopic     = "transaction.authorized"
+	defaultVoidedTransactionTopic         = "transaction.voided"
+	defaultHoldTimeout                    = 24 * time.Hour
 )
 
 // Config represents the subscriber configuration structure.
+// HoldTimeout is how long an authorized transaction can be captured before its hold is voided.
 type Config struct {
-	FailedTransactionTopic    string
-	SucceededTransactionTopic string
+	FailedTransactionTopic         string
+	SucceededTransactionTopic      string
+	StatusReportedTransactionTopic string
+	AuthorizedTransactionTopic     string
+	VoidedTransactionTopic         string
+	HoldTimeout                    time.Duration
 }
 
 func getDefaultConfig() *Config {
 	return &Config{
-		FailedTransactionTopic:    defaultFailedTransactionTopic,
-		SucceededTransactionTopic: defaultSucceededTransactionTopic,
+		FailedTransactionTopic:         defaultFailedTransactionTopic,
+		SucceededTransactionTopic:      defaultSucceededTransactionTopic,
+		StatusReportedTransactionTopic: defaultStatusReportedTransactionTopic,
+		AuthorizedTransactionTopic:     defaultAuthorizedTransactionTopic,
+		VoidedTransactionTopic:         defaultVoidedTransactionTopic,
+		HoldTimeout:                    defaultHoldTimeout,
 	}
 }
 
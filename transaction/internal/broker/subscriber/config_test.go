@@ -26,16 +26,24 @@ func TestMergeWithDefault(t *testing.T) {
 				FailedTransactionTopic: testFailedTransactionTopic,
 			},
 			expectedCfg: &Config{
-				FailedTransactionTopic:    testFailedTransactionTopic,
-				SucceededTransactionTopic: defaultSucceededTransactionTopic,
+				FailedTransactionTopic:         testFailedTransactionTopic,
+				SucceededTransactionTopic:      defaultSucceededTransactionTopic,
+				StatusReportedTransactionTopic: defaultStatusReportedTransactionTopic,
+				AuthorizedTransactionTopic:     defaultAuthorizedTransactionTopic,
+				VoidedTransactionTopic:         defaultVoidedTransactionTopic,
+				HoldTimeout:                    defaultHoldTimeout,
 			},
 		},
 		{
 			name: "With empty config",
 			cfg:  &Config{},
 			expectedCfg: &Config{
-				FailedTransactionTopic:    defaultFailedTransactionTopic,
-				SucceededTransactionTopic: defaultSucceededTransactionTopic,
+				FailedTransactionTopic:         defaultFailedTransactionTopic,
+				SucceededTransactionTopic:      defaultSucceededTransactionTopic,
+				StatusReportedTransactionTopic: defaultStatusReportedTransactionTopic,
+				AuthorizedTransactionTopic:     defaultAuthorizedTransactionTopic,
+				VoidedTransactionTopic:         defaultVoidedTransactionTopic,
+				HoldTimeout:                    defaultHoldTimeout,
 			},
 			expectedErr: nil,
 		},
@@ -3,8 +3,10 @@ package dto
 import "encoding/json"
 
 // SucceededTransaction represents a successful transaction.
+// Reason notes how a payment deviated from the transaction and still succeeded, like an overpaid watch transaction.
 type SucceededTransaction struct {
 	TransactionID string `json:"transaction_id"`
+	Reason        string `json:"reason,omitempty"`
 }
 
 // Decode decodes JSON data into a SucceededTransaction object.
@@ -22,3 +24,47 @@ type FailedTransaction struct {
 func (t *FailedTransaction) Decode(data []byte) error {
 	return json.Unmarshal(data, &t)
 }
+
+// AuthorizedTransaction represents a transaction whose value is held until it is captured or voided.
+type AuthorizedTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// Decode decodes JSON data into an AuthorizedTransaction object.
+func (t *AuthorizedTransaction) Decode(data []byte) error {
+	return json.Unmarshal(data, &t)
+}
+
+// VoidedTransaction represents a transaction whose hold was released back to the sender.
+type VoidedTransaction struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+// Decode decodes JSON data into a VoidedTransaction object.
+func (t *VoidedTransaction) Decode(data []byte) error {
+	return json.Unmarshal(data, &t)
+}
+
+const (
+	// StatusSucceeded is reported when the payment of the transaction went through.
+	StatusSucceeded = "succeeded"
+	// StatusFailed is reported when the payment of the transaction failed.
+	StatusFailed = "failed"
+	// StatusAuthorized is reported when the value of the transaction is held until it is captured or voided.
+	StatusAuthorized = "authorized"
+	// StatusVoided is reported when the hold of the transaction was released.
+	StatusVoided = "voided"
+)
+
+// StatusReportedTransaction represents the live payment status of a transaction
+// reported by the payment gateway in reply to a status request.
+type StatusReportedTransaction struct {
+	TransactionID string `json:"transaction_id"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// Decode decodes JSON data into a StatusReportedTransaction object.
+func (t *StatusReportedTransaction) Decode(data []byte) error {
+	return json.Unmarshal(data, &t)
+}
@@ -2,22 +2,33 @@ package subscriber
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	publisher_dto "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/subscriber/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/events"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
 	"github.com/ThreeDotsLabs/watermill/message"
 )
 
 // TransactionSubscriber represents a service that subscribes to transaction-related messages
-// and handles them based on their type (succeeded or failed).
+// and handles them based on their type (succeeded, failed, authorized, voided or reported status).
+// A failed capture returns the transaction to its hold instead of cancelling it.
+// Every status change it makes is published to the transaction event streams.
+// Outcomes of share payments settle the share of the group transaction,
+// a share paid after the group transaction was closed is refunded through the transaction publisher.
 type TransactionSubscriber struct {
-	cfg             *Config
-	log             logger.Logger
-	sub             message.Subscriber
-	router          *message.Router
-	transactionRepo repository.TransactionRepo
+	cfg                  *Config
+	log                  logger.Logger
+	sub                  message.Subscriber
+	router               *message.Router
+	transactionRepo      repository.TransactionRepo
+	transactionPublisher publisher.TransactionPublisher
+	eventPublisher       events.Publisher
 }
 
 // NewTransactionSubscriber creates a new TransactionSubscriber instance with the provided dependencies.
@@ -27,6 +38,8 @@ func NewTransactionSubscriber(
 	sub message.Subscriber,
 	router *message.Router,
 	transactionRepo repository.TransactionRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	eventPublisher events.Publisher,
 ) (*TransactionSubscriber, error) {
 	cfg, err := mergeWithDefault(cfg)
 	if err != nil {
@@ -34,11 +47,13 @@ func NewTransactionSubscriber(
 	}
 
 	return &TransactionSubscriber{
-		cfg:             cfg,
-		log:             log,
-		sub:             sub,
-		router:          router,
-		transactionRepo: transactionRepo,
+		cfg:                  cfg,
+		log:                  log,
+		sub:                  sub,
+		router:               router,
+		transactionRepo:      transactionRepo,
+		transactionPublisher: transactionPublisher,
+		eventPublisher:       eventPublisher,
 	}, nil
 }
 
@@ -70,6 +85,10 @@ func (s *TransactionSubscriber) handleSucceededTransaction(msg *message.Message)
 		"transaction_id": succeededTransaction.TransactionID,
 	})
 
+	if s.settleShare(ctx, succeededTransaction.TransactionID, true, "") {
+		return nil
+	}
+
 	if err := s.transactionRepo.ChangeTransactionStatus(ctx, succeededTransaction.TransactionID, model.Succeeded); err != nil {
 		s.log.Error("failed to change transaction status", map[string]interface{}{
 			"error":          err,
@@ -80,6 +99,8 @@ func (s *TransactionSubscriber) handleSucceededTransaction(msg *message.Message)
 		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
 	}
 
+	s.publishStatusEvent(ctx, succeededTransaction.TransactionID, model.Succeeded, succeededTransaction.Reason)
+
 	return nil
 }
 
@@ -111,6 +132,14 @@ func (s *TransactionSubscriber) handleFailedTransaction(msg *message.Message) er
 		"transaction_id": failedTransaction.TransactionID,
 	})
 
+	if s.settleShare(ctx, failedTransaction.TransactionID, false, failedTransaction.Reason) {
+		return nil
+	}
+
+	if s.releaseCapture(ctx, failedTransaction.TransactionID) {
+		return nil
+	}
+
 	if err := s.transactionRepo.CancelTransaction(ctx, failedTransaction.TransactionID, failedTransaction.Reason); err != nil {
 		s.log.Error("failed to cancel transaction", map[string]interface{}{
 			"error":          err,
@@ -121,9 +150,265 @@ func (s *TransactionSubscriber) handleFailedTransaction(msg *message.Message) er
 		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
 	}
 
+	s.publishStatusEvent(ctx, failedTransaction.TransactionID, model.Canceled, failedTransaction.Reason)
+
+	return nil
+}
+
+// RegisterStatusReportedTransactionHandler registers a handler for the payment statuses
+// the payment gateway reports in reply to status requests.
+func (s *TransactionSubscriber) RegisterStatusReportedTransactionHandler() {
+	s.log.Debug("Register status reported transaction handler", map[string]interface{}{})
+
+	s.router.AddNoPublisherHandler(
+		"status_reported_transaction",
+		s.cfg.StatusReportedTransactionTopic,
+		s.sub,
+		s.handleStatusReportedTransaction,
+	)
+}
+
+// handleStatusReportedTransaction settles the transaction when the reported status is terminal.
+// Other statuses leave the transaction to the supervisor deadline.
+func (s *TransactionSubscriber) handleStatusReportedTransaction(msg *message.Message) error {
+	ctx := context.Background()
+
+	reportedTransaction := &dto.StatusReportedTransaction{}
+	if err := reportedTransaction.Decode(msg.Payload); err != nil {
+		s.log.Error("failed to decode status reported transaction", map[string]interface{}{
+			"error": err,
+		})
+
+		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+	}
+
+	s.log.Debug("Start handle status reported transaction", map[string]interface{}{
+		"transaction_id": reportedTransaction.TransactionID,
+		"status":         reportedTransaction.Status,
+	})
+
+	switch reportedTransaction.Status {
+	case dto.StatusSucceeded:
+		if err := s.transactionRepo.ChangeTransactionStatus(ctx, reportedTransaction.TransactionID, model.Succeeded); err != nil {
+			s.log.Error("failed to change transaction status", map[string]interface{}{
+				"error":          err,
+				"status":         model.Succeeded,
+				"transaction_id": reportedTransaction.TransactionID,
+			})
+
+			return nil
+		}
+
+		s.publishStatusEvent(ctx, reportedTransaction.TransactionID, model.Succeeded, reportedTransaction.Reason)
+	case dto.StatusAuthorized:
+		s.authorizeTransaction(ctx, reportedTransaction.TransactionID)
+	case dto.StatusVoided:
+		s.voidTransaction(ctx, reportedTransaction.TransactionID)
+	case dto.StatusFailed:
+		if s.releaseCapture(ctx, reportedTransaction.TransactionID) {
+			return nil
+		}
+
+		if err := s.transactionRepo.CancelTransaction(ctx, reportedTransaction.TransactionID, reportedTransaction.Reason); err != nil {
+			s.log.Error("failed to cancel transaction", map[string]interface{}{
+				"error":          err,
+				"reason":         reportedTransaction.Reason,
+				"transaction_id": reportedTransaction.TransactionID,
+			})
+
+			return nil
+		}
+
+		s.publishStatusEvent(ctx, reportedTransaction.TransactionID, model.Canceled, reportedTransaction.Reason)
+	}
+
+	return nil
+}
+
+// RegisterAuthorizedTransactionHandler registers a handler for transactions whose value is held by the payment gateway.
+func (s *TransactionSubscriber) RegisterAuthorizedTransactionHandler() {
+	s.log.Debug("Register authorized transaction handler", map[string]interface{}{})
+
+	s.router.AddNoPublisherHandler(
+		"authorized_transaction",
+		s.cfg.AuthorizedTransactionTopic,
+		s.sub,
+		s.handleAuthorizedTransaction,
+	)
+}
+
+func (s *TransactionSubscriber) handleAuthorizedTransaction(msg *message.Message) error {
+	authorizedTransaction := &dto.AuthorizedTransaction{}
+	if err := authorizedTransaction.Decode(msg.Payload); err != nil {
+		s.log.Error("failed to decode authorized transaction", map[string]interface{}{
+			"error": err,
+		})
+
+		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+	}
+
+	s.log.Debug("Start handle authorized transaction", map[string]interface{}{
+		"transaction_id": authorizedTransaction.TransactionID,
+	})
+
+	s.authorizeTransaction(context.Background(), authorizedTransaction.TransactionID)
+
 	return nil
 }
 
+// RegisterVoidedTransactionHandler registers a handler for transactions whose hold was released by the payment gateway.
+func (s *TransactionSubscriber) RegisterVoidedTransactionHandler() {
+	s.log.Debug("Register voided transaction handler", map[string]interface{}{})
+
+	s.router.AddNoPublisherHandler(
+		"voided_transaction",
+		s.cfg.VoidedTransactionTopic,
+		s.sub,
+		s.handleVoidedTransaction,
+	)
+}
+
+func (s *TransactionSubscriber) handleVoidedTransaction(msg *message.Message) error {
+	voidedTransaction := &dto.VoidedTransaction{}
+	if err := voidedTransaction.Decode(msg.Payload); err != nil {
+		s.log.Error("failed to decode voided transaction", map[string]interface{}{
+			"error": err,
+		})
+
+		return nil //nolint:nilerr // it is necessary for a commit to occur and not to hang in a endless loop
+	}
+
+	s.log.Debug("Start handle voided transaction", map[string]interface{}{
+		"transaction_id": voidedTransaction.TransactionID,
+	})
+
+	s.voidTransaction(context.Background(), voidedTransaction.TransactionID)
+
+	return nil
+}
+
+// authorizeTransaction stores the hold of the transaction, it can be captured for HoldTimeout from now on.
+func (s *TransactionSubscriber) authorizeTransaction(ctx context.Context, transactionID string) {
+	authorizedUntil := time.Now().UTC().Add(s.cfg.HoldTimeout)
+
+	if err := s.transactionRepo.AuthorizeTransaction(ctx, transactionID, authorizedUntil); err != nil {
+		s.log.Error("failed to authorize transaction", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+
+		return
+	}
+
+	s.publishStatusEvent(ctx, transactionID, model.Authorized, "")
+}
+
+// voidTransaction stores that the hold of the transaction was released.
+func (s *TransactionSubscriber) voidTransaction(ctx context.Context, transactionID string) {
+	if err := s.transactionRepo.VoidTransaction(ctx, transactionID); err != nil {
+		s.log.Error("failed to void transaction", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+
+		return
+	}
+
+	s.publishStatusEvent(ctx, transactionID, model.Voided, "")
+}
+
+// releaseCapture returns a transaction whose capture failed to its hold.
+// It reports false when the transaction was not being captured, the failure then cancels it.
+// A failed release is only logged and reported as true, so a held transaction is never cancelled with its hold.
+func (s *TransactionSubscriber) releaseCapture(ctx context.Context, transactionID string) bool {
+	released, err := s.transactionRepo.ReleaseCapture(ctx, transactionID)
+	if err != nil {
+		s.log.Error("failed to release transaction capture", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+
+		return true
+	}
+
+	if released {
+		s.publishStatusEvent(ctx, transactionID, model.Authorized, "")
+	}
+
+	return released
+}
+
+// settleShare applies the payment outcome to the share of a group transaction paid or refunded under paymentID.
+// It reports false when paymentID belongs to no share, the outcome is then the one of a transaction.
+func (s *TransactionSubscriber) settleShare(ctx context.Context, paymentID string, succeeded bool, reason string) bool {
+	settlement, err := s.transactionRepo.SettleShare(ctx, paymentID, succeeded, reason)
+	if errors.Is(err, repository.ErrShareNotFound) {
+		return false
+	}
+
+	if err != nil {
+		s.log.Error("failed to settle transaction share", map[string]interface{}{
+			"error":      err,
+			"payment_id": paymentID,
+			"succeeded":  succeeded,
+		})
+
+		return true
+	}
+
+	if settlement.Completed {
+		s.publishStatusEvent(ctx, settlement.Share.TransactionID, model.Succeeded, "")
+	}
+
+	if settlement.Refund {
+		if err := s.refundShare(ctx, settlement.Share); err != nil {
+			s.log.Error("failed to refund transaction share", map[string]interface{}{
+				"error":          err,
+				"share_id":       settlement.Share.ID,
+				"transaction_id": settlement.Share.TransactionID,
+			})
+		}
+	}
+
+	return true
+}
+
+// refundShare hands the payment paying the share back to its payer over to the payment gateway.
+func (s *TransactionSubscriber) refundShare(ctx context.Context, share *model.TransactionShare) error {
+	transaction, err := s.transactionRepo.GetTransaction(ctx, share.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	storedShare := transaction.Share(share.ID)
+	if storedShare == nil {
+		return repository.ErrShareNotFound
+	}
+
+	refund, err := publisher_dto.FromShareRefund(transaction, storedShare)
+	if err != nil {
+		return err
+	}
+
+	return s.transactionPublisher.PublishProcessedTransaction(refund)
+}
+
+// publishStatusEvent announces the status change to the event streams of the transaction.
+// A failed publish is only logged, the status itself is already stored.
+func (s *TransactionSubscriber) publishStatusEvent(ctx context.Context, transactionID string, status model.TransactionStatus, reason string) {
+	if err := s.eventPublisher.Publish(ctx, &model.TransactionStatusEvent{
+		TransactionID: transactionID,
+		Status:        status,
+		Reason:        reason,
+	}); err != nil {
+		s.log.Error("failed to publish transaction status event", map[string]interface{}{
+			"error":          err,
+			"status":         status,
+			"transaction_id": transactionID,
+		})
+	}
+}
+
 // Run starts the transaction subscriber's router.
 func (s *TransactionSubscriber) Run(ctx context.Context) error {
 	s.log.Debug("Run transaction subscriber", map[string]interface{}{})
@@ -4,12 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/ShmelJUJ/software-engineering/pkg/kafka"
 	mock_subscriber "github.com/ShmelJUJ/software-engineering/pkg/kafka/mocks"
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
 	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	publisher_dto "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/subscriber/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/events"
+	mock_events "github.com/ShmelJUJ/software-engineering/transaction/internal/events/mocks"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
 	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
@@ -24,7 +30,15 @@ const (
 	testReason        = "test-reason"
 )
 
-func transactionSubscriberHelper(t *testing.T) (*mock_logger.MockLogger, *mock_subscriber.MockSubscriber, *mock_repo.MockTransactionRepo) {
+var shareNotFoundErr = repository.NewSettleShareError("test-err", repository.ErrShareNotFound)
+
+func transactionSubscriberHelper(t *testing.T) (
+	*mock_logger.MockLogger,
+	*mock_subscriber.MockSubscriber,
+	*mock_repo.MockTransactionRepo,
+	*mock_events.MockPublisher,
+	*mock_publisher.MockTransactionPublisher,
+) {
 	t.Helper()
 
 	mockCtrl := gomock.NewController(t)
@@ -33,22 +47,26 @@ func transactionSubscriberHelper(t *testing.T) (*mock_logger.MockLogger, *mock_s
 	l := mock_logger.NewMockLogger(mockCtrl)
 	sub := mock_subscriber.NewMockSubscriber(mockCtrl)
 	repo := mock_repo.NewMockTransactionRepo(mockCtrl)
+	eventPublisher := mock_events.NewMockPublisher(mockCtrl)
+	transactionPublisher := mock_publisher.NewMockTransactionPublisher(mockCtrl)
 
-	return l, sub, repo
+	return l, sub, repo, eventPublisher, transactionPublisher
 }
 
 func TestNewTransactionPublisher(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		cfg             *Config
-		log             logger.Logger
-		sub             message.Subscriber
-		router          *message.Router
-		transactionRepo repository.TransactionRepo
+		cfg                  *Config
+		log                  logger.Logger
+		sub                  message.Subscriber
+		router               *message.Router
+		transactionRepo      repository.TransactionRepo
+		transactionPublisher publisher.TransactionPublisher
+		eventPublisher       events.Publisher
 	}
 
-	log, sub, repo := transactionSubscriberHelper(t)
+	log, sub, repo, eventPublisher, transactionPublisher := transactionSubscriberHelper(t)
 
 	router, err := kafka.NewBrokerRouter()
 	assert.NoError(t, err)
@@ -62,18 +80,22 @@ func TestNewTransactionPublisher(t *testing.T) {
 		{
 			name: "Successfully create new transaction subscriber",
 			args: args{
-				cfg:             &Config{},
-				log:             log,
-				sub:             sub,
-				router:          router,
-				transactionRepo: repo,
+				cfg:                  &Config{},
+				log:                  log,
+				sub:                  sub,
+				router:               router,
+				transactionRepo:      repo,
+				transactionPublisher: transactionPublisher,
+				eventPublisher:       eventPublisher,
 			},
 			expectedTransactionSubscriber: &TransactionSubscriber{
-				cfg:             getDefaultConfig(),
-				log:             log,
-				sub:             sub,
-				router:          router,
-				transactionRepo: repo,
+				cfg:                  getDefaultConfig(),
+				log:                  log,
+				sub:                  sub,
+				router:               router,
+				transactionRepo:      repo,
+				transactionPublisher: transactionPublisher,
+				eventPublisher:       eventPublisher,
 			},
 		},
 	}
@@ -90,6 +112,8 @@ func TestNewTransactionPublisher(t *testing.T) {
 				testcase.args.sub,
 				testcase.args.router,
 				testcase.args.transactionRepo,
+				testcase.args.transactionPublisher,
+				testcase.args.eventPublisher,
 			)
 
 			assert.Equal(t, testcase.expectedTransactionSubscriber, actualTransactionSubscriber)
@@ -117,12 +141,20 @@ func TestHandleSucceededTransaction(t *testing.T) {
 	succeededTransactionData, err := json.Marshal(succeededTransaction)
 	assert.NoError(t, err)
 
+	overpaidReason := "overpaid by 0.5 ALGO, the receiver refunds the excess"
+
+	overpaidTransactionData, err := json.Marshal(&dto.SucceededTransaction{
+		TransactionID: testTransactionID,
+		Reason:        overpaidReason,
+	})
+	assert.NoError(t, err)
+
 	someErr := repository.NewChangeTransactionStatusError("test-err", nil)
 
 	testcases := []struct {
 		name        string
 		args        args
-		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_events.MockPublisher)
 		expectedErr error
 	}{
 		{
@@ -130,11 +162,35 @@ func TestHandleSucceededTransaction(t *testing.T) {
 			args: args{
 				msg: message.NewMessage(watermill.NewUUID(), succeededTransactionData),
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
 				ml.EXPECT().Debug("Start handle succeeded transaction", map[string]interface{}{
 					"transaction_id": succeededTransaction.TransactionID,
 				})
+				mtr.EXPECT().SettleShare(ctx, succeededTransaction.TransactionID, true, "").Return(nil, shareNotFoundErr).Times(1)
 				mtr.EXPECT().ChangeTransactionStatus(ctx, succeededTransaction.TransactionID, model.Succeeded).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: succeededTransaction.TransactionID,
+					Status:        model.Succeeded,
+				}).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Successfully handle overpaid transaction",
+			args: args{
+				msg: message.NewMessage(watermill.NewUUID(), overpaidTransactionData),
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle succeeded transaction", map[string]interface{}{
+					"transaction_id": testTransactionID,
+				})
+				mtr.EXPECT().SettleShare(ctx, testTransactionID, true, "").Return(nil, shareNotFoundErr).Times(1)
+				mtr.EXPECT().ChangeTransactionStatus(ctx, testTransactionID, model.Succeeded).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Succeeded,
+					Reason:        overpaidReason,
+				}).Return(nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -143,10 +199,11 @@ func TestHandleSucceededTransaction(t *testing.T) {
 			args: args{
 				msg: message.NewMessage(watermill.NewUUID(), succeededTransactionData),
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
 				ml.EXPECT().Debug("Start handle succeeded transaction", map[string]interface{}{
 					"transaction_id": succeededTransaction.TransactionID,
 				})
+				mtr.EXPECT().SettleShare(ctx, succeededTransaction.TransactionID, true, "").Return(nil, shareNotFoundErr).Times(1)
 				mtr.EXPECT().ChangeTransactionStatus(ctx, succeededTransaction.TransactionID, model.Succeeded).Return(someErr).Times(1)
 				ml.EXPECT().Error("failed to change transaction status", map[string]interface{}{
 					"error":          someErr,
@@ -164,9 +221,9 @@ func TestHandleSucceededTransaction(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			log, sub, repo := transactionSubscriberHelper(t)
+			log, sub, repo, eventPublisher, transactionPublisher := transactionSubscriberHelper(t)
 
-			testcase.mock(log, repo)
+			testcase.mock(log, repo, eventPublisher)
 
 			transactionSubscriber, err := NewTransactionSubscriber(
 				&Config{},
@@ -174,6 +231,8 @@ func TestHandleSucceededTransaction(t *testing.T) {
 				sub,
 				router,
 				repo,
+				transactionPublisher,
+				eventPublisher,
 			)
 			assert.NoError(t, err)
 
@@ -208,7 +267,7 @@ func TestHandleFailedTransaction(t *testing.T) {
 	testcases := []struct {
 		name        string
 		args        args
-		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_events.MockPublisher)
 		expectedErr error
 	}{
 		{
@@ -216,11 +275,36 @@ func TestHandleFailedTransaction(t *testing.T) {
 			args: args{
 				msg: message.NewMessage(watermill.NewUUID(), failedTransactionData),
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
 				ml.EXPECT().Debug("Start handle failed transaction", map[string]interface{}{
 					"transaction_id": failedTransaction.TransactionID,
 				})
+				mtr.EXPECT().SettleShare(ctx, failedTransaction.TransactionID, false, failedTransaction.Reason).Return(nil, shareNotFoundErr).Times(1)
+				mtr.EXPECT().ReleaseCapture(ctx, failedTransaction.TransactionID).Return(false, nil).Times(1)
 				mtr.EXPECT().CancelTransaction(ctx, failedTransaction.TransactionID, failedTransaction.Reason).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: failedTransaction.TransactionID,
+					Status:        model.Canceled,
+					Reason:        failedTransaction.Reason,
+				}).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed capture returns transaction to its hold",
+			args: args{
+				msg: message.NewMessage(watermill.NewUUID(), failedTransactionData),
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle failed transaction", map[string]interface{}{
+					"transaction_id": failedTransaction.TransactionID,
+				})
+				mtr.EXPECT().SettleShare(ctx, failedTransaction.TransactionID, false, failedTransaction.Reason).Return(nil, shareNotFoundErr).Times(1)
+				mtr.EXPECT().ReleaseCapture(ctx, failedTransaction.TransactionID).Return(true, nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: failedTransaction.TransactionID,
+					Status:        model.Authorized,
+				}).Return(nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -229,10 +313,12 @@ func TestHandleFailedTransaction(t *testing.T) {
 			args: args{
 				msg: message.NewMessage(watermill.NewUUID(), failedTransactionData),
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
 				ml.EXPECT().Debug("Start handle failed transaction", map[string]interface{}{
 					"transaction_id": failedTransaction.TransactionID,
 				})
+				mtr.EXPECT().SettleShare(ctx, failedTransaction.TransactionID, false, failedTransaction.Reason).Return(nil, shareNotFoundErr).Times(1)
+				mtr.EXPECT().ReleaseCapture(ctx, failedTransaction.TransactionID).Return(false, nil).Times(1)
 				mtr.EXPECT().CancelTransaction(ctx, failedTransaction.TransactionID, failedTransaction.Reason).Return(someErr).Times(1)
 				ml.EXPECT().Error("failed to cancel transaction", map[string]interface{}{
 					"error":          someErr,
@@ -250,9 +336,9 @@ func TestHandleFailedTransaction(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			log, sub, repo := transactionSubscriberHelper(t)
+			log, sub, repo, eventPublisher, transactionPublisher := transactionSubscriberHelper(t)
 
-			testcase.mock(log, repo)
+			testcase.mock(log, repo, eventPublisher)
 
 			transactionSubscriber, err := NewTransactionSubscriber(
 				&Config{},
@@ -260,6 +346,8 @@ func TestHandleFailedTransaction(t *testing.T) {
 				sub,
 				router,
 				repo,
+				transactionPublisher,
+				eventPublisher,
 			)
 			assert.NoError(t, err)
 
@@ -268,3 +356,389 @@ func TestHandleFailedTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleStatusReportedTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	router, err := kafka.NewBrokerRouter()
+	assert.NoError(t, err)
+
+	encode := func(status, reason string) *message.Message {
+		data, err := json.Marshal(&dto.StatusReportedTransaction{
+			TransactionID: testTransactionID,
+			Status:        status,
+			Reason:        reason,
+		})
+		assert.NoError(t, err)
+
+		return message.NewMessage(watermill.NewUUID(), data)
+	}
+
+	someErr := repository.NewChangeTransactionStatusError("test-err", nil)
+	publishErr := events.NewPublishError("test-err", nil)
+
+	testcases := []struct {
+		name        string
+		msg         *message.Message
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_events.MockPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Succeeded status settles transaction",
+			msg:  encode(dto.StatusSucceeded, ""),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle status reported transaction", gomock.Any())
+				mtr.EXPECT().ChangeTransactionStatus(ctx, testTransactionID, model.Succeeded).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Succeeded,
+				}).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Failed status cancels transaction",
+			msg:  encode(dto.StatusFailed, testReason),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle status reported transaction", gomock.Any())
+				mtr.EXPECT().ReleaseCapture(ctx, testTransactionID).Return(false, nil).Times(1)
+				mtr.EXPECT().CancelTransaction(ctx, testTransactionID, testReason).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Canceled,
+					Reason:        testReason,
+				}).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Authorized status holds transaction",
+			msg:  encode(dto.StatusAuthorized, ""),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle status reported transaction", gomock.Any())
+				mtr.EXPECT().AuthorizeTransaction(ctx, testTransactionID, gomock.Any()).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Authorized,
+				}).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Processing status leaves transaction",
+			msg:  encode("processing", ""),
+			mock: func(ml *mock_logger.MockLogger, _ *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle status reported transaction", gomock.Any())
+			},
+		},
+		{
+			name: "Failed to settle transaction",
+			msg:  encode(dto.StatusSucceeded, ""),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle status reported transaction", gomock.Any())
+				mtr.EXPECT().ChangeTransactionStatus(ctx, testTransactionID, model.Succeeded).Return(someErr).Times(1)
+				ml.EXPECT().Error("failed to change transaction status", map[string]interface{}{
+					"error":          someErr,
+					"status":         model.Succeeded,
+					"transaction_id": testTransactionID,
+				})
+			},
+		},
+		{
+			name: "Failed to publish status event",
+			msg:  encode(dto.StatusSucceeded, ""),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle status reported transaction", gomock.Any())
+				mtr.EXPECT().ChangeTransactionStatus(ctx, testTransactionID, model.Succeeded).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, gomock.Any()).Return(publishErr).Times(1)
+				ml.EXPECT().Error("failed to publish transaction status event", map[string]interface{}{
+					"error":          publishErr,
+					"status":         model.Succeeded,
+					"transaction_id": testTransactionID,
+				})
+			},
+		},
+		{
+			name: "Invalid payload",
+			msg:  message.NewMessage(watermill.NewUUID(), []byte("not json")),
+			mock: func(ml *mock_logger.MockLogger, _ *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher) {
+				ml.EXPECT().Error("failed to decode status reported transaction", gomock.Any())
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, sub, repo, eventPublisher, transactionPublisher := transactionSubscriberHelper(t)
+
+			testcase.mock(log, repo, eventPublisher)
+
+			transactionSubscriber, err := NewTransactionSubscriber(
+				&Config{},
+				log,
+				sub,
+				router,
+				repo,
+				transactionPublisher,
+				eventPublisher,
+			)
+			assert.NoError(t, err)
+
+			err = transactionSubscriber.handleStatusReportedTransaction(testcase.msg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestHandleShareOutcome(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	router, err := kafka.NewBrokerRouter()
+	assert.NoError(t, err)
+
+	const shareID = "test-share-id"
+
+	succeededData, err := json.Marshal(&dto.SucceededTransaction{
+		TransactionID: shareID,
+	})
+	assert.NoError(t, err)
+
+	failedData, err := json.Marshal(&dto.FailedTransaction{
+		TransactionID: shareID,
+		Reason:        testReason,
+	})
+	assert.NoError(t, err)
+
+	payer := &model.TransactionUser{
+		UserID:   "test-payer-user",
+		WalletID: "test-payer-wallet",
+	}
+	share := &model.TransactionShare{
+		ID:            shareID,
+		TransactionID: testTransactionID,
+		Amount:        600,
+		Status:        model.ShareRefunding,
+		RefundID:      "test-refund-id",
+		Payer:         payer,
+	}
+	transaction := &model.Transaction{
+		ID:       testTransactionID,
+		Currency: "ALGO",
+		Amount:   1000,
+		Method:   "algorand",
+		Receiver: &model.TransactionUser{
+			UserID:   "test-receiver-user",
+			WalletID: "test-receiver-wallet",
+		},
+		Shares: []*model.TransactionShare{share},
+	}
+
+	refund, err := publisher_dto.FromShareRefund(transaction, share)
+	assert.NoError(t, err)
+
+	settleErr := repository.NewSettleShareError("test-err", nil)
+
+	testcases := []struct {
+		name    string
+		msg     *message.Message
+		succeed bool
+		mock    func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_events.MockPublisher, *mock_publisher.MockTransactionPublisher)
+	}{
+		{
+			name:    "Last paid share completes transaction",
+			msg:     message.NewMessage(watermill.NewUUID(), succeededData),
+			succeed: true,
+			mock: func(_ *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher, _ *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().SettleShare(ctx, shareID, true, "").Return(&model.ShareSettlement{
+					Share:     &model.TransactionShare{ID: shareID, TransactionID: testTransactionID, Status: model.ShareSucceeded},
+					Completed: true,
+				}, nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Succeeded,
+				}).Return(nil).Times(1)
+			},
+		},
+		{
+			name:    "Share paid after deadline is refunded",
+			msg:     message.NewMessage(watermill.NewUUID(), succeededData),
+			succeed: true,
+			mock: func(_ *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher, mtp *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().SettleShare(ctx, shareID, true, "").Return(&model.ShareSettlement{
+					Share:  &model.TransactionShare{ID: shareID, TransactionID: testTransactionID, Status: model.ShareRefunding},
+					Refund: true,
+				}, nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, testTransactionID).Return(transaction, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(refund).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Failed share payment frees share",
+			msg:  message.NewMessage(watermill.NewUUID(), failedData),
+			mock: func(_ *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher, _ *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().SettleShare(ctx, shareID, false, testReason).Return(&model.ShareSettlement{
+					Share: &model.TransactionShare{ID: shareID, TransactionID: testTransactionID, Status: model.SharePending},
+				}, nil).Times(1)
+			},
+		},
+		{
+			name:    "Failed to settle share",
+			msg:     message.NewMessage(watermill.NewUUID(), succeededData),
+			succeed: true,
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher, _ *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().SettleShare(ctx, shareID, true, "").Return(nil, settleErr).Times(1)
+				ml.EXPECT().Error("failed to settle transaction share", map[string]interface{}{
+					"error":      settleErr,
+					"payment_id": shareID,
+					"succeeded":  true,
+				})
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, sub, repo, eventPublisher, transactionPublisher := transactionSubscriberHelper(t)
+
+			log.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+			testcase.mock(log, repo, eventPublisher, transactionPublisher)
+
+			transactionSubscriber, err := NewTransactionSubscriber(
+				&Config{},
+				log,
+				sub,
+				router,
+				repo,
+				transactionPublisher,
+				eventPublisher,
+			)
+			assert.NoError(t, err)
+
+			if testcase.succeed {
+				err = transactionSubscriber.handleSucceededTransaction(testcase.msg)
+			} else {
+				err = transactionSubscriber.handleFailedTransaction(testcase.msg)
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestHandleHoldTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	router, err := kafka.NewBrokerRouter()
+	assert.NoError(t, err)
+
+	authorizedData, err := json.Marshal(&dto.AuthorizedTransaction{
+		TransactionID: testTransactionID,
+	})
+	assert.NoError(t, err)
+
+	voidedData, err := json.Marshal(&dto.VoidedTransaction{
+		TransactionID: testTransactionID,
+	})
+	assert.NoError(t, err)
+
+	authorizeErr := repository.NewAuthorizeTransactionError("test-err", repository.ErrTransactionNotProcessed)
+
+	testcases := []struct {
+		name   string
+		voided bool
+		msg    *message.Message
+		mock   func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_events.MockPublisher)
+	}{
+		{
+			name: "Authorized transaction is held until the hold timeout",
+			msg:  message.NewMessage(watermill.NewUUID(), authorizedData),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle authorized transaction", gomock.Any())
+				mtr.EXPECT().AuthorizeTransaction(ctx, testTransactionID, gomock.Cond(func(x any) bool {
+					authorizedUntil, ok := x.(time.Time)
+
+					return ok && authorizedUntil.After(time.Now().Add(defaultHoldTimeout-time.Minute))
+				})).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Authorized,
+				}).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Failed to authorize settled transaction",
+			msg:  message.NewMessage(watermill.NewUUID(), authorizedData),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle authorized transaction", gomock.Any())
+				mtr.EXPECT().AuthorizeTransaction(ctx, testTransactionID, gomock.Any()).Return(authorizeErr).Times(1)
+				ml.EXPECT().Error("failed to authorize transaction", map[string]interface{}{
+					"error":          authorizeErr,
+					"transaction_id": testTransactionID,
+				})
+			},
+		},
+		{
+			name:   "Voided transaction",
+			voided: true,
+			msg:    message.NewMessage(watermill.NewUUID(), voidedData),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mep *mock_events.MockPublisher) {
+				ml.EXPECT().Debug("Start handle voided transaction", gomock.Any())
+				mtr.EXPECT().VoidTransaction(ctx, testTransactionID).Return(nil).Times(1)
+				mep.EXPECT().Publish(ctx, &model.TransactionStatusEvent{
+					TransactionID: testTransactionID,
+					Status:        model.Voided,
+				}).Return(nil).Times(1)
+			},
+		},
+		{
+			name:   "Invalid voided payload",
+			voided: true,
+			msg:    message.NewMessage(watermill.NewUUID(), []byte("not json")),
+			mock: func(ml *mock_logger.MockLogger, _ *mock_repo.MockTransactionRepo, _ *mock_events.MockPublisher) {
+				ml.EXPECT().Error("failed to decode voided transaction", gomock.Any())
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			log, sub, repo, eventPublisher, transactionPublisher := transactionSubscriberHelper(t)
+
+			testcase.mock(log, repo, eventPublisher)
+
+			transactionSubscriber, err := NewTransactionSubscriber(
+				&Config{},
+				log,
+				sub,
+				router,
+				repo,
+				transactionPublisher,
+				eventPublisher,
+			)
+			assert.NoError(t, err)
+
+			if testcase.voided {
+				err = transactionSubscriber.handleVoidedTransaction(testcase.msg)
+			} else {
+				err = transactionSubscriber.handleAuthorizedTransaction(testcase.msg)
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
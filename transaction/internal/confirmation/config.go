@@ -0,0 +1,58 @@
+package confirmation
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultCodeTTL     = 5 * time.Minute
+	defaultMaxAttempts = 3
+)
+
+// Config represents the payer confirmation configuration structure.
+type Config struct {
+	// Thresholds are the decimal amounts in the major unit keyed by the currency code,
+	// starting from which an accept must be confirmed by the payer.
+	// An accept in a currency without a threshold is always confirmed.
+	Thresholds  map[string]string
+	CodeTTL     time.Duration
+	MaxAttempts int
+}
+
+func getDefaultThresholds() map[string]string {
+	return map[string]string{
+		"ALGO": "100",
+		"RUB":  "10000",
+		"USD":  "100",
+		"EUR":  "100",
+		"KZT":  "50000",
+	}
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		Thresholds:  getDefaultThresholds(),
+		CodeTTL:     defaultCodeTTL,
+		MaxAttempts: defaultMaxAttempts,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
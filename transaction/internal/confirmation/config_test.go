@@ -0,0 +1,67 @@
+package confirmation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				Thresholds: map[string]string{"ALGO": "5", "GBP": "50"},
+				CodeTTL:    time.Minute,
+			},
+			expectedCfg: &Config{
+				Thresholds: map[string]string{
+					"ALGO": "5",
+					"RUB":  "10000",
+					"USD":  "100",
+					"EUR":  "100",
+					"KZT":  "50000",
+					"GBP":  "50",
+				},
+				CodeTTL:     time.Minute,
+				MaxAttempts: defaultMaxAttempts,
+			},
+		},
+		{
+			name: "With empty config",
+			cfg:  &Config{},
+			expectedCfg: &Config{
+				Thresholds:  getDefaultThresholds(),
+				CodeTTL:     defaultCodeTTL,
+				MaxAttempts: defaultMaxAttempts,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
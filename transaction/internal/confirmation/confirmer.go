@@ -0,0 +1,226 @@
+package confirmation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+)
+
+//go:generate mockgen -package mocks -destination mocks/confirmer_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation Confirmer
+
+const (
+	codeDigits = 6
+
+	// transactionHashDomain separates transaction hashes from any other payload signed by the wallet key.
+	transactionHashDomain = "qr-payment/transaction/v1"
+)
+
+// Confirmer issues and verifies payer confirmations for high-value accepts.
+type Confirmer interface {
+	Required(transaction *model.Transaction) bool
+	Issue(transaction *model.Transaction, sender *model.TransactionUser, method model.ConfirmationMethod) (*model.Confirmation, string, error)
+	Notify(ctx context.Context, confirmation *model.Confirmation, sender *model.TransactionUser, code string) error
+	Verify(ctx context.Context, confirmation *model.Confirmation, transaction *model.Transaction, code, signature string) error
+}
+
+type confirmer struct {
+	cfg        *Config
+	thresholds map[string]int64
+	notifier   Notifier
+	keyFetcher WalletKeyFetcher
+	clock      clock.Clock
+}
+
+// NewConfirmer creates a new instance of Confirmer.
+func NewConfirmer(
+	cfg *Config,
+	notifier Notifier,
+	keyFetcher WalletKeyFetcher,
+	clk clock.Clock,
+) (Confirmer, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	thresholds := make(map[string]int64, len(cfg.Thresholds))
+	for code, amount := range cfg.Thresholds {
+		threshold, err := money.Parse(amount, code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid confirmation threshold: %w", err)
+		}
+
+		thresholds[threshold.Currency().Code] = threshold.Amount()
+	}
+
+	return &confirmer{
+		cfg:        cfg,
+		thresholds: thresholds,
+		notifier:   notifier,
+		keyFetcher: keyFetcher,
+		clock:      clk,
+	}, nil
+}
+
+// Required reports whether accepting the transaction needs a payer confirmation.
+// The amount is compared with the threshold of the transaction currency in its minor unit.
+func (c *confirmer) Required(transaction *model.Transaction) bool {
+	threshold, ok := c.thresholds[transaction.Currency]
+	if !ok {
+		return true
+	}
+
+	return transaction.Amount >= threshold
+}
+
+// Issue creates a pending confirmation for the transaction accepted by sender.
+// For the code method the returned code must be delivered with Notify, only its hash is kept.
+func (c *confirmer) Issue(
+	transaction *model.Transaction,
+	sender *model.TransactionUser,
+	method model.ConfirmationMethod,
+) (*model.Confirmation, string, error) {
+	now := c.clock.NowUTC()
+
+	confirmation := &model.Confirmation{
+		TransactionID:   transaction.ID,
+		Method:          method,
+		TransactionHash: TransactionHash(transaction, sender),
+		ExpiresAt:       now.Add(c.cfg.CodeTTL),
+		CreatedAt:       now,
+	}
+
+	switch method {
+	case model.CodeConfirmation:
+		code, err := generateCode()
+		if err != nil {
+			return nil, "", NewIssueConfirmationError("failed to generate confirmation code", err)
+		}
+
+		confirmation.CodeHash = hashCode(code)
+
+		return confirmation, code, nil
+	case model.SignatureConfirmation:
+		return confirmation, "", nil
+	default:
+		return nil, "", NewIssueConfirmationError("failed to issue confirmation", fmt.Errorf("unknown method %q", method))
+	}
+}
+
+// Notify delivers the confirmation code to the payer.
+func (c *confirmer) Notify(ctx context.Context, confirmation *model.Confirmation, sender *model.TransactionUser, code string) error {
+	if err := c.notifier.Notify(ctx, &Notification{
+		TransactionID: confirmation.TransactionID,
+		UserID:        sender.UserID,
+		Code:          code,
+		ExpiresAt:     confirmation.ExpiresAt,
+	}); err != nil {
+		return NewIssueConfirmationError("failed to notify payer", err)
+	}
+
+	return nil
+}
+
+// Verify checks the code or the base64 encoded wallet signature against the pending confirmation.
+// The transaction is hashed again so that edits made after the accept invalidate the confirmation.
+func (c *confirmer) Verify(
+	ctx context.Context,
+	confirmation *model.Confirmation,
+	transaction *model.Transaction,
+	code, signature string,
+) error {
+	if !c.clock.NowUTC().Before(confirmation.ExpiresAt) {
+		return newExpiredAtError(confirmation.ExpiresAt)
+	}
+
+	if confirmation.Attempts >= c.cfg.MaxAttempts {
+		return NewConfirmationExpiredError("confirmation attempts exhausted")
+	}
+
+	if TransactionHash(transaction, transaction.Sender) != confirmation.TransactionHash {
+		return NewConfirmationExpiredError("transaction changed after accept")
+	}
+
+	var valid bool
+
+	switch confirmation.Method {
+	case model.CodeConfirmation:
+		valid = subtle.ConstantTimeCompare([]byte(hashCode(code)), []byte(confirmation.CodeHash)) == 1
+	case model.SignatureConfirmation:
+		publicKey, err := c.keyFetcher.FetchPublicKey(ctx, transaction.Sender)
+		if err != nil {
+			return NewVerifyConfirmationError("failed to fetch payer public key", err)
+		}
+
+		valid = verifySignature(publicKey, confirmation.TransactionHash, signature)
+	default:
+		return NewVerifyConfirmationError("failed to verify confirmation", fmt.Errorf("unknown method %q", confirmation.Method))
+	}
+
+	if !valid {
+		return NewInvalidConfirmationError(c.cfg.MaxAttempts - confirmation.Attempts - 1)
+	}
+
+	return nil
+}
+
+// TransactionHash returns the hex encoded sha256 of the transaction fields the payer agrees to.
+func TransactionHash(transaction *model.Transaction, sender *model.TransactionUser) string {
+	fields := []string{
+		transactionHashDomain,
+		transaction.ID,
+		transaction.Receiver.UserID,
+		transaction.Receiver.WalletID,
+		sender.UserID,
+		sender.WalletID,
+		transaction.Currency,
+		strconv.FormatInt(transaction.Amount, 10),
+		transaction.Method,
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\n")))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// verifySignature checks an Algorand SignBytes signature over the raw transaction hash.
+func verifySignature(publicKey []byte, transactionHash, signature string) bool {
+	message, err := hex.DecodeString(transactionHash)
+	if err != nil {
+		return false
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return crypto.VerifyBytes(publicKey, message, sig)
+}
+
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", codeDigits, n.Int64()), nil
+}
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(code)))
+
+	return hex.EncodeToString(sum[:])
+}
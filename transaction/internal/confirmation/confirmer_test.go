@@ -0,0 +1,300 @@
+package confirmation_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/algorand/go-algorand-sdk/v2/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testCodeTTL     = 5 * time.Minute
+	testMaxAttempts = 3
+)
+
+var testNow = time.Unix(1000, 0).UTC()
+
+func hashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func testTransaction() *model.Transaction {
+	return &model.Transaction{
+		ID:       "test-transaction",
+		Currency: "ALGO",
+		Amount:   150000,
+		Method:   "algorand",
+		Sender: &model.TransactionUser{
+			UserID:   "test-sender",
+			WalletID: "test-sender-wallet",
+		},
+		Receiver: &model.TransactionUser{
+			UserID:   "test-receiver",
+			WalletID: "test-receiver-wallet",
+		},
+	}
+}
+
+func confirmerHelper(t *testing.T) (confirmation.Confirmer, *mocks.MockNotifier, *mocks.MockWalletKeyFetcher) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	notifier := mocks.NewMockNotifier(mockCtrl)
+	keyFetcher := mocks.NewMockWalletKeyFetcher(mockCtrl)
+
+	c, err := confirmation.NewConfirmer(&confirmation.Config{
+		Thresholds:  map[string]string{"ALGO": "0.1", "RUB": "5000"},
+		CodeTTL:     testCodeTTL,
+		MaxAttempts: testMaxAttempts,
+	}, notifier, keyFetcher, clk)
+	require.NoError(t, err)
+
+	return c, notifier, keyFetcher
+}
+
+func TestRequired(t *testing.T) {
+	t.Parallel()
+
+	c, _, _ := confirmerHelper(t)
+
+	testcases := []struct {
+		name        string
+		transaction *model.Transaction
+		expected    bool
+	}{
+		{
+			name:        "ALGO below threshold",
+			transaction: &model.Transaction{Currency: "ALGO", Amount: 99999},
+			expected:    false,
+		},
+		{
+			name:        "ALGO at threshold",
+			transaction: &model.Transaction{Currency: "ALGO", Amount: 100000},
+			expected:    true,
+		},
+		{
+			name:        "Same amount in RUB is below its threshold",
+			transaction: &model.Transaction{Currency: "RUB", Amount: 100000},
+			expected:    false,
+		},
+		{
+			name:        "RUB at threshold",
+			transaction: &model.Transaction{Currency: "RUB", Amount: 500000},
+			expected:    true,
+		},
+		{
+			name:        "Currency without threshold",
+			transaction: &model.Transaction{Currency: "GBP", Amount: 1},
+			expected:    true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, c.Required(testcase.transaction))
+		})
+	}
+}
+
+func TestNewConfirmerInvalidThreshold(t *testing.T) {
+	t.Parallel()
+
+	for _, thresholds := range []map[string]string{
+		{"XXX": "100"},
+		{"RUB": "test-amount"},
+		{"RUB": "0.001"},
+	} {
+		_, err := confirmation.NewConfirmer(&confirmation.Config{Thresholds: thresholds}, nil, nil, nil)
+		assert.Error(t, err)
+	}
+}
+
+func TestIssueAndNotify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	transaction := testTransaction()
+
+	c, notifier, _ := confirmerHelper(t)
+
+	pendingConfirmation, code, err := c.Issue(transaction, transaction.Sender, model.CodeConfirmation)
+	require.NoError(t, err)
+
+	assert.Len(t, code, 6)
+	assert.Equal(t, hashCode(code), pendingConfirmation.CodeHash)
+	assert.Equal(t, confirmation.TransactionHash(transaction, transaction.Sender), pendingConfirmation.TransactionHash)
+	assert.Equal(t, testNow.Add(testCodeTTL), pendingConfirmation.ExpiresAt)
+
+	notifier.EXPECT().Notify(ctx, &confirmation.Notification{
+		TransactionID: transaction.ID,
+		UserID:        transaction.Sender.UserID,
+		Code:          code,
+		ExpiresAt:     pendingConfirmation.ExpiresAt,
+	}).Return(nil)
+
+	require.NoError(t, c.Notify(ctx, pendingConfirmation, transaction.Sender, code))
+
+	signatureConfirmation, code, err := c.Issue(transaction, transaction.Sender, model.SignatureConfirmation)
+	require.NoError(t, err)
+
+	assert.Empty(t, code)
+	assert.Empty(t, signatureConfirmation.CodeHash)
+
+	_, _, err = c.Issue(transaction, transaction.Sender, "test-method")
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testErr := errors.New("test err")
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	transaction := testTransaction()
+	transactionHash := confirmation.TransactionHash(transaction, transaction.Sender)
+
+	rawHash, err := hex.DecodeString(transactionHash)
+	require.NoError(t, err)
+
+	rawSignature, err := crypto.SignBytes(privateKey, rawHash)
+	require.NoError(t, err)
+
+	signature := base64.StdEncoding.EncodeToString(rawSignature)
+
+	codeConfirmation := func(attempts int, expiresAt time.Time) *model.Confirmation {
+		return &model.Confirmation{
+			TransactionID:   transaction.ID,
+			Method:          model.CodeConfirmation,
+			CodeHash:        hashCode("123456"),
+			TransactionHash: transactionHash,
+			Attempts:        attempts,
+			ExpiresAt:       expiresAt,
+		}
+	}
+	signatureConfirmation := &model.Confirmation{
+		TransactionID:   transaction.ID,
+		Method:          model.SignatureConfirmation,
+		TransactionHash: transactionHash,
+		ExpiresAt:       testNow.Add(time.Minute),
+	}
+
+	editedTransaction := testTransaction()
+	editedTransaction.Amount = 1
+
+	testcases := []struct {
+		name         string
+		confirmation *model.Confirmation
+		transaction  *model.Transaction
+		code         string
+		signature    string
+		mock         func(*mocks.MockWalletKeyFetcher)
+		expectedErr  error
+	}{
+		{
+			name:         "Valid code",
+			confirmation: codeConfirmation(0, testNow.Add(time.Minute)),
+			transaction:  transaction,
+			code:         "123456",
+		},
+		{
+			name:         "Wrong code",
+			confirmation: codeConfirmation(1, testNow.Add(time.Minute)),
+			transaction:  transaction,
+			code:         "654321",
+			expectedErr:  confirmation.NewInvalidConfirmationError(1),
+		},
+		{
+			name:         "Expired confirmation",
+			confirmation: codeConfirmation(0, testNow),
+			transaction:  transaction,
+			code:         "123456",
+			expectedErr:  confirmation.NewConfirmationExpiredError("confirmation expired at " + testNow.Format(time.RFC3339)),
+		},
+		{
+			name:         "Attempts exhausted",
+			confirmation: codeConfirmation(testMaxAttempts, testNow.Add(time.Minute)),
+			transaction:  transaction,
+			code:         "123456",
+			expectedErr:  confirmation.NewConfirmationExpiredError("confirmation attempts exhausted"),
+		},
+		{
+			name:         "Transaction changed after accept",
+			confirmation: codeConfirmation(0, testNow.Add(time.Minute)),
+			transaction:  editedTransaction,
+			code:         "123456",
+			expectedErr:  confirmation.NewConfirmationExpiredError("transaction changed after accept"),
+		},
+		{
+			name:         "Valid signature",
+			confirmation: signatureConfirmation,
+			transaction:  transaction,
+			signature:    signature,
+			mock: func(mf *mocks.MockWalletKeyFetcher) {
+				mf.EXPECT().FetchPublicKey(ctx, transaction.Sender).Return(publicKey, nil)
+			},
+		},
+		{
+			name:         "Signature of another key",
+			confirmation: signatureConfirmation,
+			transaction:  transaction,
+			signature:    signature,
+			mock: func(mf *mocks.MockWalletKeyFetcher) {
+				otherKey, _, _ := ed25519.GenerateKey(rand.Reader)
+				mf.EXPECT().FetchPublicKey(ctx, transaction.Sender).Return(otherKey, nil)
+			},
+			expectedErr: confirmation.NewInvalidConfirmationError(testMaxAttempts - 1),
+		},
+		{
+			name:         "Failed to fetch public key",
+			confirmation: signatureConfirmation,
+			transaction:  transaction,
+			signature:    signature,
+			mock: func(mf *mocks.MockWalletKeyFetcher) {
+				mf.EXPECT().FetchPublicKey(ctx, transaction.Sender).Return(nil, testErr)
+			},
+			expectedErr: confirmation.NewVerifyConfirmationError("failed to fetch payer public key", testErr),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, _, keyFetcher := confirmerHelper(t)
+			if testcase.mock != nil {
+				testcase.mock(keyFetcher)
+			}
+
+			err := c.Verify(ctx, testcase.confirmation, testcase.transaction, testcase.code, testcase.signature)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
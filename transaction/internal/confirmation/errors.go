@@ -0,0 +1,83 @@
+package confirmation
+
+import (
+	"fmt"
+	"time"
+)
+
+// InvalidConfirmationError represents a wrong confirmation code or signature.
+type InvalidConfirmationError struct {
+	remainingAttempts int
+}
+
+// NewInvalidConfirmationError creates a new InvalidConfirmationError instance with the number of attempts left.
+func NewInvalidConfirmationError(remainingAttempts int) *InvalidConfirmationError {
+	return &InvalidConfirmationError{
+		remainingAttempts: remainingAttempts,
+	}
+}
+
+// RemainingAttempts returns how many attempts are left before the confirmation is dropped.
+func (e InvalidConfirmationError) RemainingAttempts() int {
+	return e.remainingAttempts
+}
+
+func (e InvalidConfirmationError) Error() string {
+	return fmt.Sprintf("wrong confirmation code or signature, %d attempts left", e.remainingAttempts)
+}
+
+// ConfirmationExpiredError represents a confirmation that can no longer be completed.
+type ConfirmationExpiredError struct {
+	msg string
+}
+
+// NewConfirmationExpiredError creates a new ConfirmationExpiredError instance with the provided message.
+func NewConfirmationExpiredError(msg string) *ConfirmationExpiredError {
+	return &ConfirmationExpiredError{
+		msg: msg,
+	}
+}
+
+func (e ConfirmationExpiredError) Error() string {
+	return e.msg
+}
+
+func newExpiredAtError(expiresAt time.Time) *ConfirmationExpiredError {
+	return NewConfirmationExpiredError(fmt.Sprintf("confirmation expired at %s", expiresAt.Format(time.RFC3339)))
+}
+
+// IssueConfirmationError represents an error encountered while issuing a confirmation.
+type IssueConfirmationError struct {
+	msg string
+	err error
+}
+
+// NewIssueConfirmationError creates a new IssueConfirmationError instance with the provided message and error.
+func NewIssueConfirmationError(msg string, err error) *IssueConfirmationError {
+	return &IssueConfirmationError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e IssueConfirmationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+// VerifyConfirmationError represents an error encountered while verifying a confirmation.
+type VerifyConfirmationError struct {
+	msg string
+	err error
+}
+
+// NewVerifyConfirmationError creates a new VerifyConfirmationError instance with the provided message and error.
+func NewVerifyConfirmationError(msg string, err error) *VerifyConfirmationError {
+	return &VerifyConfirmationError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e VerifyConfirmationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation (interfaces: Confirmer)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/confirmer_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation Confirmer
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmer is a mock of Confirmer interface.
+type MockConfirmer struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmerMockRecorder
+}
+
+// MockConfirmerMockRecorder is the mock recorder for MockConfirmer.
+type MockConfirmerMockRecorder struct {
+	mock *MockConfirmer
+}
+
+// NewMockConfirmer creates a new mock instance.
+func NewMockConfirmer(ctrl *gomock.Controller) *MockConfirmer {
+	mock := &MockConfirmer{ctrl: ctrl}
+	mock.recorder = &MockConfirmerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmer) EXPECT() *MockConfirmerMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockConfirmer) Issue(arg0 *model.Transaction, arg1 *model.TransactionUser, arg2 model.ConfirmationMethod) (*model.Confirmation, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Confirmation)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockConfirmerMockRecorder) Issue(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockConfirmer)(nil).Issue), arg0, arg1, arg2)
+}
+
+// Notify mocks base method.
+func (m *MockConfirmer) Notify(arg0 context.Context, arg1 *model.Confirmation, arg2 *model.TransactionUser, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockConfirmerMockRecorder) Notify(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockConfirmer)(nil).Notify), arg0, arg1, arg2, arg3)
+}
+
+// Required mocks base method.
+func (m *MockConfirmer) Required(arg0 *model.Transaction) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Required", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Required indicates an expected call of Required.
+func (mr *MockConfirmerMockRecorder) Required(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Required", reflect.TypeOf((*MockConfirmer)(nil).Required), arg0)
+}
+
+// Verify mocks base method.
+func (m *MockConfirmer) Verify(arg0 context.Context, arg1 *model.Confirmation, arg2 *model.Transaction, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockConfirmerMockRecorder) Verify(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockConfirmer)(nil).Verify), arg0, arg1, arg2, arg3, arg4)
+}
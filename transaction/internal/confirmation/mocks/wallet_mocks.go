@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation (interfaces: WalletKeyFetcher)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/wallet_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation WalletKeyFetcher
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	ed25519 "crypto/ed25519"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWalletKeyFetcher is a mock of WalletKeyFetcher interface.
+type MockWalletKeyFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockWalletKeyFetcherMockRecorder
+}
+
+// MockWalletKeyFetcherMockRecorder is the mock recorder for MockWalletKeyFetcher.
+type MockWalletKeyFetcherMockRecorder struct {
+	mock *MockWalletKeyFetcher
+}
+
+// NewMockWalletKeyFetcher creates a new mock instance.
+func NewMockWalletKeyFetcher(ctrl *gomock.Controller) *MockWalletKeyFetcher {
+	mock := &MockWalletKeyFetcher{ctrl: ctrl}
+	mock.recorder = &MockWalletKeyFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWalletKeyFetcher) EXPECT() *MockWalletKeyFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchPublicKey mocks base method.
+func (m *MockWalletKeyFetcher) FetchPublicKey(arg0 context.Context, arg1 *model.TransactionUser) (ed25519.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPublicKey", arg0, arg1)
+	ret0, _ := ret[0].(ed25519.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPublicKey indicates an expected call of FetchPublicKey.
+func (mr *MockWalletKeyFetcherMockRecorder) FetchPublicKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPublicKey", reflect.TypeOf((*MockWalletKeyFetcher)(nil).FetchPublicKey), arg0, arg1)
+}
@@ -0,0 +1,86 @@
+package confirmation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+)
+
+//go:generate mockgen -package mocks -destination mocks/notifier_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation Notifier
+
+// Notification is the one-time code delivered to the payer.
+type Notification struct {
+	TransactionID string    `json:"transaction_id"`
+	UserID        string    `json:"user_id"`
+	Code          string    `json:"code"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// Notifier delivers confirmation codes to payers.
+type Notifier interface {
+	Notify(ctx context.Context, notification *Notification) error
+}
+
+type logNotifier struct {
+	log logger.Logger
+}
+
+// NewLogNotifier creates a Notifier that writes codes to the service log.
+// It is a stand-in for a real delivery channel and must not be used in production.
+func NewLogNotifier(log logger.Logger) Notifier {
+	return &logNotifier{
+		log: log,
+	}
+}
+
+// Notify writes the notification to the log.
+func (n *logNotifier) Notify(_ context.Context, notification *Notification) error {
+	n.log.Info("Transaction confirmation code", map[string]interface{}{
+		"transaction_id": notification.TransactionID,
+		"user_id":        notification.UserID,
+		"code":           notification.Code,
+		"expires_at":     notification.ExpiresAt,
+	})
+
+	return nil
+}
+
+type fileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileNotifier creates a Notifier that appends codes to a file as JSON lines.
+func NewFileNotifier(path string) Notifier {
+	return &fileNotifier{
+		path: path,
+	}
+}
+
+// Notify appends the notification to the file.
+func (n *fileNotifier) Notify(_ context.Context, notification *Notification) error {
+	line, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open notification file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+
+	return nil
+}
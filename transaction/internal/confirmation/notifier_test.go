@@ -0,0 +1,37 @@
+package confirmation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileNotifier(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "codes.log")
+	notifier := NewFileNotifier(path)
+
+	for _, code := range []string{"123456", "654321"} {
+		require.NoError(t, notifier.Notify(context.Background(), &Notification{
+			TransactionID: "test-transaction",
+			UserID:        "test-user",
+			Code:          code,
+			ExpiresAt:     time.Unix(300, 0).UTC(),
+		}))
+	}
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		`{"transaction_id":"test-transaction","user_id":"test-user","code":"123456","expires_at":"1970-01-01T00:05:00Z"}`+"\n"+
+			`{"transaction_id":"test-transaction","user_id":"test-user","code":"654321","expires_at":"1970-01-01T00:05:00Z"}`+"\n",
+		string(content),
+	)
+}
@@ -0,0 +1,90 @@
+package confirmation
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client/monitor"
+	monitor_models "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+//go:generate mockgen -package mocks -destination mocks/wallet_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation WalletKeyFetcher
+
+const (
+	transactionService = "transaction"
+	userService        = "user"
+
+	getWalletMethod = "getWalletByID"
+)
+
+var errEmptyPublicKey = errors.New("user service returned wallet without public key")
+
+// WalletKeyFetcher fetches the public key of a payer wallet.
+type WalletKeyFetcher interface {
+	FetchPublicKey(ctx context.Context, user *model.TransactionUser) (ed25519.PublicKey, error)
+}
+
+type monitorWalletKeyFetcher struct {
+	monitorClient monitor_client.ClientService
+	log           logger.Logger
+}
+
+// NewMonitorWalletKeyFetcher creates a WalletKeyFetcher that requests wallets from the user service through the monitor.
+func NewMonitorWalletKeyFetcher(monitorClient monitor_client.ClientService, log logger.Logger) WalletKeyFetcher {
+	return &monitorWalletKeyFetcher{
+		monitorClient: monitorClient,
+		log:           log,
+	}
+}
+
+// FetchPublicKey requests the wallet and decodes its Algorand address into an ed25519 public key.
+func (f *monitorWalletKeyFetcher) FetchPublicKey(ctx context.Context, user *model.TransactionUser) (ed25519.PublicKey, error) {
+	from := transactionService
+	to := userService
+	method := getWalletMethod
+
+	f.log.Debug("Fetch wallet public key", map[string]interface{}{
+		"from":      from,
+		"to":        to,
+		"method":    method,
+		"wallet_id": user.WalletID,
+	})
+
+	resp, err := f.monitorClient.Process(&monitor_client.ProcessParams{
+		Body: &monitor_models.ProcessRequest{
+			From:   &from,
+			To:     &to,
+			Method: &method,
+			Payload: map[string]interface{}{
+				"ClientID": user.UserID,
+				"WalletID": user.WalletID,
+			},
+		},
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to process getWalletByID request: %w", err)
+	}
+
+	payload, ok := resp.Payload.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected wallet payload type %T", resp.Payload)
+	}
+
+	publicKey, _ := payload["public_key"].(string)
+	if publicKey == "" {
+		return nil, errEmptyPublicKey
+	}
+
+	address, err := types.DecodeAddress(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode wallet address: %w", err)
+	}
+
+	return ed25519.PublicKey(address[:]), nil
+}
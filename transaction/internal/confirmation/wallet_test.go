@@ -0,0 +1,98 @@
+package confirmation
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/client/monitor"
+	mock_monitor_client "github.com/ShmelJUJ/software-engineering/pkg/monitor_client/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFetchPublicKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testErr := errors.New("test err")
+	testUser := &model.TransactionUser{
+		UserID:   "test-user",
+		WalletID: "test-wallet",
+	}
+
+	const testAddress = "S654A2A7TYORBPQ4GT4VBRZMVOCLT5EXA34RQISUF6TEHDCHC3GDJF7MTI"
+
+	address, err := types.DecodeAddress(testAddress)
+	assert.NoError(t, err)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_monitor_client.MockClientService)
+		expectedKey ed25519.PublicKey
+		expectedErr bool
+	}{
+		{
+			name: "Successfully fetch public key",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(&monitor_client.ProcessOK{
+					Payload: map[string]interface{}{"public_key": testAddress},
+				}, nil)
+			},
+			expectedKey: ed25519.PublicKey(address[:]),
+		},
+		{
+			name: "Failed to process request",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(nil, testErr)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Wallet without public key",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(&monitor_client.ProcessOK{
+					Payload: map[string]interface{}{},
+				}, nil)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "Malformed address",
+			mock: func(mc *mock_monitor_client.MockClientService) {
+				mc.EXPECT().Process(gomock.Any()).Return(&monitor_client.ProcessOK{
+					Payload: map[string]interface{}{"public_key": "test-address"},
+				}, nil)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			mc := mock_monitor_client.NewMockClientService(mockCtrl)
+			testcase.mock(mc)
+
+			key, err := NewMonitorWalletKeyFetcher(mc, l).FetchPublicKey(ctx, testUser)
+			if testcase.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testcase.expectedKey, key)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/redis/go-redis/v9"
+)
+
+//go:generate mockgen -package mocks -destination mocks/broker_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/events Publisher,Broker
+
+// Publisher publishes status changes of transactions.
+type Publisher interface {
+	// Publish stamps the event with its id and, unless set, the time it occurred at.
+	Publish(ctx context.Context, event *model.TransactionStatusEvent) error
+}
+
+// Broker publishes status changes of transactions and streams them to subscribers on any replica.
+type Broker interface {
+	Publisher
+	// Subscribe streams the events of the transaction until the context is canceled.
+	// When lastEventID is set, the events after it are replayed before the live ones.
+	Subscribe(ctx context.Context, transactionID, lastEventID string) (<-chan *model.TransactionStatusEvent, error)
+}
+
+const eventField = "event"
+
+// publishScript appends the event to the history of the transaction and announces it with its id,
+// so that subscribers never see an event that cannot be replayed.
+var publishScript = redis.NewScript(`
+local id = redis.call("XADD", KEYS[1], "MAXLEN", "~", ARGV[1], "*", "event", ARGV[2])
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+redis.call("PUBLISH", KEYS[1], id .. "\n" .. ARGV[2])
+return id
+`)
+
+type redisBroker struct {
+	cfg    *Config
+	client *redis.Client
+	clock  clock.Clock
+	log    logger.Logger
+}
+
+// NewRedisBroker creates a Broker keeping the event history of every transaction in a redis stream
+// and announcing new events through redis pub/sub.
+func NewRedisBroker(cfg *Config, client *redis.Client, clk clock.Clock, log logger.Logger) (Broker, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, NewPublishError("failed to set default config", err)
+	}
+
+	return &redisBroker{
+		cfg:    cfg,
+		client: client,
+		clock:  clk,
+		log:    log,
+	}, nil
+}
+
+// Publish appends the event to the transaction history and announces it to the subscribers.
+func (b *redisBroker) Publish(ctx context.Context, event *model.TransactionStatusEvent) error {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = b.clock.NowUTC()
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return NewPublishError("failed to marshal transaction event", err)
+	}
+
+	id, err := publishScript.Run(
+		ctx,
+		b.client,
+		[]string{b.key(event.TransactionID)},
+		b.cfg.HistoryLength,
+		string(data),
+		b.cfg.HistoryTTL.Milliseconds(),
+	).Text()
+	if err != nil {
+		return NewPublishError("failed to publish transaction event", err)
+	}
+
+	event.ID = id
+
+	return nil
+}
+
+// Subscribe listens to the announcements before reading the history,
+// so no event published in between is lost. Events seen twice are dropped by their id.
+func (b *redisBroker) Subscribe(
+	ctx context.Context,
+	transactionID string,
+	lastEventID string,
+) (<-chan *model.TransactionStatusEvent, error) {
+	var last eventID
+
+	if lastEventID != "" {
+		parsed, err := parseEventID(lastEventID)
+		if err != nil {
+			return nil, err
+		}
+
+		last = parsed
+	}
+
+	key := b.key(transactionID)
+
+	pubsub := b.client.Subscribe(ctx, key)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+
+		return nil, NewSubscribeError("failed to subscribe to transaction events", err)
+	}
+
+	var replay []*model.TransactionStatusEvent
+
+	if lastEventID != "" {
+		messages, err := b.client.XRange(ctx, key, lastEventID, "+").Result()
+		if err != nil {
+			pubsub.Close()
+
+			return nil, NewSubscribeError("failed to read transaction event history", err)
+		}
+
+		for _, message := range messages {
+			data, _ := message.Values[eventField].(string)
+
+			event, err := decodeEvent(message.ID, data)
+			if err != nil {
+				b.log.Error("Failed to decode transaction event", map[string]interface{}{
+					"error":    err,
+					"event_id": message.ID,
+				})
+
+				continue
+			}
+
+			replay = append(replay, event)
+		}
+	}
+
+	events := make(chan *model.TransactionStatusEvent, b.cfg.BufferSize)
+
+	go b.stream(ctx, pubsub, replay, last, events)
+
+	return events, nil
+}
+
+func (b *redisBroker) stream(
+	ctx context.Context,
+	pubsub *redis.PubSub,
+	replay []*model.TransactionStatusEvent,
+	last eventID,
+	events chan<- *model.TransactionStatusEvent,
+) {
+	defer close(events)
+	defer pubsub.Close()
+
+	// send delivers the event unless it was already delivered.
+	send := func(event *model.TransactionStatusEvent) bool {
+		id, err := parseEventID(event.ID)
+		if err != nil || !last.before(id) {
+			return true
+		}
+
+		select {
+		case events <- event:
+			last = id
+
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for _, event := range replay {
+		if !send(event) {
+			return
+		}
+	}
+
+	messages := pubsub.Channel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			id, data, _ := strings.Cut(message.Payload, "\n")
+
+			event, err := decodeEvent(id, data)
+			if err != nil {
+				b.log.Error("Failed to decode transaction event", map[string]interface{}{
+					"error":    err,
+					"event_id": id,
+				})
+
+				continue
+			}
+
+			if !send(event) {
+				return
+			}
+		}
+	}
+}
+
+func (b *redisBroker) key(transactionID string) string {
+	return b.cfg.KeyPrefix + transactionID
+}
+
+func decodeEvent(id, data string) (*model.TransactionStatusEvent, error) {
+	event := &model.TransactionStatusEvent{}
+	if err := json.Unmarshal([]byte(data), event); err != nil {
+		return nil, err
+	}
+
+	event.ID = id
+
+	return event, nil
+}
+
+// eventID is the redis stream entry id the events are ordered by.
+type eventID struct {
+	ms  uint64
+	seq uint64
+}
+
+func parseEventID(id string) (eventID, error) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return eventID{}, ErrInvalidEventID
+	}
+
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return eventID{}, ErrInvalidEventID
+	}
+
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return eventID{}, ErrInvalidEventID
+	}
+
+	return eventID{ms: ms, seq: seq}, nil
+}
+
+func (id eventID) before(other eventID) bool {
+	return id.ms < other.ms || (id.ms == other.ms && id.seq < other.seq)
+}
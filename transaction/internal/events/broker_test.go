@@ -0,0 +1,190 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testTransactionID = "3f0c8a5e-8f2d-4a51-a1f4-2d3f1d6c7b10"
+	testWait          = time.Second
+)
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func brokerHelper(t *testing.T) (Broker, *miniredis.Miniredis) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	server := miniredis.RunT(t)
+
+	broker, err := NewRedisBroker(&Config{}, redis.NewClient(&redis.Options{Addr: server.Addr()}), clk, l)
+	require.NoError(t, err)
+
+	return broker, server
+}
+
+func publish(t *testing.T, broker Broker, status model.TransactionStatus) *model.TransactionStatusEvent {
+	t.Helper()
+
+	event := &model.TransactionStatusEvent{
+		TransactionID: testTransactionID,
+		Status:        status,
+	}
+	require.NoError(t, broker.Publish(context.Background(), event))
+
+	return event
+}
+
+func receive(t *testing.T, events <-chan *model.TransactionStatusEvent) *model.TransactionStatusEvent {
+	t.Helper()
+
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(testWait):
+		t.Fatal("no event received")
+
+		return nil
+	}
+}
+
+func TestRedisBrokerPublish(t *testing.T) {
+	t.Parallel()
+
+	broker, server := brokerHelper(t)
+
+	event := publish(t, broker, model.Processed)
+
+	assert.NotEmpty(t, event.ID)
+	assert.Equal(t, testNow, event.OccurredAt)
+	assert.True(t, server.Exists(defaultKeyPrefix+testTransactionID))
+	assert.Equal(t, defaultHistoryTTL, server.TTL(defaultKeyPrefix+testTransactionID))
+}
+
+func TestRedisBrokerSubscribeLive(t *testing.T) {
+	t.Parallel()
+
+	broker, _ := brokerHelper(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := broker.Subscribe(ctx, testTransactionID, "")
+	require.NoError(t, err)
+
+	published := publish(t, broker, model.Succeeded)
+
+	event := receive(t, events)
+	assert.Equal(t, published.ID, event.ID)
+	assert.Equal(t, model.Succeeded, event.Status)
+	assert.Equal(t, testTransactionID, event.TransactionID)
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		assert.False(t, ok)
+	case <-time.After(testWait):
+		t.Fatal("events were not closed")
+	}
+}
+
+func TestRedisBrokerSubscribeResume(t *testing.T) {
+	t.Parallel()
+
+	broker, _ := brokerHelper(t)
+
+	first := publish(t, broker, model.Processed)
+	second := publish(t, broker, model.Canceled)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := broker.Subscribe(ctx, testTransactionID, first.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, second.ID, receive(t, events).ID)
+
+	third := publish(t, broker, model.Failed)
+
+	assert.Equal(t, third.ID, receive(t, events).ID)
+}
+
+func TestRedisBrokerSubscribeInvalidEventID(t *testing.T) {
+	t.Parallel()
+
+	broker, _ := brokerHelper(t)
+
+	_, err := broker.Subscribe(context.Background(), testTransactionID, "not-an-id")
+	assert.ErrorIs(t, err, ErrInvalidEventID)
+}
+
+func TestEventIDBefore(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		id       string
+		other    string
+		expected bool
+	}{
+		{
+			name:     "Earlier millisecond",
+			id:       "1700000000000-5",
+			other:    "1700000000001-0",
+			expected: true,
+		},
+		{
+			name:     "Earlier sequence",
+			id:       "1700000000000-1",
+			other:    "1700000000000-2",
+			expected: true,
+		},
+		{
+			name:     "Same id",
+			id:       "1700000000000-1",
+			other:    "1700000000000-1",
+			expected: false,
+		},
+		{
+			name:     "Later id",
+			id:       "1700000000001-0",
+			other:    "1700000000000-9",
+			expected: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := parseEventID(testcase.id)
+			require.NoError(t, err)
+
+			other, err := parseEventID(testcase.other)
+			require.NoError(t, err)
+
+			assert.Equal(t, testcase.expected, id.before(other))
+		})
+	}
+}
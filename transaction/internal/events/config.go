@@ -0,0 +1,53 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultKeyPrefix     = "transaction:events:"
+	defaultHistoryLength = 100
+	defaultHistoryTTL    = 24 * time.Hour
+	defaultBufferSize    = 16
+)
+
+// Config represents the transaction events broker configuration structure.
+type Config struct {
+	// KeyPrefix prefixes the redis stream and channel of every transaction.
+	KeyPrefix string
+	// HistoryLength is roughly how many events of a transaction are kept for resumption.
+	HistoryLength int64
+	// HistoryTTL is how long the event history outlives the last event of a transaction.
+	HistoryTTL time.Duration
+	// BufferSize is how many events a slow subscriber may fall behind.
+	BufferSize int
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		KeyPrefix:     defaultKeyPrefix,
+		HistoryLength: defaultHistoryLength,
+		HistoryTTL:    defaultHistoryTTL,
+		BufferSize:    defaultBufferSize,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
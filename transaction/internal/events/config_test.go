@@ -0,0 +1,62 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				KeyPrefix:  "test:events:",
+				HistoryTTL: time.Hour,
+			},
+			expectedCfg: &Config{
+				KeyPrefix:     "test:events:",
+				HistoryLength: defaultHistoryLength,
+				HistoryTTL:    time.Hour,
+				BufferSize:    defaultBufferSize,
+			},
+		},
+		{
+			name: "With empty config",
+			cfg:  &Config{},
+			expectedCfg: &Config{
+				KeyPrefix:     defaultKeyPrefix,
+				HistoryLength: defaultHistoryLength,
+				HistoryTTL:    defaultHistoryTTL,
+				BufferSize:    defaultBufferSize,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidEventID is returned when the event id to resume after is malformed.
+var ErrInvalidEventID = errors.New("invalid event id")
+
+// PublishError represents an error encountered while publishing a transaction event.
+type PublishError struct {
+	msg string
+	err error
+}
+
+// NewPublishError creates a new PublishError instance with the provided message and error.
+func NewPublishError(msg string, err error) *PublishError {
+	return &PublishError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e PublishError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e PublishError) Unwrap() error {
+	return e.err
+}
+
+// SubscribeError represents an error encountered while subscribing to transaction events.
+type SubscribeError struct {
+	msg string
+	err error
+}
+
+// NewSubscribeError creates a new SubscribeError instance with the provided message and error.
+func NewSubscribeError(msg string, err error) *SubscribeError {
+	return &SubscribeError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SubscribeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SubscribeError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,45 @@
+package expiry
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultSweepInterval = time.Minute
+	defaultBatchSize     = 100
+)
+
+// Config represents the expiration sweeper configuration structure.
+type Config struct {
+	// SweepInterval is how often expired transactions are looked for.
+	SweepInterval time.Duration
+	// BatchSize limits how many transactions are expired by a single statement.
+	BatchSize uint64
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		SweepInterval: defaultSweepInterval,
+		BatchSize:     defaultBatchSize,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
@@ -0,0 +1,25 @@
+package expiry
+
+import "fmt"
+
+// SweepError represents an error encountered while expiring transactions.
+type SweepError struct {
+	msg string
+	err error
+}
+
+// NewSweepError creates a new SweepError instance with the provided message and error.
+func NewSweepError(msg string, err error) *SweepError {
+	return &SweepError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SweepError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SweepError) Unwrap() error {
+	return e.err
+}
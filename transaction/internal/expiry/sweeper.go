@@ -0,0 +1,100 @@
+package expiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+// Sweeper moves created transactions that were not accepted in time to the expired status.
+type Sweeper interface {
+	Run(ctx context.Context)
+	Sweep(ctx context.Context) (int, error)
+}
+
+type sweeper struct {
+	cfg             *Config
+	transactionRepo repository.TransactionRepo
+	clock           clock.Clock
+	log             logger.Logger
+}
+
+// NewSweeper creates a new instance of Sweeper.
+func NewSweeper(
+	cfg *Config,
+	transactionRepo repository.TransactionRepo,
+	clk clock.Clock,
+	log logger.Logger,
+) (Sweeper, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &sweeper{
+		cfg:             cfg,
+		transactionRepo: transactionRepo,
+		clock:           clk,
+		log:             log,
+	}, nil
+}
+
+// Run sweeps expired transactions every sweep interval until the context is canceled.
+func (s *sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := s.Sweep(ctx)
+			if err != nil {
+				s.log.Error("Failed to sweep expired transactions", map[string]interface{}{
+					"error":   err,
+					"expired": expired,
+				})
+
+				continue
+			}
+
+			if expired > 0 {
+				s.log.Info("Expired transactions", map[string]interface{}{
+					"expired": expired,
+				})
+			}
+		}
+	}
+}
+
+// Sweep expires transactions in batches until a batch comes back incomplete
+// and returns how many transactions were expired.
+func (s *sweeper) Sweep(ctx context.Context) (int, error) {
+	expired := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return expired, NewSweepError("sweep interrupted", err)
+		}
+
+		transactionIDs, err := s.transactionRepo.ExpireTransactions(ctx, s.clock.NowUTC(), s.cfg.BatchSize)
+		if err != nil {
+			return expired, NewSweepError("failed to expire transactions", err)
+		}
+
+		expired += len(transactionIDs)
+
+		s.log.Debug("Expired transactions batch", map[string]interface{}{
+			"transaction_ids": transactionIDs,
+		})
+
+		if uint64(len(transactionIDs)) < s.cfg.BatchSize {
+			return expired, nil
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package expiry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/expiry"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testBatchSize = 2
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func sweeperHelper(t *testing.T, sweepInterval time.Duration) (expiry.Sweeper, *mock_repository.MockTransactionRepo, *mock_clock.MockClock) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := mock_repository.NewMockTransactionRepo(mockCtrl)
+	clk := mock_clock.NewMockClock(mockCtrl)
+
+	s, err := expiry.NewSweeper(&expiry.Config{
+		SweepInterval: sweepInterval,
+		BatchSize:     testBatchSize,
+	}, repo, clk, l)
+	require.NoError(t, err)
+
+	return s, repo, clk
+}
+
+func TestSweep(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	testcases := []struct {
+		name            string
+		batches         [][]string
+		batchErr        error
+		expectedExpired int
+		expectedErr     error
+	}{
+		{
+			name:            "Nothing to expire",
+			batches:         [][]string{{}},
+			expectedExpired: 0,
+		},
+		{
+			name:            "Single incomplete batch",
+			batches:         [][]string{{"first"}},
+			expectedExpired: 1,
+		},
+		{
+			name:            "Sweep until a batch is incomplete",
+			batches:         [][]string{{"first", "second"}, {"third", "fourth"}, {"fifth"}},
+			expectedExpired: 5,
+		},
+		{
+			name:            "Failed batch",
+			batches:         [][]string{{"first", "second"}},
+			batchErr:        testErr,
+			expectedExpired: 2,
+			expectedErr:     testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, repo, clk := sweeperHelper(t, time.Minute)
+
+			calls := make([]any, 0, len(testcase.batches)+1)
+
+			for i, batch := range testcase.batches {
+				now := testNow.Add(time.Duration(i) * time.Second)
+
+				calls = append(calls,
+					clk.EXPECT().NowUTC().Return(now),
+					repo.EXPECT().ExpireTransactions(gomock.Any(), now, uint64(testBatchSize)).Return(batch, nil),
+				)
+			}
+
+			if testcase.batchErr != nil {
+				calls = append(calls,
+					clk.EXPECT().NowUTC().Return(testNow),
+					repo.EXPECT().ExpireTransactions(gomock.Any(), testNow, uint64(testBatchSize)).Return(nil, testcase.batchErr),
+				)
+			}
+
+			gomock.InOrder(calls...)
+
+			expired, err := s.Sweep(context.Background())
+
+			assert.Equal(t, testcase.expectedExpired, expired)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestSweepCanceled(t *testing.T) {
+	t.Parallel()
+
+	s, _, _ := sweeperHelper(t, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expired, err := s.Sweep(ctx)
+
+	assert.Zero(t, expired)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	s, repo, clk := sweeperHelper(t, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	gomock.InOrder(
+		repo.EXPECT().ExpireTransactions(gomock.Any(), testNow, uint64(testBatchSize)).Return(nil, errors.New("test err")),
+		repo.EXPECT().ExpireTransactions(gomock.Any(), testNow, uint64(testBatchSize)).
+			DoAndReturn(func(context.Context, time.Time, uint64) ([]string, error) {
+				cancel()
+
+				return []string{"first"}, nil
+			}),
+	)
+
+	done := make(chan struct{})
+
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not stop after the context was canceled")
+	}
+}
@@ -0,0 +1,85 @@
+package fee
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -package mocks -destination mocks/calculator_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/fee Calculator
+
+// Calculator calculates the fee charged on a transaction with the fee plan of its receiver
+// for the transaction method and currency, tiered by the receiver volume of the current calendar month in UTC.
+// Transactions without a plan and group transactions are charged no fee.
+type Calculator interface {
+	Calculate(ctx context.Context, transaction *model.Transaction) (*model.Fee, error)
+}
+
+type calculator struct {
+	cfg     *Config
+	feeRepo repository.FeeRepo
+	clock   clock.Clock
+}
+
+// NewCalculator creates a new instance of Calculator.
+func NewCalculator(cfg *Config, feeRepo repository.FeeRepo, clk clock.Clock) (Calculator, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &calculator{
+		cfg:     cfg,
+		feeRepo: feeRepo,
+		clock:   clk,
+	}, nil
+}
+
+// Calculate returns the fee of the transaction paid to the platform wallet, or nil when no fee is charged.
+func (c *calculator) Calculate(ctx context.Context, transaction *model.Transaction) (*model.Fee, error) {
+	if transaction.Group() || transaction.Receiver == nil {
+		return nil, nil
+	}
+
+	plan, err := c.feeRepo.GetFeePlan(ctx, transaction.Receiver.UserID, transaction.Method, transaction.Currency)
+	if errors.Is(err, repository.ErrFeePlanNotFound) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.clock.NowUTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	volume, err := c.feeRepo.GetMerchantVolume(ctx, plan.MerchantID, plan.Currency, monthStart)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := plan.Fee(transaction.Amount, volume)
+	if amount == 0 {
+		return nil, nil
+	}
+
+	if c.cfg.WalletUserID == "" || c.cfg.WalletID == "" {
+		return nil, ErrNoFeeWallet
+	}
+
+	return &model.Fee{
+		Amount: amount,
+		Receiver: &model.TransactionUser{
+			ID:        uuid.NewString(),
+			UserID:    c.cfg.WalletUserID,
+			WalletID:  c.cfg.WalletID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}, nil
+}
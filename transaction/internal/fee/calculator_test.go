@@ -0,0 +1,174 @@
+package fee_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testWalletUserID = "b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e"
+	testWalletID     = "c2d3e4f5-a6b7-4c8d-9e0f-1a2b3c4d5e6f"
+)
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.May, 17, 12, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	transaction := &model.Transaction{
+		Currency: "USD",
+		Amount:   10000,
+		Method:   "algorand",
+		Receiver: &model.TransactionUser{
+			UserID: "merchant",
+		},
+	}
+	plan := &model.FeePlan{
+		MerchantID:    "merchant",
+		Currency:      "USD",
+		FixedAmount:   30,
+		PercentageBps: 290,
+		Tiers: []*model.FeeTier{
+			{FromVolume: 1000000, PercentageBps: 250},
+		},
+	}
+	someErr := errors.New("test err")
+
+	testcases := []struct {
+		name           string
+		cfg            *fee.Config
+		transaction    *model.Transaction
+		mock           func(*mock_repository.MockFeeRepo)
+		expectedAmount int64
+		expectedErr    error
+	}{
+		{
+			name:        "Successfully calculate fee",
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").Return(plan, nil).Times(1)
+				mfr.EXPECT().GetMerchantVolume(gomock.Any(), "merchant", "USD", monthStart).Return(int64(0), nil).Times(1)
+			},
+			expectedAmount: 320,
+		},
+		{
+			name:        "Monthly volume reaches a tier",
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").Return(plan, nil).Times(1)
+				mfr.EXPECT().GetMerchantVolume(gomock.Any(), "merchant", "USD", monthStart).Return(int64(1500000), nil).Times(1)
+			},
+			expectedAmount: 280,
+		},
+		{
+			name:        "Merchant without plan",
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").
+					Return(nil, repository.NewGetFeePlanError("failed to get fee plan", repository.ErrFeePlanNotFound)).Times(1)
+			},
+		},
+		{
+			name:        "Plan charges nothing",
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").Return(&model.FeePlan{
+					MerchantID: "merchant",
+					Currency:   "USD",
+				}, nil).Times(1)
+				mfr.EXPECT().GetMerchantVolume(gomock.Any(), "merchant", "USD", monthStart).Return(int64(0), nil).Times(1)
+			},
+		},
+		{
+			name: "Group transaction",
+			transaction: &model.Transaction{
+				Currency: "USD",
+				Amount:   10000,
+				Receiver: transaction.Receiver,
+				Shares:   []*model.TransactionShare{{}},
+			},
+			mock: func(*mock_repository.MockFeeRepo) {},
+		},
+		{
+			name:        "No platform wallet",
+			cfg:         &fee.Config{},
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").Return(plan, nil).Times(1)
+				mfr.EXPECT().GetMerchantVolume(gomock.Any(), "merchant", "USD", monthStart).Return(int64(0), nil).Times(1)
+			},
+			expectedErr: fee.ErrNoFeeWallet,
+		},
+		{
+			name:        "Failed to get fee plan",
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").Return(nil, someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+		{
+			name:        "Failed to get merchant volume",
+			transaction: transaction,
+			mock: func(mfr *mock_repository.MockFeeRepo) {
+				mfr.EXPECT().GetFeePlan(gomock.Any(), "merchant", "algorand", "USD").Return(plan, nil).Times(1)
+				mfr.EXPECT().GetMerchantVolume(gomock.Any(), "merchant", "USD", monthStart).Return(int64(0), someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			feeRepo := mock_repository.NewMockFeeRepo(mockCtrl)
+			testcase.mock(feeRepo)
+
+			clk := mock_clock.NewMockClock(mockCtrl)
+			clk.EXPECT().NowUTC().Return(now).AnyTimes()
+
+			cfg := testcase.cfg
+			if cfg == nil {
+				cfg = &fee.Config{
+					WalletUserID: testWalletUserID,
+					WalletID:     testWalletID,
+				}
+			}
+
+			calculator, err := fee.NewCalculator(cfg, feeRepo, clk)
+			require.NoError(t, err)
+
+			transactionFee, err := calculator.Calculate(context.Background(), testcase.transaction)
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedAmount == 0 {
+				assert.Nil(t, transactionFee)
+
+				return
+			}
+
+			require.NotNil(t, transactionFee)
+			assert.Equal(t, testcase.expectedAmount, transactionFee.Amount)
+			assert.Equal(t, testWalletUserID, transactionFee.Receiver.UserID)
+			assert.Equal(t, testWalletID, transactionFee.Receiver.WalletID)
+			assert.NotEmpty(t, transactionFee.Receiver.ID)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package fee
+
+import (
+	"errors"
+	"fmt"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+// Config represents the fee calculator configuration structure.
+type Config struct {
+	// WalletUserID is the platform user owning the wallet fees are paid to.
+	WalletUserID string
+	// WalletID is the platform wallet fees are paid to.
+	WalletID string
+}
+
+func getDefaultConfig() *Config {
+	return &Config{}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
@@ -0,0 +1,54 @@
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With platform wallet",
+			cfg: &Config{
+				WalletUserID: "d6f1e4b2-8c1a-4f5e-9a3b-2c7d8e9f0a1b",
+				WalletID:     "0b9c8d7e-6f5a-4b3c-8d2e-1f0a9b8c7d6e",
+			},
+			expectedCfg: &Config{
+				WalletUserID: "d6f1e4b2-8c1a-4f5e-9a3b-2c7d8e9f0a1b",
+				WalletID:     "0b9c8d7e-6f5a-4b3c-8d2e-1f0a9b8c7d6e",
+			},
+		},
+		{
+			name:        "With empty config",
+			cfg:         &Config{},
+			expectedCfg: getDefaultConfig(),
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package fee
+
+import "errors"
+
+// ErrNoFeeWallet is returned when a fee is charged but no platform wallet is configured to collect it.
+var ErrNoFeeWallet = errors.New("no platform wallet is configured to collect fees")
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/fee (interfaces: Calculator)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/calculator_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/fee Calculator
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalculator is a mock of Calculator interface.
+type MockCalculator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalculatorMockRecorder
+}
+
+// MockCalculatorMockRecorder is the mock recorder for MockCalculator.
+type MockCalculatorMockRecorder struct {
+	mock *MockCalculator
+}
+
+// NewMockCalculator creates a new mock instance.
+func NewMockCalculator(ctrl *gomock.Controller) *MockCalculator {
+	mock := &MockCalculator{ctrl: ctrl}
+	mock.recorder = &MockCalculatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalculator) EXPECT() *MockCalculatorMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockCalculator) Calculate(arg0 context.Context, arg1 *model.Transaction) (*model.Fee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", arg0, arg1)
+	ret0, _ := ret[0].(*model.Fee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockCalculatorMockRecorder) Calculate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockCalculator)(nil).Calculate), arg0, arg1)
+}
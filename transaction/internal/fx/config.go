@@ -0,0 +1,44 @@
+package fx
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const defaultLockDuration = time.Minute
+
+// Config represents the quoter configuration structure.
+type Config struct {
+	// LockDuration is how long a quoted rate is honored.
+	LockDuration time.Duration
+	// MarginBps is the service margin added to the provider rate, in basis points.
+	MarginBps int64
+	// SpreadBps is the bid/ask spread around the provider mid rate in basis points,
+	// the payer buys at the ask side, half of the spread above the mid rate.
+	SpreadBps int64
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		LockDuration: defaultLockDuration,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
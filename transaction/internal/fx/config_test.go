@@ -0,0 +1,65 @@
+package fx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				MarginBps: 150,
+				SpreadBps: 40,
+			},
+			expectedCfg: &Config{
+				LockDuration: defaultLockDuration,
+				MarginBps:    150,
+				SpreadBps:    40,
+			},
+		},
+		{
+			name: "With lock duration",
+			cfg: &Config{
+				LockDuration: 30 * time.Second,
+			},
+			expectedCfg: &Config{
+				LockDuration: 30 * time.Second,
+			},
+		},
+		{
+			name:        "With empty config",
+			cfg:         &Config{},
+			expectedCfg: getDefaultConfig(),
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
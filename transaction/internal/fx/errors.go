@@ -0,0 +1,37 @@
+package fx
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrRateNotFound is returned when the provider has no rate for the currency pair.
+	ErrRateNotFound = errors.New("exchange rate not found")
+	// ErrInvalidRate is returned when the provider returns a rate that is not positive.
+	ErrInvalidRate = errors.New("exchange rate must be positive")
+	// ErrSameCurrency is returned when a quote is requested in the transaction currency.
+	ErrSameCurrency = errors.New("transaction is already in the currency")
+)
+
+// RateError represents an error encountered while getting an exchange rate from the provider.
+type RateError struct {
+	msg string
+	err error
+}
+
+// NewRateError creates a new RateError instance with the provided message and error.
+func NewRateError(msg string, err error) *RateError {
+	return &RateError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e RateError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e RateError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,77 @@
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/shopspring/decimal"
+)
+
+type httpProvider struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPProvider creates a RateProvider that requests rates from an HTTP rate service.
+// The service is asked with GET url?from=RUB&to=ALGO and answers with {"rate": "0.0125"},
+// the rate may be a JSON string or number.
+func NewHTTPProvider(rawURL string, client *http.Client) (RateProvider, error) {
+	if _, err := url.ParseRequestURI(rawURL); err != nil {
+		return nil, fmt.Errorf("invalid rate service url: %w", err)
+	}
+
+	return &httpProvider{
+		url:    rawURL,
+		client: client,
+	}, nil
+}
+
+type rateResponse struct {
+	Rate *decimal.Decimal `json:"rate"`
+}
+
+// Rate requests the rate of the pair from the rate service.
+func (p *httpProvider) Rate(ctx context.Context, from, to money.Currency) (decimal.Decimal, error) {
+	requestURL, err := url.Parse(p.url)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to parse rate service url: %w", err)
+	}
+
+	query := requestURL.Query()
+	query.Set("from", from.Code)
+	query.Set("to", to.Code)
+	requestURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL.String(), http.NoBody)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to create rate request: %w", err)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to request rate: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return decimal.Zero, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	case resp.StatusCode != http.StatusOK:
+		return decimal.Zero, fmt.Errorf("rate service responded with status %d", resp.StatusCode)
+	}
+
+	var body rateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return decimal.Zero, fmt.Errorf("failed to decode rate response: %w", err)
+	}
+
+	if body.Rate == nil {
+		return decimal.Zero, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	}
+
+	return *body.Rate, nil
+}
@@ -0,0 +1,105 @@
+package fx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHTTPProviderRate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name         string
+		status       int
+		body         string
+		expectedRate string
+		expectedErr  error
+		expectErr    bool
+	}{
+		{
+			name:         "String rate",
+			status:       http.StatusOK,
+			body:         `{"rate": "0.0625"}`,
+			expectedRate: "0.0625",
+		},
+		{
+			name:         "Number rate",
+			status:       http.StatusOK,
+			body:         `{"rate": 0.0625}`,
+			expectedRate: "0.0625",
+		},
+		{
+			name:        "Unknown pair",
+			status:      http.StatusNotFound,
+			expectedErr: ErrRateNotFound,
+			expectErr:   true,
+		},
+		{
+			name:        "Missing rate",
+			status:      http.StatusOK,
+			body:        `{}`,
+			expectedErr: ErrRateNotFound,
+			expectErr:   true,
+		},
+		{
+			name:      "Service failure",
+			status:    http.StatusBadGateway,
+			expectErr: true,
+		},
+		{
+			name:      "Malformed body",
+			status:    http.StatusOK,
+			body:      `rate=0.0625`,
+			expectErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "RUB", r.URL.Query().Get("from"))
+				assert.Equal(t, "ALGO", r.URL.Query().Get("to"))
+				assert.Equal(t, "live", r.URL.Query().Get("source"))
+
+				w.WriteHeader(testcase.status)
+				_, _ = w.Write([]byte(testcase.body))
+			}))
+			defer server.Close()
+
+			provider, err := NewHTTPProvider(server.URL+"/rates?source=live", server.Client())
+			require.NoError(t, err)
+
+			rate, err := provider.Rate(context.Background(), rub, money.ALGO)
+
+			if testcase.expectErr {
+				require.Error(t, err)
+
+				if testcase.expectedErr != nil {
+					assert.ErrorIs(t, err, testcase.expectedErr)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectedRate, rate.String())
+		})
+	}
+}
+
+func TestNewHTTPProviderInvalidURL(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewHTTPProvider("not a url", http.DefaultClient)
+	assert.Error(t, err)
+}
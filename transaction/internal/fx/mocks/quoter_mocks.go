@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/fx (interfaces: Quoter)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/quoter_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/fx Quoter
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuoter is a mock of Quoter interface.
+type MockQuoter struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuoterMockRecorder
+}
+
+// MockQuoterMockRecorder is the mock recorder for MockQuoter.
+type MockQuoterMockRecorder struct {
+	mock *MockQuoter
+}
+
+// NewMockQuoter creates a new mock instance.
+func NewMockQuoter(ctrl *gomock.Controller) *MockQuoter {
+	mock := &MockQuoter{ctrl: ctrl}
+	mock.recorder = &MockQuoterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuoter) EXPECT() *MockQuoterMockRecorder {
+	return m.recorder
+}
+
+// Quote mocks base method.
+func (m *MockQuoter) Quote(arg0 context.Context, arg1 *model.Transaction, arg2 string) (*model.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockQuoterMockRecorder) Quote(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockQuoter)(nil).Quote), arg0, arg1, arg2)
+}
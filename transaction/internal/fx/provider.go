@@ -0,0 +1,88 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/shopspring/decimal"
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate mockgen -package mocks -destination mocks/provider_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/fx RateProvider
+
+// RateProvider provides exchange rates, the price of one major unit of from in to.
+type RateProvider interface {
+	Rate(ctx context.Context, from, to money.Currency) (decimal.Decimal, error)
+}
+
+type staticProvider struct {
+	rates map[string]decimal.Decimal
+}
+
+// NewStaticProvider creates a RateProvider with fixed rates keyed by currency pair, like "RUB/ALGO".
+// The rate of the reverse pair is derived when only one direction is listed.
+func NewStaticProvider(rates map[string]string) (RateProvider, error) {
+	provider := &staticProvider{
+		rates: make(map[string]decimal.Decimal, len(rates)),
+	}
+
+	for pair, rate := range rates {
+		from, to, ok := strings.Cut(pair, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid currency pair %q, expected FROM/TO", pair)
+		}
+
+		value, err := decimal.NewFromString(rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate of %s: %w", pair, err)
+		}
+
+		if !value.IsPositive() {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidRate, pair)
+		}
+
+		provider.rates[pairKey(from, to)] = value
+	}
+
+	return provider, nil
+}
+
+// ratesFile is the layout of the file read by LoadStaticProvider.
+type ratesFile struct {
+	Rates map[string]string `yaml:"rates"`
+}
+
+// LoadStaticProvider creates a static RateProvider from a YAML file with a rates map, a stand-in for a market data feed.
+func LoadStaticProvider(path string) (RateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rates file: %w", err)
+	}
+
+	var file ratesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rates file: %w", err)
+	}
+
+	return NewStaticProvider(file.Rates)
+}
+
+// Rate returns the listed rate of the pair or the inverse of the reverse pair.
+func (p *staticProvider) Rate(_ context.Context, from, to money.Currency) (decimal.Decimal, error) {
+	if rate, ok := p.rates[pairKey(from.Code, to.Code)]; ok {
+		return rate, nil
+	}
+
+	if rate, ok := p.rates[pairKey(to.Code, from.Code)]; ok {
+		return decimal.NewFromInt(1).Div(rate), nil
+	}
+
+	return decimal.Zero, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+}
+
+func pairKey(from, to string) string {
+	return strings.ToUpper(from) + "/" + strings.ToUpper(to)
+}
@@ -0,0 +1,119 @@
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	rub = money.Currency{Code: "RUB", Numeric: "643", Exponent: 2}
+	usd = money.Currency{Code: "USD", Numeric: "840", Exponent: 2}
+)
+
+func TestStaticProviderRate(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewStaticProvider(map[string]string{
+		"rub/algo": "0.0625",
+	})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name         string
+		from, to     money.Currency
+		expectedRate string
+		expectedErr  error
+	}{
+		{
+			name:         "Listed pair",
+			from:         rub,
+			to:           money.ALGO,
+			expectedRate: "0.0625",
+		},
+		{
+			name:         "Reverse pair",
+			from:         money.ALGO,
+			to:           rub,
+			expectedRate: "16",
+		},
+		{
+			name:        "Unknown pair",
+			from:        usd,
+			to:          money.ALGO,
+			expectedErr: ErrRateNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rate, err := provider.Rate(context.Background(), testcase.from, testcase.to)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr == nil {
+				assert.True(t, decimal.RequireFromString(testcase.expectedRate).Equal(rate), rate.String())
+			}
+		})
+	}
+}
+
+func TestNewStaticProvider(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name  string
+		rates map[string]string
+	}{
+		{
+			name:  "Pair without separator",
+			rates: map[string]string{"RUBALGO": "0.0625"},
+		},
+		{
+			name:  "Rate is not a number",
+			rates: map[string]string{"RUB/ALGO": "cheap"},
+		},
+		{
+			name:  "Rate is not positive",
+			rates: map[string]string{"RUB/ALGO": "-1"},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewStaticProvider(testcase.rates)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadStaticProvider(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "rates.yml")
+	require.NoError(t, os.WriteFile(path, []byte("rates:\n  USD/ALGO: \"5.5\"\n"), 0o600))
+
+	provider, err := LoadStaticProvider(path)
+	require.NoError(t, err)
+
+	rate, err := provider.Rate(context.Background(), usd, money.ALGO)
+	require.NoError(t, err)
+	assert.Equal(t, "5.5", rate.String())
+
+	_, err = LoadStaticProvider(filepath.Join(t.TempDir(), "missing.yml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+package fx
+
+import (
+	"context"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+//go:generate mockgen -package mocks -destination mocks/quoter_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/fx Quoter
+
+const (
+	// ratePlaces is the number of decimals a quoted rate is rounded up to.
+	ratePlaces = 12
+
+	basisPoints = 10000
+)
+
+// Quoter quotes the amount of a transaction in another currency.
+type Quoter interface {
+	Quote(ctx context.Context, transaction *model.Transaction, currency string) (*model.Quote, error)
+}
+
+type quoter struct {
+	cfg      *Config
+	provider RateProvider
+	clock    clock.Clock
+}
+
+// NewQuoter creates a new instance of Quoter.
+func NewQuoter(cfg *Config, provider RateProvider, clk clock.Clock) (Quoter, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quoter{
+		cfg:      cfg,
+		provider: provider,
+		clock:    clk,
+	}, nil
+}
+
+// Quote converts the transaction amount into the currency at the provider rate with the margin and half the spread,
+// the rate is locked for the configured duration.
+func (q *quoter) Quote(ctx context.Context, transaction *model.Transaction, currency string) (*model.Quote, error) {
+	amount, err := transaction.Money()
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := money.LookupCurrency(currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if target == amount.Currency() {
+		return nil, ErrSameCurrency
+	}
+
+	midRate, err := q.provider.Rate(ctx, amount.Currency(), target)
+	if err != nil {
+		return nil, NewRateError("failed to get exchange rate", err)
+	}
+
+	if !midRate.IsPositive() {
+		return nil, NewRateError("failed to get exchange rate", ErrInvalidRate)
+	}
+
+	rate := midRate.Mul(q.markup()).RoundUp(ratePlaces)
+
+	converted, err := amount.Convert(target, rate)
+	if err != nil {
+		return nil, err
+	}
+
+	now := q.clock.NowUTC()
+
+	return &model.Quote{
+		TransactionID: transaction.ID,
+		Currency:      target.Code,
+		Amount:        converted.Amount(),
+		Rate:          rate.String(),
+		MidRate:       midRate.String(),
+		ExpiresAt:     now.Add(q.cfg.LockDuration),
+		CreatedAt:     now,
+	}, nil
+}
+
+// markup is the factor applied to the mid rate, 1 + (margin + spread / 2) / 10000.
+func (q *quoter) markup() decimal.Decimal {
+	bps := decimal.NewFromInt(q.cfg.MarginBps).Add(decimal.NewFromInt(q.cfg.SpreadBps).Div(decimal.NewFromInt(2)))
+
+	return decimal.NewFromInt(1).Add(bps.Div(decimal.NewFromInt(basisPoints)))
+}
@@ -0,0 +1,139 @@
+package fx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fx"
+	mock_fx "github.com/ShmelJUJ/software-engineering/transaction/internal/fx/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	rub := money.Currency{Code: "RUB", Numeric: "643", Exponent: 2}
+	transaction := &model.Transaction{
+		ID:       "3f0c8a5e-8f2d-4a51-a1f4-2d3f1d6c7b10",
+		Currency: "RUB",
+		Amount:   150000,
+	}
+	someErr := errors.New("test err")
+
+	testcases := []struct {
+		name          string
+		transaction   *model.Transaction
+		currency      string
+		mock          func(*mock_fx.MockRateProvider)
+		expectedQuote *model.Quote
+		expectedErr   error
+	}{
+		{
+			name:        "Successfully quote with margin and half the spread",
+			transaction: transaction,
+			currency:    "algo",
+			mock: func(mrp *mock_fx.MockRateProvider) {
+				mrp.EXPECT().Rate(gomock.Any(), rub, money.ALGO).Return(decimal.RequireFromString("0.0625"), nil).Times(1)
+			},
+			expectedQuote: &model.Quote{
+				TransactionID: transaction.ID,
+				Currency:      "ALGO",
+				Amount:        94921875,
+				Rate:          "0.06328125",
+				MidRate:       "0.0625",
+				ExpiresAt:     now.Add(30 * time.Second),
+				CreatedAt:     now,
+			},
+		},
+		{
+			name:        "Same currency",
+			transaction: transaction,
+			currency:    "RUB",
+			mock:        func(*mock_fx.MockRateProvider) {},
+			expectedErr: fx.ErrSameCurrency,
+		},
+		{
+			name:        "Unknown currency",
+			transaction: transaction,
+			currency:    "DOGE",
+			mock:        func(*mock_fx.MockRateProvider) {},
+			expectedErr: money.ErrUnknownCurrency,
+		},
+		{
+			name:        "Unknown transaction currency",
+			transaction: &model.Transaction{Currency: "string", Amount: 1},
+			currency:    "ALGO",
+			mock:        func(*mock_fx.MockRateProvider) {},
+			expectedErr: money.ErrUnknownCurrency,
+		},
+		{
+			name:        "Rate not found",
+			transaction: transaction,
+			currency:    "ALGO",
+			mock: func(mrp *mock_fx.MockRateProvider) {
+				mrp.EXPECT().Rate(gomock.Any(), rub, money.ALGO).Return(decimal.Zero, fx.ErrRateNotFound).Times(1)
+			},
+			expectedErr: fx.ErrRateNotFound,
+		},
+		{
+			name:        "Provider failure",
+			transaction: transaction,
+			currency:    "ALGO",
+			mock: func(mrp *mock_fx.MockRateProvider) {
+				mrp.EXPECT().Rate(gomock.Any(), rub, money.ALGO).Return(decimal.Zero, someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+		{
+			name:        "Rate is not positive",
+			transaction: transaction,
+			currency:    "ALGO",
+			mock: func(mrp *mock_fx.MockRateProvider) {
+				mrp.EXPECT().Rate(gomock.Any(), rub, money.ALGO).Return(decimal.Zero, nil).Times(1)
+			},
+			expectedErr: fx.ErrInvalidRate,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			provider := mock_fx.NewMockRateProvider(mockCtrl)
+			testcase.mock(provider)
+
+			clk := mock_clock.NewMockClock(mockCtrl)
+			clk.EXPECT().NowUTC().Return(now).AnyTimes()
+
+			quoter, err := fx.NewQuoter(&fx.Config{
+				LockDuration: 30 * time.Second,
+				MarginBps:    100,
+				SpreadBps:    50,
+			}, provider, clk)
+			require.NoError(t, err)
+
+			quote, err := quoter.Quote(context.Background(), testcase.transaction, testcase.currency)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedQuote, quote)
+
+			if testcase.expectedErr == fx.ErrRateNotFound {
+				var rateErr *fx.RateError
+				assert.ErrorAs(t, err, &rateErr)
+			}
+		})
+	}
+}
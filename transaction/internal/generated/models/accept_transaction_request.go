@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,6 +20,18 @@ import (
 // swagger:model AcceptTransactionRequest
 type AcceptTransactionRequest struct {
 
+	// How the payer confirms a high-value transaction.
+	// Enum: [code signature]
+	ConfirmationMethod *string `json:"confirmation_method,omitempty"`
+
+	// Time to execute the accepted transaction at, like the due date of a rent. The transaction stays scheduled until then and can still be canceled. A time in the past executes it right away.
+	// Format: date-time
+	ExecuteAt strfmt.DateTime `json:"execute_at,omitempty"`
+
+	// Signed payload scanned from the transaction QR code, it can be used only once.
+	// Required: true
+	QrPayload *string `json:"qr_payload"`
+
 	// sender
 	// Required: true
 	Sender *AcceptTransactionUserRequest `json:"sender"`
@@ -28,6 +41,18 @@ type AcceptTransactionRequest struct {
 func (m *AcceptTransactionRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConfirmationMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExecuteAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateQrPayload(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSender(formats); err != nil {
 		res = append(res, err)
 	}
@@ -38,6 +63,69 @@ func (m *AcceptTransactionRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var acceptTransactionRequestTypeConfirmationMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["code","signature"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		acceptTransactionRequestTypeConfirmationMethodPropEnum = append(acceptTransactionRequestTypeConfirmationMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// AcceptTransactionRequestConfirmationMethodCode captures enum value "code"
+	AcceptTransactionRequestConfirmationMethodCode string = "code"
+
+	// AcceptTransactionRequestConfirmationMethodSignature captures enum value "signature"
+	AcceptTransactionRequestConfirmationMethodSignature string = "signature"
+)
+
+// prop value enum
+func (m *AcceptTransactionRequest) validateConfirmationMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, acceptTransactionRequestTypeConfirmationMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AcceptTransactionRequest) validateConfirmationMethod(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConfirmationMethod) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateConfirmationMethodEnum("confirmation_method", "body", *m.ConfirmationMethod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptTransactionRequest) validateExecuteAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExecuteAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("execute_at", "body", "date-time", m.ExecuteAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptTransactionRequest) validateQrPayload(formats strfmt.Registry) error {
+
+	if err := validate.Required("qr_payload", "body", m.QrPayload); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *AcceptTransactionRequest) validateSender(formats strfmt.Registry) error {
 
 	if err := validate.Required("sender", "body", m.Sender); err != nil {
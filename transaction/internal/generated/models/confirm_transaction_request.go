@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ConfirmTransactionRequest confirm transaction request
+//
+// swagger:model ConfirmTransactionRequest
+type ConfirmTransactionRequest struct {
+
+	// One-time code delivered to the payer.
+	Code string `json:"code,omitempty"`
+
+	// Base64 encoded Algorand signBytes signature over the transaction hash.
+	Signature string `json:"signature,omitempty"`
+}
+
+// Validate validates this confirm transaction request
+func (m *ConfirmTransactionRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this confirm transaction request based on context it is used
+func (m *ConfirmTransactionRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConfirmTransactionRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConfirmTransactionRequest) UnmarshalBinary(b []byte) error {
+	var res ConfirmTransactionRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ConfirmationRequiredResponse confirmation required response
+//
+// swagger:model ConfirmationRequiredResponse
+type ConfirmationRequiredResponse struct {
+
+	// confirmation method
+	// Required: true
+	ConfirmationMethod *string `json:"confirmation_method"`
+
+	// expires at
+	// Required: true
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expires_at"`
+
+	// Hex encoded hash to sign with the payer wallet key for the signature method.
+	// Required: true
+	TransactionHash *string `json:"transaction_hash"`
+}
+
+// Validate validates this confirmation required response
+func (m *ConfirmationRequiredResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConfirmationMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransactionHash(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConfirmationRequiredResponse) validateConfirmationMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("confirmation_method", "body", m.ConfirmationMethod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfirmationRequiredResponse) validateExpiresAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires_at", "body", m.ExpiresAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("expires_at", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ConfirmationRequiredResponse) validateTransactionHash(formats strfmt.Registry) error {
+
+	if err := validate.Required("transaction_hash", "body", m.TransactionHash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this confirmation required response based on context it is used
+func (m *ConfirmationRequiredResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConfirmationRequiredResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConfirmationRequiredResponse) UnmarshalBinary(b []byte) error {
+	var res ConfirmationRequiredResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
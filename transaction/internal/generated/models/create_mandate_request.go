@@ -0,0 +1,305 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateMandateRequest create mandate request
+//
+// swagger:model CreateMandateRequest
+type CreateMandateRequest struct {
+
+	// The mandate is completed once the next charge would fall after it.
+	// Required: true
+	// Format: date-time
+	EndsAt *strfmt.DateTime `json:"ends_at"`
+
+	// interval
+	// Required: true
+	// Enum: [day week month year]
+	Interval *string `json:"interval"`
+
+	// Number of intervals between charges.
+	// Maximum: 365
+	// Minimum: 1
+	IntervalCount int32 `json:"interval_count,omitempty"`
+
+	// money info
+	// Required: true
+	MoneyInfo *MoneyInfo `json:"money_info"`
+
+	// Wallet of the authenticated payer the mandate is charged from.
+	// Required: true
+	// Format: uuid
+	PayerWalletID *strfmt.UUID `json:"payer_wallet_id"`
+
+	// receiver
+	// Required: true
+	Receiver *CreateTransactionUserRequest `json:"receiver"`
+
+	// Date of the first charge, the mandate is charged right away when it is omitted or in the past.
+	// Format: date-time
+	StartsAt strfmt.DateTime `json:"starts_at,omitempty"`
+}
+
+// Validate validates this create mandate request
+func (m *CreateMandateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInterval(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIntervalCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMoneyInfo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePayerWalletID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceiver(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateMandateRequest) validateEndsAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("ends_at", "body", m.EndsAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("ends_at", "body", "date-time", m.EndsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var createMandateRequestTypeIntervalPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["day","week","month","year"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createMandateRequestTypeIntervalPropEnum = append(createMandateRequestTypeIntervalPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateMandateRequestIntervalDay captures enum value "day"
+	CreateMandateRequestIntervalDay string = "day"
+
+	// CreateMandateRequestIntervalWeek captures enum value "week"
+	CreateMandateRequestIntervalWeek string = "week"
+
+	// CreateMandateRequestIntervalMonth captures enum value "month"
+	CreateMandateRequestIntervalMonth string = "month"
+
+	// CreateMandateRequestIntervalYear captures enum value "year"
+	CreateMandateRequestIntervalYear string = "year"
+)
+
+// prop value enum
+func (m *CreateMandateRequest) validateIntervalEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, createMandateRequestTypeIntervalPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CreateMandateRequest) validateInterval(formats strfmt.Registry) error {
+
+	if err := validate.Required("interval", "body", m.Interval); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateIntervalEnum("interval", "body", *m.Interval); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateMandateRequest) validateIntervalCount(formats strfmt.Registry) error {
+	if swag.IsZero(m.IntervalCount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("interval_count", "body", int64(m.IntervalCount), 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("interval_count", "body", int64(m.IntervalCount), 365, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateMandateRequest) validateMoneyInfo(formats strfmt.Registry) error {
+
+	if err := validate.Required("money_info", "body", m.MoneyInfo); err != nil {
+		return err
+	}
+
+	if m.MoneyInfo != nil {
+		if err := m.MoneyInfo.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("money_info")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("money_info")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateMandateRequest) validatePayerWalletID(formats strfmt.Registry) error {
+
+	if err := validate.Required("payer_wallet_id", "body", m.PayerWalletID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("payer_wallet_id", "body", "uuid", m.PayerWalletID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateMandateRequest) validateReceiver(formats strfmt.Registry) error {
+
+	if err := validate.Required("receiver", "body", m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Receiver != nil {
+		if err := m.Receiver.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateMandateRequest) validateStartsAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartsAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("starts_at", "body", "date-time", m.StartsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create mandate request based on the context it is used
+func (m *CreateMandateRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMoneyInfo(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReceiver(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateMandateRequest) contextValidateMoneyInfo(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MoneyInfo != nil {
+
+		if err := m.MoneyInfo.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("money_info")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("money_info")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateMandateRequest) contextValidateReceiver(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Receiver != nil {
+
+		if err := m.Receiver.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateMandateRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateMandateRequest) UnmarshalBinary(b []byte) error {
+	var res CreateMandateRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
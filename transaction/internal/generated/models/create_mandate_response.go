@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateMandateResponse create mandate response
+//
+// swagger:model CreateMandateResponse
+type CreateMandateResponse struct {
+
+	// mandate id
+	// Required: true
+	// Format: uuid
+	MandateID *strfmt.UUID `json:"mandate_id"`
+}
+
+// Validate validates this create mandate response
+func (m *CreateMandateResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMandateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateMandateResponse) validateMandateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("mandate_id", "body", m.MandateID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("mandate_id", "body", "uuid", m.MandateID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create mandate response based on context it is used
+func (m *CreateMandateResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateMandateResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateMandateResponse) UnmarshalBinary(b []byte) error {
+	var res CreateMandateResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
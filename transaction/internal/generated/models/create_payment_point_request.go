@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreatePaymentPointRequest create payment point request
+//
+// swagger:model CreatePaymentPointRequest
+type CreatePaymentPointRequest struct {
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// max amount
+	// Minimum: 1
+	MaxAmount *int64 `json:"max_amount,omitempty"`
+
+	// method
+	// Required: true
+	Method *string `json:"method"`
+
+	// min amount
+	// Minimum: 1
+	MinAmount *int64 `json:"min_amount,omitempty"`
+
+	// receiver
+	// Required: true
+	Receiver *CreateTransactionUserRequest `json:"receiver"`
+}
+
+// Validate validates this create payment point request
+func (m *CreatePaymentPointRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceiver(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreatePaymentPointRequest) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreatePaymentPointRequest) validateMaxAmount(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxAmount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_amount", "body", *m.MaxAmount, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreatePaymentPointRequest) validateMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("method", "body", m.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreatePaymentPointRequest) validateMinAmount(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinAmount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_amount", "body", *m.MinAmount, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreatePaymentPointRequest) validateReceiver(formats strfmt.Registry) error {
+
+	if err := validate.Required("receiver", "body", m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Receiver != nil {
+		if err := m.Receiver.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create payment point request based on the context it is used
+func (m *CreatePaymentPointRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReceiver(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreatePaymentPointRequest) contextValidateReceiver(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Receiver != nil {
+
+		if err := m.Receiver.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreatePaymentPointRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreatePaymentPointRequest) UnmarshalBinary(b []byte) error {
+	var res CreatePaymentPointRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
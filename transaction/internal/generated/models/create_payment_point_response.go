@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreatePaymentPointResponse create payment point response
+//
+// swagger:model CreatePaymentPointResponse
+type CreatePaymentPointResponse struct {
+
+	// payment point id
+	// Required: true
+	// Format: uuid
+	PaymentPointID *strfmt.UUID `json:"payment_point_id"`
+}
+
+// Validate validates this create payment point response
+func (m *CreatePaymentPointResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePaymentPointID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreatePaymentPointResponse) validatePaymentPointID(formats strfmt.Registry) error {
+
+	if err := validate.Required("payment_point_id", "body", m.PaymentPointID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("payment_point_id", "body", "uuid", m.PaymentPointID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create payment point response based on context it is used
+func (m *CreatePaymentPointResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreatePaymentPointResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreatePaymentPointResponse) UnmarshalBinary(b []byte) error {
+	var res CreatePaymentPointResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
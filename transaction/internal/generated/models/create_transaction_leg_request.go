@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateTransactionLegRequest create transaction leg request
+//
+// swagger:model CreateTransactionLegRequest
+type CreateTransactionLegRequest struct {
+
+	// Fixed amount in the minor unit of the transaction currency, exclusive with percentage.
+	// Minimum: 1
+	Amount int64 `json:"amount,omitempty"`
+
+	// Percentage of the transaction amount with up to two decimals, like "2.5", exclusive with amount.
+	// Pattern: ^[0-9]{1,3}(\.[0-9]{1,2})?$
+	Percentage string `json:"percentage,omitempty"`
+
+	// receiver
+	// Required: true
+	Receiver *CreateTransactionUserRequest `json:"receiver"`
+}
+
+// Validate validates this create transaction leg request
+func (m *CreateTransactionLegRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePercentage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceiver(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateTransactionLegRequest) validateAmount(formats strfmt.Registry) error {
+	if swag.IsZero(m.Amount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("amount", "body", m.Amount, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateTransactionLegRequest) validatePercentage(formats strfmt.Registry) error {
+	if swag.IsZero(m.Percentage) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("percentage", "body", m.Percentage, `^[0-9]{1,3}(\.[0-9]{1,2})?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateTransactionLegRequest) validateReceiver(formats strfmt.Registry) error {
+
+	if err := validate.Required("receiver", "body", m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Receiver != nil {
+		if err := m.Receiver.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create transaction leg request based on the context it is used
+func (m *CreateTransactionLegRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReceiver(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateTransactionLegRequest) contextValidateReceiver(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Receiver != nil {
+
+		if err := m.Receiver.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateTransactionLegRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateTransactionLegRequest) UnmarshalBinary(b []byte) error {
+	var res CreateTransactionLegRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -7,6 +7,8 @@ package models
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,6 +21,19 @@ import (
 // swagger:model CreateTransactionRequest
 type CreateTransactionRequest struct {
 
+	// With manual, the accepted amount is only held on the payer side until the merchant captures or voids it, the hold is voided automatically once it times out. Group transactions are captured automatically.
+	// Enum: [automatic manual]
+	CaptureMethod *string `json:"capture_method,omitempty"`
+
+	// Seconds the transaction can be accepted for, the service default is used when omitted.
+	// Maximum: 2.592e+06
+	// Minimum: 60
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+
+	// Additional receivers to split the transaction with, the receiver gets the remainder of the amount.
+	// Max Items: 15
+	Legs []*CreateTransactionLegRequest `json:"legs"`
+
 	// money info
 	// Required: true
 	MoneyInfo *MoneyInfo `json:"money_info"`
@@ -26,12 +41,32 @@ type CreateTransactionRequest struct {
 	// receiver
 	// Required: true
 	Receiver *CreateTransactionUserRequest `json:"receiver"`
+
+	// Shares of a group transaction, each accepted and paid by its own payer. They must add up to the amount.
+	// Max Items: 20
+	// Min Items: 2
+	Shares []*CreateTransactionShareRequest `json:"shares"`
+
+	// Pays the transaction from any Algorand wallet outside of the platform. The transaction is processed right away, its QR code carries an algorand:// payment URI and the receiver address is watched for the payment until the transaction expires. Only unsplit ALGO transactions paid with algorand can be watched.
+	Watch *bool `json:"watch,omitempty"`
 }
 
 // Validate validates this create transaction request
 func (m *CreateTransactionRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCaptureMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLegs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMoneyInfo(formats); err != nil {
 		res = append(res, err)
 	}
@@ -40,12 +75,106 @@ func (m *CreateTransactionRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateShares(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+var createTransactionRequestTypeCaptureMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["automatic","manual"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createTransactionRequestTypeCaptureMethodPropEnum = append(createTransactionRequestTypeCaptureMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateTransactionRequestCaptureMethodAutomatic captures enum value "automatic"
+	CreateTransactionRequestCaptureMethodAutomatic string = "automatic"
+
+	// CreateTransactionRequestCaptureMethodManual captures enum value "manual"
+	CreateTransactionRequestCaptureMethodManual string = "manual"
+)
+
+// prop value enum
+func (m *CreateTransactionRequest) validateCaptureMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, createTransactionRequestTypeCaptureMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CreateTransactionRequest) validateCaptureMethod(formats strfmt.Registry) error {
+	if swag.IsZero(m.CaptureMethod) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCaptureMethodEnum("capture_method", "body", *m.CaptureMethod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateTransactionRequest) validateExpiresIn(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresIn) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("expires_in", "body", m.ExpiresIn, 60, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("expires_in", "body", m.ExpiresIn, 2.592e+06, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateTransactionRequest) validateLegs(formats strfmt.Registry) error {
+	if swag.IsZero(m.Legs) { // not required
+		return nil
+	}
+
+	iLegsSize := int64(len(m.Legs))
+
+	if err := validate.MaxItems("legs", "body", iLegsSize, 15); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Legs); i++ {
+		if swag.IsZero(m.Legs[i]) { // not required
+			continue
+		}
+
+		if m.Legs[i] != nil {
+			if err := m.Legs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("legs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("legs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CreateTransactionRequest) validateMoneyInfo(formats strfmt.Registry) error {
 
 	if err := validate.Required("money_info", "body", m.MoneyInfo); err != nil {
@@ -86,10 +215,50 @@ func (m *CreateTransactionRequest) validateReceiver(formats strfmt.Registry) err
 	return nil
 }
 
+func (m *CreateTransactionRequest) validateShares(formats strfmt.Registry) error {
+	if swag.IsZero(m.Shares) { // not required
+		return nil
+	}
+
+	iSharesSize := int64(len(m.Shares))
+
+	if err := validate.MinItems("shares", "body", iSharesSize, 2); err != nil {
+		return err
+	}
+
+	if err := validate.MaxItems("shares", "body", iSharesSize, 20); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Shares); i++ {
+		if swag.IsZero(m.Shares[i]) { // not required
+			continue
+		}
+
+		if m.Shares[i] != nil {
+			if err := m.Shares[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shares" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shares" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this create transaction request based on the context it is used
 func (m *CreateTransactionRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateLegs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMoneyInfo(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -98,12 +267,41 @@ func (m *CreateTransactionRequest) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateShares(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *CreateTransactionRequest) contextValidateLegs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Legs); i++ {
+
+		if m.Legs[i] != nil {
+
+			if swag.IsZero(m.Legs[i]) { // not required
+				return nil
+			}
+
+			if err := m.Legs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("legs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("legs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CreateTransactionRequest) contextValidateMoneyInfo(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.MoneyInfo != nil {
@@ -138,6 +336,31 @@ func (m *CreateTransactionRequest) contextValidateReceiver(ctx context.Context,
 	return nil
 }
 
+func (m *CreateTransactionRequest) contextValidateShares(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Shares); i++ {
+
+		if m.Shares[i] != nil {
+
+			if swag.IsZero(m.Shares[i]) { // not required
+				return nil
+			}
+
+			if err := m.Shares[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shares" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shares" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *CreateTransactionRequest) MarshalBinary() ([]byte, error) {
 	if m == nil {
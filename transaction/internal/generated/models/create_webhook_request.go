@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateWebhookRequest create webhook request
+//
+// swagger:model CreateWebhookRequest
+type CreateWebhookRequest struct {
+
+	// secret
+	// Required: true
+	// Max Length: 256
+	// Min Length: 16
+	Secret *string `json:"secret"`
+
+	// url
+	// Required: true
+	// Pattern: ^https?://
+	// Format: uri
+	URL *strfmt.URI `json:"url"`
+}
+
+// Validate validates this create webhook request
+func (m *CreateWebhookRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateWebhookRequest) validateSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("secret", "body", m.Secret); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("secret", "body", *m.Secret, 16); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("secret", "body", *m.Secret, 256); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateWebhookRequest) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("url", "body", m.URL.String(), `^https?://`); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("url", "body", "uri", m.URL.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create webhook request based on context it is used
+func (m *CreateWebhookRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateWebhookRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateWebhookRequest) UnmarshalBinary(b []byte) error {
+	var res CreateWebhookRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
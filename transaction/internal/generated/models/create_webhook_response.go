@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateWebhookResponse create webhook response
+//
+// swagger:model CreateWebhookResponse
+type CreateWebhookResponse struct {
+
+	// webhook id
+	// Required: true
+	// Format: uuid
+	WebhookID *strfmt.UUID `json:"webhook_id"`
+}
+
+// Validate validates this create webhook response
+func (m *CreateWebhookResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateWebhookID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateWebhookResponse) validateWebhookID(formats strfmt.Registry) error {
+
+	if err := validate.Required("webhook_id", "body", m.WebhookID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("webhook_id", "body", "uuid", m.WebhookID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create webhook response based on context it is used
+func (m *CreateWebhookResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateWebhookResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateWebhookResponse) UnmarshalBinary(b []byte) error {
+	var res CreateWebhookResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
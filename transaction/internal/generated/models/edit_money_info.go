@@ -17,10 +17,10 @@ import (
 // swagger:model EditMoneyInfo
 type EditMoneyInfo struct {
 
-	// amount
+	// Amount in the minor unit of the currency.
 	Amount int64 `json:"amount,omitempty"`
 
-	// currency
+	// ISO 4217 alphabetic code, or ALGO for Algorand. Unknown codes are rejected.
 	Currency string `json:"currency,omitempty"`
 
 	// method
@@ -0,0 +1,240 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FeePlanResponse fee plan response
+//
+// swagger:model FeePlanResponse
+type FeePlanResponse struct {
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// fixed amount
+	// Required: true
+	FixedAmount *int64 `json:"fixed_amount"`
+
+	// max fee
+	MaxFee *int64 `json:"max_fee,omitempty"`
+
+	// merchant id
+	// Required: true
+	// Format: uuid
+	MerchantID *strfmt.UUID `json:"merchant_id"`
+
+	// method
+	// Required: true
+	Method *string `json:"method"`
+
+	// min fee
+	MinFee *int64 `json:"min_fee,omitempty"`
+
+	// percentage
+	// Required: true
+	Percentage *string `json:"percentage"`
+
+	// tiers
+	// Required: true
+	Tiers []*FeePlanTier `json:"tiers"`
+
+	// updated at
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+}
+
+// Validate validates this fee plan response
+func (m *FeePlanResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFixedAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMerchantID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePercentage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTiers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FeePlanResponse) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FeePlanResponse) validateFixedAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("fixed_amount", "body", m.FixedAmount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FeePlanResponse) validateMerchantID(formats strfmt.Registry) error {
+
+	if err := validate.Required("merchant_id", "body", m.MerchantID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("merchant_id", "body", "uuid", m.MerchantID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FeePlanResponse) validateMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("method", "body", m.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FeePlanResponse) validatePercentage(formats strfmt.Registry) error {
+
+	if err := validate.Required("percentage", "body", m.Percentage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FeePlanResponse) validateTiers(formats strfmt.Registry) error {
+
+	if err := validate.Required("tiers", "body", m.Tiers); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Tiers); i++ {
+		if swag.IsZero(m.Tiers[i]) { // not required
+			continue
+		}
+
+		if m.Tiers[i] != nil {
+			if err := m.Tiers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tiers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tiers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *FeePlanResponse) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this fee plan response based on the context it is used
+func (m *FeePlanResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTiers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FeePlanResponse) contextValidateTiers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Tiers); i++ {
+
+		if m.Tiers[i] != nil {
+
+			if swag.IsZero(m.Tiers[i]) { // not required
+				return nil
+			}
+
+			if err := m.Tiers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tiers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tiers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FeePlanResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FeePlanResponse) UnmarshalBinary(b []byte) error {
+	var res FeePlanResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
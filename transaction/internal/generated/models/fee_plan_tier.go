@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FeePlanTier fee plan tier
+//
+// swagger:model FeePlanTier
+type FeePlanTier struct {
+
+	// Volume of the succeeded transactions of the merchant in the current month, in the minor unit of the currency.
+	// Required: true
+	// Minimum: 1
+	FromVolume *int64 `json:"from_volume"`
+
+	// Percentage of the transaction amount with up to two decimals, like "1.5".
+	// Required: true
+	Percentage *string `json:"percentage"`
+}
+
+// Validate validates this fee plan tier
+func (m *FeePlanTier) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFromVolume(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePercentage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FeePlanTier) validateFromVolume(formats strfmt.Registry) error {
+
+	if err := validate.Required("from_volume", "body", m.FromVolume); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("from_volume", "body", *m.FromVolume, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FeePlanTier) validatePercentage(formats strfmt.Registry) error {
+
+	if err := validate.Required("percentage", "body", m.Percentage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this fee plan tier based on context it is used
+func (m *FeePlanTier) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FeePlanTier) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FeePlanTier) UnmarshalBinary(b []byte) error {
+	var res FeePlanTier
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
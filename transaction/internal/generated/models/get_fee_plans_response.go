@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetFeePlansResponse get fee plans response
+//
+// swagger:model GetFeePlansResponse
+type GetFeePlansResponse struct {
+
+	// plans
+	// Required: true
+	Plans []*FeePlanResponse `json:"plans"`
+}
+
+// Validate validates this get fee plans response
+func (m *GetFeePlansResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePlans(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetFeePlansResponse) validatePlans(formats strfmt.Registry) error {
+
+	if err := validate.Required("plans", "body", m.Plans); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Plans); i++ {
+		if swag.IsZero(m.Plans[i]) { // not required
+			continue
+		}
+
+		if m.Plans[i] != nil {
+			if err := m.Plans[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("plans" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("plans" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get fee plans response based on the context it is used
+func (m *GetFeePlansResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePlans(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetFeePlansResponse) contextValidatePlans(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Plans); i++ {
+
+		if m.Plans[i] != nil {
+
+			if swag.IsZero(m.Plans[i]) { // not required
+				return nil
+			}
+
+			if err := m.Plans[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("plans" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("plans" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetFeePlansResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetFeePlansResponse) UnmarshalBinary(b []byte) error {
+	var res GetFeePlansResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
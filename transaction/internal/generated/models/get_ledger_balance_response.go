@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetLedgerBalanceResponse get ledger balance response
+//
+// swagger:model GetLedgerBalanceResponse
+type GetLedgerBalanceResponse struct {
+
+	// account id
+	// Required: true
+	AccountID *string `json:"account_id"`
+
+	// balances
+	// Required: true
+	Balances []*LedgerBalanceResponse `json:"balances"`
+
+	// kind
+	// Required: true
+	// Enum: [wallet fees clearing]
+	Kind *string `json:"kind"`
+}
+
+// Validate validates this get ledger balance response
+func (m *GetLedgerBalanceResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAccountID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateBalances(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetLedgerBalanceResponse) validateAccountID(formats strfmt.Registry) error {
+
+	if err := validate.Required("account_id", "body", m.AccountID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetLedgerBalanceResponse) validateBalances(formats strfmt.Registry) error {
+
+	if err := validate.Required("balances", "body", m.Balances); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Balances); i++ {
+		if swag.IsZero(m.Balances[i]) { // not required
+			continue
+		}
+
+		if m.Balances[i] != nil {
+			if err := m.Balances[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("balances" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("balances" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var getLedgerBalanceResponseTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["wallet","fees","clearing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getLedgerBalanceResponseTypeKindPropEnum = append(getLedgerBalanceResponseTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// GetLedgerBalanceResponseKindWallet captures enum value "wallet"
+	GetLedgerBalanceResponseKindWallet string = "wallet"
+
+	// GetLedgerBalanceResponseKindFees captures enum value "fees"
+	GetLedgerBalanceResponseKindFees string = "fees"
+
+	// GetLedgerBalanceResponseKindClearing captures enum value "clearing"
+	GetLedgerBalanceResponseKindClearing string = "clearing"
+)
+
+// prop value enum
+func (m *GetLedgerBalanceResponse) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, getLedgerBalanceResponseTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *GetLedgerBalanceResponse) validateKind(formats strfmt.Registry) error {
+
+	if err := validate.Required("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", *m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get ledger balance response based on the context it is used
+func (m *GetLedgerBalanceResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateBalances(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetLedgerBalanceResponse) contextValidateBalances(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Balances); i++ {
+
+		if m.Balances[i] != nil {
+
+			if swag.IsZero(m.Balances[i]) { // not required
+				return nil
+			}
+
+			if err := m.Balances[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("balances" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("balances" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetLedgerBalanceResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetLedgerBalanceResponse) UnmarshalBinary(b []byte) error {
+	var res GetLedgerBalanceResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
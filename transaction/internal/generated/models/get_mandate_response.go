@@ -0,0 +1,454 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetMandateResponse get mandate response
+//
+// swagger:model GetMandateResponse
+type GetMandateResponse struct {
+
+	// Amount of every charge in the major unit of the currency.
+	AmountDecimal string `json:"amount_decimal,omitempty"`
+
+	// Failed charges of the current period.
+	// Required: true
+	Attempts *int32 `json:"attempts"`
+
+	// Transaction of the charge that is being paid.
+	// Format: uuid
+	ChargeTransactionID *strfmt.UUID `json:"charge_transaction_id,omitempty"`
+
+	// ends at
+	// Required: true
+	// Format: date-time
+	EndsAt *strfmt.DateTime `json:"ends_at"`
+
+	// interval
+	// Required: true
+	Interval *string `json:"interval"`
+
+	// interval count
+	// Required: true
+	IntervalCount *int32 `json:"interval_count"`
+
+	// Reason of the last failed charge.
+	LastError string `json:"last_error,omitempty"`
+
+	// mandate id
+	// Required: true
+	// Format: uuid
+	MandateID *strfmt.UUID `json:"mandate_id"`
+
+	// money info
+	// Required: true
+	MoneyInfo *MoneyInfo `json:"money_info"`
+
+	// next charge at
+	// Format: date-time
+	NextChargeAt *strfmt.DateTime `json:"next_charge_at,omitempty"`
+
+	// payer
+	// Required: true
+	Payer *GetTransactionUserResponse `json:"payer"`
+
+	// Billing date of the period that is charged next.
+	// Format: date-time
+	PeriodStart strfmt.DateTime `json:"period_start,omitempty"`
+
+	// receiver
+	// Required: true
+	Receiver *GetTransactionUserResponse `json:"receiver"`
+
+	// starts at
+	// Required: true
+	// Format: date-time
+	StartsAt *strfmt.DateTime `json:"starts_at"`
+
+	// A past due mandate retries the failed charge, an unpaid one ran out of retries and waits to be resumed.
+	// Required: true
+	// Enum: [active past_due paused unpaid canceled completed]
+	Status *string `json:"status"`
+}
+
+// Validate validates this get mandate response
+func (m *GetMandateResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttempts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChargeTransactionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEndsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInterval(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIntervalCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMandateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMoneyInfo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextChargeAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePayer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePeriodStart(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceiver(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartsAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetMandateResponse) validateAttempts(formats strfmt.Registry) error {
+
+	if err := validate.Required("attempts", "body", m.Attempts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateChargeTransactionID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ChargeTransactionID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("charge_transaction_id", "body", "uuid", m.ChargeTransactionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateEndsAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("ends_at", "body", m.EndsAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("ends_at", "body", "date-time", m.EndsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateInterval(formats strfmt.Registry) error {
+
+	if err := validate.Required("interval", "body", m.Interval); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateIntervalCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("interval_count", "body", m.IntervalCount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateMandateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("mandate_id", "body", m.MandateID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("mandate_id", "body", "uuid", m.MandateID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateMoneyInfo(formats strfmt.Registry) error {
+
+	if err := validate.Required("money_info", "body", m.MoneyInfo); err != nil {
+		return err
+	}
+
+	if m.MoneyInfo != nil {
+		if err := m.MoneyInfo.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("money_info")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("money_info")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateNextChargeAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextChargeAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_charge_at", "body", "date-time", m.NextChargeAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validatePayer(formats strfmt.Registry) error {
+
+	if err := validate.Required("payer", "body", m.Payer); err != nil {
+		return err
+	}
+
+	if m.Payer != nil {
+		if err := m.Payer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("payer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("payer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validatePeriodStart(formats strfmt.Registry) error {
+	if swag.IsZero(m.PeriodStart) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("period_start", "body", "date-time", m.PeriodStart.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateReceiver(formats strfmt.Registry) error {
+
+	if err := validate.Required("receiver", "body", m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Receiver != nil {
+		if err := m.Receiver.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) validateStartsAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("starts_at", "body", m.StartsAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("starts_at", "body", "date-time", m.StartsAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getMandateResponseTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["active","past_due","paused","unpaid","canceled","completed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getMandateResponseTypeStatusPropEnum = append(getMandateResponseTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetMandateResponseStatusActive captures enum value "active"
+	GetMandateResponseStatusActive string = "active"
+
+	// GetMandateResponseStatusPastDue captures enum value "past_due"
+	GetMandateResponseStatusPastDue string = "past_due"
+
+	// GetMandateResponseStatusPaused captures enum value "paused"
+	GetMandateResponseStatusPaused string = "paused"
+
+	// GetMandateResponseStatusUnpaid captures enum value "unpaid"
+	GetMandateResponseStatusUnpaid string = "unpaid"
+
+	// GetMandateResponseStatusCanceled captures enum value "canceled"
+	GetMandateResponseStatusCanceled string = "canceled"
+
+	// GetMandateResponseStatusCompleted captures enum value "completed"
+	GetMandateResponseStatusCompleted string = "completed"
+)
+
+// prop value enum
+func (m *GetMandateResponse) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, getMandateResponseTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *GetMandateResponse) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get mandate response based on the context it is used
+func (m *GetMandateResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMoneyInfo(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePayer(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateReceiver(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetMandateResponse) contextValidateMoneyInfo(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MoneyInfo != nil {
+
+		if err := m.MoneyInfo.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("money_info")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("money_info")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) contextValidatePayer(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Payer != nil {
+
+		if err := m.Payer.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("payer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("payer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *GetMandateResponse) contextValidateReceiver(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Receiver != nil {
+
+		if err := m.Receiver.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetMandateResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetMandateResponse) UnmarshalBinary(b []byte) error {
+	var res GetMandateResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
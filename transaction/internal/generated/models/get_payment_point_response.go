@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetPaymentPointResponse get payment point response
+//
+// swagger:model GetPaymentPointResponse
+type GetPaymentPointResponse struct {
+
+	// active
+	// Required: true
+	Active *bool `json:"active"`
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// max amount
+	MaxAmount *int64 `json:"max_amount,omitempty"`
+
+	// Maximal amount in the major unit of the currency.
+	MaxAmountDecimal string `json:"max_amount_decimal,omitempty"`
+
+	// method
+	// Required: true
+	Method *string `json:"method"`
+
+	// min amount
+	MinAmount *int64 `json:"min_amount,omitempty"`
+
+	// Minimal amount in the major unit of the currency.
+	MinAmountDecimal string `json:"min_amount_decimal,omitempty"`
+
+	// receiver
+	// Required: true
+	Receiver *GetTransactionUserResponse `json:"receiver"`
+}
+
+// Validate validates this get payment point response
+func (m *GetPaymentPointResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateActive(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceiver(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetPaymentPointResponse) validateActive(formats strfmt.Registry) error {
+
+	if err := validate.Required("active", "body", m.Active); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetPaymentPointResponse) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetPaymentPointResponse) validateMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("method", "body", m.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetPaymentPointResponse) validateReceiver(formats strfmt.Registry) error {
+
+	if err := validate.Required("receiver", "body", m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Receiver != nil {
+		if err := m.Receiver.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get payment point response based on the context it is used
+func (m *GetPaymentPointResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReceiver(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetPaymentPointResponse) contextValidateReceiver(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Receiver != nil {
+
+		if err := m.Receiver.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("receiver")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("receiver")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetPaymentPointResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetPaymentPointResponse) UnmarshalBinary(b []byte) error {
+	var res GetPaymentPointResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -20,18 +21,52 @@ import (
 // swagger:model GetTransactionResponse
 type GetTransactionResponse struct {
 
-	// amount
+	// Amount in the minor unit of the currency, cents for USD and microAlgos for ALGO.
 	// Required: true
 	Amount *int64 `json:"amount"`
 
-	// currency
+	// Amount in the major unit of the currency with the currency number of decimals, like "12.34".
+	AmountDecimal string `json:"amount_decimal,omitempty"`
+
+	// Time the hold of an authorized transaction is voided at unless it is captured.
+	// Format: date-time
+	AuthorizedUntil strfmt.DateTime `json:"authorized_until,omitempty"`
+
+	// capture method
+	// Enum: [automatic manual]
+	CaptureMethod string `json:"capture_method,omitempty"`
+
+	// Amount taken out of the hold of a manually captured transaction, in the minor unit of the currency.
+	CapturedAmount int64 `json:"captured_amount,omitempty"`
+
+	// ISO 4217 alphabetic code, or ALGO for Algorand.
 	// Required: true
 	Currency *string `json:"currency"`
 
+	// Time a scheduled transaction is executed at.
+	// Format: date-time
+	ExecuteAt strfmt.DateTime `json:"execute_at,omitempty"`
+
+	// Time after which the transaction can no longer be accepted.
+	// Format: date-time
+	ExpiresAt strfmt.DateTime `json:"expires_at,omitempty"`
+
+	// Fee deducted from what the receiver gets, in the minor unit of the currency. Set when the transaction is accepted.
+	Fee int64 `json:"fee,omitempty"`
+
+	// Fee in the major unit of the currency.
+	FeeDecimal string `json:"fee_decimal,omitempty"`
+
+	// Receivers the transaction is split across, the first leg is the receiver. Empty when the transaction is not split.
+	Legs []*GetTransactionLegResponse `json:"legs"`
+
 	// method
 	// Required: true
 	Method *string `json:"method"`
 
+	// quote
+	Quote *TransactionQuote `json:"quote,omitempty"`
+
 	// receiver
 	// Required: true
 	Receiver *GetTransactionUserResponse `json:"receiver"`
@@ -39,10 +74,16 @@ type GetTransactionResponse struct {
 	// sender
 	Sender *GetTransactionUserResponse `json:"sender,omitempty"`
 
+	// Shares of a group transaction in the order payers take them. Empty when the transaction has a single payer.
+	Shares []*GetTransactionShareResponse `json:"shares"`
+
 	// status
 	// Required: true
-	// Enum: [created processed canceled failed succeeded]
+	// Enum: [created processed canceled failed succeeded expired scheduled authorized voided]
 	Status *string `json:"status"`
+
+	// Whether the transaction is paid from a wallet outside of the platform, by watching the receiver address.
+	Watch bool `json:"watch,omitempty"`
 }
 
 // Validate validates this get transaction response
@@ -53,14 +94,38 @@ func (m *GetTransactionResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAuthorizedUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCaptureMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCurrency(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateExecuteAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLegs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMethod(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateQuote(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateReceiver(formats); err != nil {
 		res = append(res, err)
 	}
@@ -69,6 +134,10 @@ func (m *GetTransactionResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateShares(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -88,6 +157,60 @@ func (m *GetTransactionResponse) validateAmount(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *GetTransactionResponse) validateAuthorizedUntil(formats strfmt.Registry) error {
+	if swag.IsZero(m.AuthorizedUntil) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("authorized_until", "body", "date-time", m.AuthorizedUntil.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getTransactionResponseTypeCaptureMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["automatic","manual"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getTransactionResponseTypeCaptureMethodPropEnum = append(getTransactionResponseTypeCaptureMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// GetTransactionResponseCaptureMethodAutomatic captures enum value "automatic"
+	GetTransactionResponseCaptureMethodAutomatic string = "automatic"
+
+	// GetTransactionResponseCaptureMethodManual captures enum value "manual"
+	GetTransactionResponseCaptureMethodManual string = "manual"
+)
+
+// prop value enum
+func (m *GetTransactionResponse) validateCaptureMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, getTransactionResponseTypeCaptureMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *GetTransactionResponse) validateCaptureMethod(formats strfmt.Registry) error {
+	if swag.IsZero(m.CaptureMethod) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCaptureMethodEnum("capture_method", "body", m.CaptureMethod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *GetTransactionResponse) validateCurrency(formats strfmt.Registry) error {
 
 	if err := validate.Required("currency", "body", m.Currency); err != nil {
@@ -97,6 +220,56 @@ func (m *GetTransactionResponse) validateCurrency(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *GetTransactionResponse) validateExecuteAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExecuteAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("execute_at", "body", "date-time", m.ExecuteAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetTransactionResponse) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expires_at", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetTransactionResponse) validateLegs(formats strfmt.Registry) error {
+	if swag.IsZero(m.Legs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Legs); i++ {
+		if swag.IsZero(m.Legs[i]) { // not required
+			continue
+		}
+
+		if m.Legs[i] != nil {
+			if err := m.Legs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("legs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("legs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *GetTransactionResponse) validateMethod(formats strfmt.Registry) error {
 
 	if err := validate.Required("method", "body", m.Method); err != nil {
@@ -106,6 +279,25 @@ func (m *GetTransactionResponse) validateMethod(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *GetTransactionResponse) validateQuote(formats strfmt.Registry) error {
+	if swag.IsZero(m.Quote) { // not required
+		return nil
+	}
+
+	if m.Quote != nil {
+		if err := m.Quote.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quote")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quote")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *GetTransactionResponse) validateReceiver(formats strfmt.Registry) error {
 
 	if err := validate.Required("receiver", "body", m.Receiver); err != nil {
@@ -145,11 +337,37 @@ func (m *GetTransactionResponse) validateSender(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *GetTransactionResponse) validateShares(formats strfmt.Registry) error {
+	if swag.IsZero(m.Shares) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Shares); i++ {
+		if swag.IsZero(m.Shares[i]) { // not required
+			continue
+		}
+
+		if m.Shares[i] != nil {
+			if err := m.Shares[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shares" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shares" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var getTransactionResponseTypeStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["created","processed","canceled","failed","succeeded"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["created","processed","canceled","failed","succeeded","expired","scheduled","authorized","voided"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -173,6 +391,18 @@ const (
 
 	// GetTransactionResponseStatusSucceeded captures enum value "succeeded"
 	GetTransactionResponseStatusSucceeded string = "succeeded"
+
+	// GetTransactionResponseStatusExpired captures enum value "expired"
+	GetTransactionResponseStatusExpired string = "expired"
+
+	// GetTransactionResponseStatusScheduled captures enum value "scheduled"
+	GetTransactionResponseStatusScheduled string = "scheduled"
+
+	// GetTransactionResponseStatusAuthorized captures enum value "authorized"
+	GetTransactionResponseStatusAuthorized string = "authorized"
+
+	// GetTransactionResponseStatusVoided captures enum value "voided"
+	GetTransactionResponseStatusVoided string = "voided"
 )
 
 // prop value enum
@@ -201,6 +431,14 @@ func (m *GetTransactionResponse) validateStatus(formats strfmt.Registry) error {
 func (m *GetTransactionResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateLegs(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateQuote(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateReceiver(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -209,12 +447,62 @@ func (m *GetTransactionResponse) ContextValidate(ctx context.Context, formats st
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateShares(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *GetTransactionResponse) contextValidateLegs(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Legs); i++ {
+
+		if m.Legs[i] != nil {
+
+			if swag.IsZero(m.Legs[i]) { // not required
+				return nil
+			}
+
+			if err := m.Legs[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("legs" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("legs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *GetTransactionResponse) contextValidateQuote(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Quote != nil {
+
+		if swag.IsZero(m.Quote) { // not required
+			return nil
+		}
+
+		if err := m.Quote.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("quote")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("quote")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *GetTransactionResponse) contextValidateReceiver(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Receiver != nil {
@@ -253,6 +541,31 @@ func (m *GetTransactionResponse) contextValidateSender(ctx context.Context, form
 	return nil
 }
 
+func (m *GetTransactionResponse) contextValidateShares(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Shares); i++ {
+
+		if m.Shares[i] != nil {
+
+			if swag.IsZero(m.Shares[i]) { // not required
+				return nil
+			}
+
+			if err := m.Shares[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("shares" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("shares" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *GetTransactionResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,220 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetTransactionShareResponse get transaction share response
+//
+// swagger:model GetTransactionShareResponse
+type GetTransactionShareResponse struct {
+
+	// Amount of the share in the minor unit of the transaction currency.
+	// Required: true
+	Amount *int64 `json:"amount"`
+
+	// Amount of the share in the major unit of the transaction currency.
+	AmountDecimal string `json:"amount_decimal,omitempty"`
+
+	// payer
+	Payer *GetTransactionUserResponse `json:"payer,omitempty"`
+
+	// share id
+	// Required: true
+	// Format: uuid
+	ShareID *strfmt.UUID `json:"share_id"`
+
+	// status
+	// Required: true
+	// Enum: [pending processed succeeded canceled refunding refunded refund_failed]
+	Status *string `json:"status"`
+}
+
+// Validate validates this get transaction share response
+func (m *GetTransactionShareResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePayer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShareID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetTransactionShareResponse) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetTransactionShareResponse) validatePayer(formats strfmt.Registry) error {
+	if swag.IsZero(m.Payer) { // not required
+		return nil
+	}
+
+	if m.Payer != nil {
+		if err := m.Payer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("payer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("payer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *GetTransactionShareResponse) validateShareID(formats strfmt.Registry) error {
+
+	if err := validate.Required("share_id", "body", m.ShareID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("share_id", "body", "uuid", m.ShareID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var getTransactionShareResponseTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","processed","succeeded","canceled","refunding","refunded","refund_failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getTransactionShareResponseTypeStatusPropEnum = append(getTransactionShareResponseTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetTransactionShareResponseStatusPending captures enum value "pending"
+	GetTransactionShareResponseStatusPending string = "pending"
+
+	// GetTransactionShareResponseStatusProcessed captures enum value "processed"
+	GetTransactionShareResponseStatusProcessed string = "processed"
+
+	// GetTransactionShareResponseStatusSucceeded captures enum value "succeeded"
+	GetTransactionShareResponseStatusSucceeded string = "succeeded"
+
+	// GetTransactionShareResponseStatusCanceled captures enum value "canceled"
+	GetTransactionShareResponseStatusCanceled string = "canceled"
+
+	// GetTransactionShareResponseStatusRefunding captures enum value "refunding"
+	GetTransactionShareResponseStatusRefunding string = "refunding"
+
+	// GetTransactionShareResponseStatusRefunded captures enum value "refunded"
+	GetTransactionShareResponseStatusRefunded string = "refunded"
+
+	// GetTransactionShareResponseStatusRefundFailed captures enum value "refund_failed"
+	GetTransactionShareResponseStatusRefundFailed string = "refund_failed"
+)
+
+// prop value enum
+func (m *GetTransactionShareResponse) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, getTransactionShareResponseTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *GetTransactionShareResponse) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get transaction share response based on the context it is used
+func (m *GetTransactionShareResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePayer(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetTransactionShareResponse) contextValidatePayer(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Payer != nil {
+
+		if swag.IsZero(m.Payer) { // not required
+			return nil
+		}
+
+		if err := m.Payer.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("payer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("payer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetTransactionShareResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetTransactionShareResponse) UnmarshalBinary(b []byte) error {
+	var res GetTransactionShareResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -22,7 +22,7 @@ type GetTransactionStatusResponse struct {
 
 	// transaction status
 	// Required: true
-	// Enum: [created processed canceled failed succeeded]
+	// Enum: [created processed canceled failed succeeded expired scheduled authorized voided]
 	TransactionStatus *string `json:"transaction_status"`
 }
 
@@ -44,7 +44,7 @@ var getTransactionStatusResponseTypeTransactionStatusPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["created","processed","canceled","failed","succeeded"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["created","processed","canceled","failed","succeeded","expired","scheduled","authorized","voided"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -68,6 +68,18 @@ const (
 
 	// GetTransactionStatusResponseTransactionStatusSucceeded captures enum value "succeeded"
 	GetTransactionStatusResponseTransactionStatusSucceeded string = "succeeded"
+
+	// GetTransactionStatusResponseTransactionStatusExpired captures enum value "expired"
+	GetTransactionStatusResponseTransactionStatusExpired string = "expired"
+
+	// GetTransactionStatusResponseTransactionStatusScheduled captures enum value "scheduled"
+	GetTransactionStatusResponseTransactionStatusScheduled string = "scheduled"
+
+	// GetTransactionStatusResponseTransactionStatusAuthorized captures enum value "authorized"
+	GetTransactionStatusResponseTransactionStatusAuthorized string = "authorized"
+
+	// GetTransactionStatusResponseTransactionStatusVoided captures enum value "voided"
+	GetTransactionStatusResponseTransactionStatusVoided string = "voided"
 )
 
 // prop value enum
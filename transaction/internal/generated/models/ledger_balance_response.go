@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LedgerBalanceResponse ledger balance response
+//
+// swagger:model LedgerBalanceResponse
+type LedgerBalanceResponse struct {
+
+	// Credits minus debits in the minor unit of the currency.
+	// Required: true
+	Balance *int64 `json:"balance"`
+
+	// Balance in the major unit of the currency.
+	BalanceDecimal string `json:"balance_decimal,omitempty"`
+
+	// Sum of the credits in the minor unit of the currency.
+	// Required: true
+	Credits *int64 `json:"credits"`
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// Sum of the debits in the minor unit of the currency.
+	// Required: true
+	Debits *int64 `json:"debits"`
+}
+
+// Validate validates this ledger balance response
+func (m *LedgerBalanceResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBalance(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCredits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDebits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LedgerBalanceResponse) validateBalance(formats strfmt.Registry) error {
+
+	if err := validate.Required("balance", "body", m.Balance); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerBalanceResponse) validateCredits(formats strfmt.Registry) error {
+
+	if err := validate.Required("credits", "body", m.Credits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerBalanceResponse) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerBalanceResponse) validateDebits(formats strfmt.Registry) error {
+
+	if err := validate.Required("debits", "body", m.Debits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ledger balance response based on context it is used
+func (m *LedgerBalanceResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LedgerBalanceResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LedgerBalanceResponse) UnmarshalBinary(b []byte) error {
+	var res LedgerBalanceResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,287 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LedgerStatementLineResponse ledger statement line response
+//
+// swagger:model LedgerStatementLineResponse
+type LedgerStatementLineResponse struct {
+
+	// Amount in the minor unit of the currency.
+	// Required: true
+	Amount *int64 `json:"amount"`
+
+	// Amount in the major unit of the currency.
+	AmountDecimal string `json:"amount_decimal,omitempty"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// direction
+	// Required: true
+	// Enum: [debit credit]
+	Direction *string `json:"direction"`
+
+	// entry id
+	// Required: true
+	// Format: uuid
+	EntryID *strfmt.UUID `json:"entry_id"`
+
+	// kind
+	// Required: true
+	// Enum: [hold settle reverse]
+	Kind *string `json:"kind"`
+
+	// Transaction, share or share refund the entry books a payment of.
+	// Required: true
+	// Format: uuid
+	ReferenceID *strfmt.UUID `json:"reference_id"`
+
+	// transaction id
+	// Required: true
+	// Format: uuid
+	TransactionID *strfmt.UUID `json:"transaction_id"`
+}
+
+// Validate validates this ledger statement line response
+func (m *LedgerStatementLineResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDirection(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEntryID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKind(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReferenceID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransactionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var ledgerStatementLineResponseTypeDirectionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["debit","credit"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		ledgerStatementLineResponseTypeDirectionPropEnum = append(ledgerStatementLineResponseTypeDirectionPropEnum, v)
+	}
+}
+
+const (
+
+	// LedgerStatementLineResponseDirectionDebit captures enum value "debit"
+	LedgerStatementLineResponseDirectionDebit string = "debit"
+
+	// LedgerStatementLineResponseDirectionCredit captures enum value "credit"
+	LedgerStatementLineResponseDirectionCredit string = "credit"
+)
+
+// prop value enum
+func (m *LedgerStatementLineResponse) validateDirectionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, ledgerStatementLineResponseTypeDirectionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateDirection(formats strfmt.Registry) error {
+
+	if err := validate.Required("direction", "body", m.Direction); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateDirectionEnum("direction", "body", *m.Direction); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateEntryID(formats strfmt.Registry) error {
+
+	if err := validate.Required("entry_id", "body", m.EntryID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("entry_id", "body", "uuid", m.EntryID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var ledgerStatementLineResponseTypeKindPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["hold","settle","reverse"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		ledgerStatementLineResponseTypeKindPropEnum = append(ledgerStatementLineResponseTypeKindPropEnum, v)
+	}
+}
+
+const (
+
+	// LedgerStatementLineResponseKindHold captures enum value "hold"
+	LedgerStatementLineResponseKindHold string = "hold"
+
+	// LedgerStatementLineResponseKindSettle captures enum value "settle"
+	LedgerStatementLineResponseKindSettle string = "settle"
+
+	// LedgerStatementLineResponseKindReverse captures enum value "reverse"
+	LedgerStatementLineResponseKindReverse string = "reverse"
+)
+
+// prop value enum
+func (m *LedgerStatementLineResponse) validateKindEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, ledgerStatementLineResponseTypeKindPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateKind(formats strfmt.Registry) error {
+
+	if err := validate.Required("kind", "body", m.Kind); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateKindEnum("kind", "body", *m.Kind); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateReferenceID(formats strfmt.Registry) error {
+
+	if err := validate.Required("reference_id", "body", m.ReferenceID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("reference_id", "body", "uuid", m.ReferenceID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LedgerStatementLineResponse) validateTransactionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("transaction_id", "body", m.TransactionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("transaction_id", "body", "uuid", m.TransactionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ledger statement line response based on context it is used
+func (m *LedgerStatementLineResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LedgerStatementLineResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LedgerStatementLineResponse) UnmarshalBinary(b []byte) error {
+	var res LedgerStatementLineResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
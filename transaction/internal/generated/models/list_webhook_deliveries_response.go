@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ListWebhookDeliveriesResponse list webhook deliveries response
+//
+// swagger:model ListWebhookDeliveriesResponse
+type ListWebhookDeliveriesResponse struct {
+
+	// deliveries
+	// Required: true
+	Deliveries []*WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// Validate validates this list webhook deliveries response
+func (m *ListWebhookDeliveriesResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeliveries(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ListWebhookDeliveriesResponse) validateDeliveries(formats strfmt.Registry) error {
+
+	if err := validate.Required("deliveries", "body", m.Deliveries); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Deliveries); i++ {
+		if swag.IsZero(m.Deliveries[i]) { // not required
+			continue
+		}
+
+		if m.Deliveries[i] != nil {
+			if err := m.Deliveries[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("deliveries" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("deliveries" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this list webhook deliveries response based on the context it is used
+func (m *ListWebhookDeliveriesResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDeliveries(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ListWebhookDeliveriesResponse) contextValidateDeliveries(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Deliveries); i++ {
+
+		if m.Deliveries[i] != nil {
+
+			if swag.IsZero(m.Deliveries[i]) { // not required
+				return nil
+			}
+
+			if err := m.Deliveries[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("deliveries" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("deliveries" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ListWebhookDeliveriesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ListWebhookDeliveriesResponse) UnmarshalBinary(b []byte) error {
+	var res ListWebhookDeliveriesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -20,15 +20,33 @@ import (
 type LoginResponse struct {
 
 	// auth token
+	AuthToken string `json:"auth_token,omitempty"`
+
+	// Challenge token lifetime in seconds.
+	ChallengeExpiresIn int64 `json:"challenge_expires_in,omitempty"`
+
+	// challenge token
+	ChallengeToken string `json:"challenge_token,omitempty"`
+
+	// Auth token lifetime in seconds.
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+
+	// refresh token
+	RefreshToken string `json:"refresh_token,omitempty"`
+
+	// token type
+	TokenType string `json:"token_type,omitempty"`
+
+	// If set, the login must be completed on /transaction/login/2fa with the challenge token.
 	// Required: true
-	AuthToken *string `json:"auth_token"`
+	TwoFactorRequired *bool `json:"two_factor_required"`
 }
 
 // Validate validates this login response
 func (m *LoginResponse) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := m.validateAuthToken(formats); err != nil {
+	if err := m.validateTwoFactorRequired(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -38,9 +56,9 @@ func (m *LoginResponse) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *LoginResponse) validateAuthToken(formats strfmt.Registry) error {
+func (m *LoginResponse) validateTwoFactorRequired(formats strfmt.Registry) error {
 
-	if err := validate.Required("auth_token", "body", m.AuthToken); err != nil {
+	if err := validate.Required("two_factor_required", "body", m.TwoFactorRequired); err != nil {
 		return err
 	}
 
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LoginTwoFactorRequest login two factor request
+//
+// swagger:model LoginTwoFactorRequest
+type LoginTwoFactorRequest struct {
+
+	// challenge token
+	// Required: true
+	ChallengeToken *string `json:"challenge_token"`
+
+	// Six digit TOTP code or a recovery code.
+	// Required: true
+	Code *string `json:"code"`
+}
+
+// Validate validates this login two factor request
+func (m *LoginTwoFactorRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChallengeToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LoginTwoFactorRequest) validateChallengeToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("challenge_token", "body", m.ChallengeToken); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LoginTwoFactorRequest) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this login two factor request based on context it is used
+func (m *LoginTwoFactorRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LoginTwoFactorRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LoginTwoFactorRequest) UnmarshalBinary(b []byte) error {
+	var res LoginTwoFactorRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,11 +19,11 @@ import (
 // swagger:model MoneyInfo
 type MoneyInfo struct {
 
-	// amount
+	// Amount in the minor unit of the currency, cents for USD and microAlgos for ALGO.
 	// Required: true
 	Amount *int64 `json:"amount"`
 
-	// currency
+	// ISO 4217 alphabetic code, or ALGO for Algorand. Unknown codes are rejected.
 	// Required: true
 	Currency *string `json:"currency"`
 
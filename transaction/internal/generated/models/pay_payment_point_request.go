@@ -0,0 +1,181 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PayPaymentPointRequest pay payment point request
+//
+// swagger:model PayPaymentPointRequest
+type PayPaymentPointRequest struct {
+
+	// amount
+	// Required: true
+	// Minimum: 1
+	Amount *int64 `json:"amount"`
+
+	// How the payer confirms a high-value transaction.
+	// Enum: [code signature]
+	ConfirmationMethod *string `json:"confirmation_method,omitempty"`
+
+	// sender
+	// Required: true
+	Sender *AcceptTransactionUserRequest `json:"sender"`
+}
+
+// Validate validates this pay payment point request
+func (m *PayPaymentPointRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConfirmationMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSender(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PayPaymentPointRequest) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("amount", "body", *m.Amount, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var payPaymentPointRequestTypeConfirmationMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["code","signature"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		payPaymentPointRequestTypeConfirmationMethodPropEnum = append(payPaymentPointRequestTypeConfirmationMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// PayPaymentPointRequestConfirmationMethodCode captures enum value "code"
+	PayPaymentPointRequestConfirmationMethodCode string = "code"
+
+	// PayPaymentPointRequestConfirmationMethodSignature captures enum value "signature"
+	PayPaymentPointRequestConfirmationMethodSignature string = "signature"
+)
+
+// prop value enum
+func (m *PayPaymentPointRequest) validateConfirmationMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, payPaymentPointRequestTypeConfirmationMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PayPaymentPointRequest) validateConfirmationMethod(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConfirmationMethod) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateConfirmationMethodEnum("confirmation_method", "body", *m.ConfirmationMethod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PayPaymentPointRequest) validateSender(formats strfmt.Registry) error {
+
+	if err := validate.Required("sender", "body", m.Sender); err != nil {
+		return err
+	}
+
+	if m.Sender != nil {
+		if err := m.Sender.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sender")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sender")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this pay payment point request based on the context it is used
+func (m *PayPaymentPointRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSender(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PayPaymentPointRequest) contextValidateSender(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Sender != nil {
+
+		if err := m.Sender.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sender")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sender")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PayPaymentPointRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PayPaymentPointRequest) UnmarshalBinary(b []byte) error {
+	var res PayPaymentPointRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PayPaymentPointResponse pay payment point response
+//
+// swagger:model PayPaymentPointResponse
+type PayPaymentPointResponse struct {
+
+	// confirmation
+	Confirmation *ConfirmationRequiredResponse `json:"confirmation,omitempty"`
+
+	// transaction id
+	// Required: true
+	// Format: uuid
+	TransactionID *strfmt.UUID `json:"transaction_id"`
+}
+
+// Validate validates this pay payment point response
+func (m *PayPaymentPointResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConfirmation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransactionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PayPaymentPointResponse) validateConfirmation(formats strfmt.Registry) error {
+	if swag.IsZero(m.Confirmation) { // not required
+		return nil
+	}
+
+	if m.Confirmation != nil {
+		if err := m.Confirmation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("confirmation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("confirmation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PayPaymentPointResponse) validateTransactionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("transaction_id", "body", m.TransactionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("transaction_id", "body", "uuid", m.TransactionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this pay payment point response based on the context it is used
+func (m *PayPaymentPointResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConfirmation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PayPaymentPointResponse) contextValidateConfirmation(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Confirmation != nil {
+
+		if swag.IsZero(m.Confirmation) { // not required
+			return nil
+		}
+
+		if err := m.Confirmation.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("confirmation")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("confirmation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PayPaymentPointResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PayPaymentPointResponse) UnmarshalBinary(b []byte) error {
+	var res PayPaymentPointResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// QuoteTransactionRequest quote transaction request
+//
+// swagger:model QuoteTransactionRequest
+type QuoteTransactionRequest struct {
+
+	// ISO 4217 alphabetic code, or ALGO for Algorand, to pay the transaction in.
+	// Required: true
+	Currency *string `json:"currency"`
+}
+
+// Validate validates this quote transaction request
+func (m *QuoteTransactionRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *QuoteTransactionRequest) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this quote transaction request based on context it is used
+func (m *QuoteTransactionRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *QuoteTransactionRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *QuoteTransactionRequest) UnmarshalBinary(b []byte) error {
+	var res QuoteTransactionRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
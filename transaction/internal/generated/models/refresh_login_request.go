@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RefreshLoginRequest refresh login request
+//
+// swagger:model RefreshLoginRequest
+type RefreshLoginRequest struct {
+
+	// refresh token
+	// Required: true
+	RefreshToken *string `json:"refresh_token"`
+}
+
+// Validate validates this refresh login request
+func (m *RefreshLoginRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRefreshToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RefreshLoginRequest) validateRefreshToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("refresh_token", "body", m.RefreshToken); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this refresh login request based on context it is used
+func (m *RefreshLoginRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RefreshLoginRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RefreshLoginRequest) UnmarshalBinary(b []byte) error {
+	var res RefreshLoginRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ResendWebhookDeliveryResponse resend webhook delivery response
+//
+// swagger:model ResendWebhookDeliveryResponse
+type ResendWebhookDeliveryResponse struct {
+
+	// delivery id
+	// Required: true
+	// Format: uuid
+	DeliveryID *strfmt.UUID `json:"delivery_id"`
+}
+
+// Validate validates this resend webhook delivery response
+func (m *ResendWebhookDeliveryResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeliveryID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ResendWebhookDeliveryResponse) validateDeliveryID(formats strfmt.Registry) error {
+
+	if err := validate.Required("delivery_id", "body", m.DeliveryID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("delivery_id", "body", "uuid", m.DeliveryID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this resend webhook delivery response based on context it is used
+func (m *ResendWebhookDeliveryResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ResendWebhookDeliveryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ResendWebhookDeliveryResponse) UnmarshalBinary(b []byte) error {
+	var res ResendWebhookDeliveryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
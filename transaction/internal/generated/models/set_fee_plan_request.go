@@ -0,0 +1,241 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SetFeePlanRequest set fee plan request
+//
+// swagger:model SetFeePlanRequest
+type SetFeePlanRequest struct {
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// Fixed part of the fee in the minor unit of the currency.
+	// Minimum: 0
+	FixedAmount *int64 `json:"fixed_amount,omitempty"`
+
+	// max fee
+	// Minimum: 0
+	MaxFee *int64 `json:"max_fee,omitempty"`
+
+	// User id of the merchant receiving the transactions.
+	// Required: true
+	// Format: uuid
+	MerchantID *strfmt.UUID `json:"merchant_id"`
+
+	// method
+	// Required: true
+	Method *string `json:"method"`
+
+	// min fee
+	// Minimum: 0
+	MinFee *int64 `json:"min_fee,omitempty"`
+
+	// Percentage of the transaction amount with up to two decimals, like "2.5".
+	Percentage string `json:"percentage,omitempty"`
+
+	// Percentages replacing the plan percentage from a monthly volume on, at most one per volume.
+	Tiers []*FeePlanTier `json:"tiers"`
+}
+
+// Validate validates this set fee plan request
+func (m *SetFeePlanRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFixedAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxFee(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMerchantID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMinFee(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTiers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateFixedAmount(formats strfmt.Registry) error {
+	if swag.IsZero(m.FixedAmount) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("fixed_amount", "body", *m.FixedAmount, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateMaxFee(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxFee) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("max_fee", "body", *m.MaxFee, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateMerchantID(formats strfmt.Registry) error {
+
+	if err := validate.Required("merchant_id", "body", m.MerchantID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("merchant_id", "body", "uuid", m.MerchantID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("method", "body", m.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateMinFee(formats strfmt.Registry) error {
+	if swag.IsZero(m.MinFee) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("min_fee", "body", *m.MinFee, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SetFeePlanRequest) validateTiers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tiers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Tiers); i++ {
+		if swag.IsZero(m.Tiers[i]) { // not required
+			continue
+		}
+
+		if m.Tiers[i] != nil {
+			if err := m.Tiers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tiers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tiers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this set fee plan request based on the context it is used
+func (m *SetFeePlanRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTiers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SetFeePlanRequest) contextValidateTiers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Tiers); i++ {
+
+		if m.Tiers[i] != nil {
+
+			if swag.IsZero(m.Tiers[i]) { // not required
+				return nil
+			}
+
+			if err := m.Tiers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tiers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tiers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SetFeePlanRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SetFeePlanRequest) UnmarshalBinary(b []byte) error {
+	var res SetFeePlanRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,131 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TransactionFeeResponse transaction fee response
+//
+// swagger:model TransactionFeeResponse
+type TransactionFeeResponse struct {
+
+	// Amount the payer is charged in the minor unit of the currency.
+	// Required: true
+	Amount *int64 `json:"amount"`
+
+	// amount decimal
+	AmountDecimal string `json:"amount_decimal,omitempty"`
+
+	// currency
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// Fee deducted from what the receiver gets in the minor unit of the currency.
+	// Required: true
+	Fee *int64 `json:"fee"`
+
+	// fee decimal
+	FeeDecimal string `json:"fee_decimal,omitempty"`
+
+	// Amount left to the receivers once the fee is deducted.
+	// Required: true
+	ReceiverAmount *int64 `json:"receiver_amount"`
+
+	// receiver amount decimal
+	ReceiverAmountDecimal string `json:"receiver_amount_decimal,omitempty"`
+}
+
+// Validate validates this transaction fee response
+func (m *TransactionFeeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFee(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReceiverAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TransactionFeeResponse) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionFeeResponse) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionFeeResponse) validateFee(formats strfmt.Registry) error {
+
+	if err := validate.Required("fee", "body", m.Fee); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionFeeResponse) validateReceiverAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("receiver_amount", "body", m.ReceiverAmount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this transaction fee response based on context it is used
+func (m *TransactionFeeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TransactionFeeResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TransactionFeeResponse) UnmarshalBinary(b []byte) error {
+	var res TransactionFeeResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TransactionQuote transaction quote
+//
+// swagger:model TransactionQuote
+type TransactionQuote struct {
+
+	// Amount charged in the minor unit of the quote currency.
+	// Required: true
+	Amount *int64 `json:"amount"`
+
+	// Amount charged in the major unit of the quote currency.
+	AmountDecimal string `json:"amount_decimal,omitempty"`
+
+	// Currency the transaction is paid in.
+	// Required: true
+	Currency *string `json:"currency"`
+
+	// Time until which the rate is locked.
+	// Required: true
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expires_at"`
+
+	// Price of one major unit of the transaction currency in the quote currency, margin included.
+	// Required: true
+	Rate *string `json:"rate"`
+
+	// transaction id
+	// Format: uuid
+	TransactionID strfmt.UUID `json:"transaction_id,omitempty"`
+}
+
+// Validate validates this transaction quote
+func (m *TransactionQuote) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrency(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransactionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TransactionQuote) validateAmount(formats strfmt.Registry) error {
+
+	if err := validate.Required("amount", "body", m.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionQuote) validateCurrency(formats strfmt.Registry) error {
+
+	if err := validate.Required("currency", "body", m.Currency); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionQuote) validateExpiresAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires_at", "body", m.ExpiresAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("expires_at", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionQuote) validateRate(formats strfmt.Registry) error {
+
+	if err := validate.Required("rate", "body", m.Rate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionQuote) validateTransactionID(formats strfmt.Registry) error {
+	if swag.IsZero(m.TransactionID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("transaction_id", "body", "uuid", m.TransactionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this transaction quote based on context it is used
+func (m *TransactionQuote) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TransactionQuote) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TransactionQuote) UnmarshalBinary(b []byte) error {
+	var res TransactionQuote
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
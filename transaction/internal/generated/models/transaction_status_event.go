@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TransactionStatusEvent transaction status event
+//
+// swagger:model TransactionStatusEvent
+type TransactionStatusEvent struct {
+
+	// occurred at
+	// Required: true
+	// Format: date-time
+	OccurredAt *strfmt.DateTime `json:"occurred_at"`
+
+	// Reason of the failure, set for failed transactions only.
+	Reason string `json:"reason,omitempty"`
+
+	// status
+	// Required: true
+	Status *string `json:"status"`
+
+	// transaction id
+	// Required: true
+	// Format: uuid
+	TransactionID *strfmt.UUID `json:"transaction_id"`
+}
+
+// Validate validates this transaction status event
+func (m *TransactionStatusEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOccurredAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransactionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TransactionStatusEvent) validateOccurredAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("occurred_at", "body", m.OccurredAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("occurred_at", "body", "date-time", m.OccurredAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionStatusEvent) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TransactionStatusEvent) validateTransactionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("transaction_id", "body", m.TransactionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("transaction_id", "body", "uuid", m.TransactionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this transaction status event based on context it is used
+func (m *TransactionStatusEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TransactionStatusEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TransactionStatusEvent) UnmarshalBinary(b []byte) error {
+	var res TransactionStatusEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,273 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookDeliveryResponse webhook delivery response
+//
+// swagger:model WebhookDeliveryResponse
+type WebhookDeliveryResponse struct {
+
+	// attempts
+	// Required: true
+	Attempts *int32 `json:"attempts"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// delivered at
+	// Format: date-time
+	DeliveredAt *strfmt.DateTime `json:"delivered_at,omitempty"`
+
+	// delivery id
+	// Required: true
+	// Format: uuid
+	DeliveryID *strfmt.UUID `json:"delivery_id"`
+
+	// last error
+	LastError string `json:"last_error,omitempty"`
+
+	// last response code
+	LastResponseCode *int32 `json:"last_response_code,omitempty"`
+
+	// next attempt at
+	// Format: date-time
+	NextAttemptAt *strfmt.DateTime `json:"next_attempt_at,omitempty"`
+
+	// previous status
+	// Required: true
+	PreviousStatus *string `json:"previous_status"`
+
+	// state
+	// Required: true
+	// Enum: [pending succeeded dead]
+	State *string `json:"state"`
+
+	// Transaction status the delivery notifies about.
+	// Required: true
+	Status *string `json:"status"`
+
+	// transaction id
+	// Required: true
+	// Format: uuid
+	TransactionID *strfmt.UUID `json:"transaction_id"`
+}
+
+// Validate validates this webhook delivery response
+func (m *WebhookDeliveryResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttempts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeliveredAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeliveryID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePreviousStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransactionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateAttempts(formats strfmt.Registry) error {
+
+	if err := validate.Required("attempts", "body", m.Attempts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateDeliveredAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeliveredAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("delivered_at", "body", "date-time", m.DeliveredAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateDeliveryID(formats strfmt.Registry) error {
+
+	if err := validate.Required("delivery_id", "body", m.DeliveryID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("delivery_id", "body", "uuid", m.DeliveryID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateNextAttemptAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("next_attempt_at", "body", "date-time", m.NextAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validatePreviousStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("previous_status", "body", m.PreviousStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var webhookDeliveryResponseTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","succeeded","dead"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookDeliveryResponseTypeStatePropEnum = append(webhookDeliveryResponseTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// WebhookDeliveryResponseStatePending captures enum value "pending"
+	WebhookDeliveryResponseStatePending string = "pending"
+
+	// WebhookDeliveryResponseStateSucceeded captures enum value "succeeded"
+	WebhookDeliveryResponseStateSucceeded string = "succeeded"
+
+	// WebhookDeliveryResponseStateDead captures enum value "dead"
+	WebhookDeliveryResponseStateDead string = "dead"
+)
+
+// prop value enum
+func (m *WebhookDeliveryResponse) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, webhookDeliveryResponseTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDeliveryResponse) validateTransactionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("transaction_id", "body", m.TransactionID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("transaction_id", "body", "uuid", m.TransactionID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this webhook delivery response based on context it is used
+func (m *WebhookDeliveryResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookDeliveryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookDeliveryResponse) UnmarshalBinary(b []byte) error {
+	var res WebhookDeliveryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
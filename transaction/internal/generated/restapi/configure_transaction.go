@@ -4,6 +4,7 @@ package restapi
 
 import (
 	"crypto/tls"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
@@ -11,7 +12,14 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/admin"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/mandate"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/payment_point"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/report"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/webhook"
 )
 
 //go:generate swagger generate server --target ../../generated --name Transaction --spec ../../../../doc/transaction_swagger.yml --template-dir ./transaction/swagger-templates/templates --principal interface{}
@@ -36,12 +44,18 @@ func configureAPI(api *operations.TransactionAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
+	api.BinProducer = runtime.ByteStreamProducer()
+	api.CsvProducer = runtime.CSVProducer()
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextEventStreamProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textEventStream producer has not yet been implemented")
+	})
+	api.TxtProducer = runtime.TextProducer()
+	api.XMLProducer = runtime.XMLProducer()
 
-	// Applies when the "Authorization" header is set
 	if api.BearerAuth == nil {
-		api.BearerAuth = func(token string) (interface{}, error) {
-			return nil, errors.NotImplemented("api key auth (Bearer) Authorization from header param [Authorization] has not yet been implemented")
+		api.BearerAuth = func(token string, scopes []string) (interface{}, error) {
+			return nil, errors.NotImplemented("oauth2 bearer auth (Bearer) has not yet been implemented")
 		}
 	}
 
@@ -56,26 +70,146 @@ func configureAPI(api *operations.TransactionAPI) http.Handler {
 			return middleware.NotImplemented("operation transaction.AcceptTransaction has not yet been implemented")
 		})
 	}
+	if api.MandateCancelMandateHandler == nil {
+		api.MandateCancelMandateHandler = mandate.CancelMandateHandlerFunc(func(params mandate.CancelMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.CancelMandate has not yet been implemented")
+		})
+	}
 	if api.TransactionCancelTransactionHandler == nil {
 		api.TransactionCancelTransactionHandler = transaction.CancelTransactionHandlerFunc(func(params transaction.CancelTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.CancelTransaction has not yet been implemented")
 		})
 	}
+	if api.TransactionCaptureTransactionHandler == nil {
+		api.TransactionCaptureTransactionHandler = transaction.CaptureTransactionHandlerFunc(func(params transaction.CaptureTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.CaptureTransaction has not yet been implemented")
+		})
+	}
+	if api.TransactionConfirmTransactionHandler == nil {
+		api.TransactionConfirmTransactionHandler = transaction.ConfirmTransactionHandlerFunc(func(params transaction.ConfirmTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.ConfirmTransaction has not yet been implemented")
+		})
+	}
+	if api.MandateCreateMandateHandler == nil {
+		api.MandateCreateMandateHandler = mandate.CreateMandateHandlerFunc(func(params mandate.CreateMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.CreateMandate has not yet been implemented")
+		})
+	}
+	if api.PaymentPointCreatePaymentPointHandler == nil {
+		api.PaymentPointCreatePaymentPointHandler = payment_point.CreatePaymentPointHandlerFunc(func(params payment_point.CreatePaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.CreatePaymentPoint has not yet been implemented")
+		})
+	}
 	if api.TransactionCreateTransactionHandler == nil {
 		api.TransactionCreateTransactionHandler = transaction.CreateTransactionHandlerFunc(func(params transaction.CreateTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.CreateTransaction has not yet been implemented")
 		})
 	}
+	if api.WebhookCreateWebhookHandler == nil {
+		api.WebhookCreateWebhookHandler = webhook.CreateWebhookHandlerFunc(func(params webhook.CreateWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.CreateWebhook has not yet been implemented")
+		})
+	}
+	if api.PaymentPointDisablePaymentPointHandler == nil {
+		api.PaymentPointDisablePaymentPointHandler = payment_point.DisablePaymentPointHandlerFunc(func(params payment_point.DisablePaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.DisablePaymentPoint has not yet been implemented")
+		})
+	}
 	if api.TransactionEditTransactionHandler == nil {
 		api.TransactionEditTransactionHandler = transaction.EditTransactionHandlerFunc(func(params transaction.EditTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.EditTransaction has not yet been implemented")
 		})
 	}
+	if api.FeeGetFeePlansHandler == nil {
+		api.FeeGetFeePlansHandler = fee.GetFeePlansHandlerFunc(func(params fee.GetFeePlansParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fee.GetFeePlans has not yet been implemented")
+		})
+	}
+	if api.LedgerGetLedgerBalanceHandler == nil {
+		api.LedgerGetLedgerBalanceHandler = ledger.GetLedgerBalanceHandlerFunc(func(params ledger.GetLedgerBalanceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ledger.GetLedgerBalance has not yet been implemented")
+		})
+	}
+	if api.LedgerGetLedgerStatementHandler == nil {
+		api.LedgerGetLedgerStatementHandler = ledger.GetLedgerStatementHandlerFunc(func(params ledger.GetLedgerStatementParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ledger.GetLedgerStatement has not yet been implemented")
+		})
+	}
+	if api.PaymentPointGetPaymentPointQRHandler == nil {
+		api.PaymentPointGetPaymentPointQRHandler = payment_point.GetPaymentPointQRHandlerFunc(func(params payment_point.GetPaymentPointQRParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.GetPaymentPointQR has not yet been implemented")
+		})
+	}
+	if api.ReportGetSettlementReportHandler == nil {
+		api.ReportGetSettlementReportHandler = report.GetSettlementReportHandlerFunc(func(params report.GetSettlementReportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation report.GetSettlementReport has not yet been implemented")
+		})
+	}
+	if api.TransactionGetTransactionFeeHandler == nil {
+		api.TransactionGetTransactionFeeHandler = transaction.GetTransactionFeeHandlerFunc(func(params transaction.GetTransactionFeeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.GetTransactionFee has not yet been implemented")
+		})
+	}
+	if api.TransactionGetTransactionQRHandler == nil {
+		api.TransactionGetTransactionQRHandler = transaction.GetTransactionQRHandlerFunc(func(params transaction.GetTransactionQRParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.GetTransactionQR has not yet been implemented")
+		})
+	}
+	if api.WebhookListWebhookDeliveriesHandler == nil {
+		api.WebhookListWebhookDeliveriesHandler = webhook.ListWebhookDeliveriesHandlerFunc(func(params webhook.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.ListWebhookDeliveries has not yet been implemented")
+		})
+	}
 	if api.TransactionLoginHandler == nil {
 		api.TransactionLoginHandler = transaction.LoginHandlerFunc(func(params transaction.LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.Login has not yet been implemented")
 		})
 	}
+	if api.TransactionLoginTwoFactorHandler == nil {
+		api.TransactionLoginTwoFactorHandler = transaction.LoginTwoFactorHandlerFunc(func(params transaction.LoginTwoFactorParams) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.LoginTwoFactor has not yet been implemented")
+		})
+	}
+	if api.MandatePauseMandateHandler == nil {
+		api.MandatePauseMandateHandler = mandate.PauseMandateHandlerFunc(func(params mandate.PauseMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.PauseMandate has not yet been implemented")
+		})
+	}
+	if api.PaymentPointPayPaymentPointHandler == nil {
+		api.PaymentPointPayPaymentPointHandler = payment_point.PayPaymentPointHandlerFunc(func(params payment_point.PayPaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.PayPaymentPoint has not yet been implemented")
+		})
+	}
+	if api.TransactionQuoteTransactionHandler == nil {
+		api.TransactionQuoteTransactionHandler = transaction.QuoteTransactionHandlerFunc(func(params transaction.QuoteTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.QuoteTransaction has not yet been implemented")
+		})
+	}
+	if api.TransactionRefreshLoginHandler == nil {
+		api.TransactionRefreshLoginHandler = transaction.RefreshLoginHandlerFunc(func(params transaction.RefreshLoginParams) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.RefreshLogin has not yet been implemented")
+		})
+	}
+	if api.WebhookResendWebhookDeliveryHandler == nil {
+		api.WebhookResendWebhookDeliveryHandler = webhook.ResendWebhookDeliveryHandlerFunc(func(params webhook.ResendWebhookDeliveryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.ResendWebhookDelivery has not yet been implemented")
+		})
+	}
+	if api.MandateResumeMandateHandler == nil {
+		api.MandateResumeMandateHandler = mandate.ResumeMandateHandlerFunc(func(params mandate.ResumeMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.ResumeMandate has not yet been implemented")
+		})
+	}
+	if api.MandateRetrieveMandateHandler == nil {
+		api.MandateRetrieveMandateHandler = mandate.RetrieveMandateHandlerFunc(func(params mandate.RetrieveMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.RetrieveMandate has not yet been implemented")
+		})
+	}
+	if api.PaymentPointRetrievePaymentPointHandler == nil {
+		api.PaymentPointRetrievePaymentPointHandler = payment_point.RetrievePaymentPointHandlerFunc(func(params payment_point.RetrievePaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.RetrievePaymentPoint has not yet been implemented")
+		})
+	}
 	if api.TransactionRetrieveTransactionHandler == nil {
 		api.TransactionRetrieveTransactionHandler = transaction.RetrieveTransactionHandlerFunc(func(params transaction.RetrieveTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.RetrieveTransaction has not yet been implemented")
@@ -86,6 +220,26 @@ func configureAPI(api *operations.TransactionAPI) http.Handler {
 			return middleware.NotImplemented("operation transaction.RetrieveTransactionStatus has not yet been implemented")
 		})
 	}
+	if api.FeeSetFeePlanHandler == nil {
+		api.FeeSetFeePlanHandler = fee.SetFeePlanHandlerFunc(func(params fee.SetFeePlanParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fee.SetFeePlan has not yet been implemented")
+		})
+	}
+	if api.TransactionStreamTransactionEventsHandler == nil {
+		api.TransactionStreamTransactionEventsHandler = transaction.StreamTransactionEventsHandlerFunc(func(params transaction.StreamTransactionEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.StreamTransactionEvents has not yet been implemented")
+		})
+	}
+	if api.AdminUnlockLoginHandler == nil {
+		api.AdminUnlockLoginHandler = admin.UnlockLoginHandlerFunc(func(params admin.UnlockLoginParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.UnlockLogin has not yet been implemented")
+		})
+	}
+	if api.TransactionVoidTransactionHandler == nil {
+		api.TransactionVoidTransactionHandler = transaction.VoidTransactionHandlerFunc(func(params transaction.VoidTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.VoidTransaction has not yet been implemented")
+		})
+	}
 
 	api.PreServerShutdown = func() {}
 
@@ -33,11 +33,13 @@ func init() {
   "host": "localhost:8083",
   "basePath": "/api/v1",
   "paths": {
-    "/transaction/create": {
+    "/admin/login/unlock": {
       "post": {
         "security": [
           {
-            "Bearer": []
+            "Bearer": [
+              "admin"
+            ]
           }
         ],
         "consumes": [
@@ -47,38 +49,78 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "admin"
         ],
-        "summary": "The method is used to create transactions.",
-        "operationId": "createTransaction",
+        "summary": "The method is used to lift a login lockout before it expires.",
+        "operationId": "unlockLogin",
         "parameters": [
           {
-            "description": "Created transaction object.",
+            "description": "Account and optionally the ip address to unlock.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CreateTransactionRequest"
+              "$ref": "#/definitions/UnlockLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Login successfully unlocked."
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/fee-plan/merchant/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "fee"
+        ],
+        "summary": "The method is used to retrieve the fee plans of a merchant.",
+        "operationId": "getFeePlans",
+        "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
+            "description": "User id of the merchant.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Transaction successfully created.",
-            "schema": {
-              "$ref": "#/definitions/CreateTransactionResponse"
-            }
-          },
-          "400": {
-            "description": "Validation error.",
+            "description": "Fee plans successfully retrieved.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GetFeePlansResponse"
             }
           },
           "403": {
@@ -96,8 +138,16 @@ func init() {
         }
       }
     },
-    "/transaction/login": {
+    "/fee-plan/set": {
       "post": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "The fee is the fixed amount plus the percentage of the transaction amount rounded down, kept between the minimal and maximal fee and never above the amount the receiver gets. A tier replaces the percentage once the merchant volume of the current month reaches its from_volume. Setting a plan replaces the previous plan of the merchant for the method and currency.\n",
         "consumes": [
           "application/json"
         ],
@@ -105,32 +155,26 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "fee"
         ],
-        "summary": "The method is used to user login.",
-        "operationId": "login",
+        "summary": "The method is used to set the fee plan of a merchant for a payment method and currency.",
+        "operationId": "setFeePlan",
         "parameters": [
           {
-            "description": "Get login token.",
+            "description": "Fee plan to set.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/LoginRequest"
+              "$ref": "#/definitions/SetFeePlanRequest"
             }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "User successfully logined.",
+            "description": "Fee plan successfully set.",
             "schema": {
-              "$ref": "#/definitions/LoginResponse"
+              "$ref": "#/definitions/FeePlanResponse"
             }
           },
           "400": {
@@ -139,6 +183,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -148,49 +198,49 @@ func init() {
         }
       }
     },
-    "/transaction/{id}/accept": {
-      "post": {
+    "/ledger/account/{id}/balance": {
+      "get": {
         "security": [
           {
-            "Bearer": []
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
           }
         ],
+        "description": "Wallet accounts are identified as wallet:\u003cwallet_id\u003e and are visible to their owner, the platform:fees and platform:clearing accounts to administrators only.\n",
         "produces": [
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "ledger"
         ],
-        "summary": "The method is used to accept the transaction.",
-        "operationId": "acceptTransaction",
+        "summary": "The method is used to retrieve the balances of a ledger account by currency.",
+        "operationId": "getLedgerBalance",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to retrieve.",
+            "description": "Ledger account id to retrieve the balances of.",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "Information required to accpet a transaction.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/AcceptTransactionRequest"
-            }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Transaction successfully accepted."
+            "description": "Ledger balances successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetLedgerBalanceResponse"
+            }
           },
           "403": {
             "description": "Forbidden error.",
@@ -213,52 +263,64 @@ func init() {
         }
       }
     },
-    "/transaction/{id}/cancel": {
-      "post": {
+    "/ledger/account/{id}/statement": {
+      "get": {
         "security": [
           {
-            "Bearer": []
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
           }
         ],
-        "consumes": [
-          "application/json"
-        ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "ledger"
         ],
-        "summary": "The method is used to cancel a transaction.",
-        "operationId": "cancelTransaction",
+        "summary": "The method is used to retrieve the postings of a ledger account, newest first.",
+        "operationId": "getLedgerStatement",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to cancel.",
+            "description": "Ledger account id to retrieve the statement of.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "description": "Information required to cancel a transaction.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CancelTransactionRequest"
-            }
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Transaction successfully cancelled."
+            "description": "Ledger statement successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetLedgerStatementResponse"
+            }
           },
           "403": {
             "description": "Forbidden error.",
@@ -281,11 +343,13 @@ func init() {
         }
       }
     },
-    "/transaction/{id}/edit": {
+    "/mandate/create": {
       "post": {
         "security": [
           {
-            "Bearer": []
+            "Bearer": [
+              "customer"
+            ]
           }
         ],
         "consumes": [
@@ -295,26 +359,18 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "mandate"
         ],
-        "summary": "The method is used to edit a transaction.",
-        "operationId": "editTransaction",
+        "summary": "The method is used by the payer to authorise recurring payments to the receiver once, they are charged on schedule.",
+        "operationId": "createMandate",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to edit.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Information required to change a transaction.",
+            "description": "Created mandate object.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/EditTransactionRequest"
+              "$ref": "#/definitions/CreateMandateRequest"
             }
           },
           {
@@ -326,7 +382,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Transaction successfully edited."
+            "description": "Mandate successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreateMandateResponse"
+            }
           },
           "400": {
             "description": "Validation error.",
@@ -340,12 +399,80 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/mandate/{id}/cancel": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used to stop charging the mandate for good.",
+        "operationId": "cancelMandate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Mandate id to cancel.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully canceled."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "404": {
             "description": "Not found error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "The mandate is already canceled or completed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -355,37 +482,47 @@ func init() {
         }
       }
     },
-    "/transaction/{id}/retrieve": {
-      "get": {
+    "/mandate/{id}/pause": {
+      "post": {
         "security": [
           {
-            "Bearer": []
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
           }
         ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "mandate"
         ],
-        "summary": "The method is used to retrieve the transaction.",
-        "operationId": "retrieveTransaction",
+        "summary": "The method is used to stop charging the mandate until it is resumed.",
+        "operationId": "pauseMandate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Transaction id to retrieve.",
+            "description": "Mandate id to pause.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Transaction successfully retrieved.",
-            "schema": {
-              "$ref": "#/definitions/GetTransactionResponse"
-            }
+            "description": "Mandate successfully paused."
           },
           "403": {
             "description": "Forbidden error.",
@@ -399,6 +536,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "The mandate is not active or past due.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -408,37 +551,47 @@ func init() {
         }
       }
     },
-    "/transaction/{id}/retrieve/status": {
-      "get": {
+    "/mandate/{id}/resume": {
+      "post": {
         "security": [
           {
-            "Bearer": []
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
           }
         ],
         "produces": [
           "application/json"
         ],
         "tags": [
-          "transaction"
+          "mandate"
         ],
-        "summary": "The method is used to get the status of the transaction.",
-        "operationId": "retrieveTransactionStatus",
+        "summary": "The method is used to resume charging a paused or unpaid mandate, the periods it missed are not charged.",
+        "operationId": "resumeMandate",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "Transaction id to retrieve status.",
+            "description": "Mandate id to resume.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Transaction status successfully retrieved.",
-            "schema": {
-              "$ref": "#/definitions/GetTransactionStatusResponse"
-            }
+            "description": "Mandate successfully resumed."
           },
           "403": {
             "description": "Forbidden error.",
@@ -452,6 +605,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "409": {
+            "description": "The mandate is not paused or unpaid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "Internal server error.",
             "schema": {
@@ -460,15 +619,5950 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "AcceptTransactionRequest": {
-      "type": "object",
+    },
+    "/mandate/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used to retrieve the mandate with its schedule and dunning state.",
+        "operationId": "retrieveMandate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Mandate id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetMandateResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to create a static payment point, its QR code is reused by every payer.",
+        "operationId": "createPaymentPoint",
+        "parameters": [
+          {
+            "description": "Created payment point object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreatePaymentPointRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment point successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreatePaymentPointResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/disable": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to disable the payment point, its QR code stops accepting payments.",
+        "operationId": "disablePaymentPoint",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to disable.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment point successfully disabled."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/pay": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to pay to the payment point, the transaction is created and accepted in one step.",
+        "operationId": "payPaymentPoint",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to pay to.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Payer and the amount entered by the payer.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PayPaymentPointRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully created and accepted.",
+            "schema": {
+              "$ref": "#/definitions/PayPaymentPointResponse"
+            }
+          },
+          "202": {
+            "description": "Transaction amount is above the threshold, payer confirmation is required.",
+            "schema": {
+              "$ref": "#/definitions/PayPaymentPointResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "Payment point is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The amount is outside of the payment point bounds.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/qr": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "image/png",
+          "image/svg+xml",
+          "text/plain",
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to render the static QR code of the payment point.",
+        "operationId": "getPaymentPointQR",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to render.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "png",
+              "svg",
+              "text"
+            ],
+            "type": "string",
+            "default": "png",
+            "description": "Image format of the QR code, text returns the payload itself.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "maximum": 2048,
+            "minimum": 64,
+            "type": "integer",
+            "format": "int32",
+            "default": 256,
+            "description": "Width and height of the image in pixels.",
+            "name": "size",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "L",
+              "M",
+              "Q",
+              "H"
+            ],
+            "type": "string",
+            "default": "M",
+            "description": "Error-correction level of the QR code.",
+            "name": "level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "QR code successfully rendered.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "Payment point is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to retrieve the payment point.",
+        "operationId": "retrievePaymentPoint",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment point successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetPaymentPointResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/report/settlement": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "The report has a statement per merchant and currency with the count and gross amount of the settled payments, their fees, the count and amount of the refunds and the net amount, together with the settlements themselves. The day is the UTC day, amounts are written in the major unit of the currency.\n",
+        "produces": [
+          "text/csv",
+          "application/json",
+          "application/xml"
+        ],
+        "tags": [
+          "report"
+        ],
+        "summary": "The method is used to render the settlement report of a day.",
+        "operationId": "getSettlementReport",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date",
+            "description": "Day to report.",
+            "name": "date",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "User id of the merchant to report, every merchant is reported when it is empty.",
+            "name": "merchant_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "csv",
+              "json",
+              "camt053"
+            ],
+            "type": "string",
+            "default": "csv",
+            "description": "Format of the report, camt053 is an ISO 20022 camt.053 bank to customer statement.",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Settlement report successfully rendered.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad request error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to create transactions.",
+        "operationId": "createTransaction",
+        "parameters": [
+          {
+            "description": "Created transaction object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreateTransactionResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/login": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to user login.",
+        "operationId": "login",
+        "parameters": [
+          {
+            "description": "Get login token.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoginRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully logined.",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Wrong email or password.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "Too many failed attempts, login is temporarily locked.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "format": "int64",
+                "description": "Seconds until the lock ends."
+              }
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/login/2fa": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to complete login with a two-factor code.",
+        "operationId": "loginTwoFactor",
+        "parameters": [
+          {
+            "description": "Challenge token received on login and a TOTP or recovery code.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoginTwoFactorRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully logined.",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Challenge token is invalid or the code is wrong.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "Too many failed attempts, login is temporarily locked.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "format": "int64",
+                "description": "Seconds until the lock ends."
+              }
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/login/refresh": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to renew an expired auth token.",
+        "operationId": "refreshLogin",
+        "parameters": [
+          {
+            "description": "Refresh token received on login.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefreshLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Auth token successfully renewed.",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Refresh token is invalid or expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/accept": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to accept the transaction.",
+        "operationId": "acceptTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Information required to accpet a transaction.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AcceptTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully accepted."
+          },
+          "202": {
+            "description": "Transaction amount is above the threshold, payer confirmation is required.",
+            "schema": {
+              "$ref": "#/definitions/ConfirmationRequiredResponse"
+            }
+          },
+          "400": {
+            "description": "The QR payload is malformed, not signed by the service or issued for another transaction, or execute_at is set for a group transaction or a transaction with a locked quote.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The QR payload was already used, or every share of the group transaction is taken.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "The QR payload, the transaction or its locked exchange rate expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The transaction was edited after the QR payload was issued.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/cancel": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to cancel a transaction.",
+        "operationId": "cancelTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to cancel.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Information required to cancel a transaction.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CancelTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully cancelled."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction was already handed over to the payment gateway, it can no longer be cancelled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/capture": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to capture the held amount of an authorized transaction, in full or in part.",
+        "operationId": "captureTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to capture.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Amount to capture, the whole held amount when omitted.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CaptureTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Capture requested, the rest of the hold is released."
+          },
+          "400": {
+            "description": "The amount exceeds the held amount, or a split or quoted transaction is captured in part.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is not authorized, or its hold expired or is being voided.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/confirm": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to confirm an accepted high-value transaction.",
+        "operationId": "confirmTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to confirm.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "One-time code or wallet signature over the transaction hash.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ConfirmTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully confirmed."
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Transaction can be confirmed only by its payer.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Transaction is not awaiting confirmation.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "Confirmation expired or ran out of attempts, the transaction must be accepted again.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Wrong confirmation code or signature.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/edit": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to edit a transaction.",
+        "operationId": "editTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to edit.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Information required to change a transaction.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/EditTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully edited."
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction was already accepted, canceled or expired, it can no longer be edited.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/events": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "text/event-stream",
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to stream status changes of the transaction as server-sent events. The current status is sent first unless the stream is resumed with Last-Event-ID.",
+        "operationId": "streamTransactionEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to stream status changes of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Id of the last received event, the events after it are replayed before the live ones.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of ` + "`" + `status` + "`" + ` events carrying TransactionStatusEvent, comment lines are sent as heartbeat.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Malformed Last-Event-ID.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/fee": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "description": "The fee is calculated with the fee plan of the receiver for the transaction method and currency and the receiver volume of the current month. It is deducted from what the receiver gets, the payer is charged the transaction amount. The fee is fixed when the transaction is accepted.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to preview the fee charged on the transaction before accepting it.",
+        "operationId": "getTransactionFee",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to preview the fee of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Fee successfully calculated.",
+            "schema": {
+              "$ref": "#/definitions/TransactionFeeResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is no longer in the created status.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "The transaction expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/qr": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "image/png",
+          "image/svg+xml",
+          "text/plain",
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to render the payment QR code of the transaction. Every call issues a new signed single-use payload.",
+        "operationId": "getTransactionQR",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to render.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "png",
+              "svg",
+              "text"
+            ],
+            "type": "string",
+            "default": "png",
+            "description": "Image format of the QR code, text returns the signed payload itself.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "maximum": 2048,
+            "minimum": 64,
+            "type": "integer",
+            "format": "int32",
+            "default": 256,
+            "description": "Width and height of the image in pixels.",
+            "name": "size",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "L",
+              "M",
+              "Q",
+              "H"
+            ],
+            "type": "string",
+            "default": "M",
+            "description": "Error-correction level of the QR code.",
+            "name": "level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "QR code successfully rendered.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad request error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/quote": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "description": "The transaction amount is converted into the requested currency at the provider rate with the service margin. The rate stays locked until expires_at, the transaction must be accepted before then. A new quote replaces the previous one, editing the transaction drops it.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to lock an exchange rate for paying the transaction in another currency.",
+        "operationId": "quoteTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to quote.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Currency the payer wants to pay in.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/QuoteTransactionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Exchange rate locked.",
+            "schema": {
+              "$ref": "#/definitions/TransactionQuote"
+            }
+          },
+          "400": {
+            "description": "The currency is unknown or already the transaction currency.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is no longer in the created status, or it is a group transaction.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "The transaction expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "502": {
+            "description": "No exchange rate is available for the currency pair.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to retrieve the transaction.",
+        "operationId": "retrieveTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetTransactionResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/retrieve/status": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to get the status of the transaction.",
+        "operationId": "retrieveTransactionStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to retrieve status.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction status successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetTransactionStatusResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/void": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to release the hold of an authorized transaction back to the payer.",
+        "operationId": "voidTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to void.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Void requested, the transaction is voided once the payment gateway releases the hold."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is not authorized.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/webhook/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "description": "Deliveries are POST requests with a JSON body. The X-Webhook-Signature header carries\n\"sha256=\" followed by the hex HMAC-SHA256 of the X-Webhook-Timestamp header value,\na dot and the body, keyed with the webhook secret.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "webhook"
+        ],
+        "summary": "The method is used to register a webhook notified about every status change of the merchant transactions.",
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "description": "Created webhook object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateWebhookRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Webhook successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreateWebhookResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/webhook/delivery/{id}/resend": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "webhook"
+        ],
+        "summary": "The method is used to send the delivery again as a new delivery with fresh retries.",
+        "operationId": "resendWebhookDelivery",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Delivery id to resend.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Delivery successfully enqueued again.",
+            "schema": {
+              "$ref": "#/definitions/ResendWebhookDeliveryResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/webhook/{id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "webhook"
+        ],
+        "summary": "The method is used to retrieve the delivery log of the webhook, newest deliveries first.",
+        "operationId": "listWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Webhook id to retrieve the deliveries of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Webhook deliveries successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/ListWebhookDeliveriesResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AcceptTransactionRequest": {
+      "type": "object",
+      "required": [
+        "sender",
+        "qr_payload"
+      ],
+      "properties": {
+        "confirmation_method": {
+          "description": "How the payer confirms a high-value transaction.",
+          "type": "string",
+          "default": "code",
+          "enum": [
+            "code",
+            "signature"
+          ]
+        },
+        "execute_at": {
+          "description": "Time to execute the accepted transaction at, like the due date of a rent. The transaction stays scheduled until then and can still be canceled. A time in the past executes it right away.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "qr_payload": {
+          "description": "Signed payload scanned from the transaction QR code, it can be used only once.",
+          "type": "string"
+        },
+        "sender": {
+          "$ref": "#/definitions/AcceptTransactionUserRequest"
+        }
+      }
+    },
+    "AcceptTransactionUserRequest": {
+      "type": "object",
+      "required": [
+        "user_id",
+        "wallet_id"
+      ],
+      "properties": {
+        "user_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "wallet_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CancelTransactionRequest": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "CaptureTransactionRequest": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "Amount to capture in the minor unit of the transaction currency, at most the held amount.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "ConfirmTransactionRequest": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "description": "One-time code delivered to the payer.",
+          "type": "string"
+        },
+        "signature": {
+          "description": "Base64 encoded Algorand signBytes signature over the transaction hash.",
+          "type": "string"
+        }
+      }
+    },
+    "ConfirmationRequiredResponse": {
+      "type": "object",
+      "required": [
+        "confirmation_method",
+        "transaction_hash",
+        "expires_at"
+      ],
+      "properties": {
+        "confirmation_method": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "transaction_hash": {
+          "description": "Hex encoded hash to sign with the payer wallet key for the signature method.",
+          "type": "string"
+        }
+      }
+    },
+    "CreateMandateRequest": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "payer_wallet_id",
+        "money_info",
+        "interval",
+        "ends_at"
+      ],
+      "properties": {
+        "ends_at": {
+          "description": "The mandate is completed once the next charge would fall after it.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "interval": {
+          "type": "string",
+          "enum": [
+            "day",
+            "week",
+            "month",
+            "year"
+          ]
+        },
+        "interval_count": {
+          "description": "Number of intervals between charges.",
+          "type": "integer",
+          "format": "int32",
+          "default": 1,
+          "maximum": 365,
+          "minimum": 1
+        },
+        "money_info": {
+          "$ref": "#/definitions/MoneyInfo"
+        },
+        "payer_wallet_id": {
+          "description": "Wallet of the authenticated payer the mandate is charged from.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        },
+        "starts_at": {
+          "description": "Date of the first charge, the mandate is charged right away when it is omitted or in the past.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "CreateMandateResponse": {
+      "type": "object",
+      "required": [
+        "mandate_id"
+      ],
+      "properties": {
+        "mandate_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreatePaymentPointRequest": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "method",
+        "currency"
+      ],
+      "properties": {
+        "currency": {
+          "type": "string"
+        },
+        "max_amount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "method": {
+          "type": "string"
+        },
+        "min_amount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        }
+      }
+    },
+    "CreatePaymentPointResponse": {
+      "type": "object",
+      "required": [
+        "payment_point_id"
+      ],
+      "properties": {
+        "payment_point_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreateTransactionLegRequest": {
+      "type": "object",
+      "required": [
+        "receiver"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Fixed amount in the minor unit of the transaction currency, exclusive with percentage.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount with up to two decimals, like \"2.5\", exclusive with amount.",
+          "type": "string",
+          "pattern": "^[0-9]{1,3}(\\.[0-9]{1,2})?$"
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        }
+      }
+    },
+    "CreateTransactionRequest": {
+      "type": "object",
+      "required": [
+        "money_info",
+        "receiver"
+      ],
+      "properties": {
+        "capture_method": {
+          "description": "With manual, the accepted amount is only held on the payer side until the merchant captures or voids it, the hold is voided automatically once it times out. Group transactions are captured automatically.",
+          "type": "string",
+          "default": "automatic",
+          "enum": [
+            "automatic",
+            "manual"
+          ]
+        },
+        "expires_in": {
+          "description": "Seconds the transaction can be accepted for, the service default is used when omitted.",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 2592000,
+          "minimum": 60
+        },
+        "legs": {
+          "description": "Additional receivers to split the transaction with, the receiver gets the remainder of the amount.",
+          "type": "array",
+          "maxItems": 15,
+          "items": {
+            "$ref": "#/definitions/CreateTransactionLegRequest"
+          }
+        },
+        "money_info": {
+          "$ref": "#/definitions/MoneyInfo"
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        },
+        "shares": {
+          "description": "Shares of a group transaction, each accepted and paid by its own payer. They must add up to the amount.",
+          "type": "array",
+          "maxItems": 20,
+          "minItems": 2,
+          "items": {
+            "$ref": "#/definitions/CreateTransactionShareRequest"
+          }
+        },
+        "watch": {
+          "description": "Pays the transaction from any Algorand wallet outside of the platform. The transaction is processed right away, its QR code carries an algorand:// payment URI and the receiver address is watched for the payment until the transaction expires. Only unsplit ALGO transactions paid with algorand can be watched.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "CreateTransactionResponse": {
+      "type": "object",
+      "required": [
+        "transaction_id"
+      ],
+      "properties": {
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreateTransactionShareRequest": {
+      "type": "object",
+      "required": [
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount of the share in the minor unit of the transaction currency.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "CreateTransactionUserRequest": {
+      "type": "object",
+      "required": [
+        "user_id",
+        "wallet_id"
+      ],
+      "properties": {
+        "user_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "wallet_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreateWebhookRequest": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret"
+      ],
+      "properties": {
+        "secret": {
+          "type": "string",
+          "maxLength": 256,
+          "minLength": 16
+        },
+        "url": {
+          "type": "string",
+          "format": "uri",
+          "pattern": "^https?://"
+        }
+      }
+    },
+    "CreateWebhookResponse": {
+      "type": "object",
+      "required": [
+        "webhook_id"
+      ],
+      "properties": {
+        "webhook_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "EditMoneyInfo": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand. Unknown codes are rejected.",
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        }
+      }
+    },
+    "EditTransactionRequest": {
+      "type": "object",
+      "properties": {
+        "money_info": {
+          "$ref": "#/definitions/EditMoneyInfo"
+        }
+      }
+    },
+    "ErrorResponse": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "FeePlanResponse": {
+      "type": "object",
+      "required": [
+        "merchant_id",
+        "method",
+        "currency",
+        "fixed_amount",
+        "percentage",
+        "tiers"
+      ],
+      "properties": {
+        "currency": {
+          "type": "string"
+        },
+        "fixed_amount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "max_fee": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "merchant_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "method": {
+          "type": "string"
+        },
+        "min_fee": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "percentage": {
+          "type": "string"
+        },
+        "tiers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FeePlanTier"
+          }
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "FeePlanTier": {
+      "type": "object",
+      "required": [
+        "from_volume",
+        "percentage"
+      ],
+      "properties": {
+        "from_volume": {
+          "description": "Volume of the succeeded transactions of the merchant in the current month, in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount with up to two decimals, like \"1.5\".",
+          "type": "string"
+        }
+      }
+    },
+    "GetFeePlansResponse": {
+      "type": "object",
+      "required": [
+        "plans"
+      ],
+      "properties": {
+        "plans": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FeePlanResponse"
+          }
+        }
+      }
+    },
+    "GetLedgerBalanceResponse": {
+      "type": "object",
+      "required": [
+        "account_id",
+        "kind",
+        "balances"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "balances": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LedgerBalanceResponse"
+          }
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "wallet",
+            "fees",
+            "clearing"
+          ]
+        }
+      }
+    },
+    "GetLedgerStatementResponse": {
+      "type": "object",
+      "required": [
+        "account_id",
+        "lines"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "lines": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LedgerStatementLineResponse"
+          }
+        }
+      }
+    },
+    "GetMandateResponse": {
+      "type": "object",
+      "required": [
+        "mandate_id",
+        "receiver",
+        "payer",
+        "money_info",
+        "interval",
+        "interval_count",
+        "starts_at",
+        "ends_at",
+        "status",
+        "attempts"
+      ],
+      "properties": {
+        "amount_decimal": {
+          "description": "Amount of every charge in the major unit of the currency.",
+          "type": "string"
+        },
+        "attempts": {
+          "description": "Failed charges of the current period.",
+          "type": "integer",
+          "format": "int32"
+        },
+        "charge_transaction_id": {
+          "description": "Transaction of the charge that is being paid.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "ends_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "interval": {
+          "type": "string"
+        },
+        "interval_count": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "last_error": {
+          "description": "Reason of the last failed charge.",
+          "type": "string"
+        },
+        "mandate_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "money_info": {
+          "$ref": "#/definitions/MoneyInfo"
+        },
+        "next_charge_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "payer": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "period_start": {
+          "description": "Billing date of the period that is charged next.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "starts_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "A past due mandate retries the failed charge, an unpaid one ran out of retries and waits to be resumed.",
+          "type": "string",
+          "enum": [
+            "active",
+            "past_due",
+            "paused",
+            "unpaid",
+            "canceled",
+            "completed"
+          ]
+        }
+      }
+    },
+    "GetPaymentPointResponse": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "method",
+        "currency",
+        "active"
+      ],
+      "properties": {
+        "active": {
+          "type": "boolean"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "max_amount": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "max_amount_decimal": {
+          "description": "Maximal amount in the major unit of the currency.",
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "min_amount": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "min_amount_decimal": {
+          "description": "Minimal amount in the major unit of the currency.",
+          "type": "string"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        }
+      }
+    },
+    "GetTransactionLegResponse": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount the receiver gets in the minor unit of the transaction currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount, set for legs created with a percentage.",
+          "type": "string"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        }
+      }
+    },
+    "GetTransactionResponse": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "currency",
+        "amount",
+        "status",
+        "method"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor unit of the currency, cents for USD and microAlgos for ALGO.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount in the major unit of the currency with the currency number of decimals, like \"12.34\".",
+          "type": "string"
+        },
+        "authorized_until": {
+          "description": "Time the hold of an authorized transaction is voided at unless it is captured.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "capture_method": {
+          "type": "string",
+          "enum": [
+            "automatic",
+            "manual"
+          ]
+        },
+        "captured_amount": {
+          "description": "Amount taken out of the hold of a manually captured transaction, in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand.",
+          "type": "string"
+        },
+        "execute_at": {
+          "description": "Time a scheduled transaction is executed at.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "expires_at": {
+          "description": "Time after which the transaction can no longer be accepted.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "fee": {
+          "description": "Fee deducted from what the receiver gets, in the minor unit of the currency. Set when the transaction is accepted.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "fee_decimal": {
+          "description": "Fee in the major unit of the currency.",
+          "type": "string"
+        },
+        "legs": {
+          "description": "Receivers the transaction is split across, the first leg is the receiver. Empty when the transaction is not split.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GetTransactionLegResponse"
+          }
+        },
+        "method": {
+          "type": "string"
+        },
+        "quote": {
+          "$ref": "#/definitions/TransactionQuote"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "sender": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "shares": {
+          "description": "Shares of a group transaction in the order payers take them. Empty when the transaction has a single payer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GetTransactionShareResponse"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "processed",
+            "canceled",
+            "failed",
+            "succeeded",
+            "expired",
+            "scheduled",
+            "authorized",
+            "voided"
+          ]
+        },
+        "watch": {
+          "description": "Whether the transaction is paid from a wallet outside of the platform, by watching the receiver address.",
+          "type": "boolean"
+        }
+      }
+    },
+    "GetTransactionShareResponse": {
+      "type": "object",
+      "required": [
+        "share_id",
+        "amount",
+        "status"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount of the share in the minor unit of the transaction currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount of the share in the major unit of the transaction currency.",
+          "type": "string"
+        },
+        "payer": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "share_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "processed",
+            "succeeded",
+            "canceled",
+            "refunding",
+            "refunded",
+            "refund_failed"
+          ]
+        }
+      }
+    },
+    "GetTransactionStatusResponse": {
+      "type": "object",
+      "required": [
+        "transaction_status"
+      ],
+      "properties": {
+        "transaction_status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "processed",
+            "canceled",
+            "failed",
+            "succeeded",
+            "expired",
+            "scheduled",
+            "authorized",
+            "voided"
+          ]
+        }
+      }
+    },
+    "GetTransactionUserResponse": {
+      "type": "object",
+      "required": [
+        "user_id",
+        "wallet_id"
+      ],
+      "properties": {
+        "user_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "wallet_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "LedgerBalanceResponse": {
+      "type": "object",
+      "required": [
+        "currency",
+        "debits",
+        "credits",
+        "balance"
+      ],
+      "properties": {
+        "balance": {
+          "description": "Credits minus debits in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "balance_decimal": {
+          "description": "Balance in the major unit of the currency.",
+          "type": "string"
+        },
+        "credits": {
+          "description": "Sum of the credits in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "debits": {
+          "description": "Sum of the debits in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "LedgerStatementLineResponse": {
+      "type": "object",
+      "required": [
+        "entry_id",
+        "reference_id",
+        "transaction_id",
+        "kind",
+        "direction",
+        "amount",
+        "currency",
+        "created_at"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount in the major unit of the currency.",
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "direction": {
+          "type": "string",
+          "enum": [
+            "debit",
+            "credit"
+          ]
+        },
+        "entry_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "hold",
+            "settle",
+            "reverse"
+          ]
+        },
+        "reference_id": {
+          "description": "Transaction, share or share refund the entry books a payment of.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ListWebhookDeliveriesResponse": {
+      "type": "object",
+      "required": [
+        "deliveries"
+      ],
+      "properties": {
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WebhookDeliveryResponse"
+          }
+        }
+      }
+    },
+    "LoginRequest": {
+      "type": "object",
+      "required": [
+        "email",
+        "password"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        }
+      }
+    },
+    "LoginResponse": {
+      "type": "object",
+      "required": [
+        "two_factor_required"
+      ],
+      "properties": {
+        "auth_token": {
+          "type": "string"
+        },
+        "challenge_expires_in": {
+          "description": "Challenge token lifetime in seconds.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "challenge_token": {
+          "type": "string"
+        },
+        "expires_in": {
+          "description": "Auth token lifetime in seconds.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "refresh_token": {
+          "type": "string"
+        },
+        "token_type": {
+          "type": "string"
+        },
+        "two_factor_required": {
+          "description": "If set, the login must be completed on /transaction/login/2fa with the challenge token.",
+          "type": "boolean"
+        }
+      }
+    },
+    "LoginTwoFactorRequest": {
+      "type": "object",
+      "required": [
+        "challenge_token",
+        "code"
+      ],
+      "properties": {
+        "challenge_token": {
+          "type": "string"
+        },
+        "code": {
+          "description": "Six digit TOTP code or a recovery code.",
+          "type": "string"
+        }
+      }
+    },
+    "MoneyInfo": {
+      "type": "object",
+      "required": [
+        "method",
+        "currency",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor unit of the currency, cents for USD and microAlgos for ALGO.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand. Unknown codes are rejected.",
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        }
+      }
+    },
+    "PayPaymentPointRequest": {
+      "type": "object",
+      "required": [
+        "sender",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "confirmation_method": {
+          "description": "How the payer confirms a high-value transaction.",
+          "type": "string",
+          "default": "code",
+          "enum": [
+            "code",
+            "signature"
+          ]
+        },
+        "sender": {
+          "$ref": "#/definitions/AcceptTransactionUserRequest"
+        }
+      }
+    },
+    "PayPaymentPointResponse": {
+      "type": "object",
+      "required": [
+        "transaction_id"
+      ],
+      "properties": {
+        "confirmation": {
+          "$ref": "#/definitions/ConfirmationRequiredResponse"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "QuoteTransactionRequest": {
+      "type": "object",
+      "required": [
+        "currency"
+      ],
+      "properties": {
+        "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand, to pay the transaction in.",
+          "type": "string"
+        }
+      }
+    },
+    "RefreshLoginRequest": {
+      "type": "object",
+      "required": [
+        "refresh_token"
+      ],
+      "properties": {
+        "refresh_token": {
+          "type": "string"
+        }
+      }
+    },
+    "ResendWebhookDeliveryResponse": {
+      "type": "object",
+      "required": [
+        "delivery_id"
+      ],
+      "properties": {
+        "delivery_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "SetFeePlanRequest": {
+      "type": "object",
+      "required": [
+        "merchant_id",
+        "method",
+        "currency"
+      ],
+      "properties": {
+        "currency": {
+          "type": "string"
+        },
+        "fixed_amount": {
+          "description": "Fixed part of the fee in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "max_fee": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "merchant_id": {
+          "description": "User id of the merchant receiving the transactions.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "method": {
+          "type": "string"
+        },
+        "min_fee": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount with up to two decimals, like \"2.5\".",
+          "type": "string"
+        },
+        "tiers": {
+          "description": "Percentages replacing the plan percentage from a monthly volume on, at most one per volume.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FeePlanTier"
+          }
+        }
+      }
+    },
+    "TransactionFeeResponse": {
+      "type": "object",
+      "required": [
+        "currency",
+        "amount",
+        "fee",
+        "receiver_amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount the payer is charged in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "type": "string"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "fee": {
+          "description": "Fee deducted from what the receiver gets in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "fee_decimal": {
+          "type": "string"
+        },
+        "receiver_amount": {
+          "description": "Amount left to the receivers once the fee is deducted.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "receiver_amount_decimal": {
+          "type": "string"
+        }
+      }
+    },
+    "TransactionQuote": {
+      "type": "object",
+      "required": [
+        "currency",
+        "amount",
+        "rate",
+        "expires_at"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount charged in the minor unit of the quote currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount charged in the major unit of the quote currency.",
+          "type": "string"
+        },
+        "currency": {
+          "description": "Currency the transaction is paid in.",
+          "type": "string"
+        },
+        "expires_at": {
+          "description": "Time until which the rate is locked.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "rate": {
+          "description": "Price of one major unit of the transaction currency in the quote currency, margin included.",
+          "type": "string"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "TransactionStatusEvent": {
+      "type": "object",
+      "required": [
+        "transaction_id",
+        "status",
+        "occurred_at"
+      ],
+      "properties": {
+        "occurred_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "reason": {
+          "description": "Reason of the failure, set for failed transactions only.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "UnlockLoginRequest": {
+      "type": "object",
+      "required": [
+        "email"
+      ],
+      "properties": {
+        "client_ip": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string"
+        }
+      }
+    },
+    "WebhookDeliveryResponse": {
+      "type": "object",
+      "required": [
+        "delivery_id",
+        "transaction_id",
+        "status",
+        "previous_status",
+        "state",
+        "attempts",
+        "created_at"
+      ],
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "delivered_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "delivery_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "last_error": {
+          "type": "string"
+        },
+        "last_response_code": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "next_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "previous_status": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "succeeded",
+            "dead"
+          ]
+        },
+        "status": {
+          "description": "Transaction status the delivery notifies about.",
+          "type": "string"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "Bearer": {
+      "description": "Auth token received on login. Scopes are the roles of the user, any listed alternative grants access.",
+      "type": "oauth2",
+      "flow": "password",
+      "tokenUrl": "http://localhost:8083/api/v1/transaction/login",
+      "scopes": {
+        "admin": "Manage the service.",
+        "customer": "Pay for and confirm transactions.",
+        "merchant": "Create and manage own transactions."
+      }
+    }
+  },
+  "tags": [
+    {
+      "description": "Methods for transaction management.",
+      "name": "transaction"
+    },
+    {
+      "description": "Methods available only to administrators.",
+      "name": "admin"
+    },
+    {
+      "description": "Methods for static merchant payment points.",
+      "name": "payment_point"
+    },
+    {
+      "description": "Methods for merchant webhooks notified about transaction status changes.",
+      "name": "webhook"
+    },
+    {
+      "description": "Methods for recurring payment mandates charged on schedule.",
+      "name": "mandate"
+    },
+    {
+      "description": "Methods for the double-entry ledger of the money moved by transactions.",
+      "name": "ledger"
+    },
+    {
+      "description": "Methods for the fee plans merchants are charged on by payment method.",
+      "name": "fee"
+    },
+    {
+      "description": "Methods for the settlement reports of merchants.",
+      "name": "report"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "Transaction Service",
+    "license": {
+      "name": "MIT",
+      "url": "https://opensource.org/license/mit"
+    },
+    "version": "v1"
+  },
+  "host": "localhost:8083",
+  "basePath": "/api/v1",
+  "paths": {
+    "/admin/login/unlock": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "admin"
+        ],
+        "summary": "The method is used to lift a login lockout before it expires.",
+        "operationId": "unlockLogin",
+        "parameters": [
+          {
+            "description": "Account and optionally the ip address to unlock.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UnlockLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Login successfully unlocked."
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/fee-plan/merchant/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "fee"
+        ],
+        "summary": "The method is used to retrieve the fee plans of a merchant.",
+        "operationId": "getFeePlans",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "User id of the merchant.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Fee plans successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetFeePlansResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/fee-plan/set": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "The fee is the fixed amount plus the percentage of the transaction amount rounded down, kept between the minimal and maximal fee and never above the amount the receiver gets. A tier replaces the percentage once the merchant volume of the current month reaches its from_volume. Setting a plan replaces the previous plan of the merchant for the method and currency.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "fee"
+        ],
+        "summary": "The method is used to set the fee plan of a merchant for a payment method and currency.",
+        "operationId": "setFeePlan",
+        "parameters": [
+          {
+            "description": "Fee plan to set.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SetFeePlanRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Fee plan successfully set.",
+            "schema": {
+              "$ref": "#/definitions/FeePlanResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/ledger/account/{id}/balance": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "Wallet accounts are identified as wallet:\u003cwallet_id\u003e and are visible to their owner, the platform:fees and platform:clearing accounts to administrators only.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "ledger"
+        ],
+        "summary": "The method is used to retrieve the balances of a ledger account by currency.",
+        "operationId": "getLedgerBalance",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Ledger account id to retrieve the balances of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Ledger balances successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetLedgerBalanceResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/ledger/account/{id}/statement": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "ledger"
+        ],
+        "summary": "The method is used to retrieve the postings of a ledger account, newest first.",
+        "operationId": "getLedgerStatement",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Ledger account id to retrieve the statement of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Ledger statement successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetLedgerStatementResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/mandate/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used by the payer to authorise recurring payments to the receiver once, they are charged on schedule.",
+        "operationId": "createMandate",
+        "parameters": [
+          {
+            "description": "Created mandate object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateMandateRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreateMandateResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/mandate/{id}/cancel": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used to stop charging the mandate for good.",
+        "operationId": "cancelMandate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Mandate id to cancel.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully canceled."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The mandate is already canceled or completed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/mandate/{id}/pause": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used to stop charging the mandate until it is resumed.",
+        "operationId": "pauseMandate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Mandate id to pause.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully paused."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The mandate is not active or past due.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/mandate/{id}/resume": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used to resume charging a paused or unpaid mandate, the periods it missed are not charged.",
+        "operationId": "resumeMandate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Mandate id to resume.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully resumed."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The mandate is not paused or unpaid.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/mandate/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "mandate"
+        ],
+        "summary": "The method is used to retrieve the mandate with its schedule and dunning state.",
+        "operationId": "retrieveMandate",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Mandate id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Mandate successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetMandateResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to create a static payment point, its QR code is reused by every payer.",
+        "operationId": "createPaymentPoint",
+        "parameters": [
+          {
+            "description": "Created payment point object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreatePaymentPointRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment point successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreatePaymentPointResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/disable": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to disable the payment point, its QR code stops accepting payments.",
+        "operationId": "disablePaymentPoint",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to disable.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment point successfully disabled."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/pay": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to pay to the payment point, the transaction is created and accepted in one step.",
+        "operationId": "payPaymentPoint",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to pay to.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Payer and the amount entered by the payer.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PayPaymentPointRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully created and accepted.",
+            "schema": {
+              "$ref": "#/definitions/PayPaymentPointResponse"
+            }
+          },
+          "202": {
+            "description": "Transaction amount is above the threshold, payer confirmation is required.",
+            "schema": {
+              "$ref": "#/definitions/PayPaymentPointResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "Payment point is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The amount is outside of the payment point bounds.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/qr": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json",
+          "image/png",
+          "image/svg+xml",
+          "text/plain"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to render the static QR code of the payment point.",
+        "operationId": "getPaymentPointQR",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to render.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "png",
+              "svg",
+              "text"
+            ],
+            "type": "string",
+            "default": "png",
+            "description": "Image format of the QR code, text returns the payload itself.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "maximum": 2048,
+            "minimum": 64,
+            "type": "integer",
+            "format": "int32",
+            "default": 256,
+            "description": "Width and height of the image in pixels.",
+            "name": "size",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "L",
+              "M",
+              "Q",
+              "H"
+            ],
+            "type": "string",
+            "default": "M",
+            "description": "Error-correction level of the QR code.",
+            "name": "level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "QR code successfully rendered.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "Payment point is disabled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/payment-point/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "payment_point"
+        ],
+        "summary": "The method is used to retrieve the payment point.",
+        "operationId": "retrievePaymentPoint",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Payment point id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Payment point successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetPaymentPointResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/report/settlement": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "description": "The report has a statement per merchant and currency with the count and gross amount of the settled payments, their fees, the count and amount of the refunds and the net amount, together with the settlements themselves. The day is the UTC day, amounts are written in the major unit of the currency.\n",
+        "produces": [
+          "application/json",
+          "application/xml",
+          "text/csv"
+        ],
+        "tags": [
+          "report"
+        ],
+        "summary": "The method is used to render the settlement report of a day.",
+        "operationId": "getSettlementReport",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date",
+            "description": "Day to report.",
+            "name": "date",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "User id of the merchant to report, every merchant is reported when it is empty.",
+            "name": "merchant_id",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "csv",
+              "json",
+              "camt053"
+            ],
+            "type": "string",
+            "default": "csv",
+            "description": "Format of the report, camt053 is an ISO 20022 camt.053 bank to customer statement.",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Settlement report successfully rendered.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad request error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to create transactions.",
+        "operationId": "createTransaction",
+        "parameters": [
+          {
+            "description": "Created transaction object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreateTransactionResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/login": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to user login.",
+        "operationId": "login",
+        "parameters": [
+          {
+            "description": "Get login token.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoginRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully logined.",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Wrong email or password.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "Too many failed attempts, login is temporarily locked.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "format": "int64",
+                "description": "Seconds until the lock ends."
+              }
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/login/2fa": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to complete login with a two-factor code.",
+        "operationId": "loginTwoFactor",
+        "parameters": [
+          {
+            "description": "Challenge token received on login and a TOTP or recovery code.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoginTwoFactorRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully logined.",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Challenge token is invalid or the code is wrong.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "423": {
+            "description": "Too many failed attempts, login is temporarily locked.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "format": "int64",
+                "description": "Seconds until the lock ends."
+              }
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/login/refresh": {
+      "post": {
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to renew an expired auth token.",
+        "operationId": "refreshLogin",
+        "parameters": [
+          {
+            "description": "Refresh token received on login.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RefreshLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Auth token successfully renewed.",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Refresh token is invalid or expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/accept": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to accept the transaction.",
+        "operationId": "acceptTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Information required to accpet a transaction.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/AcceptTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully accepted."
+          },
+          "202": {
+            "description": "Transaction amount is above the threshold, payer confirmation is required.",
+            "schema": {
+              "$ref": "#/definitions/ConfirmationRequiredResponse"
+            }
+          },
+          "400": {
+            "description": "The QR payload is malformed, not signed by the service or issued for another transaction, or execute_at is set for a group transaction or a transaction with a locked quote.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The QR payload was already used, or every share of the group transaction is taken.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "The QR payload, the transaction or its locked exchange rate expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The transaction was edited after the QR payload was issued.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/cancel": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to cancel a transaction.",
+        "operationId": "cancelTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to cancel.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Information required to cancel a transaction.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CancelTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully cancelled."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction was already handed over to the payment gateway, it can no longer be cancelled.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/capture": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to capture the held amount of an authorized transaction, in full or in part.",
+        "operationId": "captureTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to capture.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Amount to capture, the whole held amount when omitted.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CaptureTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Capture requested, the rest of the hold is released."
+          },
+          "400": {
+            "description": "The amount exceeds the held amount, or a split or quoted transaction is captured in part.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is not authorized, or its hold expired or is being voided.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/confirm": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to confirm an accepted high-value transaction.",
+        "operationId": "confirmTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to confirm.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "One-time code or wallet signature over the transaction hash.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ConfirmTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully confirmed."
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Transaction can be confirmed only by its payer.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Transaction is not awaiting confirmation.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "Confirmation expired or ran out of attempts, the transaction must be accepted again.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Wrong confirmation code or signature.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/edit": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to edit a transaction.",
+        "operationId": "editTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to edit.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Information required to change a transaction.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/EditTransactionRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully edited."
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction was already accepted, canceled or expired, it can no longer be edited.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/events": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to stream status changes of the transaction as server-sent events. The current status is sent first unless the stream is resumed with Last-Event-ID.",
+        "operationId": "streamTransactionEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to stream status changes of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Id of the last received event, the events after it are replayed before the live ones.",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of ` + "`" + `status` + "`" + ` events carrying TransactionStatusEvent, comment lines are sent as heartbeat.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Malformed Last-Event-ID.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/fee": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "description": "The fee is calculated with the fee plan of the receiver for the transaction method and currency and the receiver volume of the current month. It is deducted from what the receiver gets, the payer is charged the transaction amount. The fee is fixed when the transaction is accepted.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to preview the fee charged on the transaction before accepting it.",
+        "operationId": "getTransactionFee",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to preview the fee of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Fee successfully calculated.",
+            "schema": {
+              "$ref": "#/definitions/TransactionFeeResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is no longer in the created status.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "The transaction expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/qr": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json",
+          "image/png",
+          "image/svg+xml",
+          "text/plain"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to render the payment QR code of the transaction. Every call issues a new signed single-use payload.",
+        "operationId": "getTransactionQR",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to render.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "png",
+              "svg",
+              "text"
+            ],
+            "type": "string",
+            "default": "png",
+            "description": "Image format of the QR code, text returns the signed payload itself.",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "maximum": 2048,
+            "minimum": 64,
+            "type": "integer",
+            "format": "int32",
+            "default": 256,
+            "description": "Width and height of the image in pixels.",
+            "name": "size",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "L",
+              "M",
+              "Q",
+              "H"
+            ],
+            "type": "string",
+            "default": "M",
+            "description": "Error-correction level of the QR code.",
+            "name": "level",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "QR code successfully rendered.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Bad request error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/quote": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          }
+        ],
+        "description": "The transaction amount is converted into the requested currency at the provider rate with the service margin. The rate stays locked until expires_at, the transaction must be accepted before then. A new quote replaces the previous one, editing the transaction drops it.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to lock an exchange rate for paying the transaction in another currency.",
+        "operationId": "quoteTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to quote.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Currency the payer wants to pay in.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/QuoteTransactionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Exchange rate locked.",
+            "schema": {
+              "$ref": "#/definitions/TransactionQuote"
+            }
+          },
+          "400": {
+            "description": "The currency is unknown or already the transaction currency.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is no longer in the created status, or it is a group transaction.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "410": {
+            "description": "The transaction expired.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "502": {
+            "description": "No exchange rate is available for the currency pair.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/retrieve": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to retrieve the transaction.",
+        "operationId": "retrieveTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to retrieve.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetTransactionResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/retrieve/status": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "customer"
+            ]
+          },
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to get the status of the transaction.",
+        "operationId": "retrieveTransactionStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to retrieve status.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Transaction status successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/GetTransactionStatusResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/transaction/{id}/void": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          },
+          {
+            "Bearer": [
+              "admin"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "transaction"
+        ],
+        "summary": "The method is used to release the hold of an authorized transaction back to the payer.",
+        "operationId": "voidTransaction",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Transaction id to void.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Void requested, the transaction is voided once the payment gateway releases the hold."
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "The transaction is not authorized.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/webhook/create": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "description": "Deliveries are POST requests with a JSON body. The X-Webhook-Signature header carries\n\"sha256=\" followed by the hex HMAC-SHA256 of the X-Webhook-Timestamp header value,\na dot and the body, keyed with the webhook secret.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "webhook"
+        ],
+        "summary": "The method is used to register a webhook notified about every status change of the merchant transactions.",
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "description": "Created webhook object.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CreateWebhookRequest"
+            }
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Webhook successfully created.",
+            "schema": {
+              "$ref": "#/definitions/CreateWebhookResponse"
+            }
+          },
+          "400": {
+            "description": "Validation error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/webhook/delivery/{id}/resend": {
+      "post": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "webhook"
+        ],
+        "summary": "The method is used to send the delivery again as a new delivery with fresh retries.",
+        "operationId": "resendWebhookDelivery",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Delivery id to resend.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "X-Idempotency-Key",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Delivery successfully enqueued again.",
+            "schema": {
+              "$ref": "#/definitions/ResendWebhookDeliveryResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/webhook/{id}/deliveries": {
+      "get": {
+        "security": [
+          {
+            "Bearer": [
+              "merchant"
+            ]
+          }
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "webhook"
+        ],
+        "summary": "The method is used to retrieve the delivery log of the webhook, newest deliveries first.",
+        "operationId": "listWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Webhook id to retrieve the deliveries of.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int32",
+            "default": 20,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int32",
+            "default": 0,
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Webhook deliveries successfully retrieved.",
+            "schema": {
+              "$ref": "#/definitions/ListWebhookDeliveriesResponse"
+            }
+          },
+          "403": {
+            "description": "Forbidden error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not found error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal server error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AcceptTransactionRequest": {
+      "type": "object",
       "required": [
-        "sender"
+        "sender",
+        "qr_payload"
       ],
       "properties": {
+        "confirmation_method": {
+          "description": "How the payer confirms a high-value transaction.",
+          "type": "string",
+          "default": "code",
+          "enum": [
+            "code",
+            "signature"
+          ]
+        },
+        "execute_at": {
+          "description": "Time to execute the accepted transaction at, like the due date of a rent. The transaction stays scheduled until then and can still be canceled. A time in the past executes it right away.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "qr_payload": {
+          "description": "Signed payload scanned from the transaction QR code, it can be used only once.",
+          "type": "string"
+        },
         "sender": {
           "$ref": "#/definitions/AcceptTransactionUserRequest"
         }
@@ -499,6 +6593,178 @@ func init() {
         }
       }
     },
+    "CaptureTransactionRequest": {
+      "type": "object",
+      "properties": {
+        "amount": {
+          "description": "Amount to capture in the minor unit of the transaction currency, at most the held amount.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "ConfirmTransactionRequest": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "description": "One-time code delivered to the payer.",
+          "type": "string"
+        },
+        "signature": {
+          "description": "Base64 encoded Algorand signBytes signature over the transaction hash.",
+          "type": "string"
+        }
+      }
+    },
+    "ConfirmationRequiredResponse": {
+      "type": "object",
+      "required": [
+        "confirmation_method",
+        "transaction_hash",
+        "expires_at"
+      ],
+      "properties": {
+        "confirmation_method": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "transaction_hash": {
+          "description": "Hex encoded hash to sign with the payer wallet key for the signature method.",
+          "type": "string"
+        }
+      }
+    },
+    "CreateMandateRequest": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "payer_wallet_id",
+        "money_info",
+        "interval",
+        "ends_at"
+      ],
+      "properties": {
+        "ends_at": {
+          "description": "The mandate is completed once the next charge would fall after it.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "interval": {
+          "type": "string",
+          "enum": [
+            "day",
+            "week",
+            "month",
+            "year"
+          ]
+        },
+        "interval_count": {
+          "description": "Number of intervals between charges.",
+          "type": "integer",
+          "format": "int32",
+          "default": 1,
+          "maximum": 365,
+          "minimum": 1
+        },
+        "money_info": {
+          "$ref": "#/definitions/MoneyInfo"
+        },
+        "payer_wallet_id": {
+          "description": "Wallet of the authenticated payer the mandate is charged from.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        },
+        "starts_at": {
+          "description": "Date of the first charge, the mandate is charged right away when it is omitted or in the past.",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "CreateMandateResponse": {
+      "type": "object",
+      "required": [
+        "mandate_id"
+      ],
+      "properties": {
+        "mandate_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreatePaymentPointRequest": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "method",
+        "currency"
+      ],
+      "properties": {
+        "currency": {
+          "type": "string"
+        },
+        "max_amount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "method": {
+          "type": "string"
+        },
+        "min_amount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        }
+      }
+    },
+    "CreatePaymentPointResponse": {
+      "type": "object",
+      "required": [
+        "payment_point_id"
+      ],
+      "properties": {
+        "payment_point_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreateTransactionLegRequest": {
+      "type": "object",
+      "required": [
+        "receiver"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Fixed amount in the minor unit of the transaction currency, exclusive with percentage.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount with up to two decimals, like \"2.5\", exclusive with amount.",
+          "type": "string",
+          "pattern": "^[0-9]{1,3}(\\.[0-9]{1,2})?$"
+        },
+        "receiver": {
+          "$ref": "#/definitions/CreateTransactionUserRequest"
+        }
+      }
+    },
     "CreateTransactionRequest": {
       "type": "object",
       "required": [
@@ -506,38 +6772,121 @@ func init() {
         "receiver"
       ],
       "properties": {
+        "capture_method": {
+          "description": "With manual, the accepted amount is only held on the payer side until the merchant captures or voids it, the hold is voided automatically once it times out. Group transactions are captured automatically.",
+          "type": "string",
+          "default": "automatic",
+          "enum": [
+            "automatic",
+            "manual"
+          ]
+        },
+        "expires_in": {
+          "description": "Seconds the transaction can be accepted for, the service default is used when omitted.",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 2592000,
+          "minimum": 60
+        },
+        "legs": {
+          "description": "Additional receivers to split the transaction with, the receiver gets the remainder of the amount.",
+          "type": "array",
+          "maxItems": 15,
+          "items": {
+            "$ref": "#/definitions/CreateTransactionLegRequest"
+          }
+        },
         "money_info": {
           "$ref": "#/definitions/MoneyInfo"
         },
         "receiver": {
           "$ref": "#/definitions/CreateTransactionUserRequest"
+        },
+        "shares": {
+          "description": "Shares of a group transaction, each accepted and paid by its own payer. They must add up to the amount.",
+          "type": "array",
+          "maxItems": 20,
+          "minItems": 2,
+          "items": {
+            "$ref": "#/definitions/CreateTransactionShareRequest"
+          }
+        },
+        "watch": {
+          "description": "Pays the transaction from any Algorand wallet outside of the platform. The transaction is processed right away, its QR code carries an algorand:// payment URI and the receiver address is watched for the payment until the transaction expires. Only unsplit ALGO transactions paid with algorand can be watched.",
+          "type": "boolean",
+          "default": false
+        }
+      }
+    },
+    "CreateTransactionResponse": {
+      "type": "object",
+      "required": [
+        "transaction_id"
+      ],
+      "properties": {
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "CreateTransactionShareRequest": {
+      "type": "object",
+      "required": [
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount of the share in the minor unit of the transaction currency.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
-    "CreateTransactionResponse": {
+    "CreateTransactionUserRequest": {
       "type": "object",
       "required": [
-        "transaction_id"
+        "user_id",
+        "wallet_id"
       ],
       "properties": {
-        "transaction_id": {
+        "user_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "wallet_id": {
           "type": "string",
           "format": "uuid"
         }
       }
     },
-    "CreateTransactionUserRequest": {
+    "CreateWebhookRequest": {
       "type": "object",
       "required": [
-        "user_id",
-        "wallet_id"
+        "url",
+        "secret"
       ],
       "properties": {
-        "user_id": {
+        "secret": {
           "type": "string",
-          "format": "uuid"
+          "maxLength": 256,
+          "minLength": 16
         },
-        "wallet_id": {
+        "url": {
+          "type": "string",
+          "format": "uri",
+          "pattern": "^https?://"
+        }
+      }
+    },
+    "CreateWebhookResponse": {
+      "type": "object",
+      "required": [
+        "webhook_id"
+      ],
+      "properties": {
+        "webhook_id": {
           "type": "string",
           "format": "uuid"
         }
@@ -547,10 +6896,12 @@ func init() {
       "type": "object",
       "properties": {
         "amount": {
+          "description": "Amount in the minor unit of the currency.",
           "type": "integer",
           "format": "int64"
         },
         "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand. Unknown codes are rejected.",
           "type": "string"
         },
         "method": {
@@ -578,832 +6929,1006 @@ func init() {
         }
       }
     },
-    "GetTransactionResponse": {
+    "FeePlanResponse": {
       "type": "object",
       "required": [
-        "receiver",
+        "merchant_id",
+        "method",
         "currency",
-        "amount",
-        "status",
-        "method"
+        "fixed_amount",
+        "percentage",
+        "tiers"
       ],
       "properties": {
-        "amount": {
+        "currency": {
+          "type": "string"
+        },
+        "fixed_amount": {
           "type": "integer",
           "format": "int64"
         },
-        "currency": {
-          "type": "string"
+        "max_fee": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "merchant_id": {
+          "type": "string",
+          "format": "uuid"
         },
         "method": {
           "type": "string"
         },
-        "receiver": {
-          "$ref": "#/definitions/GetTransactionUserResponse"
+        "min_fee": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         },
-        "sender": {
-          "$ref": "#/definitions/GetTransactionUserResponse"
+        "percentage": {
+          "type": "string"
         },
-        "status": {
+        "tiers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FeePlanTier"
+          }
+        },
+        "updated_at": {
           "type": "string",
-          "enum": [
-            "created",
-            "processed",
-            "canceled",
-            "failed",
-            "succeeded"
-          ]
+          "format": "date-time"
         }
       }
     },
-    "GetTransactionStatusResponse": {
+    "FeePlanTier": {
       "type": "object",
       "required": [
-        "transaction_status"
+        "from_volume",
+        "percentage"
       ],
       "properties": {
-        "transaction_status": {
-          "type": "string",
-          "enum": [
-            "created",
-            "processed",
-            "canceled",
-            "failed",
-            "succeeded"
-          ]
+        "from_volume": {
+          "description": "Volume of the succeeded transactions of the merchant in the current month, in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount with up to two decimals, like \"1.5\".",
+          "type": "string"
         }
       }
     },
-    "GetTransactionUserResponse": {
+    "GetFeePlansResponse": {
       "type": "object",
       "required": [
-        "user_id",
-        "wallet_id"
+        "plans"
       ],
       "properties": {
-        "user_id": {
-          "type": "string",
-          "format": "uuid"
+        "plans": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FeePlanResponse"
+          }
+        }
+      }
+    },
+    "GetLedgerBalanceResponse": {
+      "type": "object",
+      "required": [
+        "account_id",
+        "kind",
+        "balances"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
         },
-        "wallet_id": {
+        "balances": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LedgerBalanceResponse"
+          }
+        },
+        "kind": {
           "type": "string",
-          "format": "uuid"
+          "enum": [
+            "wallet",
+            "fees",
+            "clearing"
+          ]
         }
       }
     },
-    "LoginRequest": {
+    "GetLedgerStatementResponse": {
       "type": "object",
       "required": [
-        "email",
-        "password"
+        "account_id",
+        "lines"
       ],
       "properties": {
-        "email": {
+        "account_id": {
           "type": "string"
         },
-        "password": {
-          "type": "string"
+        "lines": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LedgerStatementLineResponse"
+          }
         }
       }
     },
-    "LoginResponse": {
+    "GetMandateResponse": {
       "type": "object",
       "required": [
-        "auth_token"
+        "mandate_id",
+        "receiver",
+        "payer",
+        "money_info",
+        "interval",
+        "interval_count",
+        "starts_at",
+        "ends_at",
+        "status",
+        "attempts"
       ],
       "properties": {
-        "auth_token": {
+        "amount_decimal": {
+          "description": "Amount of every charge in the major unit of the currency.",
+          "type": "string"
+        },
+        "attempts": {
+          "description": "Failed charges of the current period.",
+          "type": "integer",
+          "format": "int32"
+        },
+        "charge_transaction_id": {
+          "description": "Transaction of the charge that is being paid.",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "ends_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "interval": {
+          "type": "string"
+        },
+        "interval_count": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "last_error": {
+          "description": "Reason of the last failed charge.",
           "type": "string"
+        },
+        "mandate_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "money_info": {
+          "$ref": "#/definitions/MoneyInfo"
+        },
+        "next_charge_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "payer": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "period_start": {
+          "description": "Billing date of the period that is charged next.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "starts_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "A past due mandate retries the failed charge, an unpaid one ran out of retries and waits to be resumed.",
+          "type": "string",
+          "enum": [
+            "active",
+            "past_due",
+            "paused",
+            "unpaid",
+            "canceled",
+            "completed"
+          ]
         }
       }
     },
-    "MoneyInfo": {
+    "GetPaymentPointResponse": {
       "type": "object",
       "required": [
+        "receiver",
         "method",
         "currency",
-        "amount"
+        "active"
       ],
       "properties": {
-        "amount": {
-          "type": "integer",
-          "format": "int64"
+        "active": {
+          "type": "boolean"
         },
         "currency": {
           "type": "string"
         },
+        "max_amount": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "max_amount_decimal": {
+          "description": "Maximal amount in the major unit of the currency.",
+          "type": "string"
+        },
         "method": {
           "type": "string"
+        },
+        "min_amount": {
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "min_amount_decimal": {
+          "description": "Minimal amount in the major unit of the currency.",
+          "type": "string"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
         }
       }
-    }
-  },
-  "securityDefinitions": {
-    "Bearer": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "tags": [
-    {
-      "description": "Methods for transaction management.",
-      "name": "transaction"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "Transaction Service",
-    "license": {
-      "name": "MIT",
-      "url": "https://opensource.org/license/mit"
     },
-    "version": "v1"
-  },
-  "host": "localhost:8083",
-  "basePath": "/api/v1",
-  "paths": {
-    "/transaction/create": {
-      "post": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to create transactions.",
-        "operationId": "createTransaction",
-        "parameters": [
-          {
-            "description": "Created transaction object.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CreateTransactionRequest"
-            }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Transaction successfully created.",
-            "schema": {
-              "$ref": "#/definitions/CreateTransactionResponse"
-            }
-          },
-          "400": {
-            "description": "Validation error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "403": {
-            "description": "Forbidden error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "GetTransactionLegResponse": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "amount"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount the receiver gets in the minor unit of the transaction currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount, set for legs created with a percentage.",
+          "type": "string"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
         }
       }
     },
-    "/transaction/login": {
-      "post": {
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to user login.",
-        "operationId": "login",
-        "parameters": [
-          {
-            "description": "Get login token.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/LoginRequest"
-            }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
+    "GetTransactionResponse": {
+      "type": "object",
+      "required": [
+        "receiver",
+        "currency",
+        "amount",
+        "status",
+        "method"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor unit of the currency, cents for USD and microAlgos for ALGO.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount in the major unit of the currency with the currency number of decimals, like \"12.34\".",
+          "type": "string"
+        },
+        "authorized_until": {
+          "description": "Time the hold of an authorized transaction is voided at unless it is captured.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "capture_method": {
+          "type": "string",
+          "enum": [
+            "automatic",
+            "manual"
+          ]
+        },
+        "captured_amount": {
+          "description": "Amount taken out of the hold of a manually captured transaction, in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand.",
+          "type": "string"
+        },
+        "execute_at": {
+          "description": "Time a scheduled transaction is executed at.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "expires_at": {
+          "description": "Time after which the transaction can no longer be accepted.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "fee": {
+          "description": "Fee deducted from what the receiver gets, in the minor unit of the currency. Set when the transaction is accepted.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "fee_decimal": {
+          "description": "Fee in the major unit of the currency.",
+          "type": "string"
+        },
+        "legs": {
+          "description": "Receivers the transaction is split across, the first leg is the receiver. Empty when the transaction is not split.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GetTransactionLegResponse"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "User successfully logined.",
-            "schema": {
-              "$ref": "#/definitions/LoginResponse"
-            }
-          },
-          "400": {
-            "description": "Validation error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+        },
+        "method": {
+          "type": "string"
+        },
+        "quote": {
+          "$ref": "#/definitions/TransactionQuote"
+        },
+        "receiver": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "sender": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "shares": {
+          "description": "Shares of a group transaction in the order payers take them. Empty when the transaction has a single payer.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/GetTransactionShareResponse"
           }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "processed",
+            "canceled",
+            "failed",
+            "succeeded",
+            "expired",
+            "scheduled",
+            "authorized",
+            "voided"
+          ]
+        },
+        "watch": {
+          "description": "Whether the transaction is paid from a wallet outside of the platform, by watching the receiver address.",
+          "type": "boolean"
         }
       }
     },
-    "/transaction/{id}/accept": {
-      "post": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to accept the transaction.",
-        "operationId": "acceptTransaction",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to retrieve.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Information required to accpet a transaction.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/AcceptTransactionRequest"
-            }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Transaction successfully accepted."
-          },
-          "403": {
-            "description": "Forbidden error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not found error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "GetTransactionShareResponse": {
+      "type": "object",
+      "required": [
+        "share_id",
+        "amount",
+        "status"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount of the share in the minor unit of the transaction currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount of the share in the major unit of the transaction currency.",
+          "type": "string"
+        },
+        "payer": {
+          "$ref": "#/definitions/GetTransactionUserResponse"
+        },
+        "share_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "processed",
+            "succeeded",
+            "canceled",
+            "refunding",
+            "refunded",
+            "refund_failed"
+          ]
         }
       }
     },
-    "/transaction/{id}/cancel": {
-      "post": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to cancel a transaction.",
-        "operationId": "cancelTransaction",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to cancel.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Information required to cancel a transaction.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/CancelTransactionRequest"
-            }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Transaction successfully cancelled."
-          },
-          "403": {
-            "description": "Forbidden error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not found error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "GetTransactionStatusResponse": {
+      "type": "object",
+      "required": [
+        "transaction_status"
+      ],
+      "properties": {
+        "transaction_status": {
+          "type": "string",
+          "enum": [
+            "created",
+            "processed",
+            "canceled",
+            "failed",
+            "succeeded",
+            "expired",
+            "scheduled",
+            "authorized",
+            "voided"
+          ]
         }
       }
     },
-    "/transaction/{id}/edit": {
-      "post": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to edit a transaction.",
-        "operationId": "editTransaction",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to edit.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Information required to change a transaction.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/EditTransactionRequest"
-            }
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "X-Idempotency-Key",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Transaction successfully edited."
-          },
-          "400": {
-            "description": "Validation error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "403": {
-            "description": "Forbidden error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not found error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "GetTransactionUserResponse": {
+      "type": "object",
+      "required": [
+        "user_id",
+        "wallet_id"
+      ],
+      "properties": {
+        "user_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "wallet_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "/transaction/{id}/retrieve": {
-      "get": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to retrieve the transaction.",
-        "operationId": "retrieveTransaction",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to retrieve.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Transaction successfully retrieved.",
-            "schema": {
-              "$ref": "#/definitions/GetTransactionResponse"
-            }
-          },
-          "403": {
-            "description": "Forbidden error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not found error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "LedgerBalanceResponse": {
+      "type": "object",
+      "required": [
+        "currency",
+        "debits",
+        "credits",
+        "balance"
+      ],
+      "properties": {
+        "balance": {
+          "description": "Credits minus debits in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "balance_decimal": {
+          "description": "Balance in the major unit of the currency.",
+          "type": "string"
+        },
+        "credits": {
+          "description": "Sum of the credits in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "debits": {
+          "description": "Sum of the debits in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/transaction/{id}/retrieve/status": {
-      "get": {
-        "security": [
-          {
-            "Bearer": []
-          }
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "transaction"
-        ],
-        "summary": "The method is used to get the status of the transaction.",
-        "operationId": "retrieveTransactionStatus",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Transaction id to retrieve status.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Transaction status successfully retrieved.",
-            "schema": {
-              "$ref": "#/definitions/GetTransactionStatusResponse"
-            }
-          },
-          "403": {
-            "description": "Forbidden error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Not found error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "500": {
-            "description": "Internal server error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
+    "LedgerStatementLineResponse": {
+      "type": "object",
+      "required": [
+        "entry_id",
+        "reference_id",
+        "transaction_id",
+        "kind",
+        "direction",
+        "amount",
+        "currency",
+        "created_at"
+      ],
+      "properties": {
+        "amount": {
+          "description": "Amount in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount in the major unit of the currency.",
+          "type": "string"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "currency": {
+          "type": "string"
+        },
+        "direction": {
+          "type": "string",
+          "enum": [
+            "debit",
+            "credit"
+          ]
+        },
+        "entry_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "hold",
+            "settle",
+            "reverse"
+          ]
+        },
+        "reference_id": {
+          "description": "Transaction, share or share refund the entry books a payment of.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
-    }
-  },
-  "definitions": {
-    "AcceptTransactionRequest": {
+    },
+    "ListWebhookDeliveriesResponse": {
+      "type": "object",
+      "required": [
+        "deliveries"
+      ],
+      "properties": {
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WebhookDeliveryResponse"
+          }
+        }
+      }
+    },
+    "LoginRequest": {
       "type": "object",
       "required": [
-        "sender"
+        "email",
+        "password"
       ],
       "properties": {
-        "sender": {
-          "$ref": "#/definitions/AcceptTransactionUserRequest"
+        "email": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
         }
       }
     },
-    "AcceptTransactionUserRequest": {
+    "LoginResponse": {
       "type": "object",
       "required": [
-        "user_id",
-        "wallet_id"
+        "two_factor_required"
       ],
       "properties": {
-        "user_id": {
-          "type": "string",
-          "format": "uuid"
+        "auth_token": {
+          "type": "string"
         },
-        "wallet_id": {
-          "type": "string",
-          "format": "uuid"
+        "challenge_expires_in": {
+          "description": "Challenge token lifetime in seconds.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "challenge_token": {
+          "type": "string"
+        },
+        "expires_in": {
+          "description": "Auth token lifetime in seconds.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "refresh_token": {
+          "type": "string"
+        },
+        "token_type": {
+          "type": "string"
+        },
+        "two_factor_required": {
+          "description": "If set, the login must be completed on /transaction/login/2fa with the challenge token.",
+          "type": "boolean"
         }
       }
     },
-    "CancelTransactionRequest": {
+    "LoginTwoFactorRequest": {
       "type": "object",
+      "required": [
+        "challenge_token",
+        "code"
+      ],
       "properties": {
-        "reason": {
+        "challenge_token": {
+          "type": "string"
+        },
+        "code": {
+          "description": "Six digit TOTP code or a recovery code.",
           "type": "string"
         }
       }
     },
-    "CreateTransactionRequest": {
+    "MoneyInfo": {
       "type": "object",
       "required": [
-        "money_info",
-        "receiver"
+        "method",
+        "currency",
+        "amount"
       ],
       "properties": {
-        "money_info": {
-          "$ref": "#/definitions/MoneyInfo"
+        "amount": {
+          "description": "Amount in the minor unit of the currency, cents for USD and microAlgos for ALGO.",
+          "type": "integer",
+          "format": "int64"
         },
-        "receiver": {
-          "$ref": "#/definitions/CreateTransactionUserRequest"
+        "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand. Unknown codes are rejected.",
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
         }
       }
     },
-    "CreateTransactionResponse": {
+    "PayPaymentPointRequest": {
       "type": "object",
       "required": [
-        "transaction_id"
+        "sender",
+        "amount"
       ],
       "properties": {
-        "transaction_id": {
+        "amount": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "confirmation_method": {
+          "description": "How the payer confirms a high-value transaction.",
           "type": "string",
-          "format": "uuid"
+          "default": "code",
+          "enum": [
+            "code",
+            "signature"
+          ]
+        },
+        "sender": {
+          "$ref": "#/definitions/AcceptTransactionUserRequest"
         }
       }
     },
-    "CreateTransactionUserRequest": {
+    "PayPaymentPointResponse": {
       "type": "object",
       "required": [
-        "user_id",
-        "wallet_id"
+        "transaction_id"
       ],
       "properties": {
-        "user_id": {
-          "type": "string",
-          "format": "uuid"
+        "confirmation": {
+          "$ref": "#/definitions/ConfirmationRequiredResponse"
         },
-        "wallet_id": {
+        "transaction_id": {
           "type": "string",
           "format": "uuid"
         }
       }
     },
-    "EditMoneyInfo": {
+    "QuoteTransactionRequest": {
       "type": "object",
+      "required": [
+        "currency"
+      ],
       "properties": {
-        "amount": {
-          "type": "integer",
-          "format": "int64"
-        },
         "currency": {
+          "description": "ISO 4217 alphabetic code, or ALGO for Algorand, to pay the transaction in.",
           "type": "string"
-        },
-        "method": {
+        }
+      }
+    },
+    "RefreshLoginRequest": {
+      "type": "object",
+      "required": [
+        "refresh_token"
+      ],
+      "properties": {
+        "refresh_token": {
           "type": "string"
         }
       }
     },
-    "EditTransactionRequest": {
+    "ResendWebhookDeliveryResponse": {
       "type": "object",
+      "required": [
+        "delivery_id"
+      ],
       "properties": {
-        "money_info": {
-          "$ref": "#/definitions/EditMoneyInfo"
+        "delivery_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "ErrorResponse": {
+    "SetFeePlanRequest": {
       "type": "object",
+      "required": [
+        "merchant_id",
+        "method",
+        "currency"
+      ],
       "properties": {
-        "code": {
+        "currency": {
+          "type": "string"
+        },
+        "fixed_amount": {
+          "description": "Fixed part of the fee in the minor unit of the currency.",
           "type": "integer",
-          "format": "int32"
+          "format": "int64",
+          "minimum": 0
         },
-        "message": {
+        "max_fee": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "merchant_id": {
+          "description": "User id of the merchant receiving the transactions.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "method": {
+          "type": "string"
+        },
+        "min_fee": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "percentage": {
+          "description": "Percentage of the transaction amount with up to two decimals, like \"2.5\".",
           "type": "string"
+        },
+        "tiers": {
+          "description": "Percentages replacing the plan percentage from a monthly volume on, at most one per volume.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FeePlanTier"
+          }
         }
       }
     },
-    "GetTransactionResponse": {
+    "TransactionFeeResponse": {
       "type": "object",
       "required": [
-        "receiver",
         "currency",
         "amount",
-        "status",
-        "method"
+        "fee",
+        "receiver_amount"
       ],
       "properties": {
         "amount": {
+          "description": "Amount the payer is charged in the minor unit of the currency.",
           "type": "integer",
           "format": "int64"
         },
-        "currency": {
+        "amount_decimal": {
           "type": "string"
         },
-        "method": {
+        "currency": {
           "type": "string"
         },
-        "receiver": {
-          "$ref": "#/definitions/GetTransactionUserResponse"
+        "fee": {
+          "description": "Fee deducted from what the receiver gets in the minor unit of the currency.",
+          "type": "integer",
+          "format": "int64"
         },
-        "sender": {
-          "$ref": "#/definitions/GetTransactionUserResponse"
+        "fee_decimal": {
+          "type": "string"
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "created",
-            "processed",
-            "canceled",
-            "failed",
-            "succeeded"
-          ]
+        "receiver_amount": {
+          "description": "Amount left to the receivers once the fee is deducted.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "receiver_amount_decimal": {
+          "type": "string"
         }
       }
     },
-    "GetTransactionStatusResponse": {
+    "TransactionQuote": {
       "type": "object",
       "required": [
-        "transaction_status"
+        "currency",
+        "amount",
+        "rate",
+        "expires_at"
       ],
       "properties": {
-        "transaction_status": {
+        "amount": {
+          "description": "Amount charged in the minor unit of the quote currency.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "amount_decimal": {
+          "description": "Amount charged in the major unit of the quote currency.",
+          "type": "string"
+        },
+        "currency": {
+          "description": "Currency the transaction is paid in.",
+          "type": "string"
+        },
+        "expires_at": {
+          "description": "Time until which the rate is locked.",
           "type": "string",
-          "enum": [
-            "created",
-            "processed",
-            "canceled",
-            "failed",
-            "succeeded"
-          ]
+          "format": "date-time"
+        },
+        "rate": {
+          "description": "Price of one major unit of the transaction currency in the quote currency, margin included.",
+          "type": "string"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     },
-    "GetTransactionUserResponse": {
+    "TransactionStatusEvent": {
       "type": "object",
       "required": [
-        "user_id",
-        "wallet_id"
+        "transaction_id",
+        "status",
+        "occurred_at"
       ],
       "properties": {
-        "user_id": {
+        "occurred_at": {
           "type": "string",
-          "format": "uuid"
+          "format": "date-time"
         },
-        "wallet_id": {
+        "reason": {
+          "description": "Reason of the failure, set for failed transactions only.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "transaction_id": {
           "type": "string",
           "format": "uuid"
         }
       }
     },
-    "LoginRequest": {
+    "UnlockLoginRequest": {
       "type": "object",
       "required": [
-        "email",
-        "password"
+        "email"
       ],
       "properties": {
-        "email": {
+        "client_ip": {
           "type": "string"
         },
-        "password": {
+        "email": {
           "type": "string"
         }
       }
     },
-    "LoginResponse": {
+    "WebhookDeliveryResponse": {
       "type": "object",
       "required": [
-        "auth_token"
+        "delivery_id",
+        "transaction_id",
+        "status",
+        "previous_status",
+        "state",
+        "attempts",
+        "created_at"
       ],
       "properties": {
-        "auth_token": {
+        "attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "delivered_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "delivery_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "last_error": {
           "type": "string"
-        }
-      }
-    },
-    "MoneyInfo": {
-      "type": "object",
-      "required": [
-        "method",
-        "currency",
-        "amount"
-      ],
-      "properties": {
-        "amount": {
+        },
+        "last_response_code": {
           "type": "integer",
-          "format": "int64"
+          "format": "int32",
+          "x-nullable": true
         },
-        "currency": {
+        "next_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "previous_status": {
           "type": "string"
         },
-        "method": {
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "succeeded",
+            "dead"
+          ]
+        },
+        "status": {
+          "description": "Transaction status the delivery notifies about.",
           "type": "string"
+        },
+        "transaction_id": {
+          "type": "string",
+          "format": "uuid"
         }
       }
     }
   },
   "securityDefinitions": {
     "Bearer": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
+      "description": "Auth token received on login. Scopes are the roles of the user, any listed alternative grants access.",
+      "type": "oauth2",
+      "flow": "password",
+      "tokenUrl": "http://localhost:8083/api/v1/transaction/login",
+      "scopes": {
+        "admin": "Manage the service.",
+        "customer": "Pay for and confirm transactions.",
+        "merchant": "Create and manage own transactions."
+      }
     }
   },
   "tags": [
     {
       "description": "Methods for transaction management.",
       "name": "transaction"
+    },
+    {
+      "description": "Methods available only to administrators.",
+      "name": "admin"
+    },
+    {
+      "description": "Methods for static merchant payment points.",
+      "name": "payment_point"
+    },
+    {
+      "description": "Methods for merchant webhooks notified about transaction status changes.",
+      "name": "webhook"
+    },
+    {
+      "description": "Methods for recurring payment mandates charged on schedule.",
+      "name": "mandate"
+    },
+    {
+      "description": "Methods for the double-entry ledger of the money moved by transactions.",
+      "name": "ledger"
+    },
+    {
+      "description": "Methods for the fee plans merchants are charged on by payment method.",
+      "name": "fee"
+    },
+    {
+      "description": "Methods for the settlement reports of merchants.",
+      "name": "report"
     }
   ]
 }`))
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// UnlockLoginHandlerFunc turns a function with the right signature into a unlock login handler
+type UnlockLoginHandlerFunc func(UnlockLoginParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UnlockLoginHandlerFunc) Handle(params UnlockLoginParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UnlockLoginHandler interface for that can handle valid unlock login params
+type UnlockLoginHandler interface {
+	Handle(UnlockLoginParams, interface{}) middleware.Responder
+}
+
+// NewUnlockLogin creates a new http.Handler for the unlock login operation
+func NewUnlockLogin(ctx *middleware.Context, handler UnlockLoginHandler) *UnlockLogin {
+	return &UnlockLogin{Context: ctx, Handler: handler}
+}
+
+/*
+	UnlockLogin swagger:route POST /admin/login/unlock admin unlockLogin
+
+The method is used to lift a login lockout before it expires.
+*/
+type UnlockLogin struct {
+	Context *middleware.Context
+	Handler UnlockLoginHandler
+}
+
+func (o *UnlockLogin) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUnlockLoginParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// UnlockLoginNoContentCode is the HTTP code returned for type UnlockLoginNoContent
+const UnlockLoginNoContentCode int = 204
+
+/*
+UnlockLoginNoContent Login successfully unlocked.
+
+swagger:response unlockLoginNoContent
+*/
+type UnlockLoginNoContent struct {
+}
+
+// NewUnlockLoginNoContent creates UnlockLoginNoContent with default headers values
+func NewUnlockLoginNoContent() *UnlockLoginNoContent {
+
+	return &UnlockLoginNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UnlockLoginNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// UnlockLoginBadRequestCode is the HTTP code returned for type UnlockLoginBadRequest
+const UnlockLoginBadRequestCode int = 400
+
+/*
+UnlockLoginBadRequest Validation error.
+
+swagger:response unlockLoginBadRequest
+*/
+type UnlockLoginBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUnlockLoginBadRequest creates UnlockLoginBadRequest with default headers values
+func NewUnlockLoginBadRequest() *UnlockLoginBadRequest {
+
+	return &UnlockLoginBadRequest{}
+}
+
+// WithPayload adds the payload to the unlock login bad request response
+func (o *UnlockLoginBadRequest) WithPayload(payload *models.ErrorResponse) *UnlockLoginBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unlock login bad request response
+func (o *UnlockLoginBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnlockLoginBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UnlockLoginForbiddenCode is the HTTP code returned for type UnlockLoginForbidden
+const UnlockLoginForbiddenCode int = 403
+
+/*
+UnlockLoginForbidden Forbidden error.
+
+swagger:response unlockLoginForbidden
+*/
+type UnlockLoginForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUnlockLoginForbidden creates UnlockLoginForbidden with default headers values
+func NewUnlockLoginForbidden() *UnlockLoginForbidden {
+
+	return &UnlockLoginForbidden{}
+}
+
+// WithPayload adds the payload to the unlock login forbidden response
+func (o *UnlockLoginForbidden) WithPayload(payload *models.ErrorResponse) *UnlockLoginForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unlock login forbidden response
+func (o *UnlockLoginForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnlockLoginForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UnlockLoginInternalServerErrorCode is the HTTP code returned for type UnlockLoginInternalServerError
+const UnlockLoginInternalServerErrorCode int = 500
+
+/*
+UnlockLoginInternalServerError Internal server error.
+
+swagger:response unlockLoginInternalServerError
+*/
+type UnlockLoginInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewUnlockLoginInternalServerError creates UnlockLoginInternalServerError with default headers values
+func NewUnlockLoginInternalServerError() *UnlockLoginInternalServerError {
+
+	return &UnlockLoginInternalServerError{}
+}
+
+// WithPayload adds the payload to the unlock login internal server error response
+func (o *UnlockLoginInternalServerError) WithPayload(payload *models.ErrorResponse) *UnlockLoginInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unlock login internal server error response
+func (o *UnlockLoginInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnlockLoginInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
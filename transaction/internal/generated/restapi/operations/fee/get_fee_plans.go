@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package fee
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetFeePlansHandlerFunc turns a function with the right signature into a get fee plans handler
+type GetFeePlansHandlerFunc func(GetFeePlansParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetFeePlansHandlerFunc) Handle(params GetFeePlansParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetFeePlansHandler interface for that can handle valid get fee plans params
+type GetFeePlansHandler interface {
+	Handle(GetFeePlansParams, interface{}) middleware.Responder
+}
+
+// NewGetFeePlans creates a new http.Handler for the get fee plans operation
+func NewGetFeePlans(ctx *middleware.Context, handler GetFeePlansHandler) *GetFeePlans {
+	return &GetFeePlans{Context: ctx, Handler: handler}
+}
+
+/*
+	GetFeePlans swagger:route GET /fee-plan/merchant/{id}/retrieve fee getFeePlans
+
+The method is used to retrieve the fee plans of a merchant.
+*/
+type GetFeePlans struct {
+	Context *middleware.Context
+	Handler GetFeePlansHandler
+}
+
+func (o *GetFeePlans) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetFeePlansParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
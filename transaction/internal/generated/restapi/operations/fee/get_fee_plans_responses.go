@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package fee
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetFeePlansOKCode is the HTTP code returned for type GetFeePlansOK
+const GetFeePlansOKCode int = 200
+
+/*
+GetFeePlansOK Fee plans successfully retrieved.
+
+swagger:response getFeePlansOK
+*/
+type GetFeePlansOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetFeePlansResponse `json:"body,omitempty"`
+}
+
+// NewGetFeePlansOK creates GetFeePlansOK with default headers values
+func NewGetFeePlansOK() *GetFeePlansOK {
+
+	return &GetFeePlansOK{}
+}
+
+// WithPayload adds the payload to the get fee plans o k response
+func (o *GetFeePlansOK) WithPayload(payload *models.GetFeePlansResponse) *GetFeePlansOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get fee plans o k response
+func (o *GetFeePlansOK) SetPayload(payload *models.GetFeePlansResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFeePlansOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetFeePlansForbiddenCode is the HTTP code returned for type GetFeePlansForbidden
+const GetFeePlansForbiddenCode int = 403
+
+/*
+GetFeePlansForbidden Forbidden error.
+
+swagger:response getFeePlansForbidden
+*/
+type GetFeePlansForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetFeePlansForbidden creates GetFeePlansForbidden with default headers values
+func NewGetFeePlansForbidden() *GetFeePlansForbidden {
+
+	return &GetFeePlansForbidden{}
+}
+
+// WithPayload adds the payload to the get fee plans forbidden response
+func (o *GetFeePlansForbidden) WithPayload(payload *models.ErrorResponse) *GetFeePlansForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get fee plans forbidden response
+func (o *GetFeePlansForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFeePlansForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetFeePlansInternalServerErrorCode is the HTTP code returned for type GetFeePlansInternalServerError
+const GetFeePlansInternalServerErrorCode int = 500
+
+/*
+GetFeePlansInternalServerError Internal server error.
+
+swagger:response getFeePlansInternalServerError
+*/
+type GetFeePlansInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetFeePlansInternalServerError creates GetFeePlansInternalServerError with default headers values
+func NewGetFeePlansInternalServerError() *GetFeePlansInternalServerError {
+
+	return &GetFeePlansInternalServerError{}
+}
+
+// WithPayload adds the payload to the get fee plans internal server error response
+func (o *GetFeePlansInternalServerError) WithPayload(payload *models.ErrorResponse) *GetFeePlansInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get fee plans internal server error response
+func (o *GetFeePlansInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFeePlansInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package fee
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// SetFeePlanHandlerFunc turns a function with the right signature into a set fee plan handler
+type SetFeePlanHandlerFunc func(SetFeePlanParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetFeePlanHandlerFunc) Handle(params SetFeePlanParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetFeePlanHandler interface for that can handle valid set fee plan params
+type SetFeePlanHandler interface {
+	Handle(SetFeePlanParams, interface{}) middleware.Responder
+}
+
+// NewSetFeePlan creates a new http.Handler for the set fee plan operation
+func NewSetFeePlan(ctx *middleware.Context, handler SetFeePlanHandler) *SetFeePlan {
+	return &SetFeePlan{Context: ctx, Handler: handler}
+}
+
+/*
+	SetFeePlan swagger:route POST /fee-plan/set fee setFeePlan
+
+The method is used to set the fee plan of a merchant for a payment method and currency.
+
+The fee is the fixed amount plus the percentage of the transaction amount rounded down, kept between the minimal and maximal fee and never above the amount the receiver gets. A tier replaces the percentage once the merchant volume of the current month reaches its from_volume. Setting a plan replaces the previous plan of the merchant for the method and currency.
+*/
+type SetFeePlan struct {
+	Context *middleware.Context
+	Handler SetFeePlanHandler
+}
+
+func (o *SetFeePlan) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSetFeePlanParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package fee
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// SetFeePlanOKCode is the HTTP code returned for type SetFeePlanOK
+const SetFeePlanOKCode int = 200
+
+/*
+SetFeePlanOK Fee plan successfully set.
+
+swagger:response setFeePlanOK
+*/
+type SetFeePlanOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.FeePlanResponse `json:"body,omitempty"`
+}
+
+// NewSetFeePlanOK creates SetFeePlanOK with default headers values
+func NewSetFeePlanOK() *SetFeePlanOK {
+
+	return &SetFeePlanOK{}
+}
+
+// WithPayload adds the payload to the set fee plan o k response
+func (o *SetFeePlanOK) WithPayload(payload *models.FeePlanResponse) *SetFeePlanOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set fee plan o k response
+func (o *SetFeePlanOK) SetPayload(payload *models.FeePlanResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetFeePlanOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SetFeePlanBadRequestCode is the HTTP code returned for type SetFeePlanBadRequest
+const SetFeePlanBadRequestCode int = 400
+
+/*
+SetFeePlanBadRequest Validation error.
+
+swagger:response setFeePlanBadRequest
+*/
+type SetFeePlanBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSetFeePlanBadRequest creates SetFeePlanBadRequest with default headers values
+func NewSetFeePlanBadRequest() *SetFeePlanBadRequest {
+
+	return &SetFeePlanBadRequest{}
+}
+
+// WithPayload adds the payload to the set fee plan bad request response
+func (o *SetFeePlanBadRequest) WithPayload(payload *models.ErrorResponse) *SetFeePlanBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set fee plan bad request response
+func (o *SetFeePlanBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetFeePlanBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SetFeePlanForbiddenCode is the HTTP code returned for type SetFeePlanForbidden
+const SetFeePlanForbiddenCode int = 403
+
+/*
+SetFeePlanForbidden Forbidden error.
+
+swagger:response setFeePlanForbidden
+*/
+type SetFeePlanForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSetFeePlanForbidden creates SetFeePlanForbidden with default headers values
+func NewSetFeePlanForbidden() *SetFeePlanForbidden {
+
+	return &SetFeePlanForbidden{}
+}
+
+// WithPayload adds the payload to the set fee plan forbidden response
+func (o *SetFeePlanForbidden) WithPayload(payload *models.ErrorResponse) *SetFeePlanForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set fee plan forbidden response
+func (o *SetFeePlanForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetFeePlanForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// SetFeePlanInternalServerErrorCode is the HTTP code returned for type SetFeePlanInternalServerError
+const SetFeePlanInternalServerErrorCode int = 500
+
+/*
+SetFeePlanInternalServerError Internal server error.
+
+swagger:response setFeePlanInternalServerError
+*/
+type SetFeePlanInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewSetFeePlanInternalServerError creates SetFeePlanInternalServerError with default headers values
+func NewSetFeePlanInternalServerError() *SetFeePlanInternalServerError {
+
+	return &SetFeePlanInternalServerError{}
+}
+
+// WithPayload adds the payload to the set fee plan internal server error response
+func (o *SetFeePlanInternalServerError) WithPayload(payload *models.ErrorResponse) *SetFeePlanInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set fee plan internal server error response
+func (o *SetFeePlanInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetFeePlanInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ledger
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetLedgerBalanceHandlerFunc turns a function with the right signature into a get ledger balance handler
+type GetLedgerBalanceHandlerFunc func(GetLedgerBalanceParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetLedgerBalanceHandlerFunc) Handle(params GetLedgerBalanceParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetLedgerBalanceHandler interface for that can handle valid get ledger balance params
+type GetLedgerBalanceHandler interface {
+	Handle(GetLedgerBalanceParams, interface{}) middleware.Responder
+}
+
+// NewGetLedgerBalance creates a new http.Handler for the get ledger balance operation
+func NewGetLedgerBalance(ctx *middleware.Context, handler GetLedgerBalanceHandler) *GetLedgerBalance {
+	return &GetLedgerBalance{Context: ctx, Handler: handler}
+}
+
+/*
+	GetLedgerBalance swagger:route GET /ledger/account/{id}/balance ledger getLedgerBalance
+
+The method is used to retrieve the balances of a ledger account by currency.
+
+Wallet accounts are identified as wallet:<wallet_id> and are visible to their owner, the platform:fees and platform:clearing accounts to administrators only.
+*/
+type GetLedgerBalance struct {
+	Context *middleware.Context
+	Handler GetLedgerBalanceHandler
+}
+
+func (o *GetLedgerBalance) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetLedgerBalanceParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ledger
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetLedgerBalanceOKCode is the HTTP code returned for type GetLedgerBalanceOK
+const GetLedgerBalanceOKCode int = 200
+
+/*
+GetLedgerBalanceOK Ledger balances successfully retrieved.
+
+swagger:response getLedgerBalanceOK
+*/
+type GetLedgerBalanceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetLedgerBalanceResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerBalanceOK creates GetLedgerBalanceOK with default headers values
+func NewGetLedgerBalanceOK() *GetLedgerBalanceOK {
+
+	return &GetLedgerBalanceOK{}
+}
+
+// WithPayload adds the payload to the get ledger balance o k response
+func (o *GetLedgerBalanceOK) WithPayload(payload *models.GetLedgerBalanceResponse) *GetLedgerBalanceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger balance o k response
+func (o *GetLedgerBalanceOK) SetPayload(payload *models.GetLedgerBalanceResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerBalanceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetLedgerBalanceForbiddenCode is the HTTP code returned for type GetLedgerBalanceForbidden
+const GetLedgerBalanceForbiddenCode int = 403
+
+/*
+GetLedgerBalanceForbidden Forbidden error.
+
+swagger:response getLedgerBalanceForbidden
+*/
+type GetLedgerBalanceForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerBalanceForbidden creates GetLedgerBalanceForbidden with default headers values
+func NewGetLedgerBalanceForbidden() *GetLedgerBalanceForbidden {
+
+	return &GetLedgerBalanceForbidden{}
+}
+
+// WithPayload adds the payload to the get ledger balance forbidden response
+func (o *GetLedgerBalanceForbidden) WithPayload(payload *models.ErrorResponse) *GetLedgerBalanceForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger balance forbidden response
+func (o *GetLedgerBalanceForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerBalanceForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetLedgerBalanceNotFoundCode is the HTTP code returned for type GetLedgerBalanceNotFound
+const GetLedgerBalanceNotFoundCode int = 404
+
+/*
+GetLedgerBalanceNotFound Not found error.
+
+swagger:response getLedgerBalanceNotFound
+*/
+type GetLedgerBalanceNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerBalanceNotFound creates GetLedgerBalanceNotFound with default headers values
+func NewGetLedgerBalanceNotFound() *GetLedgerBalanceNotFound {
+
+	return &GetLedgerBalanceNotFound{}
+}
+
+// WithPayload adds the payload to the get ledger balance not found response
+func (o *GetLedgerBalanceNotFound) WithPayload(payload *models.ErrorResponse) *GetLedgerBalanceNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger balance not found response
+func (o *GetLedgerBalanceNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerBalanceNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetLedgerBalanceInternalServerErrorCode is the HTTP code returned for type GetLedgerBalanceInternalServerError
+const GetLedgerBalanceInternalServerErrorCode int = 500
+
+/*
+GetLedgerBalanceInternalServerError Internal server error.
+
+swagger:response getLedgerBalanceInternalServerError
+*/
+type GetLedgerBalanceInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerBalanceInternalServerError creates GetLedgerBalanceInternalServerError with default headers values
+func NewGetLedgerBalanceInternalServerError() *GetLedgerBalanceInternalServerError {
+
+	return &GetLedgerBalanceInternalServerError{}
+}
+
+// WithPayload adds the payload to the get ledger balance internal server error response
+func (o *GetLedgerBalanceInternalServerError) WithPayload(payload *models.ErrorResponse) *GetLedgerBalanceInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger balance internal server error response
+func (o *GetLedgerBalanceInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerBalanceInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ledger
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetLedgerStatementHandlerFunc turns a function with the right signature into a get ledger statement handler
+type GetLedgerStatementHandlerFunc func(GetLedgerStatementParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetLedgerStatementHandlerFunc) Handle(params GetLedgerStatementParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetLedgerStatementHandler interface for that can handle valid get ledger statement params
+type GetLedgerStatementHandler interface {
+	Handle(GetLedgerStatementParams, interface{}) middleware.Responder
+}
+
+// NewGetLedgerStatement creates a new http.Handler for the get ledger statement operation
+func NewGetLedgerStatement(ctx *middleware.Context, handler GetLedgerStatementHandler) *GetLedgerStatement {
+	return &GetLedgerStatement{Context: ctx, Handler: handler}
+}
+
+/*
+	GetLedgerStatement swagger:route GET /ledger/account/{id}/statement ledger getLedgerStatement
+
+The method is used to retrieve the postings of a ledger account, newest first.
+*/
+type GetLedgerStatement struct {
+	Context *middleware.Context
+	Handler GetLedgerStatementHandler
+}
+
+func (o *GetLedgerStatement) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetLedgerStatementParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ledger
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetLedgerStatementOKCode is the HTTP code returned for type GetLedgerStatementOK
+const GetLedgerStatementOKCode int = 200
+
+/*
+GetLedgerStatementOK Ledger statement successfully retrieved.
+
+swagger:response getLedgerStatementOK
+*/
+type GetLedgerStatementOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetLedgerStatementResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerStatementOK creates GetLedgerStatementOK with default headers values
+func NewGetLedgerStatementOK() *GetLedgerStatementOK {
+
+	return &GetLedgerStatementOK{}
+}
+
+// WithPayload adds the payload to the get ledger statement o k response
+func (o *GetLedgerStatementOK) WithPayload(payload *models.GetLedgerStatementResponse) *GetLedgerStatementOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger statement o k response
+func (o *GetLedgerStatementOK) SetPayload(payload *models.GetLedgerStatementResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerStatementOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetLedgerStatementForbiddenCode is the HTTP code returned for type GetLedgerStatementForbidden
+const GetLedgerStatementForbiddenCode int = 403
+
+/*
+GetLedgerStatementForbidden Forbidden error.
+
+swagger:response getLedgerStatementForbidden
+*/
+type GetLedgerStatementForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerStatementForbidden creates GetLedgerStatementForbidden with default headers values
+func NewGetLedgerStatementForbidden() *GetLedgerStatementForbidden {
+
+	return &GetLedgerStatementForbidden{}
+}
+
+// WithPayload adds the payload to the get ledger statement forbidden response
+func (o *GetLedgerStatementForbidden) WithPayload(payload *models.ErrorResponse) *GetLedgerStatementForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger statement forbidden response
+func (o *GetLedgerStatementForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerStatementForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetLedgerStatementNotFoundCode is the HTTP code returned for type GetLedgerStatementNotFound
+const GetLedgerStatementNotFoundCode int = 404
+
+/*
+GetLedgerStatementNotFound Not found error.
+
+swagger:response getLedgerStatementNotFound
+*/
+type GetLedgerStatementNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerStatementNotFound creates GetLedgerStatementNotFound with default headers values
+func NewGetLedgerStatementNotFound() *GetLedgerStatementNotFound {
+
+	return &GetLedgerStatementNotFound{}
+}
+
+// WithPayload adds the payload to the get ledger statement not found response
+func (o *GetLedgerStatementNotFound) WithPayload(payload *models.ErrorResponse) *GetLedgerStatementNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger statement not found response
+func (o *GetLedgerStatementNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerStatementNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetLedgerStatementInternalServerErrorCode is the HTTP code returned for type GetLedgerStatementInternalServerError
+const GetLedgerStatementInternalServerErrorCode int = 500
+
+/*
+GetLedgerStatementInternalServerError Internal server error.
+
+swagger:response getLedgerStatementInternalServerError
+*/
+type GetLedgerStatementInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetLedgerStatementInternalServerError creates GetLedgerStatementInternalServerError with default headers values
+func NewGetLedgerStatementInternalServerError() *GetLedgerStatementInternalServerError {
+
+	return &GetLedgerStatementInternalServerError{}
+}
+
+// WithPayload adds the payload to the get ledger statement internal server error response
+func (o *GetLedgerStatementInternalServerError) WithPayload(payload *models.ErrorResponse) *GetLedgerStatementInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ledger statement internal server error response
+func (o *GetLedgerStatementInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLedgerStatementInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
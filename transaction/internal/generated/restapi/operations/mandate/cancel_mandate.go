@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CancelMandateHandlerFunc turns a function with the right signature into a cancel mandate handler
+type CancelMandateHandlerFunc func(CancelMandateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CancelMandateHandlerFunc) Handle(params CancelMandateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CancelMandateHandler interface for that can handle valid cancel mandate params
+type CancelMandateHandler interface {
+	Handle(CancelMandateParams, interface{}) middleware.Responder
+}
+
+// NewCancelMandate creates a new http.Handler for the cancel mandate operation
+func NewCancelMandate(ctx *middleware.Context, handler CancelMandateHandler) *CancelMandate {
+	return &CancelMandate{Context: ctx, Handler: handler}
+}
+
+/*
+	CancelMandate swagger:route POST /mandate/{id}/cancel mandate cancelMandate
+
+The method is used to stop charging the mandate for good.
+*/
+type CancelMandate struct {
+	Context *middleware.Context
+	Handler CancelMandateHandler
+}
+
+func (o *CancelMandate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCancelMandateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
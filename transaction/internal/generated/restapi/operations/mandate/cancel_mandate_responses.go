@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// CancelMandateOKCode is the HTTP code returned for type CancelMandateOK
+const CancelMandateOKCode int = 200
+
+/*
+CancelMandateOK Mandate successfully canceled.
+
+swagger:response cancelMandateOK
+*/
+type CancelMandateOK struct {
+}
+
+// NewCancelMandateOK creates CancelMandateOK with default headers values
+func NewCancelMandateOK() *CancelMandateOK {
+
+	return &CancelMandateOK{}
+}
+
+// WriteResponse to the client
+func (o *CancelMandateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// CancelMandateForbiddenCode is the HTTP code returned for type CancelMandateForbidden
+const CancelMandateForbiddenCode int = 403
+
+/*
+CancelMandateForbidden Forbidden error.
+
+swagger:response cancelMandateForbidden
+*/
+type CancelMandateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCancelMandateForbidden creates CancelMandateForbidden with default headers values
+func NewCancelMandateForbidden() *CancelMandateForbidden {
+
+	return &CancelMandateForbidden{}
+}
+
+// WithPayload adds the payload to the cancel mandate forbidden response
+func (o *CancelMandateForbidden) WithPayload(payload *models.ErrorResponse) *CancelMandateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel mandate forbidden response
+func (o *CancelMandateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelMandateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CancelMandateNotFoundCode is the HTTP code returned for type CancelMandateNotFound
+const CancelMandateNotFoundCode int = 404
+
+/*
+CancelMandateNotFound Not found error.
+
+swagger:response cancelMandateNotFound
+*/
+type CancelMandateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCancelMandateNotFound creates CancelMandateNotFound with default headers values
+func NewCancelMandateNotFound() *CancelMandateNotFound {
+
+	return &CancelMandateNotFound{}
+}
+
+// WithPayload adds the payload to the cancel mandate not found response
+func (o *CancelMandateNotFound) WithPayload(payload *models.ErrorResponse) *CancelMandateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel mandate not found response
+func (o *CancelMandateNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelMandateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CancelMandateConflictCode is the HTTP code returned for type CancelMandateConflict
+const CancelMandateConflictCode int = 409
+
+/*
+CancelMandateConflict The mandate is already canceled or completed.
+
+swagger:response cancelMandateConflict
+*/
+type CancelMandateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCancelMandateConflict creates CancelMandateConflict with default headers values
+func NewCancelMandateConflict() *CancelMandateConflict {
+
+	return &CancelMandateConflict{}
+}
+
+// WithPayload adds the payload to the cancel mandate conflict response
+func (o *CancelMandateConflict) WithPayload(payload *models.ErrorResponse) *CancelMandateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel mandate conflict response
+func (o *CancelMandateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelMandateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CancelMandateInternalServerErrorCode is the HTTP code returned for type CancelMandateInternalServerError
+const CancelMandateInternalServerErrorCode int = 500
+
+/*
+CancelMandateInternalServerError Internal server error.
+
+swagger:response cancelMandateInternalServerError
+*/
+type CancelMandateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCancelMandateInternalServerError creates CancelMandateInternalServerError with default headers values
+func NewCancelMandateInternalServerError() *CancelMandateInternalServerError {
+
+	return &CancelMandateInternalServerError{}
+}
+
+// WithPayload adds the payload to the cancel mandate internal server error response
+func (o *CancelMandateInternalServerError) WithPayload(payload *models.ErrorResponse) *CancelMandateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel mandate internal server error response
+func (o *CancelMandateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelMandateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
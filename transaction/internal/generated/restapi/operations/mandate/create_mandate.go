@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateMandateHandlerFunc turns a function with the right signature into a create mandate handler
+type CreateMandateHandlerFunc func(CreateMandateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateMandateHandlerFunc) Handle(params CreateMandateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateMandateHandler interface for that can handle valid create mandate params
+type CreateMandateHandler interface {
+	Handle(CreateMandateParams, interface{}) middleware.Responder
+}
+
+// NewCreateMandate creates a new http.Handler for the create mandate operation
+func NewCreateMandate(ctx *middleware.Context, handler CreateMandateHandler) *CreateMandate {
+	return &CreateMandate{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateMandate swagger:route POST /mandate/create mandate createMandate
+
+The method is used by the payer to authorise recurring payments to the receiver once, they are charged on schedule.
+*/
+type CreateMandate struct {
+	Context *middleware.Context
+	Handler CreateMandateHandler
+}
+
+func (o *CreateMandate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateMandateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// CreateMandateOKCode is the HTTP code returned for type CreateMandateOK
+const CreateMandateOKCode int = 200
+
+/*
+CreateMandateOK Mandate successfully created.
+
+swagger:response createMandateOK
+*/
+type CreateMandateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CreateMandateResponse `json:"body,omitempty"`
+}
+
+// NewCreateMandateOK creates CreateMandateOK with default headers values
+func NewCreateMandateOK() *CreateMandateOK {
+
+	return &CreateMandateOK{}
+}
+
+// WithPayload adds the payload to the create mandate o k response
+func (o *CreateMandateOK) WithPayload(payload *models.CreateMandateResponse) *CreateMandateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create mandate o k response
+func (o *CreateMandateOK) SetPayload(payload *models.CreateMandateResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMandateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMandateBadRequestCode is the HTTP code returned for type CreateMandateBadRequest
+const CreateMandateBadRequestCode int = 400
+
+/*
+CreateMandateBadRequest Validation error.
+
+swagger:response createMandateBadRequest
+*/
+type CreateMandateBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateMandateBadRequest creates CreateMandateBadRequest with default headers values
+func NewCreateMandateBadRequest() *CreateMandateBadRequest {
+
+	return &CreateMandateBadRequest{}
+}
+
+// WithPayload adds the payload to the create mandate bad request response
+func (o *CreateMandateBadRequest) WithPayload(payload *models.ErrorResponse) *CreateMandateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create mandate bad request response
+func (o *CreateMandateBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMandateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMandateForbiddenCode is the HTTP code returned for type CreateMandateForbidden
+const CreateMandateForbiddenCode int = 403
+
+/*
+CreateMandateForbidden Forbidden error.
+
+swagger:response createMandateForbidden
+*/
+type CreateMandateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateMandateForbidden creates CreateMandateForbidden with default headers values
+func NewCreateMandateForbidden() *CreateMandateForbidden {
+
+	return &CreateMandateForbidden{}
+}
+
+// WithPayload adds the payload to the create mandate forbidden response
+func (o *CreateMandateForbidden) WithPayload(payload *models.ErrorResponse) *CreateMandateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create mandate forbidden response
+func (o *CreateMandateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMandateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateMandateInternalServerErrorCode is the HTTP code returned for type CreateMandateInternalServerError
+const CreateMandateInternalServerErrorCode int = 500
+
+/*
+CreateMandateInternalServerError Internal server error.
+
+swagger:response createMandateInternalServerError
+*/
+type CreateMandateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateMandateInternalServerError creates CreateMandateInternalServerError with default headers values
+func NewCreateMandateInternalServerError() *CreateMandateInternalServerError {
+
+	return &CreateMandateInternalServerError{}
+}
+
+// WithPayload adds the payload to the create mandate internal server error response
+func (o *CreateMandateInternalServerError) WithPayload(payload *models.ErrorResponse) *CreateMandateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create mandate internal server error response
+func (o *CreateMandateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateMandateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PauseMandateHandlerFunc turns a function with the right signature into a pause mandate handler
+type PauseMandateHandlerFunc func(PauseMandateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PauseMandateHandlerFunc) Handle(params PauseMandateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// PauseMandateHandler interface for that can handle valid pause mandate params
+type PauseMandateHandler interface {
+	Handle(PauseMandateParams, interface{}) middleware.Responder
+}
+
+// NewPauseMandate creates a new http.Handler for the pause mandate operation
+func NewPauseMandate(ctx *middleware.Context, handler PauseMandateHandler) *PauseMandate {
+	return &PauseMandate{Context: ctx, Handler: handler}
+}
+
+/*
+	PauseMandate swagger:route POST /mandate/{id}/pause mandate pauseMandate
+
+The method is used to stop charging the mandate until it is resumed.
+*/
+type PauseMandate struct {
+	Context *middleware.Context
+	Handler PauseMandateHandler
+}
+
+func (o *PauseMandate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPauseMandateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// PauseMandateOKCode is the HTTP code returned for type PauseMandateOK
+const PauseMandateOKCode int = 200
+
+/*
+PauseMandateOK Mandate successfully paused.
+
+swagger:response pauseMandateOK
+*/
+type PauseMandateOK struct {
+}
+
+// NewPauseMandateOK creates PauseMandateOK with default headers values
+func NewPauseMandateOK() *PauseMandateOK {
+
+	return &PauseMandateOK{}
+}
+
+// WriteResponse to the client
+func (o *PauseMandateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// PauseMandateForbiddenCode is the HTTP code returned for type PauseMandateForbidden
+const PauseMandateForbiddenCode int = 403
+
+/*
+PauseMandateForbidden Forbidden error.
+
+swagger:response pauseMandateForbidden
+*/
+type PauseMandateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPauseMandateForbidden creates PauseMandateForbidden with default headers values
+func NewPauseMandateForbidden() *PauseMandateForbidden {
+
+	return &PauseMandateForbidden{}
+}
+
+// WithPayload adds the payload to the pause mandate forbidden response
+func (o *PauseMandateForbidden) WithPayload(payload *models.ErrorResponse) *PauseMandateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pause mandate forbidden response
+func (o *PauseMandateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PauseMandateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PauseMandateNotFoundCode is the HTTP code returned for type PauseMandateNotFound
+const PauseMandateNotFoundCode int = 404
+
+/*
+PauseMandateNotFound Not found error.
+
+swagger:response pauseMandateNotFound
+*/
+type PauseMandateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPauseMandateNotFound creates PauseMandateNotFound with default headers values
+func NewPauseMandateNotFound() *PauseMandateNotFound {
+
+	return &PauseMandateNotFound{}
+}
+
+// WithPayload adds the payload to the pause mandate not found response
+func (o *PauseMandateNotFound) WithPayload(payload *models.ErrorResponse) *PauseMandateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pause mandate not found response
+func (o *PauseMandateNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PauseMandateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PauseMandateConflictCode is the HTTP code returned for type PauseMandateConflict
+const PauseMandateConflictCode int = 409
+
+/*
+PauseMandateConflict The mandate is not active or past due.
+
+swagger:response pauseMandateConflict
+*/
+type PauseMandateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPauseMandateConflict creates PauseMandateConflict with default headers values
+func NewPauseMandateConflict() *PauseMandateConflict {
+
+	return &PauseMandateConflict{}
+}
+
+// WithPayload adds the payload to the pause mandate conflict response
+func (o *PauseMandateConflict) WithPayload(payload *models.ErrorResponse) *PauseMandateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pause mandate conflict response
+func (o *PauseMandateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PauseMandateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PauseMandateInternalServerErrorCode is the HTTP code returned for type PauseMandateInternalServerError
+const PauseMandateInternalServerErrorCode int = 500
+
+/*
+PauseMandateInternalServerError Internal server error.
+
+swagger:response pauseMandateInternalServerError
+*/
+type PauseMandateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPauseMandateInternalServerError creates PauseMandateInternalServerError with default headers values
+func NewPauseMandateInternalServerError() *PauseMandateInternalServerError {
+
+	return &PauseMandateInternalServerError{}
+}
+
+// WithPayload adds the payload to the pause mandate internal server error response
+func (o *PauseMandateInternalServerError) WithPayload(payload *models.ErrorResponse) *PauseMandateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pause mandate internal server error response
+func (o *PauseMandateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PauseMandateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ResumeMandateHandlerFunc turns a function with the right signature into a resume mandate handler
+type ResumeMandateHandlerFunc func(ResumeMandateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResumeMandateHandlerFunc) Handle(params ResumeMandateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ResumeMandateHandler interface for that can handle valid resume mandate params
+type ResumeMandateHandler interface {
+	Handle(ResumeMandateParams, interface{}) middleware.Responder
+}
+
+// NewResumeMandate creates a new http.Handler for the resume mandate operation
+func NewResumeMandate(ctx *middleware.Context, handler ResumeMandateHandler) *ResumeMandate {
+	return &ResumeMandate{Context: ctx, Handler: handler}
+}
+
+/*
+	ResumeMandate swagger:route POST /mandate/{id}/resume mandate resumeMandate
+
+The method is used to resume charging a paused or unpaid mandate, the periods it missed are not charged.
+*/
+type ResumeMandate struct {
+	Context *middleware.Context
+	Handler ResumeMandateHandler
+}
+
+func (o *ResumeMandate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewResumeMandateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
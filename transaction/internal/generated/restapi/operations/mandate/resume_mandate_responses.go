@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// ResumeMandateOKCode is the HTTP code returned for type ResumeMandateOK
+const ResumeMandateOKCode int = 200
+
+/*
+ResumeMandateOK Mandate successfully resumed.
+
+swagger:response resumeMandateOK
+*/
+type ResumeMandateOK struct {
+}
+
+// NewResumeMandateOK creates ResumeMandateOK with default headers values
+func NewResumeMandateOK() *ResumeMandateOK {
+
+	return &ResumeMandateOK{}
+}
+
+// WriteResponse to the client
+func (o *ResumeMandateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// ResumeMandateForbiddenCode is the HTTP code returned for type ResumeMandateForbidden
+const ResumeMandateForbiddenCode int = 403
+
+/*
+ResumeMandateForbidden Forbidden error.
+
+swagger:response resumeMandateForbidden
+*/
+type ResumeMandateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResumeMandateForbidden creates ResumeMandateForbidden with default headers values
+func NewResumeMandateForbidden() *ResumeMandateForbidden {
+
+	return &ResumeMandateForbidden{}
+}
+
+// WithPayload adds the payload to the resume mandate forbidden response
+func (o *ResumeMandateForbidden) WithPayload(payload *models.ErrorResponse) *ResumeMandateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resume mandate forbidden response
+func (o *ResumeMandateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResumeMandateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResumeMandateNotFoundCode is the HTTP code returned for type ResumeMandateNotFound
+const ResumeMandateNotFoundCode int = 404
+
+/*
+ResumeMandateNotFound Not found error.
+
+swagger:response resumeMandateNotFound
+*/
+type ResumeMandateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResumeMandateNotFound creates ResumeMandateNotFound with default headers values
+func NewResumeMandateNotFound() *ResumeMandateNotFound {
+
+	return &ResumeMandateNotFound{}
+}
+
+// WithPayload adds the payload to the resume mandate not found response
+func (o *ResumeMandateNotFound) WithPayload(payload *models.ErrorResponse) *ResumeMandateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resume mandate not found response
+func (o *ResumeMandateNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResumeMandateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResumeMandateConflictCode is the HTTP code returned for type ResumeMandateConflict
+const ResumeMandateConflictCode int = 409
+
+/*
+ResumeMandateConflict The mandate is not paused or unpaid.
+
+swagger:response resumeMandateConflict
+*/
+type ResumeMandateConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResumeMandateConflict creates ResumeMandateConflict with default headers values
+func NewResumeMandateConflict() *ResumeMandateConflict {
+
+	return &ResumeMandateConflict{}
+}
+
+// WithPayload adds the payload to the resume mandate conflict response
+func (o *ResumeMandateConflict) WithPayload(payload *models.ErrorResponse) *ResumeMandateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resume mandate conflict response
+func (o *ResumeMandateConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResumeMandateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResumeMandateInternalServerErrorCode is the HTTP code returned for type ResumeMandateInternalServerError
+const ResumeMandateInternalServerErrorCode int = 500
+
+/*
+ResumeMandateInternalServerError Internal server error.
+
+swagger:response resumeMandateInternalServerError
+*/
+type ResumeMandateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResumeMandateInternalServerError creates ResumeMandateInternalServerError with default headers values
+func NewResumeMandateInternalServerError() *ResumeMandateInternalServerError {
+
+	return &ResumeMandateInternalServerError{}
+}
+
+// WithPayload adds the payload to the resume mandate internal server error response
+func (o *ResumeMandateInternalServerError) WithPayload(payload *models.ErrorResponse) *ResumeMandateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resume mandate internal server error response
+func (o *ResumeMandateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResumeMandateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RetrieveMandateHandlerFunc turns a function with the right signature into a retrieve mandate handler
+type RetrieveMandateHandlerFunc func(RetrieveMandateParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RetrieveMandateHandlerFunc) Handle(params RetrieveMandateParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RetrieveMandateHandler interface for that can handle valid retrieve mandate params
+type RetrieveMandateHandler interface {
+	Handle(RetrieveMandateParams, interface{}) middleware.Responder
+}
+
+// NewRetrieveMandate creates a new http.Handler for the retrieve mandate operation
+func NewRetrieveMandate(ctx *middleware.Context, handler RetrieveMandateHandler) *RetrieveMandate {
+	return &RetrieveMandate{Context: ctx, Handler: handler}
+}
+
+/*
+	RetrieveMandate swagger:route GET /mandate/{id}/retrieve mandate retrieveMandate
+
+The method is used to retrieve the mandate with its schedule and dunning state.
+*/
+type RetrieveMandate struct {
+	Context *middleware.Context
+	Handler RetrieveMandateHandler
+}
+
+func (o *RetrieveMandate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRetrieveMandateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
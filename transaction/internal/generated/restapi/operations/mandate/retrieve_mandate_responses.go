@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package mandate
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// RetrieveMandateOKCode is the HTTP code returned for type RetrieveMandateOK
+const RetrieveMandateOKCode int = 200
+
+/*
+RetrieveMandateOK Mandate successfully retrieved.
+
+swagger:response retrieveMandateOK
+*/
+type RetrieveMandateOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetMandateResponse `json:"body,omitempty"`
+}
+
+// NewRetrieveMandateOK creates RetrieveMandateOK with default headers values
+func NewRetrieveMandateOK() *RetrieveMandateOK {
+
+	return &RetrieveMandateOK{}
+}
+
+// WithPayload adds the payload to the retrieve mandate o k response
+func (o *RetrieveMandateOK) WithPayload(payload *models.GetMandateResponse) *RetrieveMandateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve mandate o k response
+func (o *RetrieveMandateOK) SetPayload(payload *models.GetMandateResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrieveMandateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RetrieveMandateForbiddenCode is the HTTP code returned for type RetrieveMandateForbidden
+const RetrieveMandateForbiddenCode int = 403
+
+/*
+RetrieveMandateForbidden Forbidden error.
+
+swagger:response retrieveMandateForbidden
+*/
+type RetrieveMandateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRetrieveMandateForbidden creates RetrieveMandateForbidden with default headers values
+func NewRetrieveMandateForbidden() *RetrieveMandateForbidden {
+
+	return &RetrieveMandateForbidden{}
+}
+
+// WithPayload adds the payload to the retrieve mandate forbidden response
+func (o *RetrieveMandateForbidden) WithPayload(payload *models.ErrorResponse) *RetrieveMandateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve mandate forbidden response
+func (o *RetrieveMandateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrieveMandateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RetrieveMandateNotFoundCode is the HTTP code returned for type RetrieveMandateNotFound
+const RetrieveMandateNotFoundCode int = 404
+
+/*
+RetrieveMandateNotFound Not found error.
+
+swagger:response retrieveMandateNotFound
+*/
+type RetrieveMandateNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRetrieveMandateNotFound creates RetrieveMandateNotFound with default headers values
+func NewRetrieveMandateNotFound() *RetrieveMandateNotFound {
+
+	return &RetrieveMandateNotFound{}
+}
+
+// WithPayload adds the payload to the retrieve mandate not found response
+func (o *RetrieveMandateNotFound) WithPayload(payload *models.ErrorResponse) *RetrieveMandateNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve mandate not found response
+func (o *RetrieveMandateNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrieveMandateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RetrieveMandateInternalServerErrorCode is the HTTP code returned for type RetrieveMandateInternalServerError
+const RetrieveMandateInternalServerErrorCode int = 500
+
+/*
+RetrieveMandateInternalServerError Internal server error.
+
+swagger:response retrieveMandateInternalServerError
+*/
+type RetrieveMandateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRetrieveMandateInternalServerError creates RetrieveMandateInternalServerError with default headers values
+func NewRetrieveMandateInternalServerError() *RetrieveMandateInternalServerError {
+
+	return &RetrieveMandateInternalServerError{}
+}
+
+// WithPayload adds the payload to the retrieve mandate internal server error response
+func (o *RetrieveMandateInternalServerError) WithPayload(payload *models.ErrorResponse) *RetrieveMandateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve mandate internal server error response
+func (o *RetrieveMandateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrieveMandateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreatePaymentPointHandlerFunc turns a function with the right signature into a create payment point handler
+type CreatePaymentPointHandlerFunc func(CreatePaymentPointParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreatePaymentPointHandlerFunc) Handle(params CreatePaymentPointParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreatePaymentPointHandler interface for that can handle valid create payment point params
+type CreatePaymentPointHandler interface {
+	Handle(CreatePaymentPointParams, interface{}) middleware.Responder
+}
+
+// NewCreatePaymentPoint creates a new http.Handler for the create payment point operation
+func NewCreatePaymentPoint(ctx *middleware.Context, handler CreatePaymentPointHandler) *CreatePaymentPoint {
+	return &CreatePaymentPoint{Context: ctx, Handler: handler}
+}
+
+/*
+	CreatePaymentPoint swagger:route POST /payment-point/create payment_point createPaymentPoint
+
+The method is used to create a static payment point, its QR code is reused by every payer.
+*/
+type CreatePaymentPoint struct {
+	Context *middleware.Context
+	Handler CreatePaymentPointHandler
+}
+
+func (o *CreatePaymentPoint) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreatePaymentPointParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,129 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// NewCreatePaymentPointParams creates a new CreatePaymentPointParams object
+//
+// There are no default values defined in the spec.
+func NewCreatePaymentPointParams() CreatePaymentPointParams {
+
+	return CreatePaymentPointParams{}
+}
+
+// CreatePaymentPointParams contains all the bound params for the create payment point operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createPaymentPoint
+type CreatePaymentPointParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: header
+	*/
+	XIdempotencyKey *strfmt.UUID
+	/*Created payment point object.
+	  Required: true
+	  In: body
+	*/
+	Body *models.CreatePaymentPointRequest
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCreatePaymentPointParams() beforehand.
+func (o *CreatePaymentPointParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := o.bindXIdempotencyKey(r.Header[http.CanonicalHeaderKey("X-Idempotency-Key")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.CreatePaymentPointRequest
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindXIdempotencyKey binds and validates parameter XIdempotencyKey from header.
+func (o *CreatePaymentPointParams) bindXIdempotencyKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("X-Idempotency-Key", "header", "strfmt.UUID", raw)
+	}
+	o.XIdempotencyKey = (value.(*strfmt.UUID))
+
+	if err := o.validateXIdempotencyKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateXIdempotencyKey carries on validations for parameter XIdempotencyKey
+func (o *CreatePaymentPointParams) validateXIdempotencyKey(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("X-Idempotency-Key", "header", "uuid", o.XIdempotencyKey.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// CreatePaymentPointOKCode is the HTTP code returned for type CreatePaymentPointOK
+const CreatePaymentPointOKCode int = 200
+
+/*
+CreatePaymentPointOK Payment point successfully created.
+
+swagger:response createPaymentPointOK
+*/
+type CreatePaymentPointOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CreatePaymentPointResponse `json:"body,omitempty"`
+}
+
+// NewCreatePaymentPointOK creates CreatePaymentPointOK with default headers values
+func NewCreatePaymentPointOK() *CreatePaymentPointOK {
+
+	return &CreatePaymentPointOK{}
+}
+
+// WithPayload adds the payload to the create payment point o k response
+func (o *CreatePaymentPointOK) WithPayload(payload *models.CreatePaymentPointResponse) *CreatePaymentPointOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create payment point o k response
+func (o *CreatePaymentPointOK) SetPayload(payload *models.CreatePaymentPointResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePaymentPointOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePaymentPointBadRequestCode is the HTTP code returned for type CreatePaymentPointBadRequest
+const CreatePaymentPointBadRequestCode int = 400
+
+/*
+CreatePaymentPointBadRequest Validation error.
+
+swagger:response createPaymentPointBadRequest
+*/
+type CreatePaymentPointBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreatePaymentPointBadRequest creates CreatePaymentPointBadRequest with default headers values
+func NewCreatePaymentPointBadRequest() *CreatePaymentPointBadRequest {
+
+	return &CreatePaymentPointBadRequest{}
+}
+
+// WithPayload adds the payload to the create payment point bad request response
+func (o *CreatePaymentPointBadRequest) WithPayload(payload *models.ErrorResponse) *CreatePaymentPointBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create payment point bad request response
+func (o *CreatePaymentPointBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePaymentPointBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePaymentPointForbiddenCode is the HTTP code returned for type CreatePaymentPointForbidden
+const CreatePaymentPointForbiddenCode int = 403
+
+/*
+CreatePaymentPointForbidden Forbidden error.
+
+swagger:response createPaymentPointForbidden
+*/
+type CreatePaymentPointForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreatePaymentPointForbidden creates CreatePaymentPointForbidden with default headers values
+func NewCreatePaymentPointForbidden() *CreatePaymentPointForbidden {
+
+	return &CreatePaymentPointForbidden{}
+}
+
+// WithPayload adds the payload to the create payment point forbidden response
+func (o *CreatePaymentPointForbidden) WithPayload(payload *models.ErrorResponse) *CreatePaymentPointForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create payment point forbidden response
+func (o *CreatePaymentPointForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePaymentPointForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreatePaymentPointInternalServerErrorCode is the HTTP code returned for type CreatePaymentPointInternalServerError
+const CreatePaymentPointInternalServerErrorCode int = 500
+
+/*
+CreatePaymentPointInternalServerError Internal server error.
+
+swagger:response createPaymentPointInternalServerError
+*/
+type CreatePaymentPointInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreatePaymentPointInternalServerError creates CreatePaymentPointInternalServerError with default headers values
+func NewCreatePaymentPointInternalServerError() *CreatePaymentPointInternalServerError {
+
+	return &CreatePaymentPointInternalServerError{}
+}
+
+// WithPayload adds the payload to the create payment point internal server error response
+func (o *CreatePaymentPointInternalServerError) WithPayload(payload *models.ErrorResponse) *CreatePaymentPointInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create payment point internal server error response
+func (o *CreatePaymentPointInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePaymentPointInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DisablePaymentPointHandlerFunc turns a function with the right signature into a disable payment point handler
+type DisablePaymentPointHandlerFunc func(DisablePaymentPointParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DisablePaymentPointHandlerFunc) Handle(params DisablePaymentPointParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DisablePaymentPointHandler interface for that can handle valid disable payment point params
+type DisablePaymentPointHandler interface {
+	Handle(DisablePaymentPointParams, interface{}) middleware.Responder
+}
+
+// NewDisablePaymentPoint creates a new http.Handler for the disable payment point operation
+func NewDisablePaymentPoint(ctx *middleware.Context, handler DisablePaymentPointHandler) *DisablePaymentPoint {
+	return &DisablePaymentPoint{Context: ctx, Handler: handler}
+}
+
+/*
+	DisablePaymentPoint swagger:route POST /payment-point/{id}/disable payment_point disablePaymentPoint
+
+The method is used to disable the payment point, its QR code stops accepting payments.
+*/
+type DisablePaymentPoint struct {
+	Context *middleware.Context
+	Handler DisablePaymentPointHandler
+}
+
+func (o *DisablePaymentPoint) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDisablePaymentPointParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
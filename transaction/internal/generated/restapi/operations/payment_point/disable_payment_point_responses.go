@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// DisablePaymentPointOKCode is the HTTP code returned for type DisablePaymentPointOK
+const DisablePaymentPointOKCode int = 200
+
+/*
+DisablePaymentPointOK Payment point successfully disabled.
+
+swagger:response disablePaymentPointOK
+*/
+type DisablePaymentPointOK struct {
+}
+
+// NewDisablePaymentPointOK creates DisablePaymentPointOK with default headers values
+func NewDisablePaymentPointOK() *DisablePaymentPointOK {
+
+	return &DisablePaymentPointOK{}
+}
+
+// WriteResponse to the client
+func (o *DisablePaymentPointOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// DisablePaymentPointForbiddenCode is the HTTP code returned for type DisablePaymentPointForbidden
+const DisablePaymentPointForbiddenCode int = 403
+
+/*
+DisablePaymentPointForbidden Forbidden error.
+
+swagger:response disablePaymentPointForbidden
+*/
+type DisablePaymentPointForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDisablePaymentPointForbidden creates DisablePaymentPointForbidden with default headers values
+func NewDisablePaymentPointForbidden() *DisablePaymentPointForbidden {
+
+	return &DisablePaymentPointForbidden{}
+}
+
+// WithPayload adds the payload to the disable payment point forbidden response
+func (o *DisablePaymentPointForbidden) WithPayload(payload *models.ErrorResponse) *DisablePaymentPointForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the disable payment point forbidden response
+func (o *DisablePaymentPointForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DisablePaymentPointForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DisablePaymentPointNotFoundCode is the HTTP code returned for type DisablePaymentPointNotFound
+const DisablePaymentPointNotFoundCode int = 404
+
+/*
+DisablePaymentPointNotFound Not found error.
+
+swagger:response disablePaymentPointNotFound
+*/
+type DisablePaymentPointNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDisablePaymentPointNotFound creates DisablePaymentPointNotFound with default headers values
+func NewDisablePaymentPointNotFound() *DisablePaymentPointNotFound {
+
+	return &DisablePaymentPointNotFound{}
+}
+
+// WithPayload adds the payload to the disable payment point not found response
+func (o *DisablePaymentPointNotFound) WithPayload(payload *models.ErrorResponse) *DisablePaymentPointNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the disable payment point not found response
+func (o *DisablePaymentPointNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DisablePaymentPointNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DisablePaymentPointInternalServerErrorCode is the HTTP code returned for type DisablePaymentPointInternalServerError
+const DisablePaymentPointInternalServerErrorCode int = 500
+
+/*
+DisablePaymentPointInternalServerError Internal server error.
+
+swagger:response disablePaymentPointInternalServerError
+*/
+type DisablePaymentPointInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewDisablePaymentPointInternalServerError creates DisablePaymentPointInternalServerError with default headers values
+func NewDisablePaymentPointInternalServerError() *DisablePaymentPointInternalServerError {
+
+	return &DisablePaymentPointInternalServerError{}
+}
+
+// WithPayload adds the payload to the disable payment point internal server error response
+func (o *DisablePaymentPointInternalServerError) WithPayload(payload *models.ErrorResponse) *DisablePaymentPointInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the disable payment point internal server error response
+func (o *DisablePaymentPointInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DisablePaymentPointInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetPaymentPointQRHandlerFunc turns a function with the right signature into a get payment point q r handler
+type GetPaymentPointQRHandlerFunc func(GetPaymentPointQRParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPaymentPointQRHandlerFunc) Handle(params GetPaymentPointQRParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetPaymentPointQRHandler interface for that can handle valid get payment point q r params
+type GetPaymentPointQRHandler interface {
+	Handle(GetPaymentPointQRParams, interface{}) middleware.Responder
+}
+
+// NewGetPaymentPointQR creates a new http.Handler for the get payment point q r operation
+func NewGetPaymentPointQR(ctx *middleware.Context, handler GetPaymentPointQRHandler) *GetPaymentPointQR {
+	return &GetPaymentPointQR{Context: ctx, Handler: handler}
+}
+
+/*
+	GetPaymentPointQR swagger:route GET /payment-point/{id}/qr payment_point getPaymentPointQR
+
+The method is used to render the static QR code of the payment point.
+*/
+type GetPaymentPointQR struct {
+	Context *middleware.Context
+	Handler GetPaymentPointQRHandler
+}
+
+func (o *GetPaymentPointQR) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetPaymentPointQRParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
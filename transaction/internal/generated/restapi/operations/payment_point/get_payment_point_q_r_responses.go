@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetPaymentPointQROKCode is the HTTP code returned for type GetPaymentPointQROK
+const GetPaymentPointQROKCode int = 200
+
+/*
+GetPaymentPointQROK QR code successfully rendered.
+
+swagger:response getPaymentPointQROK
+*/
+type GetPaymentPointQROK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetPaymentPointQROK creates GetPaymentPointQROK with default headers values
+func NewGetPaymentPointQROK() *GetPaymentPointQROK {
+
+	return &GetPaymentPointQROK{}
+}
+
+// WithPayload adds the payload to the get payment point q r o k response
+func (o *GetPaymentPointQROK) WithPayload(payload io.ReadCloser) *GetPaymentPointQROK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get payment point q r o k response
+func (o *GetPaymentPointQROK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPaymentPointQROK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetPaymentPointQRForbiddenCode is the HTTP code returned for type GetPaymentPointQRForbidden
+const GetPaymentPointQRForbiddenCode int = 403
+
+/*
+GetPaymentPointQRForbidden Forbidden error.
+
+swagger:response getPaymentPointQRForbidden
+*/
+type GetPaymentPointQRForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetPaymentPointQRForbidden creates GetPaymentPointQRForbidden with default headers values
+func NewGetPaymentPointQRForbidden() *GetPaymentPointQRForbidden {
+
+	return &GetPaymentPointQRForbidden{}
+}
+
+// WithPayload adds the payload to the get payment point q r forbidden response
+func (o *GetPaymentPointQRForbidden) WithPayload(payload *models.ErrorResponse) *GetPaymentPointQRForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get payment point q r forbidden response
+func (o *GetPaymentPointQRForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPaymentPointQRForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetPaymentPointQRNotFoundCode is the HTTP code returned for type GetPaymentPointQRNotFound
+const GetPaymentPointQRNotFoundCode int = 404
+
+/*
+GetPaymentPointQRNotFound Not found error.
+
+swagger:response getPaymentPointQRNotFound
+*/
+type GetPaymentPointQRNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetPaymentPointQRNotFound creates GetPaymentPointQRNotFound with default headers values
+func NewGetPaymentPointQRNotFound() *GetPaymentPointQRNotFound {
+
+	return &GetPaymentPointQRNotFound{}
+}
+
+// WithPayload adds the payload to the get payment point q r not found response
+func (o *GetPaymentPointQRNotFound) WithPayload(payload *models.ErrorResponse) *GetPaymentPointQRNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get payment point q r not found response
+func (o *GetPaymentPointQRNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPaymentPointQRNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetPaymentPointQRGoneCode is the HTTP code returned for type GetPaymentPointQRGone
+const GetPaymentPointQRGoneCode int = 410
+
+/*
+GetPaymentPointQRGone Payment point is disabled.
+
+swagger:response getPaymentPointQRGone
+*/
+type GetPaymentPointQRGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetPaymentPointQRGone creates GetPaymentPointQRGone with default headers values
+func NewGetPaymentPointQRGone() *GetPaymentPointQRGone {
+
+	return &GetPaymentPointQRGone{}
+}
+
+// WithPayload adds the payload to the get payment point q r gone response
+func (o *GetPaymentPointQRGone) WithPayload(payload *models.ErrorResponse) *GetPaymentPointQRGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get payment point q r gone response
+func (o *GetPaymentPointQRGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPaymentPointQRGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetPaymentPointQRInternalServerErrorCode is the HTTP code returned for type GetPaymentPointQRInternalServerError
+const GetPaymentPointQRInternalServerErrorCode int = 500
+
+/*
+GetPaymentPointQRInternalServerError Internal server error.
+
+swagger:response getPaymentPointQRInternalServerError
+*/
+type GetPaymentPointQRInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetPaymentPointQRInternalServerError creates GetPaymentPointQRInternalServerError with default headers values
+func NewGetPaymentPointQRInternalServerError() *GetPaymentPointQRInternalServerError {
+
+	return &GetPaymentPointQRInternalServerError{}
+}
+
+// WithPayload adds the payload to the get payment point q r internal server error response
+func (o *GetPaymentPointQRInternalServerError) WithPayload(payload *models.ErrorResponse) *GetPaymentPointQRInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get payment point q r internal server error response
+func (o *GetPaymentPointQRInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPaymentPointQRInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PayPaymentPointHandlerFunc turns a function with the right signature into a pay payment point handler
+type PayPaymentPointHandlerFunc func(PayPaymentPointParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PayPaymentPointHandlerFunc) Handle(params PayPaymentPointParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// PayPaymentPointHandler interface for that can handle valid pay payment point params
+type PayPaymentPointHandler interface {
+	Handle(PayPaymentPointParams, interface{}) middleware.Responder
+}
+
+// NewPayPaymentPoint creates a new http.Handler for the pay payment point operation
+func NewPayPaymentPoint(ctx *middleware.Context, handler PayPaymentPointHandler) *PayPaymentPoint {
+	return &PayPaymentPoint{Context: ctx, Handler: handler}
+}
+
+/*
+	PayPaymentPoint swagger:route POST /payment-point/{id}/pay payment_point payPaymentPoint
+
+The method is used to pay to the payment point, the transaction is created and accepted in one step.
+*/
+type PayPaymentPoint struct {
+	Context *middleware.Context
+	Handler PayPaymentPointHandler
+}
+
+func (o *PayPaymentPoint) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPayPaymentPointParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
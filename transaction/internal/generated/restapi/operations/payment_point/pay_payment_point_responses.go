@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// PayPaymentPointOKCode is the HTTP code returned for type PayPaymentPointOK
+const PayPaymentPointOKCode int = 200
+
+/*
+PayPaymentPointOK Transaction successfully created and accepted.
+
+swagger:response payPaymentPointOK
+*/
+type PayPaymentPointOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PayPaymentPointResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointOK creates PayPaymentPointOK with default headers values
+func NewPayPaymentPointOK() *PayPaymentPointOK {
+
+	return &PayPaymentPointOK{}
+}
+
+// WithPayload adds the payload to the pay payment point o k response
+func (o *PayPaymentPointOK) WithPayload(payload *models.PayPaymentPointResponse) *PayPaymentPointOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point o k response
+func (o *PayPaymentPointOK) SetPayload(payload *models.PayPaymentPointResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointAcceptedCode is the HTTP code returned for type PayPaymentPointAccepted
+const PayPaymentPointAcceptedCode int = 202
+
+/*
+PayPaymentPointAccepted Transaction amount is above the threshold, payer confirmation is required.
+
+swagger:response payPaymentPointAccepted
+*/
+type PayPaymentPointAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PayPaymentPointResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointAccepted creates PayPaymentPointAccepted with default headers values
+func NewPayPaymentPointAccepted() *PayPaymentPointAccepted {
+
+	return &PayPaymentPointAccepted{}
+}
+
+// WithPayload adds the payload to the pay payment point accepted response
+func (o *PayPaymentPointAccepted) WithPayload(payload *models.PayPaymentPointResponse) *PayPaymentPointAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point accepted response
+func (o *PayPaymentPointAccepted) SetPayload(payload *models.PayPaymentPointResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointBadRequestCode is the HTTP code returned for type PayPaymentPointBadRequest
+const PayPaymentPointBadRequestCode int = 400
+
+/*
+PayPaymentPointBadRequest Validation error.
+
+swagger:response payPaymentPointBadRequest
+*/
+type PayPaymentPointBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointBadRequest creates PayPaymentPointBadRequest with default headers values
+func NewPayPaymentPointBadRequest() *PayPaymentPointBadRequest {
+
+	return &PayPaymentPointBadRequest{}
+}
+
+// WithPayload adds the payload to the pay payment point bad request response
+func (o *PayPaymentPointBadRequest) WithPayload(payload *models.ErrorResponse) *PayPaymentPointBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point bad request response
+func (o *PayPaymentPointBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointForbiddenCode is the HTTP code returned for type PayPaymentPointForbidden
+const PayPaymentPointForbiddenCode int = 403
+
+/*
+PayPaymentPointForbidden Forbidden error.
+
+swagger:response payPaymentPointForbidden
+*/
+type PayPaymentPointForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointForbidden creates PayPaymentPointForbidden with default headers values
+func NewPayPaymentPointForbidden() *PayPaymentPointForbidden {
+
+	return &PayPaymentPointForbidden{}
+}
+
+// WithPayload adds the payload to the pay payment point forbidden response
+func (o *PayPaymentPointForbidden) WithPayload(payload *models.ErrorResponse) *PayPaymentPointForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point forbidden response
+func (o *PayPaymentPointForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointNotFoundCode is the HTTP code returned for type PayPaymentPointNotFound
+const PayPaymentPointNotFoundCode int = 404
+
+/*
+PayPaymentPointNotFound Not found error.
+
+swagger:response payPaymentPointNotFound
+*/
+type PayPaymentPointNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointNotFound creates PayPaymentPointNotFound with default headers values
+func NewPayPaymentPointNotFound() *PayPaymentPointNotFound {
+
+	return &PayPaymentPointNotFound{}
+}
+
+// WithPayload adds the payload to the pay payment point not found response
+func (o *PayPaymentPointNotFound) WithPayload(payload *models.ErrorResponse) *PayPaymentPointNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point not found response
+func (o *PayPaymentPointNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointGoneCode is the HTTP code returned for type PayPaymentPointGone
+const PayPaymentPointGoneCode int = 410
+
+/*
+PayPaymentPointGone Payment point is disabled.
+
+swagger:response payPaymentPointGone
+*/
+type PayPaymentPointGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointGone creates PayPaymentPointGone with default headers values
+func NewPayPaymentPointGone() *PayPaymentPointGone {
+
+	return &PayPaymentPointGone{}
+}
+
+// WithPayload adds the payload to the pay payment point gone response
+func (o *PayPaymentPointGone) WithPayload(payload *models.ErrorResponse) *PayPaymentPointGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point gone response
+func (o *PayPaymentPointGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointUnprocessableEntityCode is the HTTP code returned for type PayPaymentPointUnprocessableEntity
+const PayPaymentPointUnprocessableEntityCode int = 422
+
+/*
+PayPaymentPointUnprocessableEntity The amount is outside of the payment point bounds.
+
+swagger:response payPaymentPointUnprocessableEntity
+*/
+type PayPaymentPointUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointUnprocessableEntity creates PayPaymentPointUnprocessableEntity with default headers values
+func NewPayPaymentPointUnprocessableEntity() *PayPaymentPointUnprocessableEntity {
+
+	return &PayPaymentPointUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the pay payment point unprocessable entity response
+func (o *PayPaymentPointUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *PayPaymentPointUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point unprocessable entity response
+func (o *PayPaymentPointUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PayPaymentPointInternalServerErrorCode is the HTTP code returned for type PayPaymentPointInternalServerError
+const PayPaymentPointInternalServerErrorCode int = 500
+
+/*
+PayPaymentPointInternalServerError Internal server error.
+
+swagger:response payPaymentPointInternalServerError
+*/
+type PayPaymentPointInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewPayPaymentPointInternalServerError creates PayPaymentPointInternalServerError with default headers values
+func NewPayPaymentPointInternalServerError() *PayPaymentPointInternalServerError {
+
+	return &PayPaymentPointInternalServerError{}
+}
+
+// WithPayload adds the payload to the pay payment point internal server error response
+func (o *PayPaymentPointInternalServerError) WithPayload(payload *models.ErrorResponse) *PayPaymentPointInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pay payment point internal server error response
+func (o *PayPaymentPointInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PayPaymentPointInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
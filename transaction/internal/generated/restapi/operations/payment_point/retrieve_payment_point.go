@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RetrievePaymentPointHandlerFunc turns a function with the right signature into a retrieve payment point handler
+type RetrievePaymentPointHandlerFunc func(RetrievePaymentPointParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RetrievePaymentPointHandlerFunc) Handle(params RetrievePaymentPointParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RetrievePaymentPointHandler interface for that can handle valid retrieve payment point params
+type RetrievePaymentPointHandler interface {
+	Handle(RetrievePaymentPointParams, interface{}) middleware.Responder
+}
+
+// NewRetrievePaymentPoint creates a new http.Handler for the retrieve payment point operation
+func NewRetrievePaymentPoint(ctx *middleware.Context, handler RetrievePaymentPointHandler) *RetrievePaymentPoint {
+	return &RetrievePaymentPoint{Context: ctx, Handler: handler}
+}
+
+/*
+	RetrievePaymentPoint swagger:route GET /payment-point/{id}/retrieve payment_point retrievePaymentPoint
+
+The method is used to retrieve the payment point.
+*/
+type RetrievePaymentPoint struct {
+	Context *middleware.Context
+	Handler RetrievePaymentPointHandler
+}
+
+func (o *RetrievePaymentPoint) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRetrievePaymentPointParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package payment_point
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// RetrievePaymentPointOKCode is the HTTP code returned for type RetrievePaymentPointOK
+const RetrievePaymentPointOKCode int = 200
+
+/*
+RetrievePaymentPointOK Payment point successfully retrieved.
+
+swagger:response retrievePaymentPointOK
+*/
+type RetrievePaymentPointOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetPaymentPointResponse `json:"body,omitempty"`
+}
+
+// NewRetrievePaymentPointOK creates RetrievePaymentPointOK with default headers values
+func NewRetrievePaymentPointOK() *RetrievePaymentPointOK {
+
+	return &RetrievePaymentPointOK{}
+}
+
+// WithPayload adds the payload to the retrieve payment point o k response
+func (o *RetrievePaymentPointOK) WithPayload(payload *models.GetPaymentPointResponse) *RetrievePaymentPointOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve payment point o k response
+func (o *RetrievePaymentPointOK) SetPayload(payload *models.GetPaymentPointResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrievePaymentPointOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RetrievePaymentPointForbiddenCode is the HTTP code returned for type RetrievePaymentPointForbidden
+const RetrievePaymentPointForbiddenCode int = 403
+
+/*
+RetrievePaymentPointForbidden Forbidden error.
+
+swagger:response retrievePaymentPointForbidden
+*/
+type RetrievePaymentPointForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRetrievePaymentPointForbidden creates RetrievePaymentPointForbidden with default headers values
+func NewRetrievePaymentPointForbidden() *RetrievePaymentPointForbidden {
+
+	return &RetrievePaymentPointForbidden{}
+}
+
+// WithPayload adds the payload to the retrieve payment point forbidden response
+func (o *RetrievePaymentPointForbidden) WithPayload(payload *models.ErrorResponse) *RetrievePaymentPointForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve payment point forbidden response
+func (o *RetrievePaymentPointForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrievePaymentPointForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RetrievePaymentPointNotFoundCode is the HTTP code returned for type RetrievePaymentPointNotFound
+const RetrievePaymentPointNotFoundCode int = 404
+
+/*
+RetrievePaymentPointNotFound Not found error.
+
+swagger:response retrievePaymentPointNotFound
+*/
+type RetrievePaymentPointNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRetrievePaymentPointNotFound creates RetrievePaymentPointNotFound with default headers values
+func NewRetrievePaymentPointNotFound() *RetrievePaymentPointNotFound {
+
+	return &RetrievePaymentPointNotFound{}
+}
+
+// WithPayload adds the payload to the retrieve payment point not found response
+func (o *RetrievePaymentPointNotFound) WithPayload(payload *models.ErrorResponse) *RetrievePaymentPointNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve payment point not found response
+func (o *RetrievePaymentPointNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrievePaymentPointNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RetrievePaymentPointInternalServerErrorCode is the HTTP code returned for type RetrievePaymentPointInternalServerError
+const RetrievePaymentPointInternalServerErrorCode int = 500
+
+/*
+RetrievePaymentPointInternalServerError Internal server error.
+
+swagger:response retrievePaymentPointInternalServerError
+*/
+type RetrievePaymentPointInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRetrievePaymentPointInternalServerError creates RetrievePaymentPointInternalServerError with default headers values
+func NewRetrievePaymentPointInternalServerError() *RetrievePaymentPointInternalServerError {
+
+	return &RetrievePaymentPointInternalServerError{}
+}
+
+// WithPayload adds the payload to the retrieve payment point internal server error response
+func (o *RetrievePaymentPointInternalServerError) WithPayload(payload *models.ErrorResponse) *RetrievePaymentPointInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retrieve payment point internal server error response
+func (o *RetrievePaymentPointInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetrievePaymentPointInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetSettlementReportHandlerFunc turns a function with the right signature into a get settlement report handler
+type GetSettlementReportHandlerFunc func(GetSettlementReportParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetSettlementReportHandlerFunc) Handle(params GetSettlementReportParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetSettlementReportHandler interface for that can handle valid get settlement report params
+type GetSettlementReportHandler interface {
+	Handle(GetSettlementReportParams, interface{}) middleware.Responder
+}
+
+// NewGetSettlementReport creates a new http.Handler for the get settlement report operation
+func NewGetSettlementReport(ctx *middleware.Context, handler GetSettlementReportHandler) *GetSettlementReport {
+	return &GetSettlementReport{Context: ctx, Handler: handler}
+}
+
+/*
+	GetSettlementReport swagger:route GET /report/settlement report getSettlementReport
+
+The method is used to render the settlement report of a day.
+
+The report has a statement per merchant and currency with the count and gross amount of the settled payments, their fees, the count and amount of the refunds and the net amount, together with the settlements themselves. The day is the UTC day, amounts are written in the major unit of the currency.
+*/
+type GetSettlementReport struct {
+	Context *middleware.Context
+	Handler GetSettlementReportHandler
+}
+
+func (o *GetSettlementReport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetSettlementReportParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,197 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetSettlementReportParams creates a new GetSettlementReportParams object
+// with the default values initialized.
+func NewGetSettlementReportParams() GetSettlementReportParams {
+
+	var (
+		// initialize parameters with default values
+
+		formatDefault = string("csv")
+	)
+
+	return GetSettlementReportParams{
+		Format: &formatDefault,
+	}
+}
+
+// GetSettlementReportParams contains all the bound params for the get settlement report operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getSettlementReport
+type GetSettlementReportParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Day to report.
+	  Required: true
+	  In: query
+	*/
+	Date strfmt.Date
+	/*Format of the report, camt053 is an ISO 20022 camt.053 bank to customer statement.
+	  In: query
+	  Default: "csv"
+	*/
+	Format *string
+	/*User id of the merchant to report, every merchant is reported when it is empty.
+	  In: query
+	*/
+	MerchantID *strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetSettlementReportParams() beforehand.
+func (o *GetSettlementReportParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qDate, qhkDate, _ := qs.GetOK("date")
+	if err := o.bindDate(qDate, qhkDate, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMerchantID, qhkMerchantID, _ := qs.GetOK("merchant_id")
+	if err := o.bindMerchantID(qMerchantID, qhkMerchantID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindDate binds and validates parameter Date from query.
+func (o *GetSettlementReportParams) bindDate(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("date", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("date", "query", raw); err != nil {
+		return err
+	}
+
+	// Format: date
+	value, err := formats.Parse("date", raw)
+	if err != nil {
+		return errors.InvalidType("date", "query", "strfmt.Date", raw)
+	}
+	o.Date = *(value.(*strfmt.Date))
+
+	if err := o.validateDate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDate carries on validations for parameter Date
+func (o *GetSettlementReportParams) validateDate(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("date", "query", "date", o.Date.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindFormat binds and validates parameter Format from query.
+func (o *GetSettlementReportParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetSettlementReportParams()
+		return nil
+	}
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *GetSettlementReportParams) validateFormat(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"csv", "json", "camt053"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindMerchantID binds and validates parameter MerchantID from query.
+func (o *GetSettlementReportParams) bindMerchantID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("merchant_id", "query", "strfmt.UUID", raw)
+	}
+	o.MerchantID = (value.(*strfmt.UUID))
+
+	if err := o.validateMerchantID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMerchantID carries on validations for parameter MerchantID
+func (o *GetSettlementReportParams) validateMerchantID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("merchant_id", "query", "uuid", o.MerchantID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
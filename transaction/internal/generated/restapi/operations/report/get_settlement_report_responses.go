@@ -0,0 +1,193 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package report
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetSettlementReportOKCode is the HTTP code returned for type GetSettlementReportOK
+const GetSettlementReportOKCode int = 200
+
+/*
+GetSettlementReportOK Settlement report successfully rendered.
+
+swagger:response getSettlementReportOK
+*/
+type GetSettlementReportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetSettlementReportOK creates GetSettlementReportOK with default headers values
+func NewGetSettlementReportOK() *GetSettlementReportOK {
+
+	return &GetSettlementReportOK{}
+}
+
+// WithPayload adds the payload to the get settlement report o k response
+func (o *GetSettlementReportOK) WithPayload(payload io.ReadCloser) *GetSettlementReportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get settlement report o k response
+func (o *GetSettlementReportOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettlementReportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetSettlementReportBadRequestCode is the HTTP code returned for type GetSettlementReportBadRequest
+const GetSettlementReportBadRequestCode int = 400
+
+/*
+GetSettlementReportBadRequest Bad request error.
+
+swagger:response getSettlementReportBadRequest
+*/
+type GetSettlementReportBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetSettlementReportBadRequest creates GetSettlementReportBadRequest with default headers values
+func NewGetSettlementReportBadRequest() *GetSettlementReportBadRequest {
+
+	return &GetSettlementReportBadRequest{}
+}
+
+// WithPayload adds the payload to the get settlement report bad request response
+func (o *GetSettlementReportBadRequest) WithPayload(payload *models.ErrorResponse) *GetSettlementReportBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get settlement report bad request response
+func (o *GetSettlementReportBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettlementReportBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetSettlementReportForbiddenCode is the HTTP code returned for type GetSettlementReportForbidden
+const GetSettlementReportForbiddenCode int = 403
+
+/*
+GetSettlementReportForbidden Forbidden error.
+
+swagger:response getSettlementReportForbidden
+*/
+type GetSettlementReportForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetSettlementReportForbidden creates GetSettlementReportForbidden with default headers values
+func NewGetSettlementReportForbidden() *GetSettlementReportForbidden {
+
+	return &GetSettlementReportForbidden{}
+}
+
+// WithPayload adds the payload to the get settlement report forbidden response
+func (o *GetSettlementReportForbidden) WithPayload(payload *models.ErrorResponse) *GetSettlementReportForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get settlement report forbidden response
+func (o *GetSettlementReportForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettlementReportForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetSettlementReportInternalServerErrorCode is the HTTP code returned for type GetSettlementReportInternalServerError
+const GetSettlementReportInternalServerErrorCode int = 500
+
+/*
+GetSettlementReportInternalServerError Internal server error.
+
+swagger:response getSettlementReportInternalServerError
+*/
+type GetSettlementReportInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetSettlementReportInternalServerError creates GetSettlementReportInternalServerError with default headers values
+func NewGetSettlementReportInternalServerError() *GetSettlementReportInternalServerError {
+
+	return &GetSettlementReportInternalServerError{}
+}
+
+// WithPayload adds the payload to the get settlement report internal server error response
+func (o *GetSettlementReportInternalServerError) WithPayload(payload *models.ErrorResponse) *GetSettlementReportInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get settlement report internal server error response
+func (o *GetSettlementReportInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettlementReportInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -38,6 +38,96 @@ func (o *AcceptTransactionOK) WriteResponse(rw http.ResponseWriter, producer run
 	rw.WriteHeader(200)
 }
 
+// AcceptTransactionAcceptedCode is the HTTP code returned for type AcceptTransactionAccepted
+const AcceptTransactionAcceptedCode int = 202
+
+/*
+AcceptTransactionAccepted Transaction amount is above the threshold, payer confirmation is required.
+
+swagger:response acceptTransactionAccepted
+*/
+type AcceptTransactionAccepted struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ConfirmationRequiredResponse `json:"body,omitempty"`
+}
+
+// NewAcceptTransactionAccepted creates AcceptTransactionAccepted with default headers values
+func NewAcceptTransactionAccepted() *AcceptTransactionAccepted {
+
+	return &AcceptTransactionAccepted{}
+}
+
+// WithPayload adds the payload to the accept transaction accepted response
+func (o *AcceptTransactionAccepted) WithPayload(payload *models.ConfirmationRequiredResponse) *AcceptTransactionAccepted {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept transaction accepted response
+func (o *AcceptTransactionAccepted) SetPayload(payload *models.ConfirmationRequiredResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptTransactionAccepted) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(202)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AcceptTransactionBadRequestCode is the HTTP code returned for type AcceptTransactionBadRequest
+const AcceptTransactionBadRequestCode int = 400
+
+/*
+AcceptTransactionBadRequest The QR payload is malformed, not signed by the service or issued for another transaction, or execute_at is set for a group transaction or a transaction with a locked quote.
+
+swagger:response acceptTransactionBadRequest
+*/
+type AcceptTransactionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAcceptTransactionBadRequest creates AcceptTransactionBadRequest with default headers values
+func NewAcceptTransactionBadRequest() *AcceptTransactionBadRequest {
+
+	return &AcceptTransactionBadRequest{}
+}
+
+// WithPayload adds the payload to the accept transaction bad request response
+func (o *AcceptTransactionBadRequest) WithPayload(payload *models.ErrorResponse) *AcceptTransactionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept transaction bad request response
+func (o *AcceptTransactionBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptTransactionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // AcceptTransactionForbiddenCode is the HTTP code returned for type AcceptTransactionForbidden
 const AcceptTransactionForbiddenCode int = 403
 
@@ -128,6 +218,141 @@ func (o *AcceptTransactionNotFound) WriteResponse(rw http.ResponseWriter, produc
 	}
 }
 
+// AcceptTransactionConflictCode is the HTTP code returned for type AcceptTransactionConflict
+const AcceptTransactionConflictCode int = 409
+
+/*
+AcceptTransactionConflict The QR payload was already used, or every share of the group transaction is taken.
+
+swagger:response acceptTransactionConflict
+*/
+type AcceptTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAcceptTransactionConflict creates AcceptTransactionConflict with default headers values
+func NewAcceptTransactionConflict() *AcceptTransactionConflict {
+
+	return &AcceptTransactionConflict{}
+}
+
+// WithPayload adds the payload to the accept transaction conflict response
+func (o *AcceptTransactionConflict) WithPayload(payload *models.ErrorResponse) *AcceptTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept transaction conflict response
+func (o *AcceptTransactionConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AcceptTransactionGoneCode is the HTTP code returned for type AcceptTransactionGone
+const AcceptTransactionGoneCode int = 410
+
+/*
+AcceptTransactionGone The QR payload, the transaction or its locked exchange rate expired.
+
+swagger:response acceptTransactionGone
+*/
+type AcceptTransactionGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAcceptTransactionGone creates AcceptTransactionGone with default headers values
+func NewAcceptTransactionGone() *AcceptTransactionGone {
+
+	return &AcceptTransactionGone{}
+}
+
+// WithPayload adds the payload to the accept transaction gone response
+func (o *AcceptTransactionGone) WithPayload(payload *models.ErrorResponse) *AcceptTransactionGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept transaction gone response
+func (o *AcceptTransactionGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptTransactionGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// AcceptTransactionUnprocessableEntityCode is the HTTP code returned for type AcceptTransactionUnprocessableEntity
+const AcceptTransactionUnprocessableEntityCode int = 422
+
+/*
+AcceptTransactionUnprocessableEntity The transaction was edited after the QR payload was issued.
+
+swagger:response acceptTransactionUnprocessableEntity
+*/
+type AcceptTransactionUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewAcceptTransactionUnprocessableEntity creates AcceptTransactionUnprocessableEntity with default headers values
+func NewAcceptTransactionUnprocessableEntity() *AcceptTransactionUnprocessableEntity {
+
+	return &AcceptTransactionUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the accept transaction unprocessable entity response
+func (o *AcceptTransactionUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *AcceptTransactionUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the accept transaction unprocessable entity response
+func (o *AcceptTransactionUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AcceptTransactionUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // AcceptTransactionInternalServerErrorCode is the HTTP code returned for type AcceptTransactionInternalServerError
 const AcceptTransactionInternalServerErrorCode int = 500
 
@@ -128,6 +128,51 @@ func (o *CancelTransactionNotFound) WriteResponse(rw http.ResponseWriter, produc
 	}
 }
 
+// CancelTransactionConflictCode is the HTTP code returned for type CancelTransactionConflict
+const CancelTransactionConflictCode int = 409
+
+/*
+CancelTransactionConflict The transaction was already handed over to the payment gateway, it can no longer be cancelled.
+
+swagger:response cancelTransactionConflict
+*/
+type CancelTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCancelTransactionConflict creates CancelTransactionConflict with default headers values
+func NewCancelTransactionConflict() *CancelTransactionConflict {
+
+	return &CancelTransactionConflict{}
+}
+
+// WithPayload adds the payload to the cancel transaction conflict response
+func (o *CancelTransactionConflict) WithPayload(payload *models.ErrorResponse) *CancelTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel transaction conflict response
+func (o *CancelTransactionConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // CancelTransactionInternalServerErrorCode is the HTTP code returned for type CancelTransactionInternalServerError
 const CancelTransactionInternalServerErrorCode int = 500
 
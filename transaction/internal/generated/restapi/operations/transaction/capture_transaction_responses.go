@@ -0,0 +1,264 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// CaptureTransactionOKCode is the HTTP code returned for type CaptureTransactionOK
+const CaptureTransactionOKCode int = 200
+
+/*
+CaptureTransactionOK Capture requested, the rest of the hold is released.
+
+swagger:response captureTransactionOK
+*/
+type CaptureTransactionOK struct {
+}
+
+// NewCaptureTransactionOK creates CaptureTransactionOK with default headers values
+func NewCaptureTransactionOK() *CaptureTransactionOK {
+
+	return &CaptureTransactionOK{}
+}
+
+// WriteResponse to the client
+func (o *CaptureTransactionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// CaptureTransactionBadRequestCode is the HTTP code returned for type CaptureTransactionBadRequest
+const CaptureTransactionBadRequestCode int = 400
+
+/*
+CaptureTransactionBadRequest The amount exceeds the held amount, or a split or quoted transaction is captured in part.
+
+swagger:response captureTransactionBadRequest
+*/
+type CaptureTransactionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCaptureTransactionBadRequest creates CaptureTransactionBadRequest with default headers values
+func NewCaptureTransactionBadRequest() *CaptureTransactionBadRequest {
+
+	return &CaptureTransactionBadRequest{}
+}
+
+// WithPayload adds the payload to the capture transaction bad request response
+func (o *CaptureTransactionBadRequest) WithPayload(payload *models.ErrorResponse) *CaptureTransactionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the capture transaction bad request response
+func (o *CaptureTransactionBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CaptureTransactionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CaptureTransactionForbiddenCode is the HTTP code returned for type CaptureTransactionForbidden
+const CaptureTransactionForbiddenCode int = 403
+
+/*
+CaptureTransactionForbidden Forbidden error.
+
+swagger:response captureTransactionForbidden
+*/
+type CaptureTransactionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCaptureTransactionForbidden creates CaptureTransactionForbidden with default headers values
+func NewCaptureTransactionForbidden() *CaptureTransactionForbidden {
+
+	return &CaptureTransactionForbidden{}
+}
+
+// WithPayload adds the payload to the capture transaction forbidden response
+func (o *CaptureTransactionForbidden) WithPayload(payload *models.ErrorResponse) *CaptureTransactionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the capture transaction forbidden response
+func (o *CaptureTransactionForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CaptureTransactionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CaptureTransactionNotFoundCode is the HTTP code returned for type CaptureTransactionNotFound
+const CaptureTransactionNotFoundCode int = 404
+
+/*
+CaptureTransactionNotFound Not found error.
+
+swagger:response captureTransactionNotFound
+*/
+type CaptureTransactionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCaptureTransactionNotFound creates CaptureTransactionNotFound with default headers values
+func NewCaptureTransactionNotFound() *CaptureTransactionNotFound {
+
+	return &CaptureTransactionNotFound{}
+}
+
+// WithPayload adds the payload to the capture transaction not found response
+func (o *CaptureTransactionNotFound) WithPayload(payload *models.ErrorResponse) *CaptureTransactionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the capture transaction not found response
+func (o *CaptureTransactionNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CaptureTransactionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CaptureTransactionConflictCode is the HTTP code returned for type CaptureTransactionConflict
+const CaptureTransactionConflictCode int = 409
+
+/*
+CaptureTransactionConflict The transaction is not authorized, or its hold expired or is being voided.
+
+swagger:response captureTransactionConflict
+*/
+type CaptureTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCaptureTransactionConflict creates CaptureTransactionConflict with default headers values
+func NewCaptureTransactionConflict() *CaptureTransactionConflict {
+
+	return &CaptureTransactionConflict{}
+}
+
+// WithPayload adds the payload to the capture transaction conflict response
+func (o *CaptureTransactionConflict) WithPayload(payload *models.ErrorResponse) *CaptureTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the capture transaction conflict response
+func (o *CaptureTransactionConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CaptureTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CaptureTransactionInternalServerErrorCode is the HTTP code returned for type CaptureTransactionInternalServerError
+const CaptureTransactionInternalServerErrorCode int = 500
+
+/*
+CaptureTransactionInternalServerError Internal server error.
+
+swagger:response captureTransactionInternalServerError
+*/
+type CaptureTransactionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCaptureTransactionInternalServerError creates CaptureTransactionInternalServerError with default headers values
+func NewCaptureTransactionInternalServerError() *CaptureTransactionInternalServerError {
+
+	return &CaptureTransactionInternalServerError{}
+}
+
+// WithPayload adds the payload to the capture transaction internal server error response
+func (o *CaptureTransactionInternalServerError) WithPayload(payload *models.ErrorResponse) *CaptureTransactionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the capture transaction internal server error response
+func (o *CaptureTransactionInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CaptureTransactionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
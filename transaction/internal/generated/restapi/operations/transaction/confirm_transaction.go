@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ConfirmTransactionHandlerFunc turns a function with the right signature into a confirm transaction handler
+type ConfirmTransactionHandlerFunc func(ConfirmTransactionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ConfirmTransactionHandlerFunc) Handle(params ConfirmTransactionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ConfirmTransactionHandler interface for that can handle valid confirm transaction params
+type ConfirmTransactionHandler interface {
+	Handle(ConfirmTransactionParams, interface{}) middleware.Responder
+}
+
+// NewConfirmTransaction creates a new http.Handler for the confirm transaction operation
+func NewConfirmTransaction(ctx *middleware.Context, handler ConfirmTransactionHandler) *ConfirmTransaction {
+	return &ConfirmTransaction{Context: ctx, Handler: handler}
+}
+
+/*
+	ConfirmTransaction swagger:route POST /transaction/{id}/confirm transaction confirmTransaction
+
+The method is used to confirm an accepted high-value transaction.
+*/
+type ConfirmTransaction struct {
+	Context *middleware.Context
+	Handler ConfirmTransactionHandler
+}
+
+func (o *ConfirmTransaction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewConfirmTransactionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
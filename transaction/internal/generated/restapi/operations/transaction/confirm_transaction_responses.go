@@ -0,0 +1,309 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// ConfirmTransactionOKCode is the HTTP code returned for type ConfirmTransactionOK
+const ConfirmTransactionOKCode int = 200
+
+/*
+ConfirmTransactionOK Transaction successfully confirmed.
+
+swagger:response confirmTransactionOK
+*/
+type ConfirmTransactionOK struct {
+}
+
+// NewConfirmTransactionOK creates ConfirmTransactionOK with default headers values
+func NewConfirmTransactionOK() *ConfirmTransactionOK {
+
+	return &ConfirmTransactionOK{}
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// ConfirmTransactionBadRequestCode is the HTTP code returned for type ConfirmTransactionBadRequest
+const ConfirmTransactionBadRequestCode int = 400
+
+/*
+ConfirmTransactionBadRequest Validation error.
+
+swagger:response confirmTransactionBadRequest
+*/
+type ConfirmTransactionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewConfirmTransactionBadRequest creates ConfirmTransactionBadRequest with default headers values
+func NewConfirmTransactionBadRequest() *ConfirmTransactionBadRequest {
+
+	return &ConfirmTransactionBadRequest{}
+}
+
+// WithPayload adds the payload to the confirm transaction bad request response
+func (o *ConfirmTransactionBadRequest) WithPayload(payload *models.ErrorResponse) *ConfirmTransactionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the confirm transaction bad request response
+func (o *ConfirmTransactionBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ConfirmTransactionForbiddenCode is the HTTP code returned for type ConfirmTransactionForbidden
+const ConfirmTransactionForbiddenCode int = 403
+
+/*
+ConfirmTransactionForbidden Transaction can be confirmed only by its payer.
+
+swagger:response confirmTransactionForbidden
+*/
+type ConfirmTransactionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewConfirmTransactionForbidden creates ConfirmTransactionForbidden with default headers values
+func NewConfirmTransactionForbidden() *ConfirmTransactionForbidden {
+
+	return &ConfirmTransactionForbidden{}
+}
+
+// WithPayload adds the payload to the confirm transaction forbidden response
+func (o *ConfirmTransactionForbidden) WithPayload(payload *models.ErrorResponse) *ConfirmTransactionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the confirm transaction forbidden response
+func (o *ConfirmTransactionForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ConfirmTransactionNotFoundCode is the HTTP code returned for type ConfirmTransactionNotFound
+const ConfirmTransactionNotFoundCode int = 404
+
+/*
+ConfirmTransactionNotFound Transaction is not awaiting confirmation.
+
+swagger:response confirmTransactionNotFound
+*/
+type ConfirmTransactionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewConfirmTransactionNotFound creates ConfirmTransactionNotFound with default headers values
+func NewConfirmTransactionNotFound() *ConfirmTransactionNotFound {
+
+	return &ConfirmTransactionNotFound{}
+}
+
+// WithPayload adds the payload to the confirm transaction not found response
+func (o *ConfirmTransactionNotFound) WithPayload(payload *models.ErrorResponse) *ConfirmTransactionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the confirm transaction not found response
+func (o *ConfirmTransactionNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ConfirmTransactionGoneCode is the HTTP code returned for type ConfirmTransactionGone
+const ConfirmTransactionGoneCode int = 410
+
+/*
+ConfirmTransactionGone Confirmation expired or ran out of attempts, the transaction must be accepted again.
+
+swagger:response confirmTransactionGone
+*/
+type ConfirmTransactionGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewConfirmTransactionGone creates ConfirmTransactionGone with default headers values
+func NewConfirmTransactionGone() *ConfirmTransactionGone {
+
+	return &ConfirmTransactionGone{}
+}
+
+// WithPayload adds the payload to the confirm transaction gone response
+func (o *ConfirmTransactionGone) WithPayload(payload *models.ErrorResponse) *ConfirmTransactionGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the confirm transaction gone response
+func (o *ConfirmTransactionGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ConfirmTransactionUnprocessableEntityCode is the HTTP code returned for type ConfirmTransactionUnprocessableEntity
+const ConfirmTransactionUnprocessableEntityCode int = 422
+
+/*
+ConfirmTransactionUnprocessableEntity Wrong confirmation code or signature.
+
+swagger:response confirmTransactionUnprocessableEntity
+*/
+type ConfirmTransactionUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewConfirmTransactionUnprocessableEntity creates ConfirmTransactionUnprocessableEntity with default headers values
+func NewConfirmTransactionUnprocessableEntity() *ConfirmTransactionUnprocessableEntity {
+
+	return &ConfirmTransactionUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the confirm transaction unprocessable entity response
+func (o *ConfirmTransactionUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ConfirmTransactionUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the confirm transaction unprocessable entity response
+func (o *ConfirmTransactionUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ConfirmTransactionInternalServerErrorCode is the HTTP code returned for type ConfirmTransactionInternalServerError
+const ConfirmTransactionInternalServerErrorCode int = 500
+
+/*
+ConfirmTransactionInternalServerError Internal server error.
+
+swagger:response confirmTransactionInternalServerError
+*/
+type ConfirmTransactionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewConfirmTransactionInternalServerError creates ConfirmTransactionInternalServerError with default headers values
+func NewConfirmTransactionInternalServerError() *ConfirmTransactionInternalServerError {
+
+	return &ConfirmTransactionInternalServerError{}
+}
+
+// WithPayload adds the payload to the confirm transaction internal server error response
+func (o *ConfirmTransactionInternalServerError) WithPayload(payload *models.ErrorResponse) *ConfirmTransactionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the confirm transaction internal server error response
+func (o *ConfirmTransactionInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ConfirmTransactionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -173,6 +173,51 @@ func (o *EditTransactionNotFound) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// EditTransactionConflictCode is the HTTP code returned for type EditTransactionConflict
+const EditTransactionConflictCode int = 409
+
+/*
+EditTransactionConflict The transaction was already accepted, canceled or expired, it can no longer be edited.
+
+swagger:response editTransactionConflict
+*/
+type EditTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewEditTransactionConflict creates EditTransactionConflict with default headers values
+func NewEditTransactionConflict() *EditTransactionConflict {
+
+	return &EditTransactionConflict{}
+}
+
+// WithPayload adds the payload to the edit transaction conflict response
+func (o *EditTransactionConflict) WithPayload(payload *models.ErrorResponse) *EditTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the edit transaction conflict response
+func (o *EditTransactionConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EditTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // EditTransactionInternalServerErrorCode is the HTTP code returned for type EditTransactionInternalServerError
 const EditTransactionInternalServerErrorCode int = 500
 
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTransactionFeeHandlerFunc turns a function with the right signature into a get transaction fee handler
+type GetTransactionFeeHandlerFunc func(GetTransactionFeeParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTransactionFeeHandlerFunc) Handle(params GetTransactionFeeParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetTransactionFeeHandler interface for that can handle valid get transaction fee params
+type GetTransactionFeeHandler interface {
+	Handle(GetTransactionFeeParams, interface{}) middleware.Responder
+}
+
+// NewGetTransactionFee creates a new http.Handler for the get transaction fee operation
+func NewGetTransactionFee(ctx *middleware.Context, handler GetTransactionFeeHandler) *GetTransactionFee {
+	return &GetTransactionFee{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTransactionFee swagger:route GET /transaction/{id}/fee transaction getTransactionFee
+
+The method is used to preview the fee charged on the transaction before accepting it.
+
+The fee is calculated with the fee plan of the receiver for the transaction method and currency and the receiver volume of the current month. It is deducted from what the receiver gets, the payer is charged the transaction amount. The fee is fixed when the transaction is accepted.
+*/
+type GetTransactionFee struct {
+	Context *middleware.Context
+	Handler GetTransactionFeeHandler
+}
+
+func (o *GetTransactionFee) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTransactionFeeParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,284 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetTransactionFeeOKCode is the HTTP code returned for type GetTransactionFeeOK
+const GetTransactionFeeOKCode int = 200
+
+/*
+GetTransactionFeeOK Fee successfully calculated.
+
+swagger:response getTransactionFeeOK
+*/
+type GetTransactionFeeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TransactionFeeResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionFeeOK creates GetTransactionFeeOK with default headers values
+func NewGetTransactionFeeOK() *GetTransactionFeeOK {
+
+	return &GetTransactionFeeOK{}
+}
+
+// WithPayload adds the payload to the get transaction fee o k response
+func (o *GetTransactionFeeOK) WithPayload(payload *models.TransactionFeeResponse) *GetTransactionFeeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction fee o k response
+func (o *GetTransactionFeeOK) SetPayload(payload *models.TransactionFeeResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionFeeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionFeeForbiddenCode is the HTTP code returned for type GetTransactionFeeForbidden
+const GetTransactionFeeForbiddenCode int = 403
+
+/*
+GetTransactionFeeForbidden Forbidden error.
+
+swagger:response getTransactionFeeForbidden
+*/
+type GetTransactionFeeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionFeeForbidden creates GetTransactionFeeForbidden with default headers values
+func NewGetTransactionFeeForbidden() *GetTransactionFeeForbidden {
+
+	return &GetTransactionFeeForbidden{}
+}
+
+// WithPayload adds the payload to the get transaction fee forbidden response
+func (o *GetTransactionFeeForbidden) WithPayload(payload *models.ErrorResponse) *GetTransactionFeeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction fee forbidden response
+func (o *GetTransactionFeeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionFeeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionFeeNotFoundCode is the HTTP code returned for type GetTransactionFeeNotFound
+const GetTransactionFeeNotFoundCode int = 404
+
+/*
+GetTransactionFeeNotFound Not found error.
+
+swagger:response getTransactionFeeNotFound
+*/
+type GetTransactionFeeNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionFeeNotFound creates GetTransactionFeeNotFound with default headers values
+func NewGetTransactionFeeNotFound() *GetTransactionFeeNotFound {
+
+	return &GetTransactionFeeNotFound{}
+}
+
+// WithPayload adds the payload to the get transaction fee not found response
+func (o *GetTransactionFeeNotFound) WithPayload(payload *models.ErrorResponse) *GetTransactionFeeNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction fee not found response
+func (o *GetTransactionFeeNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionFeeNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionFeeConflictCode is the HTTP code returned for type GetTransactionFeeConflict
+const GetTransactionFeeConflictCode int = 409
+
+/*
+GetTransactionFeeConflict The transaction is no longer in the created status.
+
+swagger:response getTransactionFeeConflict
+*/
+type GetTransactionFeeConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionFeeConflict creates GetTransactionFeeConflict with default headers values
+func NewGetTransactionFeeConflict() *GetTransactionFeeConflict {
+
+	return &GetTransactionFeeConflict{}
+}
+
+// WithPayload adds the payload to the get transaction fee conflict response
+func (o *GetTransactionFeeConflict) WithPayload(payload *models.ErrorResponse) *GetTransactionFeeConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction fee conflict response
+func (o *GetTransactionFeeConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionFeeConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionFeeGoneCode is the HTTP code returned for type GetTransactionFeeGone
+const GetTransactionFeeGoneCode int = 410
+
+/*
+GetTransactionFeeGone The transaction expired.
+
+swagger:response getTransactionFeeGone
+*/
+type GetTransactionFeeGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionFeeGone creates GetTransactionFeeGone with default headers values
+func NewGetTransactionFeeGone() *GetTransactionFeeGone {
+
+	return &GetTransactionFeeGone{}
+}
+
+// WithPayload adds the payload to the get transaction fee gone response
+func (o *GetTransactionFeeGone) WithPayload(payload *models.ErrorResponse) *GetTransactionFeeGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction fee gone response
+func (o *GetTransactionFeeGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionFeeGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionFeeInternalServerErrorCode is the HTTP code returned for type GetTransactionFeeInternalServerError
+const GetTransactionFeeInternalServerErrorCode int = 500
+
+/*
+GetTransactionFeeInternalServerError Internal server error.
+
+swagger:response getTransactionFeeInternalServerError
+*/
+type GetTransactionFeeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionFeeInternalServerError creates GetTransactionFeeInternalServerError with default headers values
+func NewGetTransactionFeeInternalServerError() *GetTransactionFeeInternalServerError {
+
+	return &GetTransactionFeeInternalServerError{}
+}
+
+// WithPayload adds the payload to the get transaction fee internal server error response
+func (o *GetTransactionFeeInternalServerError) WithPayload(payload *models.ErrorResponse) *GetTransactionFeeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction fee internal server error response
+func (o *GetTransactionFeeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionFeeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetTransactionQRHandlerFunc turns a function with the right signature into a get transaction q r handler
+type GetTransactionQRHandlerFunc func(GetTransactionQRParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTransactionQRHandlerFunc) Handle(params GetTransactionQRParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetTransactionQRHandler interface for that can handle valid get transaction q r params
+type GetTransactionQRHandler interface {
+	Handle(GetTransactionQRParams, interface{}) middleware.Responder
+}
+
+// NewGetTransactionQR creates a new http.Handler for the get transaction q r operation
+func NewGetTransactionQR(ctx *middleware.Context, handler GetTransactionQRHandler) *GetTransactionQR {
+	return &GetTransactionQR{Context: ctx, Handler: handler}
+}
+
+/*
+	GetTransactionQR swagger:route GET /transaction/{id}/qr transaction getTransactionQR
+
+The method is used to render the payment QR code of the transaction. Every call issues a new signed single-use payload.
+*/
+type GetTransactionQR struct {
+	Context *middleware.Context
+	Handler GetTransactionQRHandler
+}
+
+func (o *GetTransactionQR) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetTransactionQRParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
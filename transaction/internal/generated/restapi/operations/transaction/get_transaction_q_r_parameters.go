@@ -0,0 +1,249 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetTransactionQRParams creates a new GetTransactionQRParams object
+// with the default values initialized.
+func NewGetTransactionQRParams() GetTransactionQRParams {
+
+	var (
+		// initialize parameters with default values
+
+		formatDefault = string("png")
+
+		levelDefault = string("M")
+		sizeDefault  = int32(256)
+	)
+
+	return GetTransactionQRParams{
+		Format: &formatDefault,
+
+		Level: &levelDefault,
+
+		Size: &sizeDefault,
+	}
+}
+
+// GetTransactionQRParams contains all the bound params for the get transaction q r operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getTransactionQR
+type GetTransactionQRParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Image format of the QR code, text returns the signed payload itself.
+	  In: query
+	  Default: "png"
+	*/
+	Format *string
+	/*Transaction id to render.
+	  Required: true
+	  In: path
+	*/
+	ID strfmt.UUID
+	/*Error-correction level of the QR code.
+	  In: query
+	  Default: "M"
+	*/
+	Level *string
+	/*Width and height of the image in pixels.
+	  Maximum: 2048
+	  Minimum: 64
+	  In: query
+	  Default: 256
+	*/
+	Size *int32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetTransactionQRParams() beforehand.
+func (o *GetTransactionQRParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLevel, qhkLevel, _ := qs.GetOK("level")
+	if err := o.bindLevel(qLevel, qhkLevel, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSize, qhkSize, _ := qs.GetOK("size")
+	if err := o.bindSize(qSize, qhkSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindFormat binds and validates parameter Format from query.
+func (o *GetTransactionQRParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetTransactionQRParams()
+		return nil
+	}
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *GetTransactionQRParams) validateFormat(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"png", "svg", "text"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *GetTransactionQRParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("id", "path", "strfmt.UUID", raw)
+	}
+	o.ID = *(value.(*strfmt.UUID))
+
+	if err := o.validateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateID carries on validations for parameter ID
+func (o *GetTransactionQRParams) validateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("id", "path", "uuid", o.ID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindLevel binds and validates parameter Level from query.
+func (o *GetTransactionQRParams) bindLevel(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetTransactionQRParams()
+		return nil
+	}
+	o.Level = &raw
+
+	if err := o.validateLevel(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLevel carries on validations for parameter Level
+func (o *GetTransactionQRParams) validateLevel(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("level", "query", *o.Level, []interface{}{"L", "M", "Q", "H"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSize binds and validates parameter Size from query.
+func (o *GetTransactionQRParams) bindSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetTransactionQRParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("size", "query", "int32", raw)
+	}
+	o.Size = &value
+
+	if err := o.validateSize(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSize carries on validations for parameter Size
+func (o *GetTransactionQRParams) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("size", "query", int64(*o.Size), 64, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("size", "query", int64(*o.Size), 2048, false); err != nil {
+		return err
+	}
+
+	return nil
+}
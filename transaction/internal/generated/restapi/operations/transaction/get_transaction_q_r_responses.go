@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// GetTransactionQROKCode is the HTTP code returned for type GetTransactionQROK
+const GetTransactionQROKCode int = 200
+
+/*
+GetTransactionQROK QR code successfully rendered.
+
+swagger:response getTransactionQROK
+*/
+type GetTransactionQROK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewGetTransactionQROK creates GetTransactionQROK with default headers values
+func NewGetTransactionQROK() *GetTransactionQROK {
+
+	return &GetTransactionQROK{}
+}
+
+// WithPayload adds the payload to the get transaction q r o k response
+func (o *GetTransactionQROK) WithPayload(payload io.ReadCloser) *GetTransactionQROK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction q r o k response
+func (o *GetTransactionQROK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionQROK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// GetTransactionQRBadRequestCode is the HTTP code returned for type GetTransactionQRBadRequest
+const GetTransactionQRBadRequestCode int = 400
+
+/*
+GetTransactionQRBadRequest Bad request error.
+
+swagger:response getTransactionQRBadRequest
+*/
+type GetTransactionQRBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionQRBadRequest creates GetTransactionQRBadRequest with default headers values
+func NewGetTransactionQRBadRequest() *GetTransactionQRBadRequest {
+
+	return &GetTransactionQRBadRequest{}
+}
+
+// WithPayload adds the payload to the get transaction q r bad request response
+func (o *GetTransactionQRBadRequest) WithPayload(payload *models.ErrorResponse) *GetTransactionQRBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction q r bad request response
+func (o *GetTransactionQRBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionQRBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionQRForbiddenCode is the HTTP code returned for type GetTransactionQRForbidden
+const GetTransactionQRForbiddenCode int = 403
+
+/*
+GetTransactionQRForbidden Forbidden error.
+
+swagger:response getTransactionQRForbidden
+*/
+type GetTransactionQRForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionQRForbidden creates GetTransactionQRForbidden with default headers values
+func NewGetTransactionQRForbidden() *GetTransactionQRForbidden {
+
+	return &GetTransactionQRForbidden{}
+}
+
+// WithPayload adds the payload to the get transaction q r forbidden response
+func (o *GetTransactionQRForbidden) WithPayload(payload *models.ErrorResponse) *GetTransactionQRForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction q r forbidden response
+func (o *GetTransactionQRForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionQRForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionQRNotFoundCode is the HTTP code returned for type GetTransactionQRNotFound
+const GetTransactionQRNotFoundCode int = 404
+
+/*
+GetTransactionQRNotFound Not found error.
+
+swagger:response getTransactionQRNotFound
+*/
+type GetTransactionQRNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionQRNotFound creates GetTransactionQRNotFound with default headers values
+func NewGetTransactionQRNotFound() *GetTransactionQRNotFound {
+
+	return &GetTransactionQRNotFound{}
+}
+
+// WithPayload adds the payload to the get transaction q r not found response
+func (o *GetTransactionQRNotFound) WithPayload(payload *models.ErrorResponse) *GetTransactionQRNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction q r not found response
+func (o *GetTransactionQRNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionQRNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetTransactionQRInternalServerErrorCode is the HTTP code returned for type GetTransactionQRInternalServerError
+const GetTransactionQRInternalServerErrorCode int = 500
+
+/*
+GetTransactionQRInternalServerError Internal server error.
+
+swagger:response getTransactionQRInternalServerError
+*/
+type GetTransactionQRInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGetTransactionQRInternalServerError creates GetTransactionQRInternalServerError with default headers values
+func NewGetTransactionQRInternalServerError() *GetTransactionQRInternalServerError {
+
+	return &GetTransactionQRInternalServerError{}
+}
+
+// WithPayload adds the payload to the get transaction q r internal server error response
+func (o *GetTransactionQRInternalServerError) WithPayload(payload *models.ErrorResponse) *GetTransactionQRInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get transaction q r internal server error response
+func (o *GetTransactionQRInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTransactionQRInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
 )
@@ -103,6 +104,118 @@ func (o *LoginBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime
 	}
 }
 
+// LoginUnauthorizedCode is the HTTP code returned for type LoginUnauthorized
+const LoginUnauthorizedCode int = 401
+
+/*
+LoginUnauthorized Wrong email or password.
+
+swagger:response loginUnauthorized
+*/
+type LoginUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginUnauthorized creates LoginUnauthorized with default headers values
+func NewLoginUnauthorized() *LoginUnauthorized {
+
+	return &LoginUnauthorized{}
+}
+
+// WithPayload adds the payload to the login unauthorized response
+func (o *LoginUnauthorized) WithPayload(payload *models.ErrorResponse) *LoginUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login unauthorized response
+func (o *LoginUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// LoginLockedCode is the HTTP code returned for type LoginLocked
+const LoginLockedCode int = 423
+
+/*
+LoginLocked Too many failed attempts, login is temporarily locked.
+
+swagger:response loginLocked
+*/
+type LoginLocked struct {
+	/*Seconds until the lock ends.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginLocked creates LoginLocked with default headers values
+func NewLoginLocked() *LoginLocked {
+
+	return &LoginLocked{}
+}
+
+// WithRetryAfter adds the retryAfter to the login locked response
+func (o *LoginLocked) WithRetryAfter(retryAfter int64) *LoginLocked {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the login locked response
+func (o *LoginLocked) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the login locked response
+func (o *LoginLocked) WithPayload(payload *models.ErrorResponse) *LoginLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login locked response
+func (o *LoginLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // LoginInternalServerErrorCode is the HTTP code returned for type LoginInternalServerError
 const LoginInternalServerErrorCode int = 500
 
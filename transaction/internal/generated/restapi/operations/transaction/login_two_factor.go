@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// LoginTwoFactorHandlerFunc turns a function with the right signature into a login two factor handler
+type LoginTwoFactorHandlerFunc func(LoginTwoFactorParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn LoginTwoFactorHandlerFunc) Handle(params LoginTwoFactorParams) middleware.Responder {
+	return fn(params)
+}
+
+// LoginTwoFactorHandler interface for that can handle valid login two factor params
+type LoginTwoFactorHandler interface {
+	Handle(LoginTwoFactorParams) middleware.Responder
+}
+
+// NewLoginTwoFactor creates a new http.Handler for the login two factor operation
+func NewLoginTwoFactor(ctx *middleware.Context, handler LoginTwoFactorHandler) *LoginTwoFactor {
+	return &LoginTwoFactor{Context: ctx, Handler: handler}
+}
+
+/*
+	LoginTwoFactor swagger:route POST /transaction/login/2fa transaction loginTwoFactor
+
+The method is used to complete login with a two-factor code.
+*/
+type LoginTwoFactor struct {
+	Context *middleware.Context
+	Handler LoginTwoFactorHandler
+}
+
+func (o *LoginTwoFactor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewLoginTwoFactorParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
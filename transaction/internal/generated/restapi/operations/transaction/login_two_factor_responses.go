@@ -0,0 +1,262 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// LoginTwoFactorOKCode is the HTTP code returned for type LoginTwoFactorOK
+const LoginTwoFactorOKCode int = 200
+
+/*
+LoginTwoFactorOK User successfully logined.
+
+swagger:response loginTwoFactorOK
+*/
+type LoginTwoFactorOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LoginResponse `json:"body,omitempty"`
+}
+
+// NewLoginTwoFactorOK creates LoginTwoFactorOK with default headers values
+func NewLoginTwoFactorOK() *LoginTwoFactorOK {
+
+	return &LoginTwoFactorOK{}
+}
+
+// WithPayload adds the payload to the login two factor o k response
+func (o *LoginTwoFactorOK) WithPayload(payload *models.LoginResponse) *LoginTwoFactorOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login two factor o k response
+func (o *LoginTwoFactorOK) SetPayload(payload *models.LoginResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginTwoFactorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// LoginTwoFactorBadRequestCode is the HTTP code returned for type LoginTwoFactorBadRequest
+const LoginTwoFactorBadRequestCode int = 400
+
+/*
+LoginTwoFactorBadRequest Validation error.
+
+swagger:response loginTwoFactorBadRequest
+*/
+type LoginTwoFactorBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginTwoFactorBadRequest creates LoginTwoFactorBadRequest with default headers values
+func NewLoginTwoFactorBadRequest() *LoginTwoFactorBadRequest {
+
+	return &LoginTwoFactorBadRequest{}
+}
+
+// WithPayload adds the payload to the login two factor bad request response
+func (o *LoginTwoFactorBadRequest) WithPayload(payload *models.ErrorResponse) *LoginTwoFactorBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login two factor bad request response
+func (o *LoginTwoFactorBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginTwoFactorBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// LoginTwoFactorUnauthorizedCode is the HTTP code returned for type LoginTwoFactorUnauthorized
+const LoginTwoFactorUnauthorizedCode int = 401
+
+/*
+LoginTwoFactorUnauthorized Challenge token is invalid or the code is wrong.
+
+swagger:response loginTwoFactorUnauthorized
+*/
+type LoginTwoFactorUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginTwoFactorUnauthorized creates LoginTwoFactorUnauthorized with default headers values
+func NewLoginTwoFactorUnauthorized() *LoginTwoFactorUnauthorized {
+
+	return &LoginTwoFactorUnauthorized{}
+}
+
+// WithPayload adds the payload to the login two factor unauthorized response
+func (o *LoginTwoFactorUnauthorized) WithPayload(payload *models.ErrorResponse) *LoginTwoFactorUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login two factor unauthorized response
+func (o *LoginTwoFactorUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginTwoFactorUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// LoginTwoFactorLockedCode is the HTTP code returned for type LoginTwoFactorLocked
+const LoginTwoFactorLockedCode int = 423
+
+/*
+LoginTwoFactorLocked Too many failed attempts, login is temporarily locked.
+
+swagger:response loginTwoFactorLocked
+*/
+type LoginTwoFactorLocked struct {
+	/*Seconds until the lock ends.
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginTwoFactorLocked creates LoginTwoFactorLocked with default headers values
+func NewLoginTwoFactorLocked() *LoginTwoFactorLocked {
+
+	return &LoginTwoFactorLocked{}
+}
+
+// WithRetryAfter adds the retryAfter to the login two factor locked response
+func (o *LoginTwoFactorLocked) WithRetryAfter(retryAfter int64) *LoginTwoFactorLocked {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the login two factor locked response
+func (o *LoginTwoFactorLocked) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the login two factor locked response
+func (o *LoginTwoFactorLocked) WithPayload(payload *models.ErrorResponse) *LoginTwoFactorLocked {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login two factor locked response
+func (o *LoginTwoFactorLocked) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginTwoFactorLocked) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(423)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// LoginTwoFactorInternalServerErrorCode is the HTTP code returned for type LoginTwoFactorInternalServerError
+const LoginTwoFactorInternalServerErrorCode int = 500
+
+/*
+LoginTwoFactorInternalServerError Internal server error.
+
+swagger:response loginTwoFactorInternalServerError
+*/
+type LoginTwoFactorInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewLoginTwoFactorInternalServerError creates LoginTwoFactorInternalServerError with default headers values
+func NewLoginTwoFactorInternalServerError() *LoginTwoFactorInternalServerError {
+
+	return &LoginTwoFactorInternalServerError{}
+}
+
+// WithPayload adds the payload to the login two factor internal server error response
+func (o *LoginTwoFactorInternalServerError) WithPayload(payload *models.ErrorResponse) *LoginTwoFactorInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the login two factor internal server error response
+func (o *LoginTwoFactorInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *LoginTwoFactorInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
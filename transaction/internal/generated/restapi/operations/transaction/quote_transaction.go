@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// QuoteTransactionHandlerFunc turns a function with the right signature into a quote transaction handler
+type QuoteTransactionHandlerFunc func(QuoteTransactionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn QuoteTransactionHandlerFunc) Handle(params QuoteTransactionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// QuoteTransactionHandler interface for that can handle valid quote transaction params
+type QuoteTransactionHandler interface {
+	Handle(QuoteTransactionParams, interface{}) middleware.Responder
+}
+
+// NewQuoteTransaction creates a new http.Handler for the quote transaction operation
+func NewQuoteTransaction(ctx *middleware.Context, handler QuoteTransactionHandler) *QuoteTransaction {
+	return &QuoteTransaction{Context: ctx, Handler: handler}
+}
+
+/*
+	QuoteTransaction swagger:route POST /transaction/{id}/quote transaction quoteTransaction
+
+The method is used to lock an exchange rate for paying the transaction in another currency.
+
+The transaction amount is converted into the requested currency at the provider rate with the service margin. The rate stays locked until expires_at, the transaction must be accepted before then. A new quote replaces the previous one, editing the transaction drops it.
+*/
+type QuoteTransaction struct {
+	Context *middleware.Context
+	Handler QuoteTransactionHandler
+}
+
+func (o *QuoteTransaction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewQuoteTransactionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
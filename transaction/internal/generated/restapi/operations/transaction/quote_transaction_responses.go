@@ -0,0 +1,374 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// QuoteTransactionOKCode is the HTTP code returned for type QuoteTransactionOK
+const QuoteTransactionOKCode int = 200
+
+/*
+QuoteTransactionOK Exchange rate locked.
+
+swagger:response quoteTransactionOK
+*/
+type QuoteTransactionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TransactionQuote `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionOK creates QuoteTransactionOK with default headers values
+func NewQuoteTransactionOK() *QuoteTransactionOK {
+
+	return &QuoteTransactionOK{}
+}
+
+// WithPayload adds the payload to the quote transaction o k response
+func (o *QuoteTransactionOK) WithPayload(payload *models.TransactionQuote) *QuoteTransactionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction o k response
+func (o *QuoteTransactionOK) SetPayload(payload *models.TransactionQuote) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionBadRequestCode is the HTTP code returned for type QuoteTransactionBadRequest
+const QuoteTransactionBadRequestCode int = 400
+
+/*
+QuoteTransactionBadRequest The currency is unknown or already the transaction currency.
+
+swagger:response quoteTransactionBadRequest
+*/
+type QuoteTransactionBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionBadRequest creates QuoteTransactionBadRequest with default headers values
+func NewQuoteTransactionBadRequest() *QuoteTransactionBadRequest {
+
+	return &QuoteTransactionBadRequest{}
+}
+
+// WithPayload adds the payload to the quote transaction bad request response
+func (o *QuoteTransactionBadRequest) WithPayload(payload *models.ErrorResponse) *QuoteTransactionBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction bad request response
+func (o *QuoteTransactionBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionForbiddenCode is the HTTP code returned for type QuoteTransactionForbidden
+const QuoteTransactionForbiddenCode int = 403
+
+/*
+QuoteTransactionForbidden Forbidden error.
+
+swagger:response quoteTransactionForbidden
+*/
+type QuoteTransactionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionForbidden creates QuoteTransactionForbidden with default headers values
+func NewQuoteTransactionForbidden() *QuoteTransactionForbidden {
+
+	return &QuoteTransactionForbidden{}
+}
+
+// WithPayload adds the payload to the quote transaction forbidden response
+func (o *QuoteTransactionForbidden) WithPayload(payload *models.ErrorResponse) *QuoteTransactionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction forbidden response
+func (o *QuoteTransactionForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionNotFoundCode is the HTTP code returned for type QuoteTransactionNotFound
+const QuoteTransactionNotFoundCode int = 404
+
+/*
+QuoteTransactionNotFound Not found error.
+
+swagger:response quoteTransactionNotFound
+*/
+type QuoteTransactionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionNotFound creates QuoteTransactionNotFound with default headers values
+func NewQuoteTransactionNotFound() *QuoteTransactionNotFound {
+
+	return &QuoteTransactionNotFound{}
+}
+
+// WithPayload adds the payload to the quote transaction not found response
+func (o *QuoteTransactionNotFound) WithPayload(payload *models.ErrorResponse) *QuoteTransactionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction not found response
+func (o *QuoteTransactionNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionConflictCode is the HTTP code returned for type QuoteTransactionConflict
+const QuoteTransactionConflictCode int = 409
+
+/*
+QuoteTransactionConflict The transaction is no longer in the created status, or it is a group transaction.
+
+swagger:response quoteTransactionConflict
+*/
+type QuoteTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionConflict creates QuoteTransactionConflict with default headers values
+func NewQuoteTransactionConflict() *QuoteTransactionConflict {
+
+	return &QuoteTransactionConflict{}
+}
+
+// WithPayload adds the payload to the quote transaction conflict response
+func (o *QuoteTransactionConflict) WithPayload(payload *models.ErrorResponse) *QuoteTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction conflict response
+func (o *QuoteTransactionConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionGoneCode is the HTTP code returned for type QuoteTransactionGone
+const QuoteTransactionGoneCode int = 410
+
+/*
+QuoteTransactionGone The transaction expired.
+
+swagger:response quoteTransactionGone
+*/
+type QuoteTransactionGone struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionGone creates QuoteTransactionGone with default headers values
+func NewQuoteTransactionGone() *QuoteTransactionGone {
+
+	return &QuoteTransactionGone{}
+}
+
+// WithPayload adds the payload to the quote transaction gone response
+func (o *QuoteTransactionGone) WithPayload(payload *models.ErrorResponse) *QuoteTransactionGone {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction gone response
+func (o *QuoteTransactionGone) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionGone) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(410)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionInternalServerErrorCode is the HTTP code returned for type QuoteTransactionInternalServerError
+const QuoteTransactionInternalServerErrorCode int = 500
+
+/*
+QuoteTransactionInternalServerError Internal server error.
+
+swagger:response quoteTransactionInternalServerError
+*/
+type QuoteTransactionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionInternalServerError creates QuoteTransactionInternalServerError with default headers values
+func NewQuoteTransactionInternalServerError() *QuoteTransactionInternalServerError {
+
+	return &QuoteTransactionInternalServerError{}
+}
+
+// WithPayload adds the payload to the quote transaction internal server error response
+func (o *QuoteTransactionInternalServerError) WithPayload(payload *models.ErrorResponse) *QuoteTransactionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction internal server error response
+func (o *QuoteTransactionInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QuoteTransactionBadGatewayCode is the HTTP code returned for type QuoteTransactionBadGateway
+const QuoteTransactionBadGatewayCode int = 502
+
+/*
+QuoteTransactionBadGateway No exchange rate is available for the currency pair.
+
+swagger:response quoteTransactionBadGateway
+*/
+type QuoteTransactionBadGateway struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewQuoteTransactionBadGateway creates QuoteTransactionBadGateway with default headers values
+func NewQuoteTransactionBadGateway() *QuoteTransactionBadGateway {
+
+	return &QuoteTransactionBadGateway{}
+}
+
+// WithPayload adds the payload to the quote transaction bad gateway response
+func (o *QuoteTransactionBadGateway) WithPayload(payload *models.ErrorResponse) *QuoteTransactionBadGateway {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the quote transaction bad gateway response
+func (o *QuoteTransactionBadGateway) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QuoteTransactionBadGateway) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(502)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RefreshLoginHandlerFunc turns a function with the right signature into a refresh login handler
+type RefreshLoginHandlerFunc func(RefreshLoginParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RefreshLoginHandlerFunc) Handle(params RefreshLoginParams) middleware.Responder {
+	return fn(params)
+}
+
+// RefreshLoginHandler interface for that can handle valid refresh login params
+type RefreshLoginHandler interface {
+	Handle(RefreshLoginParams) middleware.Responder
+}
+
+// NewRefreshLogin creates a new http.Handler for the refresh login operation
+func NewRefreshLogin(ctx *middleware.Context, handler RefreshLoginHandler) *RefreshLogin {
+	return &RefreshLogin{Context: ctx, Handler: handler}
+}
+
+/*
+	RefreshLogin swagger:route POST /transaction/login/refresh transaction refreshLogin
+
+The method is used to renew an expired auth token.
+*/
+type RefreshLogin struct {
+	Context *middleware.Context
+	Handler RefreshLoginHandler
+}
+
+func (o *RefreshLogin) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRefreshLoginParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// RefreshLoginOKCode is the HTTP code returned for type RefreshLoginOK
+const RefreshLoginOKCode int = 200
+
+/*
+RefreshLoginOK Auth token successfully renewed.
+
+swagger:response refreshLoginOK
+*/
+type RefreshLoginOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.LoginResponse `json:"body,omitempty"`
+}
+
+// NewRefreshLoginOK creates RefreshLoginOK with default headers values
+func NewRefreshLoginOK() *RefreshLoginOK {
+
+	return &RefreshLoginOK{}
+}
+
+// WithPayload adds the payload to the refresh login o k response
+func (o *RefreshLoginOK) WithPayload(payload *models.LoginResponse) *RefreshLoginOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the refresh login o k response
+func (o *RefreshLoginOK) SetPayload(payload *models.LoginResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RefreshLoginOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RefreshLoginBadRequestCode is the HTTP code returned for type RefreshLoginBadRequest
+const RefreshLoginBadRequestCode int = 400
+
+/*
+RefreshLoginBadRequest Validation error.
+
+swagger:response refreshLoginBadRequest
+*/
+type RefreshLoginBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRefreshLoginBadRequest creates RefreshLoginBadRequest with default headers values
+func NewRefreshLoginBadRequest() *RefreshLoginBadRequest {
+
+	return &RefreshLoginBadRequest{}
+}
+
+// WithPayload adds the payload to the refresh login bad request response
+func (o *RefreshLoginBadRequest) WithPayload(payload *models.ErrorResponse) *RefreshLoginBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the refresh login bad request response
+func (o *RefreshLoginBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RefreshLoginBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RefreshLoginUnauthorizedCode is the HTTP code returned for type RefreshLoginUnauthorized
+const RefreshLoginUnauthorizedCode int = 401
+
+/*
+RefreshLoginUnauthorized Refresh token is invalid or expired.
+
+swagger:response refreshLoginUnauthorized
+*/
+type RefreshLoginUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRefreshLoginUnauthorized creates RefreshLoginUnauthorized with default headers values
+func NewRefreshLoginUnauthorized() *RefreshLoginUnauthorized {
+
+	return &RefreshLoginUnauthorized{}
+}
+
+// WithPayload adds the payload to the refresh login unauthorized response
+func (o *RefreshLoginUnauthorized) WithPayload(payload *models.ErrorResponse) *RefreshLoginUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the refresh login unauthorized response
+func (o *RefreshLoginUnauthorized) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RefreshLoginUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// RefreshLoginInternalServerErrorCode is the HTTP code returned for type RefreshLoginInternalServerError
+const RefreshLoginInternalServerErrorCode int = 500
+
+/*
+RefreshLoginInternalServerError Internal server error.
+
+swagger:response refreshLoginInternalServerError
+*/
+type RefreshLoginInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewRefreshLoginInternalServerError creates RefreshLoginInternalServerError with default headers values
+func NewRefreshLoginInternalServerError() *RefreshLoginInternalServerError {
+
+	return &RefreshLoginInternalServerError{}
+}
+
+// WithPayload adds the payload to the refresh login internal server error response
+func (o *RefreshLoginInternalServerError) WithPayload(payload *models.ErrorResponse) *RefreshLoginInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the refresh login internal server error response
+func (o *RefreshLoginInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RefreshLoginInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
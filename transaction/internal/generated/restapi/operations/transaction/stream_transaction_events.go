@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// StreamTransactionEventsHandlerFunc turns a function with the right signature into a stream transaction events handler
+type StreamTransactionEventsHandlerFunc func(StreamTransactionEventsParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn StreamTransactionEventsHandlerFunc) Handle(params StreamTransactionEventsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// StreamTransactionEventsHandler interface for that can handle valid stream transaction events params
+type StreamTransactionEventsHandler interface {
+	Handle(StreamTransactionEventsParams, interface{}) middleware.Responder
+}
+
+// NewStreamTransactionEvents creates a new http.Handler for the stream transaction events operation
+func NewStreamTransactionEvents(ctx *middleware.Context, handler StreamTransactionEventsHandler) *StreamTransactionEvents {
+	return &StreamTransactionEvents{Context: ctx, Handler: handler}
+}
+
+/*
+	StreamTransactionEvents swagger:route GET /transaction/{id}/events transaction streamTransactionEvents
+
+The method is used to stream status changes of the transaction as server-sent events. The current status is sent first unless the stream is resumed with Last-Event-ID.
+*/
+type StreamTransactionEvents struct {
+	Context *middleware.Context
+	Handler StreamTransactionEventsHandler
+}
+
+func (o *StreamTransactionEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewStreamTransactionEventsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,238 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// StreamTransactionEventsOKCode is the HTTP code returned for type StreamTransactionEventsOK
+const StreamTransactionEventsOKCode int = 200
+
+/*
+StreamTransactionEventsOK Stream of `status` events carrying TransactionStatusEvent, comment lines are sent as heartbeat.
+
+swagger:response streamTransactionEventsOK
+*/
+type StreamTransactionEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewStreamTransactionEventsOK creates StreamTransactionEventsOK with default headers values
+func NewStreamTransactionEventsOK() *StreamTransactionEventsOK {
+
+	return &StreamTransactionEventsOK{}
+}
+
+// WithPayload adds the payload to the stream transaction events o k response
+func (o *StreamTransactionEventsOK) WithPayload(payload io.ReadCloser) *StreamTransactionEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream transaction events o k response
+func (o *StreamTransactionEventsOK) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamTransactionEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// StreamTransactionEventsBadRequestCode is the HTTP code returned for type StreamTransactionEventsBadRequest
+const StreamTransactionEventsBadRequestCode int = 400
+
+/*
+StreamTransactionEventsBadRequest Malformed Last-Event-ID.
+
+swagger:response streamTransactionEventsBadRequest
+*/
+type StreamTransactionEventsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewStreamTransactionEventsBadRequest creates StreamTransactionEventsBadRequest with default headers values
+func NewStreamTransactionEventsBadRequest() *StreamTransactionEventsBadRequest {
+
+	return &StreamTransactionEventsBadRequest{}
+}
+
+// WithPayload adds the payload to the stream transaction events bad request response
+func (o *StreamTransactionEventsBadRequest) WithPayload(payload *models.ErrorResponse) *StreamTransactionEventsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream transaction events bad request response
+func (o *StreamTransactionEventsBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamTransactionEventsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamTransactionEventsForbiddenCode is the HTTP code returned for type StreamTransactionEventsForbidden
+const StreamTransactionEventsForbiddenCode int = 403
+
+/*
+StreamTransactionEventsForbidden Forbidden error.
+
+swagger:response streamTransactionEventsForbidden
+*/
+type StreamTransactionEventsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewStreamTransactionEventsForbidden creates StreamTransactionEventsForbidden with default headers values
+func NewStreamTransactionEventsForbidden() *StreamTransactionEventsForbidden {
+
+	return &StreamTransactionEventsForbidden{}
+}
+
+// WithPayload adds the payload to the stream transaction events forbidden response
+func (o *StreamTransactionEventsForbidden) WithPayload(payload *models.ErrorResponse) *StreamTransactionEventsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream transaction events forbidden response
+func (o *StreamTransactionEventsForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamTransactionEventsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamTransactionEventsNotFoundCode is the HTTP code returned for type StreamTransactionEventsNotFound
+const StreamTransactionEventsNotFoundCode int = 404
+
+/*
+StreamTransactionEventsNotFound Not found error.
+
+swagger:response streamTransactionEventsNotFound
+*/
+type StreamTransactionEventsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewStreamTransactionEventsNotFound creates StreamTransactionEventsNotFound with default headers values
+func NewStreamTransactionEventsNotFound() *StreamTransactionEventsNotFound {
+
+	return &StreamTransactionEventsNotFound{}
+}
+
+// WithPayload adds the payload to the stream transaction events not found response
+func (o *StreamTransactionEventsNotFound) WithPayload(payload *models.ErrorResponse) *StreamTransactionEventsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream transaction events not found response
+func (o *StreamTransactionEventsNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamTransactionEventsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// StreamTransactionEventsInternalServerErrorCode is the HTTP code returned for type StreamTransactionEventsInternalServerError
+const StreamTransactionEventsInternalServerErrorCode int = 500
+
+/*
+StreamTransactionEventsInternalServerError Internal server error.
+
+swagger:response streamTransactionEventsInternalServerError
+*/
+type StreamTransactionEventsInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewStreamTransactionEventsInternalServerError creates StreamTransactionEventsInternalServerError with default headers values
+func NewStreamTransactionEventsInternalServerError() *StreamTransactionEventsInternalServerError {
+
+	return &StreamTransactionEventsInternalServerError{}
+}
+
+// WithPayload adds the payload to the stream transaction events internal server error response
+func (o *StreamTransactionEventsInternalServerError) WithPayload(payload *models.ErrorResponse) *StreamTransactionEventsInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the stream transaction events internal server error response
+func (o *StreamTransactionEventsInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *StreamTransactionEventsInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
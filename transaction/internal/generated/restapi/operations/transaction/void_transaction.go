@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// VoidTransactionHandlerFunc turns a function with the right signature into a void transaction handler
+type VoidTransactionHandlerFunc func(VoidTransactionParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn VoidTransactionHandlerFunc) Handle(params VoidTransactionParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// VoidTransactionHandler interface for that can handle valid void transaction params
+type VoidTransactionHandler interface {
+	Handle(VoidTransactionParams, interface{}) middleware.Responder
+}
+
+// NewVoidTransaction creates a new http.Handler for the void transaction operation
+func NewVoidTransaction(ctx *middleware.Context, handler VoidTransactionHandler) *VoidTransaction {
+	return &VoidTransaction{Context: ctx, Handler: handler}
+}
+
+/*
+	VoidTransaction swagger:route POST /transaction/{id}/void transaction voidTransaction
+
+The method is used to release the hold of an authorized transaction back to the payer.
+*/
+type VoidTransaction struct {
+	Context *middleware.Context
+	Handler VoidTransactionHandler
+}
+
+func (o *VoidTransaction) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewVoidTransactionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package transaction
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// VoidTransactionOKCode is the HTTP code returned for type VoidTransactionOK
+const VoidTransactionOKCode int = 200
+
+/*
+VoidTransactionOK Void requested, the transaction is voided once the payment gateway releases the hold.
+
+swagger:response voidTransactionOK
+*/
+type VoidTransactionOK struct {
+}
+
+// NewVoidTransactionOK creates VoidTransactionOK with default headers values
+func NewVoidTransactionOK() *VoidTransactionOK {
+
+	return &VoidTransactionOK{}
+}
+
+// WriteResponse to the client
+func (o *VoidTransactionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// VoidTransactionForbiddenCode is the HTTP code returned for type VoidTransactionForbidden
+const VoidTransactionForbiddenCode int = 403
+
+/*
+VoidTransactionForbidden Forbidden error.
+
+swagger:response voidTransactionForbidden
+*/
+type VoidTransactionForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewVoidTransactionForbidden creates VoidTransactionForbidden with default headers values
+func NewVoidTransactionForbidden() *VoidTransactionForbidden {
+
+	return &VoidTransactionForbidden{}
+}
+
+// WithPayload adds the payload to the void transaction forbidden response
+func (o *VoidTransactionForbidden) WithPayload(payload *models.ErrorResponse) *VoidTransactionForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the void transaction forbidden response
+func (o *VoidTransactionForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VoidTransactionForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VoidTransactionNotFoundCode is the HTTP code returned for type VoidTransactionNotFound
+const VoidTransactionNotFoundCode int = 404
+
+/*
+VoidTransactionNotFound Not found error.
+
+swagger:response voidTransactionNotFound
+*/
+type VoidTransactionNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewVoidTransactionNotFound creates VoidTransactionNotFound with default headers values
+func NewVoidTransactionNotFound() *VoidTransactionNotFound {
+
+	return &VoidTransactionNotFound{}
+}
+
+// WithPayload adds the payload to the void transaction not found response
+func (o *VoidTransactionNotFound) WithPayload(payload *models.ErrorResponse) *VoidTransactionNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the void transaction not found response
+func (o *VoidTransactionNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VoidTransactionNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VoidTransactionConflictCode is the HTTP code returned for type VoidTransactionConflict
+const VoidTransactionConflictCode int = 409
+
+/*
+VoidTransactionConflict The transaction is not authorized.
+
+swagger:response voidTransactionConflict
+*/
+type VoidTransactionConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewVoidTransactionConflict creates VoidTransactionConflict with default headers values
+func NewVoidTransactionConflict() *VoidTransactionConflict {
+
+	return &VoidTransactionConflict{}
+}
+
+// WithPayload adds the payload to the void transaction conflict response
+func (o *VoidTransactionConflict) WithPayload(payload *models.ErrorResponse) *VoidTransactionConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the void transaction conflict response
+func (o *VoidTransactionConflict) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VoidTransactionConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// VoidTransactionInternalServerErrorCode is the HTTP code returned for type VoidTransactionInternalServerError
+const VoidTransactionInternalServerErrorCode int = 500
+
+/*
+VoidTransactionInternalServerError Internal server error.
+
+swagger:response voidTransactionInternalServerError
+*/
+type VoidTransactionInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewVoidTransactionInternalServerError creates VoidTransactionInternalServerError with default headers values
+func NewVoidTransactionInternalServerError() *VoidTransactionInternalServerError {
+
+	return &VoidTransactionInternalServerError{}
+}
+
+// WithPayload adds the payload to the void transaction internal server error response
+func (o *VoidTransactionInternalServerError) WithPayload(payload *models.ErrorResponse) *VoidTransactionInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the void transaction internal server error response
+func (o *VoidTransactionInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *VoidTransactionInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
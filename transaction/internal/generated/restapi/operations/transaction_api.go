@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,7 +20,14 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/admin"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/mandate"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/payment_point"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/report"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/transaction"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/restapi/operations/webhook"
 )
 
 // NewTransactionAPI creates a new Transaction instance
@@ -42,33 +50,125 @@ func NewTransactionAPI(spec *loads.Document) *TransactionAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer: runtime.ByteStreamProducer(),
+		CsvProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("csv producer has not yet been implemented")
+		}),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
+		TxtProducer: runtime.TextProducer(),
+		XMLProducer: runtime.XMLProducer(),
 
 		TransactionAcceptTransactionHandler: transaction.AcceptTransactionHandlerFunc(func(params transaction.AcceptTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.AcceptTransaction has not yet been implemented")
 		}),
+		MandateCancelMandateHandler: mandate.CancelMandateHandlerFunc(func(params mandate.CancelMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.CancelMandate has not yet been implemented")
+		}),
 		TransactionCancelTransactionHandler: transaction.CancelTransactionHandlerFunc(func(params transaction.CancelTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.CancelTransaction has not yet been implemented")
 		}),
+		TransactionCaptureTransactionHandler: transaction.CaptureTransactionHandlerFunc(func(params transaction.CaptureTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.CaptureTransaction has not yet been implemented")
+		}),
+		TransactionConfirmTransactionHandler: transaction.ConfirmTransactionHandlerFunc(func(params transaction.ConfirmTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.ConfirmTransaction has not yet been implemented")
+		}),
+		MandateCreateMandateHandler: mandate.CreateMandateHandlerFunc(func(params mandate.CreateMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.CreateMandate has not yet been implemented")
+		}),
+		PaymentPointCreatePaymentPointHandler: payment_point.CreatePaymentPointHandlerFunc(func(params payment_point.CreatePaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.CreatePaymentPoint has not yet been implemented")
+		}),
 		TransactionCreateTransactionHandler: transaction.CreateTransactionHandlerFunc(func(params transaction.CreateTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.CreateTransaction has not yet been implemented")
 		}),
+		WebhookCreateWebhookHandler: webhook.CreateWebhookHandlerFunc(func(params webhook.CreateWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.CreateWebhook has not yet been implemented")
+		}),
+		PaymentPointDisablePaymentPointHandler: payment_point.DisablePaymentPointHandlerFunc(func(params payment_point.DisablePaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.DisablePaymentPoint has not yet been implemented")
+		}),
 		TransactionEditTransactionHandler: transaction.EditTransactionHandlerFunc(func(params transaction.EditTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.EditTransaction has not yet been implemented")
 		}),
+		FeeGetFeePlansHandler: fee.GetFeePlansHandlerFunc(func(params fee.GetFeePlansParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fee.GetFeePlans has not yet been implemented")
+		}),
+		LedgerGetLedgerBalanceHandler: ledger.GetLedgerBalanceHandlerFunc(func(params ledger.GetLedgerBalanceParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ledger.GetLedgerBalance has not yet been implemented")
+		}),
+		LedgerGetLedgerStatementHandler: ledger.GetLedgerStatementHandlerFunc(func(params ledger.GetLedgerStatementParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation ledger.GetLedgerStatement has not yet been implemented")
+		}),
+		PaymentPointGetPaymentPointQRHandler: payment_point.GetPaymentPointQRHandlerFunc(func(params payment_point.GetPaymentPointQRParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.GetPaymentPointQR has not yet been implemented")
+		}),
+		ReportGetSettlementReportHandler: report.GetSettlementReportHandlerFunc(func(params report.GetSettlementReportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation report.GetSettlementReport has not yet been implemented")
+		}),
+		TransactionGetTransactionFeeHandler: transaction.GetTransactionFeeHandlerFunc(func(params transaction.GetTransactionFeeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.GetTransactionFee has not yet been implemented")
+		}),
+		TransactionGetTransactionQRHandler: transaction.GetTransactionQRHandlerFunc(func(params transaction.GetTransactionQRParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.GetTransactionQR has not yet been implemented")
+		}),
+		WebhookListWebhookDeliveriesHandler: webhook.ListWebhookDeliveriesHandlerFunc(func(params webhook.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.ListWebhookDeliveries has not yet been implemented")
+		}),
 		TransactionLoginHandler: transaction.LoginHandlerFunc(func(params transaction.LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.Login has not yet been implemented")
 		}),
+		TransactionLoginTwoFactorHandler: transaction.LoginTwoFactorHandlerFunc(func(params transaction.LoginTwoFactorParams) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.LoginTwoFactor has not yet been implemented")
+		}),
+		MandatePauseMandateHandler: mandate.PauseMandateHandlerFunc(func(params mandate.PauseMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.PauseMandate has not yet been implemented")
+		}),
+		PaymentPointPayPaymentPointHandler: payment_point.PayPaymentPointHandlerFunc(func(params payment_point.PayPaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.PayPaymentPoint has not yet been implemented")
+		}),
+		TransactionQuoteTransactionHandler: transaction.QuoteTransactionHandlerFunc(func(params transaction.QuoteTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.QuoteTransaction has not yet been implemented")
+		}),
+		TransactionRefreshLoginHandler: transaction.RefreshLoginHandlerFunc(func(params transaction.RefreshLoginParams) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.RefreshLogin has not yet been implemented")
+		}),
+		WebhookResendWebhookDeliveryHandler: webhook.ResendWebhookDeliveryHandlerFunc(func(params webhook.ResendWebhookDeliveryParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation webhook.ResendWebhookDelivery has not yet been implemented")
+		}),
+		MandateResumeMandateHandler: mandate.ResumeMandateHandlerFunc(func(params mandate.ResumeMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.ResumeMandate has not yet been implemented")
+		}),
+		MandateRetrieveMandateHandler: mandate.RetrieveMandateHandlerFunc(func(params mandate.RetrieveMandateParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation mandate.RetrieveMandate has not yet been implemented")
+		}),
+		PaymentPointRetrievePaymentPointHandler: payment_point.RetrievePaymentPointHandlerFunc(func(params payment_point.RetrievePaymentPointParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation payment_point.RetrievePaymentPoint has not yet been implemented")
+		}),
 		TransactionRetrieveTransactionHandler: transaction.RetrieveTransactionHandlerFunc(func(params transaction.RetrieveTransactionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.RetrieveTransaction has not yet been implemented")
 		}),
 		TransactionRetrieveTransactionStatusHandler: transaction.RetrieveTransactionStatusHandlerFunc(func(params transaction.RetrieveTransactionStatusParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation transaction.RetrieveTransactionStatus has not yet been implemented")
 		}),
+		FeeSetFeePlanHandler: fee.SetFeePlanHandlerFunc(func(params fee.SetFeePlanParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation fee.SetFeePlan has not yet been implemented")
+		}),
+		TransactionStreamTransactionEventsHandler: transaction.StreamTransactionEventsHandlerFunc(func(params transaction.StreamTransactionEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.StreamTransactionEvents has not yet been implemented")
+		}),
+		AdminUnlockLoginHandler: admin.UnlockLoginHandlerFunc(func(params admin.UnlockLoginParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation admin.UnlockLogin has not yet been implemented")
+		}),
+		TransactionVoidTransactionHandler: transaction.VoidTransactionHandlerFunc(func(params transaction.VoidTransactionParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation transaction.VoidTransaction has not yet been implemented")
+		}),
 
-		// Applies when the "Authorization" header is set
-		BearerAuth: func(token string) (interface{}, error) {
-			return nil, errors.NotImplemented("api key auth (Bearer) Authorization from header param [Authorization] has not yet been implemented")
+		BearerAuth: func(token string, scopes []string) (interface{}, error) {
+			return nil, errors.NotImplemented("oauth2 bearer auth (Bearer) has not yet been implemented")
 		},
 		// default authorizer is authorized meaning no requests are blocked
 		APIAuthorizer: security.Authorized(),
@@ -104,31 +204,103 @@ type TransactionAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - image/png
+	//   - image/svg+xml
+	BinProducer runtime.Producer
+	// CsvProducer registers a producer for the following mime types:
+	//   - text/csv
+	CsvProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
-
-	// BearerAuth registers a function that takes a token and returns a principal
-	// it performs authentication based on an api key Authorization provided in the header
-	BearerAuth func(string) (interface{}, error)
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
+	// TxtProducer registers a producer for the following mime types:
+	//   - text/plain
+	TxtProducer runtime.Producer
+	// XMLProducer registers a producer for the following mime types:
+	//   - application/xml
+	XMLProducer runtime.Producer
+
+	// BearerAuth registers a function that takes an access token and a collection of required scopes and returns a principal
+	// it performs authentication based on an oauth2 bearer token provided in the request
+	BearerAuth func(string, []string) (interface{}, error)
 
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
 	// TransactionAcceptTransactionHandler sets the operation handler for the accept transaction operation
 	TransactionAcceptTransactionHandler transaction.AcceptTransactionHandler
+	// MandateCancelMandateHandler sets the operation handler for the cancel mandate operation
+	MandateCancelMandateHandler mandate.CancelMandateHandler
 	// TransactionCancelTransactionHandler sets the operation handler for the cancel transaction operation
 	TransactionCancelTransactionHandler transaction.CancelTransactionHandler
+	// TransactionCaptureTransactionHandler sets the operation handler for the capture transaction operation
+	TransactionCaptureTransactionHandler transaction.CaptureTransactionHandler
+	// TransactionConfirmTransactionHandler sets the operation handler for the confirm transaction operation
+	TransactionConfirmTransactionHandler transaction.ConfirmTransactionHandler
+	// MandateCreateMandateHandler sets the operation handler for the create mandate operation
+	MandateCreateMandateHandler mandate.CreateMandateHandler
+	// PaymentPointCreatePaymentPointHandler sets the operation handler for the create payment point operation
+	PaymentPointCreatePaymentPointHandler payment_point.CreatePaymentPointHandler
 	// TransactionCreateTransactionHandler sets the operation handler for the create transaction operation
 	TransactionCreateTransactionHandler transaction.CreateTransactionHandler
+	// WebhookCreateWebhookHandler sets the operation handler for the create webhook operation
+	WebhookCreateWebhookHandler webhook.CreateWebhookHandler
+	// PaymentPointDisablePaymentPointHandler sets the operation handler for the disable payment point operation
+	PaymentPointDisablePaymentPointHandler payment_point.DisablePaymentPointHandler
 	// TransactionEditTransactionHandler sets the operation handler for the edit transaction operation
 	TransactionEditTransactionHandler transaction.EditTransactionHandler
+	// FeeGetFeePlansHandler sets the operation handler for the get fee plans operation
+	FeeGetFeePlansHandler fee.GetFeePlansHandler
+	// LedgerGetLedgerBalanceHandler sets the operation handler for the get ledger balance operation
+	LedgerGetLedgerBalanceHandler ledger.GetLedgerBalanceHandler
+	// LedgerGetLedgerStatementHandler sets the operation handler for the get ledger statement operation
+	LedgerGetLedgerStatementHandler ledger.GetLedgerStatementHandler
+	// PaymentPointGetPaymentPointQRHandler sets the operation handler for the get payment point q r operation
+	PaymentPointGetPaymentPointQRHandler payment_point.GetPaymentPointQRHandler
+	// ReportGetSettlementReportHandler sets the operation handler for the get settlement report operation
+	ReportGetSettlementReportHandler report.GetSettlementReportHandler
+	// TransactionGetTransactionFeeHandler sets the operation handler for the get transaction fee operation
+	TransactionGetTransactionFeeHandler transaction.GetTransactionFeeHandler
+	// TransactionGetTransactionQRHandler sets the operation handler for the get transaction q r operation
+	TransactionGetTransactionQRHandler transaction.GetTransactionQRHandler
+	// WebhookListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	WebhookListWebhookDeliveriesHandler webhook.ListWebhookDeliveriesHandler
 	// TransactionLoginHandler sets the operation handler for the login operation
 	TransactionLoginHandler transaction.LoginHandler
+	// TransactionLoginTwoFactorHandler sets the operation handler for the login two factor operation
+	TransactionLoginTwoFactorHandler transaction.LoginTwoFactorHandler
+	// MandatePauseMandateHandler sets the operation handler for the pause mandate operation
+	MandatePauseMandateHandler mandate.PauseMandateHandler
+	// PaymentPointPayPaymentPointHandler sets the operation handler for the pay payment point operation
+	PaymentPointPayPaymentPointHandler payment_point.PayPaymentPointHandler
+	// TransactionQuoteTransactionHandler sets the operation handler for the quote transaction operation
+	TransactionQuoteTransactionHandler transaction.QuoteTransactionHandler
+	// TransactionRefreshLoginHandler sets the operation handler for the refresh login operation
+	TransactionRefreshLoginHandler transaction.RefreshLoginHandler
+	// WebhookResendWebhookDeliveryHandler sets the operation handler for the resend webhook delivery operation
+	WebhookResendWebhookDeliveryHandler webhook.ResendWebhookDeliveryHandler
+	// MandateResumeMandateHandler sets the operation handler for the resume mandate operation
+	MandateResumeMandateHandler mandate.ResumeMandateHandler
+	// MandateRetrieveMandateHandler sets the operation handler for the retrieve mandate operation
+	MandateRetrieveMandateHandler mandate.RetrieveMandateHandler
+	// PaymentPointRetrievePaymentPointHandler sets the operation handler for the retrieve payment point operation
+	PaymentPointRetrievePaymentPointHandler payment_point.RetrievePaymentPointHandler
 	// TransactionRetrieveTransactionHandler sets the operation handler for the retrieve transaction operation
 	TransactionRetrieveTransactionHandler transaction.RetrieveTransactionHandler
 	// TransactionRetrieveTransactionStatusHandler sets the operation handler for the retrieve transaction status operation
 	TransactionRetrieveTransactionStatusHandler transaction.RetrieveTransactionStatusHandler
+	// FeeSetFeePlanHandler sets the operation handler for the set fee plan operation
+	FeeSetFeePlanHandler fee.SetFeePlanHandler
+	// TransactionStreamTransactionEventsHandler sets the operation handler for the stream transaction events operation
+	TransactionStreamTransactionEventsHandler transaction.StreamTransactionEventsHandler
+	// AdminUnlockLoginHandler sets the operation handler for the unlock login operation
+	AdminUnlockLoginHandler admin.UnlockLoginHandler
+	// TransactionVoidTransactionHandler sets the operation handler for the void transaction operation
+	TransactionVoidTransactionHandler transaction.VoidTransactionHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -202,35 +374,134 @@ func (o *TransactionAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
+	if o.CsvProducer == nil {
+		unregistered = append(unregistered, "CsvProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
+	if o.TxtProducer == nil {
+		unregistered = append(unregistered, "TxtProducer")
+	}
+	if o.XMLProducer == nil {
+		unregistered = append(unregistered, "XMLProducer")
+	}
 
 	if o.BearerAuth == nil {
-		unregistered = append(unregistered, "AuthorizationAuth")
+		unregistered = append(unregistered, "BearerAuth")
 	}
 
 	if o.TransactionAcceptTransactionHandler == nil {
 		unregistered = append(unregistered, "transaction.AcceptTransactionHandler")
 	}
+	if o.MandateCancelMandateHandler == nil {
+		unregistered = append(unregistered, "mandate.CancelMandateHandler")
+	}
 	if o.TransactionCancelTransactionHandler == nil {
 		unregistered = append(unregistered, "transaction.CancelTransactionHandler")
 	}
+	if o.TransactionCaptureTransactionHandler == nil {
+		unregistered = append(unregistered, "transaction.CaptureTransactionHandler")
+	}
+	if o.TransactionConfirmTransactionHandler == nil {
+		unregistered = append(unregistered, "transaction.ConfirmTransactionHandler")
+	}
+	if o.MandateCreateMandateHandler == nil {
+		unregistered = append(unregistered, "mandate.CreateMandateHandler")
+	}
+	if o.PaymentPointCreatePaymentPointHandler == nil {
+		unregistered = append(unregistered, "payment_point.CreatePaymentPointHandler")
+	}
 	if o.TransactionCreateTransactionHandler == nil {
 		unregistered = append(unregistered, "transaction.CreateTransactionHandler")
 	}
+	if o.WebhookCreateWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.CreateWebhookHandler")
+	}
+	if o.PaymentPointDisablePaymentPointHandler == nil {
+		unregistered = append(unregistered, "payment_point.DisablePaymentPointHandler")
+	}
 	if o.TransactionEditTransactionHandler == nil {
 		unregistered = append(unregistered, "transaction.EditTransactionHandler")
 	}
+	if o.FeeGetFeePlansHandler == nil {
+		unregistered = append(unregistered, "fee.GetFeePlansHandler")
+	}
+	if o.LedgerGetLedgerBalanceHandler == nil {
+		unregistered = append(unregistered, "ledger.GetLedgerBalanceHandler")
+	}
+	if o.LedgerGetLedgerStatementHandler == nil {
+		unregistered = append(unregistered, "ledger.GetLedgerStatementHandler")
+	}
+	if o.PaymentPointGetPaymentPointQRHandler == nil {
+		unregistered = append(unregistered, "payment_point.GetPaymentPointQRHandler")
+	}
+	if o.ReportGetSettlementReportHandler == nil {
+		unregistered = append(unregistered, "report.GetSettlementReportHandler")
+	}
+	if o.TransactionGetTransactionFeeHandler == nil {
+		unregistered = append(unregistered, "transaction.GetTransactionFeeHandler")
+	}
+	if o.TransactionGetTransactionQRHandler == nil {
+		unregistered = append(unregistered, "transaction.GetTransactionQRHandler")
+	}
+	if o.WebhookListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhook.ListWebhookDeliveriesHandler")
+	}
 	if o.TransactionLoginHandler == nil {
 		unregistered = append(unregistered, "transaction.LoginHandler")
 	}
+	if o.TransactionLoginTwoFactorHandler == nil {
+		unregistered = append(unregistered, "transaction.LoginTwoFactorHandler")
+	}
+	if o.MandatePauseMandateHandler == nil {
+		unregistered = append(unregistered, "mandate.PauseMandateHandler")
+	}
+	if o.PaymentPointPayPaymentPointHandler == nil {
+		unregistered = append(unregistered, "payment_point.PayPaymentPointHandler")
+	}
+	if o.TransactionQuoteTransactionHandler == nil {
+		unregistered = append(unregistered, "transaction.QuoteTransactionHandler")
+	}
+	if o.TransactionRefreshLoginHandler == nil {
+		unregistered = append(unregistered, "transaction.RefreshLoginHandler")
+	}
+	if o.WebhookResendWebhookDeliveryHandler == nil {
+		unregistered = append(unregistered, "webhook.ResendWebhookDeliveryHandler")
+	}
+	if o.MandateResumeMandateHandler == nil {
+		unregistered = append(unregistered, "mandate.ResumeMandateHandler")
+	}
+	if o.MandateRetrieveMandateHandler == nil {
+		unregistered = append(unregistered, "mandate.RetrieveMandateHandler")
+	}
+	if o.PaymentPointRetrievePaymentPointHandler == nil {
+		unregistered = append(unregistered, "payment_point.RetrievePaymentPointHandler")
+	}
 	if o.TransactionRetrieveTransactionHandler == nil {
 		unregistered = append(unregistered, "transaction.RetrieveTransactionHandler")
 	}
 	if o.TransactionRetrieveTransactionStatusHandler == nil {
 		unregistered = append(unregistered, "transaction.RetrieveTransactionStatusHandler")
 	}
+	if o.FeeSetFeePlanHandler == nil {
+		unregistered = append(unregistered, "fee.SetFeePlanHandler")
+	}
+	if o.TransactionStreamTransactionEventsHandler == nil {
+		unregistered = append(unregistered, "transaction.StreamTransactionEventsHandler")
+	}
+	if o.AdminUnlockLoginHandler == nil {
+		unregistered = append(unregistered, "admin.UnlockLoginHandler")
+	}
+	if o.TransactionVoidTransactionHandler == nil {
+		unregistered = append(unregistered, "transaction.VoidTransactionHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -250,8 +521,7 @@ func (o *TransactionAPI) AuthenticatorsFor(schemes map[string]spec.SecuritySchem
 	for name := range schemes {
 		switch name {
 		case "Bearer":
-			scheme := schemes[name]
-			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.BearerAuth)
+			result[name] = o.BearerAuthenticator(name, o.BearerAuth)
 
 		}
 	}
@@ -286,8 +556,20 @@ func (o *TransactionAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pr
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "image/png":
+			result["image/png"] = o.BinProducer
+		case "image/svg+xml":
+			result["image/svg+xml"] = o.BinProducer
+		case "text/csv":
+			result["text/csv"] = o.CsvProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
+		case "text/plain":
+			result["text/plain"] = o.TxtProducer
+		case "application/xml":
+			result["application/xml"] = o.XMLProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -335,19 +617,115 @@ func (o *TransactionAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/mandate/{id}/cancel"] = mandate.NewCancelMandate(o.context, o.MandateCancelMandateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/transaction/{id}/cancel"] = transaction.NewCancelTransaction(o.context, o.TransactionCancelTransactionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/transaction/{id}/capture"] = transaction.NewCaptureTransaction(o.context, o.TransactionCaptureTransactionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/transaction/{id}/confirm"] = transaction.NewConfirmTransaction(o.context, o.TransactionConfirmTransactionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/mandate/create"] = mandate.NewCreateMandate(o.context, o.MandateCreateMandateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payment-point/create"] = payment_point.NewCreatePaymentPoint(o.context, o.PaymentPointCreatePaymentPointHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/transaction/create"] = transaction.NewCreateTransaction(o.context, o.TransactionCreateTransactionHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/webhook/create"] = webhook.NewCreateWebhook(o.context, o.WebhookCreateWebhookHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payment-point/{id}/disable"] = payment_point.NewDisablePaymentPoint(o.context, o.PaymentPointDisablePaymentPointHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/transaction/{id}/edit"] = transaction.NewEditTransaction(o.context, o.TransactionEditTransactionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/fee-plan/merchant/{id}/retrieve"] = fee.NewGetFeePlans(o.context, o.FeeGetFeePlansHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/ledger/account/{id}/balance"] = ledger.NewGetLedgerBalance(o.context, o.LedgerGetLedgerBalanceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/ledger/account/{id}/statement"] = ledger.NewGetLedgerStatement(o.context, o.LedgerGetLedgerStatementHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/payment-point/{id}/qr"] = payment_point.NewGetPaymentPointQR(o.context, o.PaymentPointGetPaymentPointQRHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/report/settlement"] = report.NewGetSettlementReport(o.context, o.ReportGetSettlementReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/transaction/{id}/fee"] = transaction.NewGetTransactionFee(o.context, o.TransactionGetTransactionFeeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/transaction/{id}/qr"] = transaction.NewGetTransactionQR(o.context, o.TransactionGetTransactionQRHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhook/{id}/deliveries"] = webhook.NewListWebhookDeliveries(o.context, o.WebhookListWebhookDeliveriesHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/transaction/login"] = transaction.NewLogin(o.context, o.TransactionLoginHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/transaction/login/2fa"] = transaction.NewLoginTwoFactor(o.context, o.TransactionLoginTwoFactorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/mandate/{id}/pause"] = mandate.NewPauseMandate(o.context, o.MandatePauseMandateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/payment-point/{id}/pay"] = payment_point.NewPayPaymentPoint(o.context, o.PaymentPointPayPaymentPointHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/transaction/{id}/quote"] = transaction.NewQuoteTransaction(o.context, o.TransactionQuoteTransactionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/transaction/login/refresh"] = transaction.NewRefreshLogin(o.context, o.TransactionRefreshLoginHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhook/delivery/{id}/resend"] = webhook.NewResendWebhookDelivery(o.context, o.WebhookResendWebhookDeliveryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/mandate/{id}/resume"] = mandate.NewResumeMandate(o.context, o.MandateResumeMandateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/mandate/{id}/retrieve"] = mandate.NewRetrieveMandate(o.context, o.MandateRetrieveMandateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/payment-point/{id}/retrieve"] = payment_point.NewRetrievePaymentPoint(o.context, o.PaymentPointRetrievePaymentPointHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -356,6 +734,22 @@ func (o *TransactionAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/transaction/{id}/retrieve/status"] = transaction.NewRetrieveTransactionStatus(o.context, o.TransactionRetrieveTransactionStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/fee-plan/set"] = fee.NewSetFeePlan(o.context, o.FeeSetFeePlanHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/transaction/{id}/events"] = transaction.NewStreamTransactionEvents(o.context, o.TransactionStreamTransactionEventsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/login/unlock"] = admin.NewUnlockLogin(o.context, o.AdminUnlockLoginHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/transaction/{id}/void"] = transaction.NewVoidTransaction(o.context, o.TransactionVoidTransactionHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateWebhookHandlerFunc turns a function with the right signature into a create webhook handler
+type CreateWebhookHandlerFunc func(CreateWebhookParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateWebhookHandlerFunc) Handle(params CreateWebhookParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateWebhookHandler interface for that can handle valid create webhook params
+type CreateWebhookHandler interface {
+	Handle(CreateWebhookParams, interface{}) middleware.Responder
+}
+
+// NewCreateWebhook creates a new http.Handler for the create webhook operation
+func NewCreateWebhook(ctx *middleware.Context, handler CreateWebhookHandler) *CreateWebhook {
+	return &CreateWebhook{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateWebhook swagger:route POST /webhook/create webhook createWebhook
+
+The method is used to register a webhook notified about every status change of the merchant transactions.
+
+Deliveries are POST requests with a JSON body. The X-Webhook-Signature header carries
+"sha256=" followed by the hex HMAC-SHA256 of the X-Webhook-Timestamp header value,
+a dot and the body, keyed with the webhook secret.
+*/
+type CreateWebhook struct {
+	Context *middleware.Context
+	Handler CreateWebhookHandler
+}
+
+func (o *CreateWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateWebhookParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// CreateWebhookOKCode is the HTTP code returned for type CreateWebhookOK
+const CreateWebhookOKCode int = 200
+
+/*
+CreateWebhookOK Webhook successfully created.
+
+swagger:response createWebhookOK
+*/
+type CreateWebhookOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.CreateWebhookResponse `json:"body,omitempty"`
+}
+
+// NewCreateWebhookOK creates CreateWebhookOK with default headers values
+func NewCreateWebhookOK() *CreateWebhookOK {
+
+	return &CreateWebhookOK{}
+}
+
+// WithPayload adds the payload to the create webhook o k response
+func (o *CreateWebhookOK) WithPayload(payload *models.CreateWebhookResponse) *CreateWebhookOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook o k response
+func (o *CreateWebhookOK) SetPayload(payload *models.CreateWebhookResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateWebhookBadRequestCode is the HTTP code returned for type CreateWebhookBadRequest
+const CreateWebhookBadRequestCode int = 400
+
+/*
+CreateWebhookBadRequest Validation error.
+
+swagger:response createWebhookBadRequest
+*/
+type CreateWebhookBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateWebhookBadRequest creates CreateWebhookBadRequest with default headers values
+func NewCreateWebhookBadRequest() *CreateWebhookBadRequest {
+
+	return &CreateWebhookBadRequest{}
+}
+
+// WithPayload adds the payload to the create webhook bad request response
+func (o *CreateWebhookBadRequest) WithPayload(payload *models.ErrorResponse) *CreateWebhookBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook bad request response
+func (o *CreateWebhookBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateWebhookForbiddenCode is the HTTP code returned for type CreateWebhookForbidden
+const CreateWebhookForbiddenCode int = 403
+
+/*
+CreateWebhookForbidden Forbidden error.
+
+swagger:response createWebhookForbidden
+*/
+type CreateWebhookForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateWebhookForbidden creates CreateWebhookForbidden with default headers values
+func NewCreateWebhookForbidden() *CreateWebhookForbidden {
+
+	return &CreateWebhookForbidden{}
+}
+
+// WithPayload adds the payload to the create webhook forbidden response
+func (o *CreateWebhookForbidden) WithPayload(payload *models.ErrorResponse) *CreateWebhookForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook forbidden response
+func (o *CreateWebhookForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// CreateWebhookInternalServerErrorCode is the HTTP code returned for type CreateWebhookInternalServerError
+const CreateWebhookInternalServerErrorCode int = 500
+
+/*
+CreateWebhookInternalServerError Internal server error.
+
+swagger:response createWebhookInternalServerError
+*/
+type CreateWebhookInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewCreateWebhookInternalServerError creates CreateWebhookInternalServerError with default headers values
+func NewCreateWebhookInternalServerError() *CreateWebhookInternalServerError {
+
+	return &CreateWebhookInternalServerError{}
+}
+
+// WithPayload adds the payload to the create webhook internal server error response
+func (o *CreateWebhookInternalServerError) WithPayload(payload *models.ErrorResponse) *CreateWebhookInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook internal server error response
+func (o *CreateWebhookInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// ListWebhookDeliveriesOKCode is the HTTP code returned for type ListWebhookDeliveriesOK
+const ListWebhookDeliveriesOKCode int = 200
+
+/*
+ListWebhookDeliveriesOK Webhook deliveries successfully retrieved.
+
+swagger:response listWebhookDeliveriesOK
+*/
+type ListWebhookDeliveriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListWebhookDeliveriesResponse `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesOK creates ListWebhookDeliveriesOK with default headers values
+func NewListWebhookDeliveriesOK() *ListWebhookDeliveriesOK {
+
+	return &ListWebhookDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) WithPayload(payload *models.ListWebhookDeliveriesResponse) *ListWebhookDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) SetPayload(payload *models.ListWebhookDeliveriesResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesForbiddenCode is the HTTP code returned for type ListWebhookDeliveriesForbidden
+const ListWebhookDeliveriesForbiddenCode int = 403
+
+/*
+ListWebhookDeliveriesForbidden Forbidden error.
+
+swagger:response listWebhookDeliveriesForbidden
+*/
+type ListWebhookDeliveriesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesForbidden creates ListWebhookDeliveriesForbidden with default headers values
+func NewListWebhookDeliveriesForbidden() *ListWebhookDeliveriesForbidden {
+
+	return &ListWebhookDeliveriesForbidden{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries forbidden response
+func (o *ListWebhookDeliveriesForbidden) WithPayload(payload *models.ErrorResponse) *ListWebhookDeliveriesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries forbidden response
+func (o *ListWebhookDeliveriesForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesNotFoundCode is the HTTP code returned for type ListWebhookDeliveriesNotFound
+const ListWebhookDeliveriesNotFoundCode int = 404
+
+/*
+ListWebhookDeliveriesNotFound Not found error.
+
+swagger:response listWebhookDeliveriesNotFound
+*/
+type ListWebhookDeliveriesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesNotFound creates ListWebhookDeliveriesNotFound with default headers values
+func NewListWebhookDeliveriesNotFound() *ListWebhookDeliveriesNotFound {
+
+	return &ListWebhookDeliveriesNotFound{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries not found response
+func (o *ListWebhookDeliveriesNotFound) WithPayload(payload *models.ErrorResponse) *ListWebhookDeliveriesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries not found response
+func (o *ListWebhookDeliveriesNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListWebhookDeliveriesInternalServerErrorCode is the HTTP code returned for type ListWebhookDeliveriesInternalServerError
+const ListWebhookDeliveriesInternalServerErrorCode int = 500
+
+/*
+ListWebhookDeliveriesInternalServerError Internal server error.
+
+swagger:response listWebhookDeliveriesInternalServerError
+*/
+type ListWebhookDeliveriesInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesInternalServerError creates ListWebhookDeliveriesInternalServerError with default headers values
+func NewListWebhookDeliveriesInternalServerError() *ListWebhookDeliveriesInternalServerError {
+
+	return &ListWebhookDeliveriesInternalServerError{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries internal server error response
+func (o *ListWebhookDeliveriesInternalServerError) WithPayload(payload *models.ErrorResponse) *ListWebhookDeliveriesInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries internal server error response
+func (o *ListWebhookDeliveriesInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
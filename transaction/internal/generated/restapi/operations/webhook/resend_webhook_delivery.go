@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ResendWebhookDeliveryHandlerFunc turns a function with the right signature into a resend webhook delivery handler
+type ResendWebhookDeliveryHandlerFunc func(ResendWebhookDeliveryParams, interface{}) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResendWebhookDeliveryHandlerFunc) Handle(params ResendWebhookDeliveryParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ResendWebhookDeliveryHandler interface for that can handle valid resend webhook delivery params
+type ResendWebhookDeliveryHandler interface {
+	Handle(ResendWebhookDeliveryParams, interface{}) middleware.Responder
+}
+
+// NewResendWebhookDelivery creates a new http.Handler for the resend webhook delivery operation
+func NewResendWebhookDelivery(ctx *middleware.Context, handler ResendWebhookDeliveryHandler) *ResendWebhookDelivery {
+	return &ResendWebhookDelivery{Context: ctx, Handler: handler}
+}
+
+/*
+	ResendWebhookDelivery swagger:route POST /webhook/delivery/{id}/resend webhook resendWebhookDelivery
+
+The method is used to send the delivery again as a new delivery with fresh retries.
+*/
+type ResendWebhookDelivery struct {
+	Context *middleware.Context
+	Handler ResendWebhookDeliveryHandler
+}
+
+func (o *ResendWebhookDelivery) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewResendWebhookDeliveryParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,194 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+)
+
+// ResendWebhookDeliveryOKCode is the HTTP code returned for type ResendWebhookDeliveryOK
+const ResendWebhookDeliveryOKCode int = 200
+
+/*
+ResendWebhookDeliveryOK Delivery successfully enqueued again.
+
+swagger:response resendWebhookDeliveryOK
+*/
+type ResendWebhookDeliveryOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ResendWebhookDeliveryResponse `json:"body,omitempty"`
+}
+
+// NewResendWebhookDeliveryOK creates ResendWebhookDeliveryOK with default headers values
+func NewResendWebhookDeliveryOK() *ResendWebhookDeliveryOK {
+
+	return &ResendWebhookDeliveryOK{}
+}
+
+// WithPayload adds the payload to the resend webhook delivery o k response
+func (o *ResendWebhookDeliveryOK) WithPayload(payload *models.ResendWebhookDeliveryResponse) *ResendWebhookDeliveryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resend webhook delivery o k response
+func (o *ResendWebhookDeliveryOK) SetPayload(payload *models.ResendWebhookDeliveryResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResendWebhookDeliveryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResendWebhookDeliveryForbiddenCode is the HTTP code returned for type ResendWebhookDeliveryForbidden
+const ResendWebhookDeliveryForbiddenCode int = 403
+
+/*
+ResendWebhookDeliveryForbidden Forbidden error.
+
+swagger:response resendWebhookDeliveryForbidden
+*/
+type ResendWebhookDeliveryForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResendWebhookDeliveryForbidden creates ResendWebhookDeliveryForbidden with default headers values
+func NewResendWebhookDeliveryForbidden() *ResendWebhookDeliveryForbidden {
+
+	return &ResendWebhookDeliveryForbidden{}
+}
+
+// WithPayload adds the payload to the resend webhook delivery forbidden response
+func (o *ResendWebhookDeliveryForbidden) WithPayload(payload *models.ErrorResponse) *ResendWebhookDeliveryForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resend webhook delivery forbidden response
+func (o *ResendWebhookDeliveryForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResendWebhookDeliveryForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResendWebhookDeliveryNotFoundCode is the HTTP code returned for type ResendWebhookDeliveryNotFound
+const ResendWebhookDeliveryNotFoundCode int = 404
+
+/*
+ResendWebhookDeliveryNotFound Not found error.
+
+swagger:response resendWebhookDeliveryNotFound
+*/
+type ResendWebhookDeliveryNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResendWebhookDeliveryNotFound creates ResendWebhookDeliveryNotFound with default headers values
+func NewResendWebhookDeliveryNotFound() *ResendWebhookDeliveryNotFound {
+
+	return &ResendWebhookDeliveryNotFound{}
+}
+
+// WithPayload adds the payload to the resend webhook delivery not found response
+func (o *ResendWebhookDeliveryNotFound) WithPayload(payload *models.ErrorResponse) *ResendWebhookDeliveryNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resend webhook delivery not found response
+func (o *ResendWebhookDeliveryNotFound) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResendWebhookDeliveryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ResendWebhookDeliveryInternalServerErrorCode is the HTTP code returned for type ResendWebhookDeliveryInternalServerError
+const ResendWebhookDeliveryInternalServerErrorCode int = 500
+
+/*
+ResendWebhookDeliveryInternalServerError Internal server error.
+
+swagger:response resendWebhookDeliveryInternalServerError
+*/
+type ResendWebhookDeliveryInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewResendWebhookDeliveryInternalServerError creates ResendWebhookDeliveryInternalServerError with default headers values
+func NewResendWebhookDeliveryInternalServerError() *ResendWebhookDeliveryInternalServerError {
+
+	return &ResendWebhookDeliveryInternalServerError{}
+}
+
+// WithPayload adds the payload to the resend webhook delivery internal server error response
+func (o *ResendWebhookDeliveryInternalServerError) WithPayload(payload *models.ErrorResponse) *ResendWebhookDeliveryInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resend webhook delivery internal server error response
+func (o *ResendWebhookDeliveryInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResendWebhookDeliveryInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package group
+
+import "fmt"
+
+// SettleError represents an error encountered while closing group transactions.
+type SettleError struct {
+	msg string
+	err error
+}
+
+// NewSettleError creates a new SettleError instance with the provided message and error.
+func NewSettleError(msg string, err error) *SettleError {
+	return &SettleError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SettleError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SettleError) Unwrap() error {
+	return e.err
+}
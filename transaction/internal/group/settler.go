@@ -0,0 +1,139 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+// Settler closes group transactions that were not fully paid before their deadline or were canceled,
+// and pays the shares that were already paid back to their payers.
+type Settler interface {
+	Run(ctx context.Context)
+	Settle(ctx context.Context) (int, error)
+}
+
+type settler struct {
+	cfg                  *Config
+	transactionRepo      repository.TransactionRepo
+	transactionPublisher publisher.TransactionPublisher
+	clock                clock.Clock
+	log                  logger.Logger
+}
+
+// NewSettler creates a new instance of Settler.
+func NewSettler(
+	cfg *Config,
+	transactionRepo repository.TransactionRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	clk clock.Clock,
+	log logger.Logger,
+) (Settler, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &settler{
+		cfg:                  cfg,
+		transactionRepo:      transactionRepo,
+		transactionPublisher: transactionPublisher,
+		clock:                clk,
+		log:                  log,
+	}, nil
+}
+
+// Run closes group transactions every sweep interval until the context is canceled.
+func (s *settler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			closed, err := s.Settle(ctx)
+			if err != nil {
+				s.log.Error("Failed to close group transactions", map[string]interface{}{
+					"error":  err,
+					"closed": closed,
+				})
+
+				continue
+			}
+
+			if closed > 0 {
+				s.log.Info("Closed group transactions", map[string]interface{}{
+					"closed": closed,
+				})
+			}
+		}
+	}
+}
+
+// Settle closes group transactions in batches until a batch comes back incomplete
+// and returns how many transactions were closed.
+// A refund that fails to be published is logged and leaves its share refunding.
+func (s *settler) Settle(ctx context.Context) (int, error) {
+	closed := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return closed, NewSettleError("settle interrupted", err)
+		}
+
+		transactionIDs, refunds, err := s.transactionRepo.CloseGroupTransactions(ctx, s.clock.NowUTC(), s.cfg.BatchSize)
+		if err != nil {
+			return closed, NewSettleError("failed to close group transactions", err)
+		}
+
+		closed += len(transactionIDs)
+
+		s.log.Debug("Closed group transactions batch", map[string]interface{}{
+			"transaction_ids": transactionIDs,
+			"refunds":         len(refunds),
+		})
+
+		for _, share := range refunds {
+			if err := s.refund(ctx, share); err != nil {
+				s.log.Error("Failed to refund transaction share", map[string]interface{}{
+					"error":          err,
+					"share_id":       share.ID,
+					"transaction_id": share.TransactionID,
+				})
+			}
+		}
+
+		if uint64(len(transactionIDs)) < s.cfg.BatchSize {
+			return closed, nil
+		}
+	}
+}
+
+// refund hands the payment paying the share back to its payer over to the payment gateway.
+func (s *settler) refund(ctx context.Context, share *model.TransactionShare) error {
+	transaction, err := s.transactionRepo.GetTransaction(ctx, share.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	storedShare := transaction.Share(share.ID)
+	if storedShare == nil {
+		return repository.ErrShareNotFound
+	}
+
+	refund, err := dto.FromShareRefund(transaction, storedShare)
+	if err != nil {
+		return err
+	}
+
+	return s.transactionPublisher.PublishProcessedTransaction(refund)
+}
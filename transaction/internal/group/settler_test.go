@@ -0,0 +1,179 @@
+package group_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/group"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testBatchSize = 2
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func settlerHelper(t *testing.T, sweepInterval time.Duration) (
+	group.Settler,
+	*mock_repository.MockTransactionRepo,
+	*mock_publisher.MockTransactionPublisher,
+	*mock_clock.MockClock,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := mock_repository.NewMockTransactionRepo(mockCtrl)
+	transactionPublisher := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+	clk := mock_clock.NewMockClock(mockCtrl)
+
+	s, err := group.NewSettler(&group.Config{
+		SweepInterval: sweepInterval,
+		BatchSize:     testBatchSize,
+	}, repo, transactionPublisher, clk, l)
+	require.NoError(t, err)
+
+	return s, repo, transactionPublisher, clk
+}
+
+func TestSettle(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	testcases := []struct {
+		name           string
+		batches        [][]string
+		batchErr       error
+		expectedClosed int
+		expectedErr    error
+	}{
+		{
+			name:           "Nothing to close",
+			batches:        [][]string{{}},
+			expectedClosed: 0,
+		},
+		{
+			name:           "Settle until a batch is incomplete",
+			batches:        [][]string{{"first", "second"}, {"third"}},
+			expectedClosed: 3,
+		},
+		{
+			name:           "Failed batch",
+			batches:        [][]string{{"first", "second"}},
+			batchErr:       testErr,
+			expectedClosed: 2,
+			expectedErr:    testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, repo, _, clk := settlerHelper(t, time.Minute)
+
+			calls := make([]any, 0, len(testcase.batches)+1)
+
+			for i, batch := range testcase.batches {
+				now := testNow.Add(time.Duration(i) * time.Second)
+
+				calls = append(calls,
+					clk.EXPECT().NowUTC().Return(now),
+					repo.EXPECT().CloseGroupTransactions(gomock.Any(), now, uint64(testBatchSize)).Return(batch, nil, nil),
+				)
+			}
+
+			if testcase.batchErr != nil {
+				calls = append(calls,
+					clk.EXPECT().NowUTC().Return(testNow),
+					repo.EXPECT().CloseGroupTransactions(gomock.Any(), testNow, uint64(testBatchSize)).Return(nil, nil, testcase.batchErr),
+				)
+			}
+
+			gomock.InOrder(calls...)
+
+			closed, err := s.Settle(context.Background())
+
+			assert.Equal(t, testcase.expectedClosed, closed)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestSettleRefunds(t *testing.T) {
+	t.Parallel()
+
+	s, repo, transactionPublisher, clk := settlerHelper(t, time.Minute)
+
+	paidShare := &model.TransactionShare{
+		ID:            "paid-share",
+		TransactionID: "group",
+		Amount:        400,
+		Status:        model.ShareRefunding,
+		RefundID:      "paid-share-refund",
+		Payer: &model.TransactionUser{
+			UserID:   "payer-user",
+			WalletID: "payer-wallet",
+		},
+	}
+	missingShare := &model.TransactionShare{
+		ID:            "missing-share",
+		TransactionID: "group",
+	}
+	transaction := &model.Transaction{
+		ID:       "group",
+		Currency: "ALGO",
+		Amount:   1000,
+		Method:   "algorand",
+		Receiver: &model.TransactionUser{
+			UserID:   "receiver-user",
+			WalletID: "receiver-wallet",
+		},
+		Shares: []*model.TransactionShare{paidShare},
+	}
+
+	refund, err := dto.FromShareRefund(transaction, paidShare)
+	require.NoError(t, err)
+
+	clk.EXPECT().NowUTC().Return(testNow)
+	repo.EXPECT().CloseGroupTransactions(gomock.Any(), testNow, uint64(testBatchSize)).
+		Return([]string{"group"}, []*model.TransactionShare{missingShare, paidShare}, nil)
+	repo.EXPECT().GetTransaction(gomock.Any(), "group").Return(transaction, nil).Times(2)
+	transactionPublisher.EXPECT().PublishProcessedTransaction(refund).Return(nil)
+
+	closed, err := s.Settle(context.Background())
+
+	assert.Equal(t, 1, closed)
+	assert.NoError(t, err)
+}
+
+func TestSettleCanceled(t *testing.T) {
+	t.Parallel()
+
+	s, _, _, _ := settlerHelper(t, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	closed, err := s.Settle(ctx)
+
+	assert.Zero(t, closed)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,49 @@
+package hold
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultPollInterval  = time.Minute
+	defaultBatchSize     = 100
+	defaultRetryInterval = 10 * time.Minute
+)
+
+// Config represents the expired hold voider configuration structure.
+type Config struct {
+	// PollInterval is how often holds that timed out are looked for.
+	PollInterval time.Duration
+	// BatchSize limits how many holds are claimed by a single statement.
+	BatchSize uint64
+	// RetryInterval is how long a requested void may stay unanswered by the payment gateway before it is requested again.
+	RetryInterval time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		PollInterval:  defaultPollInterval,
+		BatchSize:     defaultBatchSize,
+		RetryInterval: defaultRetryInterval,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
@@ -0,0 +1,25 @@
+package hold
+
+import "fmt"
+
+// VoidError represents an error encountered while voiding expired holds.
+type VoidError struct {
+	msg string
+	err error
+}
+
+// NewVoidError creates a new VoidError instance with the provided message and error.
+func NewVoidError(msg string, err error) *VoidError {
+	return &VoidError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e VoidError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e VoidError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,133 @@
+package hold
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+// Voider releases the holds of authorized transactions that were not captured before they timed out.
+// Expired holds are claimed with row locks skipped, so every replica can run a voider: a hold is claimed by one of them.
+// A transaction stays authorized until the payment gateway reports its hold released,
+// a void left unanswered for the retry interval is requested again.
+type Voider interface {
+	Run(ctx context.Context)
+	Void(ctx context.Context) (int, error)
+}
+
+type voider struct {
+	cfg                  *Config
+	transactionRepo      repository.TransactionRepo
+	transactionPublisher publisher.TransactionPublisher
+	clock                clock.Clock
+	log                  logger.Logger
+}
+
+// NewVoider creates a new instance of Voider.
+func NewVoider(
+	cfg *Config,
+	transactionRepo repository.TransactionRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	clk clock.Clock,
+	log logger.Logger,
+) (Voider, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &voider{
+		cfg:                  cfg,
+		transactionRepo:      transactionRepo,
+		transactionPublisher: transactionPublisher,
+		clock:                clk,
+		log:                  log,
+	}, nil
+}
+
+// Run voids expired holds every poll interval until the context is canceled.
+func (v *voider) Run(ctx context.Context) {
+	ticker := time.NewTicker(v.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			voided, err := v.Void(ctx)
+			if err != nil {
+				v.log.Error("Failed to void expired holds", map[string]interface{}{
+					"error":  err,
+					"voided": voided,
+				})
+
+				continue
+			}
+
+			if voided > 0 {
+				v.log.Info("Requested void of expired holds", map[string]interface{}{
+					"voided": voided,
+				})
+			}
+		}
+	}
+}
+
+// Void requests the void of expired holds in batches until a batch comes back incomplete
+// and returns how many voids were requested.
+func (v *voider) Void(ctx context.Context) (int, error) {
+	voided := 0
+	now := v.clock.NowUTC()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return voided, NewVoidError("void interrupted", err)
+		}
+
+		transactionIDs, err := v.transactionRepo.ClaimExpiredHolds(ctx, now, now.Add(-v.cfg.RetryInterval), v.cfg.BatchSize)
+		if err != nil {
+			return voided, NewVoidError("failed to claim expired holds", err)
+		}
+
+		voided += len(transactionIDs)
+
+		for _, transactionID := range transactionIDs {
+			if err := v.publish(ctx, transactionID); err != nil {
+				v.log.Error("Failed to publish void of expired hold", map[string]interface{}{
+					"error":          err,
+					"transaction_id": transactionID,
+				})
+			}
+		}
+
+		v.log.Debug("Claimed expired holds batch", map[string]interface{}{
+			"transaction_ids": transactionIDs,
+		})
+
+		if uint64(len(transactionIDs)) < v.cfg.BatchSize {
+			return voided, nil
+		}
+	}
+}
+
+// publish asks the payment gateway to release the hold of the transaction.
+func (v *voider) publish(ctx context.Context, transactionID string) error {
+	transaction, err := v.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	void, err := dto.FromVoidRequest(transaction)
+	if err != nil {
+		return err
+	}
+
+	return v.transactionPublisher.PublishVoidRequestedTransaction(void)
+}
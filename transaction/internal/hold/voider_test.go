@@ -0,0 +1,190 @@
+package hold_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/hold"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testBatchSize     = 2
+	testRetryInterval = 10 * time.Minute
+)
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func voiderHelper(t *testing.T, pollInterval time.Duration) (
+	hold.Voider,
+	*mock_repository.MockTransactionRepo,
+	*mock_publisher.MockTransactionPublisher,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	repo := mock_repository.NewMockTransactionRepo(mockCtrl)
+	publisher := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+
+	v, err := hold.NewVoider(&hold.Config{
+		PollInterval:  pollInterval,
+		BatchSize:     testBatchSize,
+		RetryInterval: testRetryInterval,
+	}, repo, publisher, clk, l)
+	require.NoError(t, err)
+
+	return v, repo, publisher
+}
+
+func testTransaction(transactionID string) *model.Transaction {
+	senderID := "test-sender"
+	authorizedUntil := testNow.Add(-time.Second)
+
+	return &model.Transaction{
+		ID:              transactionID,
+		SenderID:        &senderID,
+		Currency:        "ALGO",
+		Amount:          5,
+		Status:          model.Authorized,
+		Method:          "algorand",
+		ManualCapture:   true,
+		AuthorizedUntil: &authorizedUntil,
+		Sender:          &model.TransactionUser{ID: senderID},
+		Receiver:        &model.TransactionUser{ID: "test-receiver"},
+	}
+}
+
+func TestVoid(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+	retryBefore := testNow.Add(-testRetryInterval)
+
+	testcases := []struct {
+		name           string
+		batches        [][]string
+		batchErr       error
+		failedPublish  string
+		expectedVoided int
+		expectedErr    error
+	}{
+		{
+			name:           "No hold expired",
+			batches:        [][]string{{}},
+			expectedVoided: 0,
+		},
+		{
+			name:           "Void until a batch is incomplete",
+			batches:        [][]string{{"first", "second"}, {"third"}},
+			expectedVoided: 3,
+		},
+		{
+			name:           "Failed publish does not stop the batch",
+			batches:        [][]string{{"first", "second"}, {}},
+			failedPublish:  "first",
+			expectedVoided: 2,
+		},
+		{
+			name:           "Failed batch",
+			batches:        [][]string{{"first", "second"}},
+			batchErr:       testErr,
+			expectedVoided: 2,
+			expectedErr:    testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, repo, publisher := voiderHelper(t, time.Minute)
+
+			calls := make([]any, 0)
+
+			for _, batch := range testcase.batches {
+				calls = append(calls, repo.EXPECT().ClaimExpiredHolds(gomock.Any(), testNow, retryBefore, uint64(testBatchSize)).Return(batch, nil))
+
+				for _, transactionID := range batch {
+					transaction := testTransaction(transactionID)
+
+					voidRequest, err := dto.FromVoidRequest(transaction)
+					require.NoError(t, err)
+					require.False(t, voidRequest.AuthorizeOnly)
+
+					var publishErr error
+					if transactionID == testcase.failedPublish {
+						publishErr = testErr
+					}
+
+					calls = append(calls,
+						repo.EXPECT().GetTransaction(gomock.Any(), transactionID).Return(transaction, nil),
+						publisher.EXPECT().PublishVoidRequestedTransaction(voidRequest).Return(publishErr),
+					)
+				}
+			}
+
+			if testcase.batchErr != nil {
+				calls = append(calls, repo.EXPECT().ClaimExpiredHolds(gomock.Any(), testNow, retryBefore, uint64(testBatchSize)).Return(nil, testcase.batchErr))
+			}
+
+			gomock.InOrder(calls...)
+
+			voided, err := v.Void(context.Background())
+
+			assert.Equal(t, testcase.expectedVoided, voided)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	v, repo, _ := voiderHelper(t, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	gomock.InOrder(
+		repo.EXPECT().ClaimExpiredHolds(gomock.Any(), gomock.Any(), gomock.Any(), uint64(testBatchSize)).Return(nil, errors.New("test err")),
+		repo.EXPECT().ClaimExpiredHolds(gomock.Any(), gomock.Any(), gomock.Any(), uint64(testBatchSize)).
+			DoAndReturn(func(context.Context, time.Time, time.Time, uint64) ([]string, error) {
+				cancel()
+
+				return []string{}, nil
+			}),
+	)
+
+	done := make(chan struct{})
+
+	go func() {
+		v.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("voider did not stop after the context was canceled")
+	}
+}
@@ -0,0 +1,73 @@
+package ledger
+
+// AccountKind tells what an account of the ledger stands for.
+type AccountKind string
+
+const (
+	// WalletAccount holds what a user wallet paid and received through the service.
+	WalletAccount AccountKind = "wallet"
+	// FeesAccount collects the fees the platform keeps from settled payments.
+	FeesAccount AccountKind = "fees"
+	// ClearingAccount holds the payments handed over to a payment gateway until they settle or are reversed.
+	ClearingAccount AccountKind = "clearing"
+	// ExternalAccount stands for the wallets outside of the platform that pay watch transactions.
+	ExternalAccount AccountKind = "external"
+)
+
+// Identifiers of the platform accounts, there is a single one of each kind.
+const (
+	FeesAccountID     = "platform:fees"
+	ClearingAccountID = "platform:clearing"
+	ExternalAccountID = "platform:external"
+)
+
+// Account represents an account of the ledger. Wallet accounts are owned by the user of the wallet,
+// platform accounts have no owner.
+type Account struct {
+	ID      string      `db:"account_id"`
+	Kind    AccountKind `db:"kind"`
+	OwnerID *string     `db:"owner_id"`
+}
+
+// WalletAccountID returns the id of the account of a user wallet.
+func WalletAccountID(walletID string) string {
+	return string(WalletAccount) + ":" + walletID
+}
+
+// NewWalletAccount returns the account of the wallet owned by the user.
+func NewWalletAccount(userID, walletID string) *Account {
+	return &Account{
+		ID:      WalletAccountID(walletID),
+		Kind:    WalletAccount,
+		OwnerID: &userID,
+	}
+}
+
+// Fees returns the platform fees account.
+func Fees() *Account {
+	return &Account{
+		ID:   FeesAccountID,
+		Kind: FeesAccount,
+	}
+}
+
+// Clearing returns the platform clearing account.
+func Clearing() *Account {
+	return &Account{
+		ID:   ClearingAccountID,
+		Kind: ClearingAccount,
+	}
+}
+
+// External returns the account of the wallets outside of the platform.
+func External() *Account {
+	return &Account{
+		ID:   ExternalAccountID,
+		Kind: ExternalAccount,
+	}
+}
+
+// OwnedBy reports whether the account belongs to the user, platform accounts belong to no one.
+func (account *Account) OwnedBy(userID string) bool {
+	return account.OwnerID != nil && *account.OwnerID == userID
+}
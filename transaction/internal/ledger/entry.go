@@ -0,0 +1,86 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Direction tells on which side of an account a posting is written.
+// Credits add to the balance of an account and debits take from it.
+type Direction string
+
+const (
+	Debit  Direction = "debit"
+	Credit Direction = "credit"
+)
+
+// EntryKind tells which step of a payment a journal entry books.
+type EntryKind string
+
+const (
+	// HoldEntry moves the payment from the payer to the clearing account when it is handed over to the payment gateway.
+	HoldEntry EntryKind = "hold"
+	// SettleEntry moves the payment from the clearing account to the payees and the platform fees.
+	SettleEntry EntryKind = "settle"
+	// ReverseEntry moves the payment from the clearing account back to the payer.
+	ReverseEntry EntryKind = "reverse"
+)
+
+var (
+	// ErrUnbalancedEntry is returned when the debits of an entry do not equal its credits in some currency.
+	ErrUnbalancedEntry = errors.New("entry debits do not equal credits")
+	// ErrInvalidPosting is returned when a posting has no account, direction, currency or positive amount.
+	ErrInvalidPosting = errors.New("invalid posting")
+)
+
+// Posting represents one side of a journal entry, Amount is counted in the minor unit of the currency.
+type Posting struct {
+	Account   *Account
+	Direction Direction
+	Amount    int64
+	Currency  string
+}
+
+// Entry represents an immutable journal entry, it books one step of the payment referenced by ReferenceID:
+// a transaction, a share of a group transaction or its refund.
+type Entry struct {
+	ID            string
+	ReferenceID   string
+	TransactionID string
+	Kind          EntryKind
+	Postings      []*Posting
+	CreatedAt     time.Time
+}
+
+// Validate checks that every posting is valid and that the debits of the entry equal its credits in every currency.
+func (entry *Entry) Validate() error {
+	if len(entry.Postings) < 2 {
+		return fmt.Errorf("%w: %d postings", ErrUnbalancedEntry, len(entry.Postings))
+	}
+
+	totals := make(map[string]int64)
+
+	for _, posting := range entry.Postings {
+		if posting.Account == nil || posting.Amount <= 0 || posting.Currency == "" {
+			return fmt.Errorf("%w: %+v", ErrInvalidPosting, posting)
+		}
+
+		switch posting.Direction {
+		case Debit:
+			totals[posting.Currency] += posting.Amount
+		case Credit:
+			totals[posting.Currency] -= posting.Amount
+		default:
+			return fmt.Errorf("%w: direction %s", ErrInvalidPosting, posting.Direction)
+		}
+	}
+
+	for currency, total := range totals {
+		if total != 0 {
+			return fmt.Errorf("%w: %d %s", ErrUnbalancedEntry, total, currency)
+		}
+	}
+
+	return nil
+}
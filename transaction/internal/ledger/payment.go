@@ -0,0 +1,223 @@
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Stage tells how far the payment has gone, Book writes the entries that bring the ledger to it.
+type Stage int
+
+const (
+	// StageNone is a payment not handed over to a payment gateway yet.
+	StageNone Stage = iota
+	// StageHeld is a payment handed over to a payment gateway, its amount waits in the clearing account.
+	StageHeld
+	// StageSettled is a paid payment, its amount reached the payees.
+	StageSettled
+	// StageReversed is a payment that failed or was canceled, its amount went back to the payer.
+	StageReversed
+)
+
+// ErrInvalidPayment is returned when a payment can not be booked:
+// it has no payer or amount, or its payees and fee exceed the held amount.
+var ErrInvalidPayment = errors.New("invalid payment")
+
+// Payee is an account a payment is paid to, Amount is counted in the minor unit of the payment currency.
+type Payee struct {
+	Account *Account
+	Amount  int64
+}
+
+// Payment describes what a payment referenced by ReferenceID moves: Amount is taken from the payer,
+// the payees and the platform fees get their parts of it when it settles and the payer gets the rest back.
+// The payer may be unknown for a payment that is only reversed, the reversal pays back the account of the hold.
+type Payment struct {
+	ReferenceID   string
+	TransactionID string
+	Currency      string
+	Payer         *Account
+	Amount        int64
+	Payees        []*Payee
+	Fee           int64
+}
+
+// Book returns the entries that bring the payment from its booked entries to the stage, none when it is there already.
+// Entries of other payments in booked are skipped. A settled payment stays settled,
+// a reversed one is held again when the payment is retried.
+func Book(payment *Payment, stage Stage, booked []*Entry, now time.Time) ([]*Entry, error) {
+	var (
+		hold    *Entry
+		settled bool
+	)
+
+	for _, entry := range booked {
+		if entry.ReferenceID != payment.ReferenceID {
+			continue
+		}
+
+		switch entry.Kind {
+		case HoldEntry:
+			hold = entry
+		case SettleEntry:
+			hold = nil
+			settled = true
+		case ReverseEntry:
+			hold = nil
+		}
+	}
+
+	var entries []*Entry
+
+	switch stage {
+	case StageHeld:
+		if hold != nil || settled {
+			return nil, nil
+		}
+
+		entry, err := payment.hold(now)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	case StageSettled:
+		if settled {
+			return nil, nil
+		}
+
+		if hold == nil {
+			entry, err := payment.hold(now)
+			if err != nil {
+				return nil, err
+			}
+
+			hold = entry
+			entries = append(entries, entry)
+		}
+
+		entry, err := payment.settle(hold, now)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	case StageReversed:
+		if hold == nil {
+			return nil, nil
+		}
+
+		entries = append(entries, reverse(hold, now))
+	default:
+		return nil, nil
+	}
+
+	for _, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, nil
+}
+
+// hold moves the amount from the payer to the clearing account.
+func (payment *Payment) hold(now time.Time) (*Entry, error) {
+	if payment.Payer == nil || payment.Amount <= 0 {
+		return nil, fmt.Errorf("%w: %s has no payer or amount", ErrInvalidPayment, payment.ReferenceID)
+	}
+
+	return payment.newEntry(HoldEntry, now,
+		&Posting{Account: payment.Payer, Direction: Debit, Amount: payment.Amount, Currency: payment.Currency},
+		&Posting{Account: Clearing(), Direction: Credit, Amount: payment.Amount, Currency: payment.Currency},
+	), nil
+}
+
+// settle moves the held amount from the clearing account to the payees and the platform fees,
+// what is left goes back to the payer of the hold.
+func (payment *Payment) settle(hold *Entry, now time.Time) (*Entry, error) {
+	payer, held := holdPayer(hold)
+
+	postings := []*Posting{
+		{Account: Clearing(), Direction: Debit, Amount: held, Currency: payment.Currency},
+	}
+
+	remaining := held
+
+	for _, payee := range payment.Payees {
+		if payee.Account == nil || payee.Amount <= 0 || payee.Amount > remaining {
+			return nil, fmt.Errorf("%w: %s pays more than held", ErrInvalidPayment, payment.ReferenceID)
+		}
+
+		remaining -= payee.Amount
+		postings = append(postings, &Posting{Account: payee.Account, Direction: Credit, Amount: payee.Amount, Currency: payment.Currency})
+	}
+
+	if payment.Fee < 0 || payment.Fee > remaining {
+		return nil, fmt.Errorf("%w: %s fee exceeds the held amount", ErrInvalidPayment, payment.ReferenceID)
+	}
+
+	if payment.Fee > 0 {
+		remaining -= payment.Fee
+		postings = append(postings, &Posting{Account: Fees(), Direction: Credit, Amount: payment.Fee, Currency: payment.Currency})
+	}
+
+	if remaining > 0 {
+		postings = append(postings, &Posting{Account: payer, Direction: Credit, Amount: remaining, Currency: payment.Currency})
+	}
+
+	return payment.newEntry(SettleEntry, now, postings...), nil
+}
+
+// reverse moves the held amount back to the payer of the hold.
+func reverse(hold *Entry, now time.Time) *Entry {
+	postings := make([]*Posting, 0, len(hold.Postings))
+
+	for _, posting := range hold.Postings {
+		direction := Debit
+		if posting.Direction == Debit {
+			direction = Credit
+		}
+
+		postings = append(postings, &Posting{
+			Account:   posting.Account,
+			Direction: direction,
+			Amount:    posting.Amount,
+			Currency:  posting.Currency,
+		})
+	}
+
+	return &Entry{
+		ID:            uuid.NewString(),
+		ReferenceID:   hold.ReferenceID,
+		TransactionID: hold.TransactionID,
+		Kind:          ReverseEntry,
+		Postings:      postings,
+		CreatedAt:     now,
+	}
+}
+
+// holdPayer returns the account the hold was taken from and the held amount.
+func holdPayer(hold *Entry) (*Account, int64) {
+	for _, posting := range hold.Postings {
+		if posting.Direction == Debit {
+			return posting.Account, posting.Amount
+		}
+	}
+
+	return nil, 0
+}
+
+func (payment *Payment) newEntry(kind EntryKind, now time.Time, postings ...*Posting) *Entry {
+	return &Entry{
+		ID:            uuid.NewString(),
+		ReferenceID:   payment.ReferenceID,
+		TransactionID: payment.TransactionID,
+		Kind:          kind,
+		Postings:      postings,
+		CreatedAt:     now,
+	}
+}
@@ -0,0 +1,220 @@
+package ledger
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+const maxAmount = 1_000_000_000
+
+var bookedAt = time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+func genPayment() gopter.Gen {
+	return gopter.CombineGens(
+		gen.Int64Range(1, maxAmount),
+		gen.SliceOfN(3, gen.Float64Range(0, 1)),
+		gen.IntRange(1, 3),
+		gen.Float64Range(0, 1),
+		gen.OneConstOf("ALGO", "USD", "RUB"),
+	).Map(func(values []interface{}) *Payment {
+		amount := values[0].(int64)
+		parts := values[1].([]float64)
+		payees := values[2].(int)
+
+		payment := &Payment{
+			ReferenceID:   "payment",
+			TransactionID: "transaction",
+			Currency:      values[4].(string),
+			Payer:         NewWalletAccount("payer", "payer-wallet"),
+			Amount:        amount,
+		}
+
+		remaining := amount
+
+		for i := 0; i < payees && remaining > 0; i++ {
+			paid := int64(float64(remaining) * parts[i])
+			if paid == 0 {
+				paid = 1
+			}
+
+			remaining -= paid
+			payment.Payees = append(payment.Payees, &Payee{
+				Account: NewWalletAccount("payee", "payee-wallet-"+string(rune('a'+i))),
+				Amount:  paid,
+			})
+		}
+
+		payment.Fee = int64(float64(remaining) * values[3].(float64))
+
+		return payment
+	})
+}
+
+func genStages() gopter.Gen {
+	return gen.IntRange(1, 8).FlatMap(func(size interface{}) gopter.Gen {
+		return gen.SliceOfN(size.(int), gen.OneConstOf(StageNone, StageHeld, StageSettled, StageReversed))
+	}, reflect.TypeOf([]Stage{}))
+}
+
+// bookStages books the payment through the stages one after another and returns every entry written.
+func bookStages(payment *Payment, stages []Stage) ([]*Entry, error) {
+	var booked []*Entry
+
+	for _, stage := range stages {
+		entries, err := Book(payment, stage, booked, bookedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		booked = append(booked, entries...)
+	}
+
+	return booked, nil
+}
+
+// netBalances returns credits minus debits of every account by currency.
+func netBalances(entries []*Entry) map[string]map[string]int64 {
+	balances := make(map[string]map[string]int64)
+
+	for _, entry := range entries {
+		for _, posting := range entry.Postings {
+			if balances[posting.Account.ID] == nil {
+				balances[posting.Account.ID] = make(map[string]int64)
+			}
+
+			switch posting.Direction {
+			case Credit:
+				balances[posting.Account.ID][posting.Currency] += posting.Amount
+			case Debit:
+				balances[posting.Account.ID][posting.Currency] -= posting.Amount
+			}
+		}
+	}
+
+	return balances
+}
+
+func TestBookProperties(t *testing.T) {
+	t.Parallel()
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 500
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("debits equal credits in every entry", prop.ForAll(
+		func(payment *Payment, stages []Stage) bool {
+			entries, err := bookStages(payment, stages)
+			if err != nil {
+				return false
+			}
+
+			for _, entry := range entries {
+				var debits, credits int64
+
+				for _, posting := range entry.Postings {
+					if posting.Direction == Debit {
+						debits += posting.Amount
+					} else {
+						credits += posting.Amount
+					}
+				}
+
+				if debits != credits || entry.Validate() != nil {
+					return false
+				}
+			}
+
+			return true
+		},
+		genPayment(),
+		genStages(),
+	))
+
+	properties.Property("trial balance is zero", prop.ForAll(
+		func(payment *Payment, stages []Stage) bool {
+			entries, err := bookStages(payment, stages)
+			if err != nil {
+				return false
+			}
+
+			var total int64
+
+			for _, balances := range netBalances(entries) {
+				total += balances[payment.Currency]
+			}
+
+			return total == 0
+		},
+		genPayment(),
+		genStages(),
+	))
+
+	properties.Property("clearing holds the payment only while it is in flight", prop.ForAll(
+		func(payment *Payment, stages []Stage) bool {
+			entries, err := bookStages(payment, stages)
+			if err != nil {
+				return false
+			}
+
+			inFlight := false
+
+			for _, entry := range entries {
+				inFlight = entry.Kind == HoldEntry
+			}
+
+			clearing := netBalances(entries)[ClearingAccountID][payment.Currency]
+			if inFlight {
+				return clearing == payment.Amount
+			}
+
+			return clearing == 0
+		},
+		genPayment(),
+		genStages(),
+	))
+
+	properties.Property("a settled payment pays the payees and the fee once", prop.ForAll(
+		func(payment *Payment, stages []Stage) bool {
+			entries, err := bookStages(payment, append(stages, StageSettled))
+			if err != nil {
+				return false
+			}
+
+			balances := netBalances(entries)
+
+			var paid int64
+
+			for _, payee := range payment.Payees {
+				paid += payee.Amount
+			}
+
+			return balances[FeesAccountID][payment.Currency] == payment.Fee &&
+				balances[payment.Payer.ID][payment.Currency] == -paid-payment.Fee
+		},
+		genPayment(),
+		genStages(),
+	))
+
+	properties.Property("booking the same stage twice writes nothing", prop.ForAll(
+		func(payment *Payment, stages []Stage) bool {
+			booked, err := bookStages(payment, stages)
+			if err != nil {
+				return false
+			}
+
+			entries, err := Book(payment, stages[len(stages)-1], booked, bookedAt)
+
+			return err == nil && len(entries) == 0
+		},
+		genPayment(),
+		genStages(),
+	))
+
+	properties.TestingRun(t)
+}
@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// Balance is what an account holds in a currency, amounts are counted in the minor unit of the currency.
+type Balance struct {
+	Currency string `db:"currency"`
+	Debits   int64  `db:"debits"`
+	Credits  int64  `db:"credits"`
+}
+
+// Net returns the credits of the account minus its debits.
+func (balance *Balance) Net() int64 {
+	return balance.Credits - balance.Debits
+}
+
+// ToLedgerBalanceDTO converts a Balance to a LedgerBalanceResponse DTO.
+func (balance *Balance) ToLedgerBalanceDTO() *dto.LedgerBalanceResponse {
+	net := balance.Net()
+
+	response := &dto.LedgerBalanceResponse{
+		Currency: &balance.Currency,
+		Debits:   &balance.Debits,
+		Credits:  &balance.Credits,
+		Balance:  &net,
+	}
+
+	if amount, err := money.New(net, balance.Currency); err == nil {
+		response.BalanceDecimal = amount.Decimal()
+	}
+
+	return response
+}
+
+// StatementLine is a posting of an account together with the entry it belongs to.
+type StatementLine struct {
+	EntryID       string    `db:"entry_id"`
+	ReferenceID   string    `db:"reference_id"`
+	TransactionID string    `db:"transaction_id"`
+	Kind          EntryKind `db:"kind"`
+	Direction     Direction `db:"direction"`
+	Amount        int64     `db:"amount"`
+	Currency      string    `db:"currency"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// ToLedgerStatementLineDTO converts a StatementLine to a LedgerStatementLineResponse DTO.
+func (line *StatementLine) ToLedgerStatementLineDTO() *dto.LedgerStatementLineResponse {
+	kind := string(line.Kind)
+	direction := string(line.Direction)
+	createdAt := strfmt.DateTime(line.CreatedAt)
+
+	response := &dto.LedgerStatementLineResponse{
+		EntryID:       (*strfmt.UUID)(&line.EntryID),
+		ReferenceID:   (*strfmt.UUID)(&line.ReferenceID),
+		TransactionID: (*strfmt.UUID)(&line.TransactionID),
+		Kind:          &kind,
+		Direction:     &direction,
+		Amount:        &line.Amount,
+		Currency:      &line.Currency,
+		CreatedAt:     &createdAt,
+	}
+
+	if amount, err := money.New(line.Amount, line.Currency); err == nil {
+		response.AmountDecimal = amount.Decimal()
+	}
+
+	return response
+}
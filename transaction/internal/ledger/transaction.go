@@ -0,0 +1,168 @@
+package ledger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+)
+
+// Plan returns the entries that book the current status of the transaction on top of its booked entries.
+// A group transaction is booked by share: every share is a payment from its payer to the receiver
+// and a share refund is a payment back from the receiver to the payer.
+func Plan(transaction *model.Transaction, booked []*Entry, now time.Time) ([]*Entry, error) {
+	if !transaction.Group() {
+		payment, err := transactionPayment(transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		return Book(payment, transactionStage(transaction.Status), booked, now)
+	}
+
+	var entries []*Entry
+
+	for _, share := range transaction.Shares {
+		payment, refund := sharePayments(transaction, share)
+		paymentStage, refundStage := shareStages(share.Status)
+
+		paymentEntries, err := Book(payment, paymentStage, booked, now)
+		if err != nil {
+			return nil, err
+		}
+
+		refundEntries, err := Book(refund, refundStage, booked, now)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, paymentEntries...)
+		entries = append(entries, refundEntries...)
+	}
+
+	return entries, nil
+}
+
+func transactionStage(status model.TransactionStatus) Stage {
+	switch status {
+	case model.Processed, model.Authorized:
+		return StageHeld
+	case model.Succeeded:
+		return StageSettled
+	case model.Canceled, model.Failed, model.Expired, model.Voided:
+		return StageReversed
+	default:
+		return StageNone
+	}
+}
+
+func shareStages(status model.ShareStatus) (Stage, Stage) {
+	switch status {
+	case model.ShareProcessed:
+		return StageHeld, StageNone
+	case model.ShareSucceeded:
+		return StageSettled, StageNone
+	case model.SharePending, model.ShareCanceled:
+		return StageReversed, StageNone
+	case model.ShareRefunding:
+		return StageSettled, StageHeld
+	case model.ShareRefunded:
+		return StageSettled, StageSettled
+	case model.ShareRefundFailed:
+		return StageSettled, StageReversed
+	default:
+		return StageNone, StageNone
+	}
+}
+
+// transactionPayment describes the payment of a transaction from the sender to its legs in the payment currency.
+// A watch transaction is paid by the external account, its payer is not known to the platform.
+// A partially captured transaction pays the captured amount to the receiver.
+// The fee is deducted from the receiver and credited to the fees account.
+func transactionPayment(transaction *model.Transaction) (*Payment, error) {
+	amount, err := transaction.PaymentMoney()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPayment, err)
+	}
+
+	payouts, fee, err := transaction.Payouts()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPayment, err)
+	}
+
+	payment := &Payment{
+		ReferenceID:   transaction.ID,
+		TransactionID: transaction.ID,
+		Currency:      amount.Currency().Code,
+		Amount:        amount.Amount(),
+		Fee:           fee.Amount(),
+	}
+
+	switch {
+	case transaction.Sender != nil:
+		payment.Payer = NewWalletAccount(transaction.Sender.UserID, transaction.Sender.WalletID)
+	case transaction.Watch:
+		payment.Payer = External()
+	}
+
+	receivers := []*model.TransactionUser{transaction.Receiver}
+	if len(transaction.Legs) != 0 {
+		receivers = make([]*model.TransactionUser, 0, len(transaction.Legs))
+
+		for _, leg := range transaction.Legs {
+			receivers = append(receivers, leg.Receiver)
+		}
+	}
+
+	for i, receiver := range receivers {
+		// A receiver whose whole part goes to the fee gets nothing posted.
+		if payouts[i].Amount() == 0 {
+			continue
+		}
+
+		payment.Payees = append(payment.Payees, &Payee{
+			Account: userAccount(receiver),
+			Amount:  payouts[i].Amount(),
+		})
+	}
+
+	return payment, nil
+}
+
+// sharePayments describes the payment of a share from its payer to the receiver and its refund.
+func sharePayments(transaction *model.Transaction, share *model.TransactionShare) (*Payment, *Payment) {
+	payer := userAccount(share.Payer)
+	receiver := userAccount(transaction.Receiver)
+
+	payment := &Payment{
+		ReferenceID:   share.ID,
+		TransactionID: transaction.ID,
+		Currency:      transaction.Currency,
+		Payer:         payer,
+		Amount:        share.Amount,
+		Payees: []*Payee{
+			{Account: receiver, Amount: share.Amount},
+		},
+	}
+
+	refund := &Payment{
+		ReferenceID:   share.RefundID,
+		TransactionID: transaction.ID,
+		Currency:      transaction.Currency,
+		Payer:         receiver,
+		Amount:        share.Amount,
+		Payees: []*Payee{
+			{Account: payer, Amount: share.Amount},
+		},
+	}
+
+	return payment, refund
+}
+
+func userAccount(user *model.TransactionUser) *Account {
+	if user == nil {
+		return nil
+	}
+
+	return NewWalletAccount(user.UserID, user.WalletID)
+}
@@ -0,0 +1,199 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	sender := &model.TransactionUser{ID: "sender", UserID: "sender-user", WalletID: "sender-wallet"}
+	receiver := &model.TransactionUser{ID: "receiver", UserID: "receiver-user", WalletID: "receiver-wallet"}
+	senderAccount := WalletAccountID(sender.WalletID)
+	receiverAccount := WalletAccountID(receiver.WalletID)
+
+	newTransaction := func(status model.TransactionStatus) *model.Transaction {
+		return &model.Transaction{
+			ID:         "transaction",
+			SenderID:   &sender.ID,
+			ReceiverID: receiver.ID,
+			Currency:   "ALGO",
+			Amount:     1000,
+			Status:     status,
+			Sender:     sender,
+			Receiver:   receiver,
+		}
+	}
+
+	newGroupTransaction := func(status model.ShareStatus, payer *model.TransactionUser) *model.Transaction {
+		transaction := newTransaction(model.Created)
+		transaction.SenderID = nil
+		transaction.Sender = nil
+		transaction.Shares = []*model.TransactionShare{
+			{ID: "share", TransactionID: transaction.ID, Amount: 1000, Status: status, RefundID: "refund", Payer: payer},
+		}
+
+		return transaction
+	}
+
+	newWatchTransaction := func(status model.TransactionStatus) *model.Transaction {
+		transaction := newTransaction(status)
+		transaction.SenderID = nil
+		transaction.Sender = nil
+		transaction.Watch = true
+
+		return transaction
+	}
+
+	capturedAmount := int64(400)
+
+	testcases := []struct {
+		name             string
+		transaction      *model.Transaction
+		previousStatuses []*model.Transaction
+		expectedKinds    []EntryKind
+		expectedBalances map[string]int64
+	}{
+		{
+			name:          "Created transaction",
+			transaction:   newTransaction(model.Created),
+			expectedKinds: nil,
+		},
+		{
+			name:          "Processed transaction",
+			transaction:   newTransaction(model.Processed),
+			expectedKinds: []EntryKind{HoldEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     -1000,
+				ClearingAccountID: 1000,
+			},
+		},
+		{
+			name:             "Succeeded transaction",
+			transaction:      newTransaction(model.Succeeded),
+			previousStatuses: []*model.Transaction{newTransaction(model.Processed)},
+			expectedKinds:    []EntryKind{HoldEntry, SettleEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     -1000,
+				receiverAccount:   1000,
+				ClearingAccountID: 0,
+			},
+		},
+		{
+			name: "Partially captured transaction",
+			transaction: func() *model.Transaction {
+				transaction := newTransaction(model.Succeeded)
+				transaction.ManualCapture = true
+				transaction.CapturedAmount = &capturedAmount
+
+				return transaction
+			}(),
+			previousStatuses: []*model.Transaction{newTransaction(model.Authorized)},
+			expectedKinds:    []EntryKind{HoldEntry, SettleEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     -400,
+				receiverAccount:   400,
+				ClearingAccountID: 0,
+			},
+		},
+		{
+			name: "Succeeded transaction with fee",
+			transaction: func() *model.Transaction {
+				transaction := newTransaction(model.Succeeded)
+				transaction.Fee = 30
+
+				return transaction
+			}(),
+			previousStatuses: []*model.Transaction{newTransaction(model.Processed)},
+			expectedKinds:    []EntryKind{HoldEntry, SettleEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     -1000,
+				receiverAccount:   970,
+				FeesAccountID:     30,
+				ClearingAccountID: 0,
+			},
+		},
+		{
+			name:             "Failed transaction",
+			transaction:      newTransaction(model.Failed),
+			previousStatuses: []*model.Transaction{newTransaction(model.Processed)},
+			expectedKinds:    []EntryKind{HoldEntry, ReverseEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     0,
+				ClearingAccountID: 0,
+			},
+		},
+		{
+			name:          "Expired transaction",
+			transaction:   newTransaction(model.Expired),
+			expectedKinds: nil,
+		},
+		{
+			name:             "Watch transaction paid from an external wallet",
+			transaction:      newWatchTransaction(model.Succeeded),
+			previousStatuses: []*model.Transaction{newWatchTransaction(model.Processed)},
+			expectedKinds:    []EntryKind{HoldEntry, SettleEntry},
+			expectedBalances: map[string]int64{
+				ExternalAccountID: -1000,
+				receiverAccount:   1000,
+				ClearingAccountID: 0,
+			},
+		},
+		{
+			name:             "Share freed after a failed payment",
+			transaction:      newGroupTransaction(model.SharePending, nil),
+			previousStatuses: []*model.Transaction{newGroupTransaction(model.ShareProcessed, sender)},
+			expectedKinds:    []EntryKind{HoldEntry, ReverseEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     0,
+				ClearingAccountID: 0,
+			},
+		},
+		{
+			name:        "Refunded share",
+			transaction: newGroupTransaction(model.ShareRefunded, sender),
+			previousStatuses: []*model.Transaction{
+				newGroupTransaction(model.ShareProcessed, sender),
+				newGroupTransaction(model.ShareRefunding, sender),
+			},
+			expectedKinds: []EntryKind{HoldEntry, SettleEntry, HoldEntry, SettleEntry},
+			expectedBalances: map[string]int64{
+				senderAccount:     0,
+				receiverAccount:   0,
+				ClearingAccountID: 0,
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var booked []*Entry
+
+			for _, transaction := range append(testcase.previousStatuses, testcase.transaction) {
+				entries, err := Plan(transaction, booked, bookedAt)
+				assert.NoError(t, err)
+
+				booked = append(booked, entries...)
+			}
+
+			var kinds []EntryKind
+			for _, entry := range booked {
+				kinds = append(kinds, entry.Kind)
+			}
+
+			assert.Equal(t, testcase.expectedKinds, kinds)
+
+			balances := netBalances(booked)
+			for accountID, expectedBalance := range testcase.expectedBalances {
+				assert.Equal(t, expectedBalance, balances[accountID]["ALGO"], accountID)
+			}
+		})
+	}
+}
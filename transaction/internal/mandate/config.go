@@ -0,0 +1,65 @@
+package mandate
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultCheckInterval  = time.Minute
+	defaultBatchSize      = 100
+	defaultMaxAttempts    = 4
+	defaultInitialBackoff = time.Hour
+	defaultMaxBackoff     = 24 * time.Hour
+	defaultLeaderKey      = "transaction:mandate:leader"
+	defaultLeaderTTL      = 15 * time.Second
+)
+
+// Config represents the mandate scheduler configuration structure.
+type Config struct {
+	// CheckInterval is how often due mandates and settled charges are looked for.
+	CheckInterval time.Duration
+	// BatchSize limits how many mandates are charged and settled by a single check.
+	BatchSize uint64
+	// MaxAttempts is the number of failed charges of a period after which the mandate is unpaid.
+	MaxAttempts int32
+	// InitialBackoff is the delay before the first retry of a failed charge, every next retry waits twice as long.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+	// LeaderKey is the redis key holding the scheduler lease.
+	LeaderKey string
+	// LeaderTTL is how long the lease outlives a replica that stopped renewing it.
+	LeaderTTL time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		CheckInterval:  defaultCheckInterval,
+		BatchSize:      defaultBatchSize,
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		LeaderKey:      defaultLeaderKey,
+		LeaderTTL:      defaultLeaderTTL,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
@@ -0,0 +1,76 @@
+package mandate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				MaxAttempts: 2,
+				LeaderKey:   "test:leader",
+			},
+			expectedCfg: &Config{
+				CheckInterval:  defaultCheckInterval,
+				BatchSize:      defaultBatchSize,
+				MaxAttempts:    2,
+				InitialBackoff: defaultInitialBackoff,
+				MaxBackoff:     defaultMaxBackoff,
+				LeaderKey:      "test:leader",
+				LeaderTTL:      defaultLeaderTTL,
+			},
+		},
+		{
+			name: "With full config",
+			cfg: &Config{
+				CheckInterval:  time.Second,
+				BatchSize:      10,
+				MaxAttempts:    3,
+				InitialBackoff: time.Minute,
+				MaxBackoff:     time.Hour,
+				LeaderKey:      "test:leader",
+				LeaderTTL:      time.Second,
+			},
+			expectedCfg: &Config{
+				CheckInterval:  time.Second,
+				BatchSize:      10,
+				MaxAttempts:    3,
+				InitialBackoff: time.Minute,
+				MaxBackoff:     time.Hour,
+				LeaderKey:      "test:leader",
+				LeaderTTL:      time.Second,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
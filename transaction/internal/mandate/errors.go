@@ -0,0 +1,25 @@
+package mandate
+
+import "fmt"
+
+// ScheduleError represents an error encountered while charging mandates.
+type ScheduleError struct {
+	msg string
+	err error
+}
+
+// NewScheduleError creates a new ScheduleError instance with the provided message and error.
+func NewScheduleError(msg string, err error) *ScheduleError {
+	return &ScheduleError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ScheduleError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ScheduleError) Unwrap() error {
+	return e.err
+}
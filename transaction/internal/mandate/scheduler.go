@@ -0,0 +1,206 @@
+package mandate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/saga"
+)
+
+// Scheduler charges the mandates on schedule. Every check it first settles the charges whose
+// transactions reached a final status, moving paid mandates to the next billing date and
+// scheduling retries of failed ones, then creates and accepts the transactions of due mandates.
+// A charge lost by the payment gateway is failed by the saga supervisor and retried like any other.
+type Scheduler interface {
+	Run(ctx context.Context)
+	Schedule(ctx context.Context) error
+}
+
+type scheduler struct {
+	cfg                  *Config
+	policy               *model.RetryPolicy
+	mandateRepo          repository.MandateRepo
+	transactionPublisher publisher.TransactionPublisher
+	fees                 fee.Calculator
+	elector              saga.Elector
+	clock                clock.Clock
+	log                  logger.Logger
+}
+
+// NewScheduler creates a new instance of Scheduler.
+func NewScheduler(
+	cfg *Config,
+	mandateRepo repository.MandateRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	fees fee.Calculator,
+	elector saga.Elector,
+	clk clock.Clock,
+	log logger.Logger,
+) (Scheduler, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &scheduler{
+		cfg: cfg,
+		policy: &model.RetryPolicy{
+			MaxAttempts:    cfg.MaxAttempts,
+			InitialBackoff: cfg.InitialBackoff,
+			MaxBackoff:     cfg.MaxBackoff,
+		},
+		mandateRepo:          mandateRepo,
+		transactionPublisher: transactionPublisher,
+		fees:                 fees,
+		elector:              elector,
+		clock:                clk,
+		log:                  log,
+	}, nil
+}
+
+// Run charges mandates every check interval while this replica is the leader,
+// until the context is canceled.
+func (s *scheduler) Run(ctx context.Context) {
+	s.elector.Lead(ctx, func(ctx context.Context) {
+		ticker := time.NewTicker(s.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.Schedule(ctx); err != nil {
+					s.log.Error("Failed to schedule mandate charges", map[string]interface{}{
+						"error": err,
+					})
+				}
+			}
+		}
+	})
+}
+
+// Schedule settles a batch of finished charges and charges a batch of due mandates.
+// Failures of single mandates are logged and retried on the next check.
+func (s *scheduler) Schedule(ctx context.Context) error {
+	now := s.clock.NowUTC()
+
+	outcomes, err := s.mandateRepo.GetMandateChargeOutcomes(ctx, s.cfg.BatchSize)
+	if err != nil {
+		return NewScheduleError("failed to get mandate charge outcomes", err)
+	}
+
+	for _, outcome := range outcomes {
+		s.settle(ctx, outcome, now)
+	}
+
+	dueIDs, err := s.mandateRepo.GetDueMandates(ctx, now, s.cfg.BatchSize)
+	if err != nil {
+		return NewScheduleError("failed to get due mandates", err)
+	}
+
+	for _, mandateID := range dueIDs {
+		s.charge(ctx, mandateID, now)
+	}
+
+	return nil
+}
+
+// settle applies the final status of the charge transaction to its mandate.
+func (s *scheduler) settle(ctx context.Context, outcome *model.MandateChargeOutcome, now time.Time) {
+	mandate, err := s.mandateRepo.SettleMandateCharge(ctx, outcome, now, s.policy)
+	if err != nil {
+		s.log.Error("Failed to settle mandate charge", map[string]interface{}{
+			"error":          err,
+			"mandate_id":     outcome.MandateID,
+			"transaction_id": outcome.TransactionID,
+		})
+
+		return
+	}
+
+	s.log.Debug("Settled mandate charge", map[string]interface{}{
+		"mandate_id":     mandate.ID,
+		"transaction_id": outcome.TransactionID,
+		"charge_status":  outcome.Status.String(),
+		"status":         mandate.Status.String(),
+		"attempts":       mandate.Attempts,
+	})
+
+	if mandate.Status == model.MandateUnpaid {
+		s.log.Warn("Mandate ran out of charge attempts", map[string]interface{}{
+			"mandate_id": mandate.ID,
+			"last_error": mandate.LastError,
+		})
+	}
+}
+
+// charge creates and accepts the transaction of the current period and hands it over to the payment gateway.
+// The fee is calculated on the charge the mandate makes now, the mandate terms do not change while it is active.
+// A transaction that fails to be published stays processed until the saga supervisor fails it.
+func (s *scheduler) charge(ctx context.Context, mandateID string, now time.Time) {
+	transactionFee, err := s.chargeFee(ctx, mandateID, now)
+	if err != nil {
+		s.log.Error("Failed to calculate mandate charge fee", map[string]interface{}{
+			"error":      err,
+			"mandate_id": mandateID,
+		})
+
+		return
+	}
+
+	transaction, err := s.mandateRepo.ChargeMandate(ctx, mandateID, transactionFee, now)
+
+	switch {
+	case errors.Is(err, repository.ErrMandateNotDue):
+		s.log.Debug("Mandate changed before it was charged", map[string]interface{}{
+			"mandate_id": mandateID,
+		})
+
+		return
+	case err != nil:
+		s.log.Error("Failed to charge mandate", map[string]interface{}{
+			"error":      err,
+			"mandate_id": mandateID,
+		})
+
+		return
+	}
+
+	s.log.Debug("Charged mandate", map[string]interface{}{
+		"mandate_id":     mandateID,
+		"transaction_id": transaction.ID,
+	})
+
+	processedTransaction, err := dto.FromTransactionModel(transaction)
+	if err == nil {
+		err = s.transactionPublisher.PublishProcessedTransaction(processedTransaction)
+	}
+
+	if err != nil {
+		s.log.Error("Failed to publish mandate charge", map[string]interface{}{
+			"error":          err,
+			"mandate_id":     mandateID,
+			"transaction_id": transaction.ID,
+		})
+	}
+}
+
+// chargeFee calculates the fee of the transaction the mandate charges now.
+func (s *scheduler) chargeFee(ctx context.Context, mandateID string, now time.Time) (*model.Fee, error) {
+	mandate, err := s.mandateRepo.GetMandate(ctx, mandateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fees.Calculate(ctx, mandate.NewCharge(now))
+}
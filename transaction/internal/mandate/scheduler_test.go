@@ -0,0 +1,291 @@
+package mandate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	mock_fee "github.com/ShmelJUJ/software-engineering/transaction/internal/fee/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/mandate"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testBatchSize   = 10
+	testMaxAttempts = 3
+)
+
+var (
+	testNow    = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	testPolicy = &model.RetryPolicy{
+		MaxAttempts:    testMaxAttempts,
+		InitialBackoff: time.Hour,
+		MaxBackoff:     24 * time.Hour,
+	}
+)
+
+// leaderElector leads right away, like a replica that always holds the lease.
+type leaderElector struct{}
+
+func (leaderElector) Lead(ctx context.Context, run func(ctx context.Context)) {
+	run(ctx)
+}
+
+func schedulerHelper(t *testing.T, checkInterval time.Duration) (
+	mandate.Scheduler,
+	*mock_repository.MockMandateRepo,
+	*mock_publisher.MockTransactionPublisher,
+	*mock_fee.MockCalculator,
+	*mock_clock.MockClock,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := mock_repository.NewMockMandateRepo(mockCtrl)
+	pub := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+	fees := mock_fee.NewMockCalculator(mockCtrl)
+	clk := mock_clock.NewMockClock(mockCtrl)
+
+	s, err := mandate.NewScheduler(&mandate.Config{
+		CheckInterval:  checkInterval,
+		BatchSize:      testBatchSize,
+		MaxAttempts:    testPolicy.MaxAttempts,
+		InitialBackoff: testPolicy.InitialBackoff,
+		MaxBackoff:     testPolicy.MaxBackoff,
+	}, repo, pub, fees, leaderElector{}, clk, l)
+	require.NoError(t, err)
+
+	return s, repo, pub, fees, clk
+}
+
+func TestSchedule(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	charge := &model.Transaction{
+		ID:       "charge",
+		Currency: "USD",
+		Amount:   999,
+		Status:   model.Processed,
+		Method:   "algorand",
+		Sender: &model.TransactionUser{
+			UserID:   "payer-user",
+			WalletID: "payer-wallet",
+		},
+		Receiver: &model.TransactionUser{
+			UserID:   "receiver-user",
+			WalletID: "receiver-wallet",
+		},
+	}
+
+	processedCharge, err := dto.FromTransactionModel(charge)
+	require.NoError(t, err)
+
+	transactionFee := &model.Fee{
+		Amount: 30,
+		Receiver: &model.TransactionUser{
+			UserID:   "platform-user",
+			WalletID: "platform-wallet",
+		},
+	}
+
+	chargeWithFee := *charge
+	chargeWithFee.Fee = transactionFee.Amount
+	chargeWithFee.FeeReceiver = transactionFee.Receiver
+
+	processedChargeWithFee, err := dto.FromTransactionModel(&chargeWithFee)
+	require.NoError(t, err)
+
+	dueMandate := &model.Mandate{
+		ID:       "due",
+		Currency: "USD",
+		Amount:   999,
+		Method:   "algorand",
+		Status:   model.MandateActive,
+		Payer:    charge.Sender,
+		Receiver: charge.Receiver,
+	}
+
+	outcome := &model.MandateChargeOutcome{
+		MandateID:     "settled",
+		TransactionID: "paid",
+		Status:        model.Succeeded,
+	}
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_repository.MockMandateRepo, *mock_publisher.MockTransactionPublisher, *mock_fee.MockCalculator)
+		expectedErr error
+	}{
+		{
+			name: "Nothing to schedule",
+			mock: func(repo *mock_repository.MockMandateRepo, _ *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return(nil, nil)
+			},
+		},
+		{
+			name: "Settle charges before charging due mandates",
+			mock: func(repo *mock_repository.MockMandateRepo, pub *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				gomock.InOrder(
+					repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return([]*model.MandateChargeOutcome{outcome}, nil),
+					repo.EXPECT().SettleMandateCharge(gomock.Any(), outcome, testNow, testPolicy).Return(&model.Mandate{
+						ID:     "settled",
+						Status: model.MandateActive,
+					}, nil),
+					repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return([]string{"due"}, nil),
+					repo.EXPECT().GetMandate(gomock.Any(), "due").Return(dueMandate, nil),
+					fees.EXPECT().Calculate(gomock.Any(), gomock.Any()).Return(nil, nil),
+					repo.EXPECT().ChargeMandate(gomock.Any(), "due", nil, testNow).Return(charge, nil),
+					pub.EXPECT().PublishProcessedTransaction(processedCharge).Return(nil),
+				)
+			},
+		},
+		{
+			name: "Failed settlement does not stop charging",
+			mock: func(repo *mock_repository.MockMandateRepo, pub *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return([]*model.MandateChargeOutcome{outcome}, nil)
+				repo.EXPECT().SettleMandateCharge(gomock.Any(), outcome, testNow, testPolicy).Return(nil, testErr)
+				repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return([]string{"due"}, nil)
+				repo.EXPECT().GetMandate(gomock.Any(), "due").Return(dueMandate, nil)
+				fees.EXPECT().Calculate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().ChargeMandate(gomock.Any(), "due", nil, testNow).Return(charge, nil)
+				pub.EXPECT().PublishProcessedTransaction(processedCharge).Return(testErr)
+			},
+		},
+		{
+			name: "Mandate changed before it was charged",
+			mock: func(repo *mock_repository.MockMandateRepo, _ *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return([]string{"paused", "due"}, nil)
+				repo.EXPECT().GetMandate(gomock.Any(), "paused").Return(&model.Mandate{
+					ID:       "paused",
+					Status:   model.MandatePaused,
+					Payer:    charge.Sender,
+					Receiver: charge.Receiver,
+				}, nil)
+				repo.EXPECT().GetMandate(gomock.Any(), "due").Return(dueMandate, nil)
+				fees.EXPECT().Calculate(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				repo.EXPECT().ChargeMandate(gomock.Any(), "paused", nil, testNow).
+					Return(nil, repository.NewChargeMandateError("failed to charge mandate", repository.ErrMandateNotDue))
+				repo.EXPECT().ChargeMandate(gomock.Any(), "due", nil, testNow).Return(nil, testErr)
+			},
+		},
+		{
+			name: "Charge mandate with a fee",
+			mock: func(repo *mock_repository.MockMandateRepo, pub *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return([]string{"due"}, nil)
+				repo.EXPECT().GetMandate(gomock.Any(), "due").Return(dueMandate, nil)
+				fees.EXPECT().Calculate(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, transaction *model.Transaction) (*model.Fee, error) {
+						assert.Equal(t, dueMandate.Amount, transaction.Amount)
+						assert.Equal(t, dueMandate.Receiver.UserID, transaction.Receiver.UserID)
+
+						return transactionFee, nil
+					})
+				repo.EXPECT().ChargeMandate(gomock.Any(), "due", transactionFee, testNow).Return(&chargeWithFee, nil)
+				pub.EXPECT().PublishProcessedTransaction(processedChargeWithFee).Return(nil)
+			},
+		},
+		{
+			name: "Failed to calculate fee skips the charge",
+			mock: func(repo *mock_repository.MockMandateRepo, _ *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return([]string{"gone", "due"}, nil)
+				repo.EXPECT().GetMandate(gomock.Any(), "gone").Return(nil, testErr)
+				repo.EXPECT().GetMandate(gomock.Any(), "due").Return(dueMandate, nil)
+				fees.EXPECT().Calculate(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+		},
+		{
+			name: "Failed to get mandate charge outcomes",
+			mock: func(repo *mock_repository.MockMandateRepo, _ *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, testErr)
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "Failed to get due mandates",
+			mock: func(repo *mock_repository.MockMandateRepo, _ *mock_publisher.MockTransactionPublisher, fees *mock_fee.MockCalculator) {
+				repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).Return(nil, testErr)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, repo, pub, fees, clk := schedulerHelper(t, time.Minute)
+
+			clk.EXPECT().NowUTC().Return(testNow)
+			testcase.mock(repo, pub, fees)
+
+			err := s.Schedule(context.Background())
+
+			if testcase.expectedErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	s, repo, _, _, clk := schedulerHelper(t, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	gomock.InOrder(
+		repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, errors.New("test err")),
+		repo.EXPECT().GetMandateChargeOutcomes(gomock.Any(), uint64(testBatchSize)).Return(nil, nil),
+		repo.EXPECT().GetDueMandates(gomock.Any(), testNow, uint64(testBatchSize)).
+			DoAndReturn(func(context.Context, time.Time, uint64) ([]string, error) {
+				cancel()
+
+				return nil, nil
+			}),
+	)
+
+	done := make(chan struct{})
+
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after the context was canceled")
+	}
+}
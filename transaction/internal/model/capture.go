@@ -0,0 +1,46 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Capture methods of a transaction, a manually captured transaction is only held on the payer side when it is accepted.
+const (
+	CaptureAutomatic = "automatic"
+	CaptureManual    = "manual"
+)
+
+// ErrInvalidCapture is returned when a capture does not take a positive part of the held amount,
+// or takes a part of a transaction that is captured in full only.
+var ErrInvalidCapture = errors.New("invalid capture")
+
+// CaptureMethod returns how the payment of the transaction is taken, CaptureAutomatic or CaptureManual.
+func (transaction *Transaction) CaptureMethod() string {
+	if transaction.ManualCapture {
+		return CaptureManual
+	}
+
+	return CaptureAutomatic
+}
+
+// CaptureAmount returns the amount a capture of amount takes out of the hold, the whole amount when amount is zero.
+// Split and quoted transactions are captured in full only, their legs and their converted amount are locked at accept.
+func (transaction *Transaction) CaptureAmount(amount int64) (int64, error) {
+	switch {
+	case amount == 0:
+		return transaction.Amount, nil
+	case amount < 0 || amount > transaction.Amount:
+		return 0, fmt.Errorf("%w: %d of %d held", ErrInvalidCapture, amount, transaction.Amount)
+	case amount < transaction.Amount && (len(transaction.Legs) != 0 || transaction.Quote != nil):
+		return 0, fmt.Errorf("%w: split and quoted transactions are captured in full", ErrInvalidCapture)
+	}
+
+	return amount, nil
+}
+
+// HoldExpired reports whether the hold of an authorized transaction timed out at the given time.
+func (transaction *Transaction) HoldExpired(now time.Time) bool {
+	return transaction.Status == Authorized && transaction.AuthorizedUntil != nil && !now.Before(*transaction.AuthorizedUntil)
+}
@@ -0,0 +1,197 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureAmount(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		transaction    *model.Transaction
+		amount         int64
+		expectedAmount int64
+		expectedErr    error
+	}{
+		{
+			name:           "Full capture by default",
+			transaction:    &model.Transaction{Amount: 1000},
+			expectedAmount: 1000,
+		},
+		{
+			name:           "Partial capture",
+			transaction:    &model.Transaction{Amount: 1000},
+			amount:         250,
+			expectedAmount: 250,
+		},
+		{
+			name:        "Capture above the hold",
+			transaction: &model.Transaction{Amount: 1000},
+			amount:      1001,
+			expectedErr: model.ErrInvalidCapture,
+		},
+		{
+			name:        "Negative capture",
+			transaction: &model.Transaction{Amount: 1000},
+			amount:      -1,
+			expectedErr: model.ErrInvalidCapture,
+		},
+		{
+			name: "Full capture of a split transaction",
+			transaction: &model.Transaction{
+				Amount: 1000,
+				Legs:   []*model.TransactionLeg{{}, {}},
+			},
+			amount:         1000,
+			expectedAmount: 1000,
+		},
+		{
+			name: "Partial capture of a split transaction",
+			transaction: &model.Transaction{
+				Amount: 1000,
+				Legs:   []*model.TransactionLeg{{}, {}},
+			},
+			amount:      500,
+			expectedErr: model.ErrInvalidCapture,
+		},
+		{
+			name: "Partial capture of a quoted transaction",
+			transaction: &model.Transaction{
+				Amount: 1000,
+				Quote:  &model.Quote{},
+			},
+			amount:      500,
+			expectedErr: model.ErrInvalidCapture,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			amount, err := testcase.transaction.CaptureAmount(testcase.amount)
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedAmount, amount)
+		})
+	}
+}
+
+func TestHoldExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+
+	testcases := []struct {
+		name        string
+		transaction *model.Transaction
+		expected    bool
+	}{
+		{
+			name:        "Hold in time",
+			transaction: &model.Transaction{Status: model.Authorized, AuthorizedUntil: &future},
+		},
+		{
+			name:        "Hold timed out",
+			transaction: &model.Transaction{Status: model.Authorized, AuthorizedUntil: &past},
+			expected:    true,
+		},
+		{
+			name:        "Hold timing out now",
+			transaction: &model.Transaction{Status: model.Authorized, AuthorizedUntil: &now},
+			expected:    true,
+		},
+		{
+			name:        "Captured transaction",
+			transaction: &model.Transaction{Status: model.Processed, AuthorizedUntil: &past},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, testcase.transaction.HoldExpired(now))
+		})
+	}
+}
+
+func TestCreateManualCaptureTransaction(t *testing.T) {
+	t.Parallel()
+
+	userID := strfmt.UUID("9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60")
+	walletID := strfmt.UUID("c4e8a1b2-7d6f-4e3a-9c5b-0a1b2c3d4e5f")
+
+	testcases := []struct {
+		name           string
+		captureMethod  *string
+		shares         []*dto.CreateTransactionShareRequest
+		expectedManual bool
+		expectedErr    error
+	}{
+		{
+			name: "Automatic capture by default",
+		},
+		{
+			name:          "Automatic capture",
+			captureMethod: swag.String(model.CaptureAutomatic),
+		},
+		{
+			name:           "Manual capture",
+			captureMethod:  swag.String(model.CaptureManual),
+			expectedManual: true,
+		},
+		{
+			name:          "Manual capture of a group transaction",
+			captureMethod: swag.String(model.CaptureManual),
+			shares: []*dto.CreateTransactionShareRequest{
+				{Amount: swag.Int64(500)},
+				{Amount: swag.Int64(500)},
+			},
+			expectedErr: model.ErrInvalidCapture,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transaction, err := model.FromCreateTransactionDTO(&dto.CreateTransactionRequest{
+				MoneyInfo: &dto.MoneyInfo{
+					Amount:   swag.Int64(1000),
+					Currency: swag.String("USD"),
+					Method:   swag.String("algorand"),
+				},
+				Receiver: &dto.CreateTransactionUserRequest{
+					UserID:   &userID,
+					WalletID: &walletID,
+				},
+				Shares:        testcase.shares,
+				CaptureMethod: testcase.captureMethod,
+			})
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.expectedManual, transaction.ManualCapture)
+				assert.Equal(t, transaction.CaptureMethod(), transaction.ToGetTransactionDTO().CaptureMethod)
+			}
+		})
+	}
+}
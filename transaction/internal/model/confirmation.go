@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type ConfirmationMethod string
+
+const (
+	// CodeConfirmation confirms a transaction with a one-time code delivered to the payer.
+	CodeConfirmation ConfirmationMethod = "code"
+	// SignatureConfirmation confirms a transaction with a payer wallet signature over the transaction hash.
+	SignatureConfirmation ConfirmationMethod = "signature"
+)
+
+// Represents how the pending payer confirmation is stored in the database.
+type Confirmation struct {
+	TransactionID   string             `db:"transaction_id"`
+	Method          ConfirmationMethod `db:"method"`
+	CodeHash        string             `db:"code_hash"`
+	TransactionHash string             `db:"transaction_hash"`
+	Attempts        int                `db:"attempts"`
+	ExpiresAt       time.Time          `db:"expires_at"`
+	CreatedAt       time.Time          `db:"created_at"`
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/pkg/qr/emvco"
+)
+
+const (
+	// EMVCoMerchantAccountTag is the merchant account template that carries the transaction receiver.
+	EMVCoMerchantAccountTag = "26"
+	// EMVCoGloballyUniqueID identifies the service inside the merchant account template.
+	EMVCoGloballyUniqueID = "com.qr-payment"
+
+	emvcoReceiverUserTag   = "01"
+	emvcoReceiverWalletTag = "02"
+	emvcoMethodTag         = "03"
+)
+
+var (
+	// ErrUnsupportedCurrency is returned when a currency has no ISO 4217 numeric code.
+	ErrUnsupportedCurrency = errors.New("currency is not supported by EMVCo")
+	// ErrForeignEMVCoPayload is returned when a payload does not carry the service merchant account.
+	ErrForeignEMVCoPayload = errors.New("emvco payload is not issued by the service")
+)
+
+// MerchantInfo describes the merchant presenting an EMVCo QR code.
+type MerchantInfo struct {
+	Name         string
+	City         string
+	CountryCode  string
+	CategoryCode string
+	PostalCode   string
+}
+
+// ToEMVCo maps the transaction and its receiver into an EMVCo merchant-presented payload.
+// Static payloads omit the amount and the transaction reference so they can be reused.
+func (transaction *Transaction) ToEMVCo(
+	merchant *MerchantInfo,
+	initiation emvco.PointOfInitiation,
+) (*emvco.Payload, error) {
+	amount, err := transaction.Money()
+	if err != nil {
+		return nil, err
+	}
+
+	currency := amount.Currency()
+	if currency.Numeric == "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, currency.Code)
+	}
+
+	account := emvco.MerchantAccount{
+		GloballyUniqueID: EMVCoGloballyUniqueID,
+		Fields: map[string]string{
+			emvcoMethodTag: transaction.Method,
+		},
+	}
+
+	if transaction.Receiver != nil {
+		account.Fields[emvcoReceiverUserTag] = transaction.Receiver.UserID
+		account.Fields[emvcoReceiverWalletTag] = transaction.Receiver.WalletID
+	}
+
+	payload := &emvco.Payload{
+		PointOfInitiation: initiation,
+		MerchantAccounts: map[string]emvco.MerchantAccount{
+			EMVCoMerchantAccountTag: account,
+		},
+		MerchantCategoryCode: merchant.CategoryCode,
+		TransactionCurrency:  currency.Numeric,
+		CountryCode:          merchant.CountryCode,
+		MerchantName:         merchant.Name,
+		MerchantCity:         merchant.City,
+		PostalCode:           merchant.PostalCode,
+	}
+
+	if initiation == emvco.Dynamic {
+		payload.TransactionAmount = amount.Decimal()
+		payload.AdditionalData = &emvco.AdditionalData{
+			ReferenceLabel: transaction.ID,
+		}
+	}
+
+	return payload, nil
+}
+
+// FromEMVCo restores the transaction and the merchant from an EMVCo payload issued by the service.
+func FromEMVCo(payload *emvco.Payload) (*Transaction, *MerchantInfo, error) {
+	account, ok := payload.MerchantAccounts[EMVCoMerchantAccountTag]
+	if !ok || account.GloballyUniqueID != EMVCoGloballyUniqueID {
+		return nil, nil, ErrForeignEMVCoPayload
+	}
+
+	currency, err := money.LookupNumeric(payload.TransactionCurrency)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrency, payload.TransactionCurrency)
+	}
+
+	transaction := &Transaction{
+		Currency: currency.Code,
+		Method:   account.Fields[emvcoMethodTag],
+	}
+
+	if userID := account.Fields[emvcoReceiverUserTag]; userID != "" {
+		transaction.Receiver = &TransactionUser{
+			UserID:   userID,
+			WalletID: account.Fields[emvcoReceiverWalletTag],
+		}
+		transaction.ReceiverID = userID
+	}
+
+	if payload.TransactionAmount != "" {
+		amount, err := money.Parse(payload.TransactionAmount, currency.Code)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %w", emvco.ErrInvalidValue, err)
+		}
+
+		transaction.Amount = amount.Amount()
+	}
+
+	if payload.AdditionalData != nil {
+		transaction.ID = payload.AdditionalData.ReferenceLabel
+	}
+
+	merchant := &MerchantInfo{
+		Name:         payload.MerchantName,
+		City:         payload.MerchantCity,
+		CountryCode:  payload.CountryCode,
+		CategoryCode: payload.MerchantCategoryCode,
+		PostalCode:   payload.PostalCode,
+	}
+
+	return transaction, merchant, nil
+}
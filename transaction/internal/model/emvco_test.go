@@ -0,0 +1,150 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/qr/emvco"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionEMVCo(t *testing.T) {
+	t.Parallel()
+
+	merchant := &model.MerchantInfo{
+		Name:         "Test Shop",
+		City:         "Moscow",
+		CountryCode:  "RU",
+		CategoryCode: "5411",
+	}
+
+	testcases := []struct {
+		name                string
+		transaction         *model.Transaction
+		initiation          emvco.PointOfInitiation
+		expectedAmount      string
+		expectedTransaction *model.Transaction
+		expectedErr         error
+	}{
+		{
+			name: "Dynamic code",
+			transaction: &model.Transaction{
+				ID:         "test-transaction-id",
+				ReceiverID: "test-user-id",
+				Currency:   "RUB",
+				Amount:     150005,
+				Method:     "algorand",
+				Receiver: &model.TransactionUser{
+					UserID:   "test-user-id",
+					WalletID: "test-wallet-id",
+				},
+			},
+			initiation:     emvco.Dynamic,
+			expectedAmount: "1500.05",
+			expectedTransaction: &model.Transaction{
+				ID:         "test-transaction-id",
+				ReceiverID: "test-user-id",
+				Currency:   "RUB",
+				Amount:     150005,
+				Method:     "algorand",
+				Receiver: &model.TransactionUser{
+					UserID:   "test-user-id",
+					WalletID: "test-wallet-id",
+				},
+			},
+		},
+		{
+			name: "Dynamic code in currency without minor units",
+			transaction: &model.Transaction{
+				ID:       "test-transaction-id",
+				Currency: "JPY",
+				Amount:   7,
+				Method:   "algorand",
+			},
+			initiation:     emvco.Dynamic,
+			expectedAmount: "7",
+			expectedTransaction: &model.Transaction{
+				ID:       "test-transaction-id",
+				Currency: "JPY",
+				Amount:   7,
+				Method:   "algorand",
+			},
+		},
+		{
+			name: "Static code omits amount and reference",
+			transaction: &model.Transaction{
+				ID:         "test-transaction-id",
+				ReceiverID: "test-user-id",
+				Currency:   "USD",
+				Amount:     5,
+				Method:     "algorand",
+				Receiver: &model.TransactionUser{
+					UserID:   "test-user-id",
+					WalletID: "test-wallet-id",
+				},
+			},
+			initiation: emvco.Static,
+			expectedTransaction: &model.Transaction{
+				ReceiverID: "test-user-id",
+				Currency:   "USD",
+				Method:     "algorand",
+				Receiver: &model.TransactionUser{
+					UserID:   "test-user-id",
+					WalletID: "test-wallet-id",
+				},
+			},
+		},
+		{
+			name: "Currency without ISO 4217 code",
+			transaction: &model.Transaction{
+				Currency: "ALGO",
+				Amount:   5,
+			},
+			initiation:  emvco.Dynamic,
+			expectedErr: model.ErrUnsupportedCurrency,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payload, err := testcase.transaction.ToEMVCo(merchant, testcase.initiation)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, testcase.expectedAmount, payload.TransactionAmount)
+
+			content, err := emvco.Encode(payload)
+			require.NoError(t, err)
+
+			decoded, err := emvco.Decode(content)
+			require.NoError(t, err)
+
+			actualTransaction, actualMerchant, err := model.FromEMVCo(decoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, testcase.expectedTransaction, actualTransaction)
+			assert.Equal(t, merchant, actualMerchant)
+		})
+	}
+}
+
+func TestFromEMVCoForeignPayload(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := model.FromEMVCo(&emvco.Payload{
+		PointOfInitiation: emvco.Static,
+		MerchantAccounts: map[string]emvco.MerchantAccount{
+			model.EMVCoMerchantAccountTag: {GloballyUniqueID: "com.example"},
+		},
+		TransactionCurrency: "643",
+	})
+	assert.ErrorIs(t, err, model.ErrForeignEMVCoPayload)
+}
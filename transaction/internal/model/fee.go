@@ -0,0 +1,292 @@
+package model
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidFeePlan is returned when a fee plan has a negative amount, a percentage above 100
+// or a minimal fee above its maximal fee, or two tiers start at the same volume.
+var ErrInvalidFeePlan = errors.New("fee plan is not valid")
+
+// Represents how the fee plan of a merchant for a payment method and currency is stored in the database.
+// Amounts are counted in the minor unit of Currency. Tiers are ordered by FromVolume.
+type FeePlan struct {
+	ID            string    `db:"fee_plan_id"`
+	MerchantID    string    `db:"merchant_id"`
+	Method        string    `db:"method"`
+	Currency      string    `db:"currency"`
+	FixedAmount   int64     `db:"fixed_amount"`
+	PercentageBps int64     `db:"percentage_bps"`
+	MinFee        *int64    `db:"min_fee"`
+	MaxFee        *int64    `db:"max_fee"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+
+	Tiers []*FeeTier `db:"-"`
+}
+
+// Represents how a volume tier of a fee plan is stored in the database.
+// PercentageBps replaces the plan percentage once the monthly volume of the merchant reaches FromVolume.
+type FeeTier struct {
+	FeePlanID     string `db:"fee_plan_id"`
+	FromVolume    int64  `db:"from_volume"`
+	PercentageBps int64  `db:"percentage_bps"`
+}
+
+// Fee is the fee charged on a transaction when it is accepted, deducted from what its receiver gets
+// and paid to the platform wallet of Receiver.
+type Fee struct {
+	Amount   int64
+	Receiver *TransactionUser
+}
+
+// FromSetFeePlanDTO creates a FeePlan from a SetFeePlanRequest DTO.
+// The currency code must be registered in money, it is stored in its canonical form.
+func FromSetFeePlanDTO(planDTO *dto.SetFeePlanRequest) (*FeePlan, error) {
+	if planDTO == nil || planDTO.MerchantID == nil || planDTO.Method == nil || planDTO.Currency == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	currency, err := money.LookupCurrency(*planDTO.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	plan := &FeePlan{
+		ID:         uuid.NewString(),
+		MerchantID: planDTO.MerchantID.String(),
+		Method:     *planDTO.Method,
+		Currency:   currency.Code,
+		MinFee:     planDTO.MinFee,
+		MaxFee:     planDTO.MaxFee,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if planDTO.FixedAmount != nil {
+		plan.FixedAmount = *planDTO.FixedAmount
+	}
+
+	if planDTO.Percentage != "" {
+		if plan.PercentageBps, err = parseFeePercentage(planDTO.Percentage); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, tierDTO := range planDTO.Tiers {
+		if tierDTO == nil || tierDTO.FromVolume == nil || tierDTO.Percentage == nil {
+			return nil, ErrIncompleteRequest
+		}
+
+		percentageBps, err := parseFeePercentage(*tierDTO.Percentage)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Tiers = append(plan.Tiers, &FeeTier{
+			FeePlanID:     plan.ID,
+			FromVolume:    *tierDTO.FromVolume,
+			PercentageBps: percentageBps,
+		})
+	}
+
+	sort.Slice(plan.Tiers, func(i, j int) bool {
+		return plan.Tiers[i].FromVolume < plan.Tiers[j].FromVolume
+	})
+
+	if err := plan.validate(); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (plan *FeePlan) validate() error {
+	if plan.FixedAmount < 0 ||
+		(plan.MinFee != nil && *plan.MinFee < 0) ||
+		(plan.MaxFee != nil && *plan.MaxFee < 0) ||
+		(plan.MinFee != nil && plan.MaxFee != nil && *plan.MinFee > *plan.MaxFee) {
+		return ErrInvalidFeePlan
+	}
+
+	for i, tier := range plan.Tiers {
+		if tier.FromVolume <= 0 || (i > 0 && tier.FromVolume == plan.Tiers[i-1].FromVolume) {
+			return ErrInvalidFeePlan
+		}
+	}
+
+	return nil
+}
+
+// Fee calculates the fee of a transaction amount given the monthly volume of the merchant.
+// It is the fixed amount plus the percentage of the highest tier the volume reached rounded down,
+// kept between the minimal and maximal fee and never above the amount itself.
+func (plan *FeePlan) Fee(amount, volume int64) int64 {
+	percentageBps := plan.PercentageBps
+
+	for _, tier := range plan.Tiers {
+		if volume < tier.FromVolume {
+			break
+		}
+
+		percentageBps = tier.PercentageBps
+	}
+
+	percentage := new(big.Int).Mul(big.NewInt(amount), big.NewInt(percentageBps))
+	fee := plan.FixedAmount + percentage.Quo(percentage, big.NewInt(basisPointsPerWhole)).Int64()
+
+	if plan.MinFee != nil && fee < *plan.MinFee {
+		fee = *plan.MinFee
+	}
+
+	if plan.MaxFee != nil && fee > *plan.MaxFee {
+		fee = *plan.MaxFee
+	}
+
+	return max(min(fee, amount), 0)
+}
+
+// ToFeePlanDTO converts a FeePlan to a FeePlanResponse DTO.
+func (plan *FeePlan) ToFeePlanDTO() *dto.FeePlanResponse {
+	percentage := formatPercentage(plan.PercentageBps)
+
+	planResponse := &dto.FeePlanResponse{
+		MerchantID:  (*strfmt.UUID)(&plan.MerchantID),
+		Method:      &plan.Method,
+		Currency:    &plan.Currency,
+		FixedAmount: &plan.FixedAmount,
+		Percentage:  &percentage,
+		MinFee:      plan.MinFee,
+		MaxFee:      plan.MaxFee,
+		Tiers:       make([]*dto.FeePlanTier, 0, len(plan.Tiers)),
+		UpdatedAt:   strfmt.DateTime(plan.UpdatedAt),
+	}
+
+	for _, tier := range plan.Tiers {
+		tierPercentage := formatPercentage(tier.PercentageBps)
+
+		planResponse.Tiers = append(planResponse.Tiers, &dto.FeePlanTier{
+			FromVolume: &tier.FromVolume,
+			Percentage: &tierPercentage,
+		})
+	}
+
+	return planResponse
+}
+
+// parseFeePercentage converts a fee percentage with up to two decimals to basis points, zero is allowed.
+func parseFeePercentage(percentage string) (int64, error) {
+	parsed, err := decimal.NewFromString(percentage)
+	if err != nil {
+		return 0, ErrInvalidFeePlan
+	}
+
+	percentageBps := parsed.Shift(2)
+	if !percentageBps.IsInteger() || percentageBps.IsNegative() ||
+		percentageBps.GreaterThan(decimal.NewFromInt(basisPointsPerWhole)) {
+		return 0, ErrInvalidFeePlan
+	}
+
+	return percentageBps.IntPart(), nil
+}
+
+func formatPercentage(percentageBps int64) string {
+	return decimal.New(percentageBps, -2).String()
+}
+
+// Payouts returns what each leg receives in the payment currency once the fee is deducted from the receiver,
+// followed by the fee. A transaction without legs pays its receiver alone, the captured amount once it is captured.
+// The fee of a quoted transaction is converted in proportion to the quote amount and rounded down,
+// it never exceeds what the receiver gets.
+func (transaction *Transaction) Payouts() ([]money.Money, money.Money, error) {
+	payment, err := transaction.PaymentMoney()
+	if err != nil {
+		return nil, money.Money{}, err
+	}
+
+	var payouts []money.Money
+
+	switch {
+	case len(transaction.Legs) != 0:
+		if payouts, err = transaction.PaymentLegs(); err != nil {
+			return nil, money.Money{}, err
+		}
+	case transaction.CapturedAmount != nil:
+		captured, err := money.New(*transaction.CapturedAmount, transaction.Currency)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+
+		payouts = []money.Money{captured}
+	default:
+		payouts = []money.Money{payment}
+	}
+
+	fee := transaction.Fee
+
+	if transaction.Quote != nil && fee != 0 {
+		converted := new(big.Int).Mul(big.NewInt(fee), big.NewInt(payment.Amount()))
+		fee = converted.Quo(converted, big.NewInt(transaction.Amount)).Int64()
+	}
+
+	fee = min(fee, payouts[0].Amount())
+
+	if payouts[0], err = money.New(payouts[0].Amount()-fee, payment.Currency().Code); err != nil {
+		return nil, money.Money{}, err
+	}
+
+	feeMoney, err := money.New(fee, payment.Currency().Code)
+	if err != nil {
+		return nil, money.Money{}, err
+	}
+
+	return payouts, feeMoney, nil
+}
+
+// ToTransactionFeeDTO converts the fee of a Transaction to a TransactionFeeResponse DTO in the payment currency.
+func (transaction *Transaction) ToTransactionFeeDTO() (*dto.TransactionFeeResponse, error) {
+	amount, err := transaction.PaymentMoney()
+	if err != nil {
+		return nil, err
+	}
+
+	payouts, fee, err := transaction.Payouts()
+	if err != nil {
+		return nil, err
+	}
+
+	var received int64
+	for _, payout := range payouts {
+		received += payout.Amount()
+	}
+
+	receiverAmount, err := money.New(received, amount.Currency().Code)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := amount.Currency().Code
+	paymentAmount := amount.Amount()
+	feeAmount := fee.Amount()
+
+	return &dto.TransactionFeeResponse{
+		Currency:              &currency,
+		Amount:                &paymentAmount,
+		AmountDecimal:         amount.Decimal(),
+		Fee:                   &feeAmount,
+		FeeDecimal:            fee.Decimal(),
+		ReceiverAmount:        &received,
+		ReceiverAmountDecimal: receiverAmount.Decimal(),
+	}, nil
+}
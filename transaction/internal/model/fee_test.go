@@ -0,0 +1,323 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSetFeePlanDTO(t *testing.T) {
+	t.Parallel()
+
+	merchantID := strfmt.UUID("9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60")
+
+	tier := func(fromVolume int64, percentage string) *dto.FeePlanTier {
+		return &dto.FeePlanTier{
+			FromVolume: swag.Int64(fromVolume),
+			Percentage: swag.String(percentage),
+		}
+	}
+
+	testcases := []struct {
+		name             string
+		modify           func(*dto.SetFeePlanRequest)
+		expectedPercents []int64
+		expectedErr      error
+	}{
+		{
+			name:             "Tiers are ordered by volume",
+			expectedPercents: []int64{250, 200},
+		},
+		{
+			name: "Zero percentage",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.Percentage = "0"
+				planDTO.Tiers = nil
+			},
+		},
+		{
+			name: "Unknown currency",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.Currency = swag.String("XXX")
+			},
+			expectedErr: money.ErrUnknownCurrency,
+		},
+		{
+			name: "Missing merchant",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.MerchantID = nil
+			},
+			expectedErr: model.ErrIncompleteRequest,
+		},
+		{
+			name: "Tier without percentage",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.Tiers[0].Percentage = nil
+			},
+			expectedErr: model.ErrIncompleteRequest,
+		},
+		{
+			name: "Percentage above 100",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.Percentage = "100.01"
+			},
+			expectedErr: model.ErrInvalidFeePlan,
+		},
+		{
+			name: "Percentage with three decimals",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.Tiers[1].Percentage = swag.String("2.125")
+			},
+			expectedErr: model.ErrInvalidFeePlan,
+		},
+		{
+			name: "Negative fixed amount",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.FixedAmount = swag.Int64(-1)
+			},
+			expectedErr: model.ErrInvalidFeePlan,
+		},
+		{
+			name: "Minimal fee above maximal fee",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.MinFee = swag.Int64(1001)
+			},
+			expectedErr: model.ErrInvalidFeePlan,
+		},
+		{
+			name: "Tiers start at the same volume",
+			modify: func(planDTO *dto.SetFeePlanRequest) {
+				planDTO.Tiers = append(planDTO.Tiers, tier(1000000, "2.4"))
+			},
+			expectedErr: model.ErrInvalidFeePlan,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			planDTO := &dto.SetFeePlanRequest{
+				MerchantID:  &merchantID,
+				Method:      swag.String("algorand"),
+				Currency:    swag.String("usd"),
+				FixedAmount: swag.Int64(30),
+				Percentage:  "2.9",
+				MinFee:      swag.Int64(50),
+				MaxFee:      swag.Int64(1000),
+				Tiers: []*dto.FeePlanTier{
+					tier(10000000, "2"),
+					tier(1000000, "2.5"),
+				},
+			}
+
+			if testcase.modify != nil {
+				testcase.modify(planDTO)
+			}
+
+			plan, err := model.FromSetFeePlanDTO(planDTO)
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, "USD", plan.Currency)
+			assert.Equal(t, merchantID.String(), plan.MerchantID)
+
+			percents := make([]int64, 0, len(plan.Tiers))
+			for _, planTier := range plan.Tiers {
+				assert.Equal(t, plan.ID, planTier.FeePlanID)
+
+				percents = append(percents, planTier.PercentageBps)
+			}
+
+			if testcase.expectedPercents == nil {
+				assert.Empty(t, percents)
+
+				return
+			}
+
+			assert.Equal(t, testcase.expectedPercents, percents)
+			assert.Equal(t, int64(290), plan.PercentageBps)
+		})
+	}
+}
+
+func TestFeePlanFee(t *testing.T) {
+	t.Parallel()
+
+	plan := &model.FeePlan{
+		FixedAmount:   30,
+		PercentageBps: 290,
+		MinFee:        swag.Int64(50),
+		MaxFee:        swag.Int64(1000),
+		Tiers: []*model.FeeTier{
+			{FromVolume: 1000000, PercentageBps: 250},
+			{FromVolume: 10000000, PercentageBps: 200},
+		},
+	}
+
+	testcases := []struct {
+		name        string
+		amount      int64
+		volume      int64
+		expectedFee int64
+	}{
+		{
+			name:        "Base percentage",
+			amount:      10000,
+			expectedFee: 320,
+		},
+		{
+			name:        "Percentage is rounded down",
+			amount:      999,
+			expectedFee: 58,
+		},
+		{
+			name:        "Volume reaches the first tier",
+			amount:      10000,
+			volume:      1000000,
+			expectedFee: 280,
+		},
+		{
+			name:        "Volume above the last tier",
+			amount:      10000,
+			volume:      20000000,
+			expectedFee: 230,
+		},
+		{
+			name:        "Minimal fee",
+			amount:      100,
+			expectedFee: 50,
+		},
+		{
+			name:        "Maximal fee",
+			amount:      1000000,
+			expectedFee: 1000,
+		},
+		{
+			name:        "Fee never exceeds the amount",
+			amount:      40,
+			expectedFee: 40,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedFee, plan.Fee(testcase.amount, testcase.volume))
+		})
+	}
+}
+
+func TestTransactionPayouts(t *testing.T) {
+	t.Parallel()
+
+	legAmount := int64(990)
+	captured := int64(5000)
+
+	testcases := []struct {
+		name             string
+		transaction      *model.Transaction
+		expectedCurrency string
+		expectedPayouts  []int64
+		expectedFee      int64
+	}{
+		{
+			name: "Without fee",
+			transaction: &model.Transaction{
+				Currency: "USD",
+				Amount:   9000,
+			},
+			expectedCurrency: "USD",
+			expectedPayouts:  []int64{9000},
+		},
+		{
+			name: "Fee is deducted from the receiver",
+			transaction: &model.Transaction{
+				Currency: "USD",
+				Amount:   9000,
+				Fee:      100,
+			},
+			expectedCurrency: "USD",
+			expectedPayouts:  []int64{8900},
+			expectedFee:      100,
+		},
+		{
+			name: "Fee of a captured transaction",
+			transaction: &model.Transaction{
+				Currency:       "USD",
+				Amount:         9000,
+				CapturedAmount: &captured,
+				Fee:            100,
+			},
+			expectedCurrency: "USD",
+			expectedPayouts:  []int64{4900},
+			expectedFee:      100,
+		},
+		{
+			name: "Fee never exceeds the receiver leg",
+			transaction: &model.Transaction{
+				Currency: "USD",
+				Amount:   1000,
+				Fee:      50,
+				Legs: []*model.TransactionLeg{
+					{Position: 0},
+					{Position: 1, Amount: &legAmount},
+				},
+			},
+			expectedCurrency: "USD",
+			expectedPayouts:  []int64{0, 990},
+			expectedFee:      10,
+		},
+		{
+			name: "Fee is converted with the quote",
+			transaction: &model.Transaction{
+				Currency: "USD",
+				Amount:   9000,
+				Fee:      90,
+				Quote: &model.Quote{
+					Currency: "ALGO",
+					Amount:   55555555,
+				},
+			},
+			expectedCurrency: "ALGO",
+			expectedPayouts:  []int64{55000000},
+			expectedFee:      555555,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			payouts, fee, err := testcase.transaction.Payouts()
+			require.NoError(t, err)
+
+			amounts := make([]int64, 0, len(payouts))
+			for _, payout := range payouts {
+				assert.Equal(t, testcase.expectedCurrency, payout.Currency().Code)
+
+				amounts = append(amounts, payout.Amount())
+			}
+
+			assert.Equal(t, testcase.expectedPayouts, amounts)
+			assert.Equal(t, testcase.expectedFee, fee.Amount())
+			assert.Equal(t, testcase.expectedCurrency, fee.Currency().Code)
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package model
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const basisPointsPerWhole = 10000
+
+// ErrInvalidSplit is returned when the transaction legs do not split the amount into positive parts.
+var ErrInvalidSplit = errors.New("transaction legs do not split the amount")
+
+// Represents how a receiver leg of a split transaction is stored in the database.
+// A leg gets either a fixed Amount, counted in the minor unit of the transaction currency,
+// or ShareBps basis points of the transaction amount rounded down.
+// The leg with neither, the transaction receiver at position 0, gets the remainder.
+type TransactionLeg struct {
+	ID            string    `db:"transaction_leg_id"`
+	TransactionID string    `db:"transaction_id"`
+	ReceiverID    string    `db:"receiver_id"`
+	Position      int       `db:"position"`
+	Amount        *int64    `db:"amount"`
+	ShareBps      *int64    `db:"share_bps"`
+	CreatedAt     time.Time `db:"created_at"`
+
+	Receiver *TransactionUser `db:"-"`
+}
+
+// newRemainderLeg creates the leg of the transaction receiver, which gets the remainder of the amount.
+func newRemainderLeg(transaction *Transaction) *TransactionLeg {
+	return &TransactionLeg{
+		ID:            uuid.NewString(),
+		TransactionID: transaction.ID,
+		ReceiverID:    transaction.ReceiverID,
+		CreatedAt:     transaction.CreatedAt,
+
+		Receiver: transaction.Receiver,
+	}
+}
+
+// FromCreateTransactionLegDTO creates a TransactionLeg from a CreateTransactionLegRequest DTO.
+// Exactly one of the amount and the percentage must be set.
+func FromCreateTransactionLegDTO(transaction *Transaction, position int, legDTO *dto.CreateTransactionLegRequest) (*TransactionLeg, error) {
+	if legDTO == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	receiver := FromCreateTransactionUserDTO(legDTO.Receiver)
+	if receiver == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	leg := &TransactionLeg{
+		ID:            uuid.NewString(),
+		TransactionID: transaction.ID,
+		ReceiverID:    receiver.ID,
+		Position:      position,
+		CreatedAt:     transaction.CreatedAt,
+
+		Receiver: receiver,
+	}
+
+	switch {
+	case legDTO.Amount != 0 && legDTO.Percentage != "":
+		return nil, ErrInvalidSplit
+	case legDTO.Amount != 0:
+		leg.Amount = &legDTO.Amount
+	case legDTO.Percentage != "":
+		shareBps, err := parsePercentage(legDTO.Percentage)
+		if err != nil {
+			return nil, err
+		}
+
+		leg.ShareBps = &shareBps
+	default:
+		return nil, ErrIncompleteRequest
+	}
+
+	return leg, nil
+}
+
+// ToGetTransactionLegDTO converts a TransactionLeg with its resolved amount to a GetTransactionLegResponse DTO.
+func (leg *TransactionLeg) ToGetTransactionLegDTO(amount int64) *dto.GetTransactionLegResponse {
+	legResponse := &dto.GetTransactionLegResponse{
+		Amount: &amount,
+	}
+
+	if leg.Receiver != nil {
+		legResponse.Receiver = leg.Receiver.ToGetTransactionUserDTO()
+	}
+
+	if leg.ShareBps != nil {
+		legResponse.Percentage = decimal.New(*leg.ShareBps, -2).String()
+	}
+
+	return legResponse
+}
+
+func (leg *TransactionLeg) remainder() bool {
+	return leg.Amount == nil && leg.ShareBps == nil
+}
+
+// parsePercentage converts a percentage with up to two decimals to basis points.
+func parsePercentage(percentage string) (int64, error) {
+	parsed, err := decimal.NewFromString(percentage)
+	if err != nil {
+		return 0, ErrInvalidSplit
+	}
+
+	shareBps := parsed.Shift(2)
+	if !shareBps.IsInteger() || !shareBps.IsPositive() || shareBps.GreaterThan(decimal.NewFromInt(basisPointsPerWhole)) {
+		return 0, ErrInvalidSplit
+	}
+
+	return shareBps.IntPart(), nil
+}
+
+// resolveLegs splits the total between the legs, the remainder leg gets what the others leave.
+func resolveLegs(total int64, legs []*TransactionLeg) ([]int64, error) {
+	amounts := make([]int64, len(legs))
+	remainderLeg := -1
+
+	var allotted int64
+
+	for i, leg := range legs {
+		switch {
+		case leg.Amount != nil:
+			amounts[i] = *leg.Amount
+		case leg.ShareBps != nil:
+			// Split the multiplication so that it can not overflow.
+			amounts[i] = total/basisPointsPerWhole*(*leg.ShareBps) + total%basisPointsPerWhole*(*leg.ShareBps)/basisPointsPerWhole
+		default:
+			if remainderLeg != -1 {
+				return nil, ErrInvalidSplit
+			}
+
+			remainderLeg = i
+
+			continue
+		}
+
+		if amounts[i] <= 0 || amounts[i] > total-allotted {
+			return nil, ErrInvalidSplit
+		}
+
+		allotted += amounts[i]
+	}
+
+	if remainderLeg == -1 || total-allotted <= 0 {
+		return nil, ErrInvalidSplit
+	}
+
+	amounts[remainderLeg] = total - allotted
+
+	return amounts, nil
+}
+
+// LegAmounts returns the amount of each leg in the minor unit of the transaction currency.
+func (transaction *Transaction) LegAmounts() ([]int64, error) {
+	return resolveLegs(transaction.Amount, transaction.Legs)
+}
+
+// PaymentLegs returns the amount each leg receives in the payment currency.
+// Quoted amounts are distributed in proportion to the legs, the remainder leg gets the rounding difference.
+func (transaction *Transaction) PaymentLegs() ([]money.Money, error) {
+	amounts, err := transaction.LegAmounts()
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := transaction.PaymentMoney()
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.Quote != nil {
+		amounts, err = distribute(payment.Amount(), transaction.Amount, amounts, transaction.Legs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	legs := make([]money.Money, len(amounts))
+
+	for i, amount := range amounts {
+		legs[i], err = money.New(amount, payment.Currency().Code)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return legs, nil
+}
+
+// distribute scales the leg amounts from the total to the payment amount.
+func distribute(payment, total int64, amounts []int64, legs []*TransactionLeg) ([]int64, error) {
+	distributed := make([]int64, len(amounts))
+	remainder := payment
+	remainderLeg := 0
+
+	for i, amount := range amounts {
+		if legs[i].remainder() {
+			remainderLeg = i
+
+			continue
+		}
+
+		share := new(big.Int).Mul(big.NewInt(payment), big.NewInt(amount))
+		distributed[i] = share.Quo(share, big.NewInt(total)).Int64()
+
+		if distributed[i] <= 0 {
+			return nil, ErrInvalidSplit
+		}
+
+		remainder -= distributed[i]
+	}
+
+	if remainder <= 0 {
+		return nil, ErrInvalidSplit
+	}
+
+	distributed[remainderLeg] = remainder
+
+	return distributed, nil
+}
@@ -0,0 +1,226 @@
+package model_test
+
+import (
+	"testing"
+
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTransactionLegs(t *testing.T) {
+	t.Parallel()
+
+	userID := strfmt.UUID("9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60")
+	walletID := strfmt.UUID("c4e8a1b2-7d6f-4e3a-9c5b-0a1b2c3d4e5f")
+	receiver := &dto.CreateTransactionUserRequest{
+		UserID:   &userID,
+		WalletID: &walletID,
+	}
+
+	testcases := []struct {
+		name            string
+		amount          int64
+		legs            []*dto.CreateTransactionLegRequest
+		expectedAmounts []int64
+		expectedErr     error
+	}{
+		{
+			name:   "Not split",
+			amount: 1000,
+		},
+		{
+			name:   "Fixed platform fee",
+			amount: 1000,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Amount: 30},
+			},
+			expectedAmounts: []int64{970, 30},
+		},
+		{
+			name:   "Percentage is rounded down",
+			amount: 999,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Percentage: "2.5"},
+				{Receiver: receiver, Percentage: "10"},
+			},
+			expectedAmounts: []int64{876, 24, 99},
+		},
+		{
+			name:   "Legs take the whole amount",
+			amount: 1000,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Percentage: "100"},
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+		{
+			name:   "Legs exceed the amount",
+			amount: 1000,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Amount: 600},
+				{Receiver: receiver, Amount: 600},
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+		{
+			name:   "Percentage rounds down to nothing",
+			amount: 10,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Percentage: "1"},
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+		{
+			name:   "Percentage with three decimals",
+			amount: 1000,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Percentage: "2.125"},
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+		{
+			name:   "Both amount and percentage",
+			amount: 1000,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Amount: 10, Percentage: "1"},
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+		{
+			name:   "Neither amount nor percentage",
+			amount: 1000,
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver},
+			},
+			expectedErr: model.ErrIncompleteRequest,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transaction, err := model.FromCreateTransactionDTO(&dto.CreateTransactionRequest{
+				MoneyInfo: &dto.MoneyInfo{
+					Amount:   swag.Int64(testcase.amount),
+					Currency: swag.String("USD"),
+					Method:   swag.String("algorand"),
+				},
+				Receiver: receiver,
+				Legs:     testcase.legs,
+			})
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			if testcase.expectedAmounts == nil {
+				assert.Empty(t, transaction.Legs)
+
+				return
+			}
+
+			amounts, err := transaction.LegAmounts()
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectedAmounts, amounts)
+			assert.Equal(t, transaction.ReceiverID, transaction.Legs[0].ReceiverID)
+
+			legResponses := transaction.ToGetTransactionDTO().Legs
+			require.Len(t, legResponses, len(testcase.expectedAmounts))
+
+			for i, legResponse := range legResponses {
+				assert.Equal(t, testcase.expectedAmounts[i], *legResponse.Amount)
+
+				if i > 0 {
+					assert.Equal(t, testcase.legs[i-1].Percentage, legResponse.Percentage)
+				}
+			}
+		})
+	}
+}
+
+func TestTransactionPaymentLegs(t *testing.T) {
+	t.Parallel()
+
+	fee := int64(250)
+	share := int64(1000)
+
+	testcases := []struct {
+		name             string
+		quote            *model.Quote
+		expectedCurrency string
+		expectedAmounts  []int64
+		expectedErr      error
+	}{
+		{
+			name:             "Paid in the transaction currency",
+			expectedCurrency: "USD",
+			expectedAmounts:  []int64{7850, 250, 900},
+		},
+		{
+			name: "Quote is distributed in proportion",
+			quote: &model.Quote{
+				Currency: "ALGO",
+				Amount:   55555555,
+			},
+			expectedCurrency: "ALGO",
+			expectedAmounts:  []int64{48456791, 1543209, 5555555},
+		},
+		{
+			name: "Quote too small to split",
+			quote: &model.Quote{
+				Currency: "JPY",
+				Amount:   3,
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transaction := &model.Transaction{
+				Currency: "USD",
+				Amount:   9000,
+				Quote:    testcase.quote,
+				Legs: []*model.TransactionLeg{
+					{Position: 0},
+					{Position: 1, Amount: &fee},
+					{Position: 2, ShareBps: &share},
+				},
+			}
+
+			legs, err := transaction.PaymentLegs()
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			require.Len(t, legs, len(testcase.expectedAmounts))
+
+			var total int64
+
+			for i, leg := range legs {
+				assert.Equal(t, testcase.expectedCurrency, leg.Currency().Code)
+				assert.Equal(t, testcase.expectedAmounts[i], leg.Amount())
+
+				total += leg.Amount()
+			}
+
+			payment, err := transaction.PaymentMoney()
+			require.NoError(t, err)
+			assert.Equal(t, payment.Amount(), total)
+		})
+	}
+}
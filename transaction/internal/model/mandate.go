@@ -0,0 +1,469 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+type MandateInterval int
+
+const (
+	UndefinedInterval MandateInterval = iota
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+func (mi MandateInterval) String() string {
+	switch mi {
+	case Daily:
+		return "day"
+	case Weekly:
+		return "week"
+	case Monthly:
+		return "month"
+	case Yearly:
+		return "year"
+	default:
+		return "undefined"
+	}
+}
+
+func parseMandateInterval(interval string) (MandateInterval, error) {
+	switch interval {
+	case "day":
+		return Daily, nil
+	case "week":
+		return Weekly, nil
+	case "month":
+		return Monthly, nil
+	case "year":
+		return Yearly, nil
+	default:
+		return UndefinedInterval, ErrInvalidMandate
+	}
+}
+
+type MandateStatus int
+
+const (
+	UndefinedMandate MandateStatus = iota
+	MandateActive
+	MandatePastDue
+	MandatePaused
+	MandateUnpaid
+	MandateCanceled
+	MandateCompleted
+)
+
+func (ms MandateStatus) String() string {
+	switch ms {
+	case MandateActive:
+		return "active"
+	case MandatePastDue:
+		return "past_due"
+	case MandatePaused:
+		return "paused"
+	case MandateUnpaid:
+		return "unpaid"
+	case MandateCanceled:
+		return "canceled"
+	case MandateCompleted:
+		return "completed"
+	default:
+		return "undefined"
+	}
+}
+
+var (
+	// ErrInvalidMandate is returned when the mandate schedule is not valid.
+	ErrInvalidMandate = errors.New("mandate schedule is not valid")
+	// ErrMandateTransition is returned when the mandate status does not allow the requested change.
+	ErrMandateTransition = errors.New("mandate status does not allow the change")
+)
+
+// Represents how the recurring payment mandate is stored in the database.
+// The payer authorises once to pay Amount every IntervalCount intervals until EndsAt.
+// PeriodStart is the billing date that is charged next, NextChargeAt is when it is attempted,
+// it is nil while ChargeTransactionID is being paid or the mandate is not charged.
+type Mandate struct {
+	ID                  string          `db:"mandate_id"`
+	PayerID             string          `db:"payer_id"`
+	ReceiverID          string          `db:"receiver_id"`
+	Currency            string          `db:"currency"`
+	Amount              int64           `db:"amount"`
+	Method              string          `db:"method"`
+	Interval            MandateInterval `db:"billing_interval"`
+	IntervalCount       int32           `db:"interval_count"`
+	StartsAt            time.Time       `db:"starts_at"`
+	EndsAt              time.Time       `db:"ends_at"`
+	Status              MandateStatus   `db:"status"`
+	PeriodStart         time.Time       `db:"period_start"`
+	NextChargeAt        *time.Time      `db:"next_charge_at"`
+	Attempts            int32           `db:"attempts"`
+	ChargeTransactionID *string         `db:"charge_transaction_id"`
+	LastError           string          `db:"last_error"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
+
+	Payer    *TransactionUser `db:"-"`
+	Receiver *TransactionUser `db:"-"`
+}
+
+// MandateChargeOutcome is the final status of the transaction a mandate was charged with.
+type MandateChargeOutcome struct {
+	MandateID     string            `db:"mandate_id"`
+	TransactionID string            `db:"transaction_id"`
+	Status        TransactionStatus `db:"status"`
+	Reason        string            `db:"canceled_reason"`
+}
+
+// RetryPolicy describes how failed mandate charges are retried before the mandate is unpaid.
+type RetryPolicy struct {
+	// MaxAttempts is the number of failed charges of a period after which the mandate is unpaid.
+	MaxAttempts int32
+	// InitialBackoff is the delay before the first retry, every next retry waits twice as long.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+}
+
+// Backoff returns the delay before the retry that follows the given number of failed attempts.
+func (policy *RetryPolicy) Backoff(attempts int32) time.Duration {
+	delay := policy.InitialBackoff
+
+	for i := int32(1); i < attempts && delay < policy.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, policy.MaxBackoff)
+}
+
+// FromCreateMandateDTO creates an active Mandate of the payer from a CreateMandateRequest DTO.
+// A mandate without a start date, or with one in the past, is charged right away.
+func FromCreateMandateDTO(payerID string, mandateDTO *dto.CreateMandateRequest) (*Mandate, error) {
+	if mandateDTO == nil || mandateDTO.PayerWalletID == nil || mandateDTO.MoneyInfo == nil ||
+		mandateDTO.MoneyInfo.Amount == nil || mandateDTO.MoneyInfo.Currency == nil ||
+		mandateDTO.MoneyInfo.Method == nil || mandateDTO.Interval == nil || mandateDTO.EndsAt == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	receiver := FromCreateTransactionUserDTO(mandateDTO.Receiver)
+	if receiver == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	payer := &TransactionUser{
+		ID:        uuid.NewString(),
+		UserID:    payerID,
+		WalletID:  mandateDTO.PayerWalletID.String(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	amount, err := money.New(*mandateDTO.MoneyInfo.Amount, *mandateDTO.MoneyInfo.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount.Amount() <= 0 {
+		return nil, ErrInvalidMandate
+	}
+
+	interval, err := parseMandateInterval(*mandateDTO.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	intervalCount := mandateDTO.IntervalCount
+	if intervalCount == 0 {
+		intervalCount = 1
+	}
+
+	if intervalCount < 0 {
+		return nil, ErrInvalidMandate
+	}
+
+	now := time.Now().UTC()
+
+	startsAt := time.Time(mandateDTO.StartsAt).UTC()
+	if startsAt.Before(now) {
+		startsAt = now
+	}
+
+	endsAt := time.Time(*mandateDTO.EndsAt).UTC()
+	if !endsAt.After(startsAt) {
+		return nil, ErrInvalidMandate
+	}
+
+	return &Mandate{
+		ID:            uuid.NewString(),
+		PayerID:       payer.ID,
+		ReceiverID:    receiver.ID,
+		Currency:      amount.Currency().Code,
+		Amount:        amount.Amount(),
+		Method:        *mandateDTO.MoneyInfo.Method,
+		Interval:      interval,
+		IntervalCount: intervalCount,
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+		Status:        MandateActive,
+		PeriodStart:   startsAt,
+		NextChargeAt:  &startsAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+
+		Payer:    payer,
+		Receiver: receiver,
+	}, nil
+}
+
+// PaidBy reports whether the user is the payer of the mandate.
+func (mandate *Mandate) PaidBy(userID string) bool {
+	return mandate.Payer != nil && mandate.Payer.UserID == userID
+}
+
+// OwnedBy reports whether the user is the payer or the receiver of the mandate.
+func (mandate *Mandate) OwnedBy(userID string) bool {
+	return mandate.PaidBy(userID) || mandate.Receiver != nil && mandate.Receiver.UserID == userID
+}
+
+// ToGetMandateDTO converts a Mandate to a GetMandateResponse DTO.
+func (mandate *Mandate) ToGetMandateDTO() *dto.GetMandateResponse {
+	mandateID := strfmt.UUID(mandate.ID)
+	interval := mandate.Interval.String()
+	status := mandate.Status.String()
+	startsAt := strfmt.DateTime(mandate.StartsAt)
+	endsAt := strfmt.DateTime(mandate.EndsAt)
+
+	mandateResponse := &dto.GetMandateResponse{
+		MandateID: &mandateID,
+		Receiver:  mandate.Receiver.ToGetTransactionUserDTO(),
+		Payer:     mandate.Payer.ToGetTransactionUserDTO(),
+		MoneyInfo: &dto.MoneyInfo{
+			Amount:   &mandate.Amount,
+			Currency: &mandate.Currency,
+			Method:   &mandate.Method,
+		},
+		Interval:      &interval,
+		IntervalCount: &mandate.IntervalCount,
+		StartsAt:      &startsAt,
+		EndsAt:        &endsAt,
+		Status:        &status,
+		PeriodStart:   strfmt.DateTime(mandate.PeriodStart),
+		Attempts:      &mandate.Attempts,
+		LastError:     mandate.LastError,
+	}
+
+	if amount, err := money.New(mandate.Amount, mandate.Currency); err == nil {
+		mandateResponse.AmountDecimal = amount.Decimal()
+	}
+
+	if mandate.NextChargeAt != nil {
+		nextChargeAt := strfmt.DateTime(*mandate.NextChargeAt)
+		mandateResponse.NextChargeAt = &nextChargeAt
+	}
+
+	if mandate.ChargeTransactionID != nil {
+		mandateResponse.ChargeTransactionID = (*strfmt.UUID)(mandate.ChargeTransactionID)
+	}
+
+	return mandateResponse
+}
+
+// advance returns the billing date that follows the given one.
+// Monthly and yearly dates keep the day of the first charge, clamped to the end of shorter months.
+func (mandate *Mandate) advance(from time.Time) time.Time {
+	count := int(mandate.IntervalCount)
+
+	switch mandate.Interval {
+	case Daily:
+		return from.AddDate(0, 0, count)
+	case Weekly:
+		return from.AddDate(0, 0, 7*count)
+	case Yearly:
+		count *= 12
+	}
+
+	month := from.Month() + time.Month(count)
+	lastDay := time.Date(from.Year(), month+1, 0, 0, 0, 0, 0, from.Location()).Day()
+
+	return time.Date(
+		from.Year(), month, min(mandate.StartsAt.Day(), lastDay),
+		from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), from.Location(),
+	)
+}
+
+// schedule sets the next charge to the current billing date, or completes the mandate when it is past the end.
+func (mandate *Mandate) schedule() {
+	if mandate.PeriodStart.After(mandate.EndsAt) {
+		mandate.Status = MandateCompleted
+		mandate.NextChargeAt = nil
+
+		return
+	}
+
+	nextChargeAt := mandate.PeriodStart
+	mandate.NextChargeAt = &nextChargeAt
+}
+
+// Charging reports whether the mandate is charged on schedule.
+func (mandate *Mandate) Charging() bool {
+	return mandate.Status == MandateActive || mandate.Status == MandatePastDue
+}
+
+// Due reports whether the mandate has to be charged by now.
+func (mandate *Mandate) Due(now time.Time) bool {
+	return mandate.Charging() && mandate.ChargeTransactionID == nil &&
+		mandate.NextChargeAt != nil && !mandate.NextChargeAt.After(now)
+}
+
+// NewCharge creates the transaction charging the current period, it is accepted on behalf of the payer.
+// Every transaction gets its own copies of the users like the ones created with CreateTransaction.
+func (mandate *Mandate) NewCharge(now time.Time) *Transaction {
+	receiver := &TransactionUser{
+		ID:        uuid.NewString(),
+		UserID:    mandate.Receiver.UserID,
+		WalletID:  mandate.Receiver.WalletID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	sender := &TransactionUser{
+		ID:        uuid.NewString(),
+		UserID:    mandate.Payer.UserID,
+		WalletID:  mandate.Payer.WalletID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return &Transaction{
+		ID:         uuid.NewString(),
+		SenderID:   &sender.ID,
+		ReceiverID: receiver.ID,
+		Currency:   mandate.Currency,
+		Amount:     mandate.Amount,
+		Status:     Created,
+		Method:     mandate.Method,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+
+		Sender:   sender,
+		Receiver: receiver,
+	}
+}
+
+// Charged records the transaction the current period is being paid with.
+func (mandate *Mandate) Charged(transactionID string, now time.Time) {
+	mandate.ChargeTransactionID = &transactionID
+	mandate.NextChargeAt = nil
+	mandate.UpdatedAt = now
+}
+
+// Settle applies the final status of the charge transaction.
+// A paid period moves the mandate to the next billing date, a failed one is retried
+// with a backoff until the policy runs out of attempts and the mandate is unpaid.
+func (mandate *Mandate) Settle(outcome *MandateChargeOutcome, now time.Time, policy *RetryPolicy) {
+	mandate.ChargeTransactionID = nil
+	mandate.UpdatedAt = now
+
+	if outcome.Status == Succeeded {
+		mandate.Attempts = 0
+		mandate.LastError = ""
+		mandate.PeriodStart = mandate.advance(mandate.PeriodStart)
+
+		if mandate.Status == MandatePastDue {
+			mandate.Status = MandateActive
+		}
+
+		switch {
+		case mandate.Charging():
+			mandate.schedule()
+		case mandate.Status == MandatePaused && mandate.PeriodStart.After(mandate.EndsAt):
+			mandate.Status = MandateCompleted
+		}
+
+		return
+	}
+
+	mandate.LastError = outcome.Reason
+	if mandate.LastError == "" {
+		mandate.LastError = "charge " + outcome.Status.String()
+	}
+
+	if !mandate.Charging() {
+		return
+	}
+
+	mandate.Attempts++
+
+	if mandate.Attempts >= policy.MaxAttempts {
+		mandate.Status = MandateUnpaid
+		mandate.NextChargeAt = nil
+
+		return
+	}
+
+	nextChargeAt := now.Add(policy.Backoff(mandate.Attempts))
+
+	mandate.Status = MandatePastDue
+	mandate.NextChargeAt = &nextChargeAt
+}
+
+// Pause stops charging an active or past due mandate.
+func (mandate *Mandate) Pause(now time.Time) error {
+	if !mandate.Charging() {
+		return ErrMandateTransition
+	}
+
+	mandate.Status = MandatePaused
+	mandate.NextChargeAt = nil
+	mandate.UpdatedAt = now
+
+	return nil
+}
+
+// Resume charges a paused or unpaid mandate again from the first billing date that is not in the past,
+// the periods it missed are not charged.
+func (mandate *Mandate) Resume(now time.Time) error {
+	if mandate.Status != MandatePaused && mandate.Status != MandateUnpaid {
+		return ErrMandateTransition
+	}
+
+	mandate.Status = MandateActive
+	mandate.Attempts = 0
+	mandate.LastError = ""
+	mandate.UpdatedAt = now
+
+	// A charge that is being paid moves the mandate to the next billing date once it is settled.
+	if mandate.ChargeTransactionID != nil {
+		return nil
+	}
+
+	for mandate.PeriodStart.Before(now) {
+		mandate.PeriodStart = mandate.advance(mandate.PeriodStart)
+	}
+
+	mandate.schedule()
+
+	return nil
+}
+
+// Cancel stops charging the mandate for good, a charge that is being paid is still settled.
+func (mandate *Mandate) Cancel(now time.Time) error {
+	if mandate.Status == MandateCanceled || mandate.Status == MandateCompleted {
+		return ErrMandateTransition
+	}
+
+	mandate.Status = MandateCanceled
+	mandate.NextChargeAt = nil
+	mandate.UpdatedAt = now
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = &model.RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Hour,
+	MaxBackoff:     90 * time.Minute,
+}
+
+func newTestMandate(interval model.MandateInterval, startsAt time.Time) *model.Mandate {
+	chargeAt := startsAt
+
+	return &model.Mandate{
+		ID:            "mandate",
+		Currency:      "USD",
+		Amount:        999,
+		Method:        "algorand",
+		Interval:      interval,
+		IntervalCount: 1,
+		StartsAt:      startsAt,
+		EndsAt:        startsAt.AddDate(1, 0, 0),
+		Status:        model.MandateActive,
+		PeriodStart:   startsAt,
+		NextChargeAt:  &chargeAt,
+		Payer:         &model.TransactionUser{UserID: "payer-user", WalletID: "payer-wallet"},
+		Receiver:      &model.TransactionUser{UserID: "receiver-user", WalletID: "receiver-wallet"},
+	}
+}
+
+// payPeriod charges the mandate and settles the charge with the given status.
+func payPeriod(t *testing.T, mandate *model.Mandate, now time.Time, status model.TransactionStatus) {
+	t.Helper()
+
+	require.True(t, mandate.Due(now))
+
+	charge := mandate.NewCharge(now)
+	mandate.Charged(charge.ID, now)
+
+	require.False(t, mandate.Due(now))
+
+	mandate.Settle(&model.MandateChargeOutcome{
+		MandateID:     mandate.ID,
+		TransactionID: charge.ID,
+		Status:        status,
+		Reason:        "insufficient funds",
+	}, now, testRetryPolicy)
+}
+
+func TestMandateBillingDates(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		interval      model.MandateInterval
+		intervalCount int32
+		startsAt      time.Time
+		expectedDates []time.Time
+	}{
+		{
+			name:     "Weekly",
+			interval: model.Weekly,
+			startsAt: time.Date(2024, time.February, 26, 9, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2024, time.March, 4, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 11, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "Monthly keeps the day of the first charge",
+			interval: model.Monthly,
+			startsAt: time.Date(2024, time.January, 31, 9, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 30, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:          "Every third month",
+			interval:      model.Monthly,
+			intervalCount: 3,
+			startsAt:      time.Date(2024, time.November, 30, 9, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2025, time.February, 28, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.May, 30, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:     "Yearly from a leap day",
+			interval: model.Yearly,
+			startsAt: time.Date(2024, time.February, 29, 9, 0, 0, 0, time.UTC),
+			expectedDates: []time.Time{
+				time.Date(2025, time.February, 28, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mandate := newTestMandate(testcase.interval, testcase.startsAt)
+			mandate.EndsAt = testcase.startsAt.AddDate(10, 0, 0)
+
+			if testcase.intervalCount != 0 {
+				mandate.IntervalCount = testcase.intervalCount
+			}
+
+			for _, expectedDate := range testcase.expectedDates {
+				payPeriod(t, mandate, *mandate.NextChargeAt, model.Succeeded)
+
+				assert.Equal(t, expectedDate, mandate.PeriodStart)
+				assert.Equal(t, expectedDate, *mandate.NextChargeAt)
+			}
+		})
+	}
+}
+
+func TestMandateDunning(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	mandate := newTestMandate(model.Monthly, startsAt)
+
+	payPeriod(t, mandate, startsAt, model.Failed)
+
+	assert.Equal(t, model.MandatePastDue, mandate.Status)
+	assert.Equal(t, int32(1), mandate.Attempts)
+	assert.Equal(t, "insufficient funds", mandate.LastError)
+	assert.Equal(t, startsAt.Add(time.Hour), *mandate.NextChargeAt)
+	assert.Equal(t, startsAt, mandate.PeriodStart)
+
+	retryAt := *mandate.NextChargeAt
+	payPeriod(t, mandate, retryAt, model.Expired)
+
+	assert.Equal(t, model.MandatePastDue, mandate.Status)
+	assert.Equal(t, retryAt.Add(90*time.Minute), *mandate.NextChargeAt)
+
+	payPeriod(t, mandate, *mandate.NextChargeAt, model.Failed)
+
+	assert.Equal(t, model.MandateUnpaid, mandate.Status)
+	assert.Nil(t, mandate.NextChargeAt)
+	assert.False(t, mandate.Due(startsAt.AddDate(1, 0, 0)))
+
+	resumedAt := time.Date(2024, time.July, 10, 8, 0, 0, 0, time.UTC)
+	require.NoError(t, mandate.Resume(resumedAt))
+
+	assert.Equal(t, model.MandateActive, mandate.Status)
+	assert.Zero(t, mandate.Attempts)
+	assert.Equal(t, time.Date(2024, time.August, 1, 12, 0, 0, 0, time.UTC), *mandate.NextChargeAt)
+
+	payPeriod(t, mandate, *mandate.NextChargeAt, model.Succeeded)
+
+	assert.Equal(t, model.MandateActive, mandate.Status)
+	assert.Equal(t, time.Date(2024, time.September, 1, 12, 0, 0, 0, time.UTC), *mandate.NextChargeAt)
+}
+
+func TestMandateCompletes(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	mandate := newTestMandate(model.Daily, startsAt)
+	mandate.EndsAt = startsAt.AddDate(0, 0, 1)
+
+	payPeriod(t, mandate, startsAt, model.Succeeded)
+	payPeriod(t, mandate, *mandate.NextChargeAt, model.Succeeded)
+
+	assert.Equal(t, model.MandateCompleted, mandate.Status)
+	assert.Nil(t, mandate.NextChargeAt)
+	assert.ErrorIs(t, mandate.Cancel(startsAt), model.ErrMandateTransition)
+}
+
+func TestMandateTransitions(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	mandate := newTestMandate(model.Monthly, startsAt)
+
+	assert.ErrorIs(t, mandate.Resume(startsAt), model.ErrMandateTransition)
+
+	charge := mandate.NewCharge(startsAt)
+	mandate.Charged(charge.ID, startsAt)
+
+	require.NoError(t, mandate.Pause(startsAt))
+	assert.ErrorIs(t, mandate.Pause(startsAt), model.ErrMandateTransition)
+
+	// The charge that was being paid when the mandate was paused still pays its period.
+	mandate.Settle(&model.MandateChargeOutcome{
+		MandateID:     mandate.ID,
+		TransactionID: charge.ID,
+		Status:        model.Succeeded,
+	}, startsAt, testRetryPolicy)
+
+	assert.Equal(t, model.MandatePaused, mandate.Status)
+	assert.Nil(t, mandate.NextChargeAt)
+
+	require.NoError(t, mandate.Resume(startsAt.AddDate(0, 0, 3)))
+	assert.Equal(t, time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC), *mandate.NextChargeAt)
+
+	require.NoError(t, mandate.Cancel(startsAt))
+	assert.Equal(t, model.MandateCanceled, mandate.Status)
+	assert.False(t, mandate.Due(startsAt.AddDate(0, 1, 0)))
+	assert.ErrorIs(t, mandate.Resume(startsAt), model.ErrMandateTransition)
+}
+
+func TestFromCreateMandateDTO(t *testing.T) {
+	t.Parallel()
+
+	userID := strfmt.UUID("9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60")
+	payerID := "5d1e7f3a-2b4c-4d6e-8f0a-1b2c3d4e5f60"
+	walletID := strfmt.UUID("c4e8a1b2-7d6f-4e3a-9c5b-0a1b2c3d4e5f")
+	startsAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+
+	testcases := []struct {
+		name          string
+		amount        int64
+		interval      string
+		intervalCount int32
+		startsAt      time.Time
+		endsAt        time.Time
+		expectedErr   error
+	}{
+		{
+			name:     "Successfully create mandate",
+			amount:   999,
+			interval: "month",
+			startsAt: startsAt,
+			endsAt:   startsAt.AddDate(1, 0, 0),
+		},
+		{
+			name:        "Unknown interval",
+			amount:      999,
+			interval:    "fortnight",
+			startsAt:    startsAt,
+			endsAt:      startsAt.AddDate(1, 0, 0),
+			expectedErr: model.ErrInvalidMandate,
+		},
+		{
+			name:        "Zero amount",
+			interval:    "month",
+			startsAt:    startsAt,
+			endsAt:      startsAt.AddDate(1, 0, 0),
+			expectedErr: model.ErrInvalidMandate,
+		},
+		{
+			name:          "Negative interval count",
+			amount:        999,
+			interval:      "week",
+			intervalCount: -1,
+			startsAt:      startsAt,
+			endsAt:        startsAt.AddDate(1, 0, 0),
+			expectedErr:   model.ErrInvalidMandate,
+		},
+		{
+			name:        "Ends before it starts",
+			amount:      999,
+			interval:    "day",
+			startsAt:    startsAt,
+			endsAt:      startsAt.Add(-time.Hour),
+			expectedErr: model.ErrInvalidMandate,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			endsAt := strfmt.DateTime(testcase.endsAt)
+
+			mandate, err := model.FromCreateMandateDTO(payerID, &dto.CreateMandateRequest{
+				Receiver:      &dto.CreateTransactionUserRequest{UserID: &userID, WalletID: &walletID},
+				PayerWalletID: &walletID,
+				MoneyInfo: &dto.MoneyInfo{
+					Amount:   swag.Int64(testcase.amount),
+					Currency: swag.String("USD"),
+					Method:   swag.String("algorand"),
+				},
+				Interval:      swag.String(testcase.interval),
+				IntervalCount: testcase.intervalCount,
+				StartsAt:      strfmt.DateTime(testcase.startsAt),
+				EndsAt:        &endsAt,
+			})
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, model.MandateActive, mandate.Status)
+			assert.Equal(t, payerID, mandate.Payer.UserID)
+			assert.Equal(t, walletID.String(), mandate.Payer.WalletID)
+			assert.True(t, mandate.PaidBy(payerID))
+			assert.False(t, mandate.PaidBy(userID.String()))
+			assert.True(t, mandate.OwnedBy(userID.String()))
+			assert.Equal(t, int32(1), mandate.IntervalCount)
+			assert.Equal(t, testcase.startsAt, *mandate.NextChargeAt)
+			assert.Equal(t, "9.99", mandate.ToGetMandateDTO().AmountDecimal)
+		})
+	}
+}
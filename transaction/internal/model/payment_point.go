@@ -0,0 +1,120 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/google/uuid"
+)
+
+// Represents how the static payment point is stored in the database.
+type PaymentPoint struct {
+	ID         string    `db:"payment_point_id"`
+	ReceiverID string    `db:"receiver_id"`
+	Currency   string    `db:"currency"`
+	Method     string    `db:"method"`
+	MinAmount  *int64    `db:"min_amount"`
+	MaxAmount  *int64    `db:"max_amount"`
+	Active     bool      `db:"active"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+
+	Receiver *TransactionUser `db:"-"`
+}
+
+// FromCreatePaymentPointDTO creates a PaymentPoint from a CreatePaymentPointRequest DTO.
+// The currency code must be registered in money, it is stored in its canonical form.
+func FromCreatePaymentPointDTO(paymentPointDTO *dto.CreatePaymentPointRequest) (*PaymentPoint, error) {
+	if paymentPointDTO == nil || paymentPointDTO.Currency == nil || paymentPointDTO.Method == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	receiver := FromCreateTransactionUserDTO(paymentPointDTO.Receiver)
+	if receiver == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	currency, err := money.LookupCurrency(*paymentPointDTO.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaymentPoint{
+		ID:         uuid.NewString(),
+		ReceiverID: receiver.ID,
+		Currency:   currency.Code,
+		Method:     *paymentPointDTO.Method,
+		MinAmount:  paymentPointDTO.MinAmount,
+		MaxAmount:  paymentPointDTO.MaxAmount,
+		Active:     true,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+
+		Receiver: receiver,
+	}, nil
+}
+
+// ToGetPaymentPointDTO converts a PaymentPoint to a GetPaymentPointResponse DTO.
+func (paymentPoint *PaymentPoint) ToGetPaymentPointDTO() *dto.GetPaymentPointResponse {
+	paymentPointResponse := &dto.GetPaymentPointResponse{
+		Receiver:  paymentPoint.Receiver.ToGetTransactionUserDTO(),
+		Currency:  &paymentPoint.Currency,
+		Method:    &paymentPoint.Method,
+		MinAmount: paymentPoint.MinAmount,
+		MaxAmount: paymentPoint.MaxAmount,
+		Active:    &paymentPoint.Active,
+	}
+
+	if paymentPoint.MinAmount != nil {
+		if amount, err := money.New(*paymentPoint.MinAmount, paymentPoint.Currency); err == nil {
+			paymentPointResponse.MinAmountDecimal = amount.Decimal()
+		}
+	}
+
+	if paymentPoint.MaxAmount != nil {
+		if amount, err := money.New(*paymentPoint.MaxAmount, paymentPoint.Currency); err == nil {
+			paymentPointResponse.MaxAmountDecimal = amount.Decimal()
+		}
+	}
+
+	return paymentPointResponse
+}
+
+// AllowsAmount reports whether the payer-entered amount is within the payment point bounds.
+func (paymentPoint *PaymentPoint) AllowsAmount(amount int64) bool {
+	if amount <= 0 {
+		return false
+	}
+
+	if paymentPoint.MinAmount != nil && amount < *paymentPoint.MinAmount {
+		return false
+	}
+
+	return paymentPoint.MaxAmount == nil || amount <= *paymentPoint.MaxAmount
+}
+
+// NewTransaction creates a transaction paying amount to the payment point receiver.
+// Every transaction gets its own copy of the receiver like the ones created with CreateTransaction.
+func (paymentPoint *PaymentPoint) NewTransaction(amount int64) *Transaction {
+	receiver := &TransactionUser{
+		ID:        uuid.NewString(),
+		UserID:    paymentPoint.Receiver.UserID,
+		WalletID:  paymentPoint.Receiver.WalletID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	return &Transaction{
+		ID:         uuid.NewString(),
+		ReceiverID: receiver.ID,
+		Currency:   paymentPoint.Currency,
+		Amount:     amount,
+		Status:     Created,
+		Method:     paymentPoint.Method,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+
+		Receiver: receiver,
+	}
+}
@@ -0,0 +1,54 @@
+package model
+
+import (
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// Represents how the exchange rate quote locked for a transaction is stored in the database.
+// The transaction amount is paid as Amount, counted in the minor unit of the payment Currency.
+// Rate is the price of one major unit of the transaction currency in the payment currency
+// with the margin applied, MidRate is the provider rate it was derived from.
+// The rate is locked for PayerID, the user who requested it, only they can accept the transaction with it.
+type Quote struct {
+	TransactionID string    `db:"transaction_id"`
+	PayerID       string    `db:"payer_id"`
+	Currency      string    `db:"currency"`
+	Amount        int64     `db:"amount"`
+	Rate          string    `db:"rate"`
+	MidRate       string    `db:"mid_rate"`
+	ExpiresAt     time.Time `db:"expires_at"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// Money returns the amount of the quote in the payment currency.
+func (quote *Quote) Money() (money.Money, error) {
+	return money.New(quote.Amount, quote.Currency)
+}
+
+// Expired reports whether the rate is no longer locked at the given time.
+func (quote *Quote) Expired(now time.Time) bool {
+	return !now.Before(quote.ExpiresAt)
+}
+
+// ToTransactionQuoteDTO converts a Quote to a TransactionQuote DTO.
+func (quote *Quote) ToTransactionQuoteDTO() *dto.TransactionQuote {
+	expiresAt := strfmt.DateTime(quote.ExpiresAt)
+
+	quoteResponse := &dto.TransactionQuote{
+		TransactionID: strfmt.UUID(quote.TransactionID),
+		Currency:      &quote.Currency,
+		Amount:        &quote.Amount,
+		Rate:          &quote.Rate,
+		ExpiresAt:     &expiresAt,
+	}
+
+	if amount, err := quote.Money(); err == nil {
+		quoteResponse.AmountDecimal = amount.Decimal()
+	}
+
+	return quoteResponse
+}
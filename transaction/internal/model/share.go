@@ -0,0 +1,159 @@
+package model
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+type ShareStatus int
+
+const (
+	UndefinedShare ShareStatus = iota
+	SharePending
+	ShareProcessed
+	ShareSucceeded
+	ShareCanceled
+	ShareRefunding
+	ShareRefunded
+	ShareRefundFailed
+)
+
+func (ss ShareStatus) String() string {
+	switch ss {
+	case SharePending:
+		return "pending"
+	case ShareProcessed:
+		return "processed"
+	case ShareSucceeded:
+		return "succeeded"
+	case ShareCanceled:
+		return "canceled"
+	case ShareRefunding:
+		return "refunding"
+	case ShareRefunded:
+		return "refunded"
+	case ShareRefundFailed:
+		return "refund_failed"
+	default:
+		return "undefined"
+	}
+}
+
+// ErrInvalidShares is returned when the shares of a group transaction do not add up to its amount.
+var ErrInvalidShares = errors.New("transaction shares do not add up to the amount")
+
+// Represents how a share of a group transaction is stored in the database.
+// Every share is accepted and paid by its own payer, Amount is counted in the minor unit of the transaction currency.
+// The share is paid under its own ID and refunded under RefundID.
+type TransactionShare struct {
+	ID            string      `db:"share_id"`
+	TransactionID string      `db:"transaction_id"`
+	Position      int         `db:"position"`
+	Amount        int64       `db:"amount"`
+	PayerID       *string     `db:"payer_id"`
+	Status        ShareStatus `db:"status"`
+	RefundID      string      `db:"refund_id"`
+	Reason        string      `db:"reason"`
+	CreatedAt     time.Time   `db:"created_at"`
+	UpdatedAt     time.Time   `db:"updated_at"`
+
+	Payer *TransactionUser `db:"-"`
+}
+
+// ShareSettlement describes how a payment outcome changed a share.
+// Completed is set when the share was the last one the group transaction waited for,
+// Refund when the share was paid after the group transaction was closed and must be paid back.
+type ShareSettlement struct {
+	Share     *TransactionShare
+	Completed bool
+	Refund    bool
+}
+
+// FromCreateTransactionShareDTO creates a pending TransactionShare from a CreateTransactionShareRequest DTO.
+func FromCreateTransactionShareDTO(transaction *Transaction, position int, shareDTO *dto.CreateTransactionShareRequest) (*TransactionShare, error) {
+	if shareDTO == nil || shareDTO.Amount == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	if *shareDTO.Amount <= 0 {
+		return nil, ErrInvalidShares
+	}
+
+	return &TransactionShare{
+		ID:            uuid.NewString(),
+		TransactionID: transaction.ID,
+		Position:      position,
+		Amount:        *shareDTO.Amount,
+		Status:        SharePending,
+		RefundID:      uuid.NewString(),
+		CreatedAt:     transaction.CreatedAt,
+		UpdatedAt:     transaction.CreatedAt,
+	}, nil
+}
+
+// ToGetTransactionShareDTO converts a TransactionShare of a transaction in currency to a GetTransactionShareResponse DTO.
+func (share *TransactionShare) ToGetTransactionShareDTO(currency string) *dto.GetTransactionShareResponse {
+	shareID := strfmt.UUID(share.ID)
+	status := share.Status.String()
+
+	shareResponse := &dto.GetTransactionShareResponse{
+		ShareID: &shareID,
+		Amount:  &share.Amount,
+		Status:  &status,
+	}
+
+	if amount, err := money.New(share.Amount, currency); err == nil {
+		shareResponse.AmountDecimal = amount.Decimal()
+	}
+
+	if share.Payer != nil {
+		shareResponse.Payer = share.Payer.ToGetTransactionUserDTO()
+	}
+
+	return shareResponse
+}
+
+// validateShares checks that every share is positive and that the shares add up to the total.
+func validateShares(total int64, shares []*TransactionShare) error {
+	remaining := total
+
+	for _, share := range shares {
+		if share.Amount <= 0 || share.Amount > remaining {
+			return ErrInvalidShares
+		}
+
+		remaining -= share.Amount
+	}
+
+	if remaining != 0 {
+		return ErrInvalidShares
+	}
+
+	return nil
+}
+
+// ValidateShares checks that the shares of a group transaction still add up to its amount.
+func (transaction *Transaction) ValidateShares() error {
+	return validateShares(transaction.Amount, transaction.Shares)
+}
+
+// Group reports whether the transaction is paid in shares by several payers.
+func (transaction *Transaction) Group() bool {
+	return len(transaction.Shares) != 0
+}
+
+// Share returns the share of a group transaction with the given ID, or nil when there is none.
+func (transaction *Transaction) Share(shareID string) *TransactionShare {
+	for _, share := range transaction.Shares {
+		if share.ID == shareID {
+			return share
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package model_test
+
+import (
+	"testing"
+
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupTransactionShares(t *testing.T) {
+	t.Parallel()
+
+	userID := strfmt.UUID("9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60")
+	walletID := strfmt.UUID("c4e8a1b2-7d6f-4e3a-9c5b-0a1b2c3d4e5f")
+	receiver := &dto.CreateTransactionUserRequest{
+		UserID:   &userID,
+		WalletID: &walletID,
+	}
+
+	testcases := []struct {
+		name        string
+		shares      []*dto.CreateTransactionShareRequest
+		legs        []*dto.CreateTransactionLegRequest
+		expectedErr error
+	}{
+		{
+			name: "Shares add up to the amount",
+			shares: []*dto.CreateTransactionShareRequest{
+				{Amount: swag.Int64(250)},
+				{Amount: swag.Int64(750)},
+			},
+		},
+		{
+			name: "Shares fall short of the amount",
+			shares: []*dto.CreateTransactionShareRequest{
+				{Amount: swag.Int64(250)},
+				{Amount: swag.Int64(500)},
+			},
+			expectedErr: model.ErrInvalidShares,
+		},
+		{
+			name: "Shares exceed the amount",
+			shares: []*dto.CreateTransactionShareRequest{
+				{Amount: swag.Int64(500)},
+				{Amount: swag.Int64(501)},
+			},
+			expectedErr: model.ErrInvalidShares,
+		},
+		{
+			name: "Share without amount",
+			shares: []*dto.CreateTransactionShareRequest{
+				{Amount: swag.Int64(1000)},
+				{},
+			},
+			expectedErr: model.ErrIncompleteRequest,
+		},
+		{
+			name: "Shares with legs",
+			shares: []*dto.CreateTransactionShareRequest{
+				{Amount: swag.Int64(500)},
+				{Amount: swag.Int64(500)},
+			},
+			legs: []*dto.CreateTransactionLegRequest{
+				{Receiver: receiver, Amount: 30},
+			},
+			expectedErr: model.ErrInvalidShares,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transaction, err := model.FromCreateTransactionDTO(&dto.CreateTransactionRequest{
+				MoneyInfo: &dto.MoneyInfo{
+					Amount:   swag.Int64(1000),
+					Currency: swag.String("USD"),
+					Method:   swag.String("algorand"),
+				},
+				Receiver: receiver,
+				Legs:     testcase.legs,
+				Shares:   testcase.shares,
+			})
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			require.True(t, transaction.Group())
+			require.Len(t, transaction.Shares, len(testcase.shares))
+
+			shareResponses := transaction.ToGetTransactionDTO().Shares
+			require.Len(t, shareResponses, len(testcase.shares))
+
+			for i, share := range transaction.Shares {
+				assert.Equal(t, i, share.Position)
+				assert.Equal(t, model.SharePending, share.Status)
+				assert.NotEqual(t, share.ID, share.RefundID)
+				assert.Same(t, share, transaction.Share(share.ID))
+
+				assert.Equal(t, *testcase.shares[i].Amount, *shareResponses[i].Amount)
+				assert.Equal(t, "pending", *shareResponses[i].Status)
+				assert.Nil(t, shareResponses[i].Payer)
+			}
+
+			assert.Equal(t, "2.50", shareResponses[0].AmountDecimal)
+		})
+	}
+}
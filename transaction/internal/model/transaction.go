@@ -1,9 +1,13 @@
 package model
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
 	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 )
 
@@ -16,6 +20,11 @@ const (
 	Canceled
 	Failed
 	Succeeded
+	AwaitingConfirmation
+	Expired
+	Scheduled
+	Authorized
+	Voided
 )
 
 func (ts TransactionStatus) String() string {
@@ -30,12 +39,29 @@ func (ts TransactionStatus) String() string {
 		return "failed"
 	case Succeeded:
 		return "succeeded"
+	case AwaitingConfirmation:
+		return "awaiting_confirmation"
+	case Expired:
+		return "expired"
+	case Scheduled:
+		return "scheduled"
+	case Authorized:
+		return "authorized"
+	case Voided:
+		return "voided"
 	default:
 		return "undefined"
 	}
 }
 
+// ErrIncompleteRequest is returned when a request DTO misses a required field.
+var ErrIncompleteRequest = errors.New("request is missing required fields")
+
 // Represents how the transaction structure is stored in the database.
+// Amount is counted in the minor unit of Currency, see money.Currency.
+// A transaction with ManualCapture is authorized instead of paid, CapturedAmount is the part of Amount taken out of the hold.
+// Fee is set when the transaction is accepted, it is deducted from what the receiver gets and paid to FeeReceiver.
+// A Watch transaction is paid from a wallet outside of the platform, it has no Sender.
 type Transaction struct {
 	ID             string            `db:"transaction_id"`
 	SenderID       *string           `db:"sender_id"`
@@ -45,31 +71,56 @@ type Transaction struct {
 	Status         TransactionStatus `db:"status"`
 	Method         string            `db:"method"`
 	CanceledReason string            `db:"canceled_reason"`
+	ExpiresAt      *time.Time        `db:"expires_at"`
+	ExecuteAt      *time.Time        `db:"execute_at"`
 	CreatedAt      time.Time         `db:"created_at"`
 	UpdatedAt      time.Time         `db:"updated_at"`
 
-	Sender   *TransactionUser `db:"-"`
-	Receiver *TransactionUser `db:"-"`
+	ManualCapture   bool       `db:"manual_capture"`
+	CapturedAmount  *int64     `db:"captured_amount"`
+	AuthorizedUntil *time.Time `db:"authorized_until"`
+	VoidRequestedAt *time.Time `db:"void_requested_at"`
+
+	Fee           int64   `db:"fee"`
+	FeeReceiverID *string `db:"fee_receiver_id"`
+
+	Watch bool `db:"watch"`
+
+	Sender      *TransactionUser `db:"-"`
+	Receiver    *TransactionUser `db:"-"`
+	FeeReceiver *TransactionUser `db:"-"`
+	// Quote is the exchange rate locked for paying in another currency, if any.
+	Quote *Quote `db:"-"`
+	// Legs are the receivers a split transaction is paid to, the first leg is the receiver.
+	Legs []*TransactionLeg `db:"-"`
+	// Shares are the parts of a group transaction paid by different payers.
+	Shares []*TransactionShare `db:"-"`
 }
 
 // FromCreateTransactionDTO creates a Transaction from a CreateTransactionRequest DTO.
-func FromCreateTransactionDTO(transactionDTO *dto.CreateTransactionRequest) *Transaction {
+// The currency code must be registered in money, it is stored in its canonical form.
+func FromCreateTransactionDTO(transactionDTO *dto.CreateTransactionRequest) (*Transaction, error) {
 	if transactionDTO == nil || transactionDTO.MoneyInfo == nil ||
 		transactionDTO.MoneyInfo.Amount == nil || transactionDTO.MoneyInfo.Currency == nil ||
 		transactionDTO.MoneyInfo.Method == nil {
-		return nil
+		return nil, ErrIncompleteRequest
 	}
 
 	receiver := FromCreateTransactionUserDTO(transactionDTO.Receiver)
 	if receiver == nil {
-		return nil
+		return nil, ErrIncompleteRequest
 	}
 
-	return &Transaction{
+	amount, err := money.New(*transactionDTO.MoneyInfo.Amount, *transactionDTO.MoneyInfo.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &Transaction{
 		ID:         uuid.NewString(),
 		ReceiverID: receiver.ID,
-		Currency:   *transactionDTO.MoneyInfo.Currency,
-		Amount:     *transactionDTO.MoneyInfo.Amount,
+		Currency:   amount.Currency().Code,
+		Amount:     amount.Amount(),
 		Status:     Created,
 		Method:     *transactionDTO.MoneyInfo.Method,
 		CreatedAt:  time.Now(),
@@ -77,21 +128,91 @@ func FromCreateTransactionDTO(transactionDTO *dto.CreateTransactionRequest) *Tra
 
 		Receiver: receiver,
 	}
+
+	if transactionDTO.ExpiresIn != 0 {
+		expiresAt := transaction.CreatedAt.Add(time.Duration(transactionDTO.ExpiresIn) * time.Second)
+		transaction.ExpiresAt = &expiresAt
+	}
+
+	if len(transactionDTO.Legs) != 0 {
+		transaction.Legs = []*TransactionLeg{newRemainderLeg(transaction)}
+
+		for i, legDTO := range transactionDTO.Legs {
+			leg, err := FromCreateTransactionLegDTO(transaction, i+1, legDTO)
+			if err != nil {
+				return nil, err
+			}
+
+			transaction.Legs = append(transaction.Legs, leg)
+		}
+
+		if _, err := transaction.LegAmounts(); err != nil {
+			return nil, err
+		}
+	}
+
+	if transactionDTO.CaptureMethod != nil && *transactionDTO.CaptureMethod == CaptureManual {
+		transaction.ManualCapture = true
+	}
+
+	if len(transactionDTO.Shares) != 0 {
+		if len(transaction.Legs) != 0 {
+			return nil, fmt.Errorf("%w: a group transaction cannot be split between receivers", ErrInvalidShares)
+		}
+
+		if transaction.ManualCapture {
+			return nil, fmt.Errorf("%w: a group transaction is captured automatically", ErrInvalidCapture)
+		}
+
+		for i, shareDTO := range transactionDTO.Shares {
+			share, err := FromCreateTransactionShareDTO(transaction, i, shareDTO)
+			if err != nil {
+				return nil, err
+			}
+
+			transaction.Shares = append(transaction.Shares, share)
+		}
+
+		if err := transaction.ValidateShares(); err != nil {
+			return nil, err
+		}
+	}
+
+	if transactionDTO.Watch != nil && *transactionDTO.Watch {
+		transaction.Watch = true
+
+		if err := transaction.ValidateWatch(); err != nil {
+			return nil, err
+		}
+	}
+
+	return transaction, nil
 }
 
 // FromEditTransactionDTO creates a Transaction from an EditTransactionRequest DTO.
-func FromEditTransactionDTO(transactionID string, transactionDTO *dto.EditTransactionRequest) *Transaction {
-	if transactionDTO.MoneyInfo == nil {
-		return nil
+// Only the set fields are edited, a set currency code must be registered in money.
+func FromEditTransactionDTO(transactionID string, transactionDTO *dto.EditTransactionRequest) (*Transaction, error) {
+	if transactionDTO == nil || transactionDTO.MoneyInfo == nil {
+		return nil, ErrIncompleteRequest
+	}
+
+	currency := transactionDTO.MoneyInfo.Currency
+	if currency != "" {
+		registered, err := money.LookupCurrency(currency)
+		if err != nil {
+			return nil, err
+		}
+
+		currency = registered.Code
 	}
 
 	return &Transaction{
 		ID:        transactionID,
-		Currency:  transactionDTO.MoneyInfo.Currency,
+		Currency:  currency,
 		Amount:    transactionDTO.MoneyInfo.Amount,
 		Method:    transactionDTO.MoneyInfo.Method,
 		UpdatedAt: time.Now(),
-	}
+	}, nil
 }
 
 // ToGetTransactionDTO converts a Transaction to a GetTransactionResponse DTO.
@@ -99,16 +220,86 @@ func (transaction *Transaction) ToGetTransactionDTO() *dto.GetTransactionRespons
 	transactionStatus := transaction.Status.String()
 
 	transactionResponse := &dto.GetTransactionResponse{
-		Amount:   &transaction.Amount,
-		Currency: &transaction.Currency,
-		Method:   &transaction.Method,
-		Status:   &transactionStatus,
-		Receiver: transaction.Receiver.ToGetTransactionUserDTO(),
+		Amount:        &transaction.Amount,
+		Currency:      &transaction.Currency,
+		Method:        &transaction.Method,
+		Status:        &transactionStatus,
+		Receiver:      transaction.Receiver.ToGetTransactionUserDTO(),
+		CaptureMethod: transaction.CaptureMethod(),
+		Watch:         transaction.Watch,
 	}
 
 	if transaction.Sender != nil {
 		transactionResponse.Sender = transaction.Sender.ToGetTransactionUserDTO()
 	}
 
+	if transaction.ExpiresAt != nil {
+		transactionResponse.ExpiresAt = strfmt.DateTime(*transaction.ExpiresAt)
+	}
+
+	if transaction.ExecuteAt != nil {
+		transactionResponse.ExecuteAt = strfmt.DateTime(*transaction.ExecuteAt)
+	}
+
+	if transaction.CapturedAmount != nil {
+		transactionResponse.CapturedAmount = *transaction.CapturedAmount
+	}
+
+	if transaction.AuthorizedUntil != nil {
+		transactionResponse.AuthorizedUntil = strfmt.DateTime(*transaction.AuthorizedUntil)
+	}
+
+	// Transactions stored before currencies were validated have no decimal amount.
+	if amount, err := transaction.Money(); err == nil {
+		transactionResponse.AmountDecimal = amount.Decimal()
+	}
+
+	if transaction.Fee != 0 {
+		transactionResponse.Fee = transaction.Fee
+
+		if fee, err := money.New(transaction.Fee, transaction.Currency); err == nil {
+			transactionResponse.FeeDecimal = fee.Decimal()
+		}
+	}
+
+	if transaction.Quote != nil {
+		transactionResponse.Quote = transaction.Quote.ToTransactionQuoteDTO()
+	}
+
+	if amounts, err := transaction.LegAmounts(); err == nil {
+		for i, leg := range transaction.Legs {
+			transactionResponse.Legs = append(transactionResponse.Legs, leg.ToGetTransactionLegDTO(amounts[i]))
+		}
+	}
+
+	for _, share := range transaction.Shares {
+		transactionResponse.Shares = append(transactionResponse.Shares, share.ToGetTransactionShareDTO(transaction.Currency))
+	}
+
 	return transactionResponse
 }
+
+// Money returns the amount of the transaction in its currency.
+func (transaction *Transaction) Money() (money.Money, error) {
+	return money.New(transaction.Amount, transaction.Currency)
+}
+
+// PaymentMoney returns the amount the payer is charged,
+// the locked quote amount when the transaction is paid in another currency.
+func (transaction *Transaction) PaymentMoney() (money.Money, error) {
+	if transaction.Quote != nil {
+		return transaction.Quote.Money()
+	}
+
+	return transaction.Money()
+}
+
+// Expired reports whether a created transaction can no longer be accepted at the given time.
+// Transactions without an expiration time never expire.
+func (transaction *Transaction) Expired(now time.Time) bool {
+	if transaction.Status == Expired {
+		return true
+	}
+
+	return transaction.Status == Created && transaction.ExpiresAt != nil && !now.Before(*transaction.ExpiresAt)
+}
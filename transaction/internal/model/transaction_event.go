@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// TransactionStatusEvent represents a status change streamed to the clients of the transaction.
+type TransactionStatusEvent struct {
+	// ID is the position of the event in the transaction event history, it is empty for status snapshots.
+	ID            string            `json:"-"`
+	TransactionID string            `json:"transaction_id"`
+	Status        TransactionStatus `json:"status"`
+	Reason        string            `json:"reason,omitempty"`
+	OccurredAt    time.Time         `json:"occurred_at"`
+}
+
+// ToTransactionStatusEventDTO converts the TransactionStatusEvent to a TransactionStatusEvent DTO.
+func (event *TransactionStatusEvent) ToTransactionStatusEventDTO() *dto.TransactionStatusEvent {
+	transactionID := strfmt.UUID(event.TransactionID)
+
+	return &dto.TransactionStatusEvent{
+		TransactionID: &transactionID,
+		Status:        stringPtr(event.Status.String()),
+		Reason:        event.Reason,
+		OccurredAt:    dateTimePtr(event.OccurredAt),
+	}
+}
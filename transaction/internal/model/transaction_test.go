@@ -0,0 +1,139 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Second)
+	future := now.Add(time.Second)
+
+	testcases := []struct {
+		name            string
+		transaction     *model.Transaction
+		expectedExpired bool
+	}{
+		{
+			name: "Created transaction before expiration",
+			transaction: &model.Transaction{
+				Status:    model.Created,
+				ExpiresAt: &future,
+			},
+			expectedExpired: false,
+		},
+		{
+			name: "Created transaction at expiration",
+			transaction: &model.Transaction{
+				Status:    model.Created,
+				ExpiresAt: &now,
+			},
+			expectedExpired: true,
+		},
+		{
+			name: "Created transaction without expiration",
+			transaction: &model.Transaction{
+				Status: model.Created,
+			},
+			expectedExpired: false,
+		},
+		{
+			name: "Processed transaction after expiration",
+			transaction: &model.Transaction{
+				Status:    model.Processed,
+				ExpiresAt: &past,
+			},
+			expectedExpired: false,
+		},
+		{
+			name: "Swept transaction",
+			transaction: &model.Transaction{
+				Status: model.Expired,
+			},
+			expectedExpired: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expectedExpired, testcase.transaction.Expired(now))
+		})
+	}
+}
+
+func TestFromCreateTransactionDTO(t *testing.T) {
+	t.Parallel()
+
+	userID := strfmt.UUID("9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60")
+	walletID := strfmt.UUID("c4e8a1b2-7d6f-4e3a-9c5b-0a1b2c3d4e5f")
+
+	testcases := []struct {
+		name             string
+		currency         string
+		expectedCurrency string
+		expectedDecimal  string
+		expectedErr      error
+	}{
+		{
+			name:             "ISO 4217 currency",
+			currency:         "USD",
+			expectedCurrency: "USD",
+			expectedDecimal:  "12.34",
+		},
+		{
+			name:             "Lower case code is canonicalized",
+			currency:         "algo",
+			expectedCurrency: "ALGO",
+			expectedDecimal:  "0.001234",
+		},
+		{
+			name:        "Unknown currency",
+			currency:    "string",
+			expectedErr: money.ErrUnknownCurrency,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			transaction, err := model.FromCreateTransactionDTO(&dto.CreateTransactionRequest{
+				MoneyInfo: &dto.MoneyInfo{
+					Amount:   swag.Int64(1234),
+					Currency: swag.String(testcase.currency),
+					Method:   swag.String("algorand"),
+				},
+				Receiver: &dto.CreateTransactionUserRequest{
+					UserID:   &userID,
+					WalletID: &walletID,
+				},
+			})
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, testcase.expectedCurrency, transaction.Currency)
+			assert.Equal(t, int64(1234), transaction.Amount)
+			assert.Equal(t, testcase.expectedDecimal, transaction.ToGetTransactionDTO().AmountDecimal)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+)
+
+// WatchMethod is the payment method a watch transaction is paid with.
+const WatchMethod = "algorand"
+
+// ErrInvalidWatch is returned when a transaction cannot be paid by watching the receiver address.
+var ErrInvalidWatch = errors.New("transaction cannot be watched")
+
+// ValidateWatch checks that a watch transaction is a single ALGO payment to the receiver,
+// which is the only payment a payer can make from their own wallet with a payment URI.
+func (transaction *Transaction) ValidateWatch() error {
+	switch {
+	case transaction.Method != WatchMethod:
+		return fmt.Errorf("%w: only %s transactions are watched", ErrInvalidWatch, WatchMethod)
+	case transaction.Currency != money.ALGO.Code:
+		return fmt.Errorf("%w: only %s transactions are watched", ErrInvalidWatch, money.ALGO.Code)
+	case len(transaction.Legs) != 0 || len(transaction.Shares) != 0:
+		return fmt.Errorf("%w: split and group transactions are paid within the platform", ErrInvalidWatch)
+	case transaction.ManualCapture:
+		return fmt.Errorf("%w: a watched payment cannot be held", ErrInvalidWatch)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateWatch(t *testing.T) {
+	t.Parallel()
+
+	watched := func(edit func(transaction *model.Transaction)) *model.Transaction {
+		transaction := &model.Transaction{
+			Currency: "ALGO",
+			Amount:   1_000_000,
+			Method:   "algorand",
+			Watch:    true,
+		}
+
+		if edit != nil {
+			edit(transaction)
+		}
+
+		return transaction
+	}
+
+	testcases := []struct {
+		name        string
+		transaction *model.Transaction
+		expectedErr error
+	}{
+		{
+			name:        "ALGO payment",
+			transaction: watched(nil),
+		},
+		{
+			name: "Other method",
+			transaction: watched(func(transaction *model.Transaction) {
+				transaction.Method = "card"
+			}),
+			expectedErr: model.ErrInvalidWatch,
+		},
+		{
+			name: "Other currency",
+			transaction: watched(func(transaction *model.Transaction) {
+				transaction.Currency = "USD"
+			}),
+			expectedErr: model.ErrInvalidWatch,
+		},
+		{
+			name: "Split transaction",
+			transaction: watched(func(transaction *model.Transaction) {
+				transaction.Legs = []*model.TransactionLeg{{}}
+			}),
+			expectedErr: model.ErrInvalidWatch,
+		},
+		{
+			name: "Group transaction",
+			transaction: watched(func(transaction *model.Transaction) {
+				transaction.Shares = []*model.TransactionShare{{}, {}}
+			}),
+			expectedErr: model.ErrInvalidWatch,
+		},
+		{
+			name: "Manual capture",
+			transaction: watched(func(transaction *model.Transaction) {
+				transaction.ManualCapture = true
+			}),
+			expectedErr: model.ErrInvalidWatch,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, testcase.transaction.ValidateWatch(), testcase.expectedErr)
+		})
+	}
+}
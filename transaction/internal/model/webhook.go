@@ -0,0 +1,154 @@
+package model
+
+import (
+	"time"
+
+	dto "github.com/ShmelJUJ/software-engineering/transaction/internal/generated/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+// WebhookEventType is the event type of every webhook delivery.
+const WebhookEventType = "transaction.status_changed"
+
+type DeliveryStatus int
+
+const (
+	UndefinedDelivery DeliveryStatus = iota
+	DeliveryPending
+	DeliverySucceeded
+	DeliveryDead
+)
+
+func (ds DeliveryStatus) String() string {
+	switch ds {
+	case DeliveryPending:
+		return "pending"
+	case DeliverySucceeded:
+		return "succeeded"
+	case DeliveryDead:
+		return "dead"
+	default:
+		return "undefined"
+	}
+}
+
+// Represents how the merchant webhook is stored in the database.
+type Webhook struct {
+	ID         string    `db:"webhook_id"`
+	MerchantID string    `db:"merchant_id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	Active     bool      `db:"active"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// FromCreateWebhookDTO creates a Webhook of the merchant from a CreateWebhookRequest DTO.
+func FromCreateWebhookDTO(merchantID string, webhookDTO *dto.CreateWebhookRequest) *Webhook {
+	if webhookDTO == nil || webhookDTO.URL == nil || webhookDTO.Secret == nil {
+		return nil
+	}
+
+	return &Webhook{
+		ID:         uuid.NewString(),
+		MerchantID: merchantID,
+		URL:        webhookDTO.URL.String(),
+		Secret:     *webhookDTO.Secret,
+		Active:     true,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+// Represents how a webhook delivery of a transaction status transition is stored in the database.
+type WebhookDelivery struct {
+	ID               string            `db:"delivery_id"`
+	WebhookID        string            `db:"webhook_id"`
+	TransactionID    string            `db:"transaction_id"`
+	FromStatus       TransactionStatus `db:"from_status"`
+	ToStatus         TransactionStatus `db:"to_status"`
+	Status           DeliveryStatus    `db:"status"`
+	Attempts         int32             `db:"attempts"`
+	NextAttemptAt    *time.Time        `db:"next_attempt_at"`
+	LastResponseCode *int32            `db:"last_response_code"`
+	LastError        *string           `db:"last_error"`
+	DeliveredAt      *time.Time        `db:"delivered_at"`
+	CreatedAt        time.Time         `db:"created_at"`
+	UpdatedAt        time.Time         `db:"updated_at"`
+}
+
+// Resend creates a new pending delivery of the same status transition.
+func (delivery *WebhookDelivery) Resend(now time.Time) *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:            uuid.NewString(),
+		WebhookID:     delivery.WebhookID,
+		TransactionID: delivery.TransactionID,
+		FromStatus:    delivery.FromStatus,
+		ToStatus:      delivery.ToStatus,
+		Status:        DeliveryPending,
+		NextAttemptAt: &now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// WebhookEvent is the JSON body sent to the merchant webhook.
+type WebhookEvent struct {
+	ID             string    `json:"id"`
+	Type           string    `json:"type"`
+	TransactionID  string    `json:"transaction_id"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+// Event returns the body sent to the merchant for the delivery.
+func (delivery *WebhookDelivery) Event() *WebhookEvent {
+	return &WebhookEvent{
+		ID:             delivery.ID,
+		Type:           WebhookEventType,
+		TransactionID:  delivery.TransactionID,
+		Status:         delivery.ToStatus.String(),
+		PreviousStatus: delivery.FromStatus.String(),
+		OccurredAt:     delivery.CreatedAt,
+	}
+}
+
+// ToWebhookDeliveryDTO converts a WebhookDelivery to a WebhookDeliveryResponse DTO.
+func (delivery *WebhookDelivery) ToWebhookDeliveryDTO() *dto.WebhookDeliveryResponse {
+	response := &dto.WebhookDeliveryResponse{
+		DeliveryID:       (*strfmt.UUID)(&delivery.ID),
+		TransactionID:    (*strfmt.UUID)(&delivery.TransactionID),
+		Status:           stringPtr(delivery.ToStatus.String()),
+		PreviousStatus:   stringPtr(delivery.FromStatus.String()),
+		State:            stringPtr(delivery.Status.String()),
+		Attempts:         &delivery.Attempts,
+		LastResponseCode: delivery.LastResponseCode,
+		CreatedAt:        dateTimePtr(delivery.CreatedAt),
+	}
+
+	if delivery.Status == DeliveryPending && delivery.NextAttemptAt != nil {
+		response.NextAttemptAt = dateTimePtr(*delivery.NextAttemptAt)
+	}
+
+	if delivery.LastError != nil {
+		response.LastError = *delivery.LastError
+	}
+
+	if delivery.DeliveredAt != nil {
+		response.DeliveredAt = dateTimePtr(*delivery.DeliveredAt)
+	}
+
+	return response
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func dateTimePtr(t time.Time) *strfmt.DateTime {
+	dateTime := strfmt.DateTime(t)
+
+	return &dateTime
+}
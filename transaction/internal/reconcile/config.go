@@ -0,0 +1,57 @@
+package reconcile
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultCheckInterval = time.Hour
+	defaultLookback      = 24 * time.Hour
+	defaultDelay         = 10 * time.Minute
+	defaultLeaderKey     = "transaction:reconcile:leader"
+	defaultLeaderTTL     = 15 * time.Second
+)
+
+// Config represents the reconciler configuration structure.
+type Config struct {
+	// CheckInterval is how often the transactions settled since the previous check are reconciled.
+	CheckInterval time.Duration
+	// Lookback is how far back the first check after a start reconciles.
+	Lookback time.Duration
+	// Delay leaves the indexer time to catch up with the chain, transactions settled later are reconciled by the next check.
+	Delay time.Duration
+	// LeaderKey is the redis key holding the reconciler lease.
+	LeaderKey string
+	// LeaderTTL is how long the lease outlives a replica that stopped renewing it.
+	LeaderTTL time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		CheckInterval: defaultCheckInterval,
+		Lookback:      defaultLookback,
+		Delay:         defaultDelay,
+		LeaderKey:     defaultLeaderKey,
+		LeaderTTL:     defaultLeaderTTL,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
@@ -0,0 +1,25 @@
+package reconcile
+
+import "fmt"
+
+// ReconcileError represents an error encountered while reconciling transactions against the chain.
+type ReconcileError struct {
+	msg string
+	err error
+}
+
+// NewReconcileError creates a new ReconcileError instance with the provided message and error.
+func NewReconcileError(msg string, err error) *ReconcileError {
+	return &ReconcileError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ReconcileError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ReconcileError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,86 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/indexer"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const indexerPageSize = 1000
+
+// Payment is a payment confirmed on the chain. Amount is counted in microAlgos.
+type Payment struct {
+	TxID        string
+	Sender      string
+	Receiver    string
+	Amount      uint64
+	Round       uint64
+	ConfirmedAt time.Time
+}
+
+// Indexer looks payments up on the chain.
+type Indexer interface {
+	// SearchPayments returns the payments confirmed after the given time whose note starts with notePrefix.
+	SearchPayments(ctx context.Context, notePrefix []byte, after time.Time) ([]*Payment, error)
+}
+
+type algorandIndexer struct {
+	client *indexer.Client
+}
+
+// NewAlgorandIndexer creates an Indexer requesting the Algorand indexer at the given address.
+func NewAlgorandIndexer(address, token string) (Indexer, error) {
+	client, err := indexer.MakeClient(address, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make indexer client: %w", err)
+	}
+
+	return &algorandIndexer{
+		client: client,
+	}, nil
+}
+
+// SearchPayments pages through the matching payment transactions until the indexer has no more of them.
+func (i *algorandIndexer) SearchPayments(ctx context.Context, notePrefix []byte, after time.Time) ([]*Payment, error) {
+	var (
+		payments  []*Payment
+		nextToken string
+	)
+
+	for {
+		search := i.client.SearchForTransactions().
+			TxType(string(types.PaymentTx)).
+			NotePrefix(notePrefix).
+			AfterTime(after).
+			Limit(indexerPageSize)
+
+		if nextToken != "" {
+			search = search.NextToken(nextToken)
+		}
+
+		resp, err := search.Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search transactions: %w", err)
+		}
+
+		for _, txn := range resp.Transactions {
+			payments = append(payments, &Payment{
+				TxID:        txn.Id,
+				Sender:      txn.Sender,
+				Receiver:    txn.PaymentTransaction.Receiver,
+				Amount:      txn.PaymentTransaction.Amount,
+				Round:       txn.ConfirmedRound,
+				ConfirmedAt: time.Unix(int64(txn.RoundTime), 0).UTC(),
+			})
+		}
+
+		if resp.NextToken == "" || len(resp.Transactions) == 0 {
+			return payments, nil
+		}
+
+		nextToken = resp.NextToken
+	}
+}
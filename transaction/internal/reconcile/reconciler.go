@@ -0,0 +1,401 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/saga"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+)
+
+const algorandMethod = "algorand"
+
+// ErrNotOnChain is returned for a transaction whose payments are not made in ALGO, they cannot be found on the chain.
+var ErrNotOnChain = errors.New("transaction is not paid in ALGO")
+
+// MismatchKind tells how the chain disagrees with a succeeded transaction.
+type MismatchKind string
+
+const (
+	// MissingPayment is an expected payment the chain does not have.
+	MissingPayment MismatchKind = "missing"
+	// DuplicatePayment is a payment the chain has more times than expected.
+	DuplicatePayment MismatchKind = "duplicate"
+	// WrongAmount is a payment whose amount differs from the expected one.
+	WrongAmount MismatchKind = "wrong_amount"
+	// WrongReceiver is a payment noting the transaction made to an address it does not pay.
+	WrongReceiver MismatchKind = "wrong_receiver"
+)
+
+// Mismatch is a disagreement between a succeeded transaction and the chain.
+// PaymentID is the ID the payment notes: the transaction ID, or the share ID for a share of a group transaction.
+// Amounts are counted in microAlgos, TxIDs are the chain transactions involved.
+type Mismatch struct {
+	Kind           MismatchKind
+	TransactionID  string
+	PaymentID      string
+	Receiver       string
+	ExpectedAmount uint64
+	ActualAmount   uint64
+	TxIDs          []string
+}
+
+// Reconciler checks that the transactions paid with Algorand and settled as succeeded actually moved their funds.
+// The payments of a transaction are found on the chain by the note the payment gateway writes into each of them
+// and compared to what the transaction pays: every leg and the fee, or every paid share of a group transaction.
+type Reconciler interface {
+	Run(ctx context.Context)
+	Reconcile(ctx context.Context, from, to time.Time) ([]*Mismatch, error)
+}
+
+type reconciler struct {
+	cfg             *Config
+	transactionRepo repository.TransactionRepo
+	wallets         confirmation.WalletKeyFetcher
+	indexer         Indexer
+	elector         saga.Elector
+	clock           clock.Clock
+	log             logger.Logger
+}
+
+// NewReconciler creates a new instance of Reconciler.
+func NewReconciler(
+	cfg *Config,
+	transactionRepo repository.TransactionRepo,
+	wallets confirmation.WalletKeyFetcher,
+	indexer Indexer,
+	elector saga.Elector,
+	clk clock.Clock,
+	log logger.Logger,
+) (Reconciler, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &reconciler{
+		cfg:             cfg,
+		transactionRepo: transactionRepo,
+		wallets:         wallets,
+		indexer:         indexer,
+		elector:         elector,
+		clock:           clk,
+		log:             log,
+	}, nil
+}
+
+// Run reconciles the transactions settled since the previous check every check interval while this replica
+// is the leader, until the context is canceled. A replica that becomes the leader starts the lookback before now,
+// a failed check is repeated over the same period by the next one.
+func (r *reconciler) Run(ctx context.Context) {
+	r.elector.Lead(ctx, func(ctx context.Context) {
+		reconciledUntil := r.clock.NowUTC().Add(-r.cfg.Delay - r.cfg.Lookback)
+
+		ticker := time.NewTicker(r.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				to := r.clock.NowUTC().Add(-r.cfg.Delay)
+
+				mismatches, err := r.Reconcile(ctx, reconciledUntil, to)
+				if err != nil {
+					r.log.Error("Failed to reconcile transactions", map[string]interface{}{
+						"error": err,
+						"from":  reconciledUntil,
+						"to":    to,
+					})
+
+					continue
+				}
+
+				for _, mismatch := range mismatches {
+					r.log.Warn("Transaction does not match the chain", map[string]interface{}{
+						"kind":            mismatch.Kind,
+						"transaction_id":  mismatch.TransactionID,
+						"payment_id":      mismatch.PaymentID,
+						"receiver":        mismatch.Receiver,
+						"expected_amount": mismatch.ExpectedAmount,
+						"actual_amount":   mismatch.ActualAmount,
+						"tx_ids":          mismatch.TxIDs,
+					})
+				}
+
+				reconciledUntil = to
+			}
+		}
+	})
+}
+
+// Reconcile compares the Algorand transactions settled as succeeded in [from, to) against the chain.
+func (r *reconciler) Reconcile(ctx context.Context, from, to time.Time) ([]*Mismatch, error) {
+	transactionIDs, err := r.transactionRepo.GetSucceededTransactions(ctx, algorandMethod, from, to)
+	if err != nil {
+		return nil, NewReconcileError("failed to get succeeded transactions", err)
+	}
+
+	addresses := make(map[string]string)
+
+	var mismatches []*Mismatch
+
+	for _, transactionID := range transactionIDs {
+		transactionMismatches, err := r.reconcileTransaction(ctx, transactionID, addresses)
+		if err != nil {
+			return nil, NewReconcileError(fmt.Sprintf("failed to reconcile transaction %s", transactionID), err)
+		}
+
+		mismatches = append(mismatches, transactionMismatches...)
+	}
+
+	r.log.Debug("Reconciled transactions", map[string]interface{}{
+		"from":         from,
+		"to":           to,
+		"transactions": len(transactionIDs),
+		"mismatches":   len(mismatches),
+	})
+
+	return mismatches, nil
+}
+
+// expectedPayment is a payment a transaction makes on the chain, in microAlgos.
+type expectedPayment struct {
+	paymentID string
+	receiver  string
+	amount    uint64
+}
+
+func (r *reconciler) reconcileTransaction(ctx context.Context, transactionID string, addresses map[string]string) ([]*Mismatch, error) {
+	transaction, err := r.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	expected, err := r.expectedPayments(ctx, transaction, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	actual := make(map[string][]*Payment)
+
+	for _, payment := range expected {
+		if _, ok := actual[payment.paymentID]; ok {
+			continue
+		}
+
+		payments, err := r.indexer.SearchPayments(ctx, paynote.New(paynote.Payment, payment.paymentID), transaction.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search payments: %w", err)
+		}
+
+		actual[payment.paymentID] = payments
+	}
+
+	return compare(transaction.ID, expected, actual), nil
+}
+
+// expectedPayments lists what the transaction pays: a payment to every leg and the fee to the fee receiver,
+// all under the transaction ID, or a payment to the receiver under the ID of every paid share of a group transaction.
+func (r *reconciler) expectedPayments(ctx context.Context, transaction *model.Transaction, addresses map[string]string) ([]*expectedPayment, error) {
+	var expected []*expectedPayment
+
+	add := func(paymentID string, receiver *model.TransactionUser, amount money.Money) error {
+		if amount.Currency() != money.ALGO {
+			return fmt.Errorf("%w: %s", ErrNotOnChain, amount.Currency())
+		}
+
+		address, err := r.address(ctx, receiver, addresses)
+		if err != nil {
+			return err
+		}
+
+		expected = append(expected, &expectedPayment{
+			paymentID: paymentID,
+			receiver:  address,
+			amount:    uint64(amount.Amount()),
+		})
+
+		return nil
+	}
+
+	if len(transaction.Shares) != 0 {
+		for _, share := range transaction.Shares {
+			if !sharePaid(share.Status) {
+				continue
+			}
+
+			amount, err := money.New(share.Amount, transaction.Currency)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := add(share.ID, transaction.Receiver, amount); err != nil {
+				return nil, err
+			}
+		}
+
+		return expected, nil
+	}
+
+	payouts, fee, err := transaction.Payouts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payouts: %w", err)
+	}
+
+	receivers := make([]*model.TransactionUser, 0, len(transaction.Legs))
+	for _, leg := range transaction.Legs {
+		receivers = append(receivers, leg.Receiver)
+	}
+
+	if len(receivers) == 0 {
+		receivers = append(receivers, transaction.Receiver)
+	}
+
+	for i, receiver := range receivers {
+		if err := add(transaction.ID, receiver, payouts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if fee.Amount() != 0 {
+		if err := add(transaction.ID, transaction.FeeReceiver, fee); err != nil {
+			return nil, err
+		}
+	}
+
+	return expected, nil
+}
+
+// address returns the Algorand address of the user wallet, the addresses fetched during a check are kept in addresses.
+func (r *reconciler) address(ctx context.Context, user *model.TransactionUser, addresses map[string]string) (string, error) {
+	if address, ok := addresses[user.WalletID]; ok {
+		return address, nil
+	}
+
+	publicKey, err := r.wallets.FetchPublicKey(ctx, user)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch wallet address: %w", err)
+	}
+
+	var address types.Address
+
+	copy(address[:], publicKey)
+
+	addresses[user.WalletID] = address.String()
+
+	return addresses[user.WalletID], nil
+}
+
+// sharePaid tells whether the payment of a share went through, refunds do not undo it.
+func sharePaid(status model.ShareStatus) bool {
+	switch status {
+	case model.ShareSucceeded, model.ShareRefunding, model.ShareRefunded, model.ShareRefundFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// compare matches the payments found on the chain, by payment ID, against the expected ones.
+// Payments to the same receiver under the same ID are summed, so a receiver of two legs is paid twice.
+func compare(transactionID string, expected []*expectedPayment, actual map[string][]*Payment) []*Mismatch {
+	type paymentKey struct {
+		paymentID string
+		receiver  string
+	}
+
+	type expectation struct {
+		count  int
+		amount uint64
+	}
+
+	var (
+		keys       []paymentKey
+		paymentIDs []string
+		mismatches []*Mismatch
+	)
+
+	expectations := make(map[paymentKey]*expectation)
+
+	for _, payment := range expected {
+		key := paymentKey{paymentID: payment.paymentID, receiver: payment.receiver}
+
+		if _, ok := expectations[key]; !ok {
+			expectations[key] = &expectation{}
+			keys = append(keys, key)
+		}
+
+		if len(paymentIDs) == 0 || paymentIDs[len(paymentIDs)-1] != payment.paymentID {
+			paymentIDs = append(paymentIDs, payment.paymentID)
+		}
+
+		expectations[key].count++
+		expectations[key].amount += payment.amount
+	}
+
+	found := make(map[paymentKey][]*Payment)
+
+	for _, paymentID := range paymentIDs {
+		for _, payment := range actual[paymentID] {
+			key := paymentKey{paymentID: paymentID, receiver: payment.Receiver}
+
+			if _, ok := expectations[key]; !ok {
+				mismatches = append(mismatches, &Mismatch{
+					Kind:          WrongReceiver,
+					TransactionID: transactionID,
+					PaymentID:     paymentID,
+					Receiver:      payment.Receiver,
+					ActualAmount:  payment.Amount,
+					TxIDs:         []string{payment.TxID},
+				})
+
+				continue
+			}
+
+			found[key] = append(found[key], payment)
+		}
+	}
+
+	for _, key := range keys {
+		want := expectations[key]
+		payments := found[key]
+
+		mismatch := &Mismatch{
+			TransactionID:  transactionID,
+			PaymentID:      key.paymentID,
+			Receiver:       key.receiver,
+			ExpectedAmount: want.amount,
+		}
+
+		for _, payment := range payments {
+			mismatch.ActualAmount += payment.Amount
+			mismatch.TxIDs = append(mismatch.TxIDs, payment.TxID)
+		}
+
+		switch {
+		case len(payments) == 0:
+			mismatch.Kind = MissingPayment
+		case len(payments) > want.count:
+			mismatch.Kind = DuplicatePayment
+		case mismatch.ActualAmount != want.amount:
+			mismatch.Kind = WrongAmount
+		default:
+			continue
+		}
+
+		mismatches = append(mismatches, mismatch)
+	}
+
+	return mismatches
+}
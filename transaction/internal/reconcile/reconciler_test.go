@@ -0,0 +1,358 @@
+package reconcile_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/pkg/paynote"
+	mock_confirmation "github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/reconcile"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/algorand/go-algorand-sdk/v2/client/v2/common/models"
+	"github.com/algorand/go-algorand-sdk/v2/encoding/json"
+	"github.com/algorand/go-algorand-sdk/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	transactionID = "b1000000-0000-4000-8000-000000000001"
+	shareA        = "c1000000-0000-4000-8000-000000000001"
+	shareB        = "c1000000-0000-4000-8000-000000000002"
+
+	receiverWallet    = "receiver-wallet"
+	feeReceiverWallet = "fee-wallet"
+	otherWallet       = "other-wallet"
+)
+
+var (
+	testFrom  = time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	testTo    = time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC)
+	createdAt = time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC)
+
+	wallets = map[string]ed25519.PublicKey{
+		receiverWallet:    walletKey(1),
+		feeReceiverWallet: walletKey(2),
+		otherWallet:       walletKey(3),
+	}
+)
+
+func walletKey(seed byte) ed25519.PublicKey {
+	return ed25519.NewKeyFromSeed(bytes.Repeat([]byte{seed}, ed25519.SeedSize)).Public().(ed25519.PublicKey) //nolint:errcheck // always a public key
+}
+
+func address(walletID string) string {
+	var addr types.Address
+
+	copy(addr[:], wallets[walletID])
+
+	return addr.String()
+}
+
+// chainPayment is a payment the fake indexer has confirmed.
+type chainPayment struct {
+	txID      string
+	paymentID string
+	walletID  string
+	amount    uint64
+}
+
+// fakeIndexer serves the transaction search of the indexer API, one payment per page to go through the paging.
+// A nil chain makes every search fail.
+func fakeIndexer(t *testing.T, chain []chainPayment) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/transactions" || chain == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		query := r.URL.Query()
+
+		assert.Equal(t, "pay", query.Get("tx-type"))
+		assert.Equal(t, createdAt.Format(time.RFC3339), query.Get("after-time"))
+
+		notePrefix, err := base64.StdEncoding.DecodeString(query.Get("note-prefix"))
+		require.NoError(t, err)
+
+		var matching []chainPayment
+
+		for _, payment := range chain {
+			if bytes.HasPrefix(paynote.New(paynote.Payment, payment.paymentID), notePrefix) {
+				matching = append(matching, payment)
+			}
+		}
+
+		page := 0
+		if token := query.Get("next"); token != "" {
+			page, err = strconv.Atoi(token)
+			require.NoError(t, err)
+		}
+
+		resp := models.TransactionsResponse{CurrentRound: 100, Transactions: []models.Transaction{}}
+
+		if page < len(matching) {
+			payment := matching[page]
+
+			resp.NextToken = strconv.Itoa(page + 1)
+			resp.Transactions = append(resp.Transactions, models.Transaction{
+				Id:             payment.txID,
+				Sender:         address(otherWallet),
+				Type:           "pay",
+				Note:           paynote.New(paynote.Payment, payment.paymentID),
+				ConfirmedRound: 90,
+				RoundTime:      uint64(createdAt.Add(time.Minute).Unix()),
+				PaymentTransaction: models.TransactionPayment{
+					Receiver: address(payment.walletID),
+					Amount:   payment.amount,
+				},
+			})
+		}
+
+		_, _ = w.Write(json.Encode(resp))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server.URL
+}
+
+func user(walletID string) *model.TransactionUser {
+	return &model.TransactionUser{UserID: walletID + "-user", WalletID: walletID}
+}
+
+// payment is a transaction of 5 ALGO paying a fee of 0.5 ALGO.
+func payment() *model.Transaction {
+	feeReceiverID := "fee-receiver"
+
+	return &model.Transaction{
+		ID:            transactionID,
+		Currency:      "ALGO",
+		Amount:        5_000_000,
+		Status:        model.Succeeded,
+		Method:        "algorand",
+		Fee:           500_000,
+		FeeReceiverID: &feeReceiverID,
+		CreatedAt:     createdAt,
+		Receiver:      user(receiverWallet),
+		FeeReceiver:   user(feeReceiverWallet),
+	}
+}
+
+// groupPayment is a group transaction of 3 ALGO, the first share of 2 ALGO is paid and the second is canceled.
+func groupPayment() *model.Transaction {
+	return &model.Transaction{
+		ID:        transactionID,
+		Currency:  "ALGO",
+		Amount:    3_000_000,
+		Status:    model.Succeeded,
+		Method:    "algorand",
+		CreatedAt: createdAt,
+		Receiver:  user(receiverWallet),
+		Shares: []*model.TransactionShare{
+			{ID: shareA, TransactionID: transactionID, Amount: 2_000_000, Status: model.ShareSucceeded},
+			{ID: shareB, TransactionID: transactionID, Position: 1, Amount: 1_000_000, Status: model.ShareCanceled},
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	paidChain := []chainPayment{
+		{txID: "TX1", paymentID: transactionID, walletID: receiverWallet, amount: 4_500_000},
+		{txID: "TX2", paymentID: transactionID, walletID: feeReceiverWallet, amount: 500_000},
+	}
+
+	testcases := []struct {
+		name               string
+		chain              []chainPayment
+		transaction        *model.Transaction
+		getSucceededErr    error
+		expectedMismatches []*reconcile.Mismatch
+		expectedErr        bool
+	}{
+		{
+			name:        "Payment matches the chain",
+			chain:       paidChain,
+			transaction: payment(),
+		},
+		{
+			name:        "Missing fee payment",
+			chain:       paidChain[:1],
+			transaction: payment(),
+			expectedMismatches: []*reconcile.Mismatch{
+				{
+					Kind:           reconcile.MissingPayment,
+					TransactionID:  transactionID,
+					PaymentID:      transactionID,
+					Receiver:       address(feeReceiverWallet),
+					ExpectedAmount: 500_000,
+				},
+			},
+		},
+		{
+			name: "Duplicate payment",
+			chain: append([]chainPayment{
+				{txID: "TX3", paymentID: transactionID, walletID: receiverWallet, amount: 4_500_000},
+			}, paidChain...),
+			transaction: payment(),
+			expectedMismatches: []*reconcile.Mismatch{
+				{
+					Kind:           reconcile.DuplicatePayment,
+					TransactionID:  transactionID,
+					PaymentID:      transactionID,
+					Receiver:       address(receiverWallet),
+					ExpectedAmount: 4_500_000,
+					ActualAmount:   9_000_000,
+					TxIDs:          []string{"TX3", "TX1"},
+				},
+			},
+		},
+		{
+			name: "Wrong amount",
+			chain: []chainPayment{
+				{txID: "TX1", paymentID: transactionID, walletID: receiverWallet, amount: 5_000_000},
+				paidChain[1],
+			},
+			transaction: payment(),
+			expectedMismatches: []*reconcile.Mismatch{
+				{
+					Kind:           reconcile.WrongAmount,
+					TransactionID:  transactionID,
+					PaymentID:      transactionID,
+					Receiver:       address(receiverWallet),
+					ExpectedAmount: 4_500_000,
+					ActualAmount:   5_000_000,
+					TxIDs:          []string{"TX1"},
+				},
+			},
+		},
+		{
+			name: "Wrong receiver",
+			chain: []chainPayment{
+				{txID: "TX1", paymentID: transactionID, walletID: otherWallet, amount: 4_500_000},
+				paidChain[1],
+			},
+			transaction: payment(),
+			expectedMismatches: []*reconcile.Mismatch{
+				{
+					Kind:          reconcile.WrongReceiver,
+					TransactionID: transactionID,
+					PaymentID:     transactionID,
+					Receiver:      address(otherWallet),
+					ActualAmount:  4_500_000,
+					TxIDs:         []string{"TX1"},
+				},
+				{
+					Kind:           reconcile.MissingPayment,
+					TransactionID:  transactionID,
+					PaymentID:      transactionID,
+					Receiver:       address(receiverWallet),
+					ExpectedAmount: 4_500_000,
+				},
+			},
+		},
+		{
+			name: "Group transaction pays its paid shares",
+			chain: []chainPayment{
+				{txID: "TX1", paymentID: shareA, walletID: receiverWallet, amount: 2_000_000},
+			},
+			transaction: groupPayment(),
+		},
+		{
+			name:        "Group transaction share missing",
+			chain:       []chainPayment{},
+			transaction: groupPayment(),
+			expectedMismatches: []*reconcile.Mismatch{
+				{
+					Kind:           reconcile.MissingPayment,
+					TransactionID:  transactionID,
+					PaymentID:      shareA,
+					Receiver:       address(receiverWallet),
+					ExpectedAmount: 2_000_000,
+				},
+			},
+		},
+		{
+			name:            "Failed to get succeeded transactions",
+			chain:           paidChain,
+			getSucceededErr: testErr,
+			expectedErr:     true,
+		},
+		{
+			name:        "Indexer fails",
+			chain:       nil,
+			transaction: payment(),
+			expectedErr: true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+
+			l := mock_logger.NewMockLogger(mockCtrl)
+			l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+			repo := mock_repository.NewMockTransactionRepo(mockCtrl)
+			walletFetcher := mock_confirmation.NewMockWalletKeyFetcher(mockCtrl)
+
+			indexer, err := reconcile.NewAlgorandIndexer(fakeIndexer(t, testcase.chain), "")
+			require.NoError(t, err)
+
+			r, err := reconcile.NewReconciler(
+				&reconcile.Config{},
+				repo,
+				walletFetcher,
+				indexer,
+				nil,
+				mock_clock.NewMockClock(mockCtrl),
+				l,
+			)
+			require.NoError(t, err)
+
+			if testcase.getSucceededErr != nil {
+				repo.EXPECT().GetSucceededTransactions(gomock.Any(), "algorand", testFrom, testTo).Return(nil, testcase.getSucceededErr)
+			} else {
+				repo.EXPECT().GetSucceededTransactions(gomock.Any(), "algorand", testFrom, testTo).Return([]string{transactionID}, nil)
+				repo.EXPECT().GetTransaction(gomock.Any(), transactionID).Return(testcase.transaction, nil)
+				walletFetcher.EXPECT().FetchPublicKey(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, user *model.TransactionUser) (ed25519.PublicKey, error) {
+						return wallets[user.WalletID], nil
+					},
+				).AnyTimes()
+			}
+
+			mismatches, err := r.Reconcile(context.Background(), testFrom, testTo)
+			if testcase.expectedErr {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testcase.expectedMismatches, mismatches)
+		})
+	}
+}
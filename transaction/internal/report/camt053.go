@@ -0,0 +1,324 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+	camtDateTimeLayout = "2006-01-02T15:04:05Z"
+	camtCompactDate    = "20060102"
+
+	creditIndicator = "CRDT"
+	debitIndicator  = "DBIT"
+
+	openingBalance = "OPBD"
+	closingBalance = "CLBD"
+	bookedStatus   = "BOOK"
+
+	merchantScheme = "MERCHANT"
+)
+
+type camtDocument struct {
+	XMLName   xml.Name      `xml:"Document"`
+	Namespace string        `xml:"xmlns,attr"`
+	Statement camtStatement `xml:"BkToCstmrStmt"`
+}
+
+type camtStatement struct {
+	GroupHeader camtGroupHeader    `xml:"GrpHdr"`
+	Statements  []*camtAccountStmt `xml:"Stmt"`
+}
+
+type camtGroupHeader struct {
+	MessageID string `xml:"MsgId"`
+	CreatedAt string `xml:"CreDtTm"`
+}
+
+type camtAccountStmt struct {
+	ID        string         `xml:"Id"`
+	CreatedAt string         `xml:"CreDtTm"`
+	Period    camtPeriod     `xml:"FrToDt"`
+	Account   camtAccount    `xml:"Acct"`
+	Balances  []*camtBalance `xml:"Bal"`
+	Summary   camtSummary    `xml:"TxsSummry"`
+	Entries   []*camtEntry   `xml:"Ntry"`
+}
+
+type camtPeriod struct {
+	From string `xml:"FrDtTm"`
+	To   string `xml:"ToDtTm"`
+}
+
+type camtAccount struct {
+	ID       camtAccountID `xml:"Id"`
+	Currency string        `xml:"Ccy"`
+}
+
+type camtAccountID struct {
+	Other camtOtherID `xml:"Othr"`
+}
+
+type camtOtherID struct {
+	ID     string     `xml:"Id"`
+	Scheme camtScheme `xml:"SchmeNm"`
+}
+
+type camtScheme struct {
+	Proprietary string `xml:"Prtry"`
+}
+
+type camtAmount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type camtBalance struct {
+	Type      camtBalanceType `xml:"Tp"`
+	Amount    camtAmount      `xml:"Amt"`
+	Indicator string          `xml:"CdtDbtInd"`
+	Date      camtDate        `xml:"Dt"`
+}
+
+type camtBalanceType struct {
+	CodeOrProprietary camtCode `xml:"CdOrPrtry"`
+}
+
+type camtCode struct {
+	Code string `xml:"Cd"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt,omitempty"`
+	DateTime string `xml:"DtTm,omitempty"`
+}
+
+type camtSummary struct {
+	Total   camtTotal    `xml:"TtlNtries"`
+	Credits camtSubtotal `xml:"TtlCdtNtries"`
+	Debits  camtSubtotal `xml:"TtlDbtNtries"`
+}
+
+type camtTotal struct {
+	Count string       `xml:"NbOfNtries"`
+	Sum   string       `xml:"Sum"`
+	Net   camtNetEntry `xml:"TtlNetNtry"`
+}
+
+type camtNetEntry struct {
+	Amount    string `xml:"Amt"`
+	Indicator string `xml:"CdtDbtInd"`
+}
+
+type camtSubtotal struct {
+	Count string `xml:"NbOfNtries"`
+	Sum   string `xml:"Sum"`
+}
+
+type camtEntry struct {
+	Reference   string           `xml:"NtryRef"`
+	Amount      camtAmount       `xml:"Amt"`
+	Indicator   string           `xml:"CdtDbtInd"`
+	Status      camtCode         `xml:"Sts"`
+	BookingDate camtDate         `xml:"BookgDt"`
+	ValueDate   camtDate         `xml:"ValDt"`
+	BankCode    camtBankCode     `xml:"BkTxCd"`
+	Charges     *camtCharges     `xml:"Chrgs,omitempty"`
+	Details     camtEntryDetails `xml:"NtryDtls"`
+}
+
+type camtBankCode struct {
+	Proprietary camtCode `xml:"Prtry"`
+}
+
+type camtCharges struct {
+	Total  camtAmount       `xml:"TtlChrgsAndTaxAmt"`
+	Record camtChargeRecord `xml:"Rcrd"`
+}
+
+type camtChargeRecord struct {
+	Amount    camtAmount `xml:"Amt"`
+	Indicator string     `xml:"CdtDbtInd"`
+	Included  bool       `xml:"ChrgInclInd"`
+}
+
+type camtEntryDetails struct {
+	Transaction camtTransactionDetails `xml:"TxDtls"`
+}
+
+type camtTransactionDetails struct {
+	References camtReferences `xml:"Refs"`
+}
+
+type camtReferences struct {
+	ServicerReference string `xml:"AcctSvcrRef"`
+	EndToEndID        string `xml:"EndToEndId"`
+}
+
+// writeCamt053 writes a camt.053 statement per merchant and currency. Each statement describes the settlements
+// of the day alone: it opens at zero and closes at the net amount. Payments are credited without their fee,
+// which is reported as an included charge, and refunds are debited. Identifiers are written without dashes
+// to fit the 35 characters ISO 20022 allows.
+func writeCamt053(w io.Writer, report *Report) error {
+	date := report.Date.Format(dateLayout)
+	createdAt := report.GeneratedAt.Format(camtDateTimeLayout)
+
+	document := &camtDocument{
+		Namespace: camt053Namespace,
+		Statement: camtStatement{
+			GroupHeader: camtGroupHeader{
+				MessageID: "SETTLEMENT-" + report.Date.Format(camtCompactDate),
+				CreatedAt: createdAt,
+			},
+			Statements: make([]*camtAccountStmt, 0, len(report.Statements)),
+		},
+	}
+
+	for i, statement := range report.Statements {
+		formatter := &amountFormatter{currency: statement.Currency}
+		amount := func(value int64) camtAmount {
+			return camtAmount{Currency: statement.Currency, Value: formatter.format(value)}
+		}
+
+		accountStmt := &camtAccountStmt{
+			ID:        fmt.Sprintf("%s-%s-%d", report.Date.Format(camtCompactDate), statement.Currency, i+1),
+			CreatedAt: createdAt,
+			Period: camtPeriod{
+				From: report.Date.Format(camtDateTimeLayout),
+				To:   report.Date.Add(24*time.Hour - time.Second).Format(camtDateTimeLayout),
+			},
+			Account: camtAccount{
+				ID: camtAccountID{
+					Other: camtOtherID{
+						ID:     compactID(statement.MerchantID),
+						Scheme: camtScheme{Proprietary: merchantScheme},
+					},
+				},
+				Currency: statement.Currency,
+			},
+			Balances: []*camtBalance{
+				{
+					Type:      camtBalanceType{CodeOrProprietary: camtCode{Code: openingBalance}},
+					Amount:    amount(0),
+					Indicator: creditIndicator,
+					Date:      camtDate{Date: date},
+				},
+				{
+					Type:      camtBalanceType{CodeOrProprietary: camtCode{Code: closingBalance}},
+					Amount:    amount(abs(statement.Net)),
+					Indicator: indicator(statement.Net),
+					Date:      camtDate{Date: date},
+				},
+			},
+			Entries: make([]*camtEntry, 0, len(statement.Lines)),
+		}
+
+		var credits, debits int64
+
+		for _, line := range statement.Lines {
+			net := line.Net()
+
+			if net < 0 {
+				debits += -net
+			} else {
+				credits += net
+			}
+
+			entry := &camtEntry{
+				Reference:   compactID(line.EntryID),
+				Amount:      amount(abs(net)),
+				Indicator:   indicator(net),
+				Status:      camtCode{Code: bookedStatus},
+				BookingDate: camtDate{DateTime: line.BookedAt.Format(camtDateTimeLayout)},
+				ValueDate:   camtDate{Date: line.BookedAt.Format(dateLayout)},
+				BankCode:    camtBankCode{Proprietary: camtCode{Code: strings.ToUpper(string(line.Kind))}},
+				Details: camtEntryDetails{
+					Transaction: camtTransactionDetails{
+						References: camtReferences{
+							ServicerReference: compactID(line.ReferenceID),
+							EndToEndID:        compactID(line.TransactionID),
+						},
+					},
+				},
+			}
+
+			if line.Fee != 0 {
+				entry.Charges = &camtCharges{
+					Total: amount(line.Fee),
+					Record: camtChargeRecord{
+						Amount:    amount(line.Fee),
+						Indicator: debitIndicator,
+						Included:  true,
+					},
+				}
+			}
+
+			accountStmt.Entries = append(accountStmt.Entries, entry)
+		}
+
+		accountStmt.Summary = camtSummary{
+			Total: camtTotal{
+				Count: fmt.Sprint(len(statement.Lines)),
+				Sum:   formatter.format(credits + debits),
+				Net: camtNetEntry{
+					Amount:    formatter.format(abs(statement.Net)),
+					Indicator: indicator(statement.Net),
+				},
+			},
+			Credits: camtSubtotal{
+				Count: fmt.Sprint(statement.Count),
+				Sum:   formatter.format(credits),
+			},
+			Debits: camtSubtotal{
+				Count: fmt.Sprint(statement.RefundCount),
+				Sum:   formatter.format(debits),
+			},
+		}
+
+		if formatter.err != nil {
+			return formatter.err
+		}
+
+		document.Statement.Statements = append(document.Statement.Statements, accountStmt)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func indicator(amount int64) string {
+	if amount < 0 {
+		return debitIndicator
+	}
+
+	return creditIndicator
+}
+
+func abs(amount int64) int64 {
+	if amount < 0 {
+		return -amount
+	}
+
+	return amount
+}
+
+func compactID(id string) string {
+	return strings.ReplaceAll(id, "-", "")
+}
@@ -0,0 +1,57 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+var csvHeader = []string{
+	"date",
+	"merchant_id",
+	"currency",
+	"count",
+	"gross",
+	"fees",
+	"refund_count",
+	"refunds",
+	"net",
+}
+
+// writeCSV writes a header and a row of totals per statement.
+func writeCSV(w io.Writer, report *Report) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	date := report.Date.Format(dateLayout)
+
+	for _, statement := range report.Statements {
+		formatter := &amountFormatter{currency: statement.Currency}
+
+		row := []string{
+			date,
+			statement.MerchantID,
+			statement.Currency,
+			strconv.FormatInt(statement.Count, 10),
+			formatter.format(statement.Gross),
+			formatter.format(statement.Fees),
+			strconv.FormatInt(statement.RefundCount, 10),
+			formatter.format(statement.Refunds),
+			formatter.format(statement.Net),
+		}
+		if formatter.err != nil {
+			return formatter.err
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
@@ -0,0 +1,6 @@
+package report
+
+import "errors"
+
+// ErrUnknownFormat is returned when a report is requested in a format that is not supported.
+var ErrUnknownFormat = errors.New("unknown report format")
@@ -0,0 +1,80 @@
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/money"
+)
+
+// Format is an output format of the settlement report.
+type Format string
+
+const (
+	// CSVFormat writes a row of totals per statement.
+	CSVFormat Format = "csv"
+	// JSONFormat writes the statements with their lines.
+	JSONFormat Format = "json"
+	// Camt053Format writes an ISO 20022 bank to customer statement, camt.053.001.08.
+	Camt053Format Format = "camt053"
+)
+
+// ParseFormat returns the format with the name, ErrUnknownFormat when it is not supported.
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(name); format {
+	case CSVFormat, JSONFormat, Camt053Format:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+	}
+}
+
+// ContentType returns the media type of the format.
+func (format Format) ContentType() string {
+	switch format {
+	case CSVFormat:
+		return "text/csv; charset=utf-8"
+	case Camt053Format:
+		return "application/xml; charset=utf-8"
+	default:
+		return "application/json"
+	}
+}
+
+// Write writes the report in the format. Amounts are written in the major unit of their currency.
+func Write(w io.Writer, report *Report, format Format) error {
+	switch format {
+	case CSVFormat:
+		return writeCSV(w, report)
+	case JSONFormat:
+		return writeJSON(w, report)
+	case Camt053Format:
+		return writeCamt053(w, report)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+const dateLayout = "2006-01-02"
+
+// amountFormatter formats amounts in the major unit of a currency with exactly the currency exponent decimals.
+// The first failure is kept in err and the later amounts are formatted empty.
+type amountFormatter struct {
+	currency string
+	err      error
+}
+
+func (formatter *amountFormatter) format(amount int64) string {
+	if formatter.err != nil {
+		return ""
+	}
+
+	m, err := money.New(amount, formatter.currency)
+	if err != nil {
+		formatter.err = err
+
+		return ""
+	}
+
+	return m.Decimal()
+}
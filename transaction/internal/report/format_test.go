@@ -0,0 +1,92 @@
+package report_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name   string
+		format report.Format
+		golden string
+	}{
+		{
+			name:   "CSV",
+			format: report.CSVFormat,
+			golden: "settlement.csv.golden",
+		},
+		{
+			name:   "JSON",
+			format: report.JSONFormat,
+			golden: "settlement.json.golden",
+		},
+		{
+			name:   "camt.053",
+			format: report.Camt053Format,
+			golden: "settlement.camt053.xml.golden",
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual bytes.Buffer
+
+			settlementReport := report.Build(testDate, testGeneratedAt, settledEntries(), "")
+			require.NoError(t, report.Write(&actual, settlementReport, testcase.format))
+
+			goldenPath := filepath.Join("testdata", testcase.golden)
+
+			if *update {
+				require.NoError(t, os.WriteFile(goldenPath, actual.Bytes(), 0o600))
+			}
+
+			expected, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+
+			assert.Equal(t, string(expected), actual.String())
+		})
+	}
+}
+
+func TestWriteUnknownCurrency(t *testing.T) {
+	t.Parallel()
+
+	settlementReport := &report.Report{
+		Date:        testDate,
+		GeneratedAt: testGeneratedAt,
+		Statements: []*report.Statement{
+			{MerchantID: merchantA, Currency: "DOGE", Count: 1, Gross: 1, Net: 1},
+		},
+	}
+
+	for _, format := range []report.Format{report.CSVFormat, report.JSONFormat, report.Camt053Format} {
+		assert.Error(t, report.Write(&bytes.Buffer{}, settlementReport, format), format)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	format, err := report.ParseFormat("camt053")
+	require.NoError(t, err)
+	assert.Equal(t, report.Camt053Format, format)
+
+	_, err = report.ParseFormat("xlsx")
+	assert.ErrorIs(t, err, report.ErrUnknownFormat)
+}
@@ -0,0 +1,95 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+type jsonReport struct {
+	Date        string           `json:"date"`
+	GeneratedAt time.Time        `json:"generated_at"`
+	Statements  []*jsonStatement `json:"statements"`
+}
+
+type jsonStatement struct {
+	MerchantID  string      `json:"merchant_id"`
+	Currency    string      `json:"currency"`
+	Count       int64       `json:"count"`
+	Gross       string      `json:"gross"`
+	Fees        string      `json:"fees"`
+	RefundCount int64       `json:"refund_count"`
+	Refunds     string      `json:"refunds"`
+	Net         string      `json:"net"`
+	Lines       []*jsonLine `json:"lines"`
+}
+
+type jsonLine struct {
+	EntryID       string    `json:"entry_id"`
+	TransactionID string    `json:"transaction_id"`
+	ReferenceID   string    `json:"reference_id"`
+	Kind          LineKind  `json:"kind"`
+	Amount        string    `json:"amount"`
+	Fee           string    `json:"fee"`
+	Net           string    `json:"net"`
+	BookedAt      time.Time `json:"booked_at"`
+}
+
+// writeJSON writes the statements with their lines, amounts are decimal strings so that no precision is lost.
+func writeJSON(w io.Writer, report *Report) error {
+	response := &jsonReport{
+		Date:        report.Date.Format(dateLayout),
+		GeneratedAt: report.GeneratedAt,
+		Statements:  make([]*jsonStatement, 0, len(report.Statements)),
+	}
+
+	for _, statement := range report.Statements {
+		jsonStatement, err := toJSONStatement(statement)
+		if err != nil {
+			return err
+		}
+
+		response.Statements = append(response.Statements, jsonStatement)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(response)
+}
+
+func toJSONStatement(statement *Statement) (*jsonStatement, error) {
+	formatter := &amountFormatter{currency: statement.Currency}
+	format := formatter.format
+
+	result := &jsonStatement{
+		MerchantID:  statement.MerchantID,
+		Currency:    statement.Currency,
+		Count:       statement.Count,
+		Gross:       format(statement.Gross),
+		Fees:        format(statement.Fees),
+		RefundCount: statement.RefundCount,
+		Refunds:     format(statement.Refunds),
+		Net:         format(statement.Net),
+		Lines:       make([]*jsonLine, 0, len(statement.Lines)),
+	}
+
+	for _, line := range statement.Lines {
+		result.Lines = append(result.Lines, &jsonLine{
+			EntryID:       line.EntryID,
+			TransactionID: line.TransactionID,
+			ReferenceID:   line.ReferenceID,
+			Kind:          line.Kind,
+			Amount:        format(line.Amount),
+			Fee:           format(line.Fee),
+			Net:           format(line.Net()),
+			BookedAt:      line.BookedAt,
+		})
+	}
+
+	if formatter.err != nil {
+		return nil, formatter.err
+	}
+
+	return result, nil
+}
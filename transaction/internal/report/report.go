@@ -0,0 +1,238 @@
+package report
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+)
+
+// LineKind tells what a line of a settlement statement settled.
+type LineKind string
+
+const (
+	// PaymentLine is a payment settled to the merchant, the fee is deducted from it.
+	PaymentLine LineKind = "payment"
+	// RefundLine is a refund the merchant paid back to a payer.
+	RefundLine LineKind = "refund"
+)
+
+// SettledEntry is a settle entry of the ledger together with the transaction it settled.
+// ReceiverID is the user the transaction was paid to, Refund tells that the entry settled
+// a refund of a group transaction share from the receiver back to its payer.
+type SettledEntry struct {
+	Entry      *ledger.Entry
+	ReceiverID string
+	Refund     bool
+}
+
+// Report is the settlement report of a day, one statement per merchant and currency.
+// The day starts at Date in UTC.
+type Report struct {
+	Date        time.Time
+	GeneratedAt time.Time
+	Statements  []*Statement
+}
+
+// Statement sums what a merchant settled in a currency during the day.
+// Amounts are counted in the minor unit of Currency, Net is Gross minus Fees and Refunds.
+type Statement struct {
+	MerchantID  string
+	Currency    string
+	Count       int64
+	Gross       int64
+	Fees        int64
+	RefundCount int64
+	Refunds     int64
+	Net         int64
+	Lines       []*Line
+}
+
+// Line is a settlement of a statement. Amount is the gross amount of a payment or the refunded amount,
+// the fee is only charged on the payments received as the transaction receiver.
+type Line struct {
+	EntryID       string
+	TransactionID string
+	ReferenceID   string
+	Kind          LineKind
+	Amount        int64
+	Fee           int64
+	BookedAt      time.Time
+}
+
+// Net returns what the line adds to the merchant: the payment without its fee or the refund taken away.
+func (line *Line) Net() int64 {
+	if line.Kind == RefundLine {
+		return -line.Amount
+	}
+
+	return line.Amount - line.Fee
+}
+
+// Build builds the settlement report of the day from the settle entries booked during it.
+// Every wallet owner credited by a payment gets a line, the receiver is charged the fee of the entry
+// and is also charged the refunds of its transactions. A non-empty merchantID keeps that merchant only.
+func Build(date, generatedAt time.Time, entries []*SettledEntry, merchantID string) *Report {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	type statementKey struct {
+		merchantID string
+		currency   string
+	}
+
+	statements := make(map[statementKey]*Statement)
+
+	add := func(owner, currency string, line *Line) {
+		if merchantID != "" && owner != merchantID {
+			return
+		}
+
+		key := statementKey{merchantID: owner, currency: currency}
+
+		statement, ok := statements[key]
+		if !ok {
+			statement = &Statement{
+				MerchantID: owner,
+				Currency:   currency,
+			}
+			statements[key] = statement
+		}
+
+		statement.Lines = append(statement.Lines, line)
+
+		if line.Kind == RefundLine {
+			statement.RefundCount++
+			statement.Refunds += line.Amount
+		} else {
+			statement.Count++
+			statement.Gross += line.Amount
+			statement.Fees += line.Fee
+		}
+
+		statement.Net += line.Net()
+	}
+
+	for _, settled := range entries {
+		for _, merchantLine := range entryLines(settled) {
+			add(merchantLine.merchantID, merchantLine.currency, merchantLine.line)
+		}
+	}
+
+	report := &Report{
+		Date:        day,
+		GeneratedAt: generatedAt.UTC(),
+		Statements:  make([]*Statement, 0, len(statements)),
+	}
+
+	for _, statement := range statements {
+		sort.SliceStable(statement.Lines, func(i, j int) bool {
+			if !statement.Lines[i].BookedAt.Equal(statement.Lines[j].BookedAt) {
+				return statement.Lines[i].BookedAt.Before(statement.Lines[j].BookedAt)
+			}
+
+			return statement.Lines[i].EntryID < statement.Lines[j].EntryID
+		})
+
+		report.Statements = append(report.Statements, statement)
+	}
+
+	sort.Slice(report.Statements, func(i, j int) bool {
+		if report.Statements[i].MerchantID != report.Statements[j].MerchantID {
+			return report.Statements[i].MerchantID < report.Statements[j].MerchantID
+		}
+
+		return report.Statements[i].Currency < report.Statements[j].Currency
+	})
+
+	return report
+}
+
+// merchantLine is a line of a settle entry with the statement it belongs to.
+type merchantLine struct {
+	merchantID string
+	currency   string
+	line       *Line
+}
+
+// entryLines splits a settle entry into the lines of the merchants it settled.
+func entryLines(settled *SettledEntry) []merchantLine {
+	entry := settled.Entry
+
+	var lines []merchantLine
+
+	newLine := func(kind LineKind, amount, fee int64) *Line {
+		return &Line{
+			EntryID:       entry.ID,
+			TransactionID: entry.TransactionID,
+			ReferenceID:   entry.ReferenceID,
+			Kind:          kind,
+			Amount:        amount,
+			Fee:           fee,
+			BookedAt:      entry.CreatedAt.UTC(),
+		}
+	}
+
+	credits := make(map[string]map[string]int64)
+	fees := make(map[string]int64)
+
+	var currencies []string
+
+	for _, posting := range entry.Postings {
+		if posting.Direction != ledger.Credit {
+			continue
+		}
+
+		if _, ok := credits[posting.Currency]; !ok {
+			credits[posting.Currency] = make(map[string]int64)
+			currencies = append(currencies, posting.Currency)
+		}
+
+		switch {
+		case settled.Refund:
+			credits[posting.Currency][settled.ReceiverID] += posting.Amount
+		case posting.Account.Kind == ledger.FeesAccount:
+			fees[posting.Currency] += posting.Amount
+		case posting.Account.OwnerID != nil:
+			credits[posting.Currency][*posting.Account.OwnerID] += posting.Amount
+		}
+	}
+
+	for _, currency := range currencies {
+		if settled.Refund {
+			lines = append(lines, merchantLine{
+				merchantID: settled.ReceiverID,
+				currency:   currency,
+				line:       newLine(RefundLine, credits[currency][settled.ReceiverID], 0),
+			})
+
+			continue
+		}
+
+		// A receiver whose whole part went to the fee has no posting but is still charged the fee.
+		if _, ok := credits[currency][settled.ReceiverID]; !ok && fees[currency] != 0 {
+			credits[currency][settled.ReceiverID] = 0
+		}
+
+		owners := make([]string, 0, len(credits[currency]))
+		for owner := range credits[currency] {
+			owners = append(owners, owner)
+		}
+
+		sort.Strings(owners)
+
+		for _, owner := range owners {
+			var fee int64
+			if owner == settled.ReceiverID {
+				fee = fees[currency]
+			}
+
+			lines = append(lines, merchantLine{
+				merchantID: owner,
+				currency:   currency,
+				line:       newLine(PaymentLine, credits[currency][owner]+fee, fee),
+			})
+		}
+	}
+
+	return lines
+}
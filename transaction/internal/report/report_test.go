@@ -0,0 +1,192 @@
+package report_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	merchantA = "9b2f6a0e-3c1d-4f5a-8b7e-1d2c3b4a5f60"
+	merchantB = "c4e8a1b2-7d6f-4e3a-9c5b-0a1b2c3d4e5f"
+	payer     = "5d6e7f80-9a0b-4c1d-8e2f-3a4b5c6d7e8f"
+)
+
+var (
+	testDate        = time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	testGeneratedAt = time.Date(2024, time.May, 2, 3, 0, 0, 0, time.UTC)
+)
+
+func posting(account *ledger.Account, direction ledger.Direction, amount int64, currency string) *ledger.Posting {
+	return &ledger.Posting{
+		Account:   account,
+		Direction: direction,
+		Amount:    amount,
+		Currency:  currency,
+	}
+}
+
+// settledEntries returns the settle entries of a day: a payment with a fee, a split payment,
+// a paid share and its refund, and a payment the fee took whole.
+func settledEntries() []*report.SettledEntry {
+	walletA := ledger.NewWalletAccount(merchantA, "1f2e3d4c-5b6a-4978-8695-a4b3c2d1e0f9")
+	walletB := ledger.NewWalletAccount(merchantB, "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d")
+	walletPayer := ledger.NewWalletAccount(payer, "3b4c5d6e-7f8a-4b9c-8d1e-2f3a4b5c6d7e")
+
+	return []*report.SettledEntry{
+		{
+			ReceiverID: merchantA,
+			Entry: &ledger.Entry{
+				ID:            "a1000000-0000-4000-8000-000000000001",
+				ReferenceID:   "b1000000-0000-4000-8000-000000000001",
+				TransactionID: "b1000000-0000-4000-8000-000000000001",
+				Kind:          ledger.SettleEntry,
+				CreatedAt:     testDate.Add(9 * time.Hour),
+				Postings: []*ledger.Posting{
+					posting(ledger.Clearing(), ledger.Debit, 1000, "USD"),
+					posting(walletA, ledger.Credit, 970, "USD"),
+					posting(ledger.Fees(), ledger.Credit, 30, "USD"),
+				},
+			},
+		},
+		{
+			ReceiverID: merchantA,
+			Entry: &ledger.Entry{
+				ID:            "a1000000-0000-4000-8000-000000000002",
+				ReferenceID:   "b1000000-0000-4000-8000-000000000002",
+				TransactionID: "b1000000-0000-4000-8000-000000000002",
+				Kind:          ledger.SettleEntry,
+				CreatedAt:     testDate.Add(10 * time.Hour),
+				Postings: []*ledger.Posting{
+					posting(ledger.Clearing(), ledger.Debit, 1000, "USD"),
+					posting(walletA, ledger.Credit, 800, "USD"),
+					posting(walletB, ledger.Credit, 200, "USD"),
+				},
+			},
+		},
+		{
+			ReceiverID: merchantA,
+			Entry: &ledger.Entry{
+				ID:            "a1000000-0000-4000-8000-000000000003",
+				ReferenceID:   "c1000000-0000-4000-8000-000000000003",
+				TransactionID: "b1000000-0000-4000-8000-000000000003",
+				Kind:          ledger.SettleEntry,
+				CreatedAt:     testDate.Add(11 * time.Hour),
+				Postings: []*ledger.Posting{
+					posting(ledger.Clearing(), ledger.Debit, 5000000, "ALGO"),
+					posting(walletA, ledger.Credit, 5000000, "ALGO"),
+				},
+			},
+		},
+		{
+			ReceiverID: merchantA,
+			Refund:     true,
+			Entry: &ledger.Entry{
+				ID:            "a1000000-0000-4000-8000-000000000004",
+				ReferenceID:   "d1000000-0000-4000-8000-000000000004",
+				TransactionID: "b1000000-0000-4000-8000-000000000003",
+				Kind:          ledger.SettleEntry,
+				CreatedAt:     testDate.Add(12 * time.Hour),
+				Postings: []*ledger.Posting{
+					posting(ledger.Clearing(), ledger.Debit, 2000000, "ALGO"),
+					posting(walletPayer, ledger.Credit, 2000000, "ALGO"),
+				},
+			},
+		},
+		{
+			ReceiverID: merchantB,
+			Entry: &ledger.Entry{
+				ID:            "a1000000-0000-4000-8000-000000000005",
+				ReferenceID:   "b1000000-0000-4000-8000-000000000005",
+				TransactionID: "b1000000-0000-4000-8000-000000000005",
+				Kind:          ledger.SettleEntry,
+				CreatedAt:     testDate.Add(8 * time.Hour),
+				Postings: []*ledger.Posting{
+					posting(ledger.Clearing(), ledger.Debit, 50, "USD"),
+					posting(ledger.Fees(), ledger.Credit, 50, "USD"),
+				},
+			},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	type totals struct {
+		merchantID  string
+		currency    string
+		count       int64
+		gross       int64
+		fees        int64
+		refundCount int64
+		refunds     int64
+		net         int64
+	}
+
+	testcases := []struct {
+		name           string
+		merchantID     string
+		expectedTotals []totals
+	}{
+		{
+			name: "All merchants",
+			expectedTotals: []totals{
+				{merchantID: merchantA, currency: "ALGO", count: 1, gross: 5000000, refundCount: 1, refunds: 2000000, net: 3000000},
+				{merchantID: merchantA, currency: "USD", count: 2, gross: 1800, fees: 30, net: 1770},
+				{merchantID: merchantB, currency: "USD", count: 2, gross: 250, fees: 50, net: 200},
+			},
+		},
+		{
+			name:       "One merchant",
+			merchantID: merchantB,
+			expectedTotals: []totals{
+				{merchantID: merchantB, currency: "USD", count: 2, gross: 250, fees: 50, net: 200},
+			},
+		},
+		{
+			name:           "Merchant without settlements",
+			merchantID:     payer,
+			expectedTotals: []totals{},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			settlementReport := report.Build(testDate.Add(15*time.Hour), testGeneratedAt, settledEntries(), testcase.merchantID)
+
+			assert.Equal(t, testDate, settlementReport.Date)
+
+			actualTotals := make([]totals, 0, len(settlementReport.Statements))
+
+			for _, statement := range settlementReport.Statements {
+				actualTotals = append(actualTotals, totals{
+					merchantID:  statement.MerchantID,
+					currency:    statement.Currency,
+					count:       statement.Count,
+					gross:       statement.Gross,
+					fees:        statement.Fees,
+					refundCount: statement.RefundCount,
+					refunds:     statement.Refunds,
+					net:         statement.Net,
+				})
+
+				require.Len(t, statement.Lines, int(statement.Count+statement.RefundCount))
+
+				for i := 1; i < len(statement.Lines); i++ {
+					assert.False(t, statement.Lines[i].BookedAt.Before(statement.Lines[i-1].BookedAt))
+				}
+			}
+
+			assert.Equal(t, testcase.expectedTotals, actualTotals)
+		})
+	}
+}
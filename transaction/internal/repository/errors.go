@@ -1,6 +1,47 @@
 package repository
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrTransactionNotFound is returned when no transaction has the requested id.
+	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrTransactionNotCreated is returned when a transaction cannot be accepted since it is not in the created status.
+	ErrTransactionNotCreated = errors.New("transaction is not in the created status")
+	// ErrTransactionNotProcessed is returned when a transaction cannot be failed since it is no longer in the processed status.
+	ErrTransactionNotProcessed = errors.New("transaction is not in the processed status")
+	// ErrQuoteLocked is returned when the exchange rate of a transaction is locked for another payer.
+	ErrQuoteLocked = errors.New("exchange rate is locked for another payer")
+	// ErrTransactionNotCancellable is returned when a transaction cannot be cancelled since it was already handed over to the payment gateway.
+	ErrTransactionNotCancellable = errors.New("transaction can no longer be cancelled")
+	// ErrTransactionNotAuthorized is returned when a transaction has no hold to capture or void,
+	// or its hold timed out or is being voided when it is captured.
+	ErrTransactionNotAuthorized = errors.New("transaction is not authorized")
+	// ErrConfirmationNotFound is returned when a transaction has no pending payer confirmation.
+	ErrConfirmationNotFound = errors.New("transaction has no pending confirmation")
+	// ErrShareNotFound is returned when no share of a group transaction is paid or refunded under the requested id.
+	ErrShareNotFound = errors.New("transaction share not found")
+	// ErrNoShareLeft is returned when every share of a group transaction is already taken by a payer.
+	ErrNoShareLeft = errors.New("every share of the transaction is taken")
+	// ErrPayerHasShare is returned when the payer already took a share of the group transaction.
+	ErrPayerHasShare = errors.New("payer already took a share of the transaction")
+	// ErrPaymentPointNotFound is returned when no payment point has the requested id.
+	ErrPaymentPointNotFound = errors.New("payment point not found")
+	// ErrMandateNotFound is returned when no mandate has the requested id.
+	ErrMandateNotFound = errors.New("mandate not found")
+	// ErrMandateNotDue is returned when a mandate is charged that is not due or is already being paid.
+	ErrMandateNotDue = errors.New("mandate is not due")
+	// ErrWebhookNotFound is returned when no webhook has the requested id.
+	ErrWebhookNotFound = errors.New("webhook not found")
+	// ErrWebhookDeliveryNotFound is returned when no webhook delivery has the requested id.
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+	// ErrLedgerAccountNotFound is returned when no ledger account has the requested id.
+	ErrLedgerAccountNotFound = errors.New("ledger account not found")
+	// ErrFeePlanNotFound is returned when the merchant has no fee plan for the payment method and currency.
+	ErrFeePlanNotFound = errors.New("fee plan not found")
+)
 
 // GetTransactionError represents an error encountered while getting a transaction.
 type GetTransactionError struct {
@@ -20,6 +61,10 @@ func (e GetTransactionError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
 
+func (e GetTransactionError) Unwrap() error {
+	return e.err
+}
+
 // GetTransactionUserError represents a user-related error encountered while getting a transaction.
 type GetTransactionUserError struct {
 	msg string
@@ -128,6 +173,10 @@ func (e UpdateTransactionError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
 
+func (e UpdateTransactionError) Unwrap() error {
+	return e.err
+}
+
 // CreateTransactionUserError represents a user-related error encountered while creating a transaction.
 type CreateTransactionUserError struct {
 	msg string
@@ -163,3 +212,1059 @@ func NewChangeTransactionStatusError(msg string, err error) *ChangeTransactionSt
 func (e ChangeTransactionStatusError) Error() string {
 	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
 }
+
+// RequestConfirmationError represents an error encountered while requesting a payer confirmation.
+type RequestConfirmationError struct {
+	msg string
+	err error
+}
+
+// NewRequestConfirmationError creates a new RequestConfirmationError instance with the provided message and error.
+func NewRequestConfirmationError(msg string, err error) *RequestConfirmationError {
+	return &RequestConfirmationError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e RequestConfirmationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e RequestConfirmationError) Unwrap() error {
+	return e.err
+}
+
+// GetConfirmationError represents an error encountered while getting a payer confirmation.
+type GetConfirmationError struct {
+	msg string
+	err error
+}
+
+// NewGetConfirmationError creates a new GetConfirmationError instance with the provided message and error.
+func NewGetConfirmationError(msg string, err error) *GetConfirmationError {
+	return &GetConfirmationError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetConfirmationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetConfirmationError) Unwrap() error {
+	return e.err
+}
+
+// IncrementConfirmationAttemptsError represents an error encountered while counting a failed confirmation attempt.
+type IncrementConfirmationAttemptsError struct {
+	msg string
+	err error
+}
+
+// NewIncrementConfirmationAttemptsError creates a new IncrementConfirmationAttemptsError instance with the provided message and error.
+func NewIncrementConfirmationAttemptsError(msg string, err error) *IncrementConfirmationAttemptsError {
+	return &IncrementConfirmationAttemptsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e IncrementConfirmationAttemptsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e IncrementConfirmationAttemptsError) Unwrap() error {
+	return e.err
+}
+
+// ConfirmTransactionError represents an error encountered while confirming a transaction.
+type ConfirmTransactionError struct {
+	msg string
+	err error
+}
+
+// NewConfirmTransactionError creates a new ConfirmTransactionError instance with the provided message and error.
+func NewConfirmTransactionError(msg string, err error) *ConfirmTransactionError {
+	return &ConfirmTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ConfirmTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ConfirmTransactionError) Unwrap() error {
+	return e.err
+}
+
+// ResetConfirmationError represents an error encountered while resetting a payer confirmation.
+type ResetConfirmationError struct {
+	msg string
+	err error
+}
+
+// NewResetConfirmationError creates a new ResetConfirmationError instance with the provided message and error.
+func NewResetConfirmationError(msg string, err error) *ResetConfirmationError {
+	return &ResetConfirmationError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ResetConfirmationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ResetConfirmationError) Unwrap() error {
+	return e.err
+}
+
+// CreatePaymentPointError represents an error encountered while creating a payment point.
+type CreatePaymentPointError struct {
+	msg string
+	err error
+}
+
+// NewCreatePaymentPointError creates a new CreatePaymentPointError instance with the provided message and error.
+func NewCreatePaymentPointError(msg string, err error) *CreatePaymentPointError {
+	return &CreatePaymentPointError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CreatePaymentPointError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CreatePaymentPointError) Unwrap() error {
+	return e.err
+}
+
+// GetPaymentPointError represents an error encountered while getting a payment point.
+type GetPaymentPointError struct {
+	msg string
+	err error
+}
+
+// NewGetPaymentPointError creates a new GetPaymentPointError instance with the provided message and error.
+func NewGetPaymentPointError(msg string, err error) *GetPaymentPointError {
+	return &GetPaymentPointError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetPaymentPointError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetPaymentPointError) Unwrap() error {
+	return e.err
+}
+
+// DisablePaymentPointError represents an error encountered while disabling a payment point.
+type DisablePaymentPointError struct {
+	msg string
+	err error
+}
+
+// NewDisablePaymentPointError creates a new DisablePaymentPointError instance with the provided message and error.
+func NewDisablePaymentPointError(msg string, err error) *DisablePaymentPointError {
+	return &DisablePaymentPointError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e DisablePaymentPointError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e DisablePaymentPointError) Unwrap() error {
+	return e.err
+}
+
+// CreatePaymentPointTransactionError represents an error encountered while creating a transaction for a payment point.
+type CreatePaymentPointTransactionError struct {
+	msg string
+	err error
+}
+
+// NewCreatePaymentPointTransactionError creates a new CreatePaymentPointTransactionError instance with the provided message and error.
+func NewCreatePaymentPointTransactionError(msg string, err error) *CreatePaymentPointTransactionError {
+	return &CreatePaymentPointTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CreatePaymentPointTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CreatePaymentPointTransactionError) Unwrap() error {
+	return e.err
+}
+
+// ExpireTransactionsError represents an error encountered while expiring transactions.
+type ExpireTransactionsError struct {
+	msg string
+	err error
+}
+
+// NewExpireTransactionsError creates a new ExpireTransactionsError instance with the provided message and error.
+func NewExpireTransactionsError(msg string, err error) *ExpireTransactionsError {
+	return &ExpireTransactionsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ExpireTransactionsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ExpireTransactionsError) Unwrap() error {
+	return e.err
+}
+
+// ExecuteScheduledTransactionsError represents an error encountered while executing scheduled transactions.
+type ExecuteScheduledTransactionsError struct {
+	msg string
+	err error
+}
+
+// NewExecuteScheduledTransactionsError creates a new ExecuteScheduledTransactionsError instance with the provided message and error.
+func NewExecuteScheduledTransactionsError(msg string, err error) *ExecuteScheduledTransactionsError {
+	return &ExecuteScheduledTransactionsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ExecuteScheduledTransactionsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ExecuteScheduledTransactionsError) Unwrap() error {
+	return e.err
+}
+
+// AuthorizeTransactionError represents an error encountered while authorizing a transaction.
+type AuthorizeTransactionError struct {
+	msg string
+	err error
+}
+
+// NewAuthorizeTransactionError creates a new AuthorizeTransactionError instance with the provided message and error.
+func NewAuthorizeTransactionError(msg string, err error) *AuthorizeTransactionError {
+	return &AuthorizeTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e AuthorizeTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e AuthorizeTransactionError) Unwrap() error {
+	return e.err
+}
+
+// CaptureTransactionError represents an error encountered while capturing a transaction.
+type CaptureTransactionError struct {
+	msg string
+	err error
+}
+
+// NewCaptureTransactionError creates a new CaptureTransactionError instance with the provided message and error.
+func NewCaptureTransactionError(msg string, err error) *CaptureTransactionError {
+	return &CaptureTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CaptureTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CaptureTransactionError) Unwrap() error {
+	return e.err
+}
+
+// ReleaseCaptureError represents an error encountered while releasing a failed capture.
+type ReleaseCaptureError struct {
+	msg string
+	err error
+}
+
+// NewReleaseCaptureError creates a new ReleaseCaptureError instance with the provided message and error.
+func NewReleaseCaptureError(msg string, err error) *ReleaseCaptureError {
+	return &ReleaseCaptureError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ReleaseCaptureError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ReleaseCaptureError) Unwrap() error {
+	return e.err
+}
+
+// RequestVoidError represents an error encountered while requesting the void of a transaction.
+type RequestVoidError struct {
+	msg string
+	err error
+}
+
+// NewRequestVoidError creates a new RequestVoidError instance with the provided message and error.
+func NewRequestVoidError(msg string, err error) *RequestVoidError {
+	return &RequestVoidError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e RequestVoidError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e RequestVoidError) Unwrap() error {
+	return e.err
+}
+
+// ClaimExpiredHoldsError represents an error encountered while claiming expired holds.
+type ClaimExpiredHoldsError struct {
+	msg string
+	err error
+}
+
+// NewClaimExpiredHoldsError creates a new ClaimExpiredHoldsError instance with the provided message and error.
+func NewClaimExpiredHoldsError(msg string, err error) *ClaimExpiredHoldsError {
+	return &ClaimExpiredHoldsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ClaimExpiredHoldsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ClaimExpiredHoldsError) Unwrap() error {
+	return e.err
+}
+
+// VoidTransactionError represents an error encountered while voiding a transaction.
+type VoidTransactionError struct {
+	msg string
+	err error
+}
+
+// NewVoidTransactionError creates a new VoidTransactionError instance with the provided message and error.
+func NewVoidTransactionError(msg string, err error) *VoidTransactionError {
+	return &VoidTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e VoidTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e VoidTransactionError) Unwrap() error {
+	return e.err
+}
+
+// GetOverdueTransactionsError represents an error encountered while retrieving overdue transactions.
+type GetOverdueTransactionsError struct {
+	msg string
+	err error
+}
+
+// NewGetOverdueTransactionsError creates a new GetOverdueTransactionsError instance with the provided message and error.
+func NewGetOverdueTransactionsError(msg string, err error) *GetOverdueTransactionsError {
+	return &GetOverdueTransactionsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetOverdueTransactionsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetOverdueTransactionsError) Unwrap() error {
+	return e.err
+}
+
+// GetSucceededTransactionsError represents an error encountered while retrieving succeeded transactions.
+type GetSucceededTransactionsError struct {
+	msg string
+	err error
+}
+
+// NewGetSucceededTransactionsError creates a new GetSucceededTransactionsError instance with the provided message and error.
+func NewGetSucceededTransactionsError(msg string, err error) *GetSucceededTransactionsError {
+	return &GetSucceededTransactionsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetSucceededTransactionsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetSucceededTransactionsError) Unwrap() error {
+	return e.err
+}
+
+// MarkStatusRequestedError represents an error encountered while marking a transaction status as requested.
+type MarkStatusRequestedError struct {
+	msg string
+	err error
+}
+
+// NewMarkStatusRequestedError creates a new MarkStatusRequestedError instance with the provided message and error.
+func NewMarkStatusRequestedError(msg string, err error) *MarkStatusRequestedError {
+	return &MarkStatusRequestedError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e MarkStatusRequestedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e MarkStatusRequestedError) Unwrap() error {
+	return e.err
+}
+
+// GetUnresolvedTransactionsError represents an error encountered while retrieving unresolved transactions.
+type GetUnresolvedTransactionsError struct {
+	msg string
+	err error
+}
+
+// NewGetUnresolvedTransactionsError creates a new GetUnresolvedTransactionsError instance with the provided message and error.
+func NewGetUnresolvedTransactionsError(msg string, err error) *GetUnresolvedTransactionsError {
+	return &GetUnresolvedTransactionsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetUnresolvedTransactionsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetUnresolvedTransactionsError) Unwrap() error {
+	return e.err
+}
+
+// FailTransactionError represents an error encountered while failing a transaction.
+type FailTransactionError struct {
+	msg string
+	err error
+}
+
+// NewFailTransactionError creates a new FailTransactionError instance with the provided message and error.
+func NewFailTransactionError(msg string, err error) *FailTransactionError {
+	return &FailTransactionError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e FailTransactionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e FailTransactionError) Unwrap() error {
+	return e.err
+}
+
+// CreateWebhookError represents an error encountered while creating a webhook.
+type CreateWebhookError struct {
+	msg string
+	err error
+}
+
+// NewCreateWebhookError creates a new CreateWebhookError instance with the provided message and error.
+func NewCreateWebhookError(msg string, err error) *CreateWebhookError {
+	return &CreateWebhookError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CreateWebhookError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CreateWebhookError) Unwrap() error {
+	return e.err
+}
+
+// GetWebhookError represents an error encountered while getting a webhook.
+type GetWebhookError struct {
+	msg string
+	err error
+}
+
+// NewGetWebhookError creates a new GetWebhookError instance with the provided message and error.
+func NewGetWebhookError(msg string, err error) *GetWebhookError {
+	return &GetWebhookError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetWebhookError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetWebhookError) Unwrap() error {
+	return e.err
+}
+
+// GetWebhookDeliveriesError represents an error encountered while getting webhook deliveries.
+type GetWebhookDeliveriesError struct {
+	msg string
+	err error
+}
+
+// NewGetWebhookDeliveriesError creates a new GetWebhookDeliveriesError instance with the provided message and error.
+func NewGetWebhookDeliveriesError(msg string, err error) *GetWebhookDeliveriesError {
+	return &GetWebhookDeliveriesError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetWebhookDeliveriesError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetWebhookDeliveriesError) Unwrap() error {
+	return e.err
+}
+
+// GetWebhookDeliveryError represents an error encountered while getting a webhook delivery.
+type GetWebhookDeliveryError struct {
+	msg string
+	err error
+}
+
+// NewGetWebhookDeliveryError creates a new GetWebhookDeliveryError instance with the provided message and error.
+func NewGetWebhookDeliveryError(msg string, err error) *GetWebhookDeliveryError {
+	return &GetWebhookDeliveryError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetWebhookDeliveryError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetWebhookDeliveryError) Unwrap() error {
+	return e.err
+}
+
+// CreateWebhookDeliveryError represents an error encountered while creating a webhook delivery.
+type CreateWebhookDeliveryError struct {
+	msg string
+	err error
+}
+
+// NewCreateWebhookDeliveryError creates a new CreateWebhookDeliveryError instance with the provided message and error.
+func NewCreateWebhookDeliveryError(msg string, err error) *CreateWebhookDeliveryError {
+	return &CreateWebhookDeliveryError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CreateWebhookDeliveryError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CreateWebhookDeliveryError) Unwrap() error {
+	return e.err
+}
+
+// ClaimDueDeliveriesError represents an error encountered while claiming due webhook deliveries.
+type ClaimDueDeliveriesError struct {
+	msg string
+	err error
+}
+
+// NewClaimDueDeliveriesError creates a new ClaimDueDeliveriesError instance with the provided message and error.
+func NewClaimDueDeliveriesError(msg string, err error) *ClaimDueDeliveriesError {
+	return &ClaimDueDeliveriesError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ClaimDueDeliveriesError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ClaimDueDeliveriesError) Unwrap() error {
+	return e.err
+}
+
+// RecordDeliveryAttemptError represents an error encountered while recording a webhook delivery attempt.
+type RecordDeliveryAttemptError struct {
+	msg string
+	err error
+}
+
+// NewRecordDeliveryAttemptError creates a new RecordDeliveryAttemptError instance with the provided message and error.
+func NewRecordDeliveryAttemptError(msg string, err error) *RecordDeliveryAttemptError {
+	return &RecordDeliveryAttemptError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e RecordDeliveryAttemptError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e RecordDeliveryAttemptError) Unwrap() error {
+	return e.err
+}
+
+// LockQuoteError represents an error encountered while locking an exchange rate quote for a transaction.
+type LockQuoteError struct {
+	msg string
+	err error
+}
+
+// NewLockQuoteError creates a new LockQuoteError instance with the provided message and error.
+func NewLockQuoteError(msg string, err error) *LockQuoteError {
+	return &LockQuoteError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e LockQuoteError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e LockQuoteError) Unwrap() error {
+	return e.err
+}
+
+// ClaimShareError represents an error encountered while claiming a share of a group transaction.
+type ClaimShareError struct {
+	msg string
+	err error
+}
+
+// NewClaimShareError creates a new ClaimShareError instance with the provided message and error.
+func NewClaimShareError(msg string, err error) *ClaimShareError {
+	return &ClaimShareError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ClaimShareError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ClaimShareError) Unwrap() error {
+	return e.err
+}
+
+// SettleShareError represents an error encountered while settling the payment of a transaction share.
+type SettleShareError struct {
+	msg string
+	err error
+}
+
+// NewSettleShareError creates a new SettleShareError instance with the provided message and error.
+func NewSettleShareError(msg string, err error) *SettleShareError {
+	return &SettleShareError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SettleShareError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SettleShareError) Unwrap() error {
+	return e.err
+}
+
+// CloseGroupTransactionsError represents an error encountered while closing group transactions.
+type CloseGroupTransactionsError struct {
+	msg string
+	err error
+}
+
+// NewCloseGroupTransactionsError creates a new CloseGroupTransactionsError instance with the provided message and error.
+func NewCloseGroupTransactionsError(msg string, err error) *CloseGroupTransactionsError {
+	return &CloseGroupTransactionsError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CloseGroupTransactionsError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CloseGroupTransactionsError) Unwrap() error {
+	return e.err
+}
+
+// CreateMandateError represents an error encountered while creating a mandate.
+type CreateMandateError struct {
+	msg string
+	err error
+}
+
+// NewCreateMandateError creates a new CreateMandateError instance with the provided message and error.
+func NewCreateMandateError(msg string, err error) *CreateMandateError {
+	return &CreateMandateError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e CreateMandateError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e CreateMandateError) Unwrap() error {
+	return e.err
+}
+
+// GetMandateError represents an error encountered while getting a mandate.
+type GetMandateError struct {
+	msg string
+	err error
+}
+
+// NewGetMandateError creates a new GetMandateError instance with the provided message and error.
+func NewGetMandateError(msg string, err error) *GetMandateError {
+	return &GetMandateError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetMandateError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetMandateError) Unwrap() error {
+	return e.err
+}
+
+// UpdateMandateError represents an error encountered while pausing, resuming or canceling a mandate.
+type UpdateMandateError struct {
+	msg string
+	err error
+}
+
+// NewUpdateMandateError creates a new UpdateMandateError instance with the provided message and error.
+func NewUpdateMandateError(msg string, err error) *UpdateMandateError {
+	return &UpdateMandateError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e UpdateMandateError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e UpdateMandateError) Unwrap() error {
+	return e.err
+}
+
+// GetDueMandatesError represents an error encountered while getting the mandates due for a charge.
+type GetDueMandatesError struct {
+	msg string
+	err error
+}
+
+// NewGetDueMandatesError creates a new GetDueMandatesError instance with the provided message and error.
+func NewGetDueMandatesError(msg string, err error) *GetDueMandatesError {
+	return &GetDueMandatesError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetDueMandatesError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetDueMandatesError) Unwrap() error {
+	return e.err
+}
+
+// ChargeMandateError represents an error encountered while charging a mandate.
+type ChargeMandateError struct {
+	msg string
+	err error
+}
+
+// NewChargeMandateError creates a new ChargeMandateError instance with the provided message and error.
+func NewChargeMandateError(msg string, err error) *ChargeMandateError {
+	return &ChargeMandateError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ChargeMandateError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ChargeMandateError) Unwrap() error {
+	return e.err
+}
+
+// GetMandateChargeOutcomesError represents an error encountered while getting the outcomes of mandate charges.
+type GetMandateChargeOutcomesError struct {
+	msg string
+	err error
+}
+
+// NewGetMandateChargeOutcomesError creates a new GetMandateChargeOutcomesError instance with the provided message and error.
+func NewGetMandateChargeOutcomesError(msg string, err error) *GetMandateChargeOutcomesError {
+	return &GetMandateChargeOutcomesError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetMandateChargeOutcomesError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetMandateChargeOutcomesError) Unwrap() error {
+	return e.err
+}
+
+// SettleMandateChargeError represents an error encountered while settling a mandate charge.
+type SettleMandateChargeError struct {
+	msg string
+	err error
+}
+
+// NewSettleMandateChargeError creates a new SettleMandateChargeError instance with the provided message and error.
+func NewSettleMandateChargeError(msg string, err error) *SettleMandateChargeError {
+	return &SettleMandateChargeError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SettleMandateChargeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SettleMandateChargeError) Unwrap() error {
+	return e.err
+}
+
+// GetLedgerAccountError represents an error encountered while getting a ledger account.
+type GetLedgerAccountError struct {
+	msg string
+	err error
+}
+
+// NewGetLedgerAccountError creates a new GetLedgerAccountError instance with the provided message and error.
+func NewGetLedgerAccountError(msg string, err error) *GetLedgerAccountError {
+	return &GetLedgerAccountError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetLedgerAccountError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetLedgerAccountError) Unwrap() error {
+	return e.err
+}
+
+// GetLedgerBalancesError represents an error encountered while getting the balances of a ledger account.
+type GetLedgerBalancesError struct {
+	msg string
+	err error
+}
+
+// NewGetLedgerBalancesError creates a new GetLedgerBalancesError instance with the provided message and error.
+func NewGetLedgerBalancesError(msg string, err error) *GetLedgerBalancesError {
+	return &GetLedgerBalancesError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetLedgerBalancesError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetLedgerBalancesError) Unwrap() error {
+	return e.err
+}
+
+// GetLedgerStatementError represents an error encountered while getting the statement of a ledger account.
+type GetLedgerStatementError struct {
+	msg string
+	err error
+}
+
+// NewGetLedgerStatementError creates a new GetLedgerStatementError instance with the provided message and error.
+func NewGetLedgerStatementError(msg string, err error) *GetLedgerStatementError {
+	return &GetLedgerStatementError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetLedgerStatementError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetLedgerStatementError) Unwrap() error {
+	return e.err
+}
+
+// SetFeePlanError represents an error encountered while setting a fee plan.
+type SetFeePlanError struct {
+	msg string
+	err error
+}
+
+// NewSetFeePlanError creates a new SetFeePlanError instance with the provided message and error.
+func NewSetFeePlanError(msg string, err error) *SetFeePlanError {
+	return &SetFeePlanError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SetFeePlanError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SetFeePlanError) Unwrap() error {
+	return e.err
+}
+
+// GetFeePlansError represents an error encountered while getting the fee plans of a merchant.
+type GetFeePlansError struct {
+	msg string
+	err error
+}
+
+// NewGetFeePlansError creates a new GetFeePlansError instance with the provided message and error.
+func NewGetFeePlansError(msg string, err error) *GetFeePlansError {
+	return &GetFeePlansError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetFeePlansError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetFeePlansError) Unwrap() error {
+	return e.err
+}
+
+// GetFeePlanError represents an error encountered while getting a fee plan.
+type GetFeePlanError struct {
+	msg string
+	err error
+}
+
+// NewGetFeePlanError creates a new GetFeePlanError instance with the provided message and error.
+func NewGetFeePlanError(msg string, err error) *GetFeePlanError {
+	return &GetFeePlanError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetFeePlanError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetFeePlanError) Unwrap() error {
+	return e.err
+}
+
+// GetMerchantVolumeError represents an error encountered while getting the monthly volume of a merchant.
+type GetMerchantVolumeError struct {
+	msg string
+	err error
+}
+
+// NewGetMerchantVolumeError creates a new GetMerchantVolumeError instance with the provided message and error.
+func NewGetMerchantVolumeError(msg string, err error) *GetMerchantVolumeError {
+	return &GetMerchantVolumeError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetMerchantVolumeError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetMerchantVolumeError) Unwrap() error {
+	return e.err
+}
+
+// GetSettledEntriesError represents an error encountered while getting the settle entries of a period.
+type GetSettledEntriesError struct {
+	msg string
+	err error
+}
+
+// NewGetSettledEntriesError creates a new GetSettledEntriesError instance with the provided message and error.
+func NewGetSettledEntriesError(msg string, err error) *GetSettledEntriesError {
+	return &GetSettledEntriesError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e GetSettledEntriesError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e GetSettledEntriesError) Unwrap() error {
+	return e.err
+}
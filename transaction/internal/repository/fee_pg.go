@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -package mocks -destination mocks/fee_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository FeeRepo
+
+// FeeRepo defines the interface for the fee plans of merchants and the volumes they are tiered by.
+type FeeRepo interface {
+	SetFeePlan(ctx context.Context, plan *model.FeePlan) error
+	GetFeePlans(ctx context.Context, merchantID string) ([]*model.FeePlan, error)
+	GetFeePlan(ctx context.Context, merchantID, method, currency string) (*model.FeePlan, error)
+	GetMerchantVolume(ctx context.Context, merchantID, currency string, since time.Time) (int64, error)
+}
+
+type feeRepo struct {
+	pg  *postgres.Postgres
+	log logger.Logger
+}
+
+// NewFeeRepo creates a new instance of FeeRepo.
+func NewFeeRepo(
+	pg *postgres.Postgres,
+	log logger.Logger,
+) FeeRepo {
+	return &feeRepo{
+		pg:  pg,
+		log: log,
+	}
+}
+
+// SetFeePlan stores the fee plan, replacing the plan of the merchant for the same method and currency
+// together with its tiers. The plan gets the id and creation time of the plan it replaces.
+func (repo *feeRepo) SetFeePlan(ctx context.Context, plan *model.FeePlan) error {
+	upsertSQLQuery, upsertArgs, err := upsertFeePlanQuery(plan).ToSql()
+	if err != nil {
+		return NewSetFeePlanError("failed to get upsert fee plan sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		if err := transactionConn.QueryRow(ctx, upsertSQLQuery, upsertArgs...).Scan(&plan.ID, &plan.CreatedAt); err != nil {
+			return fmt.Errorf("failed to QueryRow upsert fee plan sql query: %w", err)
+		}
+
+		deleteSQLQuery, deleteArgs, err := deleteFeePlanTiersQuery(plan.ID).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get delete fee plan tiers sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, deleteSQLQuery, deleteArgs...); err != nil {
+			return fmt.Errorf("failed to Exec delete fee plan tiers sql query: %w", err)
+		}
+
+		if len(plan.Tiers) == 0 {
+			return nil
+		}
+
+		for _, tier := range plan.Tiers {
+			tier.FeePlanID = plan.ID
+		}
+
+		tiersSQLQuery, tiersArgs, err := createFeePlanTiersQuery(plan.Tiers).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get create fee plan tiers sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, tiersSQLQuery, tiersArgs...); err != nil {
+			return fmt.Errorf("failed to Exec create fee plan tiers sql query: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return NewSetFeePlanError("failed to set fee plan", err)
+	}
+
+	return nil
+}
+
+// GetFeePlans retrieves the fee plans of a merchant with their tiers.
+func (repo *feeRepo) GetFeePlans(ctx context.Context, merchantID string) ([]*model.FeePlan, error) {
+	plans, err := repo.getFeePlans(ctx, getFeePlansQuery(merchantID))
+	if err != nil {
+		return nil, NewGetFeePlansError("failed to get fee plans", err)
+	}
+
+	return plans, nil
+}
+
+// GetFeePlan retrieves the fee plan of a merchant for the payment method and currency with its tiers.
+func (repo *feeRepo) GetFeePlan(ctx context.Context, merchantID, method, currency string) (*model.FeePlan, error) {
+	plans, err := repo.getFeePlans(ctx, getFeePlanQuery(merchantID, method, currency))
+	if err != nil {
+		return nil, NewGetFeePlanError("failed to get fee plan", err)
+	}
+
+	if len(plans) == 0 {
+		return nil, NewGetFeePlanError("failed to get fee plan", ErrFeePlanNotFound)
+	}
+
+	return plans[0], nil
+}
+
+// getFeePlans retrieves the fee plans selected by the query and attaches their tiers.
+func (repo *feeRepo) getFeePlans(ctx context.Context, query sq.Sqlizer) ([]*model.FeePlan, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee plans sql query: %w", err)
+	}
+
+	var plans []*model.FeePlan
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Query fee plans sql query: %w", err)
+		}
+
+		plans, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.FeePlan])
+		if err != nil {
+			return fmt.Errorf("failed to collect fee plans: %w", err)
+		}
+
+		if len(plans) == 0 {
+			return nil
+		}
+
+		planByID := make(map[string]*model.FeePlan, len(plans))
+		planIDs := make([]string, 0, len(plans))
+
+		for _, plan := range plans {
+			planByID[plan.ID] = plan
+			planIDs = append(planIDs, plan.ID)
+		}
+
+		tiersSQLQuery, tiersArgs, err := getFeePlanTiersQuery(planIDs).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get fee plan tiers sql query: %w", err)
+		}
+
+		rows, err = transactionConn.Query(ctx, tiersSQLQuery, tiersArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to Query fee plan tiers sql query: %w", err)
+		}
+
+		tiers, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.FeeTier])
+		if err != nil {
+			return fmt.Errorf("failed to collect fee plan tiers: %w", err)
+		}
+
+		for _, tier := range tiers {
+			plan := planByID[tier.FeePlanID]
+			plan.Tiers = append(plan.Tiers, tier)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// GetMerchantVolume sums the succeeded transactions the merchant received in the currency since the given time.
+func (repo *feeRepo) GetMerchantVolume(ctx context.Context, merchantID, currency string, since time.Time) (int64, error) {
+	query := getMerchantVolumeQuery(merchantID, currency, since)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return 0, NewGetMerchantVolumeError("failed to get merchant volume sql query", err)
+	}
+
+	var volume int64
+
+	if err := repo.pg.Pool.QueryRow(ctx, sqlQuery, args...).Scan(&volume); err != nil {
+		return 0, NewGetMerchantVolumeError("failed to QueryRow merchant volume sql query", err)
+	}
+
+	return volume, nil
+}
+
+// createFeeReceiverInTx stores the platform wallet the fee of an accepted transaction is paid to.
+func (repo *transactionRepo) createFeeReceiverInTx(ctx context.Context, fee *model.Fee) error {
+	if fee == nil {
+		return nil
+	}
+
+	if err := repo.createTransactionUserInTx(ctx, fee.Receiver); err != nil {
+		return fmt.Errorf("failed to create fee receiver in tx: %w", err)
+	}
+
+	return nil
+}
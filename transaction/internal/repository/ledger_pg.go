@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -package mocks -destination mocks/ledger_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository LedgerRepo
+
+// LedgerRepo defines the interface for reading the ledger.
+// Entries are written by the transaction repository together with every status transition.
+type LedgerRepo interface {
+	GetAccount(ctx context.Context, accountID string) (*ledger.Account, error)
+	GetBalances(ctx context.Context, accountID string) ([]*ledger.Balance, error)
+	GetStatement(ctx context.Context, accountID string, limit, offset uint64) ([]*ledger.StatementLine, error)
+}
+
+type ledgerRepo struct {
+	pg  *postgres.Postgres
+	log logger.Logger
+}
+
+// NewLedgerRepo creates a new instance of LedgerRepo.
+func NewLedgerRepo(
+	pg *postgres.Postgres,
+	log logger.Logger,
+) LedgerRepo {
+	return &ledgerRepo{
+		pg:  pg,
+		log: log,
+	}
+}
+
+// GetAccount retrieves a ledger account, accounts are created with their first posting.
+func (repo *ledgerRepo) GetAccount(ctx context.Context, accountID string) (*ledger.Account, error) {
+	query := getLedgerAccountQuery(accountID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetLedgerAccountError("failed to get ledger account sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetLedgerAccountError("failed to Query get ledger account sql query", err)
+	}
+
+	account, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[ledger.Account])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NewGetLedgerAccountError("failed to get ledger account", ErrLedgerAccountNotFound)
+		}
+
+		return nil, NewGetLedgerAccountError("failed to collect ledger account", err)
+	}
+
+	return account, nil
+}
+
+// GetBalances retrieves the balances of a ledger account by currency.
+func (repo *ledgerRepo) GetBalances(ctx context.Context, accountID string) ([]*ledger.Balance, error) {
+	query := getLedgerBalancesQuery(accountID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetLedgerBalancesError("failed to get ledger balances sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetLedgerBalancesError("failed to Query ledger balances sql query", err)
+	}
+
+	balances, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ledger.Balance])
+	if err != nil {
+		return nil, NewGetLedgerBalancesError("failed to collect ledger balances", err)
+	}
+
+	return balances, nil
+}
+
+// GetStatement retrieves a page of the postings of a ledger account, newest first.
+func (repo *ledgerRepo) GetStatement(
+	ctx context.Context,
+	accountID string,
+	limit, offset uint64,
+) ([]*ledger.StatementLine, error) {
+	query := getLedgerStatementQuery(accountID, limit, offset)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetLedgerStatementError("failed to get ledger statement sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetLedgerStatementError("failed to Query ledger statement sql query", err)
+	}
+
+	lines, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[ledger.StatementLine])
+	if err != nil {
+		return nil, NewGetLedgerStatementError("failed to collect ledger statement", err)
+	}
+
+	return lines, nil
+}
+
+// ledgerPosting is a posting read back together with its entry and account.
+type ledgerPosting struct {
+	EntryID       string           `db:"entry_id"`
+	ReferenceID   string           `db:"reference_id"`
+	TransactionID string           `db:"transaction_id"`
+	Kind          ledger.EntryKind `db:"kind"`
+	CreatedAt     time.Time        `db:"created_at"`
+
+	AccountID   string             `db:"account_id"`
+	AccountKind ledger.AccountKind `db:"account_kind"`
+	OwnerID     *string            `db:"owner_id"`
+	Direction   ledger.Direction   `db:"direction"`
+	Amount      int64              `db:"amount"`
+	Currency    string             `db:"currency"`
+}
+
+// bookTransactionInTx writes the ledger entries that bring the transaction and its shares to their current statuses.
+// It must run in the database transaction that changed them, so that no transition is left unbooked.
+func (repo *transactionRepo) bookTransactionInTx(ctx context.Context, transactionID string) error {
+	transaction, err := repo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction to book: %w", err)
+	}
+
+	booked, err := repo.getLedgerEntriesInTx(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	entries, err := ledger.Plan(transaction, booked, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to plan ledger entries: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := repo.createLedgerEntryInTx(ctx, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getLedgerEntriesInTx returns the ledger entries of a transaction in the order they were written.
+func (repo *transactionRepo) getLedgerEntriesInTx(ctx context.Context, transactionID string) ([]*ledger.Entry, error) {
+	sqlQuery, args, err := getLedgerPostingsQuery(transactionID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger postings sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query get ledger postings sql query: %w", err)
+	}
+
+	postings, err := pgx.CollectRows(rows, pgx.RowToStructByName[ledgerPosting])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ledger posting structures from rows: %w", err)
+	}
+
+	var entries []*ledger.Entry
+
+	for _, posting := range postings {
+		entries = appendLedgerPosting(entries, posting)
+	}
+
+	return entries, nil
+}
+
+// appendLedgerPosting adds a posting to the last entry, or to a new one when the posting starts the next entry.
+// Postings must be ordered by entry.
+func appendLedgerPosting(entries []*ledger.Entry, posting ledgerPosting) []*ledger.Entry {
+	if len(entries) == 0 || entries[len(entries)-1].ID != posting.EntryID {
+		entries = append(entries, &ledger.Entry{
+			ID:            posting.EntryID,
+			ReferenceID:   posting.ReferenceID,
+			TransactionID: posting.TransactionID,
+			Kind:          posting.Kind,
+			CreatedAt:     posting.CreatedAt,
+		})
+	}
+
+	entry := entries[len(entries)-1]
+	entry.Postings = append(entry.Postings, &ledger.Posting{
+		Account: &ledger.Account{
+			ID:      posting.AccountID,
+			Kind:    posting.AccountKind,
+			OwnerID: posting.OwnerID,
+		},
+		Direction: posting.Direction,
+		Amount:    posting.Amount,
+		Currency:  posting.Currency,
+	})
+
+	return entries
+}
+
+// createLedgerEntryInTx writes a ledger entry with its postings, creating the accounts it posts to.
+func (repo *transactionRepo) createLedgerEntryInTx(ctx context.Context, entry *ledger.Entry) error {
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	for _, posting := range entry.Postings {
+		accountSQLQuery, accountArgs, err := createLedgerAccountQuery(posting.Account, entry.CreatedAt).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get create ledger account sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, accountSQLQuery, accountArgs...); err != nil {
+			return fmt.Errorf("failed to Exec create ledger account sql query: %w", err)
+		}
+	}
+
+	entrySQLQuery, entryArgs, err := createLedgerEntryQuery(entry).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get create ledger entry sql query: %w", err)
+	}
+
+	if _, err := transactionConn.Exec(ctx, entrySQLQuery, entryArgs...); err != nil {
+		return fmt.Errorf("failed to Exec create ledger entry sql query: %w", err)
+	}
+
+	postingsSQLQuery, postingsArgs, err := createLedgerPostingsQuery(entry).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get create ledger postings sql query: %w", err)
+	}
+
+	if _, err := transactionConn.Exec(ctx, postingsSQLQuery, postingsArgs...); err != nil {
+		return fmt.Errorf("failed to Exec create ledger postings sql query: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,348 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -package mocks -destination mocks/mandate_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository MandateRepo
+
+// MandateRepo defines the interface for recurring payment mandate operations.
+// Every change of a mandate is applied to its locked row, so the scheduler and the API do not overwrite each other.
+type MandateRepo interface {
+	CreateMandate(ctx context.Context, mandate *model.Mandate) error
+	GetMandate(ctx context.Context, mandateID string) (*model.Mandate, error)
+	PauseMandate(ctx context.Context, mandateID string, now time.Time) error
+	ResumeMandate(ctx context.Context, mandateID string, now time.Time) error
+	CancelMandate(ctx context.Context, mandateID string, now time.Time) error
+	GetDueMandates(ctx context.Context, now time.Time, limit uint64) ([]string, error)
+	ChargeMandate(ctx context.Context, mandateID string, fee *model.Fee, now time.Time) (*model.Transaction, error)
+	GetMandateChargeOutcomes(ctx context.Context, limit uint64) ([]*model.MandateChargeOutcome, error)
+	SettleMandateCharge(ctx context.Context, outcome *model.MandateChargeOutcome, now time.Time, policy *model.RetryPolicy) (*model.Mandate, error)
+}
+
+type mandateRepo struct {
+	pg           *postgres.Postgres
+	transactions *transactionRepo
+	log          logger.Logger
+}
+
+// NewMandateRepo creates a new instance of MandateRepo.
+func NewMandateRepo(
+	pg *postgres.Postgres,
+	log logger.Logger,
+) MandateRepo {
+	return &mandateRepo{
+		pg: pg,
+		transactions: &transactionRepo{
+			pg:  pg,
+			log: log,
+		},
+		log: log,
+	}
+}
+
+// CreateMandate creates a new mandate with its payer and receiver in the database.
+func (repo *mandateRepo) CreateMandate(ctx context.Context, mandate *model.Mandate) error {
+	query := createMandateQuery(mandate)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewCreateMandateError("failed to get create mandate sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		if err := repo.transactions.createTransactionUserInTx(ctx, mandate.Payer); err != nil {
+			return err
+		}
+
+		if err := repo.transactions.createTransactionUserInTx(ctx, mandate.Receiver); err != nil {
+			return err
+		}
+
+		if _, err := transactionConn.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("failed to Exec create mandate sql query: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return NewCreateMandateError("failed to create mandate", err)
+	}
+
+	return nil
+}
+
+// GetMandate retrieves a mandate with its payer and receiver from the database.
+func (repo *mandateRepo) GetMandate(ctx context.Context, mandateID string) (*model.Mandate, error) {
+	var mandate *model.Mandate
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+
+		mandate, err = repo.getMandateInTx(ctx, getMandateQuery(mandateID))
+
+		return err
+	}); err != nil {
+		return nil, NewGetMandateError("failed to get mandate", err)
+	}
+
+	return mandate, nil
+}
+
+// getMandateInTx retrieves the mandate selected by query with its payer and receiver.
+func (repo *mandateRepo) getMandateInTx(ctx context.Context, query sq.Sqlizer) (*model.Mandate, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mandate sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to Query mandate sql query: %w", err)
+	}
+
+	mandate, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Mandate])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrMandateNotFound
+		}
+
+		return nil, fmt.Errorf("failed to collect mandate: %w", err)
+	}
+
+	mandate.Payer, err = repo.transactions.getTransactionUserInTx(ctx, mandate.PayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	mandate.Receiver, err = repo.transactions.getTransactionUserInTx(ctx, mandate.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return mandate, nil
+}
+
+// updateMandateInTx locks the mandate, applies change to it and stores the result.
+func (repo *mandateRepo) updateMandateInTx(ctx context.Context, mandateID string, change func(*model.Mandate) error) (*model.Mandate, error) {
+	mandate, err := repo.getMandateInTx(ctx, lockMandateQuery(mandateID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := change(mandate); err != nil {
+		return nil, err
+	}
+
+	sqlQuery, args, err := updateMandateQuery(mandate).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get update mandate sql query: %w", err)
+	}
+
+	if _, err := repo.pg.GetTransactionConn(ctx).Exec(ctx, sqlQuery, args...); err != nil {
+		return nil, fmt.Errorf("failed to Exec update mandate sql query: %w", err)
+	}
+
+	return mandate, nil
+}
+
+// PauseMandate stops charging an active or past due mandate.
+func (repo *mandateRepo) PauseMandate(ctx context.Context, mandateID string, now time.Time) error {
+	return repo.changeMandate(ctx, mandateID, func(mandate *model.Mandate) error {
+		return mandate.Pause(now)
+	})
+}
+
+// ResumeMandate charges a paused or unpaid mandate again.
+func (repo *mandateRepo) ResumeMandate(ctx context.Context, mandateID string, now time.Time) error {
+	return repo.changeMandate(ctx, mandateID, func(mandate *model.Mandate) error {
+		return mandate.Resume(now)
+	})
+}
+
+// CancelMandate stops charging the mandate for good.
+func (repo *mandateRepo) CancelMandate(ctx context.Context, mandateID string, now time.Time) error {
+	return repo.changeMandate(ctx, mandateID, func(mandate *model.Mandate) error {
+		return mandate.Cancel(now)
+	})
+}
+
+// changeMandate applies change to the locked mandate in its own transaction.
+func (repo *mandateRepo) changeMandate(ctx context.Context, mandateID string, change func(*model.Mandate) error) error {
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		_, err := repo.updateMandateInTx(ctx, mandateID, change)
+
+		return err
+	}); err != nil {
+		return NewUpdateMandateError("failed to update mandate", err)
+	}
+
+	return nil
+}
+
+// GetDueMandates retrieves the ids of up to limit mandates that have to be charged by now, longest due first.
+func (repo *mandateRepo) GetDueMandates(ctx context.Context, now time.Time, limit uint64) ([]string, error) {
+	query := dueMandatesQuery(now, limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetDueMandatesError("failed to get due mandates sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetDueMandatesError("failed to Query due mandates sql query", err)
+	}
+
+	mandateIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, NewGetDueMandatesError("failed to collect due mandate ids", err)
+	}
+
+	return mandateIDs, nil
+}
+
+// ChargeMandate creates the transaction charging the current period of a due mandate and accepts it
+// on behalf of the payer with the fee calculated for the charge, if any.
+// The accepted transaction is returned to be handed over to the payment gateway.
+func (repo *mandateRepo) ChargeMandate(ctx context.Context, mandateID string, fee *model.Fee, now time.Time) (*model.Transaction, error) {
+	var transaction *model.Transaction
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		_, err := repo.updateMandateInTx(ctx, mandateID, func(mandate *model.Mandate) error {
+			if !mandate.Due(now) {
+				return ErrMandateNotDue
+			}
+
+			transaction = mandate.NewCharge(now)
+
+			if err := repo.transactions.createTransactionUserInTx(ctx, transaction.Receiver); err != nil {
+				return err
+			}
+
+			if err := repo.transactions.createTransactionUserInTx(ctx, transaction.Sender); err != nil {
+				return err
+			}
+
+			if err := repo.transactions.createFeeReceiverInTx(ctx, fee); err != nil {
+				return err
+			}
+
+			transactionSQLQuery, transactionArgs, err := createTransactionQuery(transaction).ToSql()
+			if err != nil {
+				return fmt.Errorf("failed to get create transaction sql query: %w", err)
+			}
+
+			if _, err := transactionConn.Exec(ctx, transactionSQLQuery, transactionArgs...); err != nil {
+				return fmt.Errorf("failed to Exec create transaction sql query: %w", err)
+			}
+
+			acceptSQLQuery, acceptArgs, err := acceptTransactionQuery(transaction.ID, transaction.Sender.ID, fee, nil).ToSql()
+			if err != nil {
+				return fmt.Errorf("failed to get accept transaction sql query: %w", err)
+			}
+
+			if _, err := transactionConn.Exec(ctx, acceptSQLQuery, acceptArgs...); err != nil {
+				return fmt.Errorf("failed to Exec accept transaction sql query: %w", err)
+			}
+
+			if err := repo.transactions.bookTransactionInTx(ctx, transaction.ID); err != nil {
+				return err
+			}
+
+			transaction.Status = model.Processed
+
+			if fee != nil {
+				transaction.Fee = fee.Amount
+				transaction.FeeReceiverID = &fee.Receiver.ID
+				transaction.FeeReceiver = fee.Receiver
+			}
+
+			linkSQLQuery, linkArgs, err := createMandateTransactionQuery(mandate.ID, transaction.ID).ToSql()
+			if err != nil {
+				return fmt.Errorf("failed to get create mandate transaction sql query: %w", err)
+			}
+
+			if _, err := transactionConn.Exec(ctx, linkSQLQuery, linkArgs...); err != nil {
+				return fmt.Errorf("failed to Exec create mandate transaction sql query: %w", err)
+			}
+
+			mandate.Charged(transaction.ID, now)
+
+			return nil
+		})
+
+		return err
+	}); err != nil {
+		return nil, NewChargeMandateError("failed to charge mandate", err)
+	}
+
+	return transaction, nil
+}
+
+// GetMandateChargeOutcomes retrieves up to limit charges that are being paid and whose transactions reached a final status.
+func (repo *mandateRepo) GetMandateChargeOutcomes(ctx context.Context, limit uint64) ([]*model.MandateChargeOutcome, error) {
+	query := mandateChargeOutcomesQuery(limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetMandateChargeOutcomesError("failed to get mandate charge outcomes sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetMandateChargeOutcomesError("failed to Query mandate charge outcomes sql query", err)
+	}
+
+	outcomes, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.MandateChargeOutcome])
+	if err != nil {
+		return nil, NewGetMandateChargeOutcomesError("failed to collect mandate charge outcomes", err)
+	}
+
+	return outcomes, nil
+}
+
+// SettleMandateCharge applies the final status of the charge transaction to its mandate.
+// An outcome of a charge the mandate no longer waits for is ignored.
+func (repo *mandateRepo) SettleMandateCharge(
+	ctx context.Context,
+	outcome *model.MandateChargeOutcome,
+	now time.Time,
+	policy *model.RetryPolicy,
+) (*model.Mandate, error) {
+	var mandate *model.Mandate
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+
+		mandate, err = repo.updateMandateInTx(ctx, outcome.MandateID, func(mandate *model.Mandate) error {
+			if mandate.ChargeTransactionID == nil || *mandate.ChargeTransactionID != outcome.TransactionID {
+				return nil
+			}
+
+			mandate.Settle(outcome, now, policy)
+
+			return nil
+		})
+
+		return err
+	}); err != nil {
+		return nil, NewSettleMandateChargeError("failed to settle mandate charge", err)
+	}
+
+	return mandate, nil
+}
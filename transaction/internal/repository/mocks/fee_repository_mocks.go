@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/repository (interfaces: FeeRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/fee_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository FeeRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeeRepo is a mock of FeeRepo interface.
+type MockFeeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeRepoMockRecorder
+}
+
+// MockFeeRepoMockRecorder is the mock recorder for MockFeeRepo.
+type MockFeeRepoMockRecorder struct {
+	mock *MockFeeRepo
+}
+
+// NewMockFeeRepo creates a new mock instance.
+func NewMockFeeRepo(ctrl *gomock.Controller) *MockFeeRepo {
+	mock := &MockFeeRepo{ctrl: ctrl}
+	mock.recorder = &MockFeeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeRepo) EXPECT() *MockFeeRepoMockRecorder {
+	return m.recorder
+}
+
+// GetFeePlan mocks base method.
+func (m *MockFeeRepo) GetFeePlan(arg0 context.Context, arg1, arg2, arg3 string) (*model.FeePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeePlan", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.FeePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeePlan indicates an expected call of GetFeePlan.
+func (mr *MockFeeRepoMockRecorder) GetFeePlan(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePlan", reflect.TypeOf((*MockFeeRepo)(nil).GetFeePlan), arg0, arg1, arg2, arg3)
+}
+
+// GetFeePlans mocks base method.
+func (m *MockFeeRepo) GetFeePlans(arg0 context.Context, arg1 string) ([]*model.FeePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeePlans", arg0, arg1)
+	ret0, _ := ret[0].([]*model.FeePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeePlans indicates an expected call of GetFeePlans.
+func (mr *MockFeeRepoMockRecorder) GetFeePlans(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePlans", reflect.TypeOf((*MockFeeRepo)(nil).GetFeePlans), arg0, arg1)
+}
+
+// GetMerchantVolume mocks base method.
+func (m *MockFeeRepo) GetMerchantVolume(arg0 context.Context, arg1, arg2 string, arg3 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMerchantVolume", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMerchantVolume indicates an expected call of GetMerchantVolume.
+func (mr *MockFeeRepoMockRecorder) GetMerchantVolume(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMerchantVolume", reflect.TypeOf((*MockFeeRepo)(nil).GetMerchantVolume), arg0, arg1, arg2, arg3)
+}
+
+// SetFeePlan mocks base method.
+func (m *MockFeeRepo) SetFeePlan(arg0 context.Context, arg1 *model.FeePlan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeePlan", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeePlan indicates an expected call of SetFeePlan.
+func (mr *MockFeeRepoMockRecorder) SetFeePlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeePlan", reflect.TypeOf((*MockFeeRepo)(nil).SetFeePlan), arg0, arg1)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/repository (interfaces: LedgerRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/ledger_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository LedgerRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ledger "github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerRepo is a mock of LedgerRepo interface.
+type MockLedgerRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepoMockRecorder
+}
+
+// MockLedgerRepoMockRecorder is the mock recorder for MockLedgerRepo.
+type MockLedgerRepoMockRecorder struct {
+	mock *MockLedgerRepo
+}
+
+// NewMockLedgerRepo creates a new mock instance.
+func NewMockLedgerRepo(ctrl *gomock.Controller) *MockLedgerRepo {
+	mock := &MockLedgerRepo{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepo) EXPECT() *MockLedgerRepoMockRecorder {
+	return m.recorder
+}
+
+// GetAccount mocks base method.
+func (m *MockLedgerRepo) GetAccount(arg0 context.Context, arg1 string) (*ledger.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", arg0, arg1)
+	ret0, _ := ret[0].(*ledger.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockLedgerRepoMockRecorder) GetAccount(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockLedgerRepo)(nil).GetAccount), arg0, arg1)
+}
+
+// GetBalances mocks base method.
+func (m *MockLedgerRepo) GetBalances(arg0 context.Context, arg1 string) ([]*ledger.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalances", arg0, arg1)
+	ret0, _ := ret[0].([]*ledger.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalances indicates an expected call of GetBalances.
+func (mr *MockLedgerRepoMockRecorder) GetBalances(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockLedgerRepo)(nil).GetBalances), arg0, arg1)
+}
+
+// GetStatement mocks base method.
+func (m *MockLedgerRepo) GetStatement(arg0 context.Context, arg1 string, arg2, arg3 uint64) ([]*ledger.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*ledger.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockLedgerRepoMockRecorder) GetStatement(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockLedgerRepo)(nil).GetStatement), arg0, arg1, arg2, arg3)
+}
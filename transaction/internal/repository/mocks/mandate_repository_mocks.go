@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/repository (interfaces: MandateRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/mandate_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository MandateRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMandateRepo is a mock of MandateRepo interface.
+type MockMandateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockMandateRepoMockRecorder
+}
+
+// MockMandateRepoMockRecorder is the mock recorder for MockMandateRepo.
+type MockMandateRepoMockRecorder struct {
+	mock *MockMandateRepo
+}
+
+// NewMockMandateRepo creates a new mock instance.
+func NewMockMandateRepo(ctrl *gomock.Controller) *MockMandateRepo {
+	mock := &MockMandateRepo{ctrl: ctrl}
+	mock.recorder = &MockMandateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMandateRepo) EXPECT() *MockMandateRepoMockRecorder {
+	return m.recorder
+}
+
+// CancelMandate mocks base method.
+func (m *MockMandateRepo) CancelMandate(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelMandate indicates an expected call of CancelMandate.
+func (mr *MockMandateRepoMockRecorder) CancelMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMandate", reflect.TypeOf((*MockMandateRepo)(nil).CancelMandate), arg0, arg1, arg2)
+}
+
+// ChargeMandate mocks base method.
+func (m *MockMandateRepo) ChargeMandate(arg0 context.Context, arg1 string, arg2 *model.Fee, arg3 time.Time) (*model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeMandate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChargeMandate indicates an expected call of ChargeMandate.
+func (mr *MockMandateRepoMockRecorder) ChargeMandate(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeMandate", reflect.TypeOf((*MockMandateRepo)(nil).ChargeMandate), arg0, arg1, arg2, arg3)
+}
+
+// CreateMandate mocks base method.
+func (m *MockMandateRepo) CreateMandate(arg0 context.Context, arg1 *model.Mandate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMandate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMandate indicates an expected call of CreateMandate.
+func (mr *MockMandateRepoMockRecorder) CreateMandate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMandate", reflect.TypeOf((*MockMandateRepo)(nil).CreateMandate), arg0, arg1)
+}
+
+// GetDueMandates mocks base method.
+func (m *MockMandateRepo) GetDueMandates(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueMandates", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueMandates indicates an expected call of GetDueMandates.
+func (mr *MockMandateRepoMockRecorder) GetDueMandates(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueMandates", reflect.TypeOf((*MockMandateRepo)(nil).GetDueMandates), arg0, arg1, arg2)
+}
+
+// GetMandate mocks base method.
+func (m *MockMandateRepo) GetMandate(arg0 context.Context, arg1 string) (*model.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandate", arg0, arg1)
+	ret0, _ := ret[0].(*model.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandate indicates an expected call of GetMandate.
+func (mr *MockMandateRepoMockRecorder) GetMandate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandate", reflect.TypeOf((*MockMandateRepo)(nil).GetMandate), arg0, arg1)
+}
+
+// GetMandateChargeOutcomes mocks base method.
+func (m *MockMandateRepo) GetMandateChargeOutcomes(arg0 context.Context, arg1 uint64) ([]*model.MandateChargeOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandateChargeOutcomes", arg0, arg1)
+	ret0, _ := ret[0].([]*model.MandateChargeOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandateChargeOutcomes indicates an expected call of GetMandateChargeOutcomes.
+func (mr *MockMandateRepoMockRecorder) GetMandateChargeOutcomes(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandateChargeOutcomes", reflect.TypeOf((*MockMandateRepo)(nil).GetMandateChargeOutcomes), arg0, arg1)
+}
+
+// PauseMandate mocks base method.
+func (m *MockMandateRepo) PauseMandate(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseMandate indicates an expected call of PauseMandate.
+func (mr *MockMandateRepoMockRecorder) PauseMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseMandate", reflect.TypeOf((*MockMandateRepo)(nil).PauseMandate), arg0, arg1, arg2)
+}
+
+// ResumeMandate mocks base method.
+func (m *MockMandateRepo) ResumeMandate(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeMandate indicates an expected call of ResumeMandate.
+func (mr *MockMandateRepoMockRecorder) ResumeMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeMandate", reflect.TypeOf((*MockMandateRepo)(nil).ResumeMandate), arg0, arg1, arg2)
+}
+
+// SettleMandateCharge mocks base method.
+func (m *MockMandateRepo) SettleMandateCharge(arg0 context.Context, arg1 *model.MandateChargeOutcome, arg2 time.Time, arg3 *model.RetryPolicy) (*model.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleMandateCharge", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleMandateCharge indicates an expected call of SettleMandateCharge.
+func (mr *MockMandateRepoMockRecorder) SettleMandateCharge(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleMandateCharge", reflect.TypeOf((*MockMandateRepo)(nil).SettleMandateCharge), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/repository (interfaces: PaymentPointRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/payment_point_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository PaymentPointRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentPointRepo is a mock of PaymentPointRepo interface.
+type MockPaymentPointRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentPointRepoMockRecorder
+}
+
+// MockPaymentPointRepoMockRecorder is the mock recorder for MockPaymentPointRepo.
+type MockPaymentPointRepoMockRecorder struct {
+	mock *MockPaymentPointRepo
+}
+
+// NewMockPaymentPointRepo creates a new mock instance.
+func NewMockPaymentPointRepo(ctrl *gomock.Controller) *MockPaymentPointRepo {
+	mock := &MockPaymentPointRepo{ctrl: ctrl}
+	mock.recorder = &MockPaymentPointRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentPointRepo) EXPECT() *MockPaymentPointRepoMockRecorder {
+	return m.recorder
+}
+
+// CreatePaymentPoint mocks base method.
+func (m *MockPaymentPointRepo) CreatePaymentPoint(arg0 context.Context, arg1 *model.PaymentPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentPoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePaymentPoint indicates an expected call of CreatePaymentPoint.
+func (mr *MockPaymentPointRepoMockRecorder) CreatePaymentPoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentPoint", reflect.TypeOf((*MockPaymentPointRepo)(nil).CreatePaymentPoint), arg0, arg1)
+}
+
+// CreatePaymentPointTransaction mocks base method.
+func (m *MockPaymentPointRepo) CreatePaymentPointTransaction(arg0 context.Context, arg1 string, arg2 *model.Transaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentPointTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePaymentPointTransaction indicates an expected call of CreatePaymentPointTransaction.
+func (mr *MockPaymentPointRepoMockRecorder) CreatePaymentPointTransaction(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentPointTransaction", reflect.TypeOf((*MockPaymentPointRepo)(nil).CreatePaymentPointTransaction), arg0, arg1, arg2)
+}
+
+// DisablePaymentPoint mocks base method.
+func (m *MockPaymentPointRepo) DisablePaymentPoint(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisablePaymentPoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisablePaymentPoint indicates an expected call of DisablePaymentPoint.
+func (mr *MockPaymentPointRepoMockRecorder) DisablePaymentPoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisablePaymentPoint", reflect.TypeOf((*MockPaymentPointRepo)(nil).DisablePaymentPoint), arg0, arg1)
+}
+
+// GetPaymentPoint mocks base method.
+func (m *MockPaymentPointRepo) GetPaymentPoint(arg0 context.Context, arg1 string) (*model.PaymentPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentPoint", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentPoint indicates an expected call of GetPaymentPoint.
+func (mr *MockPaymentPointRepoMockRecorder) GetPaymentPoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentPoint", reflect.TypeOf((*MockPaymentPointRepo)(nil).GetPaymentPoint), arg0, arg1)
+}
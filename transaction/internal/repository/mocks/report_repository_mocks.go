@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/repository (interfaces: ReportRepo)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/report_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository ReportRepo
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	report "github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportRepo is a mock of ReportRepo interface.
+type MockReportRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepoMockRecorder
+}
+
+// MockReportRepoMockRecorder is the mock recorder for MockReportRepo.
+type MockReportRepoMockRecorder struct {
+	mock *MockReportRepo
+}
+
+// NewMockReportRepo creates a new mock instance.
+func NewMockReportRepo(ctrl *gomock.Controller) *MockReportRepo {
+	mock := &MockReportRepo{ctrl: ctrl}
+	mock.recorder = &MockReportRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepo) EXPECT() *MockReportRepoMockRecorder {
+	return m.recorder
+}
+
+// GetSettledEntries mocks base method.
+func (m *MockReportRepo) GetSettledEntries(arg0 context.Context, arg1, arg2 time.Time, arg3 string) ([]*report.SettledEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettledEntries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*report.SettledEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettledEntries indicates an expected call of GetSettledEntries.
+func (mr *MockReportRepoMockRecorder) GetSettledEntries(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettledEntries", reflect.TypeOf((*MockReportRepo)(nil).GetSettledEntries), arg0, arg1, arg2, arg3)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -41,17 +42,45 @@ func (m *MockTransactionRepo) EXPECT() *MockTransactionRepoMockRecorder {
 }
 
 // AcceptTransaction mocks base method.
-func (m *MockTransactionRepo) AcceptTransaction(arg0 context.Context, arg1 string, arg2 *model.TransactionUser) error {
+func (m *MockTransactionRepo) AcceptTransaction(arg0 context.Context, arg1 string, arg2 *model.TransactionUser, arg3 *model.Fee, arg4 *time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptTransaction", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "AcceptTransaction", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AcceptTransaction indicates an expected call of AcceptTransaction.
-func (mr *MockTransactionRepoMockRecorder) AcceptTransaction(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockTransactionRepoMockRecorder) AcceptTransaction(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).AcceptTransaction), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).AcceptTransaction), arg0, arg1, arg2, arg3, arg4)
+}
+
+// AuthorizeTransaction mocks base method.
+func (m *MockTransactionRepo) AuthorizeTransaction(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AuthorizeTransaction indicates an expected call of AuthorizeTransaction.
+func (mr *MockTransactionRepoMockRecorder) AuthorizeTransaction(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).AuthorizeTransaction), arg0, arg1, arg2)
+}
+
+// CancelPendingTransaction mocks base method.
+func (m *MockTransactionRepo) CancelPendingTransaction(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelPendingTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelPendingTransaction indicates an expected call of CancelPendingTransaction.
+func (mr *MockTransactionRepoMockRecorder) CancelPendingTransaction(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelPendingTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).CancelPendingTransaction), arg0, arg1, arg2)
 }
 
 // CancelTransaction mocks base method.
@@ -68,6 +97,20 @@ func (mr *MockTransactionRepoMockRecorder) CancelTransaction(arg0, arg1, arg2 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).CancelTransaction), arg0, arg1, arg2)
 }
 
+// CaptureTransaction mocks base method.
+func (m *MockTransactionRepo) CaptureTransaction(arg0 context.Context, arg1 string, arg2 int64, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureTransaction", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CaptureTransaction indicates an expected call of CaptureTransaction.
+func (mr *MockTransactionRepoMockRecorder) CaptureTransaction(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).CaptureTransaction), arg0, arg1, arg2, arg3)
+}
+
 // ChangeTransactionStatus mocks base method.
 func (m *MockTransactionRepo) ChangeTransactionStatus(arg0 context.Context, arg1 string, arg2 model.TransactionStatus) error {
 	m.ctrl.T.Helper()
@@ -82,6 +125,66 @@ func (mr *MockTransactionRepoMockRecorder) ChangeTransactionStatus(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeTransactionStatus", reflect.TypeOf((*MockTransactionRepo)(nil).ChangeTransactionStatus), arg0, arg1, arg2)
 }
 
+// ClaimExpiredHolds mocks base method.
+func (m *MockTransactionRepo) ClaimExpiredHolds(arg0 context.Context, arg1, arg2 time.Time, arg3 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimExpiredHolds", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimExpiredHolds indicates an expected call of ClaimExpiredHolds.
+func (mr *MockTransactionRepoMockRecorder) ClaimExpiredHolds(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimExpiredHolds", reflect.TypeOf((*MockTransactionRepo)(nil).ClaimExpiredHolds), arg0, arg1, arg2, arg3)
+}
+
+// ClaimShare mocks base method.
+func (m *MockTransactionRepo) ClaimShare(arg0 context.Context, arg1 string, arg2 *model.TransactionUser) (*model.TransactionShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimShare", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.TransactionShare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimShare indicates an expected call of ClaimShare.
+func (mr *MockTransactionRepoMockRecorder) ClaimShare(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimShare", reflect.TypeOf((*MockTransactionRepo)(nil).ClaimShare), arg0, arg1, arg2)
+}
+
+// CloseGroupTransactions mocks base method.
+func (m *MockTransactionRepo) CloseGroupTransactions(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]string, []*model.TransactionShare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseGroupTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].([]*model.TransactionShare)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CloseGroupTransactions indicates an expected call of CloseGroupTransactions.
+func (mr *MockTransactionRepoMockRecorder) CloseGroupTransactions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseGroupTransactions", reflect.TypeOf((*MockTransactionRepo)(nil).CloseGroupTransactions), arg0, arg1, arg2)
+}
+
+// ConfirmTransaction mocks base method.
+func (m *MockTransactionRepo) ConfirmTransaction(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTransaction indicates an expected call of ConfirmTransaction.
+func (mr *MockTransactionRepoMockRecorder) ConfirmTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).ConfirmTransaction), arg0, arg1)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockTransactionRepo) CreateTransaction(arg0 context.Context, arg1 *model.Transaction) error {
 	m.ctrl.T.Helper()
@@ -96,6 +199,95 @@ func (mr *MockTransactionRepoMockRecorder) CreateTransaction(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).CreateTransaction), arg0, arg1)
 }
 
+// ExecuteScheduledTransactions mocks base method.
+func (m *MockTransactionRepo) ExecuteScheduledTransactions(arg0 context.Context, arg1 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteScheduledTransactions", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteScheduledTransactions indicates an expected call of ExecuteScheduledTransactions.
+func (mr *MockTransactionRepoMockRecorder) ExecuteScheduledTransactions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteScheduledTransactions", reflect.TypeOf((*MockTransactionRepo)(nil).ExecuteScheduledTransactions), arg0, arg1)
+}
+
+// ExpireTransactions mocks base method.
+func (m *MockTransactionRepo) ExpireTransactions(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireTransactions indicates an expected call of ExpireTransactions.
+func (mr *MockTransactionRepoMockRecorder) ExpireTransactions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireTransactions", reflect.TypeOf((*MockTransactionRepo)(nil).ExpireTransactions), arg0, arg1, arg2)
+}
+
+// FailTransaction mocks base method.
+func (m *MockTransactionRepo) FailTransaction(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailTransaction indicates an expected call of FailTransaction.
+func (mr *MockTransactionRepoMockRecorder) FailTransaction(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).FailTransaction), arg0, arg1, arg2)
+}
+
+// GetConfirmation mocks base method.
+func (m *MockTransactionRepo) GetConfirmation(arg0 context.Context, arg1 string) (*model.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfirmation", arg0, arg1)
+	ret0, _ := ret[0].(*model.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfirmation indicates an expected call of GetConfirmation.
+func (mr *MockTransactionRepoMockRecorder) GetConfirmation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfirmation", reflect.TypeOf((*MockTransactionRepo)(nil).GetConfirmation), arg0, arg1)
+}
+
+// GetOverdueTransactions mocks base method.
+func (m *MockTransactionRepo) GetOverdueTransactions(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueTransactions indicates an expected call of GetOverdueTransactions.
+func (mr *MockTransactionRepoMockRecorder) GetOverdueTransactions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueTransactions", reflect.TypeOf((*MockTransactionRepo)(nil).GetOverdueTransactions), arg0, arg1, arg2)
+}
+
+// GetSucceededTransactions mocks base method.
+func (m *MockTransactionRepo) GetSucceededTransactions(arg0 context.Context, arg1 string, arg2, arg3 time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSucceededTransactions", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSucceededTransactions indicates an expected call of GetSucceededTransactions.
+func (mr *MockTransactionRepoMockRecorder) GetSucceededTransactions(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSucceededTransactions", reflect.TypeOf((*MockTransactionRepo)(nil).GetSucceededTransactions), arg0, arg1, arg2, arg3)
+}
+
 // GetTransaction mocks base method.
 func (m *MockTransactionRepo) GetTransaction(arg0 context.Context, arg1 string) (*model.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +318,135 @@ func (mr *MockTransactionRepoMockRecorder) GetTransactionStatus(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionStatus", reflect.TypeOf((*MockTransactionRepo)(nil).GetTransactionStatus), arg0, arg1)
 }
 
+// GetUnresolvedTransactions mocks base method.
+func (m *MockTransactionRepo) GetUnresolvedTransactions(arg0 context.Context, arg1 time.Time, arg2 uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnresolvedTransactions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnresolvedTransactions indicates an expected call of GetUnresolvedTransactions.
+func (mr *MockTransactionRepoMockRecorder) GetUnresolvedTransactions(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnresolvedTransactions", reflect.TypeOf((*MockTransactionRepo)(nil).GetUnresolvedTransactions), arg0, arg1, arg2)
+}
+
+// IncrementConfirmationAttempts mocks base method.
+func (m *MockTransactionRepo) IncrementConfirmationAttempts(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementConfirmationAttempts", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementConfirmationAttempts indicates an expected call of IncrementConfirmationAttempts.
+func (mr *MockTransactionRepoMockRecorder) IncrementConfirmationAttempts(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementConfirmationAttempts", reflect.TypeOf((*MockTransactionRepo)(nil).IncrementConfirmationAttempts), arg0, arg1)
+}
+
+// LockQuote mocks base method.
+func (m *MockTransactionRepo) LockQuote(arg0 context.Context, arg1 *model.Quote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockQuote", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockQuote indicates an expected call of LockQuote.
+func (mr *MockTransactionRepoMockRecorder) LockQuote(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockQuote", reflect.TypeOf((*MockTransactionRepo)(nil).LockQuote), arg0, arg1)
+}
+
+// MarkStatusRequested mocks base method.
+func (m *MockTransactionRepo) MarkStatusRequested(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStatusRequested", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStatusRequested indicates an expected call of MarkStatusRequested.
+func (mr *MockTransactionRepoMockRecorder) MarkStatusRequested(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStatusRequested", reflect.TypeOf((*MockTransactionRepo)(nil).MarkStatusRequested), arg0, arg1, arg2)
+}
+
+// ReleaseCapture mocks base method.
+func (m *MockTransactionRepo) ReleaseCapture(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseCapture", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseCapture indicates an expected call of ReleaseCapture.
+func (mr *MockTransactionRepoMockRecorder) ReleaseCapture(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseCapture", reflect.TypeOf((*MockTransactionRepo)(nil).ReleaseCapture), arg0, arg1)
+}
+
+// RequestConfirmation mocks base method.
+func (m *MockTransactionRepo) RequestConfirmation(arg0 context.Context, arg1 *model.TransactionUser, arg2 *model.Fee, arg3 *model.Confirmation, arg4 *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestConfirmation", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestConfirmation indicates an expected call of RequestConfirmation.
+func (mr *MockTransactionRepoMockRecorder) RequestConfirmation(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestConfirmation", reflect.TypeOf((*MockTransactionRepo)(nil).RequestConfirmation), arg0, arg1, arg2, arg3, arg4)
+}
+
+// RequestVoid mocks base method.
+func (m *MockTransactionRepo) RequestVoid(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestVoid", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestVoid indicates an expected call of RequestVoid.
+func (mr *MockTransactionRepoMockRecorder) RequestVoid(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestVoid", reflect.TypeOf((*MockTransactionRepo)(nil).RequestVoid), arg0, arg1, arg2)
+}
+
+// ResetConfirmation mocks base method.
+func (m *MockTransactionRepo) ResetConfirmation(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetConfirmation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetConfirmation indicates an expected call of ResetConfirmation.
+func (mr *MockTransactionRepoMockRecorder) ResetConfirmation(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConfirmation", reflect.TypeOf((*MockTransactionRepo)(nil).ResetConfirmation), arg0, arg1)
+}
+
+// SettleShare mocks base method.
+func (m *MockTransactionRepo) SettleShare(arg0 context.Context, arg1 string, arg2 bool, arg3 string) (*model.ShareSettlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleShare", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.ShareSettlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleShare indicates an expected call of SettleShare.
+func (mr *MockTransactionRepoMockRecorder) SettleShare(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleShare", reflect.TypeOf((*MockTransactionRepo)(nil).SettleShare), arg0, arg1, arg2, arg3)
+}
+
 // UpdateTransaction mocks base method.
 func (m *MockTransactionRepo) UpdateTransaction(arg0 context.Context, arg1 *model.Transaction) error {
 	m.ctrl.T.Helper()
@@ -139,3 +460,17 @@ func (mr *MockTransactionRepoMockRecorder) UpdateTransaction(arg0, arg1 any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).UpdateTransaction), arg0, arg1)
 }
+
+// VoidTransaction mocks base method.
+func (m *MockTransactionRepo) VoidTransaction(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidTransaction indicates an expected call of VoidTransaction.
+func (mr *MockTransactionRepoMockRecorder) VoidTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidTransaction", reflect.TypeOf((*MockTransactionRepo)(nil).VoidTransaction), arg0, arg1)
+}
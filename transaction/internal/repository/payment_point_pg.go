@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -package mocks -destination mocks/payment_point_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository PaymentPointRepo
+
+// PaymentPointRepo defines the interface for static payment point operations.
+type PaymentPointRepo interface {
+	CreatePaymentPoint(ctx context.Context, paymentPoint *model.PaymentPoint) error
+	GetPaymentPoint(ctx context.Context, paymentPointID string) (*model.PaymentPoint, error)
+	DisablePaymentPoint(ctx context.Context, paymentPointID string) error
+	CreatePaymentPointTransaction(ctx context.Context, paymentPointID string, transaction *model.Transaction) error
+}
+
+type paymentPointRepo struct {
+	pg           *postgres.Postgres
+	transactions *transactionRepo
+	log          logger.Logger
+}
+
+// NewPaymentPointRepo creates a new instance of PaymentPointRepo.
+func NewPaymentPointRepo(
+	pg *postgres.Postgres,
+	log logger.Logger,
+) PaymentPointRepo {
+	return &paymentPointRepo{
+		pg: pg,
+		transactions: &transactionRepo{
+			pg:  pg,
+			log: log,
+		},
+		log: log,
+	}
+}
+
+// CreatePaymentPoint creates a new payment point with its receiver in the database.
+func (repo *paymentPointRepo) CreatePaymentPoint(ctx context.Context, paymentPoint *model.PaymentPoint) error {
+	query := createPaymentPointQuery(paymentPoint)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewCreatePaymentPointError("failed to get create payment point sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		if err := repo.transactions.createTransactionUserInTx(ctx, paymentPoint.Receiver); err != nil {
+			return err
+		}
+
+		if _, err := transactionConn.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("failed to Exec create payment point sql query: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return NewCreatePaymentPointError("failed to create payment point", err)
+	}
+
+	return nil
+}
+
+// GetPaymentPoint retrieves a payment point with its receiver from the database.
+func (repo *paymentPointRepo) GetPaymentPoint(ctx context.Context, paymentPointID string) (*model.PaymentPoint, error) {
+	query := getPaymentPointQuery(paymentPointID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetPaymentPointError("failed to get payment point sql query", err)
+	}
+
+	var paymentPoint *model.PaymentPoint
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		collectedPaymentPoint, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.PaymentPoint])
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrPaymentPointNotFound
+			}
+
+			return err
+		}
+
+		paymentPoint = &collectedPaymentPoint
+
+		receiver, err := repo.transactions.getTransactionUserInTx(ctx, paymentPoint.ReceiverID)
+		if err != nil {
+			return err
+		}
+
+		paymentPoint.Receiver = receiver
+
+		return nil
+	}); err != nil {
+		return nil, NewGetPaymentPointError("failed to get payment point", err)
+	}
+
+	return paymentPoint, nil
+}
+
+// DisablePaymentPoint stops the payment point from accepting new payments.
+func (repo *paymentPointRepo) DisablePaymentPoint(ctx context.Context, paymentPointID string) error {
+	query := disablePaymentPointQuery(paymentPointID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewDisablePaymentPointError("failed to get disable payment point sql query", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return NewDisablePaymentPointError("failed to Exec disable payment point sql query", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewDisablePaymentPointError("failed to disable payment point", ErrPaymentPointNotFound)
+	}
+
+	return nil
+}
+
+// CreatePaymentPointTransaction creates a transaction and links it to the payment point it pays to.
+func (repo *paymentPointRepo) CreatePaymentPointTransaction(
+	ctx context.Context,
+	paymentPointID string,
+	transaction *model.Transaction,
+) error {
+	transactionSQLQuery, transactionArgs, err := createTransactionQuery(transaction).ToSql()
+	if err != nil {
+		return NewCreatePaymentPointTransactionError("failed to get create transaction sql query", err)
+	}
+
+	linkSQLQuery, linkArgs, err := createPointTransactionQuery(paymentPointID, transaction.ID).ToSql()
+	if err != nil {
+		return NewCreatePaymentPointTransactionError("failed to get create payment point transaction sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		if err := repo.transactions.createTransactionUserInTx(ctx, transaction.Receiver); err != nil {
+			return err
+		}
+
+		if _, err := transactionConn.Exec(ctx, transactionSQLQuery, transactionArgs...); err != nil {
+			return fmt.Errorf("failed to Exec create transaction sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, linkSQLQuery, linkArgs...); err != nil {
+			return fmt.Errorf("failed to Exec create payment point transaction sql query: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return NewCreatePaymentPointTransactionError("failed to create payment point transaction", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -package mocks -destination mocks/report_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository ReportRepo
+
+// ReportRepo defines the interface for reading what the settlement reports are built from.
+type ReportRepo interface {
+	GetSettledEntries(ctx context.Context, from, to time.Time, merchantID string) ([]*report.SettledEntry, error)
+}
+
+type reportRepo struct {
+	pg  *postgres.Postgres
+	log logger.Logger
+}
+
+// NewReportRepo creates a new instance of ReportRepo.
+func NewReportRepo(
+	pg *postgres.Postgres,
+	log logger.Logger,
+) ReportRepo {
+	return &reportRepo{
+		pg:  pg,
+		log: log,
+	}
+}
+
+// settledPosting is a posting of a settle entry read back together with the transaction it settled.
+type settledPosting struct {
+	ledgerPosting
+
+	ReceiverID string `db:"receiver_id"`
+	Refund     bool   `db:"refund"`
+}
+
+// GetSettledEntries retrieves the settle entries booked in [from, to) in the order they were written.
+// A non-empty merchantID keeps the entries the merchant received or was credited by.
+func (repo *reportRepo) GetSettledEntries(
+	ctx context.Context,
+	from, to time.Time,
+	merchantID string,
+) ([]*report.SettledEntry, error) {
+	query := getSettledPostingsQuery(from, to, merchantID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetSettledEntriesError("failed to get settled postings sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetSettledEntriesError("failed to Query settled postings sql query", err)
+	}
+
+	postings, err := pgx.CollectRows(rows, pgx.RowToStructByName[settledPosting])
+	if err != nil {
+		return nil, NewGetSettledEntriesError("failed to collect settled postings", err)
+	}
+
+	var (
+		entries []*ledger.Entry
+		settled []*report.SettledEntry
+	)
+
+	for _, posting := range postings {
+		entries = appendLedgerPosting(entries, posting.ledgerPosting)
+
+		if len(settled) == len(entries) {
+			continue
+		}
+
+		settled = append(settled, &report.SettledEntry{
+			Entry:      entries[len(entries)-1],
+			ReceiverID: posting.ReceiverID,
+			Refund:     posting.Refund,
+		})
+	}
+
+	return settled, nil
+}
@@ -1,17 +1,84 @@
 package repository
 
 import (
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 )
 
 const (
-	transactionsTable     = "transactions"
-	transactionUsersTable = "transaction_users"
+	transactionsTable        = "transactions"
+	transactionUsersTable    = "transaction_users"
+	confirmationsTable       = "transaction_confirmations"
+	quotesTable              = "transaction_quotes"
+	legsTable                = "transaction_legs"
+	sharesTable              = "transaction_shares"
+	paymentPointsTable       = "payment_points"
+	pointTransactionsTable   = "payment_point_transactions"
+	mandatesTable            = "mandates"
+	mandateTransactionsTable = "mandate_transactions"
+	webhooksTable            = "webhook_endpoints"
+	webhookDeliveriesTable   = "webhook_deliveries"
+	ledgerAccountsTable      = "ledger_accounts"
+	ledgerEntriesTable       = "ledger_entries"
+	ledgerPostingsTable      = "ledger_postings"
+	feePlansTable            = "fee_plans"
+	feePlanTiersTable        = "fee_plan_tiers"
 )
 
+var webhookDeliveryColumns = []string{
+	"delivery_id",
+	"webhook_id",
+	"transaction_id",
+	"from_status",
+	"to_status",
+	"status",
+	"attempts",
+	"next_attempt_at",
+	"last_response_code",
+	"last_error",
+	"delivered_at",
+	"created_at",
+	"updated_at",
+}
+
+var shareColumns = []string{
+	"share_id",
+	"transaction_id",
+	"position",
+	"amount",
+	"payer_id",
+	"status",
+	"refund_id",
+	"reason",
+	"created_at",
+	"updated_at",
+}
+
+var mandateColumns = []string{
+	"mandate_id",
+	"payer_id",
+	"receiver_id",
+	"currency",
+	"amount",
+	"method",
+	"billing_interval",
+	"interval_count",
+	"starts_at",
+	"ends_at",
+	"status",
+	"period_start",
+	"next_charge_at",
+	"attempts",
+	"charge_transaction_id",
+	"last_error",
+	"created_at",
+	"updated_at",
+}
+
 var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 func getTransactionQuery(transactionID string) sq.SelectBuilder {
@@ -25,8 +92,17 @@ func getTransactionQuery(transactionID string) sq.SelectBuilder {
 			"status",
 			"method",
 			"canceled_reason",
+			"expires_at",
+			"execute_at",
 			"created_at",
 			"updated_at",
+			"manual_capture",
+			"captured_amount",
+			"authorized_until",
+			"void_requested_at",
+			"fee",
+			"fee_receiver_id",
+			"watch",
 		).
 		From(transactionsTable).
 		Where(sq.Eq{
@@ -49,7 +125,14 @@ func getTransactionUserQuery(transactionUserID string) sq.SelectBuilder {
 		})
 }
 
+// createTransactionQuery inserts the transaction, a transaction created as processed, like a watch transaction,
+// is processed at its creation.
 func createTransactionQuery(transaction *model.Transaction) sq.InsertBuilder {
+	var processedAt *time.Time
+	if transaction.Status == model.Processed {
+		processedAt = &transaction.CreatedAt
+	}
+
 	return psql.
 		Insert(transactionsTable).
 		Columns(
@@ -60,8 +143,12 @@ func createTransactionQuery(transaction *model.Transaction) sq.InsertBuilder {
 			"status",
 			"method",
 			"canceled_reason",
+			"expires_at",
 			"created_at",
 			"updated_at",
+			"manual_capture",
+			"watch",
+			"processed_at",
 		).
 		Values(
 			transaction.ID,
@@ -71,8 +158,12 @@ func createTransactionQuery(transaction *model.Transaction) sq.InsertBuilder {
 			transaction.Status,
 			transaction.Method,
 			transaction.CanceledReason,
+			transaction.ExpiresAt,
 			transaction.CreatedAt,
 			transaction.UpdatedAt,
+			transaction.ManualCapture,
+			transaction.Watch,
+			processedAt,
 		)
 }
 
@@ -117,6 +208,13 @@ func cancelTransactionQuery(transactionID, reason string) sq.UpdateBuilder {
 		})
 }
 
+func cancelPendingTransactionQuery(transactionID, reason string) sq.UpdateBuilder {
+	return cancelTransactionQuery(transactionID, reason).
+		Where(sq.Eq{
+			"status": []model.TransactionStatus{model.Created, model.AwaitingConfirmation, model.Scheduled},
+		})
+}
+
 func updateTransactionQuery(transaction *model.Transaction) sq.UpdateBuilder {
 	query := psql.Update(transactionsTable)
 
@@ -148,19 +246,32 @@ func updateTransactionQuery(transaction *model.Transaction) sq.UpdateBuilder {
 		Set("updated_at", time.Now()).
 		Where(sq.Eq{
 			"transaction_id": transaction.ID,
+			"status":         model.Created,
 		})
 
 	return query
 }
 
-func acceptTransactionQuery(transactionID, senderID string) sq.UpdateBuilder {
-	return psql.
+// acceptTransactionQuery processes a created transaction, or schedules it when executeAt is set.
+func acceptTransactionQuery(transactionID, senderID string, fee *model.Fee, executeAt *time.Time) sq.UpdateBuilder {
+	query := setFee(psql.
 		Update(transactionsTable).
-		Set("status", model.Processed).
 		Set("sender_id", senderID).
+		Set("updated_at", time.Now()), fee).
 		Where(sq.Eq{
 			"transaction_id": transactionID,
+			"status":         model.Created,
 		})
+
+	if executeAt != nil {
+		return query.
+			Set("status", model.Scheduled).
+			Set("execute_at", executeAt)
+	}
+
+	return query.
+		Set("status", model.Processed).
+		Set("processed_at", time.Now())
 }
 
 func changeTransactionStatusQuery(transactionID string, status model.TransactionStatus) sq.UpdateBuilder {
@@ -171,3 +282,1213 @@ func changeTransactionStatusQuery(transactionID string, status model.Transaction
 			"transaction_id": transactionID,
 		})
 }
+
+// awaitConfirmationQuery keeps executeAt so that the confirmed transaction is scheduled instead of processed.
+func awaitConfirmationQuery(transactionID, senderID string, fee *model.Fee, executeAt *time.Time) sq.UpdateBuilder {
+	return setFee(psql.
+		Update(transactionsTable).
+		Set("status", model.AwaitingConfirmation).
+		Set("sender_id", senderID).
+		Set("execute_at", executeAt).
+		Set("updated_at", time.Now()), fee).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Created,
+		})
+}
+
+// setFee stores the fee the transaction is accepted with, a nil fee clears the fee of a previous acceptance.
+func setFee(query sq.UpdateBuilder, fee *model.Fee) sq.UpdateBuilder {
+	if fee == nil {
+		return query.
+			Set("fee", 0).
+			Set("fee_receiver_id", nil)
+	}
+
+	return query.
+		Set("fee", fee.Amount).
+		Set("fee_receiver_id", fee.Receiver.ID)
+}
+
+func expireTransactionsQuery(now time.Time, limit uint64) sq.UpdateBuilder {
+	// The subquery keeps question placeholders, the outer builder numbers them.
+	expiredTransactions := sq.
+		Select("transaction_id").
+		From(transactionsTable).
+		Where(sq.Eq{
+			"status": model.Created,
+		}).
+		Where(sq.LtOrEq{
+			"expires_at": now,
+		}).
+		// Group transactions are closed by the group settler, which refunds the paid shares.
+		Where("NOT EXISTS (SELECT 1 FROM " + sharesTable + " WHERE " + sharesTable + ".transaction_id = " + transactionsTable + ".transaction_id)").
+		OrderBy("expires_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Expired).
+		Set("updated_at", now).
+		Where(sq.Expr("transaction_id IN (?)", expiredTransactions)).
+		Suffix("RETURNING transaction_id")
+}
+
+// executeScheduledTransactionsQuery processes up to limit scheduled transactions whose execution time has come.
+// The time is taken from the database clock, so replicas with skewed clocks agree on what is due.
+func executeScheduledTransactionsQuery(limit uint64) sq.UpdateBuilder {
+	// The subquery keeps question placeholders, the outer builder numbers them.
+	dueTransactions := sq.
+		Select("transaction_id").
+		From(transactionsTable).
+		Where(sq.Eq{
+			"status": model.Scheduled,
+		}).
+		Where("execute_at <= (now() AT TIME ZONE 'UTC')").
+		OrderBy("execute_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Processed).
+		Set("processed_at", time.Now()).
+		Set("updated_at", time.Now()).
+		Where(sq.Expr("transaction_id IN (?)", dueTransactions)).
+		Suffix("RETURNING transaction_id")
+}
+
+// authorizeTransactionQuery holds a processed manually captured transaction that is not being captured until authorizedUntil.
+func authorizeTransactionQuery(transactionID string, authorizedUntil time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Authorized).
+		Set("authorized_until", authorizedUntil).
+		Set("status_requested_at", nil).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"transaction_id":  transactionID,
+			"status":          model.Processed,
+			"manual_capture":  true,
+			"captured_amount": nil,
+		})
+}
+
+// captureTransactionQuery processes the capture of an authorized transaction whose hold neither timed out nor is being voided.
+// The supervisor deadline of the capture starts at now.
+func captureTransactionQuery(transactionID string, amount int64, now time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Processed).
+		Set("captured_amount", amount).
+		Set("processed_at", now).
+		Set("status_requested_at", nil).
+		Set("updated_at", now).
+		Where(sq.Eq{
+			"transaction_id":    transactionID,
+			"status":            model.Authorized,
+			"void_requested_at": nil,
+		}).
+		Where(sq.Gt{
+			"authorized_until": now,
+		})
+}
+
+// releaseCaptureQuery returns a transaction whose capture failed to the authorized status, the hold is still in place.
+func releaseCaptureQuery(transactionID string) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Authorized).
+		Set("captured_amount", nil).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Processed,
+		}).
+		Where(sq.NotEq{
+			"captured_amount": nil,
+		})
+}
+
+func requestVoidQuery(transactionID string, now time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("void_requested_at", now).
+		Set("updated_at", now).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Authorized,
+		})
+}
+
+// claimExpiredHoldsQuery marks the void of up to limit authorized transactions whose hold timed out by now as requested.
+// A hold whose void was requested before retryBefore is claimed again, the payment gateway did not release it.
+func claimExpiredHoldsQuery(now, retryBefore time.Time, limit uint64) sq.UpdateBuilder {
+	// The subquery keeps question placeholders, the outer builder numbers them.
+	expiredHolds := sq.
+		Select("transaction_id").
+		From(transactionsTable).
+		Where(sq.Eq{
+			"status": model.Authorized,
+		}).
+		Where(sq.LtOrEq{
+			"authorized_until": now,
+		}).
+		Where(sq.Or{
+			sq.Eq{"void_requested_at": nil},
+			sq.LtOrEq{"void_requested_at": retryBefore},
+		}).
+		OrderBy("authorized_until").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	return psql.
+		Update(transactionsTable).
+		Set("void_requested_at", now).
+		Set("updated_at", now).
+		Where(sq.Expr("transaction_id IN (?)", expiredHolds)).
+		Suffix("RETURNING transaction_id")
+}
+
+func voidTransactionQuery(transactionID string) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Voided).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Authorized,
+		})
+}
+
+// getOverdueTransactionsQuery selects the transactions processed before processedBefore without a status report,
+// a watch transaction is watched until it expires and is only overdue once it expired before processedBefore.
+func getOverdueTransactionsQuery(processedBefore time.Time, limit uint64) sq.SelectBuilder {
+	return psql.
+		Select("transaction_id").
+		From(transactionsTable).
+		Where(sq.Eq{
+			"status":              model.Processed,
+			"status_requested_at": nil,
+		}).
+		Where(sq.LtOrEq{
+			"processed_at": processedBefore,
+		}).
+		Where(sq.Or{
+			sq.Eq{"watch": false},
+			sq.LtOrEq{"expires_at": processedBefore},
+		}).
+		OrderBy("processed_at").
+		Limit(limit)
+}
+
+// getSucceededTransactionsQuery selects the succeeded transactions of the payment method settled in [from, to),
+// a group transaction is selected by the settlements of its shares.
+func getSucceededTransactionsQuery(method string, from, to time.Time) sq.SelectBuilder {
+	return psql.
+		Select("t.transaction_id").
+		Distinct().
+		From(transactionsTable + " t").
+		Join(ledgerEntriesTable + " e ON e.transaction_id = t.transaction_id").
+		Where(sq.Eq{
+			"t.status": model.Succeeded,
+			"t.method": method,
+			"e.kind":   ledger.SettleEntry,
+		}).
+		Where(sq.GtOrEq{
+			"e.created_at": from,
+		}).
+		Where(sq.Lt{
+			"e.created_at": to,
+		}).
+		OrderBy("t.transaction_id")
+}
+
+func markStatusRequestedQuery(transactionID string, requestedAt time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status_requested_at", requestedAt).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Processed,
+		})
+}
+
+func getUnresolvedTransactionsQuery(requestedBefore time.Time, limit uint64) sq.SelectBuilder {
+	return psql.
+		Select("transaction_id").
+		From(transactionsTable).
+		Where(sq.Eq{
+			"status": model.Processed,
+		}).
+		Where(sq.LtOrEq{
+			"status_requested_at": requestedBefore,
+		}).
+		OrderBy("status_requested_at").
+		Limit(limit)
+}
+
+func failTransactionQuery(transactionID, reason string) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Failed).
+		Set("canceled_reason", reason).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Processed,
+		})
+}
+
+func createConfirmationQuery(confirmation *model.Confirmation) sq.InsertBuilder {
+	return psql.
+		Insert(confirmationsTable).
+		Columns(
+			"transaction_id",
+			"method",
+			"code_hash",
+			"transaction_hash",
+			"attempts",
+			"expires_at",
+			"created_at",
+		).
+		Values(
+			confirmation.TransactionID,
+			confirmation.Method,
+			confirmation.CodeHash,
+			confirmation.TransactionHash,
+			confirmation.Attempts,
+			confirmation.ExpiresAt,
+			confirmation.CreatedAt,
+		)
+}
+
+func getConfirmationQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"transaction_id",
+			"method",
+			"code_hash",
+			"transaction_hash",
+			"attempts",
+			"expires_at",
+			"created_at",
+		).
+		From(confirmationsTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		})
+}
+
+func incrementConfirmationAttemptsQuery(transactionID string) sq.UpdateBuilder {
+	return psql.
+		Update(confirmationsTable).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		})
+}
+
+func confirmTransactionQuery(transactionID string) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", sq.Expr("CASE WHEN execute_at IS NULL THEN ?::INTEGER ELSE ?::INTEGER END", model.Processed, model.Scheduled)).
+		Set("processed_at", sq.Expr("CASE WHEN execute_at IS NULL THEN ?::TIMESTAMP END", time.Now())).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.AwaitingConfirmation,
+		})
+}
+
+func resetConfirmationQuery(transactionID string) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Created).
+		Set("sender_id", nil).
+		Set("execute_at", nil).
+		Set("fee", 0).
+		Set("fee_receiver_id", nil).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.AwaitingConfirmation,
+		})
+}
+
+func deleteConfirmationQuery(transactionID string) sq.DeleteBuilder {
+	return psql.
+		Delete(confirmationsTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		})
+}
+
+func lockTransactionStatusQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select("status").
+		From(transactionsTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		}).
+		Suffix("FOR UPDATE")
+}
+
+func upsertQuoteQuery(quote *model.Quote) sq.InsertBuilder {
+	return psql.
+		Insert(quotesTable).
+		Columns(
+			"transaction_id",
+			"payer_id",
+			"currency",
+			"amount",
+			"rate",
+			"mid_rate",
+			"expires_at",
+			"created_at",
+		).
+		Values(
+			quote.TransactionID,
+			quote.PayerID,
+			quote.Currency,
+			quote.Amount,
+			quote.Rate,
+			quote.MidRate,
+			quote.ExpiresAt,
+			quote.CreatedAt,
+		).
+		Suffix(`ON CONFLICT (transaction_id) DO UPDATE SET
+			payer_id = EXCLUDED.payer_id,
+			currency = EXCLUDED.currency,
+			amount = EXCLUDED.amount,
+			rate = EXCLUDED.rate,
+			mid_rate = EXCLUDED.mid_rate,
+			expires_at = EXCLUDED.expires_at,
+			created_at = EXCLUDED.created_at`)
+}
+
+func getQuoteQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"transaction_id",
+			"payer_id",
+			"currency",
+			"amount",
+			"rate",
+			"mid_rate",
+			"expires_at",
+			"created_at",
+		).
+		From(quotesTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		})
+}
+
+func deleteQuoteQuery(transactionID string) sq.DeleteBuilder {
+	return psql.
+		Delete(quotesTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		})
+}
+
+func createTransactionLegsQuery(legs []*model.TransactionLeg) sq.InsertBuilder {
+	query := psql.
+		Insert(legsTable).
+		Columns(
+			"transaction_leg_id",
+			"transaction_id",
+			"receiver_id",
+			"position",
+			"amount",
+			"share_bps",
+			"created_at",
+		)
+
+	for _, leg := range legs {
+		query = query.Values(
+			leg.ID,
+			leg.TransactionID,
+			leg.ReceiverID,
+			leg.Position,
+			leg.Amount,
+			leg.ShareBps,
+			leg.CreatedAt,
+		)
+	}
+
+	return query
+}
+
+func getTransactionLegsQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"transaction_leg_id",
+			"transaction_id",
+			"receiver_id",
+			"position",
+			"amount",
+			"share_bps",
+			"created_at",
+		).
+		From(legsTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		}).
+		OrderBy("position")
+}
+
+func createTransactionSharesQuery(shares []*model.TransactionShare) sq.InsertBuilder {
+	query := psql.
+		Insert(sharesTable).
+		Columns(shareColumns...)
+
+	for _, share := range shares {
+		query = query.Values(
+			share.ID,
+			share.TransactionID,
+			share.Position,
+			share.Amount,
+			share.PayerID,
+			share.Status,
+			share.RefundID,
+			share.Reason,
+			share.CreatedAt,
+			share.UpdatedAt,
+		)
+	}
+
+	return query
+}
+
+func getTransactionSharesQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select(shareColumns...).
+		From(sharesTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		}).
+		OrderBy("position")
+}
+
+func payerHasShareQuery(transactionID, userID string) sq.SelectBuilder {
+	return psql.
+		Select("1").
+		From(sharesTable + " s").
+		Join(transactionUsersTable + " u ON u.transaction_user_id = s.payer_id").
+		Where(sq.Eq{
+			"s.transaction_id": transactionID,
+			"u.user_id":        userID,
+		}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")")
+}
+
+func claimShareQuery(transactionID, payerID string, now time.Time) sq.UpdateBuilder {
+	// The subquery keeps question placeholders, the outer builder numbers them.
+	pendingShare := sq.
+		Select("share_id").
+		From(sharesTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.SharePending,
+		}).
+		OrderBy("position").
+		Limit(1)
+
+	return psql.
+		Update(sharesTable).
+		Set("payer_id", payerID).
+		Set("status", model.ShareProcessed).
+		Set("reason", "").
+		Set("updated_at", now).
+		Where(sq.Expr("share_id = (?)", pendingShare)).
+		Suffix("RETURNING " + strings.Join(shareColumns, ", "))
+}
+
+func lockShareQuery(paymentID string) sq.SelectBuilder {
+	return psql.
+		Select(shareColumns...).
+		From(sharesTable).
+		Where(sq.Or{
+			sq.Eq{"share_id": paymentID},
+			sq.Eq{"refund_id": paymentID},
+		}).
+		Suffix("FOR UPDATE")
+}
+
+func updateShareQuery(share *model.TransactionShare) sq.UpdateBuilder {
+	return psql.
+		Update(sharesTable).
+		Set("payer_id", share.PayerID).
+		Set("status", share.Status).
+		Set("reason", share.Reason).
+		Set("updated_at", share.UpdatedAt).
+		Where(sq.Eq{
+			"share_id": share.ID,
+		})
+}
+
+func unsettledSharesQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select("count(*)").
+		From(sharesTable).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+		}).
+		Where(sq.NotEq{
+			"status": model.ShareSucceeded,
+		})
+}
+
+func succeedGroupTransactionQuery(transactionID string, now time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Succeeded).
+		Set("updated_at", now).
+		Where(sq.Eq{
+			"transaction_id": transactionID,
+			"status":         model.Created,
+		})
+}
+
+func lockClosableGroupTransactionsQuery(now time.Time, limit uint64) sq.SelectBuilder {
+	return psql.
+		Select("transaction_id").
+		From(transactionsTable).
+		Where(sq.Or{
+			sq.And{
+				sq.Eq{"status": model.Created},
+				sq.LtOrEq{"expires_at": now},
+			},
+			sq.Eq{"status": model.Canceled},
+		}).
+		Where(sq.Expr(
+			"EXISTS (SELECT 1 FROM "+sharesTable+" WHERE "+sharesTable+".transaction_id = "+transactionsTable+".transaction_id AND "+sharesTable+".status IN (?, ?))",
+			model.SharePending,
+			model.ShareSucceeded,
+		)).
+		OrderBy("expires_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+}
+
+func expireGroupTransactionsQuery(transactionIDs []string, now time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(transactionsTable).
+		Set("status", model.Expired).
+		Set("updated_at", now).
+		Where(sq.Eq{
+			"transaction_id": transactionIDs,
+			"status":         model.Created,
+		})
+}
+
+func closeSharesQuery(transactionIDs []string, from, to model.ShareStatus, reason string, now time.Time) sq.UpdateBuilder {
+	return psql.
+		Update(sharesTable).
+		Set("status", to).
+		Set("reason", reason).
+		Set("updated_at", now).
+		Where(sq.Eq{
+			"transaction_id": transactionIDs,
+			"status":         from,
+		}).
+		Suffix("RETURNING " + strings.Join(shareColumns, ", "))
+}
+
+func createPaymentPointQuery(paymentPoint *model.PaymentPoint) sq.InsertBuilder {
+	return psql.
+		Insert(paymentPointsTable).
+		Columns(
+			"payment_point_id",
+			"receiver_id",
+			"currency",
+			"method",
+			"min_amount",
+			"max_amount",
+			"active",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			paymentPoint.ID,
+			paymentPoint.ReceiverID,
+			paymentPoint.Currency,
+			paymentPoint.Method,
+			paymentPoint.MinAmount,
+			paymentPoint.MaxAmount,
+			paymentPoint.Active,
+			paymentPoint.CreatedAt,
+			paymentPoint.UpdatedAt,
+		)
+}
+
+func getPaymentPointQuery(paymentPointID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"payment_point_id",
+			"receiver_id",
+			"currency",
+			"method",
+			"min_amount",
+			"max_amount",
+			"active",
+			"created_at",
+			"updated_at",
+		).
+		From(paymentPointsTable).
+		Where(sq.Eq{
+			"payment_point_id": paymentPointID,
+		})
+}
+
+func disablePaymentPointQuery(paymentPointID string) sq.UpdateBuilder {
+	return psql.
+		Update(paymentPointsTable).
+		Set("active", false).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{
+			"payment_point_id": paymentPointID,
+		})
+}
+
+func createPointTransactionQuery(paymentPointID, transactionID string) sq.InsertBuilder {
+	return psql.
+		Insert(pointTransactionsTable).
+		Columns(
+			"transaction_id",
+			"payment_point_id",
+		).
+		Values(
+			transactionID,
+			paymentPointID,
+		)
+}
+
+func createWebhookQuery(webhook *model.Webhook) sq.InsertBuilder {
+	return psql.
+		Insert(webhooksTable).
+		Columns(
+			"webhook_id",
+			"merchant_id",
+			"url",
+			"secret",
+			"active",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			webhook.ID,
+			webhook.MerchantID,
+			webhook.URL,
+			webhook.Secret,
+			webhook.Active,
+			webhook.CreatedAt,
+			webhook.UpdatedAt,
+		)
+}
+
+func getWebhookQuery(webhookID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"webhook_id",
+			"merchant_id",
+			"url",
+			"secret",
+			"active",
+			"created_at",
+			"updated_at",
+		).
+		From(webhooksTable).
+		Where(sq.Eq{
+			"webhook_id": webhookID,
+		})
+}
+
+func getWebhookDeliveriesQuery(webhookID string, limit, offset uint64) sq.SelectBuilder {
+	return psql.
+		Select(webhookDeliveryColumns...).
+		From(webhookDeliveriesTable).
+		Where(sq.Eq{
+			"webhook_id": webhookID,
+		}).
+		OrderBy("created_at DESC", "delivery_id").
+		Limit(limit).
+		Offset(offset)
+}
+
+func getWebhookDeliveryQuery(deliveryID string) sq.SelectBuilder {
+	return psql.
+		Select(webhookDeliveryColumns...).
+		From(webhookDeliveriesTable).
+		Where(sq.Eq{
+			"delivery_id": deliveryID,
+		})
+}
+
+func createWebhookDeliveryQuery(delivery *model.WebhookDelivery) sq.InsertBuilder {
+	return psql.
+		Insert(webhookDeliveriesTable).
+		Columns(webhookDeliveryColumns...).
+		Values(
+			delivery.ID,
+			delivery.WebhookID,
+			delivery.TransactionID,
+			delivery.FromStatus,
+			delivery.ToStatus,
+			delivery.Status,
+			delivery.Attempts,
+			delivery.NextAttemptAt,
+			delivery.LastResponseCode,
+			delivery.LastError,
+			delivery.DeliveredAt,
+			delivery.CreatedAt,
+			delivery.UpdatedAt,
+		)
+}
+
+func claimDueDeliveriesQuery(now, leaseUntil time.Time, limit uint64) sq.UpdateBuilder {
+	// The subquery keeps question placeholders, the outer builder numbers them.
+	dueDeliveries := sq.
+		Select("delivery_id").
+		From(webhookDeliveriesTable).
+		Where(sq.Eq{
+			"status": model.DeliveryPending,
+		}).
+		Where(sq.LtOrEq{
+			"next_attempt_at": now,
+		}).
+		OrderBy("next_attempt_at").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	return psql.
+		Update(webhookDeliveriesTable).
+		Set("next_attempt_at", leaseUntil).
+		Set("updated_at", now).
+		Where(sq.Expr("delivery_id IN (?)", dueDeliveries)).
+		Suffix("RETURNING " + strings.Join(webhookDeliveryColumns, ", "))
+}
+
+func recordDeliveryAttemptQuery(delivery *model.WebhookDelivery) sq.UpdateBuilder {
+	return psql.
+		Update(webhookDeliveriesTable).
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("last_response_code", delivery.LastResponseCode).
+		Set("last_error", delivery.LastError).
+		Set("delivered_at", delivery.DeliveredAt).
+		Set("updated_at", delivery.UpdatedAt).
+		Where(sq.Eq{
+			"delivery_id": delivery.ID,
+		})
+}
+
+func createMandateQuery(mandate *model.Mandate) sq.InsertBuilder {
+	return psql.
+		Insert(mandatesTable).
+		Columns(mandateColumns...).
+		Values(
+			mandate.ID,
+			mandate.PayerID,
+			mandate.ReceiverID,
+			mandate.Currency,
+			mandate.Amount,
+			mandate.Method,
+			mandate.Interval,
+			mandate.IntervalCount,
+			mandate.StartsAt,
+			mandate.EndsAt,
+			mandate.Status,
+			mandate.PeriodStart,
+			mandate.NextChargeAt,
+			mandate.Attempts,
+			mandate.ChargeTransactionID,
+			mandate.LastError,
+			mandate.CreatedAt,
+			mandate.UpdatedAt,
+		)
+}
+
+func getMandateQuery(mandateID string) sq.SelectBuilder {
+	return psql.
+		Select(mandateColumns...).
+		From(mandatesTable).
+		Where(sq.Eq{
+			"mandate_id": mandateID,
+		})
+}
+
+func lockMandateQuery(mandateID string) sq.SelectBuilder {
+	return getMandateQuery(mandateID).
+		Suffix("FOR UPDATE")
+}
+
+func updateMandateQuery(mandate *model.Mandate) sq.UpdateBuilder {
+	return psql.
+		Update(mandatesTable).
+		Set("status", mandate.Status).
+		Set("period_start", mandate.PeriodStart).
+		Set("next_charge_at", mandate.NextChargeAt).
+		Set("attempts", mandate.Attempts).
+		Set("charge_transaction_id", mandate.ChargeTransactionID).
+		Set("last_error", mandate.LastError).
+		Set("updated_at", mandate.UpdatedAt).
+		Where(sq.Eq{
+			"mandate_id": mandate.ID,
+		})
+}
+
+func dueMandatesQuery(now time.Time, limit uint64) sq.SelectBuilder {
+	return psql.
+		Select("mandate_id").
+		From(mandatesTable).
+		Where(sq.Eq{
+			"status":                []model.MandateStatus{model.MandateActive, model.MandatePastDue},
+			"charge_transaction_id": nil,
+		}).
+		Where(sq.LtOrEq{
+			"next_charge_at": now,
+		}).
+		OrderBy("next_charge_at").
+		Limit(limit)
+}
+
+func createMandateTransactionQuery(mandateID, transactionID string) sq.InsertBuilder {
+	return psql.
+		Insert(mandateTransactionsTable).
+		Columns(
+			"transaction_id",
+			"mandate_id",
+		).
+		Values(
+			transactionID,
+			mandateID,
+		)
+}
+
+func mandateChargeOutcomesQuery(limit uint64) sq.SelectBuilder {
+	return psql.
+		Select(
+			"m.mandate_id",
+			"t.transaction_id",
+			"t.status",
+			"t.canceled_reason",
+		).
+		From(mandatesTable + " m").
+		Join(transactionsTable + " t ON t.transaction_id = m.charge_transaction_id").
+		Where(sq.Eq{
+			"t.status": []model.TransactionStatus{model.Succeeded, model.Failed, model.Canceled, model.Expired},
+		}).
+		OrderBy("t.updated_at").
+		Limit(limit)
+}
+
+func createLedgerAccountQuery(account *ledger.Account, now time.Time) sq.InsertBuilder {
+	return psql.
+		Insert(ledgerAccountsTable).
+		Columns(
+			"account_id",
+			"kind",
+			"owner_id",
+			"created_at",
+		).
+		Values(
+			account.ID,
+			account.Kind,
+			account.OwnerID,
+			now,
+		).
+		Suffix("ON CONFLICT (account_id) DO NOTHING")
+}
+
+func getLedgerAccountQuery(accountID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"account_id",
+			"kind",
+			"owner_id",
+		).
+		From(ledgerAccountsTable).
+		Where(sq.Eq{
+			"account_id": accountID,
+		})
+}
+
+func createLedgerEntryQuery(entry *ledger.Entry) sq.InsertBuilder {
+	return psql.
+		Insert(ledgerEntriesTable).
+		Columns(
+			"entry_id",
+			"reference_id",
+			"transaction_id",
+			"kind",
+			"created_at",
+		).
+		Values(
+			entry.ID,
+			entry.ReferenceID,
+			entry.TransactionID,
+			entry.Kind,
+			entry.CreatedAt,
+		)
+}
+
+func createLedgerPostingsQuery(entry *ledger.Entry) sq.InsertBuilder {
+	query := psql.
+		Insert(ledgerPostingsTable).
+		Columns(
+			"entry_id",
+			"position",
+			"account_id",
+			"direction",
+			"amount",
+			"currency",
+		)
+
+	for position, posting := range entry.Postings {
+		query = query.Values(
+			entry.ID,
+			position,
+			posting.Account.ID,
+			posting.Direction,
+			posting.Amount,
+			posting.Currency,
+		)
+	}
+
+	return query
+}
+
+var ledgerPostingColumns = []string{
+	"e.entry_id",
+	"e.reference_id",
+	"e.transaction_id",
+	"e.kind",
+	"e.created_at",
+	"p.account_id",
+	"a.kind AS account_kind",
+	"a.owner_id",
+	"p.direction",
+	"p.amount",
+	"p.currency",
+}
+
+func getLedgerPostingsQuery(transactionID string) sq.SelectBuilder {
+	return psql.
+		Select(ledgerPostingColumns...).
+		From(ledgerEntriesTable+" e").
+		Join(ledgerPostingsTable+" p ON p.entry_id = e.entry_id").
+		Join(ledgerAccountsTable+" a ON a.account_id = p.account_id").
+		Where(sq.Eq{
+			"e.transaction_id": transactionID,
+		}).
+		OrderBy("e.seq", "p.position")
+}
+
+func getLedgerBalancesQuery(accountID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"currency",
+			"COALESCE(SUM(amount) FILTER (WHERE direction = 'debit'), 0)::BIGINT AS debits",
+			"COALESCE(SUM(amount) FILTER (WHERE direction = 'credit'), 0)::BIGINT AS credits",
+		).
+		From(ledgerPostingsTable).
+		Where(sq.Eq{
+			"account_id": accountID,
+		}).
+		GroupBy("currency").
+		OrderBy("currency")
+}
+
+func getLedgerStatementQuery(accountID string, limit, offset uint64) sq.SelectBuilder {
+	return psql.
+		Select(
+			"e.entry_id",
+			"e.reference_id",
+			"e.transaction_id",
+			"e.kind",
+			"p.direction",
+			"p.amount",
+			"p.currency",
+			"e.created_at",
+		).
+		From(ledgerPostingsTable+" p").
+		Join(ledgerEntriesTable+" e ON e.entry_id = p.entry_id").
+		Where(sq.Eq{
+			"p.account_id": accountID,
+		}).
+		OrderBy("e.seq DESC", "p.position").
+		Limit(limit).
+		Offset(offset)
+}
+
+// getSettledPostingsQuery selects the postings of the settle entries booked in [from, to) with the receiver
+// of their transaction. An entry whose reference is a share refund settled that refund. A non-empty merchantID
+// keeps the entries of the transactions it received and the entries crediting one of its wallets.
+func getSettledPostingsQuery(from, to time.Time, merchantID string) sq.SelectBuilder {
+	query := psql.
+		Select(ledgerPostingColumns...).
+		Columns(
+			"u.user_id AS receiver_id",
+			"s.share_id IS NOT NULL AS refund",
+		).
+		From(ledgerEntriesTable + " e").
+		Join(ledgerPostingsTable + " p ON p.entry_id = e.entry_id").
+		Join(ledgerAccountsTable + " a ON a.account_id = p.account_id").
+		Join(transactionsTable + " t ON t.transaction_id = e.transaction_id").
+		Join(transactionUsersTable + " u ON u.transaction_user_id = t.receiver_id").
+		LeftJoin(sharesTable + " s ON s.refund_id = e.reference_id").
+		Where(sq.Eq{
+			"e.kind": ledger.SettleEntry,
+		}).
+		Where(sq.GtOrEq{
+			"e.created_at": from,
+		}).
+		Where(sq.Lt{
+			"e.created_at": to,
+		})
+
+	if merchantID != "" {
+		query = query.Where(
+			sq.Or{
+				sq.Eq{"u.user_id": merchantID},
+				sq.Expr(
+					"EXISTS (SELECT 1 FROM "+ledgerPostingsTable+" mp JOIN "+ledgerAccountsTable+
+						" ma ON ma.account_id = mp.account_id WHERE mp.entry_id = e.entry_id AND ma.owner_id = ?)",
+					merchantID,
+				),
+			},
+		)
+	}
+
+	return query.OrderBy("e.seq", "p.position")
+}
+
+func upsertFeePlanQuery(plan *model.FeePlan) sq.InsertBuilder {
+	return psql.
+		Insert(feePlansTable).
+		Columns(
+			"fee_plan_id",
+			"merchant_id",
+			"method",
+			"currency",
+			"fixed_amount",
+			"percentage_bps",
+			"min_fee",
+			"max_fee",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			plan.ID,
+			plan.MerchantID,
+			plan.Method,
+			plan.Currency,
+			plan.FixedAmount,
+			plan.PercentageBps,
+			plan.MinFee,
+			plan.MaxFee,
+			plan.CreatedAt,
+			plan.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (merchant_id, method, currency) DO UPDATE SET
+			fixed_amount = EXCLUDED.fixed_amount,
+			percentage_bps = EXCLUDED.percentage_bps,
+			min_fee = EXCLUDED.min_fee,
+			max_fee = EXCLUDED.max_fee,
+			updated_at = EXCLUDED.updated_at
+		RETURNING fee_plan_id, created_at`)
+}
+
+func deleteFeePlanTiersQuery(feePlanID string) sq.DeleteBuilder {
+	return psql.
+		Delete(feePlanTiersTable).
+		Where(sq.Eq{
+			"fee_plan_id": feePlanID,
+		})
+}
+
+func createFeePlanTiersQuery(tiers []*model.FeeTier) sq.InsertBuilder {
+	query := psql.
+		Insert(feePlanTiersTable).
+		Columns(
+			"fee_plan_id",
+			"from_volume",
+			"percentage_bps",
+		)
+
+	for _, tier := range tiers {
+		query = query.Values(
+			tier.FeePlanID,
+			tier.FromVolume,
+			tier.PercentageBps,
+		)
+	}
+
+	return query
+}
+
+func getFeePlansQuery(merchantID string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"fee_plan_id",
+			"merchant_id",
+			"method",
+			"currency",
+			"fixed_amount",
+			"percentage_bps",
+			"min_fee",
+			"max_fee",
+			"created_at",
+			"updated_at",
+		).
+		From(feePlansTable).
+		Where(sq.Eq{
+			"merchant_id": merchantID,
+		}).
+		OrderBy("method", "currency")
+}
+
+func getFeePlanTiersQuery(feePlanIDs []string) sq.SelectBuilder {
+	return psql.
+		Select(
+			"fee_plan_id",
+			"from_volume",
+			"percentage_bps",
+		).
+		From(feePlanTiersTable).
+		Where(sq.Eq{
+			"fee_plan_id": feePlanIDs,
+		}).
+		OrderBy("fee_plan_id", "from_volume")
+}
+
+// getMerchantVolumeQuery sums the succeeded transactions the merchant received in the currency since the given time,
+// captured transactions count with their captured amount.
+func getMerchantVolumeQuery(merchantID, currency string, since time.Time) sq.SelectBuilder {
+	return psql.
+		Select("COALESCE(SUM(COALESCE(t.captured_amount, t.amount)), 0)::BIGINT").
+		From(transactionsTable + " t").
+		Join(transactionUsersTable + " u ON u.transaction_user_id = t.receiver_id").
+		Where(sq.Eq{
+			"u.user_id":  merchantID,
+			"t.currency": currency,
+			"t.status":   model.Succeeded,
+		}).
+		Where(sq.GtOrEq{
+			"t.processed_at": since,
+		})
+}
+
+func getFeePlanQuery(merchantID, method, currency string) sq.SelectBuilder {
+	return getFeePlansQuery(merchantID).
+		Where(sq.Eq{
+			"method":   method,
+			"currency": currency,
+		})
+}
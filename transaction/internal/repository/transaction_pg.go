@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	sq "github.com/Masterminds/squirrel"
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
 	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
@@ -18,9 +21,38 @@ type TransactionRepo interface {
 	CreateTransaction(ctx context.Context, transaction *model.Transaction) error
 	GetTransactionStatus(ctx context.Context, transactionID string) (model.TransactionStatus, error)
 	CancelTransaction(ctx context.Context, transactionID string, reason string) error
-	AcceptTransaction(ctx context.Context, transactionID string, sender *model.TransactionUser) error
+	CancelPendingTransaction(ctx context.Context, transactionID string, reason string) error
+	AcceptTransaction(ctx context.Context, transactionID string, sender *model.TransactionUser, fee *model.Fee, executeAt *time.Time) error
 	ChangeTransactionStatus(ctx context.Context, transactionID string, status model.TransactionStatus) error
 	UpdateTransaction(ctx context.Context, updatedTransaction *model.Transaction) error
+	RequestConfirmation(
+		ctx context.Context,
+		sender *model.TransactionUser,
+		fee *model.Fee,
+		confirmation *model.Confirmation,
+		executeAt *time.Time,
+	) error
+	GetConfirmation(ctx context.Context, transactionID string) (*model.Confirmation, error)
+	IncrementConfirmationAttempts(ctx context.Context, transactionID string) error
+	ConfirmTransaction(ctx context.Context, transactionID string) error
+	ResetConfirmation(ctx context.Context, transactionID string) error
+	ExpireTransactions(ctx context.Context, now time.Time, limit uint64) ([]string, error)
+	ExecuteScheduledTransactions(ctx context.Context, limit uint64) ([]string, error)
+	AuthorizeTransaction(ctx context.Context, transactionID string, authorizedUntil time.Time) error
+	CaptureTransaction(ctx context.Context, transactionID string, amount int64, now time.Time) error
+	ReleaseCapture(ctx context.Context, transactionID string) (bool, error)
+	RequestVoid(ctx context.Context, transactionID string, now time.Time) error
+	ClaimExpiredHolds(ctx context.Context, now, retryBefore time.Time, limit uint64) ([]string, error)
+	VoidTransaction(ctx context.Context, transactionID string) error
+	GetOverdueTransactions(ctx context.Context, processedBefore time.Time, limit uint64) ([]string, error)
+	GetSucceededTransactions(ctx context.Context, method string, from, to time.Time) ([]string, error)
+	MarkStatusRequested(ctx context.Context, transactionID string, requestedAt time.Time) error
+	GetUnresolvedTransactions(ctx context.Context, requestedBefore time.Time, limit uint64) ([]string, error)
+	FailTransaction(ctx context.Context, transactionID string, reason string) error
+	LockQuote(ctx context.Context, quote *model.Quote) error
+	ClaimShare(ctx context.Context, transactionID string, payer *model.TransactionUser) (*model.TransactionShare, error)
+	SettleShare(ctx context.Context, paymentID string, succeeded bool, reason string) (*model.ShareSettlement, error)
+	CloseGroupTransactions(ctx context.Context, now time.Time, limit uint64) ([]string, []*model.TransactionShare, error)
 }
 
 type transactionRepo struct {
@@ -61,6 +93,10 @@ func (repo *transactionRepo) GetTransaction(ctx context.Context, transactionID s
 
 		collectedTransaction, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Transaction])
 		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTransactionNotFound
+			}
+
 			return err
 		}
 
@@ -82,6 +118,36 @@ func (repo *transactionRepo) GetTransaction(ctx context.Context, transactionID s
 			transaction.Sender = sender
 		}
 
+		if transaction.FeeReceiverID != nil {
+			feeReceiver, err := repo.getTransactionUserInTx(ctx, *transaction.FeeReceiverID)
+			if err != nil {
+				return err
+			}
+
+			transaction.FeeReceiver = feeReceiver
+		}
+
+		quote, err := repo.getQuoteInTx(ctx, transaction.ID)
+		if err != nil {
+			return err
+		}
+
+		transaction.Quote = quote
+
+		legs, err := repo.getTransactionLegsInTx(ctx, transaction)
+		if err != nil {
+			return err
+		}
+
+		transaction.Legs = legs
+
+		shares, err := repo.getTransactionSharesInTx(ctx, transaction.ID)
+		if err != nil {
+			return err
+		}
+
+		transaction.Shares = shares
+
 		return nil
 	}); err != nil {
 		return nil, NewGetTransactionError("failed to get transaction", err)
@@ -114,6 +180,70 @@ func (repo *transactionRepo) getTransactionUserInTx(ctx context.Context, transac
 	return &collectedTransactionUser, nil
 }
 
+// getQuoteInTx returns the quote locked for the transaction or nil when there is none.
+func (repo *transactionRepo) getQuoteInTx(ctx context.Context, transactionID string) (*model.Quote, error) {
+	sqlQuery, args, err := getQuoteQuery(transactionID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query get quote sql query: %w", err)
+	}
+	defer rows.Close()
+
+	quote, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Quote])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get quote structure from row: %w", err)
+	}
+
+	return &quote, nil
+}
+
+// getTransactionLegsInTx returns the legs of a split transaction ordered by position with their receivers.
+func (repo *transactionRepo) getTransactionLegsInTx(ctx context.Context, transaction *model.Transaction) ([]*model.TransactionLeg, error) {
+	sqlQuery, args, err := getTransactionLegsQuery(transaction.ID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction legs sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query get transaction legs sql query: %w", err)
+	}
+
+	legs, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.TransactionLeg])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction leg structures from rows: %w", err)
+	}
+
+	for _, leg := range legs {
+		if leg.ReceiverID == transaction.ReceiverID {
+			leg.Receiver = transaction.Receiver
+
+			continue
+		}
+
+		receiver, err := repo.getTransactionUserInTx(ctx, leg.ReceiverID)
+		if err != nil {
+			return nil, err
+		}
+
+		leg.Receiver = receiver
+	}
+
+	return legs, nil
+}
+
 // CreateTransaction creates a new transaction in the database.
 func (repo *transactionRepo) CreateTransaction(ctx context.Context, transaction *model.Transaction) error {
 	query := createTransactionQuery(transaction)
@@ -134,6 +264,19 @@ func (repo *transactionRepo) CreateTransaction(ctx context.Context, transaction
 			return err
 		}
 
+		if err := repo.createTransactionLegsInTx(ctx, transaction.Legs); err != nil {
+			return err
+		}
+
+		if err := repo.createTransactionSharesInTx(ctx, transaction.Shares); err != nil {
+			return err
+		}
+
+		// A watch transaction is processed at its creation, its payment is held right away.
+		if transaction.Watch {
+			return repo.bookTransactionInTx(ctx, transaction.ID)
+		}
+
 		return nil
 	}); err != nil {
 		return NewCreateTransactionError("failed to create transaction", err)
@@ -142,6 +285,87 @@ func (repo *transactionRepo) CreateTransaction(ctx context.Context, transaction
 	return nil
 }
 
+// createTransactionLegsInTx stores the legs of a split transaction with the receivers the transaction does not have yet.
+func (repo *transactionRepo) createTransactionLegsInTx(ctx context.Context, legs []*model.TransactionLeg) error {
+	if len(legs) == 0 {
+		return nil
+	}
+
+	for _, leg := range legs[1:] {
+		if err := repo.createTransactionUserInTx(ctx, leg.Receiver); err != nil {
+			return err
+		}
+	}
+
+	sqlQuery, args, err := createTransactionLegsQuery(legs).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get create transaction legs sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	if _, err = transactionConn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec create transaction legs sql query: %w", err)
+	}
+
+	return nil
+}
+
+// createTransactionSharesInTx stores the pending shares of a group transaction.
+func (repo *transactionRepo) createTransactionSharesInTx(ctx context.Context, shares []*model.TransactionShare) error {
+	if len(shares) == 0 {
+		return nil
+	}
+
+	sqlQuery, args, err := createTransactionSharesQuery(shares).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get create transaction shares sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	if _, err = transactionConn.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("failed to Exec create transaction shares sql query: %w", err)
+	}
+
+	return nil
+}
+
+// getTransactionSharesInTx returns the shares of a group transaction ordered by position with their payers.
+func (repo *transactionRepo) getTransactionSharesInTx(ctx context.Context, transactionID string) ([]*model.TransactionShare, error) {
+	sqlQuery, args, err := getTransactionSharesQuery(transactionID).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction shares sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query get transaction shares sql query: %w", err)
+	}
+
+	shares, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.TransactionShare])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction share structures from rows: %w", err)
+	}
+
+	for _, share := range shares {
+		if share.PayerID == nil {
+			continue
+		}
+
+		payer, err := repo.getTransactionUserInTx(ctx, *share.PayerID)
+		if err != nil {
+			return nil, err
+		}
+
+		share.Payer = payer
+	}
+
+	return shares, nil
+}
+
 func (repo *transactionRepo) createTransactionUserInTx(ctx context.Context, transactionUser *model.TransactionUser) error {
 	query := createTransactionUserQuery(transactionUser)
 
@@ -172,6 +396,10 @@ func (repo *transactionRepo) GetTransactionStatus(ctx context.Context, transacti
 
 	var transactionStatus model.TransactionStatus
 	if err = row.Scan(&transactionStatus); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Undefined, ErrTransactionNotFound
+		}
+
 		return model.Undefined, NewGetTransactionStatusError("failed to get transaction status type from row", err)
 	}
 
@@ -187,16 +415,80 @@ func (repo *transactionRepo) CancelTransaction(ctx context.Context, transactionI
 		return NewCancelTransactionError("failed to get cancel transaction sql query", err)
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
-		return NewCancelTransactionError("failed to Exec cancel transaction sql query", err)
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec cancel transaction sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		return repo.bookTransactionInTx(ctx, transactionID)
+	}); err != nil {
+		return NewCancelTransactionError("failed to cancel transaction", err)
+	}
+
+	return nil
+}
+
+// CancelPendingTransaction cancels a transaction that was not handed over to the payment gateway yet,
+// it is created, awaiting the payer confirmation or scheduled.
+// It returns ErrTransactionNotCancellable when the transaction is in any other status.
+func (repo *transactionRepo) CancelPendingTransaction(ctx context.Context, transactionID, reason string) error {
+	cancelSQLQuery, cancelArgs, err := cancelPendingTransactionQuery(transactionID, reason).ToSql()
+	if err != nil {
+		return NewCancelTransactionError("failed to get cancel pending transaction sql query", err)
+	}
+
+	statusSQLQuery, statusArgs, err := getTransactionStatusQuery(transactionID).ToSql()
+	if err != nil {
+		return NewCancelTransactionError("failed to get transaction status sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, cancelSQLQuery, cancelArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec cancel pending transaction sql query: %w", err)
+		}
+
+		if tag.RowsAffected() != 0 {
+			return repo.bookTransactionInTx(ctx, transactionID)
+		}
+
+		var status model.TransactionStatus
+		if err := transactionConn.QueryRow(ctx, statusSQLQuery, statusArgs...).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTransactionNotFound
+			}
+
+			return fmt.Errorf("failed to get transaction status: %w", err)
+		}
+
+		return ErrTransactionNotCancellable
+	}); err != nil {
+		return NewCancelTransactionError("failed to cancel pending transaction", err)
 	}
 
 	return nil
 }
 
 // AcceptTransaction accepts a transaction with a specified sender.
-func (repo *transactionRepo) AcceptTransaction(ctx context.Context, transactionID string, sender *model.TransactionUser) error {
-	query := acceptTransactionQuery(transactionID, sender.ID)
+// A transaction with executeAt is scheduled, it is processed once ExecuteScheduledTransactions reaches it.
+// The fee, if any, is stored with the transaction together with its platform receiver.
+func (repo *transactionRepo) AcceptTransaction(
+	ctx context.Context,
+	transactionID string,
+	sender *model.TransactionUser,
+	fee *model.Fee,
+	executeAt *time.Time,
+) error {
+	query := acceptTransactionQuery(transactionID, sender.ID, fee, executeAt)
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
@@ -210,11 +502,20 @@ func (repo *transactionRepo) AcceptTransaction(ctx context.Context, transactionI
 			return fmt.Errorf("failed to create transaction user in tx: %w", err)
 		}
 
-		if _, err = transactionConn.Exec(ctx, sqlQuery, args...); err != nil {
+		if err = repo.createFeeReceiverInTx(ctx, fee); err != nil {
+			return err
+		}
+
+		tag, err := transactionConn.Exec(ctx, sqlQuery, args...)
+		if err != nil {
 			return fmt.Errorf("failed to Exec accept transaction sql query: %w", err)
 		}
 
-		return nil
+		if tag.RowsAffected() == 0 {
+			return ErrTransactionNotCreated
+		}
+
+		return repo.bookTransactionInTx(ctx, transactionID)
 	}); err != nil {
 		return NewAcceptTransactionError("failed to accept transaction", err)
 	}
@@ -223,6 +524,9 @@ func (repo *transactionRepo) AcceptTransaction(ctx context.Context, transactionI
 }
 
 // UpdateTransaction updates an existing transaction in the database.
+// Only a created transaction is updated, it returns ErrTransactionNotCreated once it was accepted, canceled or expired,
+// so that the payer is never charged an amount they did not accept.
+// Editing the currency or the amount drops the locked quote, changing the status books it in the ledger.
 func (repo *transactionRepo) UpdateTransaction(ctx context.Context, updatedTransaction *model.Transaction) error {
 	query := updateTransactionQuery(updatedTransaction)
 
@@ -231,8 +535,53 @@ func (repo *transactionRepo) UpdateTransaction(ctx context.Context, updatedTrans
 		return NewUpdateTransactionError("failed to get update transaction sql query", err)
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
-		return NewUpdateTransactionError("failed to Exec update transaction sql query", err)
+	statusSQLQuery, statusArgs, err := getTransactionStatusQuery(updatedTransaction.ID).ToSql()
+	if err != nil {
+		return NewUpdateTransactionError("failed to get transaction status sql query", err)
+	}
+
+	deleteSQLQuery, deleteArgs, err := deleteQuoteQuery(updatedTransaction.ID).ToSql()
+	if err != nil {
+		return NewUpdateTransactionError("failed to get delete quote sql query", err)
+	}
+
+	// The locked quote converted the previous amount, it is dropped when the amount changes.
+	amountChanged := updatedTransaction.Currency != "" || updatedTransaction.Amount != 0
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec update transaction sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			var status model.TransactionStatus
+			if err := transactionConn.QueryRow(ctx, statusSQLQuery, statusArgs...).Scan(&status); err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return ErrTransactionNotFound
+				}
+
+				return fmt.Errorf("failed to get transaction status: %w", err)
+			}
+
+			return ErrTransactionNotCreated
+		}
+
+		if amountChanged {
+			if _, err := transactionConn.Exec(ctx, deleteSQLQuery, deleteArgs...); err != nil {
+				return fmt.Errorf("failed to Exec delete quote sql query: %w", err)
+			}
+		}
+
+		if updatedTransaction.Status == model.Undefined {
+			return nil
+		}
+
+		return repo.bookTransactionInTx(ctx, updatedTransaction.ID)
+	}); err != nil {
+		return NewUpdateTransactionError("failed to update transaction", err)
 	}
 
 	return nil
@@ -247,9 +596,830 @@ func (repo *transactionRepo) ChangeTransactionStatus(ctx context.Context, transa
 		return NewChangeTransactionStatusError("failed to get change transaction status sql query", err)
 	}
 
-	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
-		return NewChangeTransactionStatusError("failed to Exec change transaction status sql query", err)
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec change transaction status sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return nil
+		}
+
+		return repo.bookTransactionInTx(ctx, transactionID)
+	}); err != nil {
+		return NewChangeTransactionStatusError("failed to change transaction status", err)
+	}
+
+	return nil
+}
+
+// RequestConfirmation assigns the sender and stores a pending payer confirmation for a created transaction.
+// The executeAt is kept until the confirmation, a transaction confirmed with it is scheduled instead of processed.
+// The fee is fixed when the confirmation is requested.
+func (repo *transactionRepo) RequestConfirmation(
+	ctx context.Context,
+	sender *model.TransactionUser,
+	fee *model.Fee,
+	confirmation *model.Confirmation,
+	executeAt *time.Time,
+) error {
+	awaitQuery := awaitConfirmationQuery(confirmation.TransactionID, sender.ID, fee, executeAt)
+
+	awaitSQLQuery, awaitArgs, err := awaitQuery.ToSql()
+	if err != nil {
+		return NewRequestConfirmationError("failed to get await confirmation sql query", err)
+	}
+
+	createQuery := createConfirmationQuery(confirmation)
+
+	createSQLQuery, createArgs, err := createQuery.ToSql()
+	if err != nil {
+		return NewRequestConfirmationError("failed to get create confirmation sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		if err := repo.createTransactionUserInTx(ctx, sender); err != nil {
+			return fmt.Errorf("failed to create transaction user in tx: %w", err)
+		}
+
+		if err := repo.createFeeReceiverInTx(ctx, fee); err != nil {
+			return err
+		}
+
+		tag, err := transactionConn.Exec(ctx, awaitSQLQuery, awaitArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec await confirmation sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return ErrTransactionNotCreated
+		}
+
+		if _, err := transactionConn.Exec(ctx, createSQLQuery, createArgs...); err != nil {
+			return fmt.Errorf("failed to Exec create confirmation sql query: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return NewRequestConfirmationError("failed to request confirmation", err)
 	}
 
 	return nil
 }
+
+// GetConfirmation retrieves the pending payer confirmation of a transaction.
+func (repo *transactionRepo) GetConfirmation(ctx context.Context, transactionID string) (*model.Confirmation, error) {
+	query := getConfirmationQuery(transactionID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetConfirmationError("failed to get confirmation sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetConfirmationError("failed to query get confirmation sql query", err)
+	}
+	defer rows.Close()
+
+	confirmation, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[model.Confirmation])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NewGetConfirmationError("failed to get confirmation", ErrConfirmationNotFound)
+		}
+
+		return nil, NewGetConfirmationError("failed to get confirmation structure from row", err)
+	}
+
+	return &confirmation, nil
+}
+
+// IncrementConfirmationAttempts counts a failed confirmation attempt.
+func (repo *transactionRepo) IncrementConfirmationAttempts(ctx context.Context, transactionID string) error {
+	query := incrementConfirmationAttemptsQuery(transactionID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewIncrementConfirmationAttemptsError("failed to get increment confirmation attempts sql query", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return NewIncrementConfirmationAttemptsError("failed to Exec increment confirmation attempts sql query", err)
+	}
+
+	return nil
+}
+
+// ConfirmTransaction moves a transaction awaiting confirmation to processed, or to scheduled
+// when it was accepted with an execution time, and drops its confirmation.
+func (repo *transactionRepo) ConfirmTransaction(ctx context.Context, transactionID string) error {
+	return repo.finishConfirmation(ctx, confirmTransactionQuery(transactionID), transactionID, func(msg string, err error) error {
+		return NewConfirmTransactionError(msg, err)
+	})
+}
+
+// ResetConfirmation returns a transaction awaiting confirmation to created so that it can be accepted again.
+func (repo *transactionRepo) ResetConfirmation(ctx context.Context, transactionID string) error {
+	return repo.finishConfirmation(ctx, resetConfirmationQuery(transactionID), transactionID, func(msg string, err error) error {
+		return NewResetConfirmationError(msg, err)
+	})
+}
+
+func (repo *transactionRepo) finishConfirmation(
+	ctx context.Context,
+	statusQuery sq.UpdateBuilder,
+	transactionID string,
+	newErr func(msg string, err error) error,
+) error {
+	statusSQLQuery, statusArgs, err := statusQuery.ToSql()
+	if err != nil {
+		return newErr("failed to get transaction status sql query", err)
+	}
+
+	deleteSQLQuery, deleteArgs, err := deleteConfirmationQuery(transactionID).ToSql()
+	if err != nil {
+		return newErr("failed to get delete confirmation sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, statusSQLQuery, statusArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec transaction status sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return ErrConfirmationNotFound
+		}
+
+		if _, err := transactionConn.Exec(ctx, deleteSQLQuery, deleteArgs...); err != nil {
+			return fmt.Errorf("failed to Exec delete confirmation sql query: %w", err)
+		}
+
+		return repo.bookTransactionInTx(ctx, transactionID)
+	}); err != nil {
+		return newErr("failed to finish confirmation", err)
+	}
+
+	return nil
+}
+
+// ExpireTransactions moves up to limit created transactions that expired by now to the expired status.
+// It returns the ids of the expired transactions, rows locked by concurrent accepts are skipped.
+func (repo *transactionRepo) ExpireTransactions(ctx context.Context, now time.Time, limit uint64) ([]string, error) {
+	query := expireTransactionsQuery(now, limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewExpireTransactionsError("failed to get expire transactions sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewExpireTransactionsError("failed to Query expire transactions sql query", err)
+	}
+
+	transactionIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, NewExpireTransactionsError("failed to collect expired transactions", err)
+	}
+
+	return transactionIDs, nil
+}
+
+// ExecuteScheduledTransactions moves up to limit scheduled transactions whose execution time has come to processed.
+// It returns the ids of the processed transactions, rows locked by concurrent cancels or other replicas are skipped,
+// so every scheduled transaction is processed once.
+func (repo *transactionRepo) ExecuteScheduledTransactions(ctx context.Context, limit uint64) ([]string, error) {
+	query := executeScheduledTransactionsQuery(limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewExecuteScheduledTransactionsError("failed to get execute scheduled transactions sql query", err)
+	}
+
+	var transactionIDs []string
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		rows, err := transactionConn.Query(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to query execute scheduled transactions sql query: %w", err)
+		}
+
+		transactionIDs, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("failed to collect executed transactions: %w", err)
+		}
+
+		for _, transactionID := range transactionIDs {
+			if err := repo.bookTransactionInTx(ctx, transactionID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, NewExecuteScheduledTransactionsError("failed to execute scheduled transactions", err)
+	}
+
+	return transactionIDs, nil
+}
+
+// AuthorizeTransaction moves a processed manually captured transaction to the authorized status,
+// its hold is voided once authorizedUntil passes.
+// It returns ErrTransactionNotProcessed when the transaction was settled in the meantime.
+func (repo *transactionRepo) AuthorizeTransaction(ctx context.Context, transactionID string, authorizedUntil time.Time) error {
+	query := authorizeTransactionQuery(transactionID, authorizedUntil)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewAuthorizeTransactionError("failed to get authorize transaction sql query", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return NewAuthorizeTransactionError("failed to Exec authorize transaction sql query", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewAuthorizeTransactionError("failed to authorize transaction", ErrTransactionNotProcessed)
+	}
+
+	return nil
+}
+
+// CaptureTransaction moves an authorized transaction back to processed with the captured amount,
+// the payment gateway then takes it out of the hold.
+// It returns ErrTransactionNotAuthorized when the hold timed out by now, is being voided or there is none.
+func (repo *transactionRepo) CaptureTransaction(ctx context.Context, transactionID string, amount int64, now time.Time) error {
+	query := captureTransactionQuery(transactionID, amount, now)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewCaptureTransactionError("failed to get capture transaction sql query", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return NewCaptureTransactionError("failed to Exec capture transaction sql query", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewCaptureTransactionError("failed to capture transaction", ErrTransactionNotAuthorized)
+	}
+
+	return nil
+}
+
+// ReleaseCapture returns a transaction whose capture failed to the authorized status, so that it can be captured
+// again or is voided with its hold. It reports false when the transaction is not being captured.
+func (repo *transactionRepo) ReleaseCapture(ctx context.Context, transactionID string) (bool, error) {
+	query := releaseCaptureQuery(transactionID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return false, NewReleaseCaptureError("failed to get release capture sql query", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, NewReleaseCaptureError("failed to Exec release capture sql query", err)
+	}
+
+	return tag.RowsAffected() != 0, nil
+}
+
+// RequestVoid records that the void of an authorized transaction was requested at now,
+// a capture is refused from then on.
+// It returns ErrTransactionNotAuthorized when the transaction has no hold.
+func (repo *transactionRepo) RequestVoid(ctx context.Context, transactionID string, now time.Time) error {
+	query := requestVoidQuery(transactionID, now)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewRequestVoidError("failed to get request void sql query", err)
+	}
+
+	tag, err := repo.pg.Pool.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return NewRequestVoidError("failed to Exec request void sql query", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return NewRequestVoidError("failed to request void", ErrTransactionNotAuthorized)
+	}
+
+	return nil
+}
+
+// ClaimExpiredHolds requests the void of up to limit authorized transactions whose hold timed out by now.
+// Holds whose void was requested before retryBefore are claimed again.
+// It returns the ids of the claimed transactions, rows locked by concurrent captures or other replicas are skipped.
+func (repo *transactionRepo) ClaimExpiredHolds(ctx context.Context, now, retryBefore time.Time, limit uint64) ([]string, error) {
+	query := claimExpiredHoldsQuery(now, retryBefore, limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewClaimExpiredHoldsError("failed to get claim expired holds sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewClaimExpiredHoldsError("failed to Query claim expired holds sql query", err)
+	}
+
+	transactionIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, NewClaimExpiredHoldsError("failed to collect expired holds", err)
+	}
+
+	return transactionIDs, nil
+}
+
+// VoidTransaction moves an authorized transaction whose hold was released to the voided status.
+// It returns ErrTransactionNotAuthorized when the transaction was captured in the meantime.
+func (repo *transactionRepo) VoidTransaction(ctx context.Context, transactionID string) error {
+	query := voidTransactionQuery(transactionID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewVoidTransactionError("failed to get void transaction sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec void transaction sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return ErrTransactionNotAuthorized
+		}
+
+		return repo.bookTransactionInTx(ctx, transactionID)
+	}); err != nil {
+		return NewVoidTransactionError("failed to void transaction", err)
+	}
+
+	return nil
+}
+
+// GetOverdueTransactions returns up to limit processed transactions that were processed
+// before processedBefore and whose payment status has not been requested yet.
+func (repo *transactionRepo) GetOverdueTransactions(ctx context.Context, processedBefore time.Time, limit uint64) ([]string, error) {
+	query := getOverdueTransactionsQuery(processedBefore, limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetOverdueTransactionsError("failed to get overdue transactions sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetOverdueTransactionsError("failed to Query overdue transactions sql query", err)
+	}
+
+	transactionIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, NewGetOverdueTransactionsError("failed to collect overdue transactions", err)
+	}
+
+	return transactionIDs, nil
+}
+
+// GetSucceededTransactions retrieves the succeeded transactions of the payment method settled in [from, to).
+func (repo *transactionRepo) GetSucceededTransactions(ctx context.Context, method string, from, to time.Time) ([]string, error) {
+	query := getSucceededTransactionsQuery(method, from, to)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetSucceededTransactionsError("failed to get succeeded transactions sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetSucceededTransactionsError("failed to Query succeeded transactions sql query", err)
+	}
+
+	transactionIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, NewGetSucceededTransactionsError("failed to collect succeeded transactions", err)
+	}
+
+	return transactionIDs, nil
+}
+
+// MarkStatusRequested records when the payment status of a processed transaction was requested.
+func (repo *transactionRepo) MarkStatusRequested(ctx context.Context, transactionID string, requestedAt time.Time) error {
+	query := markStatusRequestedQuery(transactionID, requestedAt)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewMarkStatusRequestedError("failed to get mark status requested sql query", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return NewMarkStatusRequestedError("failed to Exec mark status requested sql query", err)
+	}
+
+	return nil
+}
+
+// GetUnresolvedTransactions returns up to limit transactions that are still processed
+// although their payment status was requested before requestedBefore.
+func (repo *transactionRepo) GetUnresolvedTransactions(ctx context.Context, requestedBefore time.Time, limit uint64) ([]string, error) {
+	query := getUnresolvedTransactionsQuery(requestedBefore, limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetUnresolvedTransactionsError("failed to get unresolved transactions sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetUnresolvedTransactionsError("failed to Query unresolved transactions sql query", err)
+	}
+
+	transactionIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, NewGetUnresolvedTransactionsError("failed to collect unresolved transactions", err)
+	}
+
+	return transactionIDs, nil
+}
+
+// FailTransaction moves a processed transaction to the failed status with a specified reason.
+// It returns ErrTransactionNotProcessed when the transaction was settled in the meantime.
+func (repo *transactionRepo) FailTransaction(ctx context.Context, transactionID, reason string) error {
+	query := failTransactionQuery(transactionID, reason)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewFailTransactionError("failed to get fail transaction sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		tag, err := transactionConn.Exec(ctx, sqlQuery, args...)
+		if err != nil {
+			return fmt.Errorf("failed to Exec fail transaction sql query: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return ErrTransactionNotProcessed
+		}
+
+		return repo.bookTransactionInTx(ctx, transactionID)
+	}); err != nil {
+		return NewFailTransactionError("failed to fail transaction", err)
+	}
+
+	return nil
+}
+
+// LockQuote stores the quote of a created transaction, replacing the previous one.
+// The transaction row is locked so that the quote cannot change once the transaction is accepted.
+// It returns ErrQuoteLocked while the previous quote of another payer has not expired.
+func (repo *transactionRepo) LockQuote(ctx context.Context, quote *model.Quote) error {
+	statusSQLQuery, statusArgs, err := lockTransactionStatusQuery(quote.TransactionID).ToSql()
+	if err != nil {
+		return NewLockQuoteError("failed to get lock transaction status sql query", err)
+	}
+
+	upsertSQLQuery, upsertArgs, err := upsertQuoteQuery(quote).ToSql()
+	if err != nil {
+		return NewLockQuoteError("failed to get upsert quote sql query", err)
+	}
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		var status model.TransactionStatus
+		if err := transactionConn.QueryRow(ctx, statusSQLQuery, statusArgs...).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTransactionNotFound
+			}
+
+			return fmt.Errorf("failed to lock transaction status: %w", err)
+		}
+
+		if status != model.Created {
+			return ErrTransactionNotCreated
+		}
+
+		previous, err := repo.getQuoteInTx(ctx, quote.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		if previous != nil && previous.PayerID != quote.PayerID && !previous.Expired(quote.CreatedAt) {
+			return ErrQuoteLocked
+		}
+
+		if _, err := transactionConn.Exec(ctx, upsertSQLQuery, upsertArgs...); err != nil {
+			return fmt.Errorf("failed to Exec upsert quote sql query: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return NewLockQuoteError("failed to lock quote", err)
+	}
+
+	return nil
+}
+
+// ClaimShare gives the first pending share of a created group transaction to the payer and moves it to the processed status.
+// It returns ErrNoShareLeft when every share is taken and ErrPayerHasShare when the payer already took one.
+func (repo *transactionRepo) ClaimShare(ctx context.Context, transactionID string, payer *model.TransactionUser) (*model.TransactionShare, error) {
+	statusSQLQuery, statusArgs, err := lockTransactionStatusQuery(transactionID).ToSql()
+	if err != nil {
+		return nil, NewClaimShareError("failed to get lock transaction status sql query", err)
+	}
+
+	payerSQLQuery, payerArgs, err := payerHasShareQuery(transactionID, payer.UserID).ToSql()
+	if err != nil {
+		return nil, NewClaimShareError("failed to get payer has share sql query", err)
+	}
+
+	claimSQLQuery, claimArgs, err := claimShareQuery(transactionID, payer.ID, time.Now()).ToSql()
+	if err != nil {
+		return nil, NewClaimShareError("failed to get claim share sql query", err)
+	}
+
+	var share *model.TransactionShare
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		var status model.TransactionStatus
+		if err := transactionConn.QueryRow(ctx, statusSQLQuery, statusArgs...).Scan(&status); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTransactionNotFound
+			}
+
+			return fmt.Errorf("failed to lock transaction status: %w", err)
+		}
+
+		if status != model.Created {
+			return ErrTransactionNotCreated
+		}
+
+		var hasShare bool
+		if err := transactionConn.QueryRow(ctx, payerSQLQuery, payerArgs...).Scan(&hasShare); err != nil {
+			return fmt.Errorf("failed to query payer has share sql query: %w", err)
+		}
+
+		if hasShare {
+			return ErrPayerHasShare
+		}
+
+		if err := repo.createTransactionUserInTx(ctx, payer); err != nil {
+			return fmt.Errorf("failed to create transaction user in tx: %w", err)
+		}
+
+		rows, err := transactionConn.Query(ctx, claimSQLQuery, claimArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to query claim share sql query: %w", err)
+		}
+
+		claimedShare, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.TransactionShare])
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNoShareLeft
+			}
+
+			return fmt.Errorf("failed to get share structure from row: %w", err)
+		}
+
+		claimedShare.Payer = payer
+		share = claimedShare
+
+		return repo.bookTransactionInTx(ctx, transactionID)
+	}); err != nil {
+		return nil, NewClaimShareError("failed to claim share", err)
+	}
+
+	return share, nil
+}
+
+// SettleShare applies the outcome of a payment made for a share of a group transaction,
+// paymentID is either the share id or its refund id. It returns ErrShareNotFound when neither matches.
+// A paid share completes the group transaction once every share is paid,
+// it is refunded instead when the group transaction was closed in the meantime.
+// A failed share payment frees the share for another payer while the group transaction is open.
+// Outcomes that do not match the share status are ignored, so repeated messages change nothing.
+func (repo *transactionRepo) SettleShare(ctx context.Context, paymentID string, succeeded bool, reason string) (*model.ShareSettlement, error) {
+	lockSQLQuery, lockArgs, err := lockShareQuery(paymentID).ToSql()
+	if err != nil {
+		return nil, NewSettleShareError("failed to get lock share sql query", err)
+	}
+
+	var settlement *model.ShareSettlement
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		rows, err := transactionConn.Query(ctx, lockSQLQuery, lockArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to query lock share sql query: %w", err)
+		}
+
+		share, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.TransactionShare])
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrShareNotFound
+			}
+
+			return fmt.Errorf("failed to get share structure from row: %w", err)
+		}
+
+		settlement = &model.ShareSettlement{
+			Share: share,
+		}
+
+		statusSQLQuery, statusArgs, err := lockTransactionStatusQuery(share.TransactionID).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get lock transaction status sql query: %w", err)
+		}
+
+		var status model.TransactionStatus
+		if err := transactionConn.QueryRow(ctx, statusSQLQuery, statusArgs...).Scan(&status); err != nil {
+			return fmt.Errorf("failed to lock transaction status: %w", err)
+		}
+
+		switch {
+		case paymentID == share.RefundID && share.Status == model.ShareRefunding:
+			share.Status = model.ShareRefunded
+			if !succeeded {
+				share.Status = model.ShareRefundFailed
+				share.Reason = reason
+			}
+		case paymentID == share.ID && share.Status == model.ShareProcessed:
+			switch {
+			case succeeded && status == model.Created:
+				share.Status = model.ShareSucceeded
+			case succeeded:
+				share.Status = model.ShareRefunding
+				settlement.Refund = true
+			case status == model.Created:
+				share.Status = model.SharePending
+				share.PayerID = nil
+				share.Payer = nil
+				share.Reason = reason
+			default:
+				share.Status = model.ShareCanceled
+				share.Reason = reason
+			}
+		default:
+			return nil
+		}
+
+		share.UpdatedAt = time.Now()
+
+		updateSQLQuery, updateArgs, err := updateShareQuery(share).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get update share sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, updateSQLQuery, updateArgs...); err != nil {
+			return fmt.Errorf("failed to Exec update share sql query: %w", err)
+		}
+
+		if share.Status == model.ShareSucceeded {
+			if err := repo.completeGroupTransactionInTx(ctx, settlement, share.UpdatedAt); err != nil {
+				return err
+			}
+		}
+
+		return repo.bookTransactionInTx(ctx, share.TransactionID)
+	}); err != nil {
+		return nil, NewSettleShareError("failed to settle share", err)
+	}
+
+	return settlement, nil
+}
+
+// completeGroupTransactionInTx moves the group transaction to the succeeded status when all of its shares are paid.
+func (repo *transactionRepo) completeGroupTransactionInTx(ctx context.Context, settlement *model.ShareSettlement, now time.Time) error {
+	transactionID := settlement.Share.TransactionID
+
+	unsettledSQLQuery, unsettledArgs, err := unsettledSharesQuery(transactionID).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get unsettled shares sql query: %w", err)
+	}
+
+	transactionConn := repo.pg.GetTransactionConn(ctx)
+
+	var unsettled int
+	if err := transactionConn.QueryRow(ctx, unsettledSQLQuery, unsettledArgs...).Scan(&unsettled); err != nil {
+		return fmt.Errorf("failed to query unsettled shares sql query: %w", err)
+	}
+
+	if unsettled > 0 {
+		return nil
+	}
+
+	succeedSQLQuery, succeedArgs, err := succeedGroupTransactionQuery(transactionID, now).ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to get succeed group transaction sql query: %w", err)
+	}
+
+	tag, err := transactionConn.Exec(ctx, succeedSQLQuery, succeedArgs...)
+	if err != nil {
+		return fmt.Errorf("failed to Exec succeed group transaction sql query: %w", err)
+	}
+
+	settlement.Completed = tag.RowsAffected() > 0
+
+	return nil
+}
+
+// CloseGroupTransactions closes up to limit group transactions that passed their deadline by now or were canceled.
+// Created ones are moved to the expired status, their pending shares are canceled and the paid shares are moved
+// to the refunding status. It returns the ids of the closed transactions and the shares to refund,
+// rows locked by concurrent accepts are skipped.
+func (repo *transactionRepo) CloseGroupTransactions(ctx context.Context, now time.Time, limit uint64) ([]string, []*model.TransactionShare, error) {
+	lockSQLQuery, lockArgs, err := lockClosableGroupTransactionsQuery(now, limit).ToSql()
+	if err != nil {
+		return nil, nil, NewCloseGroupTransactionsError("failed to get lock closable group transactions sql query", err)
+	}
+
+	var (
+		transactionIDs []string
+		refunds        []*model.TransactionShare
+	)
+
+	if err := repo.pg.TrManager.Do(ctx, func(ctx context.Context) error {
+		transactionConn := repo.pg.GetTransactionConn(ctx)
+
+		rows, err := transactionConn.Query(ctx, lockSQLQuery, lockArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to query lock closable group transactions sql query: %w", err)
+		}
+
+		transactionIDs, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return fmt.Errorf("failed to collect group transaction ids: %w", err)
+		}
+
+		if len(transactionIDs) == 0 {
+			return nil
+		}
+
+		expireSQLQuery, expireArgs, err := expireGroupTransactionsQuery(transactionIDs, now).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get expire group transactions sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, expireSQLQuery, expireArgs...); err != nil {
+			return fmt.Errorf("failed to Exec expire group transactions sql query: %w", err)
+		}
+
+		cancelSQLQuery, cancelArgs, err := closeSharesQuery(transactionIDs, model.SharePending, model.ShareCanceled, "transaction closed", now).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get cancel shares sql query: %w", err)
+		}
+
+		if _, err := transactionConn.Exec(ctx, cancelSQLQuery, cancelArgs...); err != nil {
+			return fmt.Errorf("failed to Exec cancel shares sql query: %w", err)
+		}
+
+		refundSQLQuery, refundArgs, err := closeSharesQuery(transactionIDs, model.ShareSucceeded, model.ShareRefunding, "transaction closed", now).ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to get refund shares sql query: %w", err)
+		}
+
+		rows, err = transactionConn.Query(ctx, refundSQLQuery, refundArgs...)
+		if err != nil {
+			return fmt.Errorf("failed to query refund shares sql query: %w", err)
+		}
+
+		refunds, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.TransactionShare])
+		if err != nil {
+			return fmt.Errorf("failed to get share structures from rows: %w", err)
+		}
+
+		for _, transactionID := range transactionIDs {
+			if err := repo.bookTransactionInTx(ctx, transactionID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, nil, NewCloseGroupTransactionsError("failed to close group transactions", err)
+	}
+
+	return transactionIDs, refunds, nil
+}
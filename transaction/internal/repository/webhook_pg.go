@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:generate mockgen -package mocks -destination mocks/webhook_repository_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/repository WebhookRepo
+
+// WebhookRepo defines the interface for merchant webhook operations.
+// Deliveries are enqueued by the database on every transaction status transition.
+type WebhookRepo interface {
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) error
+	GetWebhook(ctx context.Context, webhookID string) (*model.Webhook, error)
+	GetWebhookDeliveries(ctx context.Context, webhookID string, limit, offset uint64) ([]*model.WebhookDelivery, error)
+	GetWebhookDelivery(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error)
+	CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit uint64) ([]*model.WebhookDelivery, error)
+	RecordDeliveryAttempt(ctx context.Context, delivery *model.WebhookDelivery) error
+}
+
+type webhookRepo struct {
+	pg  *postgres.Postgres
+	log logger.Logger
+}
+
+// NewWebhookRepo creates a new instance of WebhookRepo.
+func NewWebhookRepo(
+	pg *postgres.Postgres,
+	log logger.Logger,
+) WebhookRepo {
+	return &webhookRepo{
+		pg:  pg,
+		log: log,
+	}
+}
+
+// CreateWebhook creates a new merchant webhook in the database.
+func (repo *webhookRepo) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	query := createWebhookQuery(webhook)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewCreateWebhookError("failed to get create webhook sql query", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return NewCreateWebhookError("failed to Exec create webhook sql query", err)
+	}
+
+	return nil
+}
+
+// GetWebhook retrieves a merchant webhook from the database.
+func (repo *webhookRepo) GetWebhook(ctx context.Context, webhookID string) (*model.Webhook, error) {
+	query := getWebhookQuery(webhookID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetWebhookError("failed to get webhook sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetWebhookError("failed to Query get webhook sql query", err)
+	}
+
+	webhook, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.Webhook])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NewGetWebhookError("failed to get webhook", ErrWebhookNotFound)
+		}
+
+		return nil, NewGetWebhookError("failed to collect webhook", err)
+	}
+
+	return webhook, nil
+}
+
+// GetWebhookDeliveries retrieves a page of the webhook deliveries, newest first.
+func (repo *webhookRepo) GetWebhookDeliveries(
+	ctx context.Context,
+	webhookID string,
+	limit, offset uint64,
+) ([]*model.WebhookDelivery, error) {
+	query := getWebhookDeliveriesQuery(webhookID, limit, offset)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetWebhookDeliveriesError("failed to get webhook deliveries sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetWebhookDeliveriesError("failed to Query webhook deliveries sql query", err)
+	}
+
+	deliveries, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.WebhookDelivery])
+	if err != nil {
+		return nil, NewGetWebhookDeliveriesError("failed to collect webhook deliveries", err)
+	}
+
+	return deliveries, nil
+}
+
+// GetWebhookDelivery retrieves a webhook delivery from the database.
+func (repo *webhookRepo) GetWebhookDelivery(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	query := getWebhookDeliveryQuery(deliveryID)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewGetWebhookDeliveryError("failed to get webhook delivery sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewGetWebhookDeliveryError("failed to Query webhook delivery sql query", err)
+	}
+
+	delivery, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[model.WebhookDelivery])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, NewGetWebhookDeliveryError("failed to get webhook delivery", ErrWebhookDeliveryNotFound)
+		}
+
+		return nil, NewGetWebhookDeliveryError("failed to collect webhook delivery", err)
+	}
+
+	return delivery, nil
+}
+
+// CreateWebhookDelivery enqueues a webhook delivery.
+func (repo *webhookRepo) CreateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	query := createWebhookDeliveryQuery(delivery)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewCreateWebhookDeliveryError("failed to get create webhook delivery sql query", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return NewCreateWebhookDeliveryError("failed to Exec create webhook delivery sql query", err)
+	}
+
+	return nil
+}
+
+// ClaimDueDeliveries takes up to limit pending deliveries due by now and moves their next attempt
+// to leaseUntil, so that other dispatchers skip them while they are being sent.
+func (repo *webhookRepo) ClaimDueDeliveries(
+	ctx context.Context,
+	now, leaseUntil time.Time,
+	limit uint64,
+) ([]*model.WebhookDelivery, error) {
+	query := claimDueDeliveriesQuery(now, leaseUntil, limit)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, NewClaimDueDeliveriesError("failed to get claim due deliveries sql query", err)
+	}
+
+	rows, err := repo.pg.Pool.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, NewClaimDueDeliveriesError("failed to Query claim due deliveries sql query", err)
+	}
+
+	deliveries, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByName[model.WebhookDelivery])
+	if err != nil {
+		return nil, NewClaimDueDeliveriesError("failed to collect claimed deliveries", err)
+	}
+
+	return deliveries, nil
+}
+
+// RecordDeliveryAttempt stores the outcome of a delivery attempt.
+func (repo *webhookRepo) RecordDeliveryAttempt(ctx context.Context, delivery *model.WebhookDelivery) error {
+	query := recordDeliveryAttemptQuery(delivery)
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return NewRecordDeliveryAttemptError("failed to get record delivery attempt sql query", err)
+	}
+
+	if _, err = repo.pg.Pool.Exec(ctx, sqlQuery, args...); err != nil {
+		return NewRecordDeliveryAttemptError("failed to Exec record delivery attempt sql query", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package saga
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultProcessingTimeout = 2 * time.Minute
+	defaultStatusTimeout     = 30 * time.Second
+	defaultCheckInterval     = 10 * time.Second
+	defaultBatchSize         = 100
+	defaultLeaderKey         = "transaction:saga:leader"
+	defaultLeaderTTL         = 15 * time.Second
+)
+
+// Config represents the saga supervisor configuration structure.
+type Config struct {
+	// ProcessingTimeout is how long a processed transaction may wait for the payment gateway
+	// before its live status is requested.
+	ProcessingTimeout time.Duration
+	// StatusTimeout is how long the payment gateway has to resolve a requested status
+	// before the transaction is failed.
+	StatusTimeout time.Duration
+	// CheckInterval is how often the supervisor looks for overdue transactions.
+	CheckInterval time.Duration
+	// BatchSize limits how many transactions are handled by a single check.
+	BatchSize uint64
+	// LeaderKey is the redis key holding the supervisor lease.
+	LeaderKey string
+	// LeaderTTL is how long the lease outlives a replica that stopped renewing it.
+	LeaderTTL time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		ProcessingTimeout: defaultProcessingTimeout,
+		StatusTimeout:     defaultStatusTimeout,
+		CheckInterval:     defaultCheckInterval,
+		BatchSize:         defaultBatchSize,
+		LeaderKey:         defaultLeaderKey,
+		LeaderTTL:         defaultLeaderTTL,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
@@ -0,0 +1,66 @@
+package saga
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				ProcessingTimeout: time.Minute,
+				LeaderKey:         "test:leader",
+			},
+			expectedCfg: &Config{
+				ProcessingTimeout: time.Minute,
+				StatusTimeout:     defaultStatusTimeout,
+				CheckInterval:     defaultCheckInterval,
+				BatchSize:         defaultBatchSize,
+				LeaderKey:         "test:leader",
+				LeaderTTL:         defaultLeaderTTL,
+			},
+		},
+		{
+			name: "With empty config",
+			cfg:  &Config{},
+			expectedCfg: &Config{
+				ProcessingTimeout: defaultProcessingTimeout,
+				StatusTimeout:     defaultStatusTimeout,
+				CheckInterval:     defaultCheckInterval,
+				BatchSize:         defaultBatchSize,
+				LeaderKey:         defaultLeaderKey,
+				LeaderTTL:         defaultLeaderTTL,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
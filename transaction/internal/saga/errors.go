@@ -0,0 +1,25 @@
+package saga
+
+import "fmt"
+
+// SuperviseError represents an error encountered while supervising processed transactions.
+type SuperviseError struct {
+	msg string
+	err error
+}
+
+// NewSuperviseError creates a new SuperviseError instance with the provided message and error.
+func NewSuperviseError(msg string, err error) *SuperviseError {
+	return &SuperviseError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e SuperviseError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e SuperviseError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,142 @@
+package saga
+
+import (
+	"context"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// Elector runs work on a single replica at a time.
+type Elector interface {
+	// Lead blocks until the context is canceled. Whenever this replica holds the lease
+	// it calls run with a context that is canceled as soon as the lease is lost.
+	Lead(ctx context.Context, run func(ctx context.Context))
+}
+
+// renewScript extends the lease only when it is still held by the caller.
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript removes the lease only when it is still held by the caller.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type redisElector struct {
+	client *redis.Client
+	key    string
+	id     string
+	ttl    time.Duration
+	log    logger.Logger
+}
+
+// NewRedisElector creates an Elector backed by a redis key with a TTL.
+// The lease is renewed every third of the TTL, so a crashed leader is replaced after at most one TTL.
+func NewRedisElector(client *redis.Client, key string, ttl time.Duration, log logger.Logger) Elector {
+	return &redisElector{
+		client: client,
+		key:    key,
+		id:     uuid.NewString(),
+		ttl:    ttl,
+		log:    log,
+	}
+}
+
+// Lead tries to take the lease every third of the TTL and holds it while run is working.
+func (e *redisElector) Lead(ctx context.Context, run func(ctx context.Context)) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		acquired, err := e.client.SetNX(ctx, e.key, e.id, e.ttl).Result()
+		if err != nil && ctx.Err() == nil {
+			e.log.Error("Failed to acquire leader lease", map[string]interface{}{
+				"error": err,
+				"key":   e.key,
+			})
+		}
+
+		if acquired {
+			e.log.Info("Acquired leader lease", map[string]interface{}{
+				"key": e.key,
+				"id":  e.id,
+			})
+
+			e.hold(ctx, run)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// hold runs the work and renews the lease until the work returns, the context is canceled
+// or the lease is lost. The lease is released on the way out in all cases.
+func (e *redisElector) hold(ctx context.Context, run func(ctx context.Context)) {
+	defer e.release(ctx)
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		run(leaderCtx)
+	}()
+
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			renewed, err := renewScript.Run(ctx, e.client, []string{e.key}, e.id, e.ttl.Milliseconds()).Int()
+			if err == nil && renewed == 1 {
+				continue
+			}
+
+			if ctx.Err() == nil {
+				e.log.Error("Lost leader lease", map[string]interface{}{
+					"error": err,
+					"key":   e.key,
+					"id":    e.id,
+				})
+			}
+
+			return
+		}
+	}
+}
+
+// release gives the lease up so that another replica does not wait for the TTL.
+func (e *redisElector) release(ctx context.Context) {
+	if err := releaseScript.Run(context.WithoutCancel(ctx), e.client, []string{e.key}, e.id).Err(); err != nil {
+		e.log.Error("Failed to release leader lease", map[string]interface{}{
+			"error": err,
+			"key":   e.key,
+		})
+	}
+}
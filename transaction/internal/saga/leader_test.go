@@ -0,0 +1,111 @@
+package saga
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testLeaderKey = "test:saga:leader"
+	testLeaderTTL = 150 * time.Millisecond
+	testWait      = time.Second
+)
+
+func electorHelper(t *testing.T, server *miniredis.Miniredis) Elector {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return NewRedisElector(redis.NewClient(&redis.Options{Addr: server.Addr()}), testLeaderKey, testLeaderTTL, l)
+}
+
+// leading starts the elector and reports the contexts it leads with.
+func leading(ctx context.Context, elector Elector) <-chan context.Context {
+	leaderCtxs := make(chan context.Context, 1)
+
+	go elector.Lead(ctx, func(ctx context.Context) {
+		leaderCtxs <- ctx
+		<-ctx.Done()
+	})
+
+	return leaderCtxs
+}
+
+func TestRedisElectorFailover(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	defer cancelFirst()
+
+	first := leading(firstCtx, electorHelper(t, server))
+
+	select {
+	case <-first:
+	case <-time.After(testWait):
+		t.Fatal("first elector did not lead")
+	}
+
+	secondCtx, cancelSecond := context.WithCancel(context.Background())
+	defer cancelSecond()
+
+	second := leading(secondCtx, electorHelper(t, server))
+
+	select {
+	case <-second:
+		t.Fatal("second elector led while the first one holds the lease")
+	case <-time.After(3 * testLeaderTTL):
+	}
+
+	cancelFirst()
+
+	select {
+	case <-second:
+	case <-time.After(testWait):
+		t.Fatal("second elector did not take over the released lease")
+	}
+}
+
+func TestRedisElectorLostLease(t *testing.T) {
+	t.Parallel()
+
+	server := miniredis.RunT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	leader := leading(ctx, electorHelper(t, server))
+
+	var leaderCtx context.Context
+
+	select {
+	case leaderCtx = <-leader:
+	case <-time.After(testWait):
+		t.Fatal("elector did not lead")
+	}
+
+	require.NoError(t, server.Set(testLeaderKey, "other-replica"))
+
+	select {
+	case <-leaderCtx.Done():
+	case <-time.After(testWait):
+		t.Fatal("work was not stopped after the lease was lost")
+	}
+
+	value, err := server.Get(testLeaderKey)
+	require.NoError(t, err)
+	assert.Equal(t, "other-replica", value, "the lease of another replica must not be released")
+}
@@ -0,0 +1,171 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+// DeadlineExceededReason is the reason stored on transactions failed by the supervisor.
+const DeadlineExceededReason = "payment status was not resolved before the deadline"
+
+// Supervisor watches transactions handed over to the payment gateway and compensates the ones
+// the gateway lost. A transaction processed for longer than the processing timeout has its live
+// status requested from the gateway; when it is still processed once the status timeout passes,
+// the gateway is told to cancel it and the transaction is failed.
+type Supervisor interface {
+	Run(ctx context.Context)
+	Supervise(ctx context.Context) error
+}
+
+type supervisor struct {
+	cfg                  *Config
+	transactionRepo      repository.TransactionRepo
+	transactionPublisher publisher.TransactionPublisher
+	elector              Elector
+	clock                clock.Clock
+	log                  logger.Logger
+}
+
+// NewSupervisor creates a new instance of Supervisor.
+func NewSupervisor(
+	cfg *Config,
+	transactionRepo repository.TransactionRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	elector Elector,
+	clk clock.Clock,
+	log logger.Logger,
+) (Supervisor, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &supervisor{
+		cfg:                  cfg,
+		transactionRepo:      transactionRepo,
+		transactionPublisher: transactionPublisher,
+		elector:              elector,
+		clock:                clk,
+		log:                  log,
+	}, nil
+}
+
+// Run supervises transactions every check interval while this replica is the leader,
+// until the context is canceled.
+func (s *supervisor) Run(ctx context.Context) {
+	s.elector.Lead(ctx, func(ctx context.Context) {
+		ticker := time.NewTicker(s.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.Supervise(ctx); err != nil {
+					s.log.Error("Failed to supervise transactions", map[string]interface{}{
+						"error": err,
+					})
+				}
+			}
+		}
+	})
+}
+
+// Supervise requests the status of overdue transactions and fails the unresolved ones.
+// Failures of single transactions are logged and retried on the next check.
+func (s *supervisor) Supervise(ctx context.Context) error {
+	now := s.clock.NowUTC()
+
+	overdueIDs, err := s.transactionRepo.GetOverdueTransactions(ctx, now.Add(-s.cfg.ProcessingTimeout), s.cfg.BatchSize)
+	if err != nil {
+		return NewSuperviseError("failed to get overdue transactions", err)
+	}
+
+	for _, transactionID := range overdueIDs {
+		s.requestStatus(ctx, transactionID, now)
+	}
+
+	unresolvedIDs, err := s.transactionRepo.GetUnresolvedTransactions(ctx, now.Add(-s.cfg.StatusTimeout), s.cfg.BatchSize)
+	if err != nil {
+		return NewSuperviseError("failed to get unresolved transactions", err)
+	}
+
+	for _, transactionID := range unresolvedIDs {
+		s.failTransaction(ctx, transactionID)
+	}
+
+	return nil
+}
+
+// requestStatus asks the payment gateway for the live status of the transaction.
+// The request is recorded only once it is published, so a lost publish is repeated.
+func (s *supervisor) requestStatus(ctx context.Context, transactionID string, now time.Time) {
+	s.log.Debug("Request transaction status", map[string]interface{}{
+		"transaction_id": transactionID,
+	})
+
+	if err := s.transactionPublisher.PublishStatusRequestedTransaction(&dto.StatusRequestedTransaction{
+		TransactionID: transactionID,
+	}); err != nil {
+		s.log.Error("Failed to request transaction status", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+
+		return
+	}
+
+	if err := s.transactionRepo.MarkStatusRequested(ctx, transactionID, now); err != nil {
+		s.log.Error("Failed to mark transaction status requested", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+	}
+}
+
+// failTransaction fails the transaction and tells the payment gateway to stop paying it.
+func (s *supervisor) failTransaction(ctx context.Context, transactionID string) {
+	s.log.Debug("Fail unresolved transaction", map[string]interface{}{
+		"transaction_id": transactionID,
+	})
+
+	err := s.transactionRepo.FailTransaction(ctx, transactionID, DeadlineExceededReason)
+
+	switch {
+	case errors.Is(err, repository.ErrTransactionNotProcessed):
+		s.log.Debug("Transaction settled before the deadline", map[string]interface{}{
+			"transaction_id": transactionID,
+		})
+
+		return
+	case err != nil:
+		s.log.Error("Failed to fail transaction", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+
+		return
+	}
+
+	s.log.Warn("Failed transaction unresolved by the payment gateway", map[string]interface{}{
+		"transaction_id": transactionID,
+	})
+
+	if err := s.transactionPublisher.PublishCancelledTransaction(&dto.CancelledTransaction{
+		TransactionID: transactionID,
+	}); err != nil {
+		s.log.Error("Failed to publish cancelled transaction", map[string]interface{}{
+			"error":          err,
+			"transaction_id": transactionID,
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package saga_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/saga"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testBatchSize         = 10
+	testProcessingTimeout = 2 * time.Minute
+	testStatusTimeout     = 30 * time.Second
+)
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+// leaderElector leads right away, like a replica that always holds the lease.
+type leaderElector struct{}
+
+func (leaderElector) Lead(ctx context.Context, run func(ctx context.Context)) {
+	run(ctx)
+}
+
+func supervisorHelper(t *testing.T, checkInterval time.Duration) (
+	saga.Supervisor,
+	*mock_repository.MockTransactionRepo,
+	*mock_publisher.MockTransactionPublisher,
+	*mock_clock.MockClock,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := mock_repository.NewMockTransactionRepo(mockCtrl)
+	pub := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+	clk := mock_clock.NewMockClock(mockCtrl)
+
+	s, err := saga.NewSupervisor(&saga.Config{
+		ProcessingTimeout: testProcessingTimeout,
+		StatusTimeout:     testStatusTimeout,
+		CheckInterval:     checkInterval,
+		BatchSize:         testBatchSize,
+	}, repo, pub, leaderElector{}, clk, l)
+	require.NoError(t, err)
+
+	return s, repo, pub, clk
+}
+
+func TestSupervise(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+	processedBefore := testNow.Add(-testProcessingTimeout)
+	requestedBefore := testNow.Add(-testStatusTimeout)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_repository.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Nothing to supervise",
+			mock: func(repo *mock_repository.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return(nil, nil)
+			},
+		},
+		{
+			name: "Request status of overdue transactions",
+			mock: func(repo *mock_repository.MockTransactionRepo, pub *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return([]string{"first", "second"}, nil)
+				gomock.InOrder(
+					pub.EXPECT().PublishStatusRequestedTransaction(&dto.StatusRequestedTransaction{TransactionID: "first"}).Return(nil),
+					repo.EXPECT().MarkStatusRequested(gomock.Any(), "first", testNow).Return(nil),
+				)
+				gomock.InOrder(
+					pub.EXPECT().PublishStatusRequestedTransaction(&dto.StatusRequestedTransaction{TransactionID: "second"}).Return(nil),
+					repo.EXPECT().MarkStatusRequested(gomock.Any(), "second", testNow).Return(nil),
+				)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return(nil, nil)
+			},
+		},
+		{
+			name: "Unpublished status request is not marked",
+			mock: func(repo *mock_repository.MockTransactionRepo, pub *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return([]string{"first"}, nil)
+				pub.EXPECT().PublishStatusRequestedTransaction(&dto.StatusRequestedTransaction{TransactionID: "first"}).Return(testErr)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return(nil, nil)
+			},
+		},
+		{
+			name: "Fail and cancel unresolved transactions",
+			mock: func(repo *mock_repository.MockTransactionRepo, pub *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return([]string{"first"}, nil)
+				gomock.InOrder(
+					repo.EXPECT().FailTransaction(gomock.Any(), "first", saga.DeadlineExceededReason).Return(nil),
+					pub.EXPECT().PublishCancelledTransaction(&dto.CancelledTransaction{TransactionID: "first"}).Return(nil),
+				)
+			},
+		},
+		{
+			name: "Transaction settled before the deadline is not cancelled",
+			mock: func(repo *mock_repository.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return([]string{"first"}, nil)
+				repo.EXPECT().FailTransaction(gomock.Any(), "first", saga.DeadlineExceededReason).
+					Return(repository.NewFailTransactionError("failed to fail transaction", repository.ErrTransactionNotProcessed))
+			},
+		},
+		{
+			name: "Failed to fail transaction",
+			mock: func(repo *mock_repository.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return([]string{"first"}, nil)
+				repo.EXPECT().FailTransaction(gomock.Any(), "first", saga.DeadlineExceededReason).Return(testErr)
+			},
+		},
+		{
+			name: "Failed to get overdue transactions",
+			mock: func(repo *mock_repository.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return(nil, testErr)
+			},
+			expectedErr: testErr,
+		},
+		{
+			name: "Failed to get unresolved transactions",
+			mock: func(repo *mock_repository.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				repo.EXPECT().GetOverdueTransactions(gomock.Any(), processedBefore, uint64(testBatchSize)).Return(nil, nil)
+				repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), requestedBefore, uint64(testBatchSize)).Return(nil, testErr)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, repo, pub, clk := supervisorHelper(t, time.Minute)
+
+			clk.EXPECT().NowUTC().Return(testNow)
+			testcase.mock(repo, pub)
+
+			err := s.Supervise(context.Background())
+
+			if testcase.expectedErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	s, repo, _, clk := supervisorHelper(t, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	gomock.InOrder(
+		repo.EXPECT().GetOverdueTransactions(gomock.Any(), gomock.Any(), uint64(testBatchSize)).Return(nil, fmt.Errorf("test err")),
+		repo.EXPECT().GetOverdueTransactions(gomock.Any(), gomock.Any(), uint64(testBatchSize)).Return(nil, nil),
+		repo.EXPECT().GetUnresolvedTransactions(gomock.Any(), gomock.Any(), uint64(testBatchSize)).
+			DoAndReturn(func(context.Context, time.Time, uint64) ([]string, error) {
+				cancel()
+
+				return nil, nil
+			}),
+	)
+
+	done := make(chan struct{})
+
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("supervisor did not stop after the context was canceled")
+	}
+}
@@ -0,0 +1,40 @@
+package scantoken
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const defaultTTL = 10 * time.Minute
+
+// Config represents the scan token configuration structure.
+type Config struct {
+	// ServiceURL is embedded into every payload so that a scanner knows where to accept the transaction.
+	ServiceURL string
+	TTL        time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		TTL: defaultTTL,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
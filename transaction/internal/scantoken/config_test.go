@@ -0,0 +1,60 @@
+package scantoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				ServiceURL: "http://localhost:8083/api/v1",
+			},
+			expectedCfg: &Config{
+				ServiceURL: "http://localhost:8083/api/v1",
+				TTL:        defaultTTL,
+			},
+		},
+		{
+			name: "With full config",
+			cfg: &Config{
+				ServiceURL: "http://localhost:8083/api/v1",
+				TTL:        time.Minute,
+			},
+			expectedCfg: &Config{
+				ServiceURL: "http://localhost:8083/api/v1",
+				TTL:        time.Minute,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package scantoken
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidToken is returned for payloads that are malformed, not signed by the service or issued for another transaction.
+	ErrInvalidToken = errors.New("invalid qr payload")
+	// ErrTokenExpired is returned for payloads used after their expiry.
+	ErrTokenExpired = errors.New("qr payload expired")
+	// ErrTokenReplayed is returned when the single-use nonce of the payload was already redeemed.
+	ErrTokenReplayed = errors.New("qr payload already used")
+	// ErrAmountMismatch is returned when the transaction was edited after the payload was issued.
+	ErrAmountMismatch = errors.New("qr payload does not match the transaction")
+)
+
+// IssueScanTokenError represents an error encountered while issuing a scan token.
+type IssueScanTokenError struct {
+	msg string
+	err error
+}
+
+// NewIssueScanTokenError creates a new IssueScanTokenError instance with the provided message and error.
+func NewIssueScanTokenError(msg string, err error) *IssueScanTokenError {
+	return &IssueScanTokenError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e IssueScanTokenError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e IssueScanTokenError) Unwrap() error {
+	return e.err
+}
+
+// RedeemScanTokenError represents an error encountered while redeeming a scan token.
+type RedeemScanTokenError struct {
+	msg string
+	err error
+}
+
+// NewRedeemScanTokenError creates a new RedeemScanTokenError instance with the provided message and error.
+func NewRedeemScanTokenError(msg string, err error) *RedeemScanTokenError {
+	return &RedeemScanTokenError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e RedeemScanTokenError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e RedeemScanTokenError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,157 @@
+package scantoken
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/qr"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+)
+
+//go:generate mockgen -package mocks -destination mocks/issuer_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken Issuer
+
+const nonceBytes = 16
+
+// Issuer issues signed single-use QR payloads and redeems them on accept.
+// A payload redeemed for an accept that failed is restored, so that the payer can retry with it.
+type Issuer interface {
+	Issue(ctx context.Context, transaction *model.Transaction) (string, error)
+	Redeem(ctx context.Context, content string, transaction *model.Transaction) error
+	Restore(ctx context.Context, content string, transaction *model.Transaction) error
+}
+
+type issuer struct {
+	cfg   *Config
+	key   ed25519.PrivateKey
+	store NonceStore
+	clock clock.Clock
+}
+
+// NewIssuer creates a new instance of Issuer signing payloads with key.
+func NewIssuer(
+	cfg *Config,
+	key ed25519.PrivateKey,
+	store NonceStore,
+	clk clock.Clock,
+) (Issuer, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &issuer{
+		cfg:   cfg,
+		key:   key,
+		store: store,
+		clock: clk,
+	}, nil
+}
+
+// Issue signs a payload for the current state of the transaction and registers its nonce.
+func (i *issuer) Issue(ctx context.Context, transaction *model.Transaction) (string, error) {
+	nonce, err := generateNonce()
+	if err != nil {
+		return "", NewIssueScanTokenError("failed to generate nonce", err)
+	}
+
+	payload := &qr.Payload{
+		TransactionID: transaction.ID,
+		Amount:        transaction.Amount,
+		Currency:      transaction.Currency,
+		Method:        transaction.Method,
+		ServiceURL:    i.cfg.ServiceURL,
+		ExpiresAt:     i.clock.NowUTC().Add(i.cfg.TTL),
+		Nonce:         nonce,
+	}
+
+	if err := qr.Sign(payload, i.key); err != nil {
+		return "", NewIssueScanTokenError("failed to sign payload", err)
+	}
+
+	content, err := qr.Encode(payload)
+	if err != nil {
+		return "", NewIssueScanTokenError("failed to encode payload", err)
+	}
+
+	if err := i.store.Save(ctx, nonce, transaction.ID, i.cfg.TTL); err != nil {
+		return "", NewIssueScanTokenError("failed to save nonce", err)
+	}
+
+	return content, nil
+}
+
+// Redeem verifies the payload against the transaction and consumes its nonce.
+// The nonce is consumed last, a payload rejected for any other reason is not burned by the attempt.
+func (i *issuer) Redeem(ctx context.Context, content string, transaction *model.Transaction) error {
+	payload, err := i.verify(content, transaction)
+	if err != nil {
+		return err
+	}
+
+	transactionID, err := i.store.Consume(ctx, payload.Nonce)
+	if err != nil {
+		return NewRedeemScanTokenError("failed to consume nonce", err)
+	}
+
+	if transactionID != transaction.ID {
+		return ErrTokenReplayed
+	}
+
+	return nil
+}
+
+// Restore puts the nonce of a redeemed payload back for the rest of the payload validity.
+// The payload is verified again, only a nonce issued for the transaction can be restored.
+func (i *issuer) Restore(ctx context.Context, content string, transaction *model.Transaction) error {
+	payload, err := i.verify(content, transaction)
+	if err != nil {
+		return err
+	}
+
+	if err := i.store.Save(ctx, payload.Nonce, transaction.ID, payload.ExpiresAt.Sub(i.clock.NowUTC())); err != nil {
+		return NewRedeemScanTokenError("failed to restore nonce", err)
+	}
+
+	return nil
+}
+
+// verify checks the signature and the expiration of the payload and that it was issued for the current state of the transaction.
+func (i *issuer) verify(content string, transaction *model.Transaction) (*qr.Payload, error) {
+	payload, err := qr.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+
+	if err := qr.Verify(payload, i.key.Public().(ed25519.PublicKey)); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err.Error())
+	}
+
+	if payload.Expired(i.clock.NowUTC()) {
+		return nil, ErrTokenExpired
+	}
+
+	if payload.TransactionID != transaction.ID {
+		return nil, fmt.Errorf("%w: issued for another transaction", ErrInvalidToken)
+	}
+
+	if payload.Amount != transaction.Amount ||
+		payload.Currency != transaction.Currency ||
+		payload.Method != transaction.Method {
+		return nil, ErrAmountMismatch
+	}
+
+	return payload, nil
+}
+
+func generateNonce() (string, error) {
+	nonce := make([]byte, nonceBytes)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(nonce), nil
+}
@@ -0,0 +1,308 @@
+package scantoken_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/ShmelJUJ/software-engineering/pkg/qr"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testTTL        = 10 * time.Minute
+	testServiceURL = "http://localhost:8083/api/v1"
+)
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func testTransaction() *model.Transaction {
+	return &model.Transaction{
+		ID:       "test-transaction",
+		Currency: "ALGO",
+		Amount:   100,
+		Method:   "algorand",
+		Status:   model.Created,
+	}
+}
+
+func issuerHelper(t *testing.T, key ed25519.PrivateKey, now time.Time) (scantoken.Issuer, *mocks.MockNonceStore) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(now).AnyTimes()
+
+	store := mocks.NewMockNonceStore(mockCtrl)
+
+	i, err := scantoken.NewIssuer(&scantoken.Config{
+		ServiceURL: testServiceURL,
+		TTL:        testTTL,
+	}, key, store, clk)
+	require.NoError(t, err)
+
+	return i, store
+}
+
+func issueHelper(t *testing.T, key ed25519.PrivateKey) (string, *qr.Payload) {
+	t.Helper()
+
+	i, store := issuerHelper(t, key, testNow)
+	store.EXPECT().Save(gomock.Any(), gomock.Any(), "test-transaction", testTTL).Return(nil)
+
+	content, err := i.Issue(context.Background(), testTransaction())
+	require.NoError(t, err)
+
+	payload, err := qr.Parse(content)
+	require.NoError(t, err)
+
+	return content, payload
+}
+
+func TestIssue(t *testing.T) {
+	t.Parallel()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, payload := issueHelper(t, privateKey)
+
+	assert.Equal(t, "test-transaction", payload.TransactionID)
+	assert.Equal(t, int64(100), payload.Amount)
+	assert.Equal(t, testServiceURL, payload.ServiceURL)
+	assert.Equal(t, testNow.Add(testTTL), payload.ExpiresAt)
+	assert.NotEmpty(t, payload.Nonce)
+	assert.NoError(t, qr.Verify(payload, publicKey))
+
+	_, otherPayload := issueHelper(t, privateKey)
+
+	assert.NotEqual(t, payload.Nonce, otherPayload.Nonce)
+}
+
+func TestIssueSaveNonceFailed(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	i, store := issuerHelper(t, privateKey, testNow)
+	store.EXPECT().Save(gomock.Any(), gomock.Any(), "test-transaction", testTTL).Return(testErr)
+
+	_, err = i.Issue(context.Background(), testTransaction())
+	assert.ErrorIs(t, err, testErr)
+}
+
+func TestRedeem(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testErr := errors.New("test err")
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, foreignKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	content, payload := issueHelper(t, privateKey)
+	foreignContent, _ := issueHelper(t, foreignKey)
+
+	tamperedPayload := *payload
+	tamperedPayload.Amount = 1
+
+	tamperedContent, err := qr.Encode(&tamperedPayload)
+	require.NoError(t, err)
+
+	editedTransaction := testTransaction()
+	editedTransaction.Amount = 200
+
+	otherTransaction := testTransaction()
+	otherTransaction.ID = "other-transaction"
+
+	testcases := []struct {
+		name        string
+		content     string
+		transaction *model.Transaction
+		now         time.Time
+		mock        func(*mocks.MockNonceStore)
+		expectedErr error
+	}{
+		{
+			name:        "Successfully redeem",
+			content:     content,
+			transaction: testTransaction(),
+			now:         testNow.Add(time.Minute),
+			mock: func(ms *mocks.MockNonceStore) {
+				ms.EXPECT().Consume(ctx, payload.Nonce).Return("test-transaction", nil)
+			},
+		},
+		{
+			name:        "Replayed payload",
+			content:     content,
+			transaction: testTransaction(),
+			now:         testNow.Add(time.Minute),
+			mock: func(ms *mocks.MockNonceStore) {
+				ms.EXPECT().Consume(ctx, payload.Nonce).Return("", nil)
+			},
+			expectedErr: scantoken.ErrTokenReplayed,
+		},
+		{
+			name:        "Expired payload",
+			content:     content,
+			transaction: testTransaction(),
+			now:         testNow.Add(testTTL),
+			expectedErr: scantoken.ErrTokenExpired,
+		},
+		{
+			name:        "Transaction edited after issue",
+			content:     content,
+			transaction: editedTransaction,
+			now:         testNow.Add(time.Minute),
+			expectedErr: scantoken.ErrAmountMismatch,
+		},
+		{
+			name:        "Payload of another transaction",
+			content:     content,
+			transaction: otherTransaction,
+			now:         testNow.Add(time.Minute),
+			expectedErr: scantoken.ErrInvalidToken,
+		},
+		{
+			name:        "Tampered amount",
+			content:     tamperedContent,
+			transaction: testTransaction(),
+			now:         testNow.Add(time.Minute),
+			expectedErr: scantoken.ErrInvalidToken,
+		},
+		{
+			name:        "Signed by foreign key",
+			content:     foreignContent,
+			transaction: testTransaction(),
+			now:         testNow.Add(time.Minute),
+			expectedErr: scantoken.ErrInvalidToken,
+		},
+		{
+			name:        "Malformed payload",
+			content:     "test-payload",
+			transaction: testTransaction(),
+			now:         testNow.Add(time.Minute),
+			expectedErr: scantoken.ErrInvalidToken,
+		},
+		{
+			name:        "Failed to consume nonce",
+			content:     content,
+			transaction: testTransaction(),
+			now:         testNow.Add(time.Minute),
+			mock: func(ms *mocks.MockNonceStore) {
+				ms.EXPECT().Consume(ctx, payload.Nonce).Return("", testErr)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			i, store := issuerHelper(t, privateKey, testcase.now)
+			if testcase.mock != nil {
+				testcase.mock(store)
+			}
+
+			err := i.Redeem(ctx, testcase.content, testcase.transaction)
+			if testcase.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testErr := errors.New("test err")
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, foreignKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	content, payload := issueHelper(t, privateKey)
+	foreignContent, _ := issueHelper(t, foreignKey)
+
+	testcases := []struct {
+		name        string
+		content     string
+		now         time.Time
+		mock        func(*mocks.MockNonceStore)
+		expectedErr error
+	}{
+		{
+			name:    "Successfully restore",
+			content: content,
+			now:     testNow.Add(time.Minute),
+			mock: func(ms *mocks.MockNonceStore) {
+				ms.EXPECT().Save(ctx, payload.Nonce, "test-transaction", testTTL-time.Minute).Return(nil)
+			},
+		},
+		{
+			name:        "Expired payload",
+			content:     content,
+			now:         testNow.Add(testTTL),
+			expectedErr: scantoken.ErrTokenExpired,
+		},
+		{
+			name:        "Signed by foreign key",
+			content:     foreignContent,
+			now:         testNow.Add(time.Minute),
+			expectedErr: scantoken.ErrInvalidToken,
+		},
+		{
+			name:    "Failed to save nonce",
+			content: content,
+			now:     testNow.Add(time.Minute),
+			mock: func(ms *mocks.MockNonceStore) {
+				ms.EXPECT().Save(ctx, payload.Nonce, "test-transaction", testTTL-time.Minute).Return(testErr)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			i, store := issuerHelper(t, privateKey, testcase.now)
+			if testcase.mock != nil {
+				testcase.mock(store)
+			}
+
+			err := i.Restore(ctx, testcase.content, testTransaction())
+			if testcase.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+			}
+		})
+	}
+}
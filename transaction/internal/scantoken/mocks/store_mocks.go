@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken (interfaces: NonceStore)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/store_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken NonceStore
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNonceStore is a mock of NonceStore interface.
+type MockNonceStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockNonceStoreMockRecorder
+}
+
+// MockNonceStoreMockRecorder is the mock recorder for MockNonceStore.
+type MockNonceStoreMockRecorder struct {
+	mock *MockNonceStore
+}
+
+// NewMockNonceStore creates a new mock instance.
+func NewMockNonceStore(ctrl *gomock.Controller) *MockNonceStore {
+	mock := &MockNonceStore{ctrl: ctrl}
+	mock.recorder = &MockNonceStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonceStore) EXPECT() *MockNonceStoreMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockNonceStore) Consume(arg0 context.Context, arg1 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockNonceStoreMockRecorder) Consume(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockNonceStore)(nil).Consume), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockNonceStore) Save(arg0 context.Context, arg1, arg2 string, arg3 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockNonceStoreMockRecorder) Save(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockNonceStore)(nil).Save), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,47 @@
+package scantoken
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+//go:generate mockgen -package mocks -destination mocks/store_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken NonceStore
+
+const nonceKeyPrefix = "qr:nonce:"
+
+// NonceStore keeps the nonces of issued payloads until they are redeemed or expire.
+type NonceStore interface {
+	Save(ctx context.Context, nonce, transactionID string, ttl time.Duration) error
+	// Consume atomically removes the nonce and returns the transaction it was issued for,
+	// or an empty string when the nonce is unknown, already consumed or expired.
+	Consume(ctx context.Context, nonce string) (string, error)
+}
+
+type redisNonceStore struct {
+	client *redis.Client
+}
+
+// NewRedisNonceStore creates a NonceStore backed by redis keys with a TTL.
+func NewRedisNonceStore(client *redis.Client) NonceStore {
+	return &redisNonceStore{
+		client: client,
+	}
+}
+
+// Save stores the nonce for the duration of the payload validity.
+func (s *redisNonceStore) Save(ctx context.Context, nonce, transactionID string, ttl time.Duration) error {
+	return s.client.Set(ctx, nonceKeyPrefix+nonce, transactionID, ttl).Err()
+}
+
+// Consume removes the nonce with GETDEL so that concurrent redeems cannot both succeed.
+func (s *redisNonceStore) Consume(ctx context.Context, nonce string) (string, error) {
+	transactionID, err := s.client.GetDel(ctx, nonceKeyPrefix+nonce).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+
+	return transactionID, err
+}
@@ -0,0 +1,38 @@
+package scantoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisNonceStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	server := miniredis.RunT(t)
+	store := NewRedisNonceStore(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+
+	require.NoError(t, store.Save(ctx, "test-nonce", "test-transaction", time.Minute))
+	require.NoError(t, store.Save(ctx, "expired-nonce", "test-transaction", time.Minute))
+
+	transactionID, err := store.Consume(ctx, "test-nonce")
+	require.NoError(t, err)
+	assert.Equal(t, "test-transaction", transactionID)
+
+	transactionID, err = store.Consume(ctx, "test-nonce")
+	require.NoError(t, err)
+	assert.Empty(t, transactionID, "nonce must be single-use")
+
+	server.FastForward(time.Minute)
+
+	transactionID, err = store.Consume(ctx, "expired-nonce")
+	require.NoError(t, err)
+	assert.Empty(t, transactionID)
+}
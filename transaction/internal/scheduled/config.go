@@ -0,0 +1,45 @@
+package scheduled
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultPollInterval = 10 * time.Second
+	defaultBatchSize    = 100
+)
+
+// Config represents the scheduled transaction executor configuration structure.
+type Config struct {
+	// PollInterval is how often scheduled transactions that are due are looked for.
+	PollInterval time.Duration
+	// BatchSize limits how many transactions are executed by a single statement.
+	BatchSize uint64
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		PollInterval: defaultPollInterval,
+		BatchSize:    defaultBatchSize,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
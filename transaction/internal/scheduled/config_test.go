@@ -0,0 +1,60 @@
+package scheduled
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				BatchSize: 10,
+			},
+			expectedCfg: &Config{
+				PollInterval: defaultPollInterval,
+				BatchSize:    10,
+			},
+		},
+		{
+			name: "With full config",
+			cfg: &Config{
+				PollInterval: time.Second,
+				BatchSize:    10,
+			},
+			expectedCfg: &Config{
+				PollInterval: time.Second,
+				BatchSize:    10,
+			},
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
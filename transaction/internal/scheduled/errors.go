@@ -0,0 +1,25 @@
+package scheduled
+
+import "fmt"
+
+// ExecuteError represents an error encountered while executing scheduled transactions.
+type ExecuteError struct {
+	msg string
+	err error
+}
+
+// NewExecuteError creates a new ExecuteError instance with the provided message and error.
+func NewExecuteError(msg string, err error) *ExecuteError {
+	return &ExecuteError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e ExecuteError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e ExecuteError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,128 @@
+package scheduled
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+// Executor hands scheduled transactions over to the payment gateway once their execution time has come.
+// The schedule is kept in the database, so it survives restarts, and what is due is decided by the database clock,
+// so every replica can run an executor: a transaction is moved to processed by exactly one of them.
+// A transaction that fails to be published stays processed until the saga supervisor fails it.
+type Executor interface {
+	Run(ctx context.Context)
+	Execute(ctx context.Context) (int, error)
+}
+
+type executor struct {
+	cfg                  *Config
+	transactionRepo      repository.TransactionRepo
+	transactionPublisher publisher.TransactionPublisher
+	log                  logger.Logger
+}
+
+// NewExecutor creates a new instance of Executor.
+func NewExecutor(
+	cfg *Config,
+	transactionRepo repository.TransactionRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	log logger.Logger,
+) (Executor, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &executor{
+		cfg:                  cfg,
+		transactionRepo:      transactionRepo,
+		transactionPublisher: transactionPublisher,
+		log:                  log,
+	}, nil
+}
+
+// Run executes due transactions every poll interval until the context is canceled.
+func (e *executor) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			executed, err := e.Execute(ctx)
+			if err != nil {
+				e.log.Error("Failed to execute scheduled transactions", map[string]interface{}{
+					"error":    err,
+					"executed": executed,
+				})
+
+				continue
+			}
+
+			if executed > 0 {
+				e.log.Info("Executed scheduled transactions", map[string]interface{}{
+					"executed": executed,
+				})
+			}
+		}
+	}
+}
+
+// Execute processes due transactions in batches until a batch comes back incomplete
+// and returns how many transactions were processed.
+func (e *executor) Execute(ctx context.Context) (int, error) {
+	executed := 0
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return executed, NewExecuteError("execution interrupted", err)
+		}
+
+		transactionIDs, err := e.transactionRepo.ExecuteScheduledTransactions(ctx, e.cfg.BatchSize)
+		if err != nil {
+			return executed, NewExecuteError("failed to execute scheduled transactions", err)
+		}
+
+		executed += len(transactionIDs)
+
+		for _, transactionID := range transactionIDs {
+			if err := e.publish(ctx, transactionID); err != nil {
+				e.log.Error("Failed to publish scheduled transaction", map[string]interface{}{
+					"error":          err,
+					"transaction_id": transactionID,
+				})
+			}
+		}
+
+		e.log.Debug("Executed scheduled transactions batch", map[string]interface{}{
+			"transaction_ids": transactionIDs,
+		})
+
+		if uint64(len(transactionIDs)) < e.cfg.BatchSize {
+			return executed, nil
+		}
+	}
+}
+
+// publish hands the processed transaction over to the payment gateway.
+func (e *executor) publish(ctx context.Context, transactionID string) error {
+	transaction, err := e.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	processedTransaction, err := dto.FromTransactionModel(transaction)
+	if err != nil {
+		return err
+	}
+
+	return e.transactionPublisher.PublishProcessedTransaction(processedTransaction)
+}
@@ -0,0 +1,175 @@
+package scheduled_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scheduled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testBatchSize = 2
+
+func executorHelper(t *testing.T, pollInterval time.Duration) (
+	scheduled.Executor,
+	*mock_repository.MockTransactionRepo,
+	*mock_publisher.MockTransactionPublisher,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo := mock_repository.NewMockTransactionRepo(mockCtrl)
+	publisher := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+
+	e, err := scheduled.NewExecutor(&scheduled.Config{
+		PollInterval: pollInterval,
+		BatchSize:    testBatchSize,
+	}, repo, publisher, l)
+	require.NoError(t, err)
+
+	return e, repo, publisher
+}
+
+func testTransaction(transactionID string) *model.Transaction {
+	senderID := "test-sender"
+
+	return &model.Transaction{
+		ID:       transactionID,
+		SenderID: &senderID,
+		Currency: "ALGO",
+		Amount:   5,
+		Status:   model.Processed,
+		Method:   "algorand",
+		Sender:   &model.TransactionUser{ID: senderID},
+		Receiver: &model.TransactionUser{ID: "test-receiver"},
+	}
+}
+
+func TestExecute(t *testing.T) {
+	t.Parallel()
+
+	testErr := errors.New("test err")
+
+	testcases := []struct {
+		name             string
+		batches          [][]string
+		batchErr         error
+		failedPublish    string
+		expectedExecuted int
+		expectedErr      error
+	}{
+		{
+			name:             "Nothing is due",
+			batches:          [][]string{{}},
+			expectedExecuted: 0,
+		},
+		{
+			name:             "Execute until a batch is incomplete",
+			batches:          [][]string{{"first", "second"}, {"third"}},
+			expectedExecuted: 3,
+		},
+		{
+			name:             "Failed publish does not stop the batch",
+			batches:          [][]string{{"first", "second"}, {}},
+			failedPublish:    "first",
+			expectedExecuted: 2,
+		},
+		{
+			name:             "Failed batch",
+			batches:          [][]string{{"first", "second"}},
+			batchErr:         testErr,
+			expectedExecuted: 2,
+			expectedErr:      testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			e, repo, publisher := executorHelper(t, time.Minute)
+
+			calls := make([]any, 0)
+
+			for _, batch := range testcase.batches {
+				calls = append(calls, repo.EXPECT().ExecuteScheduledTransactions(gomock.Any(), uint64(testBatchSize)).Return(batch, nil))
+
+				for _, transactionID := range batch {
+					transaction := testTransaction(transactionID)
+
+					processedTransaction, err := dto.FromTransactionModel(transaction)
+					require.NoError(t, err)
+
+					var publishErr error
+					if transactionID == testcase.failedPublish {
+						publishErr = testErr
+					}
+
+					calls = append(calls,
+						repo.EXPECT().GetTransaction(gomock.Any(), transactionID).Return(transaction, nil),
+						publisher.EXPECT().PublishProcessedTransaction(processedTransaction).Return(publishErr),
+					)
+				}
+			}
+
+			if testcase.batchErr != nil {
+				calls = append(calls, repo.EXPECT().ExecuteScheduledTransactions(gomock.Any(), uint64(testBatchSize)).Return(nil, testcase.batchErr))
+			}
+
+			gomock.InOrder(calls...)
+
+			executed, err := e.Execute(context.Background())
+
+			assert.Equal(t, testcase.expectedExecuted, executed)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	e, repo, _ := executorHelper(t, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	gomock.InOrder(
+		repo.EXPECT().ExecuteScheduledTransactions(gomock.Any(), uint64(testBatchSize)).Return(nil, errors.New("test err")),
+		repo.EXPECT().ExecuteScheduledTransactions(gomock.Any(), uint64(testBatchSize)).
+			DoAndReturn(func(context.Context, uint64) ([]string, error) {
+				cancel()
+
+				return []string{}, nil
+			}),
+	)
+
+	done := make(chan struct{})
+
+	go func() {
+		e.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("executor did not stop after the context was canceled")
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+//go:generate mockgen -package mocks -destination mocks/fee_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase FeeUsecase
+
+// FeeUsecase defines the interface for managing the fee plans of merchants.
+// Plans apply to the transactions accepted after they are set, accepted transactions keep their fee.
+type FeeUsecase interface {
+	SetFeePlan(ctx context.Context, plan *model.FeePlan) error
+	GetFeePlans(ctx context.Context, merchantID string) ([]*model.FeePlan, error)
+}
+
+type feeUsecase struct {
+	feeRepo repository.FeeRepo
+	log     logger.Logger
+}
+
+// NewFeeUsecase creates a new instance of FeeUsecase.
+func NewFeeUsecase(
+	feeRepo repository.FeeRepo,
+	log logger.Logger,
+) FeeUsecase {
+	return &feeUsecase{
+		feeRepo: feeRepo,
+		log:     log,
+	}
+}
+
+// SetFeePlan replaces the fee plan of the merchant for the plan method and currency.
+func (usecase *feeUsecase) SetFeePlan(ctx context.Context, plan *model.FeePlan) error {
+	usecase.log.Debug("Set fee plan usecase", map[string]interface{}{
+		"merchant_id": plan.MerchantID,
+		"method":      plan.Method,
+		"currency":    plan.Currency,
+	})
+
+	return usecase.feeRepo.SetFeePlan(ctx, plan)
+}
+
+// GetFeePlans retrieves the fee plans of the merchant.
+func (usecase *feeUsecase) GetFeePlans(ctx context.Context, merchantID string) ([]*model.FeePlan, error) {
+	usecase.log.Debug("Get fee plans usecase", map[string]interface{}{
+		"merchant_id": merchantID,
+	})
+
+	return usecase.feeRepo.GetFeePlans(ctx, merchantID)
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+//go:generate mockgen -package mocks -destination mocks/ledger_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase LedgerUsecase
+
+// LedgerUsecase defines the interface for ledger use cases.
+// Users only see the accounts of their own wallets, other accounts are reported as not found.
+// An empty ownerID stands for an administrator, who sees every account including the platform ones.
+type LedgerUsecase interface {
+	GetBalances(ctx context.Context, ownerID, accountID string) (*ledger.Account, []*ledger.Balance, error)
+	GetStatement(ctx context.Context, ownerID, accountID string, limit, offset uint64) ([]*ledger.StatementLine, error)
+}
+
+// ErrLedgerAccountNotFound is returned when the user has no ledger account with the requested id.
+var ErrLedgerAccountNotFound = repository.ErrLedgerAccountNotFound
+
+type ledgerUsecase struct {
+	ledgerRepo repository.LedgerRepo
+	log        logger.Logger
+}
+
+// NewLedgerUsecase creates a new instance of LedgerUsecase.
+func NewLedgerUsecase(
+	ledgerRepo repository.LedgerRepo,
+	log logger.Logger,
+) LedgerUsecase {
+	return &ledgerUsecase{
+		ledgerRepo: ledgerRepo,
+		log:        log,
+	}
+}
+
+// GetBalances retrieves the account with its balances by currency.
+func (usecase *ledgerUsecase) GetBalances(ctx context.Context, ownerID, accountID string) (*ledger.Account, []*ledger.Balance, error) {
+	usecase.log.Debug("Get ledger balances usecase", map[string]interface{}{
+		"owner_id":   ownerID,
+		"account_id": accountID,
+	})
+
+	account, err := usecase.ownedAccount(ctx, ownerID, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balances, err := usecase.ledgerRepo.GetBalances(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, balances, nil
+}
+
+// GetStatement retrieves a page of the postings of the account, newest first.
+func (usecase *ledgerUsecase) GetStatement(
+	ctx context.Context,
+	ownerID, accountID string,
+	limit, offset uint64,
+) ([]*ledger.StatementLine, error) {
+	usecase.log.Debug("Get ledger statement usecase", map[string]interface{}{
+		"owner_id":   ownerID,
+		"account_id": accountID,
+		"limit":      limit,
+		"offset":     offset,
+	})
+
+	if _, err := usecase.ownedAccount(ctx, ownerID, accountID); err != nil {
+		return nil, err
+	}
+
+	return usecase.ledgerRepo.GetStatement(ctx, accountID, limit, offset)
+}
+
+// ownedAccount retrieves the account and checks that it belongs to the owner.
+func (usecase *ledgerUsecase) ownedAccount(ctx context.Context, ownerID, accountID string) (*ledger.Account, error) {
+	account, err := usecase.ledgerRepo.GetAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ownerID != "" && !account.OwnedBy(ownerID) {
+		return nil, ErrLedgerAccountNotFound
+	}
+
+	return account, nil
+}
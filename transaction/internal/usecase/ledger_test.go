@@ -0,0 +1,171 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testLedgerOwnerID  = "test-owner-id"
+	testLedgerWalletID = "test-wallet-id"
+)
+
+func ledgerHelper(t *testing.T) (usecase.LedgerUsecase, *mock_repo.MockLedgerRepo) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	ledgerRepo := mock_repo.NewMockLedgerRepo(mockCtrl)
+
+	return usecase.NewLedgerUsecase(ledgerRepo, l), ledgerRepo
+}
+
+func TestGetLedgerBalances(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	walletAccount := ledger.NewWalletAccount(testLedgerOwnerID, testLedgerWalletID)
+	balances := []*ledger.Balance{{Currency: "ALGO", Debits: 100, Credits: 250}}
+	notFoundErr := repository.NewGetLedgerAccountError("failed to get ledger account", repository.ErrLedgerAccountNotFound)
+
+	testcases := []struct {
+		name             string
+		ownerID          string
+		accountID        string
+		mock             func(*mock_repo.MockLedgerRepo)
+		expectedAccount  *ledger.Account
+		expectedBalances []*ledger.Balance
+		expectedErr      error
+	}{
+		{
+			name:      "Own wallet account",
+			ownerID:   testLedgerOwnerID,
+			accountID: walletAccount.ID,
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, walletAccount.ID).Return(walletAccount, nil)
+				mlr.EXPECT().GetBalances(ctx, walletAccount.ID).Return(balances, nil)
+			},
+			expectedAccount:  walletAccount,
+			expectedBalances: balances,
+		},
+		{
+			name:      "Wallet account of another user",
+			ownerID:   "other-owner-id",
+			accountID: walletAccount.ID,
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, walletAccount.ID).Return(walletAccount, nil)
+			},
+			expectedErr: usecase.ErrLedgerAccountNotFound,
+		},
+		{
+			name:      "Platform account of a user",
+			ownerID:   testLedgerOwnerID,
+			accountID: ledger.FeesAccountID,
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, ledger.FeesAccountID).Return(ledger.Fees(), nil)
+			},
+			expectedErr: usecase.ErrLedgerAccountNotFound,
+		},
+		{
+			name:      "Platform account of an administrator",
+			accountID: ledger.FeesAccountID,
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, ledger.FeesAccountID).Return(ledger.Fees(), nil)
+				mlr.EXPECT().GetBalances(ctx, ledger.FeesAccountID).Return(balances, nil)
+			},
+			expectedAccount:  ledger.Fees(),
+			expectedBalances: balances,
+		},
+		{
+			name:      "Account not found",
+			ownerID:   testLedgerOwnerID,
+			accountID: walletAccount.ID,
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, walletAccount.ID).Return(nil, notFoundErr)
+			},
+			expectedErr: usecase.ErrLedgerAccountNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ledgerUsecase, ledgerRepo := ledgerHelper(t)
+
+			testcase.mock(ledgerRepo)
+
+			actualAccount, actualBalances, err := ledgerUsecase.GetBalances(ctx, testcase.ownerID, testcase.accountID)
+
+			assert.Equal(t, testcase.expectedAccount, actualAccount)
+			assert.Equal(t, testcase.expectedBalances, actualBalances)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestGetLedgerStatement(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	walletAccount := ledger.NewWalletAccount(testLedgerOwnerID, testLedgerWalletID)
+	lines := []*ledger.StatementLine{{EntryID: "test-entry-id", Kind: ledger.HoldEntry, Direction: ledger.Debit, Amount: 100, Currency: "ALGO"}}
+
+	testcases := []struct {
+		name          string
+		ownerID       string
+		mock          func(*mock_repo.MockLedgerRepo)
+		expectedLines []*ledger.StatementLine
+		expectedErr   error
+	}{
+		{
+			name:    "Own wallet account",
+			ownerID: testLedgerOwnerID,
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, walletAccount.ID).Return(walletAccount, nil)
+				mlr.EXPECT().GetStatement(ctx, walletAccount.ID, uint64(20), uint64(40)).Return(lines, nil)
+			},
+			expectedLines: lines,
+		},
+		{
+			name:    "Wallet account of another user",
+			ownerID: "other-owner-id",
+			mock: func(mlr *mock_repo.MockLedgerRepo) {
+				mlr.EXPECT().GetAccount(ctx, walletAccount.ID).Return(walletAccount, nil)
+			},
+			expectedErr: usecase.ErrLedgerAccountNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ledgerUsecase, ledgerRepo := ledgerHelper(t)
+
+			testcase.mock(ledgerRepo)
+
+			actualLines, err := ledgerUsecase.GetStatement(ctx, testcase.ownerID, walletAccount.ID, 20, 40)
+
+			assert.Equal(t, testcase.expectedLines, actualLines)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+//go:generate mockgen -package mocks -destination mocks/mandate_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase MandateUsecase
+
+// MandateUsecase defines the interface for recurring payment mandate use cases.
+type MandateUsecase interface {
+	CreateMandate(ctx context.Context, mandate *model.Mandate) error
+	GetMandate(ctx context.Context, ownerID, mandateID string) (*model.Mandate, error)
+	PauseMandate(ctx context.Context, payerID, mandateID string) error
+	ResumeMandate(ctx context.Context, payerID, mandateID string) error
+	CancelMandate(ctx context.Context, ownerID, mandateID string) error
+}
+
+var (
+	// ErrMandateNotFound is returned when no mandate has the requested id.
+	ErrMandateNotFound = repository.ErrMandateNotFound
+	// ErrMandateNeedsConfirmation is returned when the charges of a mandate would need a payer confirmation,
+	// which nobody is around to give when the scheduler charges the mandate.
+	ErrMandateNeedsConfirmation = errors.New("mandate charges need a payer confirmation")
+	// ErrMandateTransition is returned when the mandate can not be paused, resumed or canceled in its status.
+	ErrMandateTransition = model.ErrMandateTransition
+)
+
+type mandateUsecase struct {
+	mandateRepo repository.MandateRepo
+	confirmer   confirmation.Confirmer
+	clock       clock.Clock
+	log         logger.Logger
+}
+
+// NewMandateUsecase creates a new instance of MandateUsecase.
+func NewMandateUsecase(
+	mandateRepo repository.MandateRepo,
+	confirmer confirmation.Confirmer,
+	clk clock.Clock,
+	log logger.Logger,
+) MandateUsecase {
+	return &mandateUsecase{
+		mandateRepo: mandateRepo,
+		confirmer:   confirmer,
+		clock:       clk,
+		log:         log,
+	}
+}
+
+// CreateMandate creates a new mandate charged by the scheduler from its start date.
+func (usecase *mandateUsecase) CreateMandate(ctx context.Context, mandate *model.Mandate) error {
+	usecase.log.Debug("Create mandate usecase", map[string]interface{}{
+		"mandate": mandate,
+	})
+
+	if usecase.confirmer.Required(mandate.NewCharge(usecase.clock.NowUTC())) {
+		return ErrMandateNeedsConfirmation
+	}
+
+	return usecase.mandateRepo.CreateMandate(ctx, mandate)
+}
+
+// GetMandate retrieves a mandate by its ID if the owner is its payer or receiver, an empty owner sees every mandate.
+func (usecase *mandateUsecase) GetMandate(ctx context.Context, ownerID, mandateID string) (*model.Mandate, error) {
+	usecase.log.Debug("Get mandate usecase", map[string]interface{}{
+		"owner_id":   ownerID,
+		"mandate_id": mandateID,
+	})
+
+	return usecase.ownedMandate(ctx, ownerID, mandateID, (*model.Mandate).OwnedBy)
+}
+
+// PauseMandate stops charging the mandate of the payer until it is resumed.
+func (usecase *mandateUsecase) PauseMandate(ctx context.Context, payerID, mandateID string) error {
+	usecase.log.Debug("Pause mandate usecase", map[string]interface{}{
+		"payer_id":   payerID,
+		"mandate_id": mandateID,
+	})
+
+	if _, err := usecase.ownedMandate(ctx, payerID, mandateID, (*model.Mandate).PaidBy); err != nil {
+		return err
+	}
+
+	return usecase.mandateRepo.PauseMandate(ctx, mandateID, usecase.clock.NowUTC())
+}
+
+// ResumeMandate charges a paused or unpaid mandate of the payer again from its next billing date.
+// Periods missed while the mandate was not charged are not billed.
+func (usecase *mandateUsecase) ResumeMandate(ctx context.Context, payerID, mandateID string) error {
+	usecase.log.Debug("Resume mandate usecase", map[string]interface{}{
+		"payer_id":   payerID,
+		"mandate_id": mandateID,
+	})
+
+	if _, err := usecase.ownedMandate(ctx, payerID, mandateID, (*model.Mandate).PaidBy); err != nil {
+		return err
+	}
+
+	return usecase.mandateRepo.ResumeMandate(ctx, mandateID, usecase.clock.NowUTC())
+}
+
+// CancelMandate stops charging the mandate of the owner, its payer or receiver, for good.
+func (usecase *mandateUsecase) CancelMandate(ctx context.Context, ownerID, mandateID string) error {
+	usecase.log.Debug("Cancel mandate usecase", map[string]interface{}{
+		"owner_id":   ownerID,
+		"mandate_id": mandateID,
+	})
+
+	if _, err := usecase.ownedMandate(ctx, ownerID, mandateID, (*model.Mandate).OwnedBy); err != nil {
+		return err
+	}
+
+	return usecase.mandateRepo.CancelMandate(ctx, mandateID, usecase.clock.NowUTC())
+}
+
+// ownedMandate retrieves the mandate and hides it from users it does not belong to.
+func (usecase *mandateUsecase) ownedMandate(
+	ctx context.Context,
+	userID, mandateID string,
+	belongsTo func(*model.Mandate, string) bool,
+) (*model.Mandate, error) {
+	mandate, err := usecase.mandateRepo.GetMandate(ctx, mandateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID != "" && !belongsTo(mandate, userID) {
+		return nil, ErrMandateNotFound
+	}
+
+	return mandate, nil
+}
@@ -0,0 +1,260 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	mock_confirmation "github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const mandateID = "test-mandate-id"
+
+func mandateHelper(t *testing.T) (
+	*mock_logger.MockLogger,
+	*mock_repo.MockMandateRepo,
+	*mock_confirmation.MockConfirmer,
+	*mock_clock.MockClock,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	mandateRepo := mock_repo.NewMockMandateRepo(mockCtrl)
+	confirmer := mock_confirmation.NewMockConfirmer(mockCtrl)
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	return l, mandateRepo, confirmer, clk
+}
+
+func TestCreateMandate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mandate := &model.Mandate{
+		ID:       mandateID,
+		Currency: "USD",
+		Amount:   999,
+		Method:   "algorand",
+		Payer:    &model.TransactionUser{UserID: "payer-user", WalletID: "payer-wallet"},
+		Receiver: &model.TransactionUser{UserID: "receiver-user", WalletID: "receiver-wallet"},
+	}
+	someErr := repository.NewCreateMandateError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockMandateRepo, *mock_confirmation.MockConfirmer)
+		expectedErr error
+	}{
+		{
+			name: "Successfully create mandate",
+			mock: func(ml *mock_logger.MockLogger, mmr *mock_repo.MockMandateRepo, mc *mock_confirmation.MockConfirmer) {
+				ml.EXPECT().Debug("Create mandate usecase", map[string]interface{}{
+					"mandate": mandate,
+				})
+				mc.EXPECT().Required(gomock.Any()).Return(false).Times(1)
+				mmr.EXPECT().CreateMandate(ctx, mandate).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Charges need a confirmation",
+			mock: func(ml *mock_logger.MockLogger, _ *mock_repo.MockMandateRepo, mc *mock_confirmation.MockConfirmer) {
+				ml.EXPECT().Debug("Create mandate usecase", map[string]interface{}{
+					"mandate": mandate,
+				})
+				mc.EXPECT().Required(gomock.Any()).Return(true).Times(1)
+			},
+			expectedErr: usecase.ErrMandateNeedsConfirmation,
+		},
+		{
+			name: "Failed to create mandate",
+			mock: func(ml *mock_logger.MockLogger, mmr *mock_repo.MockMandateRepo, mc *mock_confirmation.MockConfirmer) {
+				ml.EXPECT().Debug("Create mandate usecase", map[string]interface{}{
+					"mandate": mandate,
+				})
+				mc.EXPECT().Required(gomock.Any()).Return(false).Times(1)
+				mmr.EXPECT().CreateMandate(ctx, mandate).Return(someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, mandateRepo, confirmer, clk := mandateHelper(t)
+			testcase.mock(l, mandateRepo, confirmer)
+
+			mandateUsecase := usecase.NewMandateUsecase(mandateRepo, confirmer, clk, l)
+
+			err := mandateUsecase.CreateMandate(ctx, mandate)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestCancelMandate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mandate := &model.Mandate{
+		ID:       mandateID,
+		Payer:    &model.TransactionUser{UserID: "payer-user"},
+		Receiver: &model.TransactionUser{UserID: "receiver-user"},
+	}
+	notFoundErr := repository.NewUpdateMandateError("test err", repository.ErrMandateNotFound)
+	transitionErr := repository.NewUpdateMandateError("test err", model.ErrMandateTransition)
+
+	testcases := []struct {
+		name        string
+		ownerID     string
+		mock        func(*mock_repo.MockMandateRepo)
+		expectedErr error
+	}{
+		{
+			name:    "Payer cancels mandate",
+			ownerID: "payer-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+				mmr.EXPECT().CancelMandate(ctx, mandateID, testNow).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "Receiver cancels mandate",
+			ownerID: "receiver-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+				mmr.EXPECT().CancelMandate(ctx, mandateID, testNow).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Administrator cancels mandate",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+				mmr.EXPECT().CancelMandate(ctx, mandateID, testNow).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "Mandate of another user",
+			ownerID: "other-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+			},
+			expectedErr: usecase.ErrMandateNotFound,
+		},
+		{
+			name:    "Mandate not found",
+			ownerID: "payer-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(nil, notFoundErr).Times(1)
+			},
+			expectedErr: usecase.ErrMandateNotFound,
+		},
+		{
+			name:    "Mandate already canceled",
+			ownerID: "payer-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+				mmr.EXPECT().CancelMandate(ctx, mandateID, testNow).Return(transitionErr).Times(1)
+			},
+			expectedErr: usecase.ErrMandateTransition,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, mandateRepo, confirmer, clk := mandateHelper(t)
+			l.EXPECT().Debug("Cancel mandate usecase", map[string]interface{}{
+				"owner_id":   testcase.ownerID,
+				"mandate_id": mandateID,
+			})
+			testcase.mock(mandateRepo)
+
+			mandateUsecase := usecase.NewMandateUsecase(mandateRepo, confirmer, clk, l)
+
+			err := mandateUsecase.CancelMandate(ctx, testcase.ownerID, mandateID)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestPauseMandate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mandate := &model.Mandate{
+		ID:       mandateID,
+		Payer:    &model.TransactionUser{UserID: "payer-user"},
+		Receiver: &model.TransactionUser{UserID: "receiver-user"},
+	}
+
+	testcases := []struct {
+		name        string
+		payerID     string
+		mock        func(*mock_repo.MockMandateRepo)
+		expectedErr error
+	}{
+		{
+			name:    "Payer pauses mandate",
+			payerID: "payer-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+				mmr.EXPECT().PauseMandate(ctx, mandateID, testNow).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:    "Receiver can not pause mandate",
+			payerID: "receiver-user",
+			mock: func(mmr *mock_repo.MockMandateRepo) {
+				mmr.EXPECT().GetMandate(ctx, mandateID).Return(mandate, nil).Times(1)
+			},
+			expectedErr: usecase.ErrMandateNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, mandateRepo, confirmer, clk := mandateHelper(t)
+			l.EXPECT().Debug("Pause mandate usecase", map[string]interface{}{
+				"payer_id":   testcase.payerID,
+				"mandate_id": mandateID,
+			})
+			testcase.mock(mandateRepo)
+
+			mandateUsecase := usecase.NewMandateUsecase(mandateRepo, confirmer, clk, l)
+
+			err := mandateUsecase.PauseMandate(ctx, testcase.payerID, mandateID)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/usecase (interfaces: FeeUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/fee_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase FeeUsecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFeeUsecase is a mock of FeeUsecase interface.
+type MockFeeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeUsecaseMockRecorder
+}
+
+// MockFeeUsecaseMockRecorder is the mock recorder for MockFeeUsecase.
+type MockFeeUsecaseMockRecorder struct {
+	mock *MockFeeUsecase
+}
+
+// NewMockFeeUsecase creates a new mock instance.
+func NewMockFeeUsecase(ctrl *gomock.Controller) *MockFeeUsecase {
+	mock := &MockFeeUsecase{ctrl: ctrl}
+	mock.recorder = &MockFeeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeUsecase) EXPECT() *MockFeeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetFeePlans mocks base method.
+func (m *MockFeeUsecase) GetFeePlans(arg0 context.Context, arg1 string) ([]*model.FeePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeePlans", arg0, arg1)
+	ret0, _ := ret[0].([]*model.FeePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeePlans indicates an expected call of GetFeePlans.
+func (mr *MockFeeUsecaseMockRecorder) GetFeePlans(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeePlans", reflect.TypeOf((*MockFeeUsecase)(nil).GetFeePlans), arg0, arg1)
+}
+
+// SetFeePlan mocks base method.
+func (m *MockFeeUsecase) SetFeePlan(arg0 context.Context, arg1 *model.FeePlan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeePlan", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFeePlan indicates an expected call of SetFeePlan.
+func (mr *MockFeeUsecaseMockRecorder) SetFeePlan(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeePlan", reflect.TypeOf((*MockFeeUsecase)(nil).SetFeePlan), arg0, arg1)
+}
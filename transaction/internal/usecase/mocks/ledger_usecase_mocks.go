@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/usecase (interfaces: LedgerUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/ledger_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase LedgerUsecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ledger "github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLedgerUsecase is a mock of LedgerUsecase interface.
+type MockLedgerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerUsecaseMockRecorder
+}
+
+// MockLedgerUsecaseMockRecorder is the mock recorder for MockLedgerUsecase.
+type MockLedgerUsecaseMockRecorder struct {
+	mock *MockLedgerUsecase
+}
+
+// NewMockLedgerUsecase creates a new mock instance.
+func NewMockLedgerUsecase(ctrl *gomock.Controller) *MockLedgerUsecase {
+	mock := &MockLedgerUsecase{ctrl: ctrl}
+	mock.recorder = &MockLedgerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerUsecase) EXPECT() *MockLedgerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetBalances mocks base method.
+func (m *MockLedgerUsecase) GetBalances(arg0 context.Context, arg1, arg2 string) (*ledger.Account, []*ledger.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalances", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ledger.Account)
+	ret1, _ := ret[1].([]*ledger.Balance)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBalances indicates an expected call of GetBalances.
+func (mr *MockLedgerUsecaseMockRecorder) GetBalances(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockLedgerUsecase)(nil).GetBalances), arg0, arg1, arg2)
+}
+
+// GetStatement mocks base method.
+func (m *MockLedgerUsecase) GetStatement(arg0 context.Context, arg1, arg2 string, arg3, arg4 uint64) ([]*ledger.StatementLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]*ledger.StatementLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockLedgerUsecaseMockRecorder) GetStatement(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockLedgerUsecase)(nil).GetStatement), arg0, arg1, arg2, arg3, arg4)
+}
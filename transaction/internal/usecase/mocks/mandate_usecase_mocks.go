@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/usecase (interfaces: MandateUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/mandate_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase MandateUsecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMandateUsecase is a mock of MandateUsecase interface.
+type MockMandateUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMandateUsecaseMockRecorder
+}
+
+// MockMandateUsecaseMockRecorder is the mock recorder for MockMandateUsecase.
+type MockMandateUsecaseMockRecorder struct {
+	mock *MockMandateUsecase
+}
+
+// NewMockMandateUsecase creates a new mock instance.
+func NewMockMandateUsecase(ctrl *gomock.Controller) *MockMandateUsecase {
+	mock := &MockMandateUsecase{ctrl: ctrl}
+	mock.recorder = &MockMandateUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMandateUsecase) EXPECT() *MockMandateUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CancelMandate mocks base method.
+func (m *MockMandateUsecase) CancelMandate(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelMandate indicates an expected call of CancelMandate.
+func (mr *MockMandateUsecaseMockRecorder) CancelMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMandate", reflect.TypeOf((*MockMandateUsecase)(nil).CancelMandate), arg0, arg1, arg2)
+}
+
+// CreateMandate mocks base method.
+func (m *MockMandateUsecase) CreateMandate(arg0 context.Context, arg1 *model.Mandate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMandate", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMandate indicates an expected call of CreateMandate.
+func (mr *MockMandateUsecaseMockRecorder) CreateMandate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMandate", reflect.TypeOf((*MockMandateUsecase)(nil).CreateMandate), arg0, arg1)
+}
+
+// GetMandate mocks base method.
+func (m *MockMandateUsecase) GetMandate(arg0 context.Context, arg1, arg2 string) (*model.Mandate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*model.Mandate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMandate indicates an expected call of GetMandate.
+func (mr *MockMandateUsecaseMockRecorder) GetMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMandate", reflect.TypeOf((*MockMandateUsecase)(nil).GetMandate), arg0, arg1, arg2)
+}
+
+// PauseMandate mocks base method.
+func (m *MockMandateUsecase) PauseMandate(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseMandate indicates an expected call of PauseMandate.
+func (mr *MockMandateUsecaseMockRecorder) PauseMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseMandate", reflect.TypeOf((*MockMandateUsecase)(nil).PauseMandate), arg0, arg1, arg2)
+}
+
+// ResumeMandate mocks base method.
+func (m *MockMandateUsecase) ResumeMandate(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeMandate", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeMandate indicates an expected call of ResumeMandate.
+func (mr *MockMandateUsecaseMockRecorder) ResumeMandate(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeMandate", reflect.TypeOf((*MockMandateUsecase)(nil).ResumeMandate), arg0, arg1, arg2)
+}
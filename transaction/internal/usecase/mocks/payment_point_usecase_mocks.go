@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/usecase (interfaces: PaymentPointUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/payment_point_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase PaymentPointUsecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentPointUsecase is a mock of PaymentPointUsecase interface.
+type MockPaymentPointUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentPointUsecaseMockRecorder
+}
+
+// MockPaymentPointUsecaseMockRecorder is the mock recorder for MockPaymentPointUsecase.
+type MockPaymentPointUsecaseMockRecorder struct {
+	mock *MockPaymentPointUsecase
+}
+
+// NewMockPaymentPointUsecase creates a new mock instance.
+func NewMockPaymentPointUsecase(ctrl *gomock.Controller) *MockPaymentPointUsecase {
+	mock := &MockPaymentPointUsecase{ctrl: ctrl}
+	mock.recorder = &MockPaymentPointUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentPointUsecase) EXPECT() *MockPaymentPointUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreatePaymentPoint mocks base method.
+func (m *MockPaymentPointUsecase) CreatePaymentPoint(arg0 context.Context, arg1 *model.PaymentPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentPoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePaymentPoint indicates an expected call of CreatePaymentPoint.
+func (mr *MockPaymentPointUsecaseMockRecorder) CreatePaymentPoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentPoint", reflect.TypeOf((*MockPaymentPointUsecase)(nil).CreatePaymentPoint), arg0, arg1)
+}
+
+// DisablePaymentPoint mocks base method.
+func (m *MockPaymentPointUsecase) DisablePaymentPoint(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisablePaymentPoint", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisablePaymentPoint indicates an expected call of DisablePaymentPoint.
+func (mr *MockPaymentPointUsecaseMockRecorder) DisablePaymentPoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisablePaymentPoint", reflect.TypeOf((*MockPaymentPointUsecase)(nil).DisablePaymentPoint), arg0, arg1)
+}
+
+// GetPaymentPoint mocks base method.
+func (m *MockPaymentPointUsecase) GetPaymentPoint(arg0 context.Context, arg1 string) (*model.PaymentPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentPoint", arg0, arg1)
+	ret0, _ := ret[0].(*model.PaymentPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentPoint indicates an expected call of GetPaymentPoint.
+func (mr *MockPaymentPointUsecaseMockRecorder) GetPaymentPoint(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentPoint", reflect.TypeOf((*MockPaymentPointUsecase)(nil).GetPaymentPoint), arg0, arg1)
+}
+
+// PayPaymentPoint mocks base method.
+func (m *MockPaymentPointUsecase) PayPaymentPoint(arg0 context.Context, arg1 string, arg2 *model.TransactionUser, arg3 int64, arg4 model.ConfirmationMethod) (*model.Transaction, *model.Confirmation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayPaymentPoint", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*model.Transaction)
+	ret1, _ := ret[1].(*model.Confirmation)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PayPaymentPoint indicates an expected call of PayPaymentPoint.
+func (mr *MockPaymentPointUsecaseMockRecorder) PayPaymentPoint(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayPaymentPoint", reflect.TypeOf((*MockPaymentPointUsecase)(nil).PayPaymentPoint), arg0, arg1, arg2, arg3, arg4)
+}
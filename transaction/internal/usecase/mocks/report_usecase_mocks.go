@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ShmelJUJ/software-engineering/transaction/internal/usecase (interfaces: ReportUsecase)
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -destination mocks/report_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase ReportUsecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	report "github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportUsecase is a mock of ReportUsecase interface.
+type MockReportUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportUsecaseMockRecorder
+}
+
+// MockReportUsecaseMockRecorder is the mock recorder for MockReportUsecase.
+type MockReportUsecaseMockRecorder struct {
+	mock *MockReportUsecase
+}
+
+// NewMockReportUsecase creates a new mock instance.
+func NewMockReportUsecase(ctrl *gomock.Controller) *MockReportUsecase {
+	mock := &MockReportUsecase{ctrl: ctrl}
+	mock.recorder = &MockReportUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportUsecase) EXPECT() *MockReportUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetSettlementReport mocks base method.
+func (m *MockReportUsecase) GetSettlementReport(arg0 context.Context, arg1 time.Time, arg2 string) (*report.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettlementReport", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*report.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettlementReport indicates an expected call of GetSettlementReport.
+func (mr *MockReportUsecaseMockRecorder) GetSettlementReport(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettlementReport", reflect.TypeOf((*MockReportUsecase)(nil).GetSettlementReport), arg0, arg1, arg2)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	model "github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 	gomock "go.uber.org/mock/gomock"
@@ -41,17 +42,18 @@ func (m *MockTransactionUsecase) EXPECT() *MockTransactionUsecaseMockRecorder {
 }
 
 // AcceptTransaction mocks base method.
-func (m *MockTransactionUsecase) AcceptTransaction(arg0 context.Context, arg1 string, arg2 *model.TransactionUser) error {
+func (m *MockTransactionUsecase) AcceptTransaction(arg0 context.Context, arg1 string, arg2 *model.TransactionUser, arg3 model.ConfirmationMethod, arg4 string, arg5 *time.Time) (*model.Confirmation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcceptTransaction", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AcceptTransaction", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*model.Confirmation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AcceptTransaction indicates an expected call of AcceptTransaction.
-func (mr *MockTransactionUsecaseMockRecorder) AcceptTransaction(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockTransactionUsecaseMockRecorder) AcceptTransaction(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).AcceptTransaction), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).AcceptTransaction), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
 // CancelTransaction mocks base method.
@@ -68,6 +70,20 @@ func (mr *MockTransactionUsecaseMockRecorder) CancelTransaction(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).CancelTransaction), arg0, arg1, arg2)
 }
 
+// CaptureTransaction mocks base method.
+func (m *MockTransactionUsecase) CaptureTransaction(arg0 context.Context, arg1 string, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureTransaction", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CaptureTransaction indicates an expected call of CaptureTransaction.
+func (mr *MockTransactionUsecaseMockRecorder) CaptureTransaction(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).CaptureTransaction), arg0, arg1, arg2)
+}
+
 // ChangeTransactionStatus mocks base method.
 func (m *MockTransactionUsecase) ChangeTransactionStatus(arg0 context.Context, arg1 string, arg2 model.TransactionStatus) error {
 	m.ctrl.T.Helper()
@@ -82,6 +98,20 @@ func (mr *MockTransactionUsecaseMockRecorder) ChangeTransactionStatus(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeTransactionStatus", reflect.TypeOf((*MockTransactionUsecase)(nil).ChangeTransactionStatus), arg0, arg1, arg2)
 }
 
+// ConfirmTransaction mocks base method.
+func (m *MockTransactionUsecase) ConfirmTransaction(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTransaction", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmTransaction indicates an expected call of ConfirmTransaction.
+func (mr *MockTransactionUsecaseMockRecorder) ConfirmTransaction(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).ConfirmTransaction), arg0, arg1, arg2, arg3, arg4)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockTransactionUsecase) CreateTransaction(arg0 context.Context, arg1 *model.Transaction) error {
 	m.ctrl.T.Helper()
@@ -111,6 +141,21 @@ func (mr *MockTransactionUsecaseMockRecorder) GetTransaction(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).GetTransaction), arg0, arg1)
 }
 
+// GetTransactionFee mocks base method.
+func (m *MockTransactionUsecase) GetTransactionFee(arg0 context.Context, arg1 string) (*model.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionFee", arg0, arg1)
+	ret0, _ := ret[0].(*model.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionFee indicates an expected call of GetTransactionFee.
+func (mr *MockTransactionUsecaseMockRecorder) GetTransactionFee(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionFee", reflect.TypeOf((*MockTransactionUsecase)(nil).GetTransactionFee), arg0, arg1)
+}
+
 // GetTransactionStatus mocks base method.
 func (m *MockTransactionUsecase) GetTransactionStatus(arg0 context.Context, arg1 string) (model.TransactionStatus, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +171,21 @@ func (mr *MockTransactionUsecaseMockRecorder) GetTransactionStatus(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionStatus", reflect.TypeOf((*MockTransactionUsecase)(nil).GetTransactionStatus), arg0, arg1)
 }
 
+// QuoteTransaction mocks base method.
+func (m *MockTransactionUsecase) QuoteTransaction(arg0 context.Context, arg1, arg2, arg3 string) (*model.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteTransaction", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*model.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteTransaction indicates an expected call of QuoteTransaction.
+func (mr *MockTransactionUsecaseMockRecorder) QuoteTransaction(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).QuoteTransaction), arg0, arg1, arg2, arg3)
+}
+
 // UpdateTransaction mocks base method.
 func (m *MockTransactionUsecase) UpdateTransaction(arg0 context.Context, arg1 *model.Transaction) error {
 	m.ctrl.T.Helper()
@@ -139,3 +199,17 @@ func (mr *MockTransactionUsecaseMockRecorder) UpdateTransaction(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).UpdateTransaction), arg0, arg1)
 }
+
+// VoidTransaction mocks base method.
+func (m *MockTransactionUsecase) VoidTransaction(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidTransaction", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidTransaction indicates an expected call of VoidTransaction.
+func (mr *MockTransactionUsecaseMockRecorder) VoidTransaction(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidTransaction", reflect.TypeOf((*MockTransactionUsecase)(nil).VoidTransaction), arg0, arg1)
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+//go:generate mockgen -package mocks -destination mocks/payment_point_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase PaymentPointUsecase
+
+// PaymentPointUsecase defines the interface for static payment point use cases.
+type PaymentPointUsecase interface {
+	CreatePaymentPoint(ctx context.Context, paymentPoint *model.PaymentPoint) error
+	GetPaymentPoint(ctx context.Context, paymentPointID string) (*model.PaymentPoint, error)
+	DisablePaymentPoint(ctx context.Context, paymentPointID string) error
+	PayPaymentPoint(ctx context.Context, paymentPointID string, sender *model.TransactionUser, amount int64, method model.ConfirmationMethod) (*model.Transaction, *model.Confirmation, error)
+}
+
+var (
+	// ErrPaymentPointNotFound is returned when no payment point has the requested id.
+	ErrPaymentPointNotFound = repository.ErrPaymentPointNotFound
+	// ErrPaymentPointDisabled is returned when a disabled payment point is paid to.
+	ErrPaymentPointDisabled = errors.New("payment point is disabled")
+	// ErrAmountOutOfRange is returned when the payer-entered amount is outside of the payment point bounds.
+	ErrAmountOutOfRange = errors.New("amount is outside of the payment point bounds")
+)
+
+type paymentPointUsecase struct {
+	paymentPointRepo repository.PaymentPointRepo
+	transactions     *transactionUsecase
+	log              logger.Logger
+}
+
+// NewPaymentPointUsecase creates a new instance of PaymentPointUsecase.
+// Payments to a point are accepted the same way as transactions created by the merchant.
+func NewPaymentPointUsecase(
+	paymentPointRepo repository.PaymentPointRepo,
+	transactionRepo repository.TransactionRepo,
+	transactionPublisher publisher.TransactionPublisher,
+	confirmer confirmation.Confirmer,
+	fees fee.Calculator,
+	clk clock.Clock,
+	transactionTTL time.Duration,
+	log logger.Logger,
+) PaymentPointUsecase {
+	return &paymentPointUsecase{
+		paymentPointRepo: paymentPointRepo,
+		transactions: &transactionUsecase{
+			transactionRepo:      transactionRepo,
+			transactionPublisher: transactionPublisher,
+			confirmer:            confirmer,
+			fees:                 fees,
+			clock:                clk,
+			transactionTTL:       transactionTTL,
+			log:                  log,
+		},
+		log: log,
+	}
+}
+
+// CreatePaymentPoint creates a new static payment point.
+func (usecase *paymentPointUsecase) CreatePaymentPoint(ctx context.Context, paymentPoint *model.PaymentPoint) error {
+	usecase.log.Debug("Create payment point usecase", map[string]interface{}{
+		"payment_point": paymentPoint,
+	})
+
+	return usecase.paymentPointRepo.CreatePaymentPoint(ctx, paymentPoint)
+}
+
+// GetPaymentPoint retrieves a payment point by its ID.
+func (usecase *paymentPointUsecase) GetPaymentPoint(ctx context.Context, paymentPointID string) (*model.PaymentPoint, error) {
+	usecase.log.Debug("Get payment point usecase", map[string]interface{}{
+		"payment_point_id": paymentPointID,
+	})
+
+	return usecase.paymentPointRepo.GetPaymentPoint(ctx, paymentPointID)
+}
+
+// DisablePaymentPoint stops the payment point from accepting new payments.
+func (usecase *paymentPointUsecase) DisablePaymentPoint(ctx context.Context, paymentPointID string) error {
+	usecase.log.Debug("Disable payment point usecase", map[string]interface{}{
+		"payment_point_id": paymentPointID,
+	})
+
+	return usecase.paymentPointRepo.DisablePaymentPoint(ctx, paymentPointID)
+}
+
+// PayPaymentPoint creates a transaction for the payer-entered amount and accepts it in one step.
+// Like AcceptTransaction it returns a confirmation when the amount is above the confirmation threshold.
+func (usecase *paymentPointUsecase) PayPaymentPoint(
+	ctx context.Context,
+	paymentPointID string,
+	sender *model.TransactionUser,
+	amount int64,
+	method model.ConfirmationMethod,
+) (*model.Transaction, *model.Confirmation, error) {
+	usecase.log.Debug("Pay payment point usecase", map[string]interface{}{
+		"payment_point_id": paymentPointID,
+		"amount":           amount,
+	})
+
+	paymentPoint, err := usecase.paymentPointRepo.GetPaymentPoint(ctx, paymentPointID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !paymentPoint.Active {
+		return nil, nil, ErrPaymentPointDisabled
+	}
+
+	if !paymentPoint.AllowsAmount(amount) {
+		return nil, nil, ErrAmountOutOfRange
+	}
+
+	transaction := paymentPoint.NewTransaction(amount)
+	usecase.transactions.setExpiration(transaction)
+
+	if err := usecase.paymentPointRepo.CreatePaymentPointTransaction(ctx, paymentPoint.ID, transaction); err != nil {
+		return nil, nil, err
+	}
+
+	pendingConfirmation, err := usecase.transactions.accept(ctx, transaction, sender, method, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transaction, pendingConfirmation, nil
+}
@@ -0,0 +1,344 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	mock_confirmation "github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation/mocks"
+	mock_fee "github.com/ShmelJUJ/software-engineering/transaction/internal/fee/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const paymentPointID = "test-payment-point-id"
+
+func paymentPointHelper(t *testing.T) (
+	*mock_logger.MockLogger,
+	*mock_repo.MockPaymentPointRepo,
+	*mock_repo.MockTransactionRepo,
+	*mock_publisher.MockTransactionPublisher,
+	*mock_confirmation.MockConfirmer,
+	*mock_fee.MockCalculator,
+	*mock_clock.MockClock,
+) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	pointRepo := mock_repo.NewMockPaymentPointRepo(mockCtrl)
+	transactionRepo := mock_repo.NewMockTransactionRepo(mockCtrl)
+	publisher := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+	confirmer := mock_confirmation.NewMockConfirmer(mockCtrl)
+	fees := mock_fee.NewMockCalculator(mockCtrl)
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	return l, pointRepo, transactionRepo, publisher, confirmer, fees, clk
+}
+
+func TestCreatePaymentPoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	paymentPoint := &model.PaymentPoint{
+		ID: paymentPointID,
+	}
+	someErr := repository.NewCreatePaymentPointError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockPaymentPointRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully create payment point",
+			mock: func(ml *mock_logger.MockLogger, mpr *mock_repo.MockPaymentPointRepo) {
+				ml.EXPECT().Debug("Create payment point usecase", map[string]interface{}{
+					"payment_point": paymentPoint,
+				})
+				mpr.EXPECT().CreatePaymentPoint(ctx, paymentPoint).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed to create payment point",
+			mock: func(ml *mock_logger.MockLogger, mpr *mock_repo.MockPaymentPointRepo) {
+				ml.EXPECT().Debug("Create payment point usecase", map[string]interface{}{
+					"payment_point": paymentPoint,
+				})
+				mpr.EXPECT().CreatePaymentPoint(ctx, paymentPoint).Return(someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, pointRepo, transactionRepo, publisher, confirmer, fees, clk := paymentPointHelper(t)
+			testcase.mock(l, pointRepo)
+
+			paymentPointUsecase := usecase.NewPaymentPointUsecase(pointRepo, transactionRepo, publisher, confirmer, fees, clk, transactionTTL, l)
+
+			err := paymentPointUsecase.CreatePaymentPoint(ctx, paymentPoint)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestDisablePaymentPoint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	notFoundErr := repository.NewDisablePaymentPointError("test err", repository.ErrPaymentPointNotFound)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockPaymentPointRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully disable payment point",
+			mock: func(ml *mock_logger.MockLogger, mpr *mock_repo.MockPaymentPointRepo) {
+				ml.EXPECT().Debug("Disable payment point usecase", map[string]interface{}{
+					"payment_point_id": paymentPointID,
+				})
+				mpr.EXPECT().DisablePaymentPoint(ctx, paymentPointID).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Payment point not found",
+			mock: func(ml *mock_logger.MockLogger, mpr *mock_repo.MockPaymentPointRepo) {
+				ml.EXPECT().Debug("Disable payment point usecase", map[string]interface{}{
+					"payment_point_id": paymentPointID,
+				})
+				mpr.EXPECT().DisablePaymentPoint(ctx, paymentPointID).Return(notFoundErr).Times(1)
+			},
+			expectedErr: usecase.ErrPaymentPointNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, pointRepo, transactionRepo, publisher, confirmer, fees, clk := paymentPointHelper(t)
+			testcase.mock(l, pointRepo)
+
+			paymentPointUsecase := usecase.NewPaymentPointUsecase(pointRepo, transactionRepo, publisher, confirmer, fees, clk, transactionTTL, l)
+
+			err := paymentPointUsecase.DisablePaymentPoint(ctx, paymentPointID)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestPayPaymentPoint(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		amount int64
+		method model.ConfirmationMethod
+	}
+
+	ctx := context.Background()
+
+	minAmount, maxAmount := int64(100), int64(1000)
+
+	receiver := &model.TransactionUser{
+		ID:       "test-receiver",
+		UserID:   "test-receiver-user",
+		WalletID: "test-receiver-wallet",
+	}
+	sender := &model.TransactionUser{
+		ID: "test-sender",
+	}
+	activePoint := &model.PaymentPoint{
+		ID:         paymentPointID,
+		ReceiverID: receiver.ID,
+		Currency:   "ALGO",
+		Method:     "algorand",
+		MinAmount:  &minAmount,
+		MaxAmount:  &maxAmount,
+		Active:     true,
+		Receiver:   receiver,
+	}
+	disabledPoint := &model.PaymentPoint{
+		ID:       paymentPointID,
+		Active:   false,
+		Receiver: receiver,
+	}
+	pendingConfirmation := &model.Confirmation{
+		Method: model.CodeConfirmation,
+	}
+
+	// isPayment matches the transaction created for the payer-entered amount.
+	isPayment := func(amount int64) gomock.Matcher {
+		return gomock.Cond(func(x any) bool {
+			transaction, ok := x.(*model.Transaction)
+
+			return ok &&
+				transaction.Amount == amount &&
+				transaction.Status == model.Created &&
+				transaction.Currency == activePoint.Currency &&
+				transaction.Method == activePoint.Method &&
+				transaction.Receiver.UserID == receiver.UserID &&
+				transaction.Receiver.ID != receiver.ID &&
+				transaction.ExpiresAt != nil &&
+				transaction.ExpiresAt.Equal(testNow.Add(transactionTTL))
+		})
+	}
+
+	someErr := repository.NewCreatePaymentPointTransactionError("test err", nil)
+
+	testcases := []struct {
+		name                 string
+		args                 args
+		mock                 func(*mock_repo.MockPaymentPointRepo, *mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher, *mock_confirmation.MockConfirmer, *mock_fee.MockCalculator)
+		expectedAmount       int64
+		expectedConfirmation *model.Confirmation
+		expectedErr          error
+	}{
+		{
+			name: "Successfully pay payment point",
+			args: args{
+				amount: 500,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, mf *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).Return(activePoint, nil).Times(1)
+				mpr.EXPECT().CreatePaymentPointTransaction(ctx, paymentPointID, isPayment(500)).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, isPayment(500)).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(isPayment(500)).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, gomock.Any(), sender, nil, nil).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, gomock.Any()).Return(&model.Transaction{Currency: "ALGO", Amount: 500, Sender: sender, Receiver: receiver}, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(gomock.Any()).Return(nil).Times(1)
+			},
+			expectedAmount: 500,
+		},
+		{
+			name: "Successfully request confirmation",
+			args: args{
+				amount: 1000,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, mf *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).Return(activePoint, nil).Times(1)
+				mpr.EXPECT().CreatePaymentPointTransaction(ctx, paymentPointID, isPayment(1000)).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, isPayment(1000)).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(isPayment(1000)).Return(true).Times(1)
+				mc.EXPECT().Issue(isPayment(1000), sender, model.CodeConfirmation).Return(pendingConfirmation, "123456", nil).Times(1)
+				mtr.EXPECT().RequestConfirmation(ctx, sender, nil, pendingConfirmation, nil).Return(nil).Times(1)
+				mc.EXPECT().Notify(ctx, pendingConfirmation, sender, "123456").Return(nil).Times(1)
+			},
+			expectedAmount:       1000,
+			expectedConfirmation: pendingConfirmation,
+		},
+		{
+			name: "Amount below minimum",
+			args: args{
+				amount: 99,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, _ *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).Return(activePoint, nil).Times(1)
+			},
+			expectedErr: usecase.ErrAmountOutOfRange,
+		},
+		{
+			name: "Amount above maximum",
+			args: args{
+				amount: 1001,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, _ *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).Return(activePoint, nil).Times(1)
+			},
+			expectedErr: usecase.ErrAmountOutOfRange,
+		},
+		{
+			name: "Disabled payment point",
+			args: args{
+				amount: 500,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, _ *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).Return(disabledPoint, nil).Times(1)
+			},
+			expectedErr: usecase.ErrPaymentPointDisabled,
+		},
+		{
+			name: "Payment point not found",
+			args: args{
+				amount: 500,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, _ *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).
+					Return(nil, repository.NewGetPaymentPointError("test err", repository.ErrPaymentPointNotFound)).Times(1)
+			},
+			expectedErr: usecase.ErrPaymentPointNotFound,
+		},
+		{
+			name: "Failed to create transaction",
+			args: args{
+				amount: 500,
+				method: model.CodeConfirmation,
+			},
+			mock: func(mpr *mock_repo.MockPaymentPointRepo, _ *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_fee.MockCalculator) {
+				mpr.EXPECT().GetPaymentPoint(ctx, paymentPointID).Return(activePoint, nil).Times(1)
+				mpr.EXPECT().CreatePaymentPointTransaction(ctx, paymentPointID, isPayment(500)).Return(someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, pointRepo, transactionRepo, publisher, confirmer, fees, clk := paymentPointHelper(t)
+			l.EXPECT().Debug("Pay payment point usecase", map[string]interface{}{
+				"payment_point_id": paymentPointID,
+				"amount":           testcase.args.amount,
+			})
+			testcase.mock(pointRepo, transactionRepo, publisher, confirmer, fees)
+
+			paymentPointUsecase := usecase.NewPaymentPointUsecase(pointRepo, transactionRepo, publisher, confirmer, fees, clk, transactionTTL, l)
+
+			transaction, pendingConfirmation, err := paymentPointUsecase.PayPaymentPoint(
+				ctx,
+				paymentPointID,
+				sender,
+				testcase.args.amount,
+				testcase.args.method,
+			)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedConfirmation, pendingConfirmation)
+
+			if testcase.expectedErr == nil {
+				assert.Equal(t, testcase.expectedAmount, transaction.Amount)
+			}
+		})
+	}
+}
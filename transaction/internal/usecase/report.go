@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+//go:generate mockgen -package mocks -destination mocks/report_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase ReportUsecase
+
+// ReportUsecase defines the interface for settlement report use cases.
+type ReportUsecase interface {
+	GetSettlementReport(ctx context.Context, date time.Time, merchantID string) (*report.Report, error)
+}
+
+type reportUsecase struct {
+	reportRepo repository.ReportRepo
+	clock      clock.Clock
+	log        logger.Logger
+}
+
+// NewReportUsecase creates a new instance of ReportUsecase.
+func NewReportUsecase(
+	reportRepo repository.ReportRepo,
+	clk clock.Clock,
+	log logger.Logger,
+) ReportUsecase {
+	return &reportUsecase{
+		reportRepo: reportRepo,
+		clock:      clk,
+		log:        log,
+	}
+}
+
+// GetSettlementReport builds the settlement report of the UTC day of date from the settle entries booked during it.
+// A non-empty merchantID reports that merchant only.
+func (usecase *reportUsecase) GetSettlementReport(
+	ctx context.Context,
+	date time.Time,
+	merchantID string,
+) (*report.Report, error) {
+	usecase.log.Debug("Get settlement report usecase", map[string]interface{}{
+		"date":        date,
+		"merchant_id": merchantID,
+	})
+
+	from := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	entries, err := usecase.reportRepo.GetSettledEntries(ctx, from, from.AddDate(0, 0, 1), merchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return report.Build(from, usecase.clock.NowUTC(), entries, merchantID), nil
+}
@@ -0,0 +1,129 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/ledger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/report"
+	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testReportMerchantID = "test-merchant-id"
+
+func reportHelper(t *testing.T) (usecase.ReportUsecase, *mock_repo.MockReportRepo) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	reportRepo := mock_repo.NewMockReportRepo(mockCtrl)
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	return usecase.NewReportUsecase(reportRepo, clk, l), reportRepo
+}
+
+func TestGetSettlementReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	date := time.Date(2024, time.May, 1, 17, 30, 0, 0, time.UTC)
+	from := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC)
+
+	entries := []*report.SettledEntry{
+		{
+			ReceiverID: testReportMerchantID,
+			Entry: &ledger.Entry{
+				ID:            "test-entry-id",
+				ReferenceID:   "test-transaction-id",
+				TransactionID: "test-transaction-id",
+				Kind:          ledger.SettleEntry,
+				CreatedAt:     from.Add(time.Hour),
+				Postings: []*ledger.Posting{
+					{Account: ledger.Clearing(), Direction: ledger.Debit, Amount: 1000, Currency: "USD"},
+					{Account: ledger.NewWalletAccount(testReportMerchantID, "test-wallet-id"), Direction: ledger.Credit, Amount: 970, Currency: "USD"},
+					{Account: ledger.Fees(), Direction: ledger.Credit, Amount: 30, Currency: "USD"},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name               string
+		mock               func(*mock_repo.MockReportRepo)
+		expectedStatements []*report.Statement
+		expectedErr        error
+	}{
+		{
+			name: "Successfully get settlement report",
+			mock: func(mrr *mock_repo.MockReportRepo) {
+				mrr.EXPECT().GetSettledEntries(ctx, from, to, testReportMerchantID).Return(entries, nil)
+			},
+			expectedStatements: []*report.Statement{
+				{
+					MerchantID: testReportMerchantID,
+					Currency:   "USD",
+					Count:      1,
+					Gross:      1000,
+					Fees:       30,
+					Net:        970,
+					Lines: []*report.Line{
+						{
+							EntryID:       "test-entry-id",
+							TransactionID: "test-transaction-id",
+							ReferenceID:   "test-transaction-id",
+							Kind:          report.PaymentLine,
+							Amount:        1000,
+							Fee:           30,
+							BookedAt:      from.Add(time.Hour),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Failed to get settled entries",
+			mock: func(mrr *mock_repo.MockReportRepo) {
+				mrr.EXPECT().GetSettledEntries(ctx, from, to, testReportMerchantID).Return(nil, errors.New("test err"))
+			},
+			expectedErr: errors.New("test err"),
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			reportUsecase, reportRepo := reportHelper(t)
+			testcase.mock(reportRepo)
+
+			settlementReport, err := reportUsecase.GetSettlementReport(ctx, date, testReportMerchantID)
+			if testcase.expectedErr != nil {
+				assert.Equal(t, testcase.expectedErr, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, from, settlementReport.Date)
+			assert.Equal(t, testNow, settlementReport.GeneratedAt)
+			assert.Equal(t, testcase.expectedStatements, settlementReport.Statements)
+		})
+	}
+}
@@ -2,12 +2,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
 	"github.com/ShmelJUJ/software-engineering/pkg/logger"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fee"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fx"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken"
 )
 
 //go:generate mockgen -package mocks -destination mocks/transaction_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase TransactionUsecase
@@ -18,26 +25,100 @@ type TransactionUsecase interface {
 	CreateTransaction(ctx context.Context, transaction *model.Transaction) error
 	GetTransactionStatus(ctx context.Context, transactionID string) (model.TransactionStatus, error)
 	CancelTransaction(ctx context.Context, transactionID, reason string) error
-	AcceptTransaction(ctx context.Context, transactionID string, sender *model.TransactionUser) error
+	AcceptTransaction(
+		ctx context.Context,
+		transactionID string,
+		sender *model.TransactionUser,
+		method model.ConfirmationMethod,
+		qrPayload string,
+		executeAt *time.Time,
+	) (*model.Confirmation, error)
+	ConfirmTransaction(ctx context.Context, transactionID, userID, code, signature string) error
 	ChangeTransactionStatus(ctx context.Context, transactionID string, status model.TransactionStatus) error
 	UpdateTransaction(ctx context.Context, updatedTransaction *model.Transaction) error
+	QuoteTransaction(ctx context.Context, transactionID, payerID, currency string) (*model.Quote, error)
+	CaptureTransaction(ctx context.Context, transactionID string, amount int64) error
+	VoidTransaction(ctx context.Context, transactionID string) error
+	GetTransactionFee(ctx context.Context, transactionID string) (*model.Transaction, error)
 }
 
+var (
+	// ErrTransactionNotFound is returned when no transaction has the requested id.
+	ErrTransactionNotFound = repository.ErrTransactionNotFound
+	// ErrConfirmationNotFound is returned when the transaction is not awaiting a payer confirmation.
+	ErrConfirmationNotFound = repository.ErrConfirmationNotFound
+	// ErrNotPayer is returned when the transaction is confirmed by someone other than its payer.
+	ErrNotPayer = errors.New("only the payer can confirm the transaction")
+	// ErrNotSender is returned when the transaction is accepted on behalf of a sender other than the principal.
+	ErrNotSender = errors.New("the transaction can only be accepted by the sender themselves")
+	// ErrNotReceiver is returned when the transaction is managed by someone other than its receiver.
+	ErrNotReceiver = errors.New("only the receiver can manage the transaction")
+	// ErrTransactionNotCancellable is returned when the transaction was already handed over to the payment gateway.
+	ErrTransactionNotCancellable = repository.ErrTransactionNotCancellable
+	// ErrTransactionExpired is returned when the transaction was not accepted before its expiration time.
+	ErrTransactionExpired = errors.New("transaction expired")
+	// ErrTransactionNotCreated is returned when the transaction was already accepted, canceled or expired.
+	ErrTransactionNotCreated = repository.ErrTransactionNotCreated
+	// ErrQuoteExpired is returned when the transaction is accepted after its locked exchange rate expired.
+	ErrQuoteExpired = errors.New("quote expired, request a new one")
+	// ErrQuoteLocked is returned when the exchange rate of the transaction is locked for another payer.
+	ErrQuoteLocked = repository.ErrQuoteLocked
+	// ErrNoShareLeft is returned when every share of a group transaction is already taken by a payer.
+	ErrNoShareLeft = repository.ErrNoShareLeft
+	// ErrPayerHasShare is returned when the payer already took a share of the group transaction.
+	ErrPayerHasShare = repository.ErrPayerHasShare
+	// ErrShareNeedsConfirmation is returned when a share of a group transaction reaches the confirmation threshold,
+	// shares are paid right away.
+	ErrShareNeedsConfirmation = errors.New("group transaction shares must stay below the confirmation threshold")
+	// ErrGroupTransaction is returned when a group transaction is quoted, its shares are paid in the transaction currency.
+	ErrGroupTransaction = errors.New("group transactions are paid in the transaction currency")
+	// ErrScheduleUnsupported is returned when a group transaction or a transaction with a locked quote is accepted
+	// with an execution time, their shares are paid and their rates hold only right away.
+	ErrScheduleUnsupported = errors.New("group transactions and transactions with a locked quote cannot be scheduled")
+	// ErrTransactionNotAuthorized is returned when the transaction has no hold to capture or void,
+	// or its hold timed out or is being voided.
+	ErrTransactionNotAuthorized = repository.ErrTransactionNotAuthorized
+)
+
 type transactionUsecase struct {
 	transactionRepo      repository.TransactionRepo
 	transactionPublisher publisher.TransactionPublisher
+	confirmer            confirmation.Confirmer
+	scanTokens           scantoken.Issuer
+	quoter               fx.Quoter
+	fees                 fee.Calculator
+	clock                clock.Clock
+	transactionTTL       time.Duration
+	groupDeadline        time.Duration
 	log                  logger.Logger
 }
 
 // NewTransactionUsecase creates a new instance of TransactionUsecase.
+// Created transactions expire after transactionTTL unless the request sets its own expiration,
+// a zero transactionTTL keeps them open until they are accepted or canceled.
+// Group transactions use groupDeadline instead, their paid shares are refunded once it passes.
 func NewTransactionUsecase(
 	transactionRepo repository.TransactionRepo,
 	transactionPublisher publisher.TransactionPublisher,
+	confirmer confirmation.Confirmer,
+	scanTokens scantoken.Issuer,
+	quoter fx.Quoter,
+	fees fee.Calculator,
+	clk clock.Clock,
+	transactionTTL time.Duration,
+	groupDeadline time.Duration,
 	log logger.Logger,
 ) TransactionUsecase {
 	return &transactionUsecase{
 		transactionRepo:      transactionRepo,
 		transactionPublisher: transactionPublisher,
+		confirmer:            confirmer,
+		scanTokens:           scanTokens,
+		quoter:               quoter,
+		fees:                 fees,
+		clock:                clk,
+		transactionTTL:       transactionTTL,
+		groupDeadline:        groupDeadline,
 		log:                  log,
 	}
 }
@@ -52,14 +133,62 @@ func (usecase *transactionUsecase) GetTransaction(ctx context.Context, transacti
 }
 
 // CreateTransaction creates a new transaction.
+// A watch transaction is handed over to the payment gateway at its creation.
 func (usecase *transactionUsecase) CreateTransaction(ctx context.Context, transaction *model.Transaction) error {
 	usecase.log.Debug("Create transaction usecase", map[string]interface{}{
 		"transaction": transaction,
 	})
 
+	for _, share := range transaction.Shares {
+		shareTransaction := *transaction
+		shareTransaction.Amount = share.Amount
+
+		if usecase.confirmer.Required(&shareTransaction) {
+			return ErrShareNeedsConfirmation
+		}
+	}
+
+	usecase.setExpiration(transaction)
+
+	if transaction.Watch {
+		return usecase.createWatchTransaction(ctx, transaction)
+	}
+
 	return usecase.transactionRepo.CreateTransaction(ctx, transaction)
 }
 
+// createWatchTransaction creates a transaction paid from a wallet outside of the platform.
+// Nobody accepts it, so it is processed right away and the payment gateway watches the receiver until it expires.
+func (usecase *transactionUsecase) createWatchTransaction(ctx context.Context, transaction *model.Transaction) error {
+	if err := transaction.ValidateWatch(); err != nil {
+		return err
+	}
+
+	transaction.Status = model.Processed
+
+	if err := usecase.transactionRepo.CreateTransaction(ctx, transaction); err != nil {
+		return err
+	}
+
+	return usecase.publishTransaction(transaction)
+}
+
+// setExpiration applies the default expiration to a transaction created without its own,
+// the group deadline for group transactions.
+func (usecase *transactionUsecase) setExpiration(transaction *model.Transaction) {
+	ttl := usecase.transactionTTL
+	if transaction.Group() {
+		ttl = usecase.groupDeadline
+	}
+
+	if transaction.ExpiresAt != nil || ttl == 0 {
+		return
+	}
+
+	expiresAt := usecase.clock.NowUTC().Add(ttl)
+	transaction.ExpiresAt = &expiresAt
+}
+
 // GetTransactionStatus retrieves the status of a transaction by its ID.
 func (usecase *transactionUsecase) GetTransactionStatus(ctx context.Context, transactionID string) (model.TransactionStatus, error) {
 	usecase.log.Debug("Get transaction status usecase", map[string]interface{}{
@@ -69,23 +198,346 @@ func (usecase *transactionUsecase) GetTransactionStatus(ctx context.Context, tra
 	return usecase.transactionRepo.GetTransactionStatus(ctx, transactionID)
 }
 
-// CancelTransaction cancels a transaction with a specified reason.
+// CancelTransaction cancels a transaction with a specified reason, as long as it was not handed over to the payment gateway.
+// An authorized transaction is voided instead, so that its hold is released back to the payer.
 func (usecase *transactionUsecase) CancelTransaction(ctx context.Context, transactionID, reason string) error {
 	usecase.log.Debug("Cancel transaction usecase", map[string]interface{}{
 		"transaction_id": transactionID,
 		"reason":         reason,
 	})
 
-	return usecase.transactionRepo.CancelTransaction(ctx, transactionID, reason)
+	status, err := usecase.transactionRepo.GetTransactionStatus(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if status == model.Authorized {
+		return usecase.requestVoid(ctx, transactionID)
+	}
+
+	return usecase.transactionRepo.CancelPendingTransaction(ctx, transactionID, reason)
 }
 
 // AcceptTransaction accepts a transaction initiated by a sender.
-func (usecase *transactionUsecase) AcceptTransaction(ctx context.Context, transactionID string, sender *model.TransactionUser) error {
+// The qrPayload is the signed payload scanned by the sender, it is redeemed only once.
+// A transaction with a locked quote is paid in the quote currency and must be accepted by the payer who requested
+// the quote before it expires, the rate then holds through the payer confirmation.
+// Transactions above the confirmation threshold are not processed right away,
+// the returned confirmation must be completed with ConfirmTransaction first.
+// A payer of a group transaction takes the next pending share, which is paid right away.
+// A transaction accepted with a future executeAt is scheduled and handed over to the payment gateway
+// by the scheduled transaction executor at that time, until then it can be canceled.
+func (usecase *transactionUsecase) AcceptTransaction(
+	ctx context.Context,
+	transactionID string,
+	sender *model.TransactionUser,
+	method model.ConfirmationMethod,
+	qrPayload string,
+	executeAt *time.Time,
+) (*model.Confirmation, error) {
 	usecase.log.Debug("Accept transaction usecase", map[string]interface{}{
 		"transaction_id": transactionID,
 	})
 
-	if err := usecase.transactionRepo.AcceptTransaction(ctx, transactionID, sender); err != nil {
+	transaction, err := usecase.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := usecase.clock.NowUTC()
+
+	if transaction.Expired(now) {
+		return nil, ErrTransactionExpired
+	}
+
+	if transaction.Quote != nil && transaction.Quote.Expired(now) {
+		return nil, ErrQuoteExpired
+	}
+
+	if transaction.Quote != nil && (sender == nil || transaction.Quote.PayerID != sender.UserID) {
+		return nil, ErrQuoteLocked
+	}
+
+	if executeAt != nil && !executeAt.After(now) {
+		executeAt = nil
+	}
+
+	if executeAt != nil {
+		if transaction.Group() || transaction.Quote != nil {
+			return nil, ErrScheduleUnsupported
+		}
+
+		executeAtUTC := executeAt.UTC()
+		executeAt = &executeAtUTC
+	}
+
+	if err := usecase.scanTokens.Redeem(ctx, qrPayload, transaction); err != nil {
+		return nil, err
+	}
+
+	var pendingConfirmation *model.Confirmation
+
+	if transaction.Group() {
+		err = usecase.acceptShare(ctx, transaction, sender)
+	} else {
+		pendingConfirmation, err = usecase.accept(ctx, transaction, sender, method, executeAt)
+	}
+
+	if err != nil {
+		// The scanned payload is not burned by a failed accept, the payer can retry with it.
+		if restoreErr := usecase.scanTokens.Restore(ctx, qrPayload, transaction); restoreErr != nil {
+			usecase.log.Error("Failed to restore scan token", map[string]interface{}{
+				"transaction_id": transaction.ID,
+				"error":          restoreErr,
+			})
+		}
+
+		return nil, err
+	}
+
+	return pendingConfirmation, nil
+}
+
+// acceptShare gives the payer the next pending share of a group transaction and hands its payment over to the payment gateway.
+func (usecase *transactionUsecase) acceptShare(ctx context.Context, transaction *model.Transaction, payer *model.TransactionUser) error {
+	share, err := usecase.transactionRepo.ClaimShare(ctx, transaction.ID, payer)
+	if err != nil {
+		return err
+	}
+
+	processedShare, err := dto.FromTransactionShare(transaction, share)
+	if err != nil {
+		return err
+	}
+
+	return usecase.transactionPublisher.PublishProcessedTransaction(processedShare)
+}
+
+// accept processes a created transaction right away, or schedules it when executeAt is set,
+// or requests the payer confirmation for it. The fee of the receiver plan is fixed at this point.
+func (usecase *transactionUsecase) accept(
+	ctx context.Context,
+	transaction *model.Transaction,
+	sender *model.TransactionUser,
+	method model.ConfirmationMethod,
+	executeAt *time.Time,
+) (*model.Confirmation, error) {
+	transactionFee, err := usecase.fees.Calculate(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if !usecase.confirmer.Required(transaction) {
+		if err := usecase.transactionRepo.AcceptTransaction(ctx, transaction.ID, sender, transactionFee, executeAt); err != nil {
+			return nil, err
+		}
+
+		if executeAt != nil {
+			return nil, nil
+		}
+
+		return nil, usecase.publishProcessedTransaction(ctx, transaction.ID)
+	}
+
+	pendingConfirmation, code, err := usecase.confirmer.Issue(transaction, sender, method)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecase.transactionRepo.RequestConfirmation(ctx, sender, transactionFee, pendingConfirmation, executeAt); err != nil {
+		return nil, err
+	}
+
+	if pendingConfirmation.Method == model.CodeConfirmation {
+		if err := usecase.confirmer.Notify(ctx, pendingConfirmation, sender, code); err != nil {
+			// The payer never received the code, the transaction is created again so that it can be accepted anew.
+			if resetErr := usecase.transactionRepo.ResetConfirmation(ctx, transaction.ID); resetErr != nil {
+				usecase.log.Error("Failed to reset confirmation", map[string]interface{}{
+					"transaction_id": transaction.ID,
+					"error":          resetErr,
+				})
+			}
+
+			return nil, err
+		}
+	}
+
+	return pendingConfirmation, nil
+}
+
+// GetTransactionFee calculates the fee a created transaction would be accepted with now,
+// the returned transaction carries it for the payer to preview what the receivers get.
+func (usecase *transactionUsecase) GetTransactionFee(ctx context.Context, transactionID string) (*model.Transaction, error) {
+	usecase.log.Debug("Get transaction fee usecase", map[string]interface{}{
+		"transaction_id": transactionID,
+	})
+
+	transaction, err := usecase.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.Status != model.Created {
+		return nil, ErrTransactionNotCreated
+	}
+
+	if transaction.Expired(usecase.clock.NowUTC()) {
+		return nil, ErrTransactionExpired
+	}
+
+	transactionFee, err := usecase.fees.Calculate(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if transactionFee != nil {
+		transaction.Fee = transactionFee.Amount
+	}
+
+	return transaction, nil
+}
+
+// ConfirmTransaction completes the payer confirmation and processes or schedules the transaction.
+// A confirmation that expired or ran out of attempts is dropped and the transaction can be accepted again.
+func (usecase *transactionUsecase) ConfirmTransaction(ctx context.Context, transactionID, userID, code, signature string) error {
+	usecase.log.Debug("Confirm transaction usecase", map[string]interface{}{
+		"transaction_id": transactionID,
+	})
+
+	transaction, err := usecase.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	if transaction.Status != model.AwaitingConfirmation || transaction.Sender == nil {
+		return ErrConfirmationNotFound
+	}
+
+	if transaction.Sender.UserID != userID {
+		return ErrNotPayer
+	}
+
+	pendingConfirmation, err := usecase.transactionRepo.GetConfirmation(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		invalidErr *confirmation.InvalidConfirmationError
+		expiredErr *confirmation.ConfirmationExpiredError
+	)
+
+	err = usecase.confirmer.Verify(ctx, pendingConfirmation, transaction, code, signature)
+
+	switch {
+	case errors.As(err, &invalidErr):
+		if invalidErr.RemainingAttempts() > 0 {
+			if err := usecase.transactionRepo.IncrementConfirmationAttempts(ctx, transactionID); err != nil {
+				return err
+			}
+
+			return invalidErr
+		}
+
+		if err := usecase.transactionRepo.ResetConfirmation(ctx, transactionID); err != nil {
+			return err
+		}
+
+		return confirmation.NewConfirmationExpiredError("confirmation attempts exhausted")
+	case errors.As(err, &expiredErr):
+		if err := usecase.transactionRepo.ResetConfirmation(ctx, transactionID); err != nil {
+			return err
+		}
+
+		return expiredErr
+	case err != nil:
+		return err
+	}
+
+	if err := usecase.transactionRepo.ConfirmTransaction(ctx, transactionID); err != nil {
+		return err
+	}
+
+	// A transaction accepted with an execution time waits for the scheduled transaction executor.
+	if transaction.ExecuteAt != nil {
+		return nil
+	}
+
+	transaction.Status = model.Processed
+
+	return usecase.publishTransaction(transaction)
+}
+
+func (usecase *transactionUsecase) publishProcessedTransaction(ctx context.Context, transactionID string) error {
+	transaction, err := usecase.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	return usecase.publishTransaction(transaction)
+}
+
+// publishTransaction hands the processed transaction over to the payment gateway.
+func (usecase *transactionUsecase) publishTransaction(transaction *model.Transaction) error {
+	processedTransaction, err := dto.FromTransactionModel(transaction)
+	if err != nil {
+		return err
+	}
+
+	return usecase.transactionPublisher.PublishProcessedTransaction(processedTransaction)
+}
+
+// CaptureTransaction takes amount out of the hold of an authorized transaction, the whole hold when amount is zero.
+// The rest of a partial capture is released back to the payer by the payment gateway.
+func (usecase *transactionUsecase) CaptureTransaction(ctx context.Context, transactionID string, amount int64) error {
+	usecase.log.Debug("Capture transaction usecase", map[string]interface{}{
+		"transaction_id": transactionID,
+		"amount":         amount,
+	})
+
+	transaction, err := usecase.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return err
+	}
+
+	now := usecase.clock.NowUTC()
+
+	if transaction.Status != model.Authorized || transaction.HoldExpired(now) || transaction.VoidRequestedAt != nil {
+		return ErrTransactionNotAuthorized
+	}
+
+	capturedAmount, err := transaction.CaptureAmount(amount)
+	if err != nil {
+		return err
+	}
+
+	if err := usecase.transactionRepo.CaptureTransaction(ctx, transactionID, capturedAmount, now); err != nil {
+		return err
+	}
+
+	transaction.Status = model.Processed
+	transaction.CapturedAmount = &capturedAmount
+
+	capture, err := dto.FromCaptureRequest(transaction)
+	if err != nil {
+		return err
+	}
+
+	return usecase.transactionPublisher.PublishCaptureRequestedTransaction(capture)
+}
+
+// VoidTransaction releases the hold of an authorized transaction back to the payer.
+// The transaction is voided once the payment gateway reports the hold released, it can no longer be captured meanwhile.
+func (usecase *transactionUsecase) VoidTransaction(ctx context.Context, transactionID string) error {
+	usecase.log.Debug("Void transaction usecase", map[string]interface{}{
+		"transaction_id": transactionID,
+	})
+
+	return usecase.requestVoid(ctx, transactionID)
+}
+
+func (usecase *transactionUsecase) requestVoid(ctx context.Context, transactionID string) error {
+	if err := usecase.transactionRepo.RequestVoid(ctx, transactionID, usecase.clock.NowUTC()); err != nil {
 		return err
 	}
 
@@ -94,15 +546,47 @@ func (usecase *transactionUsecase) AcceptTransaction(ctx context.Context, transa
 		return err
 	}
 
-	return usecase.transactionPublisher.PublishProcessedTransaction(dto.FromTransactionModel(transaction))
+	void, err := dto.FromVoidRequest(transaction)
+	if err != nil {
+		return err
+	}
+
+	return usecase.transactionPublisher.PublishVoidRequestedTransaction(void)
 }
 
-// UpdateTransaction updates an existing transaction.
+// UpdateTransaction updates an existing transaction, as long as it is created.
+// A new amount of a split transaction must still leave every leg a positive part,
+// the shares of a group transaction must still add up to it.
 func (usecase *transactionUsecase) UpdateTransaction(ctx context.Context, updatedTransaction *model.Transaction) error {
 	usecase.log.Debug("Update transaction usecase", map[string]interface{}{
 		"updated_transaction": updatedTransaction,
 	})
 
+	if updatedTransaction.Amount != 0 {
+		transaction, err := usecase.transactionRepo.GetTransaction(ctx, updatedTransaction.ID)
+		if err != nil {
+			return err
+		}
+
+		if transaction.Status != model.Created {
+			return ErrTransactionNotCreated
+		}
+
+		transaction.Amount = updatedTransaction.Amount
+
+		if len(transaction.Legs) != 0 {
+			if _, err := transaction.LegAmounts(); err != nil {
+				return err
+			}
+		}
+
+		if transaction.Group() {
+			if err := transaction.ValidateShares(); err != nil {
+				return err
+			}
+		}
+	}
+
 	return usecase.transactionRepo.UpdateTransaction(ctx, updatedTransaction)
 }
 
@@ -114,3 +598,51 @@ func (usecase *transactionUsecase) ChangeTransactionStatus(ctx context.Context,
 
 	return usecase.transactionRepo.ChangeTransactionStatus(ctx, transactionID, status)
 }
+
+// QuoteTransaction locks an exchange rate for the payer paying a created transaction in another currency.
+// The quote replaces the previous one unless it is still locked for another payer,
+// the payment gateway is sent the converted amount.
+func (usecase *transactionUsecase) QuoteTransaction(ctx context.Context, transactionID, payerID, currency string) (*model.Quote, error) {
+	usecase.log.Debug("Quote transaction usecase", map[string]interface{}{
+		"transaction_id": transactionID,
+		"payer_id":       payerID,
+		"currency":       currency,
+	})
+
+	transaction, err := usecase.transactionRepo.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.Status != model.Created {
+		return nil, ErrTransactionNotCreated
+	}
+
+	if transaction.Group() {
+		return nil, ErrGroupTransaction
+	}
+
+	if transaction.Expired(usecase.clock.NowUTC()) {
+		return nil, ErrTransactionExpired
+	}
+
+	quote, err := usecase.quoter.Quote(ctx, transaction, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	quote.PayerID = payerID
+	transaction.Quote = quote
+
+	if len(transaction.Legs) != 0 {
+		if _, err := transaction.PaymentLegs(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := usecase.transactionRepo.LockQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	return quote, nil
+}
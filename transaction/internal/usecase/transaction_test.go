@@ -2,25 +2,49 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
 	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/dto"
 	mock_publisher "github.com/ShmelJUJ/software-engineering/transaction/internal/broker/publisher/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation"
+	mock_confirmation "github.com/ShmelJUJ/software-engineering/transaction/internal/confirmation/mocks"
+	mock_fee "github.com/ShmelJUJ/software-engineering/transaction/internal/fee/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/fx"
+	mock_fx "github.com/ShmelJUJ/software-engineering/transaction/internal/fx/mocks"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
 	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken"
+	mock_scantoken "github.com/ShmelJUJ/software-engineering/transaction/internal/scantoken/mocks"
 	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
 const (
-	transactionID = "test-id"
-	reason        = "test-reason"
+	transactionID  = "test-id"
+	reason         = "test-reason"
+	transactionTTL = time.Hour
+	groupDeadline  = 4 * time.Hour
 )
 
-func transactionHelper(t *testing.T) (*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher) {
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func transactionHelper(t *testing.T) (
+	*mock_logger.MockLogger,
+	*mock_repo.MockTransactionRepo,
+	*mock_publisher.MockTransactionPublisher,
+	*mock_confirmation.MockConfirmer,
+	*mock_scantoken.MockIssuer,
+	*mock_fx.MockQuoter,
+	*mock_fee.MockCalculator,
+	*mock_clock.MockClock,
+) {
 	t.Helper()
 
 	mockCtrl := gomock.NewController(t)
@@ -29,8 +53,24 @@ func transactionHelper(t *testing.T) (*mock_logger.MockLogger, *mock_repo.MockTr
 	l := mock_logger.NewMockLogger(mockCtrl)
 	repo := mock_repo.NewMockTransactionRepo(mockCtrl)
 	publisher := mock_publisher.NewMockTransactionPublisher(mockCtrl)
+	confirmer := mock_confirmation.NewMockConfirmer(mockCtrl)
+	scanTokens := mock_scantoken.NewMockIssuer(mockCtrl)
+	quoter := mock_fx.NewMockQuoter(mockCtrl)
+	fees := mock_fee.NewMockCalculator(mockCtrl)
 
-	return l, repo, publisher
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	return l, repo, publisher, confirmer, scanTokens, quoter, fees, clk
+}
+
+func processedTransactionDTO(t *testing.T, transaction *model.Transaction) *dto.ProcessedTransaction {
+	t.Helper()
+
+	processedTransaction, err := dto.FromTransactionModel(transaction)
+	require.NoError(t, err)
+
+	return processedTransaction
 }
 
 func TestGetTransaction(t *testing.T) {
@@ -93,10 +133,10 @@ func TestGetTransaction(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			l, repo, publisher := transactionHelper(t)
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
 			testcase.mock(l, repo)
 
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
 
 			actualTransaction, err := transactionUsecase.GetTransaction(
 				testcase.args.ctx,
@@ -112,51 +152,60 @@ func TestCreateTransaction(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx         context.Context
-		transaction *model.Transaction
+		ctx       context.Context
+		expiresAt *time.Time
 	}
 
 	ctx := context.Background()
 
-	transaction := &model.Transaction{
-		ID: transactionID,
-	}
+	requestedExpiresAt := testNow.Add(5 * time.Minute)
+	defaultExpiresAt := testNow.Add(transactionTTL)
 
 	someErr := repository.NewGetTransactionError("test err", nil)
 
 	testcases := []struct {
-		name        string
-		args        args
-		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
-		expectedErr error
+		name              string
+		args              args
+		mock              func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
+		expectedExpiresAt *time.Time
+		expectedErr       error
 	}{
 		{
-			name: "Successfully create transaction",
+			name: "Successfully create transaction with default expiration",
 			args: args{
-				ctx:         ctx,
-				transaction: transaction,
+				ctx: ctx,
 			},
 			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
-				ml.EXPECT().Debug("Create transaction usecase", map[string]interface{}{
-					"transaction": transaction,
-				})
-				mtr.EXPECT().CreateTransaction(ctx, transaction).Return(nil).Times(1)
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mtr.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(nil).Times(1)
 			},
-			expectedErr: nil,
+			expectedExpiresAt: &defaultExpiresAt,
+			expectedErr:       nil,
+		},
+		{
+			name: "Successfully create transaction with requested expiration",
+			args: args{
+				ctx:       ctx,
+				expiresAt: &requestedExpiresAt,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mtr.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedExpiresAt: &requestedExpiresAt,
+			expectedErr:       nil,
 		},
 		{
 			name: "Failed to create transaction",
 			args: args{
-				ctx:         ctx,
-				transaction: transaction,
+				ctx: ctx,
 			},
 			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
-				ml.EXPECT().Debug("Create transaction usecase", map[string]interface{}{
-					"transaction": transaction,
-				})
-				mtr.EXPECT().CreateTransaction(ctx, transaction).Return(someErr).Times(1)
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mtr.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(someErr).Times(1)
 			},
-			expectedErr: someErr,
+			expectedExpiresAt: &defaultExpiresAt,
+			expectedErr:       someErr,
 		},
 	}
 
@@ -166,16 +215,104 @@ func TestCreateTransaction(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			l, repo, publisher := transactionHelper(t)
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
 			testcase.mock(l, repo)
 
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			transaction := &model.Transaction{
+				ID:        transactionID,
+				ExpiresAt: testcase.args.expiresAt,
+			}
 
 			err := transactionUsecase.CreateTransaction(
 				testcase.args.ctx,
-				testcase.args.transaction,
+				transaction,
 			)
 			assert.Equal(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedExpiresAt, transaction.ExpiresAt)
+		})
+	}
+}
+
+func TestCreateWatchTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	defaultExpiresAt := testNow.Add(transactionTTL)
+
+	someErr := repository.NewCreateTransactionError("test err", nil)
+
+	watchTransaction := func(currency string) *model.Transaction {
+		return &model.Transaction{
+			ID:       transactionID,
+			Currency: currency,
+			Amount:   1_000_000,
+			Method:   "algorand",
+			Status:   model.Created,
+			Watch:    true,
+			Receiver: &model.TransactionUser{UserID: "receiver-user", WalletID: "receiver-wallet"},
+		}
+	}
+
+	testcases := []struct {
+		name        string
+		transaction *model.Transaction
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
+		expectedErr error
+	}{
+		{
+			name:        "Successfully create watch transaction",
+			transaction: watchTransaction("ALGO"),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mp *mock_publisher.MockTransactionPublisher) {
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mtr.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(nil)
+				mp.EXPECT().PublishProcessedTransaction(gomock.Any()).DoAndReturn(func(processedTransaction *dto.ProcessedTransaction) error {
+					assert.True(t, processedTransaction.Watch)
+					assert.Nil(t, processedTransaction.Sender)
+					assert.Equal(t, &defaultExpiresAt, processedTransaction.ExpiresAt)
+
+					return nil
+				})
+			},
+		},
+		{
+			name:        "Watch transaction not paid in ALGO",
+			transaction: watchTransaction("USD"),
+			mock: func(ml *mock_logger.MockLogger, _ *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+			},
+			expectedErr: model.ErrInvalidWatch,
+		},
+		{
+			name:        "Failed to create watch transaction",
+			transaction: watchTransaction("ALGO"),
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mtr.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			testcase.mock(l, repo, publisher)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			err := transactionUsecase.CreateTransaction(ctx, testcase.transaction)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr == nil {
+				assert.Equal(t, model.Processed, testcase.transaction.Status)
+			}
 		})
 	}
 }
@@ -238,10 +375,10 @@ func TestGetTransactionStatus(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			l, repo, publisher := transactionHelper(t)
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
 			testcase.mock(l, repo)
 
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
 
 			actualTransactionStatus, err := transactionUsecase.GetTransactionStatus(
 				testcase.args.ctx,
@@ -264,12 +401,25 @@ func TestCancelTransaction(t *testing.T) {
 
 	ctx := context.Background()
 
+	authorizedTransaction := &model.Transaction{
+		ID:            transactionID,
+		Currency:      "ALGO",
+		Amount:        5,
+		Status:        model.Authorized,
+		ManualCapture: true,
+		Sender:        &model.TransactionUser{ID: "test-sender"},
+		Receiver:      &model.TransactionUser{ID: "test-receiver"},
+	}
+
+	voidRequest, err := dto.FromVoidRequest(authorizedTransaction)
+	require.NoError(t, err)
+
 	someErr := repository.NewCancelTransactionError("test err", nil)
 
 	testcases := []struct {
 		name        string
 		args        args
-		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
 		expectedErr error
 	}{
 		{
@@ -279,12 +429,32 @@ func TestCancelTransaction(t *testing.T) {
 				transactionID: transactionID,
 				reason:        reason,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				ml.EXPECT().Debug("Cancel transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+					"reason":         reason,
+				})
+				mtr.EXPECT().GetTransactionStatus(ctx, transactionID).Return(model.Created, nil).Times(1)
+				mtr.EXPECT().CancelPendingTransaction(ctx, transactionID, reason).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Authorized transaction is voided",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				reason:        reason,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mp *mock_publisher.MockTransactionPublisher) {
 				ml.EXPECT().Debug("Cancel transaction usecase", map[string]interface{}{
 					"transaction_id": transactionID,
 					"reason":         reason,
 				})
-				mtr.EXPECT().CancelTransaction(ctx, transactionID, reason).Return(nil).Times(1)
+				mtr.EXPECT().GetTransactionStatus(ctx, transactionID).Return(model.Authorized, nil).Times(1)
+				mtr.EXPECT().RequestVoid(ctx, transactionID, testNow).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(authorizedTransaction, nil).Times(1)
+				mp.EXPECT().PublishVoidRequestedTransaction(voidRequest).Return(nil).Times(1)
 			},
 			expectedErr: nil,
 		},
@@ -295,15 +465,49 @@ func TestCancelTransaction(t *testing.T) {
 				transactionID: transactionID,
 				reason:        reason,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
 				ml.EXPECT().Debug("Cancel transaction usecase", map[string]interface{}{
 					"transaction_id": transactionID,
 					"reason":         reason,
 				})
-				mtr.EXPECT().CancelTransaction(ctx, transactionID, reason).Return(someErr).Times(1)
+				mtr.EXPECT().GetTransactionStatus(ctx, transactionID).Return(model.Created, nil).Times(1)
+				mtr.EXPECT().CancelPendingTransaction(ctx, transactionID, reason).Return(someErr).Times(1)
 			},
 			expectedErr: someErr,
 		},
+		{
+			name: "Transaction already handed over to the payment gateway",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				reason:        reason,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				ml.EXPECT().Debug("Cancel transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+					"reason":         reason,
+				})
+				mtr.EXPECT().GetTransactionStatus(ctx, transactionID).Return(model.Processed, nil).Times(1)
+				mtr.EXPECT().CancelPendingTransaction(ctx, transactionID, reason).Return(usecase.ErrTransactionNotCancellable).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotCancellable,
+		},
+		{
+			name: "Transaction not found",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				reason:        reason,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				ml.EXPECT().Debug("Cancel transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+					"reason":         reason,
+				})
+				mtr.EXPECT().GetTransactionStatus(ctx, transactionID).Return(model.Undefined, usecase.ErrTransactionNotFound).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotFound,
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -312,10 +516,10 @@ func TestCancelTransaction(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			l, repo, publisher := transactionHelper(t)
-			testcase.mock(l, repo)
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			testcase.mock(l, repo, publisher)
 
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
 
 			err := transactionUsecase.CancelTransaction(
 				testcase.args.ctx,
@@ -334,29 +538,101 @@ func TestAcceptTransaction(t *testing.T) {
 		ctx           context.Context
 		transactionID string
 		sender        *model.TransactionUser
+		method        model.ConfirmationMethod
+		qrPayload     string
+		executeAt     *time.Time
 	}
 
 	ctx := context.Background()
 
 	sender := &model.TransactionUser{
-		ID: "test-user",
+		ID:     "test-user",
+		UserID: "test-payer",
 	}
 	receiver := &model.TransactionUser{
 		ID: "test-user",
 	}
 	transaction := &model.Transaction{
-		ID:       "test-transaction",
+		ID:       transactionID,
+		Currency: "ALGO",
+		Amount:   5,
+		Sender:   sender,
+		Receiver: receiver,
+	}
+	expiredAt := testNow.Add(-time.Second)
+	expiredTransaction := &model.Transaction{
+		ID:        transactionID,
+		Status:    model.Created,
+		ExpiresAt: &expiredAt,
+		Receiver:  receiver,
+	}
+	quotedTransaction := &model.Transaction{
+		ID:       transactionID,
+		Currency: "RUB",
+		Amount:   150000,
 		Sender:   sender,
 		Receiver: receiver,
+		Quote: &model.Quote{
+			TransactionID: transactionID,
+			PayerID:       "test-payer",
+			Currency:      "ALGO",
+			Amount:        94921875,
+			ExpiresAt:     testNow.Add(time.Second),
+		},
+	}
+	otherPayerQuoteTransaction := &model.Transaction{
+		ID:       transactionID,
+		Currency: "RUB",
+		Amount:   150000,
+		Receiver: receiver,
+		Quote: &model.Quote{
+			TransactionID: transactionID,
+			PayerID:       "test-other-payer",
+			Currency:      "ALGO",
+			Amount:        94921875,
+			ExpiresAt:     testNow.Add(time.Second),
+		},
+	}
+	expiredQuoteTransaction := &model.Transaction{
+		ID:       transactionID,
+		Currency: "RUB",
+		Amount:   150000,
+		Receiver: receiver,
+		Quote: &model.Quote{
+			TransactionID: transactionID,
+			Currency:      "ALGO",
+			Amount:        94921875,
+			ExpiresAt:     testNow,
+		},
+	}
+	pendingConfirmation := &model.Confirmation{
+		TransactionID: "test-transaction",
+		Method:        model.CodeConfirmation,
+	}
+	signatureConfirmation := &model.Confirmation{
+		TransactionID: "test-transaction",
+		Method:        model.SignatureConfirmation,
+	}
+
+	transactionFee := &model.Fee{
+		Amount: 1,
+		Receiver: &model.TransactionUser{
+			ID: "test-fee-receiver",
+		},
 	}
 
+	executeAt := testNow.Add(72 * time.Hour)
+	pastExecuteAt := testNow.Add(-time.Minute)
+
 	someErr := repository.NewAcceptTransactionError("test err", nil)
+	qrPayload := "test-qr-payload"
 
 	testcases := []struct {
-		name        string
-		args        args
-		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
-		expectedErr error
+		name                 string
+		args                 args
+		mock                 func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher, *mock_confirmation.MockConfirmer, *mock_scantoken.MockIssuer, *mock_fee.MockCalculator)
+		expectedConfirmation *model.Confirmation
+		expectedErr          error
 	}{
 		{
 			name: "Successfully accept transaction",
@@ -364,164 +640,871 @@ func TestAcceptTransaction(t *testing.T) {
 				ctx:           ctx,
 				transactionID: transactionID,
 				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
 				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
 					"transaction_id": transactionID,
 				})
-				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender).Return(nil).Times(1)
 				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
-				mtp.EXPECT().PublishProcessedTransaction(dto.FromTransactionModel(transaction)).Return(nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, nil).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(processedTransactionDTO(t, transaction)).Return(nil).Times(1)
 			},
 			expectedErr: nil,
 		},
 		{
-			name: "Failed to accept transaction",
+			name: "Accept transaction with the receiver fee",
 			args: args{
 				ctx:           ctx,
 				transactionID: transactionID,
 				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
 				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
 					"transaction_id": transactionID,
 				})
-				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender).Return(someErr).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(transactionFee, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, transactionFee, nil).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(processedTransactionDTO(t, transaction)).Return(nil).Times(1)
 			},
-			expectedErr: someErr,
+			expectedErr: nil,
 		},
 		{
-			name: "Failed to get transaction",
+			name: "Failed to calculate fee",
 			args: args{
 				ctx:           ctx,
 				transactionID: transactionID,
 				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
 				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
 					"transaction_id": transactionID,
 				})
-				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender).Return(nil).Times(1)
-				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(nil, someErr).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, someErr).Times(1)
+				ms.EXPECT().Restore(ctx, qrPayload, transaction).Return(nil).Times(1)
 			},
 			expectedErr: someErr,
 		},
 		{
-			name: "Failed to publish succeeded transaction",
+			name: "Successfully request code confirmation",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(true).Times(1)
+				mc.EXPECT().Issue(transaction, sender, model.CodeConfirmation).Return(pendingConfirmation, "123456", nil).Times(1)
+				mtr.EXPECT().RequestConfirmation(ctx, sender, nil, pendingConfirmation, nil).Return(nil).Times(1)
+				mc.EXPECT().Notify(ctx, pendingConfirmation, sender, "123456").Return(nil).Times(1)
+			},
+			expectedConfirmation: pendingConfirmation,
+			expectedErr:          nil,
+		},
+		{
+			name: "Failed to notify payer",
 			args: args{
 				ctx:           ctx,
 				transactionID: transactionID,
 				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher) {
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
 				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
 					"transaction_id": transactionID,
 				})
-				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender).Return(nil).Times(1)
 				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
-				mtp.EXPECT().PublishProcessedTransaction(dto.FromTransactionModel(transaction)).Return(someErr).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(true).Times(1)
+				mc.EXPECT().Issue(transaction, sender, model.CodeConfirmation).Return(pendingConfirmation, "123456", nil).Times(1)
+				mtr.EXPECT().RequestConfirmation(ctx, sender, nil, pendingConfirmation, nil).Return(nil).Times(1)
+				mc.EXPECT().Notify(ctx, pendingConfirmation, sender, "123456").Return(someErr).Times(1)
+				mtr.EXPECT().ResetConfirmation(ctx, transactionID).Return(nil).Times(1)
+				ms.EXPECT().Restore(ctx, qrPayload, transaction).Return(nil).Times(1)
 			},
 			expectedErr: someErr,
 		},
-	}
-
-	for _, testcase := range testcases {
-		testcase := testcase
-
-		t.Run(testcase.name, func(t *testing.T) {
-			t.Parallel()
-
-			l, repo, publisher := transactionHelper(t)
-			testcase.mock(l, repo, publisher)
-
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
-
-			err := transactionUsecase.AcceptTransaction(
-				testcase.args.ctx,
-				testcase.args.transactionID,
-				testcase.args.sender,
-			)
-			assert.Equal(t, err, testcase.expectedErr)
-		})
-	}
-}
-
-func TestUpdateTransaction(t *testing.T) {
-	t.Parallel()
-
-	type args struct {
-		ctx                context.Context
-		updatedTransaction *model.Transaction
-	}
-
-	ctx := context.Background()
-
-	transaction := &model.Transaction{
-		ID: "test-transaction",
-	}
-
-	someErr := repository.NewUpdateTransactionError("test err", nil)
-
-	testcases := []struct {
-		name        string
-		args        args
-		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
-		expectedErr error
-	}{
 		{
-			name: "Successfully update transaction",
+			name: "Successfully request signature confirmation",
 			args: args{
-				ctx:                ctx,
-				updatedTransaction: transaction,
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.SignatureConfirmation,
+				qrPayload:     qrPayload,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
-				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
-					"updated_transaction": transaction,
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
 				})
-				mtr.EXPECT().UpdateTransaction(ctx, transaction).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(true).Times(1)
+				mc.EXPECT().Issue(transaction, sender, model.SignatureConfirmation).Return(signatureConfirmation, "", nil).Times(1)
+				mtr.EXPECT().RequestConfirmation(ctx, sender, nil, signatureConfirmation, nil).Return(nil).Times(1)
 			},
-			expectedErr: nil,
+			expectedConfirmation: signatureConfirmation,
+			expectedErr:          nil,
 		},
 		{
-			name: "Failed to update transaction",
+			name: "Failed to store confirmation",
 			args: args{
-				ctx:                ctx,
-				updatedTransaction: transaction,
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
 			},
-			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
-				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
-					"updated_transaction": transaction,
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
 				})
-				mtr.EXPECT().UpdateTransaction(ctx, transaction).Return(someErr).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(true).Times(1)
+				mc.EXPECT().Issue(transaction, sender, model.CodeConfirmation).Return(pendingConfirmation, "123456", nil).Times(1)
+				mtr.EXPECT().RequestConfirmation(ctx, sender, nil, pendingConfirmation, nil).Return(someErr).Times(1)
+				ms.EXPECT().Restore(ctx, qrPayload, transaction).Return(nil).Times(1)
 			},
 			expectedErr: someErr,
 		},
-	}
-
-	for _, testcase := range testcases {
-		testcase := testcase
-
-		t.Run(testcase.name, func(t *testing.T) {
-			t.Parallel()
-
-			l, repo, publisher := transactionHelper(t)
-			testcase.mock(l, repo)
-
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
-
-			err := transactionUsecase.UpdateTransaction(
-				testcase.args.ctx,
-				testcase.args.updatedTransaction,
-			)
-			assert.Equal(t, err, testcase.expectedErr)
-		})
-	}
-}
-
-func TestChangeTransactionStatus(t *testing.T) {
-	t.Parallel()
-
-	type args struct {
+		{
+			name: "Failed to accept transaction",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, nil).Return(someErr).Times(1)
+				ms.EXPECT().Restore(ctx, qrPayload, transaction).Return(nil).Times(1)
+			},
+			expectedErr: someErr,
+		},
+		{
+			name: "Failed to accept transaction and restore its scan token",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, nil).Return(someErr).Times(1)
+				ms.EXPECT().Restore(ctx, qrPayload, transaction).Return(scantoken.ErrTokenExpired).Times(1)
+				ml.EXPECT().Error("Failed to restore scan token", map[string]interface{}{
+					"transaction_id": transactionID,
+					"error":          scantoken.ErrTokenExpired,
+				})
+			},
+			expectedErr: someErr,
+		},
+		{
+			name: "Successfully schedule transaction",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+				executeAt:     &executeAt,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, &executeAt).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Schedule transaction after confirmation",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+				executeAt:     &executeAt,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(true).Times(1)
+				mc.EXPECT().Issue(transaction, sender, model.CodeConfirmation).Return(pendingConfirmation, "123456", nil).Times(1)
+				mtr.EXPECT().RequestConfirmation(ctx, sender, nil, pendingConfirmation, &executeAt).Return(nil).Times(1)
+				mc.EXPECT().Notify(ctx, pendingConfirmation, sender, "123456").Return(nil).Times(1)
+			},
+			expectedConfirmation: pendingConfirmation,
+			expectedErr:          nil,
+		},
+		{
+			name: "Execution time in the past processes right away",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+				executeAt:     &pastExecuteAt,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, nil).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(processedTransactionDTO(t, transaction)).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Quoted transaction cannot be scheduled",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+				executeAt:     &executeAt,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_scantoken.MockIssuer, _ *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(quotedTransaction, nil).Times(1)
+			},
+			expectedErr: usecase.ErrScheduleUnsupported,
+		},
+		{
+			name: "Expired transaction",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_scantoken.MockIssuer, _ *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(expiredTransaction, nil).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionExpired,
+		},
+		{
+			name: "Successfully accept quoted transaction",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(quotedTransaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, quotedTransaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, quotedTransaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(quotedTransaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, nil).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(quotedTransaction, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(gomock.Cond(func(x any) bool {
+					processedTransaction, ok := x.(*dto.ProcessedTransaction)
+
+					return ok &&
+						processedTransaction.Transaction.Value == "94.921875" &&
+						processedTransaction.Transaction.Currency == "ALGO"
+				})).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Expired quote",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_scantoken.MockIssuer, _ *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(expiredQuoteTransaction, nil).Times(1)
+			},
+			expectedErr: usecase.ErrQuoteExpired,
+		},
+		{
+			name: "Quote locked for another payer",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, _ *mock_scantoken.MockIssuer, _ *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(otherPayerQuoteTransaction, nil).Times(1)
+			},
+			expectedErr: usecase.ErrQuoteLocked,
+		},
+		{
+			name: "Replayed qr payload",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, _ *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(scantoken.ErrTokenReplayed).Times(1)
+			},
+			expectedErr: scantoken.ErrTokenReplayed,
+		},
+		{
+			name: "Failed to get transaction",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, _ *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(nil, someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+		{
+			name: "Failed to publish succeeded transaction",
+			args: args{
+				ctx:           ctx,
+				transactionID: transactionID,
+				sender:        sender,
+				method:        model.CodeConfirmation,
+				qrPayload:     qrPayload,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer, ms *mock_scantoken.MockIssuer, mf *mock_fee.MockCalculator) {
+				ml.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+					"transaction_id": transactionID,
+				})
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				ms.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+				mc.EXPECT().Required(transaction).Return(false).Times(1)
+				mtr.EXPECT().AcceptTransaction(ctx, transactionID, sender, nil, nil).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(processedTransactionDTO(t, transaction)).Return(someErr).Times(1)
+				ms.EXPECT().Restore(ctx, qrPayload, transaction).Return(nil).Times(1)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			testcase.mock(l, repo, publisher, confirmer, scanTokens, fees)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			actualConfirmation, err := transactionUsecase.AcceptTransaction(
+				testcase.args.ctx,
+				testcase.args.transactionID,
+				testcase.args.sender,
+				testcase.args.method,
+				testcase.args.qrPayload,
+				testcase.args.executeAt,
+			)
+			assert.Equal(t, testcase.expectedConfirmation, actualConfirmation)
+			assert.Equal(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestGetTransactionFee(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	expiredAt := testNow.Add(-time.Second)
+	someErr := errors.New("test err")
+
+	testcases := []struct {
+		name        string
+		transaction *model.Transaction
+		mock        func(*mock_fee.MockCalculator, *model.Transaction)
+		expectedFee int64
+		expectedErr error
+	}{
+		{
+			name: "Successfully get transaction fee",
+			transaction: &model.Transaction{
+				ID:     transactionID,
+				Status: model.Created,
+			},
+			mock: func(mf *mock_fee.MockCalculator, transaction *model.Transaction) {
+				mf.EXPECT().Calculate(ctx, transaction).Return(&model.Fee{Amount: 30}, nil).Times(1)
+			},
+			expectedFee: 30,
+		},
+		{
+			name: "Transaction without fee",
+			transaction: &model.Transaction{
+				ID:     transactionID,
+				Status: model.Created,
+			},
+			mock: func(mf *mock_fee.MockCalculator, transaction *model.Transaction) {
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Transaction already accepted",
+			transaction: &model.Transaction{
+				ID:     transactionID,
+				Status: model.Processed,
+			},
+			mock:        func(*mock_fee.MockCalculator, *model.Transaction) {},
+			expectedErr: usecase.ErrTransactionNotCreated,
+		},
+		{
+			name: "Transaction expired",
+			transaction: &model.Transaction{
+				ID:        transactionID,
+				Status:    model.Created,
+				ExpiresAt: &expiredAt,
+			},
+			mock:        func(*mock_fee.MockCalculator, *model.Transaction) {},
+			expectedErr: usecase.ErrTransactionExpired,
+		},
+		{
+			name: "Failed to calculate fee",
+			transaction: &model.Transaction{
+				ID:     transactionID,
+				Status: model.Created,
+			},
+			mock: func(mf *mock_fee.MockCalculator, transaction *model.Transaction) {
+				mf.EXPECT().Calculate(ctx, transaction).Return(nil, someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			l.EXPECT().Debug("Get transaction fee usecase", map[string]interface{}{
+				"transaction_id": transactionID,
+			})
+			repo.EXPECT().GetTransaction(ctx, transactionID).Return(testcase.transaction, nil).Times(1)
+			testcase.mock(fees, testcase.transaction)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			transaction, err := transactionUsecase.GetTransactionFee(ctx, transactionID)
+			require.ErrorIs(t, err, testcase.expectedErr)
+
+			if testcase.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, testcase.expectedFee, transaction.Fee)
+		})
+	}
+}
+
+func TestConfirmTransaction(t *testing.T) {
+	t.Parallel()
+
+	const (
+		payerID = "test-payer"
+		code    = "123456"
+	)
+
+	ctx := context.Background()
+
+	newTransaction := func() *model.Transaction {
+		return &model.Transaction{
+			ID:       transactionID,
+			Currency: "ALGO",
+			Amount:   5,
+			Status:   model.AwaitingConfirmation,
+			Sender:   &model.TransactionUser{ID: "test-sender", UserID: payerID},
+			Receiver: &model.TransactionUser{ID: "test-receiver"},
+		}
+	}
+	processedTransaction := newTransaction()
+	processedTransaction.Status = model.Processed
+
+	pendingConfirmation := &model.Confirmation{
+		TransactionID: transactionID,
+		Method:        model.CodeConfirmation,
+		ExpiresAt:     time.Unix(300, 0),
+	}
+
+	executeAt := testNow.Add(72 * time.Hour)
+	newScheduledTransaction := func() *model.Transaction {
+		transaction := newTransaction()
+		transaction.ExecuteAt = &executeAt
+
+		return transaction
+	}
+
+	someErr := repository.NewConfirmTransactionError("test err", nil)
+	invalidErr := confirmation.NewInvalidConfirmationError(2)
+	expiredErr := confirmation.NewConfirmationExpiredError("test expired")
+
+	testcases := []struct {
+		name        string
+		userID      string
+		mock        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher, *mock_confirmation.MockConfirmer)
+		expectedErr error
+	}{
+		{
+			name:   "Successfully confirm transaction",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newTransaction(), nil)
+				mtr.EXPECT().GetConfirmation(ctx, transactionID).Return(pendingConfirmation, nil)
+				mc.EXPECT().Verify(ctx, pendingConfirmation, newTransaction(), code, "").Return(nil)
+				mtr.EXPECT().ConfirmTransaction(ctx, transactionID).Return(nil)
+				mtp.EXPECT().PublishProcessedTransaction(processedTransactionDTO(t, processedTransaction)).Return(nil)
+			},
+		},
+		{
+			name:   "Confirmed transaction with execution time is scheduled",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newScheduledTransaction(), nil)
+				mtr.EXPECT().GetConfirmation(ctx, transactionID).Return(pendingConfirmation, nil)
+				mc.EXPECT().Verify(ctx, pendingConfirmation, newScheduledTransaction(), code, "").Return(nil)
+				mtr.EXPECT().ConfirmTransaction(ctx, transactionID).Return(nil)
+			},
+		},
+		{
+			name:   "Transaction is not awaiting confirmation",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(processedTransaction, nil)
+			},
+			expectedErr: usecase.ErrConfirmationNotFound,
+		},
+		{
+			name:   "Confirmed not by the payer",
+			userID: "test-stranger",
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, _ *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newTransaction(), nil)
+			},
+			expectedErr: usecase.ErrNotPayer,
+		},
+		{
+			name:   "Wrong code counts an attempt",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newTransaction(), nil)
+				mtr.EXPECT().GetConfirmation(ctx, transactionID).Return(pendingConfirmation, nil)
+				mc.EXPECT().Verify(ctx, pendingConfirmation, newTransaction(), code, "").Return(invalidErr)
+				mtr.EXPECT().IncrementConfirmationAttempts(ctx, transactionID).Return(nil)
+			},
+			expectedErr: invalidErr,
+		},
+		{
+			name:   "Last wrong code drops the confirmation",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newTransaction(), nil)
+				mtr.EXPECT().GetConfirmation(ctx, transactionID).Return(pendingConfirmation, nil)
+				mc.EXPECT().Verify(ctx, pendingConfirmation, newTransaction(), code, "").Return(confirmation.NewInvalidConfirmationError(0))
+				mtr.EXPECT().ResetConfirmation(ctx, transactionID).Return(nil)
+			},
+			expectedErr: confirmation.NewConfirmationExpiredError("confirmation attempts exhausted"),
+		},
+		{
+			name:   "Expired confirmation is dropped",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newTransaction(), nil)
+				mtr.EXPECT().GetConfirmation(ctx, transactionID).Return(pendingConfirmation, nil)
+				mc.EXPECT().Verify(ctx, pendingConfirmation, newTransaction(), code, "").Return(expiredErr)
+				mtr.EXPECT().ResetConfirmation(ctx, transactionID).Return(nil)
+			},
+			expectedErr: expiredErr,
+		},
+		{
+			name:   "Failed to confirm transaction",
+			userID: payerID,
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher, mc *mock_confirmation.MockConfirmer) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(newTransaction(), nil)
+				mtr.EXPECT().GetConfirmation(ctx, transactionID).Return(pendingConfirmation, nil)
+				mc.EXPECT().Verify(ctx, pendingConfirmation, newTransaction(), code, "").Return(nil)
+				mtr.EXPECT().ConfirmTransaction(ctx, transactionID).Return(someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			l.EXPECT().Debug("Confirm transaction usecase", map[string]interface{}{
+				"transaction_id": transactionID,
+			})
+			testcase.mock(repo, publisher, confirmer)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			err := transactionUsecase.ConfirmTransaction(ctx, transactionID, testcase.userID, code, "")
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestUpdateTransaction(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx                context.Context
+		updatedTransaction *model.Transaction
+	}
+
+	ctx := context.Background()
+
+	transaction := &model.Transaction{
+		ID: "test-transaction",
+	}
+
+	someErr := repository.NewUpdateTransactionError("test err", nil)
+
+	fee := int64(300)
+	splitTransaction := func() *model.Transaction {
+		return &model.Transaction{
+			ID:     "test-transaction",
+			Amount: 1000,
+			Status: model.Created,
+			Legs: []*model.TransactionLeg{
+				{Position: 0},
+				{Position: 1, Amount: &fee},
+			},
+		}
+	}
+	reducedTransaction := &model.Transaction{
+		ID:     "test-transaction",
+		Amount: 500,
+	}
+	belowFeeTransaction := &model.Transaction{
+		ID:     "test-transaction",
+		Amount: 300,
+	}
+	authorizedTransaction := func() *model.Transaction {
+		transaction := splitTransaction()
+		transaction.Status = model.Authorized
+
+		return transaction
+	}
+	notCreatedErr := repository.NewUpdateTransactionError("test err", repository.ErrTransactionNotCreated)
+
+	testcases := []struct {
+		name        string
+		args        args
+		mock        func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully update transaction",
+			args: args{
+				ctx:                ctx,
+				updatedTransaction: transaction,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
+					"updated_transaction": transaction,
+				})
+				mtr.EXPECT().UpdateTransaction(ctx, transaction).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Failed to update transaction",
+			args: args{
+				ctx:                ctx,
+				updatedTransaction: transaction,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
+					"updated_transaction": transaction,
+				})
+				mtr.EXPECT().UpdateTransaction(ctx, transaction).Return(someErr).Times(1)
+			},
+			expectedErr: someErr,
+		},
+		{
+			name: "Successfully update amount of split transaction",
+			args: args{
+				ctx:                ctx,
+				updatedTransaction: reducedTransaction,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
+					"updated_transaction": reducedTransaction,
+				})
+				mtr.EXPECT().GetTransaction(ctx, reducedTransaction.ID).Return(splitTransaction(), nil).Times(1)
+				mtr.EXPECT().UpdateTransaction(ctx, reducedTransaction).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Amount leaves nothing to the receiver of split transaction",
+			args: args{
+				ctx:                ctx,
+				updatedTransaction: belowFeeTransaction,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
+					"updated_transaction": belowFeeTransaction,
+				})
+				mtr.EXPECT().GetTransaction(ctx, belowFeeTransaction.ID).Return(splitTransaction(), nil).Times(1)
+			},
+			expectedErr: model.ErrInvalidSplit,
+		},
+		{
+			name: "Amount of authorized transaction",
+			args: args{
+				ctx:                ctx,
+				updatedTransaction: reducedTransaction,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
+					"updated_transaction": reducedTransaction,
+				})
+				mtr.EXPECT().GetTransaction(ctx, reducedTransaction.ID).Return(authorizedTransaction(), nil).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotCreated,
+		},
+		{
+			name: "Transaction accepted while editing",
+			args: args{
+				ctx:                ctx,
+				updatedTransaction: reducedTransaction,
+			},
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo) {
+				ml.EXPECT().Debug("Update transaction usecase", map[string]interface{}{
+					"updated_transaction": reducedTransaction,
+				})
+				mtr.EXPECT().GetTransaction(ctx, reducedTransaction.ID).Return(splitTransaction(), nil).Times(1)
+				mtr.EXPECT().UpdateTransaction(ctx, reducedTransaction).Return(notCreatedErr).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotCreated,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			testcase.mock(l, repo)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			err := transactionUsecase.UpdateTransaction(
+				testcase.args.ctx,
+				testcase.args.updatedTransaction,
+			)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestChangeTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
 		ctx           context.Context
 		transactionID string
 		status        model.TransactionStatus
@@ -577,10 +1560,10 @@ func TestChangeTransactionStatus(t *testing.T) {
 		t.Run(testcase.name, func(t *testing.T) {
 			t.Parallel()
 
-			l, repo, publisher := transactionHelper(t)
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
 			testcase.mock(l, repo)
 
-			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, l)
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
 
 			err := transactionUsecase.ChangeTransactionStatus(
 				testcase.args.ctx,
@@ -591,3 +1574,474 @@ func TestChangeTransactionStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestQuoteTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	transaction := &model.Transaction{
+		ID:       transactionID,
+		Currency: "RUB",
+		Amount:   150000,
+		Status:   model.Created,
+	}
+	acceptedTransaction := &model.Transaction{
+		ID:     transactionID,
+		Status: model.Processed,
+	}
+	expiredAt := testNow.Add(-time.Second)
+	expiredTransaction := &model.Transaction{
+		ID:        transactionID,
+		Status:    model.Created,
+		ExpiresAt: &expiredAt,
+	}
+	// The quoter returns a new quote on every call, the payer is set on it.
+	newQuote := func() *model.Quote {
+		return &model.Quote{
+			TransactionID: transactionID,
+			Currency:      "ALGO",
+			Amount:        94921875,
+			Rate:          "0.06328125",
+			MidRate:       "0.0625",
+			ExpiresAt:     testNow.Add(time.Minute),
+			CreatedAt:     testNow,
+		}
+	}
+	quote := newQuote()
+	quote.PayerID = "test-payer"
+	rateErr := fx.NewRateError("failed to get exchange rate", fx.ErrRateNotFound)
+	someErr := repository.NewLockQuoteError("test err", repository.ErrTransactionNotCreated)
+	lockedErr := repository.NewLockQuoteError("test err", repository.ErrQuoteLocked)
+
+	testcases := []struct {
+		name          string
+		mock          func(*mock_repo.MockTransactionRepo, *mock_fx.MockQuoter)
+		expectedQuote *model.Quote
+		expectedErr   error
+	}{
+		{
+			name: "Successfully quote transaction",
+			mock: func(mtr *mock_repo.MockTransactionRepo, mq *mock_fx.MockQuoter) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mq.EXPECT().Quote(ctx, transaction, "ALGO").Return(newQuote(), nil).Times(1)
+				mtr.EXPECT().LockQuote(ctx, quote).Return(nil).Times(1)
+			},
+			expectedQuote: quote,
+		},
+		{
+			name: "Quote locked for another payer",
+			mock: func(mtr *mock_repo.MockTransactionRepo, mq *mock_fx.MockQuoter) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mq.EXPECT().Quote(ctx, transaction, "ALGO").Return(newQuote(), nil).Times(1)
+				mtr.EXPECT().LockQuote(ctx, quote).Return(lockedErr).Times(1)
+			},
+			expectedErr: usecase.ErrQuoteLocked,
+		},
+		{
+			name: "Accepted transaction",
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_fx.MockQuoter) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(acceptedTransaction, nil).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotCreated,
+		},
+		{
+			name: "Expired transaction",
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_fx.MockQuoter) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(expiredTransaction, nil).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionExpired,
+		},
+		{
+			name: "Failed to get rate",
+			mock: func(mtr *mock_repo.MockTransactionRepo, mq *mock_fx.MockQuoter) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mq.EXPECT().Quote(ctx, transaction, "ALGO").Return(nil, rateErr).Times(1)
+			},
+			expectedErr: fx.ErrRateNotFound,
+		},
+		{
+			name: "Accepted while quoting",
+			mock: func(mtr *mock_repo.MockTransactionRepo, mq *mock_fx.MockQuoter) {
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+				mq.EXPECT().Quote(ctx, transaction, "ALGO").Return(newQuote(), nil).Times(1)
+				mtr.EXPECT().LockQuote(ctx, quote).Return(someErr).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotCreated,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			l.EXPECT().Debug("Quote transaction usecase", map[string]interface{}{
+				"transaction_id": transactionID,
+				"payer_id":       "test-payer",
+				"currency":       "ALGO",
+			})
+			testcase.mock(repo, quoter)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			actualQuote, err := transactionUsecase.QuoteTransaction(ctx, transactionID, "test-payer", "ALGO")
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedQuote, actualQuote)
+		})
+	}
+}
+
+func TestCreateGroupTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	groupExpiresAt := testNow.Add(groupDeadline)
+
+	testcases := []struct {
+		name              string
+		mock              func(*mock_logger.MockLogger, *mock_repo.MockTransactionRepo, *mock_confirmation.MockConfirmer)
+		expectedExpiresAt *time.Time
+		expectedErr       error
+	}{
+		{
+			name: "Successfully create group transaction with group deadline",
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mc *mock_confirmation.MockConfirmer) {
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mc.EXPECT().Required(gomock.Any()).Return(false).Times(2)
+				mtr.EXPECT().CreateTransaction(ctx, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedExpiresAt: &groupExpiresAt,
+			expectedErr:       nil,
+		},
+		{
+			name: "Share reaches confirmation threshold",
+			mock: func(ml *mock_logger.MockLogger, mtr *mock_repo.MockTransactionRepo, mc *mock_confirmation.MockConfirmer) {
+				ml.EXPECT().Debug("Create transaction usecase", gomock.Any())
+				mc.EXPECT().Required(gomock.Any()).Return(true).Times(1)
+			},
+			expectedErr: usecase.ErrShareNeedsConfirmation,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+			testcase.mock(l, repo, confirmer)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			transaction := &model.Transaction{
+				ID:     transactionID,
+				Amount: 1000,
+				Shares: []*model.TransactionShare{
+					{ID: "first-share", Amount: 600},
+					{ID: "second-share", Amount: 400},
+				},
+			}
+
+			err := transactionUsecase.CreateTransaction(ctx, transaction)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Equal(t, testcase.expectedExpiresAt, transaction.ExpiresAt)
+		})
+	}
+}
+
+func TestAcceptGroupTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	payer := &model.TransactionUser{
+		ID:       "test-payer",
+		UserID:   "test-payer-user",
+		WalletID: "test-payer-wallet",
+	}
+	receiver := &model.TransactionUser{
+		ID:       "test-receiver",
+		UserID:   "test-receiver-user",
+		WalletID: "test-receiver-wallet",
+	}
+	expiresAt := testNow.Add(time.Hour)
+	transaction := &model.Transaction{
+		ID:        transactionID,
+		Currency:  "ALGO",
+		Amount:    1000,
+		Status:    model.Created,
+		Method:    "algorand",
+		ExpiresAt: &expiresAt,
+		Receiver:  receiver,
+		Shares: []*model.TransactionShare{
+			{ID: "first-share", Amount: 600, Status: model.SharePending},
+			{ID: "second-share", Amount: 400, Status: model.SharePending},
+		},
+	}
+	claimedShare := &model.TransactionShare{
+		ID:       "first-share",
+		Amount:   600,
+		Status:   model.ShareProcessed,
+		RefundID: "first-refund",
+		Payer:    payer,
+	}
+	processedShare := &dto.ProcessedTransaction{
+		Transaction: &dto.Transaction{
+			TransactionID: "first-share",
+			Value:         "0.000600",
+			Currency:      "ALGO",
+			PaymentMethod: "algorand",
+		},
+		Sender: &dto.TransactionUser{
+			UserID:   "test-payer-user",
+			WalletID: "test-payer-wallet",
+		},
+		Receiver: &dto.TransactionUser{
+			UserID:   "test-receiver-user",
+			WalletID: "test-receiver-wallet",
+		},
+	}
+	qrPayload := "test-qr-payload"
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Successfully pay the next share",
+			mock: func(mtr *mock_repo.MockTransactionRepo, mtp *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().ClaimShare(ctx, transactionID, payer).Return(claimedShare, nil).Times(1)
+				mtp.EXPECT().PublishProcessedTransaction(processedShare).Return(nil).Times(1)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Every share is taken",
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().ClaimShare(ctx, transactionID, payer).Return(nil, repository.NewClaimShareError("test err", repository.ErrNoShareLeft)).Times(1)
+			},
+			expectedErr: usecase.ErrNoShareLeft,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+
+			l.EXPECT().Debug("Accept transaction usecase", map[string]interface{}{
+				"transaction_id": transactionID,
+			})
+			repo.EXPECT().GetTransaction(ctx, transactionID).Return(transaction, nil).Times(1)
+			scanTokens.EXPECT().Redeem(ctx, qrPayload, transaction).Return(nil).Times(1)
+			testcase.mock(repo, publisher)
+
+			if testcase.expectedErr != nil {
+				scanTokens.EXPECT().Restore(ctx, qrPayload, transaction).Return(nil).Times(1)
+			}
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			confirmation, err := transactionUsecase.AcceptTransaction(ctx, transactionID, payer, model.CodeConfirmation, qrPayload, nil)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+			assert.Nil(t, confirmation)
+		})
+	}
+}
+
+func TestCaptureTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authorizedUntil := testNow.Add(time.Hour)
+	expiredAt := testNow
+	voidRequestedAt := testNow.Add(-time.Minute)
+
+	authorizedTransaction := func() *model.Transaction {
+		return &model.Transaction{
+			ID:              transactionID,
+			Currency:        "ALGO",
+			Amount:          5_000_000,
+			Status:          model.Authorized,
+			ManualCapture:   true,
+			AuthorizedUntil: &authorizedUntil,
+			Sender:          &model.TransactionUser{ID: "test-sender"},
+			Receiver:        &model.TransactionUser{ID: "test-receiver"},
+		}
+	}
+
+	partialCapture := processedTransactionDTO(t, authorizedTransaction())
+	partialCapture.AuthorizeOnly = false
+	partialCapture.Transaction.Value = "2.000000"
+
+	fullCapture := processedTransactionDTO(t, authorizedTransaction())
+	fullCapture.AuthorizeOnly = false
+
+	captureErr := repository.NewCaptureTransactionError("test err", repository.ErrTransactionNotAuthorized)
+
+	testcases := []struct {
+		name        string
+		amount      int64
+		transaction *model.Transaction
+		mock        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
+		expectedErr error
+	}{
+		{
+			name:        "Partial capture",
+			amount:      2_000_000,
+			transaction: authorizedTransaction(),
+			mock: func(mtr *mock_repo.MockTransactionRepo, mp *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().CaptureTransaction(ctx, transactionID, int64(2_000_000), testNow).Return(nil).Times(1)
+				mp.EXPECT().PublishCaptureRequestedTransaction(partialCapture).Return(nil).Times(1)
+			},
+		},
+		{
+			name:        "Full capture",
+			transaction: authorizedTransaction(),
+			mock: func(mtr *mock_repo.MockTransactionRepo, mp *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().CaptureTransaction(ctx, transactionID, int64(5_000_000), testNow).Return(nil).Times(1)
+				mp.EXPECT().PublishCaptureRequestedTransaction(fullCapture).Return(nil).Times(1)
+			},
+		},
+		{
+			name:        "Capture above the hold",
+			amount:      5_000_001,
+			transaction: authorizedTransaction(),
+			mock:        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher) {},
+			expectedErr: model.ErrInvalidCapture,
+		},
+		{
+			name: "Capture of an expired hold",
+			transaction: func() *model.Transaction {
+				transaction := authorizedTransaction()
+				transaction.AuthorizedUntil = &expiredAt
+
+				return transaction
+			}(),
+			mock:        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher) {},
+			expectedErr: usecase.ErrTransactionNotAuthorized,
+		},
+		{
+			name: "Capture of a hold being voided",
+			transaction: func() *model.Transaction {
+				transaction := authorizedTransaction()
+				transaction.VoidRequestedAt = &voidRequestedAt
+
+				return transaction
+			}(),
+			mock:        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher) {},
+			expectedErr: usecase.ErrTransactionNotAuthorized,
+		},
+		{
+			name: "Capture of a transaction paid right away",
+			transaction: &model.Transaction{
+				ID:     transactionID,
+				Amount: 5_000_000,
+				Status: model.Succeeded,
+			},
+			mock:        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher) {},
+			expectedErr: usecase.ErrTransactionNotAuthorized,
+		},
+		{
+			name:        "Hold voided concurrently",
+			transaction: authorizedTransaction(),
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().CaptureTransaction(ctx, transactionID, int64(5_000_000), testNow).Return(captureErr).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotAuthorized,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+
+			l.EXPECT().Debug("Capture transaction usecase", gomock.Any())
+			repo.EXPECT().GetTransaction(ctx, transactionID).Return(testcase.transaction, nil).Times(1)
+			testcase.mock(repo, publisher)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			err := transactionUsecase.CaptureTransaction(ctx, transactionID, testcase.amount)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestVoidTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	authorizedTransaction := &model.Transaction{
+		ID:            transactionID,
+		Currency:      "ALGO",
+		Amount:        5,
+		Status:        model.Authorized,
+		ManualCapture: true,
+		Sender:        &model.TransactionUser{ID: "test-sender"},
+		Receiver:      &model.TransactionUser{ID: "test-receiver"},
+	}
+
+	voidRequest, err := dto.FromVoidRequest(authorizedTransaction)
+	require.NoError(t, err)
+
+	voidErr := repository.NewRequestVoidError("test err", repository.ErrTransactionNotAuthorized)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_repo.MockTransactionRepo, *mock_publisher.MockTransactionPublisher)
+		expectedErr error
+	}{
+		{
+			name: "Successfully request void",
+			mock: func(mtr *mock_repo.MockTransactionRepo, mp *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().RequestVoid(ctx, transactionID, testNow).Return(nil).Times(1)
+				mtr.EXPECT().GetTransaction(ctx, transactionID).Return(authorizedTransaction, nil).Times(1)
+				mp.EXPECT().PublishVoidRequestedTransaction(voidRequest).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Transaction without hold",
+			mock: func(mtr *mock_repo.MockTransactionRepo, _ *mock_publisher.MockTransactionPublisher) {
+				mtr.EXPECT().RequestVoid(ctx, transactionID, testNow).Return(voidErr).Times(1)
+			},
+			expectedErr: usecase.ErrTransactionNotAuthorized,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, repo, publisher, confirmer, scanTokens, quoter, fees, clk := transactionHelper(t)
+
+			l.EXPECT().Debug("Void transaction usecase", map[string]interface{}{
+				"transaction_id": transactionID,
+			})
+			testcase.mock(repo, publisher)
+
+			transactionUsecase := usecase.NewTransactionUsecase(repo, publisher, confirmer, scanTokens, quoter, fees, clk, transactionTTL, groupDeadline, l)
+
+			err := transactionUsecase.VoidTransaction(ctx, transactionID)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+//go:generate mockgen -package mocks -destination mocks/webhook_usecase_mocks.go github.com/ShmelJUJ/software-engineering/transaction/internal/usecase WebhookUsecase
+
+// WebhookUsecase defines the interface for merchant webhook use cases.
+// Merchants only see their own webhooks, the ones of other merchants are reported as not found.
+type WebhookUsecase interface {
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) error
+	ListWebhookDeliveries(ctx context.Context, merchantID, webhookID string, limit, offset uint64) ([]*model.WebhookDelivery, error)
+	ResendWebhookDelivery(ctx context.Context, merchantID, deliveryID string) (*model.WebhookDelivery, error)
+}
+
+var (
+	// ErrWebhookNotFound is returned when the merchant has no webhook with the requested id.
+	ErrWebhookNotFound = repository.ErrWebhookNotFound
+	// ErrWebhookDeliveryNotFound is returned when the merchant has no webhook delivery with the requested id.
+	ErrWebhookDeliveryNotFound = repository.ErrWebhookDeliveryNotFound
+)
+
+type webhookUsecase struct {
+	webhookRepo repository.WebhookRepo
+	clock       clock.Clock
+	log         logger.Logger
+}
+
+// NewWebhookUsecase creates a new instance of WebhookUsecase.
+func NewWebhookUsecase(
+	webhookRepo repository.WebhookRepo,
+	clk clock.Clock,
+	log logger.Logger,
+) WebhookUsecase {
+	return &webhookUsecase{
+		webhookRepo: webhookRepo,
+		clock:       clk,
+		log:         log,
+	}
+}
+
+// CreateWebhook registers a new merchant webhook.
+func (usecase *webhookUsecase) CreateWebhook(ctx context.Context, webhook *model.Webhook) error {
+	usecase.log.Debug("Create webhook usecase", map[string]interface{}{
+		"webhook_id":  webhook.ID,
+		"merchant_id": webhook.MerchantID,
+		"url":         webhook.URL,
+	})
+
+	return usecase.webhookRepo.CreateWebhook(ctx, webhook)
+}
+
+// ListWebhookDeliveries retrieves a page of the delivery log of the merchant webhook.
+func (usecase *webhookUsecase) ListWebhookDeliveries(
+	ctx context.Context,
+	merchantID, webhookID string,
+	limit, offset uint64,
+) ([]*model.WebhookDelivery, error) {
+	usecase.log.Debug("List webhook deliveries usecase", map[string]interface{}{
+		"merchant_id": merchantID,
+		"webhook_id":  webhookID,
+		"limit":       limit,
+		"offset":      offset,
+	})
+
+	if _, err := usecase.merchantWebhook(ctx, merchantID, webhookID); err != nil {
+		return nil, err
+	}
+
+	return usecase.webhookRepo.GetWebhookDeliveries(ctx, webhookID, limit, offset)
+}
+
+// ResendWebhookDelivery enqueues a new delivery of the same status transition with fresh retries.
+// The original delivery stays in the log as it is.
+func (usecase *webhookUsecase) ResendWebhookDelivery(
+	ctx context.Context,
+	merchantID, deliveryID string,
+) (*model.WebhookDelivery, error) {
+	usecase.log.Debug("Resend webhook delivery usecase", map[string]interface{}{
+		"merchant_id": merchantID,
+		"delivery_id": deliveryID,
+	})
+
+	delivery, err := usecase.webhookRepo.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := usecase.merchantWebhook(ctx, merchantID, delivery.WebhookID); err != nil {
+		if errors.Is(err, ErrWebhookNotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+
+		return nil, err
+	}
+
+	resent := delivery.Resend(usecase.clock.NowUTC())
+
+	if err := usecase.webhookRepo.CreateWebhookDelivery(ctx, resent); err != nil {
+		return nil, err
+	}
+
+	return resent, nil
+}
+
+// merchantWebhook retrieves the webhook and checks that it belongs to the merchant.
+func (usecase *webhookUsecase) merchantWebhook(ctx context.Context, merchantID, webhookID string) (*model.Webhook, error) {
+	webhook, err := usecase.webhookRepo.GetWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if webhook.MerchantID != merchantID {
+		return nil, ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
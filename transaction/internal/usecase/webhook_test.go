@@ -0,0 +1,186 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+	mock_repo "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testMerchantID           = "test-merchant-id"
+	testWebhookID            = "test-webhook-id"
+	testDeliveryID           = "test-delivery-id"
+	testWebhookTransactionID = "test-transaction-id"
+)
+
+func webhookHelper(t *testing.T) (usecase.WebhookUsecase, *mock_repo.MockWebhookRepo) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+
+	webhookRepo := mock_repo.NewMockWebhookRepo(mockCtrl)
+
+	return usecase.NewWebhookUsecase(webhookRepo, clk, l), webhookRepo
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	deliveries := []*model.WebhookDelivery{{ID: testDeliveryID, WebhookID: testWebhookID}}
+	notFoundErr := repository.NewGetWebhookError("failed to get webhook", repository.ErrWebhookNotFound)
+
+	testcases := []struct {
+		name               string
+		mock               func(*mock_repo.MockWebhookRepo)
+		expectedDeliveries []*model.WebhookDelivery
+		expectedErr        error
+	}{
+		{
+			name: "Successfully list deliveries",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhook(ctx, testWebhookID).Return(&model.Webhook{ID: testWebhookID, MerchantID: testMerchantID}, nil)
+				mwr.EXPECT().GetWebhookDeliveries(ctx, testWebhookID, uint64(20), uint64(40)).Return(deliveries, nil)
+			},
+			expectedDeliveries: deliveries,
+		},
+		{
+			name: "Webhook of another merchant",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhook(ctx, testWebhookID).Return(&model.Webhook{ID: testWebhookID, MerchantID: "other-merchant-id"}, nil)
+			},
+			expectedErr: usecase.ErrWebhookNotFound,
+		},
+		{
+			name: "Webhook not found",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhook(ctx, testWebhookID).Return(nil, notFoundErr)
+			},
+			expectedErr: usecase.ErrWebhookNotFound,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookUsecase, webhookRepo := webhookHelper(t)
+
+			testcase.mock(webhookRepo)
+
+			actualDeliveries, err := webhookUsecase.ListWebhookDeliveries(ctx, testMerchantID, testWebhookID, 20, 40)
+
+			assert.Equal(t, testcase.expectedDeliveries, actualDeliveries)
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestResendWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	delivery := &model.WebhookDelivery{
+		ID:            testDeliveryID,
+		WebhookID:     testWebhookID,
+		TransactionID: testWebhookTransactionID,
+		FromStatus:    model.Processed,
+		ToStatus:      model.Failed,
+		Status:        model.DeliveryDead,
+		Attempts:      8,
+	}
+	someErr := repository.NewCreateWebhookDeliveryError("test err", nil)
+
+	testcases := []struct {
+		name        string
+		mock        func(*mock_repo.MockWebhookRepo)
+		expectedErr error
+	}{
+		{
+			name: "Successfully resend delivery",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhookDelivery(ctx, testDeliveryID).Return(delivery, nil)
+				mwr.EXPECT().GetWebhook(ctx, testWebhookID).Return(&model.Webhook{ID: testWebhookID, MerchantID: testMerchantID}, nil)
+				mwr.EXPECT().CreateWebhookDelivery(ctx, gomock.Cond(func(x any) bool {
+					resent := x.(*model.WebhookDelivery)
+
+					return resent.ID != testDeliveryID &&
+						resent.WebhookID == testWebhookID &&
+						resent.TransactionID == testWebhookTransactionID &&
+						resent.FromStatus == model.Processed &&
+						resent.ToStatus == model.Failed &&
+						resent.Status == model.DeliveryPending &&
+						resent.Attempts == 0 &&
+						resent.NextAttemptAt.Equal(testNow)
+				})).Return(nil)
+			},
+		},
+		{
+			name: "Delivery of another merchant",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhookDelivery(ctx, testDeliveryID).Return(delivery, nil)
+				mwr.EXPECT().GetWebhook(ctx, testWebhookID).Return(&model.Webhook{ID: testWebhookID, MerchantID: "other-merchant-id"}, nil)
+			},
+			expectedErr: usecase.ErrWebhookDeliveryNotFound,
+		},
+		{
+			name: "Delivery not found",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhookDelivery(ctx, testDeliveryID).
+					Return(nil, repository.NewGetWebhookDeliveryError("failed to get webhook delivery", repository.ErrWebhookDeliveryNotFound))
+			},
+			expectedErr: usecase.ErrWebhookDeliveryNotFound,
+		},
+		{
+			name: "Failed to enqueue delivery",
+			mock: func(mwr *mock_repo.MockWebhookRepo) {
+				mwr.EXPECT().GetWebhookDelivery(ctx, testDeliveryID).Return(delivery, nil)
+				mwr.EXPECT().GetWebhook(ctx, testWebhookID).Return(&model.Webhook{ID: testWebhookID, MerchantID: testMerchantID}, nil)
+				mwr.EXPECT().CreateWebhookDelivery(ctx, gomock.Any()).Return(someErr)
+			},
+			expectedErr: someErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			webhookUsecase, webhookRepo := webhookHelper(t)
+
+			testcase.mock(webhookRepo)
+
+			resent, err := webhookUsecase.ResendWebhookDelivery(ctx, testMerchantID, testDeliveryID)
+
+			if testcase.expectedErr != nil {
+				assert.ErrorIs(t, err, testcase.expectedErr)
+				assert.Nil(t, resent)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, resent)
+		})
+	}
+}
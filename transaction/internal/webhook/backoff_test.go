@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	d := &dispatcher{
+		cfg: &Config{
+			InitialBackoff: 30 * time.Second,
+			MaxBackoff:     10 * time.Minute,
+		},
+	}
+
+	testcases := []struct {
+		attempts      int32
+		expectedDelay time.Duration
+	}{
+		{attempts: 1, expectedDelay: 30 * time.Second},
+		{attempts: 2, expectedDelay: time.Minute},
+		{attempts: 3, expectedDelay: 2 * time.Minute},
+		{attempts: 5, expectedDelay: 8 * time.Minute},
+		{attempts: 6, expectedDelay: 10 * time.Minute},
+		{attempts: 100, expectedDelay: 10 * time.Minute},
+	}
+
+	for _, testcase := range testcases {
+		assert.Equal(t, testcase.expectedDelay, d.backoff(testcase.attempts), "attempts %d", testcase.attempts)
+	}
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"dario.cat/mergo"
+)
+
+var ErrNilConfig = errors.New("cannot override nil config")
+
+const (
+	defaultPollInterval   = 5 * time.Second
+	defaultBatchSize      = 50
+	defaultRequestTimeout = 10 * time.Second
+	defaultMaxAttempts    = 8
+	defaultInitialBackoff = 30 * time.Second
+	defaultMaxBackoff     = time.Hour
+)
+
+// Config represents the webhook dispatcher configuration structure.
+type Config struct {
+	// PollInterval is how often due deliveries are looked for.
+	PollInterval time.Duration
+	// BatchSize limits how many deliveries are claimed at once.
+	BatchSize uint64
+	// RequestTimeout limits a single delivery request, it also leases claimed deliveries.
+	RequestTimeout time.Duration
+	// MaxAttempts is the number of attempts after which a delivery is dead.
+	MaxAttempts int32
+	// InitialBackoff is the delay before the first retry, every next retry waits twice as long.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between retries.
+	MaxBackoff time.Duration
+}
+
+func getDefaultConfig() *Config {
+	return &Config{
+		PollInterval:   defaultPollInterval,
+		BatchSize:      defaultBatchSize,
+		RequestTimeout: defaultRequestTimeout,
+		MaxAttempts:    defaultMaxAttempts,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+	}
+}
+
+func mergeWithDefault(cfg *Config) (*Config, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+
+	defaultCfg := getDefaultConfig()
+
+	if err := mergo.Merge(defaultCfg, cfg, mergo.WithOverride); err != nil {
+		return nil, fmt.Errorf("failed to merge configs: %w", err)
+	}
+
+	return defaultCfg, nil
+}
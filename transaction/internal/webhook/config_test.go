@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeWithDefault(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		cfg         *Config
+		expectedCfg *Config
+		expectedErr error
+	}{
+		{
+			name: "With some config",
+			cfg: &Config{
+				MaxAttempts: 3,
+				MaxBackoff:  time.Minute,
+			},
+			expectedCfg: &Config{
+				PollInterval:   defaultPollInterval,
+				BatchSize:      defaultBatchSize,
+				RequestTimeout: defaultRequestTimeout,
+				MaxAttempts:    3,
+				InitialBackoff: defaultInitialBackoff,
+				MaxBackoff:     time.Minute,
+			},
+		},
+		{
+			name:        "With empty config",
+			cfg:         &Config{},
+			expectedCfg: getDefaultConfig(),
+		},
+		{
+			name:        "With nil config",
+			cfg:         nil,
+			expectedCfg: nil,
+			expectedErr: ErrNilConfig,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualCfg, err := mergeWithDefault(testcase.cfg)
+
+			assert.Equal(t, testcase.expectedCfg, actualCfg)
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/logger"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/repository"
+)
+
+// Dispatcher sends the enqueued webhook deliveries to the merchants.
+// Deliveries are claimed with a lease, so dispatchers of several replicas do not send the same delivery.
+type Dispatcher interface {
+	Run(ctx context.Context)
+	Dispatch(ctx context.Context) (int, error)
+}
+
+type dispatcher struct {
+	cfg         *Config
+	webhookRepo repository.WebhookRepo
+	client      *http.Client
+	clock       clock.Clock
+	log         logger.Logger
+}
+
+// NewDispatcher creates a new instance of Dispatcher.
+func NewDispatcher(
+	cfg *Config,
+	webhookRepo repository.WebhookRepo,
+	client *http.Client,
+	clk clock.Clock,
+	log logger.Logger,
+) (Dispatcher, error) {
+	cfg, err := mergeWithDefault(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set default config: %w", err)
+	}
+
+	return &dispatcher{
+		cfg:         cfg,
+		webhookRepo: webhookRepo,
+		client:      client,
+		clock:       clk,
+		log:         log,
+	}, nil
+}
+
+// Run dispatches due deliveries every poll interval until the context is canceled.
+func (d *dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			dispatched, err := d.Dispatch(ctx)
+			if err != nil {
+				d.log.Error("Failed to dispatch webhook deliveries", map[string]interface{}{
+					"error": err,
+				})
+
+				continue
+			}
+
+			if dispatched > 0 {
+				d.log.Debug("Dispatched webhook deliveries", map[string]interface{}{
+					"dispatched": dispatched,
+				})
+			}
+		}
+	}
+}
+
+// Dispatch claims a batch of due deliveries, sends them concurrently and records the outcomes.
+// It returns how many deliveries were attempted.
+func (d *dispatcher) Dispatch(ctx context.Context) (int, error) {
+	now := d.clock.NowUTC()
+
+	// The lease outlives the requests, a dispatcher that crashed mid-batch lets them be retried.
+	deliveries, err := d.webhookRepo.ClaimDueDeliveries(ctx, now, now.Add(2*d.cfg.RequestTimeout), d.cfg.BatchSize)
+	if err != nil {
+		return 0, NewDispatchError("failed to claim due deliveries", err)
+	}
+
+	webhooks := make(map[string]*model.Webhook)
+
+	var wg sync.WaitGroup
+
+	for _, delivery := range deliveries {
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = d.webhookRepo.GetWebhook(ctx, delivery.WebhookID)
+			if err != nil {
+				d.log.Error("Failed to get webhook of delivery", map[string]interface{}{
+					"error":       err,
+					"delivery_id": delivery.ID,
+					"webhook_id":  delivery.WebhookID,
+				})
+
+				continue
+			}
+
+			webhooks[delivery.WebhookID] = webhook
+		}
+
+		wg.Add(1)
+
+		go func(delivery *model.WebhookDelivery) {
+			defer wg.Done()
+
+			d.deliver(ctx, webhook, delivery)
+		}(delivery)
+	}
+
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// deliver sends the delivery once and schedules the next attempt when it failed.
+func (d *dispatcher) deliver(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) {
+	responseCode, err := d.send(ctx, webhook, delivery)
+
+	now := d.clock.NowUTC()
+
+	delivery.Attempts++
+	delivery.LastResponseCode = responseCode
+	delivery.UpdatedAt = now
+
+	switch {
+	case err == nil:
+		delivery.Status = model.DeliverySucceeded
+		delivery.NextAttemptAt = nil
+		delivery.LastError = nil
+		delivery.DeliveredAt = &now
+	case delivery.Attempts >= d.cfg.MaxAttempts:
+		lastError := err.Error()
+
+		delivery.Status = model.DeliveryDead
+		delivery.NextAttemptAt = nil
+		delivery.LastError = &lastError
+	default:
+		lastError := err.Error()
+		nextAttemptAt := now.Add(d.backoff(delivery.Attempts))
+
+		delivery.Status = model.DeliveryPending
+		delivery.NextAttemptAt = &nextAttemptAt
+		delivery.LastError = &lastError
+	}
+
+	d.log.Debug("Webhook delivery attempted", map[string]interface{}{
+		"delivery_id":   delivery.ID,
+		"webhook_id":    webhook.ID,
+		"state":         delivery.Status.String(),
+		"attempts":      delivery.Attempts,
+		"response_code": responseCode,
+		"error":         err,
+	})
+
+	if err := d.webhookRepo.RecordDeliveryAttempt(ctx, delivery); err != nil {
+		d.log.Error("Failed to record webhook delivery attempt", map[string]interface{}{
+			"error":       err,
+			"delivery_id": delivery.ID,
+		})
+	}
+}
+
+// send posts the signed event to the webhook and returns the response code when there was a response.
+// Any status code outside of 2xx fails the attempt.
+func (d *dispatcher) send(ctx context.Context, webhook *model.Webhook, delivery *model.WebhookDelivery) (*int32, error) {
+	body, err := json.Marshal(delivery.Event())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	timestamp := d.clock.NowUnix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Draining the body lets the connection be reused by the next delivery.
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	responseCode := int32(resp.StatusCode)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &responseCode, fmt.Errorf("webhook responded with status code %d", resp.StatusCode)
+	}
+
+	return &responseCode, nil
+}
+
+// backoff returns the delay before the attempt that follows the given one.
+func (d *dispatcher) backoff(attempts int32) time.Duration {
+	delay := d.cfg.InitialBackoff
+
+	for i := int32(1); i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, d.cfg.MaxBackoff)
+}
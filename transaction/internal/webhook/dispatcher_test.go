@@ -0,0 +1,246 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	mock_logger "github.com/ShmelJUJ/software-engineering/pkg/logger/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/model"
+	mock_repository "github.com/ShmelJUJ/software-engineering/transaction/internal/repository/mocks"
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testBatchSize      = 10
+	testMaxAttempts    = 3
+	testInitialBackoff = time.Minute
+	testRequestTimeout = time.Second
+	testWebhookID      = "7a9e1f0b-6d0c-4f5a-9b8e-1c2d3e4f5a6b"
+	testTransactionID  = "3f0c8a5e-8f2d-4a51-a1f4-2d3f1d6c7b10"
+)
+
+var testNow = time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+func dispatcherHelper(t *testing.T) (webhook.Dispatcher, *mock_repository.MockWebhookRepo) {
+	t.Helper()
+
+	mockCtrl := gomock.NewController(t)
+
+	l := mock_logger.NewMockLogger(mockCtrl)
+	l.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	l.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(testNow).AnyTimes()
+	clk.EXPECT().NowUnix().Return(testNow.Unix()).AnyTimes()
+
+	repo := mock_repository.NewMockWebhookRepo(mockCtrl)
+
+	d, err := webhook.NewDispatcher(&webhook.Config{
+		BatchSize:      testBatchSize,
+		RequestTimeout: testRequestTimeout,
+		MaxAttempts:    testMaxAttempts,
+		InitialBackoff: testInitialBackoff,
+		MaxBackoff:     time.Hour,
+	}, repo, &http.Client{}, clk, l)
+	require.NoError(t, err)
+
+	return d, repo
+}
+
+func testDelivery(attempts int32) *model.WebhookDelivery {
+	return &model.WebhookDelivery{
+		ID:            "0d4b8f7c-5b0f-4a41-9d55-8a5d8a0b9e57",
+		WebhookID:     testWebhookID,
+		TransactionID: testTransactionID,
+		FromStatus:    model.Processed,
+		ToStatus:      model.Succeeded,
+		Status:        model.DeliveryPending,
+		Attempts:      attempts,
+		CreatedAt:     testNow.Add(-time.Minute),
+	}
+}
+
+// receiver verifies deliveries like a merchant would and answers with the given status code.
+func receiver(t *testing.T, statusCode int, received *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		assert.NoError(t, webhook.Verify(
+			testSecret,
+			r.Header.Get(webhook.SignatureHeader),
+			r.Header.Get(webhook.TimestampHeader),
+			body,
+			testNow,
+			time.Minute,
+		))
+
+		var event model.WebhookEvent
+		assert.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, r.Header.Get(webhook.DeliveryHeader), event.ID)
+		assert.Equal(t, model.WebhookEventType, event.Type)
+		assert.Equal(t, testTransactionID, event.TransactionID)
+		assert.Equal(t, "succeeded", event.Status)
+		assert.Equal(t, "processed", event.PreviousStatus)
+
+		received.Add(1)
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestDispatch(t *testing.T) {
+	t.Parallel()
+
+	nextAttemptAt := testNow.Add(testInitialBackoff)
+
+	testcases := []struct {
+		name             string
+		attempts         int32
+		statusCode       int
+		unreachable      bool
+		expectedState    model.DeliveryStatus
+		expectedCode     *int32
+		expectedNext     *time.Time
+		expectedError    bool
+		expectedReceived int32
+	}{
+		{
+			name:             "Delivered",
+			statusCode:       http.StatusNoContent,
+			expectedState:    model.DeliverySucceeded,
+			expectedCode:     int32Ptr(http.StatusNoContent),
+			expectedReceived: 1,
+		},
+		{
+			name:             "Retried after error response",
+			statusCode:       http.StatusInternalServerError,
+			expectedState:    model.DeliveryPending,
+			expectedCode:     int32Ptr(http.StatusInternalServerError),
+			expectedNext:     &nextAttemptAt,
+			expectedError:    true,
+			expectedReceived: 1,
+		},
+		{
+			name:          "Retried when unreachable",
+			unreachable:   true,
+			expectedState: model.DeliveryPending,
+			expectedNext:  &nextAttemptAt,
+			expectedError: true,
+		},
+		{
+			name:             "Dead after the last attempt",
+			attempts:         testMaxAttempts - 1,
+			statusCode:       http.StatusGone,
+			expectedState:    model.DeliveryDead,
+			expectedCode:     int32Ptr(http.StatusGone),
+			expectedError:    true,
+			expectedReceived: 1,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, repo := dispatcherHelper(t)
+
+			var received atomic.Int32
+
+			server := receiver(t, testcase.statusCode, &received)
+			if testcase.unreachable {
+				server.Close()
+			}
+
+			delivery := testDelivery(testcase.attempts)
+
+			repo.EXPECT().
+				ClaimDueDeliveries(gomock.Any(), testNow, testNow.Add(2*testRequestTimeout), uint64(testBatchSize)).
+				Return([]*model.WebhookDelivery{delivery}, nil)
+			repo.EXPECT().GetWebhook(gomock.Any(), testWebhookID).Return(&model.Webhook{
+				ID:     testWebhookID,
+				URL:    server.URL,
+				Secret: testSecret,
+				Active: true,
+			}, nil)
+			repo.EXPECT().RecordDeliveryAttempt(gomock.Any(), delivery).Return(nil)
+
+			dispatched, err := d.Dispatch(context.Background())
+			require.NoError(t, err)
+
+			assert.Equal(t, 1, dispatched)
+			assert.Equal(t, testcase.expectedReceived, received.Load())
+			assert.Equal(t, testcase.attempts+1, delivery.Attempts)
+			assert.Equal(t, testcase.expectedState, delivery.Status)
+			assert.Equal(t, testcase.expectedCode, delivery.LastResponseCode)
+			assert.Equal(t, testcase.expectedNext, delivery.NextAttemptAt)
+			assert.Equal(t, testcase.expectedError, delivery.LastError != nil)
+			assert.Equal(t, testcase.expectedState == model.DeliverySucceeded, delivery.DeliveredAt != nil)
+		})
+	}
+}
+
+func TestDispatchSharesWebhookLookups(t *testing.T) {
+	t.Parallel()
+
+	d, repo := dispatcherHelper(t)
+
+	var received atomic.Int32
+
+	server := receiver(t, http.StatusOK, &received)
+
+	first, second := testDelivery(0), testDelivery(0)
+	second.ID = "1d4b8f7c-5b0f-4a41-9d55-8a5d8a0b9e57"
+
+	repo.EXPECT().ClaimDueDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]*model.WebhookDelivery{first, second}, nil)
+	repo.EXPECT().GetWebhook(gomock.Any(), testWebhookID).Return(&model.Webhook{
+		ID:     testWebhookID,
+		URL:    server.URL,
+		Secret: testSecret,
+	}, nil).Times(1)
+	repo.EXPECT().RecordDeliveryAttempt(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	dispatched, err := d.Dispatch(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, dispatched)
+	assert.Equal(t, int32(2), received.Load())
+}
+
+func TestDispatchClaimFailed(t *testing.T) {
+	t.Parallel()
+
+	d, repo := dispatcherHelper(t)
+
+	testErr := errors.New("test err")
+
+	repo.EXPECT().ClaimDueDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, testErr)
+
+	dispatched, err := d.Dispatch(context.Background())
+
+	assert.Zero(t, dispatched)
+	assert.ErrorIs(t, err, testErr)
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
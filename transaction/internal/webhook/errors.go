@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidSignature is returned when the signature does not match the body.
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	// ErrStaleTimestamp is returned when the signed timestamp is outside of the tolerance.
+	ErrStaleTimestamp = errors.New("webhook timestamp is outside of the tolerance")
+)
+
+// DispatchError represents an error encountered while dispatching webhook deliveries.
+type DispatchError struct {
+	msg string
+	err error
+}
+
+// NewDispatchError creates a new DispatchError instance with the provided message and error.
+func NewDispatchError(msg string, err error) *DispatchError {
+	return &DispatchError{
+		msg: msg,
+		err: err,
+	}
+}
+
+func (e DispatchError) Error() string {
+	return fmt.Sprintf("%s: %s", e.msg, e.err.Error())
+}
+
+func (e DispatchError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the delivery.
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader carries the unix time the delivery was signed at.
+	TimestampHeader = "X-Webhook-Timestamp"
+	// DeliveryHeader carries the delivery id, it is stable across retries of the delivery.
+	DeliveryHeader = "X-Webhook-Id"
+
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the signature header value of the body sent at timestamp.
+// The timestamp is signed with the body so that a captured delivery cannot be replayed later.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp header values of a received delivery.
+// Deliveries signed more than tolerance away from now are rejected.
+func Verify(secret, signature, timestamp string, body []byte, now time.Time, tolerance time.Duration) error {
+	signedAt, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrStaleTimestamp
+	}
+
+	if diff := now.Sub(time.Unix(signedAt, 0)); diff > tolerance || diff < -tolerance {
+		return ErrStaleTimestamp
+	}
+
+	if !strings.HasPrefix(signature, signaturePrefix) ||
+		!hmac.Equal([]byte(signature), []byte(Sign(secret, signedAt, body))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package webhook_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/transaction/internal/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "test-webhook-secret"
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"id":"test"}`)
+	signedAt := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	signature := webhook.Sign(testSecret, signedAt.Unix(), body)
+
+	testcases := []struct {
+		name        string
+		secret      string
+		signature   string
+		timestamp   string
+		body        []byte
+		now         time.Time
+		expectedErr error
+	}{
+		{
+			name:      "Valid signature",
+			secret:    testSecret,
+			signature: signature,
+			timestamp: timestamp,
+			body:      body,
+			now:       signedAt.Add(time.Minute),
+		},
+		{
+			name:        "Tampered body",
+			secret:      testSecret,
+			signature:   signature,
+			timestamp:   timestamp,
+			body:        []byte(`{"id":"other"}`),
+			now:         signedAt,
+			expectedErr: webhook.ErrInvalidSignature,
+		},
+		{
+			name:        "Wrong secret",
+			secret:      "other-webhook-secret",
+			signature:   signature,
+			timestamp:   timestamp,
+			body:        body,
+			now:         signedAt,
+			expectedErr: webhook.ErrInvalidSignature,
+		},
+		{
+			name:        "Replayed timestamp",
+			secret:      testSecret,
+			signature:   signature,
+			timestamp:   strconv.FormatInt(signedAt.Add(time.Second).Unix(), 10),
+			body:        body,
+			now:         signedAt,
+			expectedErr: webhook.ErrInvalidSignature,
+		},
+		{
+			name:        "Stale timestamp",
+			secret:      testSecret,
+			signature:   signature,
+			timestamp:   timestamp,
+			body:        body,
+			now:         signedAt.Add(time.Hour),
+			expectedErr: webhook.ErrStaleTimestamp,
+		},
+		{
+			name:        "Malformed timestamp",
+			secret:      testSecret,
+			signature:   signature,
+			timestamp:   "yesterday",
+			body:        body,
+			now:         signedAt,
+			expectedErr: webhook.ErrStaleTimestamp,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := webhook.Verify(
+				testcase.secret,
+				testcase.signature,
+				testcase.timestamp,
+				testcase.body,
+				testcase.now,
+				5*time.Minute,
+			)
+
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transaction_confirmations (
+    transaction_id UUID PRIMARY KEY NOT NULL,
+    method TEXT NOT NULL,
+    code_hash TEXT NOT NULL,
+    transaction_hash TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_confirmations;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS payment_points (
+    payment_point_id UUID PRIMARY KEY NOT NULL,
+    receiver_id UUID NOT NULL,
+    currency TEXT NOT NULL,
+    method TEXT NOT NULL,
+    min_amount BIGINT NULL CHECK (min_amount > 0),
+    max_amount BIGINT NULL CHECK (max_amount > 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    CHECK (min_amount IS NULL OR max_amount IS NULL OR min_amount <= max_amount),
+    FOREIGN KEY (receiver_id) REFERENCES transaction_users(transaction_user_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS payment_point_transactions (
+    transaction_id UUID PRIMARY KEY NOT NULL,
+    payment_point_id UUID NOT NULL,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (payment_point_id) REFERENCES payment_points(payment_point_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS payment_point_transactions_payment_point_id_idx ON payment_point_transactions (payment_point_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS payment_point_transactions;
+
+DROP TABLE IF EXISTS payment_points;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP NULL;
+
+-- Created transactions are looked up by the expiration sweeper.
+CREATE INDEX IF NOT EXISTS transactions_created_expires_at_idx ON transactions (expires_at) WHERE status = 1;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_created_expires_at_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS expires_at;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
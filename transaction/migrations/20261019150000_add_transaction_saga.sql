@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS processed_at TIMESTAMP NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS status_requested_at TIMESTAMP NULL;
+
+-- Transactions already waiting on the payment gateway get their deadline from the last update.
+UPDATE transactions SET processed_at = updated_at WHERE status = 2;
+
+-- Processed transactions are looked up by the saga supervisor.
+CREATE INDEX IF NOT EXISTS transactions_processed_at_idx ON transactions (processed_at) WHERE status = 2;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_processed_at_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS status_requested_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS processed_at;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
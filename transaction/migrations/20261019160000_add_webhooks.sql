@@ -0,0 +1,76 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    webhook_id UUID PRIMARY KEY NOT NULL,
+    merchant_id UUID NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS webhook_endpoints_merchant_id_idx ON webhook_endpoints (merchant_id) WHERE active;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    delivery_id UUID PRIMARY KEY NOT NULL,
+    webhook_id UUID NOT NULL,
+    transaction_id UUID NOT NULL,
+    from_status INT NOT NULL,
+    to_status INT NOT NULL,
+    status INT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NULL,
+    last_response_code INT NULL,
+    last_error TEXT NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (webhook_id) REFERENCES webhook_endpoints(webhook_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- Pending deliveries are looked up by the dispatcher, the log is read newest first.
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, created_at DESC);
+
+-- Every status transition enqueues a delivery to each active webhook of the receiving merchant
+-- in the same database transaction, so no transition is missed whichever code path makes it.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION enqueue_webhook_deliveries() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO webhook_deliveries (
+        delivery_id, webhook_id, transaction_id, from_status, to_status,
+        status, attempts, next_attempt_at, created_at, updated_at
+    )
+    SELECT
+        gen_random_uuid(), w.webhook_id, NEW.transaction_id, OLD.status, NEW.status,
+        1, 0, NOW() AT TIME ZONE 'UTC', NOW() AT TIME ZONE 'UTC', NOW() AT TIME ZONE 'UTC'
+    FROM webhook_endpoints w
+    JOIN transaction_users u ON u.user_id = w.merchant_id
+    WHERE u.transaction_user_id = NEW.receiver_id AND w.active;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER transactions_status_webhooks
+    AFTER UPDATE OF status ON transactions
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status)
+    EXECUTE FUNCTION enqueue_webhook_deliveries();
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS transactions_status_webhooks ON transactions;
+DROP FUNCTION IF EXISTS enqueue_webhook_deliveries();
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transaction_quotes (
+    transaction_id UUID PRIMARY KEY NOT NULL,
+    currency TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    rate TEXT NOT NULL,
+    mid_rate TEXT NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_quotes;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
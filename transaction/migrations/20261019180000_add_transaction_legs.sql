@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transaction_legs (
+    transaction_leg_id UUID PRIMARY KEY NOT NULL,
+    transaction_id UUID NOT NULL,
+    receiver_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    amount BIGINT,
+    share_bps BIGINT,
+    created_at TIMESTAMP NOT NULL,
+
+    UNIQUE (transaction_id, position),
+    CHECK (amount IS NULL OR share_bps IS NULL),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (receiver_id) REFERENCES transaction_users(transaction_user_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_legs;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
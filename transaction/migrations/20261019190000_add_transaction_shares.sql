@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS transaction_shares (
+    share_id UUID PRIMARY KEY NOT NULL,
+    transaction_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    amount BIGINT NOT NULL,
+    payer_id UUID,
+    status INTEGER NOT NULL,
+    refund_id UUID UNIQUE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE (transaction_id, position),
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (payer_id) REFERENCES transaction_users(transaction_user_id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS transaction_shares;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS mandates (
+    mandate_id UUID PRIMARY KEY NOT NULL,
+    payer_id UUID NOT NULL,
+    receiver_id UUID NOT NULL,
+    currency TEXT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    method TEXT NOT NULL,
+    billing_interval INTEGER NOT NULL,
+    interval_count INTEGER NOT NULL CHECK (interval_count > 0),
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    status INTEGER NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    next_charge_at TIMESTAMP NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    charge_transaction_id UUID NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    CHECK (ends_at > starts_at),
+    FOREIGN KEY (payer_id) REFERENCES transaction_users(transaction_user_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (receiver_id) REFERENCES transaction_users(transaction_user_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (charge_transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS mandates_next_charge_at_idx ON mandates (next_charge_at)
+    WHERE next_charge_at IS NOT NULL AND charge_transaction_id IS NULL;
+
+CREATE INDEX IF NOT EXISTS mandates_charge_transaction_id_idx ON mandates (charge_transaction_id)
+    WHERE charge_transaction_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS mandate_transactions (
+    transaction_id UUID PRIMARY KEY NOT NULL,
+    mandate_id UUID NOT NULL,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (mandate_id) REFERENCES mandates(mandate_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS mandate_transactions_mandate_id_idx ON mandate_transactions (mandate_id);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE IF EXISTS mandate_transactions;
+
+DROP TABLE IF EXISTS mandates;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS execute_at TIMESTAMP NULL;
+
+-- Scheduled transactions are looked up by the scheduled transaction executor.
+CREATE INDEX IF NOT EXISTS transactions_scheduled_execute_at_idx ON transactions (execute_at) WHERE status = 8;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_scheduled_execute_at_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS execute_at;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
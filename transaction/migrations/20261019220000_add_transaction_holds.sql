@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS manual_capture BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS captured_amount BIGINT NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS authorized_until TIMESTAMP NULL;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS void_requested_at TIMESTAMP NULL;
+
+-- Authorized transactions are looked up by the hold voider once their hold times out.
+CREATE INDEX IF NOT EXISTS transactions_authorized_until_idx ON transactions (authorized_until) WHERE status = 9;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_authorized_until_idx;
+ALTER TABLE transactions DROP COLUMN IF EXISTS void_requested_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS authorized_until;
+ALTER TABLE transactions DROP COLUMN IF EXISTS captured_amount;
+ALTER TABLE transactions DROP COLUMN IF EXISTS manual_capture;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,101 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS ledger_accounts (
+    account_id TEXT PRIMARY KEY NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('wallet', 'fees', 'clearing')),
+    owner_id UUID NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ledger_accounts_owner_id_idx ON ledger_accounts (owner_id);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    entry_id UUID PRIMARY KEY NOT NULL,
+    seq BIGSERIAL NOT NULL UNIQUE,
+    reference_id UUID NOT NULL,
+    transaction_id UUID NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('hold', 'settle', 'reverse')),
+    created_at TIMESTAMP NOT NULL,
+
+    FOREIGN KEY (transaction_id) REFERENCES transactions(transaction_id)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entries_transaction_id_idx ON ledger_entries (transaction_id, seq);
+
+CREATE TABLE IF NOT EXISTS ledger_postings (
+    entry_id UUID NOT NULL,
+    position INT NOT NULL,
+    account_id TEXT NOT NULL,
+    direction TEXT NOT NULL CHECK (direction IN ('debit', 'credit')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency TEXT NOT NULL,
+
+    PRIMARY KEY (entry_id, position),
+    FOREIGN KEY (entry_id) REFERENCES ledger_entries(entry_id),
+    FOREIGN KEY (account_id) REFERENCES ledger_accounts(account_id)
+);
+
+-- Balances and statements are read by account.
+CREATE INDEX IF NOT EXISTS ledger_postings_account_id_idx ON ledger_postings (account_id, currency);
+
+-- The journal is append only.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION reject_ledger_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'ledger % is append only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER ledger_entries_immutable
+    BEFORE UPDATE OR DELETE ON ledger_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_ledger_change();
+
+CREATE TRIGGER ledger_postings_immutable
+    BEFORE UPDATE OR DELETE ON ledger_postings
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_ledger_change();
+
+-- Every entry must balance debits and credits in each currency once its database transaction commits,
+-- whichever code path wrote it.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION check_ledger_entry_balance() RETURNS TRIGGER AS $$
+BEGIN
+    IF EXISTS (
+        SELECT 1
+        FROM ledger_postings
+        WHERE entry_id = NEW.entry_id
+        GROUP BY currency
+        HAVING SUM(CASE WHEN direction = 'debit' THEN amount ELSE -amount END) <> 0
+    ) OR (SELECT COUNT(*) FROM ledger_postings WHERE entry_id = NEW.entry_id) < 2 THEN
+        RAISE EXCEPTION 'ledger entry % is unbalanced', NEW.entry_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+    AFTER INSERT ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+    EXECUTE FUNCTION check_ledger_entry_balance();
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS ledger_entries_balanced ON ledger_entries;
+DROP TRIGGER IF EXISTS ledger_postings_immutable ON ledger_postings;
+DROP TRIGGER IF EXISTS ledger_entries_immutable ON ledger_entries;
+DROP FUNCTION IF EXISTS check_ledger_entry_balance();
+DROP FUNCTION IF EXISTS reject_ledger_change();
+DROP TABLE IF EXISTS ledger_postings;
+DROP TABLE IF EXISTS ledger_entries;
+DROP TABLE IF EXISTS ledger_accounts;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fee BIGINT NOT NULL DEFAULT 0 CHECK (fee >= 0);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fee_receiver_id UUID NULL
+    REFERENCES transaction_users(transaction_user_id) ON UPDATE CASCADE ON DELETE SET NULL;
+
+CREATE TABLE IF NOT EXISTS fee_plans (
+    fee_plan_id UUID PRIMARY KEY NOT NULL,
+    merchant_id UUID NOT NULL,
+    method TEXT NOT NULL,
+    currency TEXT NOT NULL,
+    fixed_amount BIGINT NOT NULL CHECK (fixed_amount >= 0),
+    percentage_bps BIGINT NOT NULL CHECK (percentage_bps >= 0 AND percentage_bps <= 10000),
+    min_fee BIGINT NULL CHECK (min_fee >= 0),
+    max_fee BIGINT NULL CHECK (max_fee >= 0),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+
+    UNIQUE (merchant_id, method, currency),
+    CHECK (min_fee IS NULL OR max_fee IS NULL OR min_fee <= max_fee)
+);
+
+CREATE TABLE IF NOT EXISTS fee_plan_tiers (
+    fee_plan_id UUID NOT NULL,
+    from_volume BIGINT NOT NULL CHECK (from_volume > 0),
+    percentage_bps BIGINT NOT NULL CHECK (percentage_bps >= 0 AND percentage_bps <= 10000),
+
+    PRIMARY KEY (fee_plan_id, from_volume),
+    FOREIGN KEY (fee_plan_id) REFERENCES fee_plans(fee_plan_id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+-- The monthly volume of a merchant is summed over its succeeded transactions when a fee is calculated.
+CREATE INDEX IF NOT EXISTS transactions_succeeded_processed_at_idx ON transactions (processed_at) WHERE status = 5;
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS transactions_succeeded_processed_at_idx;
+
+DROP TABLE IF EXISTS fee_plan_tiers;
+
+DROP TABLE IF EXISTS fee_plans;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS fee_receiver_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS fee;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
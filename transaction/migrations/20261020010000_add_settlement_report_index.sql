@@ -0,0 +1,14 @@
+-- +goose Up
+-- Settlement reports read the settle entries of a day.
+CREATE INDEX IF NOT EXISTS ledger_entries_kind_created_at_idx ON ledger_entries (kind, created_at);
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+DROP INDEX IF EXISTS ledger_entries_kind_created_at_idx;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS watch BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Watch transactions are paid from wallets outside of the platform, booked against the external account.
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_kind_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_kind_check CHECK (kind IN ('wallet', 'fees', 'clearing', 'external'));
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE ledger_accounts DROP CONSTRAINT IF EXISTS ledger_accounts_kind_check;
+ALTER TABLE ledger_accounts ADD CONSTRAINT ledger_accounts_kind_check CHECK (kind IN ('wallet', 'fees', 'clearing'));
+ALTER TABLE transactions DROP COLUMN IF EXISTS watch;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
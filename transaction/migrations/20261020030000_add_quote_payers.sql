@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE transaction_quotes ADD COLUMN IF NOT EXISTS payer_id TEXT NOT NULL DEFAULT '';
+
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+-- +goose Down
+ALTER TABLE transaction_quotes DROP COLUMN IF EXISTS payer_id;
+
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"flag"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/go-faster/errors"
@@ -12,8 +14,14 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/ShmelJUJ/software-engineering/pkg/redis"
 	gen "github.com/ShmelJUJ/software-engineering/user/gen"
 	"github.com/ShmelJUJ/software-engineering/user/internal/api"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/lockout"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/token"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/totp"
 	"github.com/ShmelJUJ/software-engineering/user/internal/httpmiddleware"
 )
 
@@ -22,15 +30,67 @@ const shutdownTimeout = 15 * time.Second
 func main() {
 	app.Run(func(ctx context.Context, lg *zap.Logger, m *app.Metrics) error {
 		var arg struct {
-			Addr string
+			Addr             string
+			TokenIssuer      string
+			AccessTokenTTL   time.Duration
+			RefreshTokenTTL  time.Duration
+			ChallengeTTL     time.Duration
+			TOTPIssuer       string
+			KeyRotationEvery time.Duration
+			SigningKeyFile   string
+			RedisURL         string
+			Lockout          lockout.Config
 		}
 		flag.StringVar(&arg.Addr, "addr", ":8080", "listen address") // TODO наебашить конфиг
+		flag.StringVar(&arg.TokenIssuer, "token-issuer", "user", "issuer of auth tokens")
+		flag.DurationVar(&arg.AccessTokenTTL, "access-token-ttl", 15*time.Minute, "auth token lifetime")
+		flag.DurationVar(&arg.RefreshTokenTTL, "refresh-token-ttl", 30*24*time.Hour, "refresh token lifetime")
+		flag.DurationVar(&arg.ChallengeTTL, "challenge-token-ttl", 5*time.Minute, "time to enter the second factor after the password")
+		flag.StringVar(&arg.TOTPIssuer, "totp-issuer", "QR Payment", "issuer shown in authenticator apps")
+		flag.DurationVar(&arg.KeyRotationEvery, "key-rotation-interval", 24*time.Hour, "signing key rotation interval")
+		flag.StringVar(&arg.SigningKeyFile, "signing-key-file", "/run/secrets/jwt_signing_key", "file with the secret the signing keys are derived from, shared by all replicas")
+		flag.StringVar(&arg.RedisURL, "redis-url", "redis://user_redis:6379/0", "redis connection url")
+		flag.Int64Var(&arg.Lockout.MaxAttempts, "login-max-attempts", 5, "failed logins per account before lockout")
+		flag.Int64Var(&arg.Lockout.MaxIPAttempts, "login-max-ip-attempts", 20, "failed logins per ip address before lockout")
+		flag.DurationVar(&arg.Lockout.Window, "login-attempts-window", 15*time.Minute, "period in which failed logins are counted")
+		flag.DurationVar(&arg.Lockout.BaseLockout, "login-lockout", time.Minute, "first lockout duration, doubled on every next lockout")
+		flag.DurationVar(&arg.Lockout.MaxLockout, "login-max-lockout", 24*time.Hour, "maximum lockout duration")
+		flag.DurationVar(&arg.Lockout.EscalationWindow, "login-lockout-memory", 24*time.Hour, "how long previous lockouts are remembered")
 		flag.Parse()
 
 		lg.Info("Initializing",
 			zap.String("http.addr", arg.Addr),
 		)
-		oasServer, err := gen.NewServer(api.Handler{},
+
+		clk := clock.New()
+		secret, err := os.ReadFile(arg.SigningKeyFile)
+		if err != nil {
+			return errors.Wrap(err, "read signing key secret")
+		}
+		// Retired keys are kept as long as the refresh tokens they signed stay valid.
+		keys, err := jwt.NewKeySet(bytes.TrimSpace(secret), clk, arg.KeyRotationEvery, arg.RefreshTokenTTL)
+		if err != nil {
+			return errors.Wrap(err, "key set init")
+		}
+		issuer := token.NewIssuer(token.Config{
+			Issuer:       arg.TokenIssuer,
+			AccessTTL:    arg.AccessTokenTTL,
+			RefreshTTL:   arg.RefreshTokenTTL,
+			ChallengeTTL: arg.ChallengeTTL,
+		}, keys, clk)
+
+		r, err := redis.New(ctx, &redis.Config{
+			ConnURL: arg.RedisURL,
+		})
+		if err != nil {
+			return errors.Wrap(err, "redis init")
+		}
+		defer r.Close()
+		store := lockout.NewRedisStore(r.Client)
+		guard := lockout.NewGuard(arg.Lockout, store)
+		authenticator := totp.NewAuthenticator(arg.TOTPIssuer, store, clk)
+
+		oasServer, err := gen.NewServer(api.NewHandler(issuer, guard, authenticator),
 			gen.WithTracerProvider(m.TracerProvider()),
 		)
 		if err != nil {
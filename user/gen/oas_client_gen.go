@@ -23,6 +23,12 @@ import (
 
 // Invoker invokes operations described by OpenAPI v3 specification.
 type Invoker interface {
+	// ConfirmTOTPEnrollment invokes ConfirmTOTPEnrollment operation.
+	//
+	// Enables two-factor authentication once the first code from the authenticator app is valid.
+	//
+	// POST /user/internal/v1/clients/{client_id}/totp/confirm
+	ConfirmTOTPEnrollment(ctx context.Context, request OptTOTPCode, params ConfirmTOTPEnrollmentParams) (ConfirmTOTPEnrollmentRes, error)
 	// GetAuthToken invokes GetAuthToken operation.
 	//
 	// POST /user/internal/v1/clients/auth
@@ -31,10 +37,36 @@ type Invoker interface {
 	//
 	// GET /user/internal/v1/clients/{client_id}
 	GetClientById(ctx context.Context, params GetClientByIdParams) (GetClientByIdRes, error)
+	// GetJWKS invokes GetJWKS operation.
+	//
+	// GET /user/internal/v1/.well-known/jwks.json
+	GetJWKS(ctx context.Context) (GetJWKSRes, error)
 	// GetWalletById invokes GetWalletById operation.
 	//
 	// GET /user/internal/v1/clients/{client_id}/wallets/{wallet_id}
 	GetWalletById(ctx context.Context, params GetWalletByIdParams) (GetWalletByIdRes, error)
+	// RefreshAuthToken invokes RefreshAuthToken operation.
+	//
+	// POST /user/internal/v1/clients/auth/refresh
+	RefreshAuthToken(ctx context.Context, request OptRefreshRequest) (RefreshAuthTokenRes, error)
+	// StartTOTPEnrollment invokes StartTOTPEnrollment operation.
+	//
+	// Generates a new TOTP secret, it is not used until the enrollment is confirmed.
+	//
+	// POST /user/internal/v1/clients/{client_id}/totp
+	StartTOTPEnrollment(ctx context.Context, params StartTOTPEnrollmentParams) (StartTOTPEnrollmentRes, error)
+	// UnlockLogin invokes UnlockLogin operation.
+	//
+	// Removes login lockout of the account and optionally of the ip address.
+	//
+	// POST /user/internal/v1/clients/auth/unlock
+	UnlockLogin(ctx context.Context, request OptUnlockRequest) (UnlockLoginRes, error)
+	// VerifyTOTPChallenge invokes VerifyTOTPChallenge operation.
+	//
+	// Second login step, accepts a TOTP code or an unused recovery code.
+	//
+	// POST /user/internal/v1/clients/auth/totp
+	VerifyTOTPChallenge(ctx context.Context, request OptTOTPChallengeRequest) (VerifyTOTPChallengeRes, error)
 }
 
 // Client implements OAS client.
@@ -85,6 +117,100 @@ func (c *Client) requestURL(ctx context.Context) *url.URL {
 	return u
 }
 
+// ConfirmTOTPEnrollment invokes ConfirmTOTPEnrollment operation.
+//
+// Enables two-factor authentication once the first code from the authenticator app is valid.
+//
+// POST /user/internal/v1/clients/{client_id}/totp/confirm
+func (c *Client) ConfirmTOTPEnrollment(ctx context.Context, request OptTOTPCode, params ConfirmTOTPEnrollmentParams) (ConfirmTOTPEnrollmentRes, error) {
+	res, err := c.sendConfirmTOTPEnrollment(ctx, request, params)
+	return res, err
+}
+
+func (c *Client) sendConfirmTOTPEnrollment(ctx context.Context, request OptTOTPCode, params ConfirmTOTPEnrollmentParams) (res ConfirmTOTPEnrollmentRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("ConfirmTOTPEnrollment"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user/internal/v1/clients/{client_id}/totp/confirm"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "ConfirmTOTPEnrollment",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/user/internal/v1/clients/"
+	{
+		// Encode "client_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "client_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ClientID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/totp/confirm"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeConfirmTOTPEnrollmentRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeConfirmTOTPEnrollmentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // GetAuthToken invokes GetAuthToken operation.
 //
 // POST /user/internal/v1/clients/auth
@@ -246,6 +372,76 @@ func (c *Client) sendGetClientById(ctx context.Context, params GetClientByIdPara
 	return result, nil
 }
 
+// GetJWKS invokes GetJWKS operation.
+//
+// GET /user/internal/v1/.well-known/jwks.json
+func (c *Client) GetJWKS(ctx context.Context) (GetJWKSRes, error) {
+	res, err := c.sendGetJWKS(ctx)
+	return res, err
+}
+
+func (c *Client) sendGetJWKS(ctx context.Context) (res GetJWKSRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("GetJWKS"),
+		semconv.HTTPMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user/internal/v1/.well-known/jwks.json"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "GetJWKS",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/user/internal/v1/.well-known/jwks.json"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "GET", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeGetJWKSResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
 // GetWalletById invokes GetWalletById operation.
 //
 // GET /user/internal/v1/clients/{client_id}/wallets/{wallet_id}
@@ -352,3 +548,317 @@ func (c *Client) sendGetWalletById(ctx context.Context, params GetWalletByIdPara
 
 	return result, nil
 }
+
+// RefreshAuthToken invokes RefreshAuthToken operation.
+//
+// POST /user/internal/v1/clients/auth/refresh
+func (c *Client) RefreshAuthToken(ctx context.Context, request OptRefreshRequest) (RefreshAuthTokenRes, error) {
+	res, err := c.sendRefreshAuthToken(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendRefreshAuthToken(ctx context.Context, request OptRefreshRequest) (res RefreshAuthTokenRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("RefreshAuthToken"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user/internal/v1/clients/auth/refresh"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "RefreshAuthToken",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/user/internal/v1/clients/auth/refresh"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeRefreshAuthTokenRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeRefreshAuthTokenResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// StartTOTPEnrollment invokes StartTOTPEnrollment operation.
+//
+// Generates a new TOTP secret, it is not used until the enrollment is confirmed.
+//
+// POST /user/internal/v1/clients/{client_id}/totp
+func (c *Client) StartTOTPEnrollment(ctx context.Context, params StartTOTPEnrollmentParams) (StartTOTPEnrollmentRes, error) {
+	res, err := c.sendStartTOTPEnrollment(ctx, params)
+	return res, err
+}
+
+func (c *Client) sendStartTOTPEnrollment(ctx context.Context, params StartTOTPEnrollmentParams) (res StartTOTPEnrollmentRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("StartTOTPEnrollment"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user/internal/v1/clients/{client_id}/totp"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "StartTOTPEnrollment",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [3]string
+	pathParts[0] = "/user/internal/v1/clients/"
+	{
+		// Encode "client_id" parameter.
+		e := uri.NewPathEncoder(uri.PathEncoderConfig{
+			Param:   "client_id",
+			Style:   uri.PathStyleSimple,
+			Explode: false,
+		})
+		if err := func() error {
+			return e.EncodeValue(conv.StringToString(params.ClientID))
+		}(); err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		encoded, err := e.Result()
+		if err != nil {
+			return res, errors.Wrap(err, "encode path")
+		}
+		pathParts[1] = encoded
+	}
+	pathParts[2] = "/totp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeStartTOTPEnrollmentResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// UnlockLogin invokes UnlockLogin operation.
+//
+// Removes login lockout of the account and optionally of the ip address.
+//
+// POST /user/internal/v1/clients/auth/unlock
+func (c *Client) UnlockLogin(ctx context.Context, request OptUnlockRequest) (UnlockLoginRes, error) {
+	res, err := c.sendUnlockLogin(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendUnlockLogin(ctx context.Context, request OptUnlockRequest) (res UnlockLoginRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("UnlockLogin"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user/internal/v1/clients/auth/unlock"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "UnlockLogin",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/user/internal/v1/clients/auth/unlock"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeUnlockLoginRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeUnlockLoginResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
+
+// VerifyTOTPChallenge invokes VerifyTOTPChallenge operation.
+//
+// Second login step, accepts a TOTP code or an unused recovery code.
+//
+// POST /user/internal/v1/clients/auth/totp
+func (c *Client) VerifyTOTPChallenge(ctx context.Context, request OptTOTPChallengeRequest) (VerifyTOTPChallengeRes, error) {
+	res, err := c.sendVerifyTOTPChallenge(ctx, request)
+	return res, err
+}
+
+func (c *Client) sendVerifyTOTPChallenge(ctx context.Context, request OptTOTPChallengeRequest) (res VerifyTOTPChallengeRes, err error) {
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("VerifyTOTPChallenge"),
+		semconv.HTTPMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user/internal/v1/clients/auth/totp"),
+	}
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		elapsedDuration := time.Since(startTime)
+		c.duration.Record(ctx, float64(float64(elapsedDuration)/float64(time.Millisecond)), metric.WithAttributes(otelAttrs...))
+	}()
+
+	// Increment request counter.
+	c.requests.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+
+	// Start a span for this request.
+	ctx, span := c.cfg.Tracer.Start(ctx, "VerifyTOTPChallenge",
+		trace.WithAttributes(otelAttrs...),
+		clientSpanKind,
+	)
+	// Track stage for error reporting.
+	var stage string
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, stage)
+			c.errors.Add(ctx, 1, metric.WithAttributes(otelAttrs...))
+		}
+		span.End()
+	}()
+
+	stage = "BuildURL"
+	u := uri.Clone(c.requestURL(ctx))
+	var pathParts [1]string
+	pathParts[0] = "/user/internal/v1/clients/auth/totp"
+	uri.AddPathParts(u, pathParts[:]...)
+
+	stage = "EncodeRequest"
+	r, err := ht.NewRequest(ctx, "POST", u)
+	if err != nil {
+		return res, errors.Wrap(err, "create request")
+	}
+	if err := encodeVerifyTOTPChallengeRequest(request, r); err != nil {
+		return res, errors.Wrap(err, "encode request")
+	}
+
+	stage = "SendRequest"
+	resp, err := c.cfg.Client.Do(r)
+	if err != nil {
+		return res, errors.Wrap(err, "do request")
+	}
+	defer resp.Body.Close()
+
+	stage = "DecodeResponse"
+	result, err := decodeVerifyTOTPChallengeResponse(resp)
+	if err != nil {
+		return res, errors.Wrap(err, "decode response")
+	}
+
+	return result, nil
+}
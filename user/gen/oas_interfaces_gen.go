@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package user
 
+type ConfirmTOTPEnrollmentRes interface {
+	confirmTOTPEnrollmentRes()
+}
+
 type GetAuthTokenRes interface {
 	getAuthTokenRes()
 }
@@ -9,6 +13,26 @@ type GetClientByIdRes interface {
 	getClientByIdRes()
 }
 
+type GetJWKSRes interface {
+	getJWKSRes()
+}
+
 type GetWalletByIdRes interface {
 	getWalletByIdRes()
 }
+
+type RefreshAuthTokenRes interface {
+	refreshAuthTokenRes()
+}
+
+type StartTOTPEnrollmentRes interface {
+	startTOTPEnrollmentRes()
+}
+
+type UnlockLoginRes interface {
+	unlockLoginRes()
+}
+
+type VerifyTOTPChallengeRes interface {
+	verifyTOTPChallengeRes()
+}
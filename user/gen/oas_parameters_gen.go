@@ -15,6 +15,72 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// ConfirmTOTPEnrollmentParams is parameters of ConfirmTOTPEnrollment operation.
+type ConfirmTOTPEnrollmentParams struct {
+	// Идентификатор пользователя.
+	ClientID string
+}
+
+func unpackConfirmTOTPEnrollmentParams(packed middleware.Parameters) (params ConfirmTOTPEnrollmentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "client_id",
+			In:   "path",
+		}
+		params.ClientID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeConfirmTOTPEnrollmentParams(args [1]string, argsEscaped bool, r *http.Request) (params ConfirmTOTPEnrollmentParams, _ error) {
+	// Decode path: client_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "client_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ClientID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "client_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // GetClientByIdParams is parameters of GetClientById operation.
 type GetClientByIdParams struct {
 	// Идентификатор.
@@ -200,3 +266,69 @@ func decodeGetWalletByIdParams(args [2]string, argsEscaped bool, r *http.Request
 	}
 	return params, nil
 }
+
+// StartTOTPEnrollmentParams is parameters of StartTOTPEnrollment operation.
+type StartTOTPEnrollmentParams struct {
+	// Идентификатор пользователя.
+	ClientID string
+}
+
+func unpackStartTOTPEnrollmentParams(packed middleware.Parameters) (params StartTOTPEnrollmentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "client_id",
+			In:   "path",
+		}
+		params.ClientID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeStartTOTPEnrollmentParams(args [1]string, argsEscaped bool, r *http.Request) (params StartTOTPEnrollmentParams, _ error) {
+	// Decode path: client_id.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "client_id",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ClientID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "client_id",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
@@ -49,9 +49,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/user/internal/v1/clients/"
+		case '/': // Prefix: "/user/internal/v1/"
 			origElem := elem
-			if l := len("/user/internal/v1/clients/"); len(elem) >= l && elem[0:l] == "/user/internal/v1/clients/" {
+			if l := len("/user/internal/v1/"); len(elem) >= l && elem[0:l] == "/user/internal/v1/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -61,9 +61,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth"
+			case '.': // Prefix: ".well-known/jwks.json"
 				origElem := elem
-				if l := len("auth"); len(elem) >= l && elem[0:l] == "auth" {
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
 					elem = elem[l:]
 				} else {
 					break
@@ -72,59 +72,143 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch r.Method {
-					case "POST":
-						s.handleGetAuthTokenRequest([0]string{}, elemIsEscaped, w, r)
+					case "GET":
+						s.handleGetJWKSRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "POST")
+						s.notAllowed(w, r, "GET")
 					}
 
 					return
 				}
 
 				elem = origElem
-			}
-			// Param: "client_id"
-			// Match until "/"
-			idx := strings.IndexByte(elem, '/')
-			if idx < 0 {
-				idx = len(elem)
-			}
-			args[0] = elem[:idx]
-			elem = elem[idx:]
-
-			if len(elem) == 0 {
-				switch r.Method {
-				case "GET":
-					s.handleGetClientByIdRequest([1]string{
-						args[0],
-					}, elemIsEscaped, w, r)
-				default:
-					s.notAllowed(w, r, "GET")
-				}
-
-				return
-			}
-			switch elem[0] {
-			case '/': // Prefix: "/wallets/"
+			case 'c': // Prefix: "clients/"
 				origElem := elem
-				if l := len("/wallets/"); len(elem) >= l && elem[0:l] == "/wallets/" {
+				if l := len("clients/"); len(elem) >= l && elem[0:l] == "clients/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "wallet_id"
-				// Leaf parameter
-				args[1] = elem
-				elem = ""
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "auth"
+					origElem := elem
+					if l := len("auth"); len(elem) >= l && elem[0:l] == "auth" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleGetAuthTokenRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'r': // Prefix: "refresh"
+							origElem := elem
+							if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRefreshAuthTokenRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 't': // Prefix: "totp"
+							origElem := elem
+							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleVerifyTOTPChallengeRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'u': // Prefix: "unlock"
+							origElem := elem
+							if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleUnlockLoginRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+				// Param: "client_id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
-						s.handleGetWalletByIdRequest([2]string{
+						s.handleGetClientByIdRequest([1]string{
 							args[0],
-							args[1],
 						}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET")
@@ -132,6 +216,99 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 't': // Prefix: "totp"
+						origElem := elem
+						if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "POST":
+								s.handleStartTOTPEnrollmentRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/confirm"
+							origElem := elem
+							if l := len("/confirm"); len(elem) >= l && elem[0:l] == "/confirm" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleConfirmTOTPEnrollmentRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 'w': // Prefix: "wallets/"
+						origElem := elem
+						if l := len("wallets/"); len(elem) >= l && elem[0:l] == "wallets/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "wallet_id"
+						// Leaf parameter
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetWalletByIdRequest([2]string{
+									args[0],
+									args[1],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			}
@@ -217,9 +394,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/user/internal/v1/clients/"
+		case '/': // Prefix: "/user/internal/v1/"
 			origElem := elem
-			if l := len("/user/internal/v1/clients/"); len(elem) >= l && elem[0:l] == "/user/internal/v1/clients/" {
+			if l := len("/user/internal/v1/"); len(elem) >= l && elem[0:l] == "/user/internal/v1/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -229,9 +406,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth"
+			case '.': // Prefix: ".well-known/jwks.json"
 				origElem := elem
-				if l := len("auth"); len(elem) >= l && elem[0:l] == "auth" {
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
 					elem = elem[l:]
 				} else {
 					break
@@ -239,12 +416,12 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 				if len(elem) == 0 {
 					switch method {
-					case "POST":
-						// Leaf: GetAuthToken
-						r.name = "GetAuthToken"
+					case "GET":
+						// Leaf: GetJWKS
+						r.name = "GetJWKS"
 						r.summary = ""
-						r.operationID = "GetAuthToken"
-						r.pathPattern = "/user/internal/v1/clients/auth"
+						r.operationID = "GetJWKS"
+						r.pathPattern = "/user/internal/v1/.well-known/jwks.json"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -254,59 +431,256 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			}
-			// Param: "client_id"
-			// Match until "/"
-			idx := strings.IndexByte(elem, '/')
-			if idx < 0 {
-				idx = len(elem)
-			}
-			args[0] = elem[:idx]
-			elem = elem[idx:]
-
-			if len(elem) == 0 {
-				switch method {
-				case "GET":
-					r.name = "GetClientById"
-					r.summary = ""
-					r.operationID = "GetClientById"
-					r.pathPattern = "/user/internal/v1/clients/{client_id}"
-					r.args = args
-					r.count = 1
-					return r, true
-				default:
-					return
-				}
-			}
-			switch elem[0] {
-			case '/': // Prefix: "/wallets/"
+			case 'c': // Prefix: "clients/"
 				origElem := elem
-				if l := len("/wallets/"); len(elem) >= l && elem[0:l] == "/wallets/" {
+				if l := len("clients/"); len(elem) >= l && elem[0:l] == "clients/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
-				// Param: "wallet_id"
-				// Leaf parameter
-				args[1] = elem
-				elem = ""
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "auth"
+					origElem := elem
+					if l := len("auth"); len(elem) >= l && elem[0:l] == "auth" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = "GetAuthToken"
+							r.summary = ""
+							r.operationID = "GetAuthToken"
+							r.pathPattern = "/user/internal/v1/clients/auth"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'r': // Prefix: "refresh"
+							origElem := elem
+							if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: RefreshAuthToken
+									r.name = "RefreshAuthToken"
+									r.summary = ""
+									r.operationID = "RefreshAuthToken"
+									r.pathPattern = "/user/internal/v1/clients/auth/refresh"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 't': // Prefix: "totp"
+							origElem := elem
+							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: VerifyTOTPChallenge
+									r.name = "VerifyTOTPChallenge"
+									r.summary = ""
+									r.operationID = "VerifyTOTPChallenge"
+									r.pathPattern = "/user/internal/v1/clients/auth/totp"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'u': // Prefix: "unlock"
+							origElem := elem
+							if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: UnlockLogin
+									r.name = "UnlockLogin"
+									r.summary = ""
+									r.operationID = "UnlockLogin"
+									r.pathPattern = "/user/internal/v1/clients/auth/unlock"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+				// Param: "client_id"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						// Leaf: GetWalletById
-						r.name = "GetWalletById"
+						r.name = "GetClientById"
 						r.summary = ""
-						r.operationID = "GetWalletById"
-						r.pathPattern = "/user/internal/v1/clients/{client_id}/wallets/{wallet_id}"
+						r.operationID = "GetClientById"
+						r.pathPattern = "/user/internal/v1/clients/{client_id}"
 						r.args = args
-						r.count = 2
+						r.count = 1
 						return r, true
 					default:
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 't': // Prefix: "totp"
+						origElem := elem
+						if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = "StartTOTPEnrollment"
+								r.summary = ""
+								r.operationID = "StartTOTPEnrollment"
+								r.pathPattern = "/user/internal/v1/clients/{client_id}/totp"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/confirm"
+							origElem := elem
+							if l := len("/confirm"); len(elem) >= l && elem[0:l] == "/confirm" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: ConfirmTOTPEnrollment
+									r.name = "ConfirmTOTPEnrollment"
+									r.summary = ""
+									r.operationID = "ConfirmTOTPEnrollment"
+									r.pathPattern = "/user/internal/v1/clients/{client_id}/totp/confirm"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 'w': // Prefix: "wallets/"
+						origElem := elem
+						if l := len("wallets/"); len(elem) >= l && elem[0:l] == "wallets/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "wallet_id"
+						// Leaf parameter
+						args[1] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetWalletById
+								r.name = "GetWalletById"
+								r.summary = ""
+								r.operationID = "GetWalletById"
+								r.pathPattern = "/user/internal/v1/clients/{client_id}/wallets/{wallet_id}"
+								r.args = args
+								r.count = 2
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
 
 				elem = origElem
 			}
@@ -10,6 +10,8 @@ import (
 type AuthRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// Address the login attempt came from.
+	ClientIP OptString `json:"client_ip"`
 }
 
 // GetEmail returns the value of Email.
@@ -22,6 +24,11 @@ func (s *AuthRequest) GetPassword() string {
 	return s.Password
 }
 
+// GetClientIP returns the value of ClientIP.
+func (s *AuthRequest) GetClientIP() OptString {
+	return s.ClientIP
+}
+
 // SetEmail sets the value of Email.
 func (s *AuthRequest) SetEmail(val string) {
 	s.Email = val
@@ -32,9 +39,20 @@ func (s *AuthRequest) SetPassword(val string) {
 	s.Password = val
 }
 
+// SetClientIP sets the value of ClientIP.
+func (s *AuthRequest) SetClientIP(val OptString) {
+	s.ClientIP = val
+}
+
 // Ref: #/components/schemas/AuthResponse
 type AuthResponse struct {
+	// Short-lived access token.
 	AuthToken string `json:"auth_token"`
+	// Long-lived token used to get a new access token.
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	// Access token lifetime in seconds.
+	ExpiresIn int64 `json:"expires_in"`
 }
 
 // GetAuthToken returns the value of AuthToken.
@@ -42,12 +60,86 @@ func (s *AuthResponse) GetAuthToken() string {
 	return s.AuthToken
 }
 
+// GetRefreshToken returns the value of RefreshToken.
+func (s *AuthResponse) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// GetTokenType returns the value of TokenType.
+func (s *AuthResponse) GetTokenType() string {
+	return s.TokenType
+}
+
+// GetExpiresIn returns the value of ExpiresIn.
+func (s *AuthResponse) GetExpiresIn() int64 {
+	return s.ExpiresIn
+}
+
 // SetAuthToken sets the value of AuthToken.
 func (s *AuthResponse) SetAuthToken(val string) {
 	s.AuthToken = val
 }
 
-func (*AuthResponse) getAuthTokenRes() {}
+// SetRefreshToken sets the value of RefreshToken.
+func (s *AuthResponse) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+// SetTokenType sets the value of TokenType.
+func (s *AuthResponse) SetTokenType(val string) {
+	s.TokenType = val
+}
+
+// SetExpiresIn sets the value of ExpiresIn.
+func (s *AuthResponse) SetExpiresIn(val int64) {
+	s.ExpiresIn = val
+}
+
+func (*AuthResponse) getAuthTokenRes()        {}
+func (*AuthResponse) refreshAuthTokenRes()    {}
+func (*AuthResponse) verifyTOTPChallengeRes() {}
+
+// Ref: #/components/schemas/ChallengeResponse
+type ChallengeResponse struct {
+	// Short-lived token to pass with the second factor.
+	ChallengeToken string `json:"challenge_token"`
+	// Challenge token lifetime in seconds.
+	ChallengeExpiresIn int64 `json:"challenge_expires_in"`
+}
+
+// GetChallengeToken returns the value of ChallengeToken.
+func (s *ChallengeResponse) GetChallengeToken() string {
+	return s.ChallengeToken
+}
+
+// GetChallengeExpiresIn returns the value of ChallengeExpiresIn.
+func (s *ChallengeResponse) GetChallengeExpiresIn() int64 {
+	return s.ChallengeExpiresIn
+}
+
+// SetChallengeToken sets the value of ChallengeToken.
+func (s *ChallengeResponse) SetChallengeToken(val string) {
+	s.ChallengeToken = val
+}
+
+// SetChallengeExpiresIn sets the value of ChallengeExpiresIn.
+func (s *ChallengeResponse) SetChallengeExpiresIn(val int64) {
+	s.ChallengeExpiresIn = val
+}
+
+func (*ChallengeResponse) getAuthTokenRes() {}
+
+type ConfirmTOTPEnrollmentBadRequest Error
+
+func (*ConfirmTOTPEnrollmentBadRequest) confirmTOTPEnrollmentRes() {}
+
+type ConfirmTOTPEnrollmentInternalServerError Error
+
+func (*ConfirmTOTPEnrollmentInternalServerError) confirmTOTPEnrollmentRes() {}
+
+type ConfirmTOTPEnrollmentNotFound Error
+
+func (*ConfirmTOTPEnrollmentNotFound) confirmTOTPEnrollmentRes() {}
 
 // Ref: #/components/schemas/Error
 type Error struct {
@@ -77,21 +169,19 @@ func (s *Error) SetCode(val string) {
 	s.Code = val
 }
 
+func (*Error) getJWKSRes() {}
+
 type GetAuthTokenBadRequest Error
 
 func (*GetAuthTokenBadRequest) getAuthTokenRes() {}
 
-type GetAuthTokenForbidden Error
-
-func (*GetAuthTokenForbidden) getAuthTokenRes() {}
-
 type GetAuthTokenInternalServerError Error
 
 func (*GetAuthTokenInternalServerError) getAuthTokenRes() {}
 
-type GetAuthTokenNotFound Error
+type GetAuthTokenUnauthorized Error
 
-func (*GetAuthTokenNotFound) getAuthTokenRes() {}
+func (*GetAuthTokenUnauthorized) getAuthTokenRes() {}
 
 type GetClientByIdBadRequest Error
 
@@ -117,6 +207,136 @@ type GetWalletByIdNotFound Error
 
 func (*GetWalletByIdNotFound) getWalletByIdRes() {}
 
+// Ref: #/components/schemas/JWK
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// GetKty returns the value of Kty.
+func (s *JWK) GetKty() string {
+	return s.Kty
+}
+
+// GetCrv returns the value of Crv.
+func (s *JWK) GetCrv() string {
+	return s.Crv
+}
+
+// GetX returns the value of X.
+func (s *JWK) GetX() string {
+	return s.X
+}
+
+// GetKid returns the value of Kid.
+func (s *JWK) GetKid() string {
+	return s.Kid
+}
+
+// GetUse returns the value of Use.
+func (s *JWK) GetUse() string {
+	return s.Use
+}
+
+// GetAlg returns the value of Alg.
+func (s *JWK) GetAlg() string {
+	return s.Alg
+}
+
+// SetKty sets the value of Kty.
+func (s *JWK) SetKty(val string) {
+	s.Kty = val
+}
+
+// SetCrv sets the value of Crv.
+func (s *JWK) SetCrv(val string) {
+	s.Crv = val
+}
+
+// SetX sets the value of X.
+func (s *JWK) SetX(val string) {
+	s.X = val
+}
+
+// SetKid sets the value of Kid.
+func (s *JWK) SetKid(val string) {
+	s.Kid = val
+}
+
+// SetUse sets the value of Use.
+func (s *JWK) SetUse(val string) {
+	s.Use = val
+}
+
+// SetAlg sets the value of Alg.
+func (s *JWK) SetAlg(val string) {
+	s.Alg = val
+}
+
+// Ref: #/components/schemas/JWKS
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// GetKeys returns the value of Keys.
+func (s *JWKS) GetKeys() []JWK {
+	return s.Keys
+}
+
+// SetKeys sets the value of Keys.
+func (s *JWKS) SetKeys(val []JWK) {
+	s.Keys = val
+}
+
+func (*JWKS) getJWKSRes() {}
+
+// Ref: #/components/schemas/LockedError
+type LockedError struct {
+	// Human-redable error message.
+	Message string `json:"message"`
+	// Machine-redable error message.
+	Code string `json:"code"`
+	// Seconds until the lockout ends.
+	RetryAfter int64 `json:"retry_after"`
+}
+
+// GetMessage returns the value of Message.
+func (s *LockedError) GetMessage() string {
+	return s.Message
+}
+
+// GetCode returns the value of Code.
+func (s *LockedError) GetCode() string {
+	return s.Code
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *LockedError) GetRetryAfter() int64 {
+	return s.RetryAfter
+}
+
+// SetMessage sets the value of Message.
+func (s *LockedError) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetCode sets the value of Code.
+func (s *LockedError) SetCode(val string) {
+	s.Code = val
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *LockedError) SetRetryAfter(val int64) {
+	s.RetryAfter = val
+}
+
+func (*LockedError) getAuthTokenRes()        {}
+func (*LockedError) verifyTOTPChallengeRes() {}
+
 // NewOptAuthRequest returns new OptAuthRequest with value set to v.
 func NewOptAuthRequest(v AuthRequest) OptAuthRequest {
 	return OptAuthRequest{
@@ -163,6 +383,52 @@ func (o OptAuthRequest) Or(d AuthRequest) AuthRequest {
 	return d
 }
 
+// NewOptRefreshRequest returns new OptRefreshRequest with value set to v.
+func NewOptRefreshRequest(v RefreshRequest) OptRefreshRequest {
+	return OptRefreshRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRefreshRequest is optional RefreshRequest.
+type OptRefreshRequest struct {
+	Value RefreshRequest
+	Set   bool
+}
+
+// IsSet returns true if OptRefreshRequest was set.
+func (o OptRefreshRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRefreshRequest) Reset() {
+	var v RefreshRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRefreshRequest) SetTo(v RefreshRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRefreshRequest) Get() (v RefreshRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRefreshRequest) Or(d RefreshRequest) RefreshRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptString returns new OptString with value set to v.
 func NewOptString(v string) OptString {
 	return OptString{
@@ -209,6 +475,343 @@ func (o OptString) Or(d string) string {
 	return d
 }
 
+// NewOptTOTPChallengeRequest returns new OptTOTPChallengeRequest with value set to v.
+func NewOptTOTPChallengeRequest(v TOTPChallengeRequest) OptTOTPChallengeRequest {
+	return OptTOTPChallengeRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTOTPChallengeRequest is optional TOTPChallengeRequest.
+type OptTOTPChallengeRequest struct {
+	Value TOTPChallengeRequest
+	Set   bool
+}
+
+// IsSet returns true if OptTOTPChallengeRequest was set.
+func (o OptTOTPChallengeRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTOTPChallengeRequest) Reset() {
+	var v TOTPChallengeRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTOTPChallengeRequest) SetTo(v TOTPChallengeRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTOTPChallengeRequest) Get() (v TOTPChallengeRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTOTPChallengeRequest) Or(d TOTPChallengeRequest) TOTPChallengeRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTOTPCode returns new OptTOTPCode with value set to v.
+func NewOptTOTPCode(v TOTPCode) OptTOTPCode {
+	return OptTOTPCode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTOTPCode is optional TOTPCode.
+type OptTOTPCode struct {
+	Value TOTPCode
+	Set   bool
+}
+
+// IsSet returns true if OptTOTPCode was set.
+func (o OptTOTPCode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTOTPCode) Reset() {
+	var v TOTPCode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTOTPCode) SetTo(v TOTPCode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTOTPCode) Get() (v TOTPCode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTOTPCode) Or(d TOTPCode) TOTPCode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUnlockRequest returns new OptUnlockRequest with value set to v.
+func NewOptUnlockRequest(v UnlockRequest) OptUnlockRequest {
+	return OptUnlockRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUnlockRequest is optional UnlockRequest.
+type OptUnlockRequest struct {
+	Value UnlockRequest
+	Set   bool
+}
+
+// IsSet returns true if OptUnlockRequest was set.
+func (o OptUnlockRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUnlockRequest) Reset() {
+	var v UnlockRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUnlockRequest) SetTo(v UnlockRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUnlockRequest) Get() (v UnlockRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUnlockRequest) Or(d UnlockRequest) UnlockRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/RecoveryCodes
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *RecoveryCodes) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
+}
+
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *RecoveryCodes) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
+}
+
+func (*RecoveryCodes) confirmTOTPEnrollmentRes() {}
+
+type RefreshAuthTokenBadRequest Error
+
+func (*RefreshAuthTokenBadRequest) refreshAuthTokenRes() {}
+
+type RefreshAuthTokenInternalServerError Error
+
+func (*RefreshAuthTokenInternalServerError) refreshAuthTokenRes() {}
+
+type RefreshAuthTokenNotFound Error
+
+func (*RefreshAuthTokenNotFound) refreshAuthTokenRes() {}
+
+type RefreshAuthTokenUnauthorized Error
+
+func (*RefreshAuthTokenUnauthorized) refreshAuthTokenRes() {}
+
+// Ref: #/components/schemas/RefreshRequest
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// GetRefreshToken returns the value of RefreshToken.
+func (s *RefreshRequest) GetRefreshToken() string {
+	return s.RefreshToken
+}
+
+// SetRefreshToken sets the value of RefreshToken.
+func (s *RefreshRequest) SetRefreshToken(val string) {
+	s.RefreshToken = val
+}
+
+type StartTOTPEnrollmentBadRequest Error
+
+func (*StartTOTPEnrollmentBadRequest) startTOTPEnrollmentRes() {}
+
+type StartTOTPEnrollmentConflict Error
+
+func (*StartTOTPEnrollmentConflict) startTOTPEnrollmentRes() {}
+
+type StartTOTPEnrollmentInternalServerError Error
+
+func (*StartTOTPEnrollmentInternalServerError) startTOTPEnrollmentRes() {}
+
+type StartTOTPEnrollmentNotFound Error
+
+func (*StartTOTPEnrollmentNotFound) startTOTPEnrollmentRes() {}
+
+// Ref: #/components/schemas/TOTPChallengeRequest
+type TOTPChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token"`
+	// Code from the authenticator app or a recovery code.
+	Code string `json:"code"`
+	// Address the login attempt came from.
+	ClientIP OptString `json:"client_ip"`
+}
+
+// GetChallengeToken returns the value of ChallengeToken.
+func (s *TOTPChallengeRequest) GetChallengeToken() string {
+	return s.ChallengeToken
+}
+
+// GetCode returns the value of Code.
+func (s *TOTPChallengeRequest) GetCode() string {
+	return s.Code
+}
+
+// GetClientIP returns the value of ClientIP.
+func (s *TOTPChallengeRequest) GetClientIP() OptString {
+	return s.ClientIP
+}
+
+// SetChallengeToken sets the value of ChallengeToken.
+func (s *TOTPChallengeRequest) SetChallengeToken(val string) {
+	s.ChallengeToken = val
+}
+
+// SetCode sets the value of Code.
+func (s *TOTPChallengeRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetClientIP sets the value of ClientIP.
+func (s *TOTPChallengeRequest) SetClientIP(val OptString) {
+	s.ClientIP = val
+}
+
+// Ref: #/components/schemas/TOTPCode
+type TOTPCode struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *TOTPCode) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *TOTPCode) SetCode(val string) {
+	s.Code = val
+}
+
+// Ref: #/components/schemas/TOTPEnrollment
+type TOTPEnrollment struct {
+	// Base32 encoded secret for manual entry.
+	Secret string `json:"secret"`
+	// Key uri understood by authenticator apps.
+	OtpauthURI string `json:"otpauth_uri"`
+	// Png image of the otpauth uri.
+	QrCode []byte `json:"qr_code"`
+}
+
+// GetSecret returns the value of Secret.
+func (s *TOTPEnrollment) GetSecret() string {
+	return s.Secret
+}
+
+// GetOtpauthURI returns the value of OtpauthURI.
+func (s *TOTPEnrollment) GetOtpauthURI() string {
+	return s.OtpauthURI
+}
+
+// GetQrCode returns the value of QrCode.
+func (s *TOTPEnrollment) GetQrCode() []byte {
+	return s.QrCode
+}
+
+// SetSecret sets the value of Secret.
+func (s *TOTPEnrollment) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetOtpauthURI sets the value of OtpauthURI.
+func (s *TOTPEnrollment) SetOtpauthURI(val string) {
+	s.OtpauthURI = val
+}
+
+// SetQrCode sets the value of QrCode.
+func (s *TOTPEnrollment) SetQrCode(val []byte) {
+	s.QrCode = val
+}
+
+func (*TOTPEnrollment) startTOTPEnrollmentRes() {}
+
+type UnlockLoginBadRequest Error
+
+func (*UnlockLoginBadRequest) unlockLoginRes() {}
+
+type UnlockLoginInternalServerError Error
+
+func (*UnlockLoginInternalServerError) unlockLoginRes() {}
+
+// UnlockLoginNoContent is response for UnlockLogin operation.
+type UnlockLoginNoContent struct{}
+
+func (*UnlockLoginNoContent) unlockLoginRes() {}
+
+// Ref: #/components/schemas/UnlockRequest
+type UnlockRequest struct {
+	Email    string    `json:"email"`
+	ClientIP OptString `json:"client_ip"`
+}
+
+// GetEmail returns the value of Email.
+func (s *UnlockRequest) GetEmail() string {
+	return s.Email
+}
+
+// GetClientIP returns the value of ClientIP.
+func (s *UnlockRequest) GetClientIP() OptString {
+	return s.ClientIP
+}
+
+// SetEmail sets the value of Email.
+func (s *UnlockRequest) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetClientIP sets the value of ClientIP.
+func (s *UnlockRequest) SetClientIP(val OptString) {
+	s.ClientIP = val
+}
+
 // Ref: #/components/schemas/User
 type User struct {
 	ClientID  uuid.UUID `json:"client_id"`
@@ -270,6 +873,18 @@ func (s *User) SetWallets(val []string) {
 
 func (*User) getClientByIdRes() {}
 
+type VerifyTOTPChallengeBadRequest Error
+
+func (*VerifyTOTPChallengeBadRequest) verifyTOTPChallengeRes() {}
+
+type VerifyTOTPChallengeInternalServerError Error
+
+func (*VerifyTOTPChallengeInternalServerError) verifyTOTPChallengeRes() {}
+
+type VerifyTOTPChallengeUnauthorized Error
+
+func (*VerifyTOTPChallengeUnauthorized) verifyTOTPChallengeRes() {}
+
 // Ref: #/components/schemas/Wallet
 type Wallet struct {
 	PublicKey  string `json:"public_key"`
@@ -8,6 +8,12 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// ConfirmTOTPEnrollment implements ConfirmTOTPEnrollment operation.
+	//
+	// Enables two-factor authentication once the first code from the authenticator app is valid.
+	//
+	// POST /user/internal/v1/clients/{client_id}/totp/confirm
+	ConfirmTOTPEnrollment(ctx context.Context, req OptTOTPCode, params ConfirmTOTPEnrollmentParams) (ConfirmTOTPEnrollmentRes, error)
 	// GetAuthToken implements GetAuthToken operation.
 	//
 	// POST /user/internal/v1/clients/auth
@@ -16,10 +22,36 @@ type Handler interface {
 	//
 	// GET /user/internal/v1/clients/{client_id}
 	GetClientById(ctx context.Context, params GetClientByIdParams) (GetClientByIdRes, error)
+	// GetJWKS implements GetJWKS operation.
+	//
+	// GET /user/internal/v1/.well-known/jwks.json
+	GetJWKS(ctx context.Context) (GetJWKSRes, error)
 	// GetWalletById implements GetWalletById operation.
 	//
 	// GET /user/internal/v1/clients/{client_id}/wallets/{wallet_id}
 	GetWalletById(ctx context.Context, params GetWalletByIdParams) (GetWalletByIdRes, error)
+	// RefreshAuthToken implements RefreshAuthToken operation.
+	//
+	// POST /user/internal/v1/clients/auth/refresh
+	RefreshAuthToken(ctx context.Context, req OptRefreshRequest) (RefreshAuthTokenRes, error)
+	// StartTOTPEnrollment implements StartTOTPEnrollment operation.
+	//
+	// Generates a new TOTP secret, it is not used until the enrollment is confirmed.
+	//
+	// POST /user/internal/v1/clients/{client_id}/totp
+	StartTOTPEnrollment(ctx context.Context, params StartTOTPEnrollmentParams) (StartTOTPEnrollmentRes, error)
+	// UnlockLogin implements UnlockLogin operation.
+	//
+	// Removes login lockout of the account and optionally of the ip address.
+	//
+	// POST /user/internal/v1/clients/auth/unlock
+	UnlockLogin(ctx context.Context, req OptUnlockRequest) (UnlockLoginRes, error)
+	// VerifyTOTPChallenge implements VerifyTOTPChallenge operation.
+	//
+	// Second login step, accepts a TOTP code or an unused recovery code.
+	//
+	// POST /user/internal/v1/clients/auth/totp
+	VerifyTOTPChallenge(ctx context.Context, req OptTOTPChallengeRequest) (VerifyTOTPChallengeRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
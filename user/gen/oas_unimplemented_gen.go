@@ -13,6 +13,15 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// ConfirmTOTPEnrollment implements ConfirmTOTPEnrollment operation.
+//
+// Enables two-factor authentication once the first code from the authenticator app is valid.
+//
+// POST /user/internal/v1/clients/{client_id}/totp/confirm
+func (UnimplementedHandler) ConfirmTOTPEnrollment(ctx context.Context, req OptTOTPCode, params ConfirmTOTPEnrollmentParams) (r ConfirmTOTPEnrollmentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetAuthToken implements GetAuthToken operation.
 //
 // POST /user/internal/v1/clients/auth
@@ -27,9 +36,50 @@ func (UnimplementedHandler) GetClientById(ctx context.Context, params GetClientB
 	return r, ht.ErrNotImplemented
 }
 
+// GetJWKS implements GetJWKS operation.
+//
+// GET /user/internal/v1/.well-known/jwks.json
+func (UnimplementedHandler) GetJWKS(ctx context.Context) (r GetJWKSRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetWalletById implements GetWalletById operation.
 //
 // GET /user/internal/v1/clients/{client_id}/wallets/{wallet_id}
 func (UnimplementedHandler) GetWalletById(ctx context.Context, params GetWalletByIdParams) (r GetWalletByIdRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// RefreshAuthToken implements RefreshAuthToken operation.
+//
+// POST /user/internal/v1/clients/auth/refresh
+func (UnimplementedHandler) RefreshAuthToken(ctx context.Context, req OptRefreshRequest) (r RefreshAuthTokenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StartTOTPEnrollment implements StartTOTPEnrollment operation.
+//
+// Generates a new TOTP secret, it is not used until the enrollment is confirmed.
+//
+// POST /user/internal/v1/clients/{client_id}/totp
+func (UnimplementedHandler) StartTOTPEnrollment(ctx context.Context, params StartTOTPEnrollmentParams) (r StartTOTPEnrollmentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockLogin implements UnlockLogin operation.
+//
+// Removes login lockout of the account and optionally of the ip address.
+//
+// POST /user/internal/v1/clients/auth/unlock
+func (UnimplementedHandler) UnlockLogin(ctx context.Context, req OptUnlockRequest) (r UnlockLoginRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// VerifyTOTPChallenge implements VerifyTOTPChallenge operation.
+//
+// Second login step, accepts a TOTP code or an unused recovery code.
+//
+// POST /user/internal/v1/clients/auth/totp
+func (UnimplementedHandler) VerifyTOTPChallenge(ctx context.Context, req OptTOTPChallengeRequest) (r VerifyTOTPChallengeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
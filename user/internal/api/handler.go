@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"github.com/ShmelJUJ/software-engineering/user/gen"
 	"github.com/ShmelJUJ/software-engineering/user/internal/domains/client"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/lockout"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/token"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/totp"
 	"github.com/go-faster/sdk/zctx"
 	"github.com/ogen-go/ogen/conv"
 	"go.uber.org/zap"
@@ -18,6 +21,18 @@ var _ user.Handler = (*Handler)(nil)
 
 type Handler struct {
 	user.UnimplementedHandler // automatically implement all methods
+
+	issuer        *token.Issuer
+	guard         *lockout.Guard
+	authenticator *totp.Authenticator
+}
+
+func NewHandler(issuer *token.Issuer, guard *lockout.Guard, authenticator *totp.Authenticator) Handler {
+	return Handler{
+		issuer:        issuer,
+		guard:         guard,
+		authenticator: authenticator,
+	}
 }
 
 func (h Handler) GetClientById(ctx context.Context, params user.GetClientByIdParams) (user.GetClientByIdRes, error) {
@@ -90,29 +105,190 @@ func (h Handler) GetAuthToken(ctx context.Context, request user.OptAuthRequest)
 		zctx.From(ctx).Error("Empty Get Auth Token Request")
 		return &user.GetAuthTokenBadRequest{}, nil
 	}
-	zctx.From(ctx).Info("GetToken", zap.Any("params", request.Value.Email))
+	email := request.Value.Email
+	client_ip := request.Value.ClientIP.Or("")
+	zctx.From(ctx).Info("GetToken", zap.Any("params", email))
+	var locked *lockout.LockedError
+	if err := h.guard.Check(ctx, email, client_ip); err != nil {
+		if errors.As(err, &locked) {
+			auditLoginFailure(ctx, email, client_ip, "locked")
+			return lockedError(locked), nil
+		}
+		zctx.From(ctx).Error(err.Error())
+		return &user.GetAuthTokenInternalServerError{Code: "lockout_error", Message: "cant check login lockout"}, nil
+	}
 	repository, err := client.NewClientRepository(pgURL, ctx) //TODO наебашить конфиг для подключения к бд
 	var not_found *client.UserNotFoundError
 	if err != nil {
 
 		return &user.GetAuthTokenBadRequest{}, err
 	}
-	user_from_db, err := repository.GetClientByEmail(request.Value.Email)
+	user_from_db, err := repository.GetClientByEmail(email)
 	if err != nil {
 		if errors.As(err, &not_found) {
-			return &user.GetAuthTokenNotFound{Code: "user_not_found", Message: not_found.Error()}, nil
+			// Unknown emails are answered the same way as wrong passwords so that accounts can not be enumerated.
+			return h.loginFailed(ctx, email, client_ip, "user_not_found"), nil
 		}
 		return &user.GetAuthTokenBadRequest{}, err
 	}
-	auth_token, err := user_from_db.GetAuthToken(request.Value.Password)
+	err = user_from_db.CheckPassword(request.Value.Password)
 	var wrong_password_err *client.WrongPasswordError
 	if err != nil {
 		if errors.As(err, &wrong_password_err) {
-			return &user.GetAuthTokenForbidden{Code: "wrong_password", Message: wrong_password_err.Error()}, wrong_password_err
+			return h.loginFailed(ctx, email, client_ip, "wrong_password"), nil
 		}
 		return &user.GetAuthTokenBadRequest{}, err
 	}
+	totp_repository, err := totp.NewTOTPRepository(pgURL, ctx)
+	if err != nil {
+		return &user.GetAuthTokenInternalServerError{Code: "db_error", Message: "cant connect to db"}, nil
+	}
+	second_factor, err := totp_repository.GetTOTP(user_from_db.GetClientId())
+	var no_totp *totp.TOTPNotFoundError
+	if err != nil && !errors.As(err, &no_totp) {
+		return &user.GetAuthTokenInternalServerError{Code: "db_error", Message: "cant get two-factor settings"}, nil
+	}
+	if err == nil && second_factor.IsConfirmed() {
+		// Failures are not reset until the second factor is passed, so a leaked password
+		// does not give unlimited attempts to guess the code.
+		challenge_token, expires_in, err := h.issuer.IssueChallenge(user_from_db.GetClientId())
+		if err != nil {
+			zctx.From(ctx).Error(err.Error())
+			return &user.GetAuthTokenInternalServerError{Code: "token_error", Message: "cant issue challenge token"}, nil
+		}
+		return &user.ChallengeResponse{
+			ChallengeToken:     challenge_token,
+			ChallengeExpiresIn: int64(expires_in.Seconds()),
+		}, nil
+	}
+	if err := h.guard.RegisterSuccess(ctx, email); err != nil {
+		zctx.From(ctx).Error(err.Error())
+	}
+	tokens, err := h.issuer.Issue(user_from_db.GetClientId(), user_from_db.GetRoles())
+	if err != nil {
+		zctx.From(ctx).Error(err.Error())
+		return &user.GetAuthTokenInternalServerError{Code: "token_error", Message: "cant issue auth token"}, nil
+	}
+	return authResponse(tokens), nil
+}
+
+func (h Handler) loginFailed(ctx context.Context, email string, client_ip string, reason string) user.GetAuthTokenRes {
+	if locked := h.registerLoginFailure(ctx, email, client_ip, reason); locked != nil {
+		return lockedError(locked)
+	}
+	return &user.GetAuthTokenUnauthorized{Code: "wrong_credentials", Message: "wrong email or password"}
+}
+
+// registerLoginFailure audits the failed attempt and returns LockedError if it caused a lockout.
+func (h Handler) registerLoginFailure(ctx context.Context, email string, client_ip string, reason string) *lockout.LockedError {
+	auditLoginFailure(ctx, email, client_ip, reason)
+	err := h.guard.RegisterFailure(ctx, email, client_ip)
+	var locked *lockout.LockedError
+	if errors.As(err, &locked) {
+		zctx.From(ctx).Named("audit").Warn("Login locked",
+			zap.String("email", email),
+			zap.String("client_ip", client_ip),
+			zap.Duration("retry_after", locked.RetryAfter()),
+		)
+		return locked
+	}
+	if err != nil {
+		zctx.From(ctx).Error(err.Error())
+	}
+	return nil
+}
+
+func auditLoginFailure(ctx context.Context, email string, client_ip string, reason string) {
+	zctx.From(ctx).Named("audit").Warn("Login failed",
+		zap.String("email", email),
+		zap.String("client_ip", client_ip),
+		zap.String("reason", reason),
+	)
+}
+
+func lockedError(locked *lockout.LockedError) *user.LockedError {
+	return &user.LockedError{
+		Code:       "login_locked",
+		Message:    locked.Error(),
+		RetryAfter: int64(locked.RetryAfter().Seconds()),
+	}
+}
+
+func (h Handler) UnlockLogin(ctx context.Context, request user.OptUnlockRequest) (r user.UnlockLoginRes, _ error) {
+	if !request.Set {
+		zctx.From(ctx).Error("Empty Unlock Login Request")
+		return &user.UnlockLoginBadRequest{Code: "empty_request", Message: "email is required"}, nil
+	}
+	email := request.Value.Email
+	client_ip := request.Value.ClientIP.Or("")
+	if err := h.guard.Unlock(ctx, email, client_ip); err != nil {
+		zctx.From(ctx).Error(err.Error())
+		return &user.UnlockLoginInternalServerError{Code: "lockout_error", Message: "cant unlock login"}, nil
+	}
+	zctx.From(ctx).Named("audit").Info("Login unlocked",
+		zap.String("email", email),
+		zap.String("client_ip", client_ip),
+	)
+	return &user.UnlockLoginNoContent{}, nil
+}
+
+func (h Handler) RefreshAuthToken(ctx context.Context, request user.OptRefreshRequest) (r user.RefreshAuthTokenRes, _ error) {
+	if !request.Set {
+		zctx.From(ctx).Error("Empty Refresh Auth Token Request")
+		return &user.RefreshAuthTokenBadRequest{Code: "empty_request", Message: "refresh token is required"}, nil
+	}
+	claims, err := h.issuer.VerifyRefreshToken(ctx, request.Value.RefreshToken)
+	if err != nil {
+		zctx.From(ctx).Info("RefreshToken rejected", zap.Error(err))
+		return &user.RefreshAuthTokenUnauthorized{Code: "invalid_token", Message: err.Error()}, nil
+	}
+	zctx.From(ctx).Info("RefreshToken", zap.String("client_id", claims.Subject))
+	converted_client_id, err := conv.ToUUID(claims.Subject)
+	if err != nil {
+		return &user.RefreshAuthTokenUnauthorized{Code: "invalid_token", Message: "cant parse token subject to uuid"}, nil
+	}
+	repository, err := client.NewClientRepository(pgURL, ctx) //TODO наебашить конфиг для подключения к бд
+	var not_found *client.UserNotFoundError
+	if err != nil {
+		return &user.RefreshAuthTokenInternalServerError{}, err
+	}
+	// The user is reloaded so that a deleted account can not renew its tokens.
+	user_from_db, err := repository.GetClientById(converted_client_id)
+	if err != nil {
+		if errors.As(err, &not_found) {
+			return &user.RefreshAuthTokenNotFound{Code: "user_not_found", Message: not_found.Error()}, nil
+		}
+		return &user.RefreshAuthTokenInternalServerError{}, err
+	}
+	tokens, err := h.issuer.Issue(user_from_db.GetClientId(), user_from_db.GetRoles())
+	if err != nil {
+		zctx.From(ctx).Error(err.Error())
+		return &user.RefreshAuthTokenInternalServerError{Code: "token_error", Message: "cant issue auth token"}, nil
+	}
+	return authResponse(tokens), nil
+}
+
+func (h Handler) GetJWKS(ctx context.Context) (r user.GetJWKSRes, _ error) {
+	jwks := h.issuer.JWKS()
+	keys := make([]user.JWK, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		keys = append(keys, user.JWK{
+			Kty: key.Kty,
+			Crv: key.Crv,
+			X:   key.X,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+		})
+	}
+	return &user.JWKS{Keys: keys}, nil
+}
+
+func authResponse(tokens token.Pair) *user.AuthResponse {
 	return &user.AuthResponse{
-		AuthToken: auth_token,
-	}, nil
+		AuthToken:    tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		TokenType:    tokens.TokenType,
+		ExpiresIn:    int64(tokens.ExpiresIn.Seconds()),
+	}
 }
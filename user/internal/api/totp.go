@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ShmelJUJ/software-engineering/user/gen"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/client"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/lockout"
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/totp"
+	"github.com/go-faster/sdk/zctx"
+	"github.com/ogen-go/ogen/conv"
+	"go.uber.org/zap"
+)
+
+func (h Handler) StartTOTPEnrollment(ctx context.Context, params user.StartTOTPEnrollmentParams) (r user.StartTOTPEnrollmentRes, _ error) {
+	zctx.From(ctx).Info("StartTOTPEnrollment", zap.Any("params", params))
+	converted_client_id, err := conv.ToUUID(params.ClientID)
+	if err != nil {
+		return &user.StartTOTPEnrollmentBadRequest{Code: "invalid_client_id", Message: err.Error()}, nil
+	}
+	repository, err := client.NewClientRepository(pgURL, ctx) //TODO наебашить конфиг для подключения к бд
+	var not_found *client.UserNotFoundError
+	if err != nil {
+		return &user.StartTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant connect to db"}, nil
+	}
+	user_from_db, err := repository.GetClientById(converted_client_id)
+	if err != nil {
+		if errors.As(err, &not_found) {
+			return &user.StartTOTPEnrollmentNotFound{Code: "user_not_found", Message: not_found.Error()}, nil
+		}
+		return &user.StartTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant get user"}, nil
+	}
+	enrollment, err := h.authenticator.Enroll(user_from_db.GetEmail())
+	if err != nil {
+		zctx.From(ctx).Error(err.Error())
+		return &user.StartTOTPEnrollmentInternalServerError{Code: "totp_error", Message: "cant generate totp secret"}, nil
+	}
+	totp_repository, err := totp.NewTOTPRepository(pgURL, ctx)
+	if err != nil {
+		return &user.StartTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant connect to db"}, nil
+	}
+	var already_enabled *totp.AlreadyEnabledError
+	if err := totp_repository.SaveSecret(converted_client_id, enrollment.GetSecret()); err != nil {
+		if errors.As(err, &already_enabled) {
+			return &user.StartTOTPEnrollmentConflict{Code: "totp_already_enabled", Message: already_enabled.Error()}, nil
+		}
+		return &user.StartTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant save totp secret"}, nil
+	}
+	return &user.TOTPEnrollment{
+		Secret:     enrollment.GetSecret(),
+		OtpauthURI: enrollment.GetOtpauthURI(),
+		QrCode:     enrollment.GetQRCode(),
+	}, nil
+}
+
+func (h Handler) ConfirmTOTPEnrollment(ctx context.Context, request user.OptTOTPCode, params user.ConfirmTOTPEnrollmentParams) (r user.ConfirmTOTPEnrollmentRes, _ error) {
+	zctx.From(ctx).Info("ConfirmTOTPEnrollment", zap.Any("params", params))
+	if !request.Set {
+		return &user.ConfirmTOTPEnrollmentBadRequest{Code: "empty_request", Message: "code is required"}, nil
+	}
+	converted_client_id, err := conv.ToUUID(params.ClientID)
+	if err != nil {
+		return &user.ConfirmTOTPEnrollmentBadRequest{Code: "invalid_client_id", Message: err.Error()}, nil
+	}
+	totp_repository, err := totp.NewTOTPRepository(pgURL, ctx)
+	if err != nil {
+		return &user.ConfirmTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant connect to db"}, nil
+	}
+	second_factor, err := totp_repository.GetTOTP(converted_client_id)
+	var no_totp *totp.TOTPNotFoundError
+	if err != nil {
+		if errors.As(err, &no_totp) {
+			return &user.ConfirmTOTPEnrollmentNotFound{Code: "totp_not_found", Message: no_totp.Error()}, nil
+		}
+		return &user.ConfirmTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant get totp secret"}, nil
+	}
+	if second_factor.IsConfirmed() {
+		return &user.ConfirmTOTPEnrollmentBadRequest{Code: "totp_already_enabled", Message: "two-factor authentication is already enabled"}, nil
+	}
+	var invalid_code *totp.InvalidCodeError
+	var reused_code *totp.CodeReusedError
+	if err := h.authenticator.Verify(ctx, converted_client_id, second_factor.GetSecret(), request.Value.Code); err != nil {
+		if errors.As(err, &invalid_code) || errors.As(err, &reused_code) {
+			return &user.ConfirmTOTPEnrollmentBadRequest{Code: "wrong_code", Message: err.Error()}, nil
+		}
+		zctx.From(ctx).Error(err.Error())
+		return &user.ConfirmTOTPEnrollmentInternalServerError{Code: "totp_error", Message: "cant verify code"}, nil
+	}
+	recovery_codes, recovery_code_hashes, err := totp.GenerateRecoveryCodes()
+	if err != nil {
+		zctx.From(ctx).Error(err.Error())
+		return &user.ConfirmTOTPEnrollmentInternalServerError{Code: "totp_error", Message: "cant generate recovery codes"}, nil
+	}
+	var already_enabled *totp.AlreadyEnabledError
+	if err := totp_repository.Confirm(converted_client_id, recovery_code_hashes); err != nil {
+		if errors.As(err, &already_enabled) {
+			return &user.ConfirmTOTPEnrollmentBadRequest{Code: "totp_already_enabled", Message: already_enabled.Error()}, nil
+		}
+		return &user.ConfirmTOTPEnrollmentInternalServerError{Code: "db_error", Message: "cant enable two-factor authentication"}, nil
+	}
+	zctx.From(ctx).Named("audit").Info("TOTP enabled", zap.String("client_id", converted_client_id.String()))
+	return &user.RecoveryCodes{RecoveryCodes: recovery_codes}, nil
+}
+
+func (h Handler) VerifyTOTPChallenge(ctx context.Context, request user.OptTOTPChallengeRequest) (r user.VerifyTOTPChallengeRes, _ error) {
+	if !request.Set {
+		return &user.VerifyTOTPChallengeBadRequest{Code: "empty_request", Message: "challenge token and code are required"}, nil
+	}
+	claims, err := h.issuer.VerifyChallengeToken(ctx, request.Value.ChallengeToken)
+	if err != nil {
+		zctx.From(ctx).Info("ChallengeToken rejected", zap.Error(err))
+		return &user.VerifyTOTPChallengeUnauthorized{Code: "invalid_token", Message: err.Error()}, nil
+	}
+	converted_client_id, err := conv.ToUUID(claims.Subject)
+	if err != nil {
+		return &user.VerifyTOTPChallengeUnauthorized{Code: "invalid_token", Message: "cant parse token subject to uuid"}, nil
+	}
+	repository, err := client.NewClientRepository(pgURL, ctx) //TODO наебашить конфиг для подключения к бд
+	var not_found *client.UserNotFoundError
+	if err != nil {
+		return &user.VerifyTOTPChallengeInternalServerError{Code: "db_error", Message: "cant connect to db"}, nil
+	}
+	user_from_db, err := repository.GetClientById(converted_client_id)
+	if err != nil {
+		if errors.As(err, &not_found) {
+			return &user.VerifyTOTPChallengeUnauthorized{Code: "invalid_token", Message: not_found.Error()}, nil
+		}
+		return &user.VerifyTOTPChallengeInternalServerError{Code: "db_error", Message: "cant get user"}, nil
+	}
+	email := user_from_db.GetEmail()
+	client_ip := request.Value.ClientIP.Or("")
+	var locked *lockout.LockedError
+	if err := h.guard.Check(ctx, email, client_ip); err != nil {
+		if errors.As(err, &locked) {
+			auditLoginFailure(ctx, email, client_ip, "locked")
+			return lockedError(locked), nil
+		}
+		return &user.VerifyTOTPChallengeInternalServerError{Code: "lockout_error", Message: "cant check login lockout"}, nil
+	}
+	totp_repository, err := totp.NewTOTPRepository(pgURL, ctx)
+	if err != nil {
+		return &user.VerifyTOTPChallengeInternalServerError{Code: "db_error", Message: "cant connect to db"}, nil
+	}
+	second_factor, err := totp_repository.GetTOTP(converted_client_id)
+	var no_totp *totp.TOTPNotFoundError
+	if err != nil {
+		if errors.As(err, &no_totp) {
+			return &user.VerifyTOTPChallengeUnauthorized{Code: "totp_not_enabled", Message: no_totp.Error()}, nil
+		}
+		return &user.VerifyTOTPChallengeInternalServerError{Code: "db_error", Message: "cant get totp secret"}, nil
+	}
+	if !second_factor.IsConfirmed() {
+		return &user.VerifyTOTPChallengeUnauthorized{Code: "totp_not_enabled", Message: "two-factor authentication is not enabled"}, nil
+	}
+	code := request.Value.Code
+	if totp.IsRecoveryCode(code) {
+		used, err := totp_repository.UseRecoveryCode(converted_client_id, totp.HashRecoveryCode(code))
+		if err != nil {
+			return &user.VerifyTOTPChallengeInternalServerError{Code: "db_error", Message: "cant check recovery code"}, nil
+		}
+		if !used {
+			return h.secondFactorFailed(ctx, email, client_ip, "wrong_recovery_code"), nil
+		}
+		zctx.From(ctx).Named("audit").Info("Recovery code used", zap.String("client_id", converted_client_id.String()))
+	} else {
+		var invalid_code *totp.InvalidCodeError
+		var reused_code *totp.CodeReusedError
+		if err := h.authenticator.Verify(ctx, converted_client_id, second_factor.GetSecret(), code); err != nil {
+			switch {
+			case errors.As(err, &invalid_code):
+				return h.secondFactorFailed(ctx, email, client_ip, "wrong_totp_code"), nil
+			case errors.As(err, &reused_code):
+				return h.secondFactorFailed(ctx, email, client_ip, "reused_totp_code"), nil
+			}
+			zctx.From(ctx).Error(err.Error())
+			return &user.VerifyTOTPChallengeInternalServerError{Code: "totp_error", Message: "cant verify code"}, nil
+		}
+	}
+	if err := h.guard.RegisterSuccess(ctx, email); err != nil {
+		zctx.From(ctx).Error(err.Error())
+	}
+	tokens, err := h.issuer.Issue(converted_client_id, user_from_db.GetRoles())
+	if err != nil {
+		zctx.From(ctx).Error(err.Error())
+		return &user.VerifyTOTPChallengeInternalServerError{Code: "token_error", Message: "cant issue auth token"}, nil
+	}
+	return authResponse(tokens), nil
+}
+
+func (h Handler) secondFactorFailed(ctx context.Context, email string, client_ip string, reason string) user.VerifyTOTPChallengeRes {
+	if locked := h.registerLoginFailure(ctx, email, client_ip, reason); locked != nil {
+		return lockedError(locked)
+	}
+	return &user.VerifyTOTPChallengeUnauthorized{Code: "wrong_code", Message: "wrong two-factor code"}
+}
@@ -3,7 +3,6 @@ package client
 import (
 	"fmt"
 
-	"github.com/chilts/sid"
 	"github.com/google/uuid"
 )
 
@@ -20,12 +19,13 @@ type Client struct {
 	client_last_name  string
 	email             string
 	wallets           []wallet
+	roles             []string
 }
 
 func NewClient(client_id uuid.UUID,
 	client_first_name string,
 	client_last_name string,
-	email string, password string, wallets []wallet,
+	email string, password string, wallets []wallet, roles []string,
 ) (client Client) {
 	client.client_id = client_id
 	client.client_first_name = client_first_name
@@ -33,6 +33,7 @@ func NewClient(client_id uuid.UUID,
 	client.email = email
 	client.password = password
 	client.wallets = wallets
+	client.roles = roles
 	return client
 }
 
@@ -56,11 +57,15 @@ func (client *Client) GetWallets() []wallet {
 	return client.wallets
 }
 
-func (client *Client) GetAuthToken(password string) (string, error) {
+func (client *Client) GetRoles() []string {
+	return client.roles
+}
+
+func (client *Client) CheckPassword(password string) error {
 	if fmt.Sprintf("%q", password) != client.password {
-		return "", &WrongPasswordError{client_id: client.client_id}
+		return &WrongPasswordError{client_id: client.client_id}
 	}
-	return sid.Id(), nil
+	return nil
 }
 
 func NewWallet(wallet_id uuid.UUID, public_key string, private_key string) wallet {
@@ -67,8 +67,14 @@ func (repository *ClientRepository) GetClientById(id uuid.UUID) (Client, error)
 
 		return Client{}, err
 	}
+	roles, err := repository.GetRolesByUserId(id)
+	if err != nil {
+		lg.Error(err.Error())
+
+		return Client{}, err
+	}
 
-	return NewClient(client_record.UserId, client_record.FirstName, client_record.LastName, client_record.Email, DecodePrivateData(client_record.Password), wallets), nil
+	return NewClient(client_record.UserId, client_record.FirstName, client_record.LastName, client_record.Email, DecodePrivateData(client_record.Password), wallets, roles), nil
 }
 
 func (repository *ClientRepository) GetClientByEmail(email string) (Client, error) {
@@ -94,7 +100,13 @@ func (repository *ClientRepository) GetClientByEmail(email string) (Client, erro
 
 		return Client{}, err
 	}
-	return NewClient(client_record.UserId, client_record.FirstName, client_record.LastName, client_record.Email, DecodePrivateData(client_record.Password), wallets), nil
+	roles, err := repository.GetRolesByUserId(client_record.UserId)
+	if err != nil {
+		lg.Error(err.Error())
+
+		return Client{}, err
+	}
+	return NewClient(client_record.UserId, client_record.FirstName, client_record.LastName, client_record.Email, DecodePrivateData(client_record.Password), wallets, roles), nil
 }
 
 func DecodePrivateData(private_data string) string {
@@ -128,3 +140,15 @@ func (repository *ClientRepository) GetWalletsByUserId(id uuid.UUID) ([]wallet,
 	}
 	return wallets, nil
 }
+
+func (repository *ClientRepository) GetRolesByUserId(id uuid.UUID) ([]string, error) {
+	rows, err := repository.cluster.Pool.Query(repository.ctx, kGetRolesByUserId, id.String())
+	if err != nil {
+		zctx.From(repository.ctx).Error(err.Error())
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
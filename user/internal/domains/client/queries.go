@@ -11,4 +11,9 @@ const (
 	kGetWalletByUserId = `SELECT wallet_id, user_id, public_key, private_key
 							FROM public.algorand_wallets
 							WHERE user_id = $1::UUID`
+
+	kGetRolesByUserId = `SELECT role
+							FROM public.user_roles
+							WHERE user_id = $1::UUID
+							ORDER BY role`
 )
@@ -0,0 +1,18 @@
+package lockout
+
+import (
+	"fmt"
+	"time"
+)
+
+type LockedError struct {
+	retry_after time.Duration
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, try again in %s", e.retry_after.Round(time.Second))
+}
+
+func (e *LockedError) RetryAfter() time.Duration {
+	return e.retry_after
+}
@@ -0,0 +1,150 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	accountScope = "account"
+	ipScope      = "ip"
+
+	keyPrefix = "login"
+)
+
+type Config struct {
+	// MaxAttempts is the number of failures per account before it is locked.
+	MaxAttempts int64
+	// MaxIPAttempts is the number of failures per ip address before it is locked.
+	MaxIPAttempts int64
+	// Window is the period in which failures are counted.
+	Window time.Duration
+	// BaseLockout is the first lockout duration, every next one is doubled up to MaxLockout.
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+	// EscalationWindow is how long previous lockouts are remembered.
+	EscalationWindow time.Duration
+}
+
+// Guard counts failed login attempts per account and per ip address
+// and locks them out with exponentially growing durations.
+type Guard struct {
+	cfg   Config
+	store Store
+}
+
+func NewGuard(cfg Config, store Store) *Guard {
+	return &Guard{
+		cfg:   cfg,
+		store: store,
+	}
+}
+
+// Check returns LockedError if either the account or the ip address is locked.
+func (guard *Guard) Check(ctx context.Context, email string, client_ip string) error {
+	for _, subject := range guard.subjects(email, client_ip) {
+		ttl, err := guard.store.TTL(ctx, key("lock", subject.scope, subject.id))
+		if err != nil {
+			return fmt.Errorf("cant check %s lock: %w", subject.scope, err)
+		}
+		if ttl > 0 {
+			return &LockedError{retry_after: ttl}
+		}
+	}
+	return nil
+}
+
+// RegisterFailure counts the failed attempt and returns LockedError if it caused a lockout.
+func (guard *Guard) RegisterFailure(ctx context.Context, email string, client_ip string) error {
+	var locked error
+	for _, subject := range guard.subjects(email, client_ip) {
+		failures, err := guard.store.Incr(ctx, key("failures", subject.scope, subject.id), guard.cfg.Window)
+		if err != nil {
+			return fmt.Errorf("cant count %s failure: %w", subject.scope, err)
+		}
+		if failures < subject.max_attempts {
+			continue
+		}
+		lockout, err := guard.lock(ctx, subject)
+		if err != nil {
+			return err
+		}
+		if locked == nil {
+			locked = &LockedError{retry_after: lockout}
+		}
+	}
+	return locked
+}
+
+// RegisterSuccess forgets the failures of the account.
+func (guard *Guard) RegisterSuccess(ctx context.Context, email string) error {
+	email = normalize(email)
+	return guard.store.Del(ctx,
+		key("failures", accountScope, email),
+		key("lockouts", accountScope, email),
+	)
+}
+
+// Unlock removes the lock of the account and, if given, of the ip address.
+func (guard *Guard) Unlock(ctx context.Context, email string, client_ip string) error {
+	var keys []string
+	for _, subject := range guard.subjects(email, client_ip) {
+		keys = append(keys,
+			key("lock", subject.scope, subject.id),
+			key("failures", subject.scope, subject.id),
+			key("lockouts", subject.scope, subject.id),
+		)
+	}
+	return guard.store.Del(ctx, keys...)
+}
+
+func (guard *Guard) lock(ctx context.Context, subject subject) (time.Duration, error) {
+	lockouts, err := guard.store.Incr(ctx, key("lockouts", subject.scope, subject.id), guard.cfg.EscalationWindow)
+	if err != nil {
+		return 0, fmt.Errorf("cant count %s lockouts: %w", subject.scope, err)
+	}
+	lockout := guard.lockoutDuration(lockouts)
+	if err := guard.store.Set(ctx, key("lock", subject.scope, subject.id), lockout); err != nil {
+		return 0, fmt.Errorf("cant lock %s: %w", subject.scope, err)
+	}
+	// Counting starts over after the lockout ends.
+	if err := guard.store.Del(ctx, key("failures", subject.scope, subject.id)); err != nil {
+		return 0, fmt.Errorf("cant reset %s failures: %w", subject.scope, err)
+	}
+	return lockout, nil
+}
+
+func (guard *Guard) lockoutDuration(lockouts int64) time.Duration {
+	lockout := guard.cfg.BaseLockout
+	for i := int64(1); i < lockouts && lockout < guard.cfg.MaxLockout; i++ {
+		lockout *= 2
+	}
+	if lockout > guard.cfg.MaxLockout {
+		return guard.cfg.MaxLockout
+	}
+	return lockout
+}
+
+type subject struct {
+	scope        string
+	id           string
+	max_attempts int64
+}
+
+func (guard *Guard) subjects(email string, client_ip string) []subject {
+	subjects := []subject{{scope: accountScope, id: normalize(email), max_attempts: guard.cfg.MaxAttempts}}
+	if client_ip != "" {
+		subjects = append(subjects, subject{scope: ipScope, id: client_ip, max_attempts: guard.cfg.MaxIPAttempts})
+	}
+	return subjects
+}
+
+func key(kind string, scope string, id string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", keyPrefix, kind, scope, id)
+}
+
+func normalize(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,198 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/user/internal/domains/lockout/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testEmail = "Test@Example.com"
+	testIP    = "10.0.0.1"
+)
+
+var testCfg = Config{
+	MaxAttempts:      3,
+	MaxIPAttempts:    10,
+	Window:           15 * time.Minute,
+	BaseLockout:      time.Minute,
+	MaxLockout:       time.Hour,
+	EscalationWindow: 24 * time.Hour,
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testErr := errors.New("test err")
+
+	testcases := []struct {
+		name        string
+		mock        func(*mocks.MockStore)
+		expectedErr error
+	}{
+		{
+			name: "Not locked",
+			mock: func(ms *mocks.MockStore) {
+				ms.EXPECT().TTL(ctx, "login:lock:account:test@example.com").Return(time.Duration(0), nil)
+				ms.EXPECT().TTL(ctx, "login:lock:ip:"+testIP).Return(time.Duration(0), nil)
+			},
+		},
+		{
+			name: "Account locked",
+			mock: func(ms *mocks.MockStore) {
+				ms.EXPECT().TTL(ctx, "login:lock:account:test@example.com").Return(time.Minute, nil)
+			},
+			expectedErr: &LockedError{retry_after: time.Minute},
+		},
+		{
+			name: "Ip locked",
+			mock: func(ms *mocks.MockStore) {
+				ms.EXPECT().TTL(ctx, "login:lock:account:test@example.com").Return(time.Duration(0), nil)
+				ms.EXPECT().TTL(ctx, "login:lock:ip:"+testIP).Return(2*time.Minute, nil)
+			},
+			expectedErr: &LockedError{retry_after: 2 * time.Minute},
+		},
+		{
+			name: "Store error",
+			mock: func(ms *mocks.MockStore) {
+				ms.EXPECT().TTL(ctx, "login:lock:account:test@example.com").Return(time.Duration(0), testErr)
+			},
+			expectedErr: testErr,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			store := mocks.NewMockStore(mockCtrl)
+			testcase.mock(store)
+
+			err := NewGuard(testCfg, store).Check(ctx, testEmail, testIP)
+
+			var locked *LockedError
+			if errors.As(testcase.expectedErr, &locked) {
+				assert.Equal(t, testcase.expectedErr, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, testcase.expectedErr)
+		})
+	}
+}
+
+func TestRegisterFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testcases := []struct {
+		name        string
+		clientIP    string
+		mock        func(*mocks.MockStore)
+		expectedErr error
+	}{
+		{
+			name:     "Failure below the limit",
+			clientIP: testIP,
+			mock: func(ms *mocks.MockStore) {
+				ms.EXPECT().Incr(ctx, "login:failures:account:test@example.com", testCfg.Window).Return(int64(1), nil)
+				ms.EXPECT().Incr(ctx, "login:failures:ip:"+testIP, testCfg.Window).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "First account lockout",
+			mock: func(ms *mocks.MockStore) {
+				gomock.InOrder(
+					ms.EXPECT().Incr(ctx, "login:failures:account:test@example.com", testCfg.Window).Return(int64(3), nil),
+					ms.EXPECT().Incr(ctx, "login:lockouts:account:test@example.com", testCfg.EscalationWindow).Return(int64(1), nil),
+					ms.EXPECT().Set(ctx, "login:lock:account:test@example.com", time.Minute).Return(nil),
+					ms.EXPECT().Del(ctx, "login:failures:account:test@example.com").Return(nil),
+				)
+			},
+			expectedErr: &LockedError{retry_after: time.Minute},
+		},
+		{
+			name: "Repeated account lockout is doubled",
+			mock: func(ms *mocks.MockStore) {
+				gomock.InOrder(
+					ms.EXPECT().Incr(ctx, "login:failures:account:test@example.com", testCfg.Window).Return(int64(3), nil),
+					ms.EXPECT().Incr(ctx, "login:lockouts:account:test@example.com", testCfg.EscalationWindow).Return(int64(3), nil),
+					ms.EXPECT().Set(ctx, "login:lock:account:test@example.com", 4*time.Minute).Return(nil),
+					ms.EXPECT().Del(ctx, "login:failures:account:test@example.com").Return(nil),
+				)
+			},
+			expectedErr: &LockedError{retry_after: 4 * time.Minute},
+		},
+		{
+			name: "Lockout is capped",
+			mock: func(ms *mocks.MockStore) {
+				gomock.InOrder(
+					ms.EXPECT().Incr(ctx, "login:failures:account:test@example.com", testCfg.Window).Return(int64(3), nil),
+					ms.EXPECT().Incr(ctx, "login:lockouts:account:test@example.com", testCfg.EscalationWindow).Return(int64(100), nil),
+					ms.EXPECT().Set(ctx, "login:lock:account:test@example.com", time.Hour).Return(nil),
+					ms.EXPECT().Del(ctx, "login:failures:account:test@example.com").Return(nil),
+				)
+			},
+			expectedErr: &LockedError{retry_after: time.Hour},
+		},
+		{
+			name:     "Ip lockout",
+			clientIP: testIP,
+			mock: func(ms *mocks.MockStore) {
+				gomock.InOrder(
+					ms.EXPECT().Incr(ctx, "login:failures:account:test@example.com", testCfg.Window).Return(int64(1), nil),
+					ms.EXPECT().Incr(ctx, "login:failures:ip:"+testIP, testCfg.Window).Return(int64(10), nil),
+					ms.EXPECT().Incr(ctx, "login:lockouts:ip:"+testIP, testCfg.EscalationWindow).Return(int64(1), nil),
+					ms.EXPECT().Set(ctx, "login:lock:ip:"+testIP, time.Minute).Return(nil),
+					ms.EXPECT().Del(ctx, "login:failures:ip:"+testIP).Return(nil),
+				)
+			},
+			expectedErr: &LockedError{retry_after: time.Minute},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			store := mocks.NewMockStore(mockCtrl)
+			testcase.mock(store)
+
+			err := NewGuard(testCfg, store).RegisterFailure(ctx, testEmail, testcase.clientIP)
+
+			assert.Equal(t, testcase.expectedErr, err)
+		})
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	mockCtrl := gomock.NewController(t)
+	store := mocks.NewMockStore(mockCtrl)
+	store.EXPECT().Del(ctx,
+		"login:lock:account:test@example.com",
+		"login:failures:account:test@example.com",
+		"login:lockouts:account:test@example.com",
+		"login:lock:ip:"+testIP,
+		"login:failures:ip:"+testIP,
+		"login:lockouts:ip:"+testIP,
+	).Return(nil)
+
+	assert.NoError(t, NewGuard(testCfg, store).Unlock(ctx, testEmail, testIP))
+}
@@ -0,0 +1,62 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+//go:generate mockgen -package mocks -destination mocks/store_mocks.go github.com/ShmelJUJ/software-engineering/user/internal/domains/lockout Store
+
+// Store keeps attempt counters and locks.
+type Store interface {
+	// Incr increments the counter and starts its ttl on the first increment.
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// Set creates the key for ttl.
+	Set(ctx context.Context, key string, ttl time.Duration) error
+	// TTL returns the remaining lifetime of the key or zero if it does not exist.
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	Del(ctx context.Context, keys ...string) error
+}
+
+type redisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(client *redis.Client) Store {
+	return &redisStore{
+		client: client,
+	}
+}
+
+func (store *redisStore) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	pipe := store.client.TxPipeline()
+	counter := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return counter.Val(), nil
+}
+
+func (store *redisStore) Set(ctx context.Context, key string, ttl time.Duration) error {
+	return store.client.Set(ctx, key, 1, ttl).Err()
+}
+
+func (store *redisStore) TTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := store.client.PTTL(ctx, key).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, err
+	}
+	// Negative values mean that the key does not exist or never expires.
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (store *redisStore) Del(ctx context.Context, keys ...string) error {
+	return store.client.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,107 @@
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/ShmelJUJ/software-engineering/pkg/jwt"
+	"github.com/google/uuid"
+)
+
+const tokenType = "Bearer"
+
+type Config struct {
+	Issuer       string
+	AccessTTL    time.Duration
+	RefreshTTL   time.Duration
+	ChallengeTTL time.Duration
+}
+
+// Pair is an access token together with the refresh token to renew it.
+type Pair struct {
+	AccessToken  string
+	RefreshToken string
+	TokenType    string
+	ExpiresIn    time.Duration
+}
+
+// Issuer issues and verifies the tokens of the user service.
+type Issuer struct {
+	cfg   Config
+	keys  *jwt.KeySet
+	clock clock.Clock
+}
+
+func NewIssuer(cfg Config, keys *jwt.KeySet, clk clock.Clock) *Issuer {
+	return &Issuer{
+		cfg:   cfg,
+		keys:  keys,
+		clock: clk,
+	}
+}
+
+// Issue creates a new token pair for the user.
+func (issuer *Issuer) Issue(user_id uuid.UUID, roles []string) (Pair, error) {
+	now := issuer.clock.NowUTC()
+
+	access_token, err := issuer.keys.Sign(issuer.claims(jwt.AccessToken, user_id, roles, now, issuer.cfg.AccessTTL))
+	if err != nil {
+		return Pair{}, err
+	}
+	refresh_token, err := issuer.keys.Sign(issuer.claims(jwt.RefreshToken, user_id, roles, now, issuer.cfg.RefreshTTL))
+	if err != nil {
+		return Pair{}, err
+	}
+	return Pair{
+		AccessToken:  access_token,
+		RefreshToken: refresh_token,
+		TokenType:    tokenType,
+		ExpiresIn:    issuer.cfg.AccessTTL,
+	}, nil
+}
+
+// IssueChallenge creates a token for the second login step.
+func (issuer *Issuer) IssueChallenge(user_id uuid.UUID) (string, time.Duration, error) {
+	challenge_token, err := issuer.keys.Sign(issuer.claims(jwt.ChallengeToken, user_id, nil, issuer.clock.NowUTC(), issuer.cfg.ChallengeTTL))
+	if err != nil {
+		return "", 0, err
+	}
+	return challenge_token, issuer.cfg.ChallengeTTL, nil
+}
+
+func (issuer *Issuer) claims(token_type string, user_id uuid.UUID, roles []string, now time.Time, ttl time.Duration) *jwt.Claims {
+	return &jwt.Claims{
+		ID:        uuid.NewString(),
+		Issuer:    issuer.cfg.Issuer,
+		Subject:   user_id.String(),
+		Type:      token_type,
+		Roles:     roles,
+		IssuedAt:  now.Unix(),
+		NotBefore: now.Unix(),
+		ExpiresAt: now.Add(ttl).Unix(),
+	}
+}
+
+// VerifyRefreshToken checks that the refresh token was issued by this service and is still valid.
+func (issuer *Issuer) VerifyRefreshToken(ctx context.Context, refresh_token string) (*jwt.Claims, error) {
+	return jwt.Verify(ctx, refresh_token, issuer.keys, &jwt.VerifyOptions{
+		Type:   jwt.RefreshToken,
+		Issuer: issuer.cfg.Issuer,
+		Now:    issuer.clock.NowUTC(),
+	})
+}
+
+// VerifyChallengeToken checks the token issued by IssueChallenge.
+func (issuer *Issuer) VerifyChallengeToken(ctx context.Context, challenge_token string) (*jwt.Claims, error) {
+	return jwt.Verify(ctx, challenge_token, issuer.keys, &jwt.VerifyOptions{
+		Type:   jwt.ChallengeToken,
+		Issuer: issuer.cfg.Issuer,
+		Now:    issuer.clock.NowUTC(),
+	})
+}
+
+// JWKS returns the public keys needed to verify issued tokens.
+func (issuer *Issuer) JWKS() *jwt.JWKS {
+	return issuer.keys.JWKS()
+}
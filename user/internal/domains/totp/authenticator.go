@@ -0,0 +1,52 @@
+package totp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ShmelJUJ/software-engineering/pkg/clock"
+	"github.com/google/uuid"
+)
+
+// CodeStore remembers used codes.
+type CodeStore interface {
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+}
+
+// Authenticator enrolls users and checks their authenticator codes.
+type Authenticator struct {
+	issuer string
+	store  CodeStore
+	clock  clock.Clock
+}
+
+func NewAuthenticator(issuer string, store CodeStore, clk clock.Clock) *Authenticator {
+	return &Authenticator{
+		issuer: issuer,
+		store:  store,
+		clock:  clk,
+	}
+}
+
+// Enroll generates a new secret for the account.
+func (authenticator *Authenticator) Enroll(account_name string) (Enrollment, error) {
+	return NewEnrollment(authenticator.issuer, account_name)
+}
+
+// Verify checks the code and makes it single-use.
+func (authenticator *Authenticator) Verify(ctx context.Context, client_id uuid.UUID, secret string, code string) error {
+	if !ValidateCode(code, secret, authenticator.clock.NowUTC()) {
+		return &InvalidCodeError{client_id: client_id}
+	}
+	// The code stays valid for the whole skew window around its period.
+	ttl := time.Duration((2*skew+1)*period) * time.Second
+	uses, err := authenticator.store.Incr(ctx, fmt.Sprintf("totp:used:%s:%s", client_id.String(), code), ttl)
+	if err != nil {
+		return err
+	}
+	if uses > 1 {
+		return &CodeReusedError{client_id: client_id}
+	}
+	return nil
+}
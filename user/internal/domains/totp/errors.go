@@ -0,0 +1,39 @@
+package totp
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+type TOTPNotFoundError struct {
+	client_id uuid.UUID
+}
+
+func (e *TOTPNotFoundError) Error() string {
+	return fmt.Sprintf("user with id %s has no totp secret", e.client_id.String())
+}
+
+type AlreadyEnabledError struct {
+	client_id uuid.UUID
+}
+
+func (e *AlreadyEnabledError) Error() string {
+	return fmt.Sprintf("user with id %s already has two-factor authentication enabled", e.client_id.String())
+}
+
+type CodeReusedError struct {
+	client_id uuid.UUID
+}
+
+func (e *CodeReusedError) Error() string {
+	return fmt.Sprintf("code of user with id %s was already used", e.client_id.String())
+}
+
+type InvalidCodeError struct {
+	client_id uuid.UUID
+}
+
+func (e *InvalidCodeError) Error() string {
+	return fmt.Sprintf("invalid code for user with id %s", e.client_id.String())
+}
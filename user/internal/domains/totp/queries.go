@@ -0,0 +1,22 @@
+package totp
+
+const (
+	kGetTOTPByUserId = `SELECT user_id, secret, confirmed
+						FROM public.user_totp
+						WHERE user_id = $1::UUID;`
+	kSaveSecret = `INSERT INTO public.user_totp (user_id, secret, confirmed)
+						VALUES ($1::UUID, $2::TEXT, FALSE)
+						ON CONFLICT (user_id) DO UPDATE
+						SET secret = EXCLUDED.secret, created_at = NOW()
+						WHERE user_totp.confirmed = FALSE;`
+	kConfirm = `UPDATE public.user_totp
+						SET confirmed = TRUE
+						WHERE user_id = $1::UUID AND confirmed = FALSE;`
+	kDeleteRecoveryCodes = `DELETE FROM public.user_recovery_codes
+						WHERE user_id = $1::UUID;`
+	kInsertRecoveryCode = `INSERT INTO public.user_recovery_codes (code_id, user_id, code_hash)
+						VALUES ($1::UUID, $2::UUID, $3::TEXT);`
+	kUseRecoveryCode = `UPDATE public.user_recovery_codes
+						SET used_at = NOW()
+						WHERE user_id = $1::UUID AND code_hash = $2::TEXT AND used_at IS NULL;`
+)
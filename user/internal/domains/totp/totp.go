@@ -0,0 +1,124 @@
+package totp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	period = 30
+	digits = otp.DigitsSix
+	// skew allows the code of the previous and the next period to tolerate clock drift of the phone.
+	skew = 1
+
+	qrSize = 256
+
+	recoveryCodesCount   = 10
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+type Enrollment struct {
+	secret      string
+	otpauth_uri string
+	qr_code     []byte
+}
+
+func (enrollment *Enrollment) GetSecret() string {
+	return enrollment.secret
+}
+
+func (enrollment *Enrollment) GetOtpauthURI() string {
+	return enrollment.otpauth_uri
+}
+
+func (enrollment *Enrollment) GetQRCode() []byte {
+	return enrollment.qr_code
+}
+
+// NewEnrollment generates a new secret and its otpauth uri with the png qr code for authenticator apps.
+func NewEnrollment(issuer string, account_name string) (Enrollment, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      issuer,
+		AccountName: account_name,
+		Period:      period,
+		Digits:      digits,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return Enrollment{}, err
+	}
+	image, err := key.Image(qrSize, qrSize)
+	if err != nil {
+		return Enrollment{}, err
+	}
+	var qr_code bytes.Buffer
+	if err := png.Encode(&qr_code, image); err != nil {
+		return Enrollment{}, err
+	}
+	return Enrollment{
+		secret:      key.Secret(),
+		otpauth_uri: key.URL(),
+		qr_code:     qr_code.Bytes(),
+	}, nil
+}
+
+// ValidateCode checks the code from the authenticator app.
+func ValidateCode(code string, secret string, now time.Time) bool {
+	valid, err := totp.ValidateCustom(strings.TrimSpace(code), secret, now, totp.ValidateOpts{
+		Period:    period,
+		Skew:      skew,
+		Digits:    digits,
+		Algorithm: otp.AlgorithmSHA1,
+	})
+	return err == nil && valid
+}
+
+// GenerateRecoveryCodes returns one-time codes to show to the user and their hashes to store.
+func GenerateRecoveryCodes() (codes []string, hashes []string, err error) {
+	for i := 0; i < recoveryCodesCount; i++ {
+		code, err := randomCode()
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode normalizes the recovery code as typed by the user and hashes it.
+// Codes are random enough for a plain hash to be safe.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsRecoveryCode tells recovery codes apart from numeric authenticator codes.
+func IsRecoveryCode(code string) bool {
+	return len(strings.TrimSpace(code)) != digits.Length()
+}
+
+func randomCode() (string, error) {
+	random := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	var code strings.Builder
+	for i, b := range random {
+		if i == recoveryCodeLength/2 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+	}
+	return code.String(), nil
+}
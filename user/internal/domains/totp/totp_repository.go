@@ -0,0 +1,126 @@
+package totp
+
+import (
+	"context"
+	"errors"
+
+	pg "github.com/ShmelJUJ/software-engineering/pkg/postgres"
+	"github.com/go-faster/sdk/zctx"
+	"github.com/google/uuid"
+	pgx "github.com/jackc/pgx/v5"
+)
+
+type TOTPRepository struct {
+	cluster pg.Postgres
+	ctx     context.Context
+}
+
+type TOTPColumns struct {
+	UserId    uuid.UUID `db:"user_id"`
+	Secret    string    `db:"secret"`
+	Confirmed bool      `db:"confirmed"`
+}
+
+type TOTP struct {
+	client_id uuid.UUID
+	secret    string
+	confirmed bool
+}
+
+func (t *TOTP) GetSecret() string {
+	return t.secret
+}
+
+func (t *TOTP) IsConfirmed() bool {
+	return t.confirmed
+}
+
+func NewTOTPRepository(url string, ctx context.Context) (TOTPRepository, error) {
+	lg := zctx.From(ctx)
+	pg_cluster, err := pg.New(ctx, url)
+	if err != nil {
+		lg.Error(err.Error())
+		return TOTPRepository{}, err
+	}
+	return TOTPRepository{
+		cluster: *pg_cluster,
+		ctx:     ctx,
+	}, nil
+}
+
+func (repository *TOTPRepository) GetTOTP(client_id uuid.UUID) (TOTP, error) {
+	row, err := repository.cluster.Pool.Query(repository.ctx, kGetTOTPByUserId, client_id.String())
+	lg := zctx.From(repository.ctx)
+	if err != nil {
+		lg.Error(err.Error())
+		return TOTP{}, err
+	}
+	defer row.Close()
+	totp_record, err := pgx.CollectOneRow[TOTPColumns](row, pgx.RowToStructByName[TOTPColumns])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return TOTP{}, &TOTPNotFoundError{client_id: client_id}
+		}
+		lg.Error(err.Error())
+		return TOTP{}, err
+	}
+	return TOTP{
+		client_id: totp_record.UserId,
+		secret:    totp_record.Secret,
+		confirmed: totp_record.Confirmed,
+	}, nil
+}
+
+// SaveSecret stores a new unconfirmed secret, replacing the previous unconfirmed one.
+func (repository *TOTPRepository) SaveSecret(client_id uuid.UUID, secret string) error {
+	tag, err := repository.cluster.Pool.Exec(repository.ctx, kSaveSecret, client_id.String(), secret)
+	if err != nil {
+		zctx.From(repository.ctx).Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return &AlreadyEnabledError{client_id: client_id}
+	}
+	return nil
+}
+
+// Confirm enables two-factor authentication and replaces the recovery codes.
+func (repository *TOTPRepository) Confirm(client_id uuid.UUID, recovery_code_hashes []string) error {
+	lg := zctx.From(repository.ctx)
+	tx, err := repository.cluster.Pool.Begin(repository.ctx)
+	if err != nil {
+		lg.Error(err.Error())
+		return err
+	}
+	defer tx.Rollback(repository.ctx) //nolint:errcheck // no-op after commit
+
+	tag, err := tx.Exec(repository.ctx, kConfirm, client_id.String())
+	if err != nil {
+		lg.Error(err.Error())
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return &AlreadyEnabledError{client_id: client_id}
+	}
+	if _, err := tx.Exec(repository.ctx, kDeleteRecoveryCodes, client_id.String()); err != nil {
+		lg.Error(err.Error())
+		return err
+	}
+	for _, code_hash := range recovery_code_hashes {
+		if _, err := tx.Exec(repository.ctx, kInsertRecoveryCode, uuid.NewString(), client_id.String(), code_hash); err != nil {
+			lg.Error(err.Error())
+			return err
+		}
+	}
+	return tx.Commit(repository.ctx)
+}
+
+// UseRecoveryCode marks the recovery code as used and reports whether it was valid.
+func (repository *TOTPRepository) UseRecoveryCode(client_id uuid.UUID, code_hash string) (bool, error) {
+	tag, err := repository.cluster.Pool.Exec(repository.ctx, kUseRecoveryCode, client_id.String(), code_hash)
+	if err != nil {
+		zctx.From(repository.ctx).Error(err.Error())
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,143 @@
+package totp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mock_clock "github.com/ShmelJUJ/software-engineering/pkg/clock/mocks"
+	"github.com/google/uuid"
+	"github.com/pquerna/otp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// Secret of the RFC 6238 test vectors, base32 encoded.
+const testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateCode(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		code     string
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "Valid code",
+			code:     "287082",
+			now:      time.Unix(59, 0),
+			expected: true,
+		},
+		{
+			name:     "Code of the previous period",
+			code:     "287082",
+			now:      time.Unix(89, 0),
+			expected: true,
+		},
+		{
+			name:     "Code outside the skew window",
+			code:     "287082",
+			now:      time.Unix(150, 0),
+			expected: false,
+		},
+		{
+			name:     "Wrong code",
+			code:     "123456",
+			now:      time.Unix(59, 0),
+			expected: false,
+		},
+		{
+			name:     "Malformed code",
+			code:     "test-code",
+			now:      time.Unix(59, 0),
+			expected: false,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testcase.expected, ValidateCode(testcase.code, testSecret, testcase.now))
+		})
+	}
+}
+
+func TestNewEnrollment(t *testing.T) {
+	t.Parallel()
+
+	enrollment, err := NewEnrollment("test-issuer", "test@example.com")
+	require.NoError(t, err)
+
+	key, err := otp.NewKeyFromURL(enrollment.GetOtpauthURI())
+	require.NoError(t, err)
+
+	assert.Equal(t, enrollment.GetSecret(), key.Secret())
+	assert.Equal(t, "test-issuer", key.Issuer())
+	assert.Equal(t, "test@example.com", key.AccountName())
+	assert.True(t, strings.HasPrefix(string(enrollment.GetQRCode()), "\x89PNG"))
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	codes, hashes, err := GenerateRecoveryCodes()
+	require.NoError(t, err)
+
+	assert.Len(t, codes, recoveryCodesCount)
+	assert.Len(t, hashes, recoveryCodesCount)
+
+	for i, code := range codes {
+		assert.True(t, IsRecoveryCode(code))
+		assert.Equal(t, hashes[i], HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))))
+	}
+}
+
+type testCodeStore struct {
+	uses map[string]int64
+	err  error
+}
+
+func (s *testCodeStore) Incr(_ context.Context, key string, _ time.Duration) (int64, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	s.uses[key]++
+
+	return s.uses[key], nil
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clientID := uuid.New()
+	testErr := errors.New("test err")
+
+	mockCtrl := gomock.NewController(t)
+	clk := mock_clock.NewMockClock(mockCtrl)
+	clk.EXPECT().NowUTC().Return(time.Unix(59, 0)).AnyTimes()
+
+	authenticator := NewAuthenticator("test-issuer", &testCodeStore{uses: make(map[string]int64)}, clk)
+
+	require.NoError(t, authenticator.Verify(ctx, clientID, testSecret, "287082"))
+
+	var reused *CodeReusedError
+	assert.ErrorAs(t, authenticator.Verify(ctx, clientID, testSecret, "287082"), &reused)
+
+	var invalid *InvalidCodeError
+	assert.ErrorAs(t, authenticator.Verify(ctx, clientID, testSecret, "123456"), &invalid)
+
+	assert.NoError(t, authenticator.Verify(ctx, uuid.New(), testSecret, "287082"))
+
+	failing := NewAuthenticator("test-issuer", &testCodeStore{err: testErr}, clk)
+	assert.ErrorIs(t, failing.Verify(ctx, clientID, testSecret, "287082"), testErr)
+}
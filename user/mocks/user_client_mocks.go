@@ -40,6 +40,21 @@ func (m *MockHandler) EXPECT() *MockHandlerMockRecorder {
 	return m.recorder
 }
 
+// ConfirmTOTPEnrollment mocks base method.
+func (m *MockHandler) ConfirmTOTPEnrollment(arg0 context.Context, arg1 user.OptTOTPCode, arg2 user.ConfirmTOTPEnrollmentParams) (user.ConfirmTOTPEnrollmentRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTOTPEnrollment", arg0, arg1, arg2)
+	ret0, _ := ret[0].(user.ConfirmTOTPEnrollmentRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTOTPEnrollment indicates an expected call of ConfirmTOTPEnrollment.
+func (mr *MockHandlerMockRecorder) ConfirmTOTPEnrollment(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTOTPEnrollment", reflect.TypeOf((*MockHandler)(nil).ConfirmTOTPEnrollment), arg0, arg1, arg2)
+}
+
 // GetAuthToken mocks base method.
 func (m *MockHandler) GetAuthToken(arg0 context.Context, arg1 user.OptAuthRequest) (user.GetAuthTokenRes, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +85,21 @@ func (mr *MockHandlerMockRecorder) GetClientById(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientById", reflect.TypeOf((*MockHandler)(nil).GetClientById), arg0, arg1)
 }
 
+// GetJWKS mocks base method.
+func (m *MockHandler) GetJWKS(arg0 context.Context) (user.GetJWKSRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJWKS", arg0)
+	ret0, _ := ret[0].(user.GetJWKSRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJWKS indicates an expected call of GetJWKS.
+func (mr *MockHandlerMockRecorder) GetJWKS(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockHandler)(nil).GetJWKS), arg0)
+}
+
 // GetWalletById mocks base method.
 func (m *MockHandler) GetWalletById(arg0 context.Context, arg1 user.GetWalletByIdParams) (user.GetWalletByIdRes, error) {
 	m.ctrl.T.Helper()
@@ -84,3 +114,63 @@ func (mr *MockHandlerMockRecorder) GetWalletById(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWalletById", reflect.TypeOf((*MockHandler)(nil).GetWalletById), arg0, arg1)
 }
+
+// RefreshAuthToken mocks base method.
+func (m *MockHandler) RefreshAuthToken(arg0 context.Context, arg1 user.OptRefreshRequest) (user.RefreshAuthTokenRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshAuthToken", arg0, arg1)
+	ret0, _ := ret[0].(user.RefreshAuthTokenRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshAuthToken indicates an expected call of RefreshAuthToken.
+func (mr *MockHandlerMockRecorder) RefreshAuthToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshAuthToken", reflect.TypeOf((*MockHandler)(nil).RefreshAuthToken), arg0, arg1)
+}
+
+// StartTOTPEnrollment mocks base method.
+func (m *MockHandler) StartTOTPEnrollment(arg0 context.Context, arg1 user.StartTOTPEnrollmentParams) (user.StartTOTPEnrollmentRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTOTPEnrollment", arg0, arg1)
+	ret0, _ := ret[0].(user.StartTOTPEnrollmentRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTOTPEnrollment indicates an expected call of StartTOTPEnrollment.
+func (mr *MockHandlerMockRecorder) StartTOTPEnrollment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTOTPEnrollment", reflect.TypeOf((*MockHandler)(nil).StartTOTPEnrollment), arg0, arg1)
+}
+
+// UnlockLogin mocks base method.
+func (m *MockHandler) UnlockLogin(arg0 context.Context, arg1 user.OptUnlockRequest) (user.UnlockLoginRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockLogin", arg0, arg1)
+	ret0, _ := ret[0].(user.UnlockLoginRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnlockLogin indicates an expected call of UnlockLogin.
+func (mr *MockHandlerMockRecorder) UnlockLogin(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockLogin", reflect.TypeOf((*MockHandler)(nil).UnlockLogin), arg0, arg1)
+}
+
+// VerifyTOTPChallenge mocks base method.
+func (m *MockHandler) VerifyTOTPChallenge(arg0 context.Context, arg1 user.OptTOTPChallengeRequest) (user.VerifyTOTPChallengeRes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTOTPChallenge", arg0, arg1)
+	ret0, _ := ret[0].(user.VerifyTOTPChallengeRes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyTOTPChallenge indicates an expected call of VerifyTOTPChallenge.
+func (mr *MockHandlerMockRecorder) VerifyTOTPChallenge(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTOTPChallenge", reflect.TypeOf((*MockHandler)(nil).VerifyTOTPChallenge), arg0, arg1)
+}